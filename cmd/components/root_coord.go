@@ -27,7 +27,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
-	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
 
@@ -36,7 +35,6 @@ type RootCoord struct {
 	ctx context.Context
 	svr *rc.Server
 
-	tracer opentracing.Tracer
 	closer io.Closer
 }
 
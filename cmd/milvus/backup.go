@@ -0,0 +1,905 @@
+package milvus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/cmd/tools/migration/backend"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/log"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvindexcoord "github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	BackupCmd         = "backup"
+	BackupTypeCreate  = "create"
+	BackupTypeRestore = "restore"
+
+	backupComponent     = "milvus"
+	restoreObjectPrefix = "backup"
+	importCheckInterval = 2 * time.Second
+
+	// the key of the timestamp saved by the tso allocator of rootcoord, under the kv root path
+	tsoTimestampKey = "tso/timestamp"
+)
+
+// backupManifest lists the collections of a metadata snapshot and the objects referenced by their segments.
+type backupManifest struct {
+	Revision int64 `json:"revision"`
+	// CreateTime is the unix time in seconds, for display only
+	CreateTime int64 `json:"create_time"`
+	// SnapshotTs is a hybrid timestamp no less than any timestamp allocated before the snapshot
+	SnapshotTs           uint64                `json:"snapshot_ts"`
+	ChunkManagerRootPath string                `json:"chunk_manager_root_path"`
+	Collections          []*collectionManifest `json:"collections"`
+}
+
+type collectionManifest struct {
+	ID               int64                     `json:"id"`
+	DBID             int64                     `json:"db_id"`
+	DBName           string                    `json:"db_name"`
+	Name             string                    `json:"name"`
+	Schema           []byte                    `json:"schema"`
+	ShardsNum        int32                     `json:"shards_num"`
+	ConsistencyLevel commonpb.ConsistencyLevel `json:"consistency_level"`
+	// Properties keep the collection ttl, the dynamic field switch and the number of partitions of a partition key collection
+	Properties map[string]string    `json:"properties"`
+	Partitions []*partitionManifest `json:"partitions"`
+	Indexes    []*indexManifest     `json:"indexes"`
+}
+
+type partitionManifest struct {
+	ID       int64              `json:"id"`
+	Name     string             `json:"name"`
+	Segments []*segmentManifest `json:"segments"`
+}
+
+type indexManifest struct {
+	ID      int64             `json:"id"`
+	FieldID int64             `json:"field_id"`
+	Name    string            `json:"name"`
+	Params  map[string]string `json:"params"`
+}
+
+type segmentManifest struct {
+	ID         int64             `json:"id"`
+	NumRows    int64             `json:"num_rows"`
+	InsertLogs []*objectManifest `json:"insert_logs"`
+	DeltaLogs  []*objectManifest `json:"delta_logs"`
+	StatsLogs  []*objectManifest `json:"stats_logs"`
+	IndexFiles []*objectManifest `json:"index_files"`
+}
+
+type objectManifest struct {
+	Path    string `json:"path"`
+	FieldID int64  `json:"field_id,omitempty"`
+	Size    int64  `json:"size"`
+}
+
+// backupExtra is stored in the extra of backup header, the manifest is kept beside the etcd entries
+// so that a restore doesn't need to decode the metadata again.
+type backupExtra struct {
+	backend.BackupHeaderExtra
+	Manifest *backupManifest `json:"manifest"`
+}
+
+type backup struct {
+	params *paramtable.ComponentParam
+
+	etcdIP          string
+	etcdRootPath    string
+	revision        int64
+	output          string
+	input           string
+	collectionName  string
+	newName         string
+	milvusAddress   string
+	wait            bool
+	source          minioFlags
+	target          minioFlags
+	sourceCM        storage.ChunkManager
+	targetCM        storage.ChunkManager
+	milvusClient    milvuspb.MilvusServiceClient
+	restoreBasePath string
+}
+
+type minioFlags struct {
+	address    string
+	username   string
+	password   string
+	useSSL     string
+	bucketName string
+	rootPath   string
+}
+
+func (c *backup) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, backupLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, backupLine)
+	}
+
+	logutil.SetupLogger(&log.Config{
+		Level: "info",
+		File: log.FileLogConfig{
+			Filename: fmt.Sprintf("backup-%s.log", time.Now().Format("20060102150405.99")),
+		},
+	})
+
+	paramtable.Init()
+	c.params = paramtable.Get()
+	c.formatFlags(args, flags)
+
+	ctx := context.Background()
+	var err error
+	switch args[2] {
+	case BackupTypeCreate:
+		err = c.create(ctx)
+	case BackupTypeRestore:
+		err = c.restore(ctx)
+	default:
+		fmt.Fprintln(os.Stderr, backupLine)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s %s failed: %s\n", BackupCmd, args[2], err.Error())
+		os.Exit(1)
+	}
+}
+
+func (c *backup) formatFlags(args []string, flags *flag.FlagSet) {
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.etcdRootPath, "etcdRootPath", "", "Etcd root path")
+	flags.Int64Var(&c.revision, "revision", 0, "Etcd revision of the snapshot, 0 means the latest")
+	flags.StringVar(&c.output, "output", "", "Backup file to write")
+	flags.StringVar(&c.input, "input", "", "Backup file to restore from")
+	flags.StringVar(&c.collectionName, "collection", "", "Collection to restore")
+	flags.StringVar(&c.newName, "newName", "", "Name of the restored collection")
+	flags.StringVar(&c.milvusAddress, "milvusAddress", "localhost:19530", "Address of the milvus proxy to restore into")
+	flags.BoolVar(&c.wait, "wait", true, "Wait for the restore import tasks to finish")
+	c.source.register(flags, "minio", "")
+	c.target.register(flags, "targetMinio", "the restore target, default to the source minio ")
+
+	if err := flags.Parse(args[3:]); err != nil {
+		log.Fatal("failed to parse flags", zap.Error(err))
+	}
+	log.Info("args", zap.Strings("args", args))
+}
+
+func (f *minioFlags) register(flags *flag.FlagSet, prefix string, usagePrefix string) {
+	flags.StringVar(&f.address, prefix+"Address", "", usagePrefix+"Minio endpoint to connect")
+	flags.StringVar(&f.username, prefix+"Username", "", usagePrefix+"Minio username")
+	flags.StringVar(&f.password, prefix+"Password", "", usagePrefix+"Minio password")
+	flags.StringVar(&f.useSSL, prefix+"UseSSL", "", usagePrefix+"Minio to use ssl")
+	flags.StringVar(&f.bucketName, prefix+"BucketName", "", usagePrefix+"Minio bucket name")
+	flags.StringVar(&f.rootPath, prefix+"RootPath", "", usagePrefix+"Minio root path")
+}
+
+// withDefault fills the empty flags by the given ones
+func (f minioFlags) withDefault(d minioFlags) minioFlags {
+	pick := func(a, b string) string {
+		if a != "" {
+			return a
+		}
+		return b
+	}
+	return minioFlags{
+		address:    pick(f.address, d.address),
+		username:   pick(f.username, d.username),
+		password:   pick(f.password, d.password),
+		useSSL:     pick(f.useSSL, d.useSSL),
+		bucketName: pick(f.bucketName, d.bucketName),
+		rootPath:   pick(f.rootPath, d.rootPath),
+	}
+}
+
+func (c *backup) paramMinioFlags() minioFlags {
+	return minioFlags{
+		address:    c.params.MinioCfg.Address.GetValue(),
+		username:   c.params.MinioCfg.AccessKeyID.GetValue(),
+		password:   c.params.MinioCfg.SecretAccessKey.GetValue(),
+		useSSL:     c.params.MinioCfg.UseSSL.GetValue(),
+		bucketName: c.params.MinioCfg.BucketName.GetValue(),
+		rootPath:   c.params.MinioCfg.RootPath.GetValue(),
+	}
+}
+
+func (c *backup) newChunkManager(ctx context.Context, f minioFlags) (storage.ChunkManager, error) {
+	useSSL, _ := strconv.ParseBool(f.useSSL)
+	return storage.NewMinioChunkManager(ctx,
+		storage.Address(f.address),
+		storage.AccessKeyID(f.username),
+		storage.SecretAccessKeyID(f.password),
+		storage.UseSSL(useSSL),
+		storage.BucketName(f.bucketName),
+		storage.RootPath(f.rootPath),
+		storage.UseIAM(c.params.MinioCfg.UseIAM.GetAsBool()),
+		storage.CloudProvider(c.params.MinioCfg.CloudProvider.GetValue()),
+		storage.IAMEndpoint(c.params.MinioCfg.IAMEndpoint.GetValue()),
+		storage.CreateBucket(true))
+}
+
+func (c *backup) connectEtcd() (*clientv3.Client, error) {
+	if c.etcdIP != "" {
+		return etcd.GetRemoteEtcdClient([]string{c.etcdIP})
+	}
+	return etcd.GetEtcdClient(
+		c.params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		c.params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		c.params.EtcdCfg.Endpoints.GetAsStrings(),
+		c.params.EtcdCfg.EtcdTLSCert.GetValue(),
+		c.params.EtcdCfg.EtcdTLSKey.GetValue(),
+		c.params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		c.params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+}
+
+// create takes a snapshot of all the metadata under the etcd root path at one revision,
+// and writes it with the manifest of referenced objects to the backup file.
+func (c *backup) create(ctx context.Context) error {
+	if c.output == "" {
+		return errors.New("the output backup file is not specified")
+	}
+	etcdCli, err := c.connectEtcd()
+	if err != nil {
+		return fmt.Errorf("failed to connect to etcd: %w", err)
+	}
+	defer etcdCli.Close()
+
+	rootPath := getConfigValue(c.etcdRootPath, c.params.EtcdCfg.MetaRootPath.GetValue(), "ectd_root_path")
+	source := c.source.withDefault(c.paramMinioFlags())
+	if c.sourceCM, err = c.newChunkManager(ctx, source); err != nil {
+		return fmt.Errorf("failed to connect to minio: %w", err)
+	}
+
+	manifest, kvs, err := c.snapshotMetadata(ctx, etcdCli, rootPath, c.params.EtcdCfg.KvRootPath.GetValue(), source.rootPath)
+	if err != nil {
+		return err
+	}
+	if err := writeBackupFile(c.output, rootPath, manifest, kvs); err != nil {
+		return err
+	}
+	fmt.Printf("backup %d collections at revision %d to %s\n", len(manifest.Collections), manifest.Revision, c.output)
+	return nil
+}
+
+// snapshotMetadata loads the metadata under rootPath at one revision and builds its manifest, the snapshot
+// timestamp is read at the same revision from the tso allocator under kvRootPath.
+func (c *backup) snapshotMetadata(ctx context.Context, etcdCli *clientv3.Client, rootPath, kvRootPath, chunkRootPath string) (*backupManifest, map[string]string, error) {
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if c.revision > 0 {
+		opts = append(opts, clientv3.WithRev(c.revision))
+	}
+	resp, err := etcdCli.Get(ctx, rootPath+"/", opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load metadata from etcd: %w", err)
+	}
+	revision := resp.Header.GetRevision()
+	kvs := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		kvs[strings.TrimPrefix(string(kv.Key), rootPath+"/")] = string(kv.Value)
+	}
+	log.Info("load metadata snapshot", zap.String("rootPath", rootPath),
+		zap.Int64("revision", revision), zap.Int("entries", len(kvs)))
+
+	snapshotTs, err := loadSnapshotTs(ctx, etcdCli, kvRootPath, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot := memkv.NewMemoryKV()
+	if err := snapshot.MultiSave(kvs); err != nil {
+		return nil, nil, err
+	}
+	manifest, err := buildBackupManifest(ctx, snapshot, c.sourceCM, chunkRootPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest.Revision = revision
+	manifest.CreateTime = time.Now().Unix()
+	manifest.SnapshotTs = snapshotTs
+	return manifest, kvs, nil
+}
+
+// loadSnapshotTs returns the timestamp saved by the tso allocator at the revision. The allocator saves
+// the end of its window before handing out any timestamp in it, so every timestamp of the data in the
+// snapshot is less than the saved one.
+func loadSnapshotTs(ctx context.Context, etcdCli *clientv3.Client, kvRootPath string, revision int64) (uint64, error) {
+	key := path.Join(kvRootPath, tsoTimestampKey)
+	resp, err := etcdCli.Get(ctx, key, clientv3.WithRev(revision))
+	if err != nil {
+		return 0, fmt.Errorf("failed to load timestamp from etcd: %w", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, fmt.Errorf("timestamp %s is not found at revision %d", key, revision)
+	}
+	saved, err := typeutil.ParseTimestamp(resp.Kvs[0].Value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %s: %w", key, err)
+	}
+	return tsoutil.ComposeTSByTime(saved, 0), nil
+}
+
+func writeBackupFile(output string, rootPath string, manifest *backupManifest, kvs map[string]string) error {
+	extra, err := json.Marshal(&backupExtra{Manifest: manifest})
+	if err != nil {
+		return err
+	}
+	header := &backend.BackupHeader{
+		Version:   backend.BackupHeaderVersionV1,
+		Instance:  rootPath,
+		Component: backupComponent,
+		Extra:     extra,
+	}
+	file, err := backend.NewBackupCodec().Serialize(header, kvs)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, file, 0600)
+}
+
+// buildBackupManifest decodes the metadata snapshot by the coordinator catalogs, and collects the objects
+// referenced by the flushed segments. Every object is checked to exist in the ChunkManager.
+func buildBackupManifest(ctx context.Context, snapshot kv.TxnKV, cm storage.ChunkManager, chunkRootPath string) (*backupManifest, error) {
+	ss, err := kvrootcoord.NewSuffixSnapshot(snapshot, kvrootcoord.SnapshotsSep, "", kvrootcoord.SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	rootCatalog := &kvrootcoord.Catalog{Txn: snapshot, Snapshot: ss}
+	dataCatalog := &kvdatacoord.Catalog{Txn: snapshot, ChunkManagerRootPath: chunkRootPath}
+	indexCatalog := &kvindexcoord.Catalog{Txn: snapshot}
+
+	dbIDs := []int64{util.DefaultDBID}
+	dbNames := map[int64]string{util.DefaultDBID: util.DefaultDBName}
+	dbs, err := rootCatalog.ListDatabases(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		if db.ID != util.DefaultDBID {
+			dbIDs = append(dbIDs, db.ID)
+			dbNames[db.ID] = db.Name
+		}
+	}
+	var collections []*model.Collection
+	for _, dbID := range dbIDs {
+		colls, err := rootCatalog.ListCollections(ctx, dbID, 0)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, colls...)
+	}
+
+	segments, err := dataCatalog.ListSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := indexCatalog.ListIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segmentIndexes, err := indexCatalog.ListSegmentIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segmentIndexMap := make(map[int64][]*model.SegmentIndex)
+	for _, segIdx := range segmentIndexes {
+		if !segIdx.IsDeleted && segIdx.IndexState == commonpb.IndexState_Finished {
+			segmentIndexMap[segIdx.SegmentID] = append(segmentIndexMap[segIdx.SegmentID], segIdx)
+		}
+	}
+
+	manifest := &backupManifest{ChunkManagerRootPath: chunkRootPath}
+	for _, coll := range collections {
+		if !coll.Available() {
+			continue
+		}
+		collInfo := model.MarshalCollectionModelWithOption(coll, model.WithFields())
+		schema, err := proto.Marshal(collInfo.GetSchema())
+		if err != nil {
+			return nil, err
+		}
+		collManifest := &collectionManifest{
+			ID:               coll.CollectionID,
+			DBID:             coll.DBID,
+			DBName:           dbNames[coll.DBID],
+			Name:             coll.Name,
+			Schema:           schema,
+			ShardsNum:        coll.ShardsNum,
+			ConsistencyLevel: coll.ConsistencyLevel,
+			Properties:       kvPairsToMap(coll.Properties),
+		}
+		partitions := make(map[int64]*partitionManifest)
+		for _, partition := range coll.Partitions {
+			if !partition.Available() {
+				continue
+			}
+			p := &partitionManifest{ID: partition.PartitionID, Name: partition.PartitionName}
+			partitions[partition.PartitionID] = p
+			collManifest.Partitions = append(collManifest.Partitions, p)
+		}
+		for _, index := range indexes {
+			if index.CollectionID != coll.CollectionID || index.IsDeleted {
+				continue
+			}
+			params := index.UserIndexParams
+			if len(params) == 0 {
+				params = index.IndexParams
+			}
+			collManifest.Indexes = append(collManifest.Indexes, &indexManifest{
+				ID:      index.IndexID,
+				FieldID: index.FieldID,
+				Name:    index.IndexName,
+				Params:  kvPairsToMap(params),
+			})
+		}
+
+		for _, segment := range segments {
+			// only the flushed segments have complete binlogs
+			if segment.GetCollectionID() != coll.CollectionID || segment.GetState() != commonpb.SegmentState_Flushed {
+				continue
+			}
+			p, ok := partitions[segment.GetPartitionID()]
+			if !ok {
+				continue
+			}
+			segManifest, err := buildSegmentManifest(ctx, cm, chunkRootPath, segment, segmentIndexMap[segment.GetID()])
+			if err != nil {
+				return nil, err
+			}
+			p.Segments = append(p.Segments, segManifest)
+		}
+		manifest.Collections = append(manifest.Collections, collManifest)
+	}
+	return manifest, nil
+}
+
+func buildSegmentManifest(ctx context.Context, cm storage.ChunkManager, chunkRootPath string, segment *datapb.SegmentInfo, segmentIndexes []*model.SegmentIndex) (*segmentManifest, error) {
+	objects := func(fieldBinlogs []*datapb.FieldBinlog) ([]*objectManifest, error) {
+		var result []*objectManifest
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				obj, err := statObject(ctx, cm, binlog.GetLogPath())
+				if err != nil {
+					return nil, err
+				}
+				obj.FieldID = fieldBinlog.GetFieldID()
+				result = append(result, obj)
+			}
+		}
+		return result, nil
+	}
+
+	segManifest := &segmentManifest{ID: segment.GetID(), NumRows: segment.GetNumOfRows()}
+	var err error
+	if segManifest.InsertLogs, err = objects(segment.GetBinlogs()); err != nil {
+		return nil, err
+	}
+	if segManifest.DeltaLogs, err = objects(segment.GetDeltalogs()); err != nil {
+		return nil, err
+	}
+	if segManifest.StatsLogs, err = objects(segment.GetStatslogs()); err != nil {
+		return nil, err
+	}
+	for _, segIdx := range segmentIndexes {
+		for _, filePath := range metautil.BuildSegmentIndexFilePaths(chunkRootPath, segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys) {
+			obj, err := statObject(ctx, cm, filePath)
+			if err != nil {
+				return nil, err
+			}
+			segManifest.IndexFiles = append(segManifest.IndexFiles, obj)
+		}
+	}
+	return segManifest, nil
+}
+
+func statObject(ctx context.Context, cm storage.ChunkManager, filePath string) (*objectManifest, error) {
+	size, err := cm.Size(ctx, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("referenced object %s is not available: %w", filePath, err)
+	}
+	return &objectManifest{Path: filePath, Size: size}, nil
+}
+
+func kvPairsToMap(pairs []*commonpb.KeyValuePair) map[string]string {
+	m := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		m[pair.GetKey()] = pair.GetValue()
+	}
+	return m
+}
+
+func mapToKvPairs(m map[string]string) []*commonpb.KeyValuePair {
+	pairs := make([]*commonpb.KeyValuePair, 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, &commonpb.KeyValuePair{Key: k, Value: v})
+	}
+	return pairs
+}
+
+func readBackupManifest(file string) (*backupManifest, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	backupFile := backend.BackupFile(content)
+	header, _, err := backupFile.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+	if header.Component != backupComponent {
+		return nil, fmt.Errorf("%s is not a milvus metadata backup, component: %s", file, header.Component)
+	}
+	extra := &backupExtra{}
+	if err := json.Unmarshal(header.Extra, extra); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %w", err)
+	}
+	if extra.Manifest == nil {
+		return nil, errors.New("invalid backup file, the manifest is missing")
+	}
+	return extra.Manifest, nil
+}
+
+func (m *backupManifest) getCollection(name string) (*collectionManifest, error) {
+	for _, coll := range m.Collections {
+		if coll.Name == name {
+			return coll, nil
+		}
+	}
+	return nil, fmt.Errorf("collection %s is not in the backup", name)
+}
+
+// restore rebuilds a collection of the backup under a new name. The binlogs of the collection are copied into
+// the target storage with the layout of the binlog import, then imported partition by partition.
+func (c *backup) restore(ctx context.Context) error {
+	if c.input == "" || c.collectionName == "" {
+		return errors.New("the input backup file and the collection to restore are required")
+	}
+	if c.newName == "" {
+		c.newName = c.collectionName
+	}
+	manifest, err := readBackupManifest(c.input)
+	if err != nil {
+		return err
+	}
+	coll, err := manifest.getCollection(c.collectionName)
+	if err != nil {
+		return err
+	}
+
+	source := c.source.withDefault(c.paramMinioFlags())
+	target := c.target.withDefault(source)
+	if c.sourceCM, err = c.newChunkManager(ctx, source); err != nil {
+		return fmt.Errorf("failed to connect to source minio: %w", err)
+	}
+	if c.targetCM, err = c.newChunkManager(ctx, target); err != nil {
+		return fmt.Errorf("failed to connect to target minio: %w", err)
+	}
+
+	conn, err := grpc.DialContext(ctx, c.milvusAddress, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return fmt.Errorf("failed to connect to milvus %s: %w", c.milvusAddress, err)
+	}
+	defer conn.Close()
+	c.milvusClient = milvuspb.NewMilvusServiceClient(conn)
+
+	tasks, err := c.restoreCollection(ctx, manifest, coll, target.rootPath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("restore collection %s as %s with %d import tasks\n", c.collectionName, c.newName, len(tasks))
+	return nil
+}
+
+// restoreEndTs returns the end_ts option of the restore imports, in milliseconds as the import expects.
+func restoreEndTs(manifest *backupManifest) string {
+	if manifest.SnapshotTs == 0 {
+		// backups without snapshot_ts only have the create time in seconds, round it up
+		return strconv.FormatInt(time.Unix(manifest.CreateTime+1, 0).UnixMilli(), 10)
+	}
+	return strconv.FormatInt(tsoutil.PhysicalTime(manifest.SnapshotTs).UnixMilli(), 10)
+}
+
+// restoreCollection creates the collection and imports its partitions from the copied binlogs,
+// returns the import tasks.
+func (c *backup) restoreCollection(ctx context.Context, manifest *backupManifest, coll *collectionManifest, targetRootPath string) ([]int64, error) {
+	c.restoreBasePath = path.Join(targetRootPath, restoreObjectPrefix,
+		fmt.Sprintf("%d-%d", manifest.Revision, coll.ID))
+
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(coll.Schema, schema); err != nil {
+		return nil, err
+	}
+	partitionKeyMode := typeutil.GetPartitionKeyField(schema) != nil
+	fieldIDMapping, err := c.createCollection(ctx, coll, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []int64
+	for _, partition := range coll.Partitions {
+		if len(partition.Segments) == 0 {
+			continue
+		}
+		insertRoot, deltaRoot, err := c.copyPartitionBinlogs(ctx, coll, partition, fieldIDMapping)
+		if err != nil {
+			return nil, err
+		}
+		req := &milvuspb.ImportRequest{
+			CollectionName: c.newName,
+			PartitionName:  partition.Name,
+			Files:          []string{insertRoot, deltaRoot},
+			Options: []*commonpb.KeyValuePair{
+				{Key: importutil.BackupFlag, Value: "true"},
+				{Key: importutil.EndTs, Value: restoreEndTs(manifest)},
+				{Key: importutil.DBName, Value: coll.DBName},
+			},
+		}
+		if partitionKeyMode {
+			// the hidden partitions can't be imported by name, the rows of a hidden partition are hashed
+			// to the one with the same index since the number of partitions is kept
+			index, err := partitionKeyIndex(partition.Name)
+			if err != nil {
+				return nil, err
+			}
+			req.PartitionName = ""
+			req.Options = append(req.Options, &commonpb.KeyValuePair{Key: importutil.PartitionKeyIndex, Value: strconv.Itoa(index)})
+		}
+		resp, err := c.milvusClient.Import(ctx, req)
+		if err = checkStatus(resp.GetStatus(), err); err != nil {
+			return nil, fmt.Errorf("failed to import partition %s: %w", partition.Name, err)
+		}
+		log.Info("restore partition", zap.String("partition", partition.Name), zap.Int64s("tasks", resp.GetTasks()))
+		tasks = append(tasks, resp.GetTasks()...)
+	}
+
+	if c.wait {
+		if err := c.waitImportTasks(ctx, tasks); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.createIndexes(ctx, coll); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// partitionKeyIndex returns the index of a hidden partition of a partition key collection.
+func partitionKeyIndex(partitionName string) (int, error) {
+	prefix := paramtable.Get().CommonCfg.DefaultPartitionName.GetValue() + "_"
+	index, err := strconv.Atoi(strings.TrimPrefix(partitionName, prefix))
+	if err != nil || !strings.HasPrefix(partitionName, prefix) {
+		return 0, fmt.Errorf("%s is not a partition of partition key collection", partitionName)
+	}
+	return index, nil
+}
+
+// createCollection creates the collection and its partitions in the database of the backup, returns the mapping
+// from the field ids in the backup to the field ids of the new collection. The dynamic field is created by the
+// enable_dynamic_field property, and the hidden partitions of a partition key collection by its number of partitions.
+func (c *backup) createCollection(ctx context.Context, coll *collectionManifest, schema *schemapb.CollectionSchema) (map[int64]int64, error) {
+	properties := make(map[string]string, len(coll.Properties))
+	for k, v := range coll.Properties {
+		properties[k] = v
+	}
+	restoredSchema := &schemapb.CollectionSchema{
+		Name:        c.newName,
+		Description: schema.GetDescription(),
+		AutoID:      schema.GetAutoID(),
+	}
+	userFields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		userFields = append(userFields, field)
+		if typeutil.IsDynamicField(field) {
+			properties[common.EnableDynamicFieldKey] = "true"
+			continue
+		}
+		restoredSchema.Fields = append(restoredSchema.Fields, field)
+	}
+	partitionKeyMode := typeutil.GetPartitionKeyField(schema) != nil
+	if partitionKeyMode {
+		properties[common.PartitionKeyNumPartitionsKey] = strconv.Itoa(len(coll.Partitions))
+	}
+	marshaledSchema, err := proto.Marshal(restoredSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := c.milvusClient.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		DbName:           coll.DBName,
+		CollectionName:   c.newName,
+		Schema:           marshaledSchema,
+		ShardsNum:        coll.ShardsNum,
+		ConsistencyLevel: coll.ConsistencyLevel,
+		Properties:       mapToKvPairs(properties),
+	})
+	if err = checkStatus(status, err); err != nil {
+		return nil, fmt.Errorf("failed to create collection %s: %w", c.newName, err)
+	}
+	describeResp, err := c.milvusClient.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		DbName:         coll.DBName,
+		CollectionName: c.newName,
+	})
+	if err = checkStatus(describeResp.GetStatus(), err); err != nil {
+		return nil, fmt.Errorf("failed to describe collection %s: %w", c.newName, err)
+	}
+	newFieldIDs := make(map[string]int64)
+	for _, field := range describeResp.GetSchema().GetFields() {
+		newFieldIDs[field.GetName()] = field.GetFieldID()
+	}
+	fieldIDMapping := map[int64]int64{
+		common.RowIDField:     common.RowIDField,
+		common.TimeStampField: common.TimeStampField,
+	}
+	for _, field := range userFields {
+		newID, ok := newFieldIDs[field.GetName()]
+		if !ok {
+			return nil, fmt.Errorf("field %s is missing in the restored collection", field.GetName())
+		}
+		fieldIDMapping[field.GetFieldID()] = newID
+	}
+
+	if partitionKeyMode {
+		return fieldIDMapping, nil
+	}
+	for _, partition := range coll.Partitions {
+		hasResp, err := c.milvusClient.HasPartition(ctx, &milvuspb.HasPartitionRequest{
+			DbName:         coll.DBName,
+			CollectionName: c.newName,
+			PartitionName:  partition.Name,
+		})
+		if err = checkStatus(hasResp.GetStatus(), err); err != nil {
+			return nil, err
+		}
+		if hasResp.GetValue() {
+			continue
+		}
+		status, err := c.milvusClient.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			DbName:         coll.DBName,
+			CollectionName: c.newName,
+			PartitionName:  partition.Name,
+		})
+		if err = checkStatus(status, err); err != nil {
+			return nil, fmt.Errorf("failed to create partition %s: %w", partition.Name, err)
+		}
+	}
+	return fieldIDMapping, nil
+}
+
+// copyPartitionBinlogs copies the insert logs and delta logs of a partition to the restore path, the field ids in
+// the insert log paths are replaced by the ones of the new collection. Returns the insert log and delta log root.
+func (c *backup) copyPartitionBinlogs(ctx context.Context, coll *collectionManifest, partition *partitionManifest, fieldIDMapping map[int64]int64) (string, string, error) {
+	insertRoot := path.Join(c.restoreBasePath, common.SegmentInsertLogPath, strconv.FormatInt(coll.ID, 10), strconv.FormatInt(partition.ID, 10))
+	deltaRoot := path.Join(c.restoreBasePath, common.SegmentDeltaLogPath, strconv.FormatInt(coll.ID, 10), strconv.FormatInt(partition.ID, 10))
+	hasDelta := false
+	for _, segment := range partition.Segments {
+		for _, obj := range segment.InsertLogs {
+			fieldID, ok := fieldIDMapping[obj.FieldID]
+			if !ok {
+				return "", "", fmt.Errorf("unknown field %d of insert log %s", obj.FieldID, obj.Path)
+			}
+			dst := path.Join(insertRoot, strconv.FormatInt(segment.ID, 10), strconv.FormatInt(fieldID, 10), path.Base(obj.Path))
+			if err := c.copyObject(ctx, obj.Path, dst); err != nil {
+				return "", "", err
+			}
+		}
+		for _, obj := range segment.DeltaLogs {
+			dst := path.Join(deltaRoot, strconv.FormatInt(segment.ID, 10), path.Base(obj.Path))
+			if err := c.copyObject(ctx, obj.Path, dst); err != nil {
+				return "", "", err
+			}
+			hasDelta = true
+		}
+	}
+	if !hasDelta {
+		deltaRoot = ""
+	}
+	return insertRoot, deltaRoot, nil
+}
+
+func (c *backup) copyObject(ctx context.Context, src, dst string) error {
+	content, err := c.sourceCM.Read(ctx, src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	if err := c.targetCM.Write(ctx, dst, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	return nil
+}
+
+func (c *backup) waitImportTasks(ctx context.Context, tasks []int64) error {
+	pending := tasks
+	for len(pending) > 0 {
+		var next []int64
+		for _, task := range pending {
+			resp, err := c.milvusClient.GetImportState(ctx, &milvuspb.GetImportStateRequest{Task: task})
+			if err = checkStatus(resp.GetStatus(), err); err != nil {
+				return err
+			}
+			switch resp.GetState() {
+			case commonpb.ImportState_ImportCompleted:
+				log.Info("restore import task completed", zap.Int64("task", task), zap.Int64("rows", resp.GetRowCount()))
+			case commonpb.ImportState_ImportFailed, commonpb.ImportState_ImportFailedAndCleaned:
+				return fmt.Errorf("import task %d failed: %v", task, resp.GetInfos())
+			default:
+				next = append(next, task)
+			}
+		}
+		pending = next
+		if len(pending) > 0 {
+			time.Sleep(importCheckInterval)
+		}
+	}
+	return nil
+}
+
+func (c *backup) createIndexes(ctx context.Context, coll *collectionManifest) error {
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(coll.Schema, schema); err != nil {
+		return err
+	}
+	fieldNames := make(map[int64]string)
+	for _, field := range schema.GetFields() {
+		fieldNames[field.GetFieldID()] = field.GetName()
+	}
+	for _, index := range coll.Indexes {
+		status, err := c.milvusClient.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			DbName:         coll.DBName,
+			CollectionName: c.newName,
+			FieldName:      fieldNames[index.FieldID],
+			IndexName:      index.Name,
+			ExtraParams:    mapToKvPairs(index.Params),
+		})
+		if err = checkStatus(status, err); err != nil {
+			return fmt.Errorf("failed to create index %s: %w", index.Name, err)
+		}
+	}
+	return nil
+}
+
+func checkStatus(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
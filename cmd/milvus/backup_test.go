@@ -0,0 +1,503 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvus
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/cmd/tools/migration/backend"
+	"github.com/milvus-io/milvus/internal/common"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvindexcoord "github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	kvrootcoord "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func prepareBackupSnapshot(t *testing.T, rootPath string) (*memkv.MemoryKV, storage.ChunkManager) {
+	ctx := context.Background()
+	snapshot := memkv.NewMemoryKV()
+	ss, err := kvrootcoord.NewSuffixSnapshot(snapshot, kvrootcoord.SnapshotsSep, "", kvrootcoord.SnapshotPrefix)
+	require.NoError(t, err)
+	rootCatalog := &kvrootcoord.Catalog{Txn: snapshot, Snapshot: ss}
+
+	coll := &model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		ShardsNum:    2,
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default", CollectionID: 1, State: pb.PartitionState_PartitionCreated},
+		},
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}},
+		State:      pb.CollectionState_CollectionCreating,
+	}
+	require.NoError(t, rootCatalog.CreateCollection(ctx, coll, 100))
+	created := coll.Clone()
+	created.State = pb.CollectionState_CollectionCreated
+	require.NoError(t, rootCatalog.AlterCollection(ctx, coll, created, metastore.MODIFY, 101))
+
+	insertLog := metautil.BuildInsertLogPath(rootPath, 1, 10, 1000, 100, 1)
+	deltaLog := metautil.BuildDeltaLogPath(rootPath, 1, 10, 1000, 2)
+	dataCatalog := &kvdatacoord.Catalog{Txn: snapshot, ChunkManagerRootPath: rootPath}
+	require.NoError(t, dataCatalog.AddSegment(ctx, &datapb.SegmentInfo{
+		ID:           1000,
+		CollectionID: 1,
+		PartitionID:  10,
+		NumOfRows:    10,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: insertLog, LogID: 1}}}},
+		Deltalogs:    []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: deltaLog, LogID: 2}}}},
+	}))
+	// growing segments are not in the backup
+	require.NoError(t, dataCatalog.AddSegment(ctx, &datapb.SegmentInfo{
+		ID:           1001,
+		CollectionID: 1,
+		PartitionID:  10,
+		State:        commonpb.SegmentState_Growing,
+	}))
+
+	indexCatalog := &kvindexcoord.Catalog{Txn: snapshot}
+	require.NoError(t, indexCatalog.CreateIndex(ctx, &model.Index{
+		CollectionID: 1,
+		FieldID:      101,
+		IndexID:      5,
+		IndexName:    "vec_index",
+		IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}))
+	require.NoError(t, indexCatalog.CreateSegmentIndex(ctx, &model.SegmentIndex{
+		SegmentID:     1000,
+		CollectionID:  1,
+		PartitionID:   10,
+		IndexID:       5,
+		BuildID:       6,
+		IndexVersion:  1,
+		IndexState:    commonpb.IndexState_Finished,
+		IndexFileKeys: []string{"IVF"},
+	}))
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+	require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{
+		insertLog: []byte("insert"),
+		deltaLog:  []byte("delta"),
+		metautil.BuildSegmentIndexFilePath(rootPath, 6, 1, 10, 1000, "IVF"): []byte("index"),
+	}))
+	return snapshot, cm
+}
+
+func TestBuildBackupManifest(t *testing.T) {
+	rootPath := t.TempDir()
+	snapshot, cm := prepareBackupSnapshot(t, rootPath)
+
+	manifest, err := buildBackupManifest(context.Background(), snapshot, cm, rootPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(manifest.Collections))
+	coll := manifest.Collections[0]
+	assert.Equal(t, "coll", coll.Name)
+	assert.Equal(t, util.DefaultDBName, coll.DBName)
+	assert.Equal(t, int32(2), coll.ShardsNum)
+	assert.Equal(t, map[string]string{common.CollectionTTLConfigKey: "3600"}, coll.Properties)
+	assert.Equal(t, 1, len(coll.Indexes))
+	assert.Equal(t, "IVF_FLAT", coll.Indexes[0].Params["index_type"])
+
+	require.Equal(t, 1, len(coll.Partitions))
+	require.Equal(t, 1, len(coll.Partitions[0].Segments))
+	segment := coll.Partitions[0].Segments[0]
+	assert.Equal(t, int64(1000), segment.ID)
+	require.Equal(t, 1, len(segment.InsertLogs))
+	assert.Equal(t, int64(100), segment.InsertLogs[0].FieldID)
+	assert.Equal(t, int64(len("insert")), segment.InsertLogs[0].Size)
+	assert.Equal(t, 1, len(segment.DeltaLogs))
+	assert.Equal(t, 1, len(segment.IndexFiles))
+
+	// referenced objects must exist
+	require.NoError(t, cm.Remove(context.Background(), segment.IndexFiles[0].Path))
+	_, err = buildBackupManifest(context.Background(), snapshot, cm, rootPath)
+	assert.Error(t, err)
+}
+
+func TestReadBackupManifest(t *testing.T) {
+	manifest := &backupManifest{
+		Revision:    10,
+		Collections: []*collectionManifest{{ID: 1, Name: "coll"}},
+	}
+	extra, err := json.Marshal(&backupExtra{Manifest: manifest})
+	require.NoError(t, err)
+	file, err := backend.NewBackupCodec().Serialize(&backend.BackupHeader{
+		Version:   backend.BackupHeaderVersionV1,
+		Component: backupComponent,
+		Extra:     extra,
+	}, map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	backupPath := path.Join(t.TempDir(), "backup")
+	require.NoError(t, os.WriteFile(backupPath, file, 0600))
+	got, err := readBackupManifest(backupPath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), got.Revision)
+
+	coll, err := got.getCollection("coll")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), coll.ID)
+	_, err = got.getCollection("not_exist")
+	assert.Error(t, err)
+
+	_, err = readBackupManifest(path.Join(t.TempDir(), "not_exist"))
+	assert.Error(t, err)
+}
+
+// restoreTestClient runs the binlog import of the restore in place, counting the imported rows.
+type restoreTestClient struct {
+	milvuspb.MilvusServiceClient
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+	rows   int
+}
+
+func (c *restoreTestClient) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	c.schema = &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), c.schema); err != nil {
+		return nil, err
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *restoreTestClient) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+	return &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema: c.schema,
+	}, nil
+}
+
+func (c *restoreTestClient) HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	return &milvuspb.BoolResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Value:  true,
+	}, nil
+}
+
+func (c *restoreTestClient) Import(ctx context.Context, req *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		c.rows += fields[100].RowNum()
+		return nil
+	}
+	parser, err := importutil.NewBinlogParser(ctx, c.schema, 2, 16*1024*1024, c.cm, flushFunc, tsStart, tsEnd)
+	if err != nil {
+		return nil, err
+	}
+	if err := parser.Parse(req.GetFiles()); err != nil {
+		return nil, err
+	}
+	return &milvuspb.ImportResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Tasks:  []int64{1},
+	}, nil
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	server, dir, err := etcd.StartTestEmbedEtcdServer()
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer server.Close()
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: etcd.GetEmbedEtcdEndpoints(server)})
+	require.NoError(t, err)
+	defer etcdCli.Close()
+
+	const (
+		metaRootPath = "by-dev/meta"
+		kvRootPath   = "by-dev/kv"
+		numRows      = 10
+		numDeleted   = 3
+	)
+	chunkRootPath := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(chunkRootPath))
+
+	txn := etcdkv.NewEtcdKV(etcdCli, metaRootPath)
+	ss, err := kvrootcoord.NewSuffixSnapshot(txn, kvrootcoord.SnapshotsSep, metaRootPath, kvrootcoord.SnapshotPrefix)
+	require.NoError(t, err)
+	rootCatalog := &kvrootcoord.Catalog{Txn: txn, Snapshot: ss}
+	coll := &model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		ShardsNum:    2,
+		Fields: []*model.Field{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default", CollectionID: 1, State: pb.PartitionState_PartitionCreated},
+		},
+		State: pb.CollectionState_CollectionCreated,
+	}
+	require.NoError(t, rootCatalog.CreateCollection(ctx, coll, 100))
+
+	// the rows and the deletions are written with timestamps allocated before the saved one
+	now := time.Now()
+	_, err = etcdCli.Put(ctx, path.Join(kvRootPath, tsoTimestampKey),
+		string(typeutil.Uint64ToBytesBigEndian(uint64(now.Add(3*time.Second).UnixNano()))))
+	require.NoError(t, err)
+
+	rowIDs, tss, pks := &storage.Int64FieldData{}, &storage.Int64FieldData{}, &storage.Int64FieldData{}
+	vectors := &storage.FloatVectorFieldData{Dim: 4}
+	deleteData := &storage.DeleteData{}
+	for i := 0; i < numRows; i++ {
+		rowIDs.Data = append(rowIDs.Data, int64(i))
+		tss.Data = append(tss.Data, int64(tsoutil.ComposeTSByTime(now, int64(i))))
+		pks.Data = append(pks.Data, int64(i))
+		vectors.Data = append(vectors.Data, float32(i), float32(i), float32(i), float32(i))
+		if i < numDeleted {
+			deleteData.Append(storage.NewInt64PrimaryKey(int64(i)), tsoutil.ComposeTSByTime(now, int64(numRows+i)))
+		}
+	}
+	insertData := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.RowIDField:     rowIDs,
+		common.TimeStampField: tss,
+		100:                   pks,
+		101:                   vectors,
+	}}
+	insertCodec := storage.NewInsertCodec(&pb.CollectionMeta{ID: 1, Schema: model.MarshalCollectionModel(coll).GetSchema()})
+	blobs, _, err := insertCodec.Serialize(10, 1000, insertData)
+	require.NoError(t, err)
+	logs := make(map[string][]byte)
+	var binlogs []*datapb.FieldBinlog
+	for i, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		require.NoError(t, err)
+		logID := int64(i + 1)
+		logPath := metautil.BuildInsertLogPath(chunkRootPath, 1, 10, 1000, fieldID, logID)
+		logs[logPath] = blob.Value
+		binlogs = append(binlogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: logPath, LogID: logID}}})
+	}
+	deltaBlob, err := storage.NewDeleteCodec().Serialize(1, 10, 1000, deleteData)
+	require.NoError(t, err)
+	deltaLog := metautil.BuildDeltaLogPath(chunkRootPath, 1, 10, 1000, 100)
+	logs[deltaLog] = deltaBlob.Value
+	require.NoError(t, cm.MultiWrite(ctx, logs))
+
+	dataCatalog := &kvdatacoord.Catalog{Txn: txn, ChunkManagerRootPath: chunkRootPath}
+	require.NoError(t, dataCatalog.AddSegment(ctx, &datapb.SegmentInfo{
+		ID:           1000,
+		CollectionID: 1,
+		PartitionID:  10,
+		NumOfRows:    numRows,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      binlogs,
+		Deltalogs:    []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: deltaLog, LogID: 100}}}},
+	}))
+
+	c := &backup{sourceCM: cm, targetCM: cm}
+	manifest, kvs, err := c.snapshotMetadata(ctx, etcdCli, metaRootPath, kvRootPath, chunkRootPath)
+	require.NoError(t, err)
+	assert.Equal(t, tsoutil.ComposeTSByTime(now.Add(3*time.Second), 0), manifest.SnapshotTs)
+	backupFile := path.Join(t.TempDir(), "backup")
+	require.NoError(t, writeBackupFile(backupFile, metaRootPath, manifest, kvs))
+
+	manifest, err = readBackupManifest(backupFile)
+	require.NoError(t, err)
+	restored, err := manifest.getCollection("coll")
+	require.NoError(t, err)
+	client := &restoreTestClient{cm: cm}
+	c.milvusClient = client
+	c.newName = "coll_restored"
+	tasks, err := c.restoreCollection(ctx, manifest, restored, chunkRootPath)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, tasks)
+	assert.Equal(t, numRows-numDeleted, client.rows)
+
+	// the tso key is required to take the snapshot
+	_, err = etcdCli.Delete(ctx, path.Join(kvRootPath, tsoTimestampKey))
+	require.NoError(t, err)
+	_, _, err = c.snapshotMetadata(ctx, etcdCli, metaRootPath, kvRootPath, chunkRootPath)
+	assert.Error(t, err)
+}
+
+// createCollectionTestClient checks the requests of the restore as the proxy does.
+type createCollectionTestClient struct {
+	milvuspb.MilvusServiceClient
+	createReq  *milvuspb.CreateCollectionRequest
+	schema     *schemapb.CollectionSchema
+	importReqs []*milvuspb.ImportRequest
+}
+
+func (c *createCollectionTestClient) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	c.createReq = req
+	c.schema = &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), c.schema); err != nil {
+		return nil, err
+	}
+	for _, field := range c.schema.GetFields() {
+		if strings.HasPrefix(field.GetName(), "$") {
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_IllegalArgument, Reason: "invalid field name " + field.GetName()}, nil
+		}
+	}
+	// the dynamic field is appended by the proxy
+	if kvPairsToMap(req.GetProperties())[common.EnableDynamicFieldKey] == "true" {
+		c.schema.Fields = append(c.schema.Fields, &schemapb.FieldSchema{
+			FieldID:  common.StartOfUserFieldID + int64(len(c.schema.Fields)),
+			Name:     common.MetaFieldName,
+			DataType: typeutil.DataTypeJSON,
+		})
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *createCollectionTestClient) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+	return &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema: c.schema,
+	}, nil
+}
+
+func (c *createCollectionTestClient) HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	return &milvuspb.BoolResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "partition key mode"},
+	}, nil
+}
+
+func (c *createCollectionTestClient) Import(ctx context.Context, req *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	if err := importutil.ValidateOptions(req.GetOptions()); err != nil {
+		return nil, err
+	}
+	c.importReqs = append(c.importReqs, req)
+	return &milvuspb.ImportResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Tasks:  []int64{int64(len(c.importReqs))},
+	}, nil
+}
+
+func TestRestorePartitionKeyCollection(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	schema, err := proto.Marshal(&schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}}},
+			{FieldID: 102, Name: common.MetaFieldName, DataType: typeutil.DataTypeJSON},
+		},
+	})
+	require.NoError(t, err)
+	segment := func(id int64) *segmentManifest {
+		return &segmentManifest{ID: id, InsertLogs: []*objectManifest{
+			{Path: "100", FieldID: 100}, {Path: "101", FieldID: 101}, {Path: "102", FieldID: 102},
+		}}
+	}
+	coll := &collectionManifest{
+		ID:         1,
+		DBID:       2,
+		DBName:     "db1",
+		Name:       "coll",
+		Schema:     schema,
+		ShardsNum:  2,
+		Properties: map[string]string{common.CollectionTTLConfigKey: "3600", common.EnableDynamicFieldKey: "true"},
+		Partitions: []*partitionManifest{
+			{ID: 10, Name: "_default_0", Segments: []*segmentManifest{segment(1000)}},
+			{ID: 11, Name: "_default_1"},
+			{ID: 12, Name: "_default_2", Segments: []*segmentManifest{segment(1001)}},
+		},
+	}
+
+	rootPath := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+	require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{"100": []byte("100"), "101": []byte("101"), "102": []byte("102")}))
+	client := &createCollectionTestClient{}
+	c := &backup{sourceCM: cm, targetCM: cm, milvusClient: client, newName: "coll_restored"}
+	tasks, err := c.restoreCollection(ctx, &backupManifest{SnapshotTs: tsoutil.ComposeTSByTime(time.Now(), 0)}, coll, rootPath)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, tasks)
+
+	// the properties and the database are restored, the dynamic field is created by the property
+	assert.Equal(t, "db1", client.createReq.GetDbName())
+	assert.Equal(t, map[string]string{
+		common.CollectionTTLConfigKey:       "3600",
+		common.EnableDynamicFieldKey:        "true",
+		common.PartitionKeyNumPartitionsKey: "3",
+	}, kvPairsToMap(client.createReq.GetProperties()))
+
+	// the hidden partitions are imported by index, the dynamic field binlogs go to the new dynamic field
+	require.Equal(t, 2, len(client.importReqs))
+	for i, index := range []string{"0", "2"} {
+		req := client.importReqs[i]
+		assert.Equal(t, "", req.GetPartitionName())
+		options := kvPairsToMap(req.GetOptions())
+		assert.Equal(t, index, options[importutil.PartitionKeyIndex])
+		assert.Equal(t, "db1", options[importutil.DBName])
+	}
+	for _, fieldID := range []int64{100, 101, 102} {
+		exist, err := cm.Exist(ctx, path.Join(c.restoreBasePath, common.SegmentInsertLogPath, "1", "10", "1000",
+			strconv.FormatInt(fieldID, 10), strconv.FormatInt(fieldID, 10)))
+		require.NoError(t, err)
+		assert.True(t, exist)
+	}
+}
+
+func TestRestoreEndTs(t *testing.T) {
+	now := time.Now()
+	// the end ts is in milliseconds
+	ts := tsoutil.ComposeTSByTime(now, 10)
+	endTs, err := strconv.ParseInt(restoreEndTs(&backupManifest{SnapshotTs: ts}), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, now.UnixMilli(), endTs)
+
+	// backups without snapshot ts cover everything written up to the create time
+	endTs, err = strconv.ParseInt(restoreEndTs(&backupManifest{CreateTime: now.Unix()}), 10, 64)
+	require.NoError(t, err)
+	assert.Greater(t, tsoutil.ComposeTS(endTs, 0), tsoutil.ComposeTSByTime(now, 0))
+}
@@ -9,7 +9,7 @@ import (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, backupLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -62,5 +62,50 @@ milvus mck run [flags]
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	backupLine = `
+milvus backup create [flags]
+	Snapshot the metadata of all coordinators at one etcd revision, with a manifest of the
+	binlog and index objects referenced by the flushed segments.
+[flags]
+	-output ''
+		The backup file to write.
+	-revision '0'
+		The etcd revision to snapshot, 0 means the latest.
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-etcdRootPath ''
+		The root path of operating the etcd data.
+	-minioAddress ''
+		Address to connect the minio server.
+	-minioUsername ''
+		The username to login the minio server.
+	-minioPassword ''
+		The password to login the minio server.
+	-minioUseSSL 'false'
+		Whether to use the ssl to connect the minio server.
+	-minioBucketName ''
+		The bucket to operate the data in it.
+	-minioRootPath ''
+		The root path of the objects in the bucket.
+
+milvus backup restore [flags]
+	Rebuild a collection of the backup under a new name in the default database, by importing
+	its binlogs through the milvus proxy.
+[flags]
+	-input ''
+		The backup file to restore from.
+	-collection ''
+		The collection to restore.
+	-newName ''
+		The name of the restored collection, default to the original name.
+	-milvusAddress 'localhost:19530'
+		Address of the milvus proxy to restore into.
+	-wait 'true'
+		Whether to wait for the import tasks to finish before creating the indexes.
+	-targetMinioAddress, -targetMinioUsername, -targetMinioPassword, -targetMinioUseSSL,
+	-targetMinioBucketName, -targetMinioRootPath
+		The storage of the restore target cluster, default to the source minio flags.
+	Tips: The source minio flags are the same as its of the 'milvus backup create [flags]'
 `
 )
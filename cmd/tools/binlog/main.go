@@ -17,19 +17,139 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+var (
+	schemaFile = flag.String("schema", "", "collection schema json file, export the segment instead of printing binlog files if set")
+	insertLogs = flag.String("insert", "", "comma separated insert binlog paths of the segment")
+	deltaLogs  = flag.String("delta", "", "comma separated delta binlog paths of the segment")
+	statsLogs  = flag.String("stats", "", "comma separated stats binlog paths of the segment")
+	format     = flag.String("format", "csv", "export format, csv or parquet")
+	output     = flag.String("output", "", "export file, csv is written to stdout if not set")
+
+	storageType     = flag.String("storage", "local", "storage of the binlogs, local or minio")
+	minioAddress    = flag.String("minio.address", "localhost:9000", "minio address")
+	minioBucket     = flag.String("minio.bucket", "a-bucket", "minio bucket name")
+	minioAccessKey  = flag.String("minio.accessKeyID", "minioadmin", "minio access key id")
+	minioSecretKey  = flag.String("minio.secretAccessKey", "minioadmin", "minio secret access key")
+	minioUseSSL     = flag.Bool("minio.useSSL", false, "access minio with ssl")
+	storageRootPath = flag.String("rootPath", "", "root path of the binlogs")
+)
+
 func main() {
-	if len(os.Args) == 1 {
+	flag.Usage = func() {
 		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Println("       binlog -schema schema.json -insert log1,log2 [-delta log3] [-stats log4] [-format csv|parquet] [-output file]")
+		flag.PrintDefaults()
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
+	flag.Parse()
+
+	if *schemaFile == "" {
+		if flag.NArg() == 0 {
+			flag.Usage()
+		}
+		if err := storage.PrintBinlogFiles(flag.Args()); err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+		} else {
+			fmt.Printf("print binlog complete.\n")
+		}
+		return
+	}
+
+	if err := exportSegment(context.Background()); err != nil {
 		fmt.Printf("error: %s\n", err.Error())
-	} else {
-		fmt.Printf("print binlog complete.\n")
+		os.Exit(1)
+	}
+	if *output != "" {
+		fmt.Printf("export segment to %s complete.\n", *output)
+	}
+}
+
+func exportSegment(ctx context.Context) error {
+	schema, err := readSchema(*schemaFile)
+	if err != nil {
+		return err
+	}
+	cm, err := newChunkManager(ctx)
+	if err != nil {
+		return err
+	}
+	sd, err := storage.ReadSegmentData(ctx, cm, schema, &storage.SegmentBinlogPaths{
+		InsertLogs: splitPaths(*insertLogs),
+		DeltaLogs:  splitPaths(*deltaLogs),
+		StatsLogs:  splitPaths(*statsLogs),
+	})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch strings.ToLower(*format) {
+	case "csv":
+		return storage.WriteSegmentDataCSV(w, sd)
+	case "parquet":
+		if *output == "" {
+			return fmt.Errorf("output file is required by parquet format")
+		}
+		return storage.WriteSegmentDataParquet(w, sd)
+	default:
+		return fmt.Errorf("unknown export format %s", *format)
+	}
+}
+
+func readSchema(file string) (*schemapb.CollectionSchema, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	schema := &schemapb.CollectionSchema{}
+	if err := jsonpb.Unmarshal(f, schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema file %s: %w", file, err)
+	}
+	return schema, nil
+}
+
+func newChunkManager(ctx context.Context) (storage.ChunkManager, error) {
+	switch *storageType {
+	case "local":
+		return storage.NewLocalChunkManager(storage.RootPath(*storageRootPath)), nil
+	case "minio":
+		return storage.NewMinioChunkManager(ctx,
+			storage.Address(*minioAddress),
+			storage.BucketName(*minioBucket),
+			storage.AccessKeyID(*minioAccessKey),
+			storage.SecretAccessKeyID(*minioSecretKey),
+			storage.UseSSL(*minioUseSSL),
+			storage.RootPath(*storageRootPath))
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", *storageType)
+	}
+}
+
+func splitPaths(paths string) []string {
+	if paths == "" {
+		return nil
 	}
+	return strings.Split(paths, ",")
 }
@@ -41,6 +41,9 @@ const (
 	// TimeStampFieldName defines the name of the Timestamp field
 	TimeStampFieldName = "Timestamp"
 
+	// MetaFieldName is the name of the hidden JSON field which keeps the undeclared fields of a dynamic schema
+	MetaFieldName = "$meta"
+
 	// DefaultShardsNum defines the default number of shards when creating a collection
 	DefaultShardsNum = int32(2)
 
@@ -80,12 +83,57 @@ const (
 	IndexTypeKey   = "index_type"
 	MetricTypeKey  = "metric_type"
 	DimKey         = "dim"
+
+	// ElementTypeKey is the type param holding the element type of an array field
+	ElementTypeKey = "element_type"
+
+	// PartitionKeyKey is the type param marking a scalar field as the partition key of the collection
+	PartitionKeyKey = "is_partition_key"
+
+	// ClusteringKeyKey is the type param marking a scalar field as the clustering key of the collection
+	ClusteringKeyKey = "is_clustering_key"
+
+	// FieldStatsKey is the type param enabling the min/max stats of a scalar field, which helps to skip
+	// the segments on range filters
+	FieldStatsKey = "enable_stats"
+
+	// BloomFilterKey is the type param enabling the bloom filter stats of a scalar field, which helps to skip
+	// the segments on equality filters, the min/max stats are enabled as well
+	BloomFilterKey = "enable_bloom_filter"
+
+	// GroupByFieldKey and GroupSizeKey are search params to group search results by a scalar field
+	GroupByFieldKey = "group_by_field"
+	GroupSizeKey    = "group_size"
+
+	// QueryIteratorCursorHeader is the response header carrying the cursor of the next page of query iterator
+	QueryIteratorCursorHeader = "iterator-cursor"
 )
 
 //  Collection properties key
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+
+	// collection level quotas, they override the per collection quotas of the configuration
+	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
+	CollectionDeleteRateMaxKey   = "collection.deleteRate.max.mb"
+	CollectionBulkLoadRateMaxKey = "collection.bulkLoadRate.max.mb"
+	CollectionSearchRateMaxKey   = "collection.searchRate.max.vps"
+	CollectionQueryRateMaxKey    = "collection.queryRate.max.qps"
+	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
+
+	// partition level quotas set in collection properties, they apply to each partition of the collection
+	// and override the per partition quotas of the configuration
+	PartitionInsertRateMaxKey   = "partition.insertRate.max.mb"
+	PartitionDeleteRateMaxKey   = "partition.deleteRate.max.mb"
+	PartitionBulkLoadRateMaxKey = "partition.bulkLoadRate.max.mb"
+	PartitionDiskQuotaKey       = "partition.diskProtection.diskQuota.mb"
+
+	// EnableDynamicFieldKey enables the dynamic schema when creating a collection
+	EnableDynamicFieldKey = "enable_dynamic_field"
+
+	// PartitionKeyNumPartitionsKey is the number of hidden partitions created for a partition key collection
+	PartitionKeyNumPartitionsKey = "partition_key.num_partitions"
 )
 
 const (
@@ -25,6 +25,13 @@ const milvus::PkType INVALID_PK;  // of std::monostate if not set.
 // TODO: default field start id, could get from config.yaml
 const int64_t START_USER_FIELDID = 100;
 const char MAX_LENGTH[] = "max_length";
+const char ELEMENT_TYPE[] = "element_type";
+// bounds of a range search in search params
+const char RADIUS[] = "radius";
+const char RANGE_FILTER[] = "range_filter";
+
+// estimated size of a JSON or ARRAY row, used for chunk memory estimation only
+const int64_t VARIABLE_FIELD_ESTIMATED_SIZE = 256;
 
 // const fieldID (rowID and timestamp)
 const milvus::FieldId RowFieldID = milvus::FieldId(0);
@@ -20,6 +20,7 @@
 #include <stdexcept>
 #include <string>
 
+#include "common/Consts.h"
 #include "common/Types.h"
 #include "exceptions/EasyAssert.h"
 #include "utils/Status.h"
@@ -75,6 +76,10 @@ datatype_name(DataType data_type) {
             return "double";
         case DataType::VARCHAR:
             return "varChar";
+        case DataType::ARRAY:
+            return "array";
+        case DataType::JSON:
+            return "json";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
         case DataType::VECTOR_BINARY: {
@@ -103,6 +108,22 @@ datatype_is_string(DataType datatype) {
     }
 }
 
+inline bool
+datatype_is_json(DataType datatype) {
+    return datatype == DataType::JSON;
+}
+
+inline bool
+datatype_is_array(DataType datatype) {
+    return datatype == DataType::ARRAY;
+}
+
+// variable length types are stored as std::string in segcore, one serialized row per element
+inline bool
+datatype_is_variable(DataType datatype) {
+    return datatype_is_string(datatype) || datatype_is_json(datatype) || datatype_is_array(datatype);
+}
+
 inline bool
 datatype_is_integer(DataType datatype) {
     switch (datatype) {
@@ -139,6 +160,7 @@ class FieldMeta {
 
     FieldMeta(const FieldName& name, FieldId id, DataType type) : name_(name), id_(id), type_(type) {
         Assert(!is_vector());
+        Assert(!is_array());
     }
 
     FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t max_length)
@@ -146,6 +168,13 @@ class FieldMeta {
         Assert(is_string());
     }
 
+    FieldMeta(const FieldName& name, FieldId id, DataType type, DataType element_type)
+        : name_(name), id_(id), type_(type), array_info_(ArrayInfo{element_type}) {
+        Assert(is_array());
+        Assert(!datatype_is_vector(element_type) && !datatype_is_array(element_type) &&
+               !datatype_is_json(element_type));
+    }
+
     FieldMeta(
         const FieldName& name, FieldId id, DataType type, int64_t dim, std::optional<knowhere::MetricType> metric_type)
         : name_(name), id_(id), type_(type), vector_info_(VectorInfo{dim, metric_type}) {
@@ -164,6 +193,18 @@ class FieldMeta {
         return type_ == DataType::VARCHAR || type_ == DataType::STRING;
     }
 
+    bool
+    is_json() const {
+        Assert(type_ != DataType::NONE);
+        return type_ == DataType::JSON;
+    }
+
+    bool
+    is_array() const {
+        Assert(type_ != DataType::NONE);
+        return type_ == DataType::ARRAY;
+    }
+
     int64_t
     get_dim() const {
         Assert(is_vector());
@@ -178,6 +219,13 @@ class FieldMeta {
         return string_info_->max_length;
     }
 
+    DataType
+    get_element_type() const {
+        Assert(is_array());
+        Assert(array_info_.has_value());
+        return array_info_->element_type_;
+    }
+
     std::optional<knowhere::MetricType>
     get_metric_type() const {
         Assert(is_vector());
@@ -206,6 +254,9 @@ class FieldMeta {
             return datatype_sizeof(type_, get_dim());
         } else if (is_string()) {
             return string_info_->max_length;
+        } else if (is_json() || is_array()) {
+            // variable length, only used to estimate the memory of a chunk
+            return VARIABLE_FIELD_ESTIMATED_SIZE;
         } else {
             return datatype_sizeof(type_);
         }
@@ -219,11 +270,15 @@ class FieldMeta {
     struct StringInfo {
         int64_t max_length;
     };
+    struct ArrayInfo {
+        DataType element_type_;
+    };
     FieldName name_;
     FieldId id_;
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
     std::optional<StringInfo> string_info_;
+    std::optional<ArrayInfo> array_info_;
 };
 
 }  // namespace milvus
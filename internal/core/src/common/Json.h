@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <limits>
+#include <optional>
+#include <string>
+#include <type_traits>
+#include <vector>
+
+#include "nlohmann/json.hpp"
+
+namespace milvus {
+
+// JsonPath locates a value inside the JSON documents of a JSON field, one key per nesting level.
+class JsonPath {
+ public:
+    JsonPath() = default;
+
+    explicit JsonPath(const std::vector<std::string>& nested_path) {
+        std::string pointer;
+        for (auto& key : nested_path) {
+            pointer.push_back('/');
+            // escape as RFC 6901 requires, '~' must be escaped first
+            for (auto c : key) {
+                if (c == '~') {
+                    pointer.append("~0");
+                } else if (c == '/') {
+                    pointer.append("~1");
+                } else {
+                    pointer.push_back(c);
+                }
+            }
+        }
+        pointer_ = nlohmann::json::json_pointer(pointer);
+    }
+
+    // Find returns the value at the path, nullopt if the document is not a valid JSON
+    // or the path doesn't exist in it.
+    std::optional<nlohmann::json>
+    Find(const std::string& doc) const {
+        auto root = nlohmann::json::parse(doc, nullptr, false);
+        if (root.is_discarded() || !root.contains(pointer_)) {
+            return std::nullopt;
+        }
+        return root.at(pointer_);
+    }
+
+ private:
+    nlohmann::json::json_pointer pointer_;
+};
+
+template <typename T>
+inline int
+ThreeWayCompare(const T& a, const T& b) {
+    return a < b ? -1 : (b < a ? 1 : 0);
+}
+
+// CompareJson orders a JSON value against val, the result is negative, zero or positive like strcmp.
+// Integers and floats are compared numerically, nullopt is returned if the value is of another type than val,
+// such rows never match a filter.
+template <typename T>
+inline std::optional<int>
+CompareJson(const nlohmann::json& value, const T& val) {
+    if constexpr (std::is_same_v<T, bool>) {
+        if (!value.is_boolean()) {
+            return std::nullopt;
+        }
+        return ThreeWayCompare(value.get<bool>(), val);
+    } else if constexpr (std::is_integral_v<T>) {
+        if (value.is_number_unsigned()) {
+            // non-negative integers are parsed as unsigned, those beyond int64 are greater than any val
+            auto x = value.get<uint64_t>();
+            if (x > static_cast<uint64_t>(std::numeric_limits<int64_t>::max())) {
+                return 1;
+            }
+            return ThreeWayCompare(static_cast<int64_t>(x), static_cast<int64_t>(val));
+        }
+        if (value.is_number_integer()) {
+            return ThreeWayCompare(value.get<int64_t>(), static_cast<int64_t>(val));
+        }
+        if (value.is_number()) {
+            return ThreeWayCompare(value.get<double>(), static_cast<double>(val));
+        }
+        return std::nullopt;
+    } else if constexpr (std::is_floating_point_v<T>) {
+        if (!value.is_number()) {
+            return std::nullopt;
+        }
+        return ThreeWayCompare(value.get<double>(), static_cast<double>(val));
+    } else if constexpr (std::is_same_v<T, std::string>) {
+        if (!value.is_string()) {
+            return std::nullopt;
+        }
+        return value.get_ref<const std::string&>().compare(val);
+    } else {
+        static_assert(!std::is_same_v<T, T>, "unsupported type to compare with JSON value");
+    }
+}
+
+}  // namespace milvus
@@ -17,6 +17,7 @@
 #pragma once
 
 #include <memory>
+#include <optional>
 
 #include "common/Types.h"
 
@@ -27,6 +28,10 @@ struct SearchInfo {
     FieldId field_id_;
     MetricType metric_type_;
     Config search_params_;
+    // set for a range search, which returns the hits in the range, topk_ is then the max number of hits per query.
+    // the hits are within (radius, range_filter] for IP, and [range_filter, radius) for other metrics.
+    std::optional<float> radius_;
+    std::optional<float> range_filter_;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
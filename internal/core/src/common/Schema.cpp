@@ -73,6 +73,13 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             AssertInfo(type_map.count(MAX_LENGTH), "max_length not found");
             auto max_len = boost::lexical_cast<int64_t>(type_map.at(MAX_LENGTH));
             schema->AddField(name, field_id, data_type, max_len);
+        } else if (datatype_is_array(data_type)) {
+            auto type_map = RepeatedKeyValToMap(child.type_params());
+            AssertInfo(type_map.count(ELEMENT_TYPE), "element_type not found");
+            proto::schema::DataType element_type;
+            auto ok = proto::schema::DataType_Parse(type_map.at(ELEMENT_TYPE), &element_type);
+            AssertInfo(ok, "invalid element_type: " + type_map.at(ELEMENT_TYPE));
+            schema->AddField(name, field_id, data_type, DataType(element_type));
         } else {
             schema->AddField(name, field_id, data_type);
         }
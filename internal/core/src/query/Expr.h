@@ -88,15 +88,24 @@ struct LogicalBinaryExpr : BinaryExprBase {
     accept(ExprVisitor&) override;
 };
 
+using ValCase = proto::plan::GenericValue::ValCase;
+
 struct TermExpr : Expr {
     const FieldId field_id_;
     const DataType data_type_;
+    // path inside the documents and type of the values, only set on JSON fields
+    const std::vector<std::string> nested_path_;
+    const ValCase val_case_;
 
  protected:
     // prevent accidential instantiation
     TermExpr() = delete;
 
-    TermExpr(const FieldId field_id, const DataType data_type) : field_id_(field_id), data_type_(data_type) {
+    TermExpr(const FieldId field_id,
+             const DataType data_type,
+             const std::vector<std::string>& nested_path = {},
+             const ValCase val_case = ValCase::VAL_NOT_SET)
+        : field_id_(field_id), data_type_(data_type), nested_path_(nested_path), val_case_(val_case) {
     }
 
  public:
@@ -149,13 +158,24 @@ struct UnaryRangeExpr : Expr {
     const FieldId field_id_;
     const DataType data_type_;
     const OpType op_type_;
+    // path inside the documents and type of the value, only set on JSON fields
+    const std::vector<std::string> nested_path_;
+    const ValCase val_case_;
 
  protected:
     // prevent accidential instantiation
     UnaryRangeExpr() = delete;
 
-    UnaryRangeExpr(const FieldId field_id, const DataType data_type, const OpType op_type)
-        : field_id_(field_id), data_type_(data_type), op_type_(op_type) {
+    UnaryRangeExpr(const FieldId field_id,
+                   const DataType data_type,
+                   const OpType op_type,
+                   const std::vector<std::string>& nested_path = {},
+                   const ValCase val_case = ValCase::VAL_NOT_SET)
+        : field_id_(field_id),
+          data_type_(data_type),
+          op_type_(op_type),
+          nested_path_(nested_path),
+          val_case_(val_case) {
     }
 
  public:
@@ -168,6 +188,9 @@ struct BinaryRangeExpr : Expr {
     const DataType data_type_;
     const bool lower_inclusive_;
     const bool upper_inclusive_;
+    // path inside the documents and type of the bounds, only set on JSON fields
+    const std::vector<std::string> nested_path_;
+    const ValCase val_case_;
 
  protected:
     // prevent accidential instantiation
@@ -176,11 +199,36 @@ struct BinaryRangeExpr : Expr {
     BinaryRangeExpr(const FieldId field_id,
                     const DataType data_type,
                     const bool lower_inclusive,
-                    const bool upper_inclusive)
+                    const bool upper_inclusive,
+                    const std::vector<std::string>& nested_path = {},
+                    const ValCase val_case = ValCase::VAL_NOT_SET)
         : field_id_(field_id),
           data_type_(data_type),
           lower_inclusive_(lower_inclusive),
-          upper_inclusive_(upper_inclusive) {
+          upper_inclusive_(upper_inclusive),
+          nested_path_(nested_path),
+          val_case_(val_case) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+using ArrayOp = proto::plan::ArrayContainsExpr_ArrayOp;
+
+struct ArrayContainsExpr : Expr {
+    const FieldId field_id_;
+    const DataType data_type_;
+    const DataType element_type_;
+    const ArrayOp op_;
+
+ protected:
+    // prevent accidential instantiation
+    ArrayContainsExpr() = delete;
+
+    ArrayContainsExpr(const FieldId field_id, const DataType data_type, const DataType element_type, const ArrayOp op)
+        : field_id_(field_id), data_type_(data_type), element_type_(element_type), op_(op) {
     }
 
  public:
@@ -28,8 +28,12 @@ template <typename T>
 struct TermExprImpl : TermExpr {
     const std::vector<T> terms_;
 
-    TermExprImpl(const FieldId field_id, const DataType data_type, const std::vector<T>& terms)
-        : TermExpr(field_id, data_type), terms_(terms) {
+    TermExprImpl(const FieldId field_id,
+                 const DataType data_type,
+                 const std::vector<T>& terms,
+                 const std::vector<std::string>& nested_path = {},
+                 const ValCase val_case = ValCase::VAL_NOT_SET)
+        : TermExpr(field_id, data_type, nested_path, val_case), terms_(terms) {
     }
 };
 
@@ -54,8 +58,13 @@ template <typename T>
 struct UnaryRangeExprImpl : UnaryRangeExpr {
     const T value_;
 
-    UnaryRangeExprImpl(const FieldId field_id, const DataType data_type, const OpType op_type, const T value)
-        : UnaryRangeExpr(field_id, data_type, op_type), value_(value) {
+    UnaryRangeExprImpl(const FieldId field_id,
+                       const DataType data_type,
+                       const OpType op_type,
+                       const T value,
+                       const std::vector<std::string>& nested_path = {},
+                       const ValCase val_case = ValCase::VAL_NOT_SET)
+        : UnaryRangeExpr(field_id, data_type, op_type, nested_path, val_case), value_(value) {
     }
 };
 
@@ -69,11 +78,26 @@ struct BinaryRangeExprImpl : BinaryRangeExpr {
                         const bool lower_inclusive,
                         const bool upper_inclusive,
                         const T lower_value,
-                        const T upper_value)
-        : BinaryRangeExpr(field_id, data_type, lower_inclusive, upper_inclusive),
+                        const T upper_value,
+                        const std::vector<std::string>& nested_path = {},
+                        const ValCase val_case = ValCase::VAL_NOT_SET)
+        : BinaryRangeExpr(field_id, data_type, lower_inclusive, upper_inclusive, nested_path, val_case),
           lower_value_(lower_value),
           upper_value_(upper_value) {
     }
 };
 
+template <typename T>
+struct ArrayContainsExprImpl : ArrayContainsExpr {
+    const std::vector<T> elements_;
+
+    ArrayContainsExprImpl(const FieldId field_id,
+                          const DataType data_type,
+                          const DataType element_type,
+                          const ArrayOp op,
+                          const std::vector<T>& elements)
+        : ArrayContainsExpr(field_id, data_type, element_type, op), elements_(elements) {
+    }
+};
+
 }  // namespace milvus::query
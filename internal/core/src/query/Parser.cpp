@@ -20,6 +20,7 @@
 #include "ExprImpl.h"
 #include "Parser.h"
 #include "Plan.h"
+#include "query/Utils.h"
 #include "generated/ExtractInfoPlanNodeVisitor.h"
 #include "generated/VerifyPlanNodeVisitor.h"
 
@@ -204,6 +205,7 @@ Parser::ParseVecNode(const Json& out_body) {
     vec_node->search_info_.search_params_ = vec_info.at("params");
     vec_node->search_info_.field_id_ = field_id;
     vec_node->search_info_.round_decimal_ = vec_info.at("round_decimal");
+    ParseRangeSearchParams(vec_node->search_info_);
     vec_node->placeholder_tag_ = vec_info.at("query");
     auto tag = vec_node->placeholder_tag_;
     AssertInfo(!tag2field_.count(tag), "duplicated placeholder tag");
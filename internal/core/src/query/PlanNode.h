@@ -18,6 +18,7 @@
 #include <string>
 
 #include "common/QueryInfo.h"
+#include "common/Types.h"
 #include "query/Expr.h"
 #include "knowhere/common/Config.h"
 
@@ -39,6 +40,8 @@ struct VectorPlanNode : PlanNode {
     std::optional<ExprPtr> predicate_;
     SearchInfo search_info_;
     std::string placeholder_tag_;
+    // rows inserted before it are expired by collection ttl, 0 means ttl is disabled
+    Timestamp collection_ttl_timestamp_ = 0;
 };
 
 struct FloatVectorANNS : VectorPlanNode {
@@ -59,6 +62,11 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // rows inserted before it are expired by collection ttl, 0 means ttl is disabled
+    Timestamp collection_ttl_timestamp_ = 0;
+    // at most limit entities with the smallest primary keys are retrieved in the order of primary keys,
+    // 0 means unlimited
+    int64_t limit_ = 0;
 };
 
 }  // namespace milvus::query
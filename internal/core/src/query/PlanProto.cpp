@@ -11,10 +11,12 @@
 
 #include <google/protobuf/text_format.h>
 
+#include <algorithm>
 #include <string>
 
 #include "ExprImpl.h"
 #include "PlanProto.h"
+#include "query/Utils.h"
 #include "generated/ExtractInfoExprVisitor.h"
 #include "generated/ExtractInfoPlanNodeVisitor.h"
 #include "common/VectorTrait.h"
@@ -24,7 +26,11 @@ namespace planpb = milvus::proto::plan;
 
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
-ExtractTermExprImpl(FieldId field_id, DataType data_type, const planpb::TermExpr& expr_proto) {
+ExtractTermExprImpl(FieldId field_id,
+                    DataType data_type,
+                    const planpb::TermExpr& expr_proto,
+                    const std::vector<std::string>& nested_path = {},
+                    ValCase val_case = ValCase::VAL_NOT_SET) {
     static_assert(IsScalar<T>);
     auto size = expr_proto.values_size();
     std::vector<T> terms(size);
@@ -37,6 +43,11 @@ ExtractTermExprImpl(FieldId field_id, DataType data_type, const planpb::TermExpr
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             terms[i] = static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
+            // integers are widened when they are mixed with floats in the terms of a JSON field
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                terms[i] = static_cast<T>(value_proto.int64_val());
+                continue;
+            }
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             terms[i] = static_cast<T>(value_proto.float_val());
         } else if constexpr (std::is_same_v<T, std::string>) {
@@ -47,12 +58,16 @@ ExtractTermExprImpl(FieldId field_id, DataType data_type, const planpb::TermExpr
         }
     }
     std::sort(terms.begin(), terms.end());
-    return std::make_unique<TermExprImpl<T>>(field_id, data_type, terms);
+    return std::make_unique<TermExprImpl<T>>(field_id, data_type, terms, nested_path, val_case);
 }
 
 template <typename T>
 std::unique_ptr<UnaryRangeExprImpl<T>>
-ExtractUnaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
+ExtractUnaryRangeExprImpl(FieldId field_id,
+                          DataType data_type,
+                          const planpb::UnaryRangeExpr& expr_proto,
+                          const std::vector<std::string>& nested_path = {},
+                          ValCase val_case = ValCase::VAL_NOT_SET) {
     static_assert(IsScalar<T>);
     auto getValue = [&](const auto& value_proto) -> T {
         if constexpr (std::is_same_v<T, bool>) {
@@ -72,12 +87,16 @@ ExtractUnaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::Un
         }
     };
     return std::make_unique<UnaryRangeExprImpl<T>>(field_id, data_type, static_cast<OpType>(expr_proto.op()),
-                                                   getValue(expr_proto.value()));
+                                                   getValue(expr_proto.value()), nested_path, val_case);
 }
 
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
-ExtractBinaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
+ExtractBinaryRangeExprImpl(FieldId field_id,
+                           DataType data_type,
+                           const planpb::BinaryRangeExpr& expr_proto,
+                           const std::vector<std::string>& nested_path = {},
+                           ValCase val_case = ValCase::VAL_NOT_SET) {
     static_assert(IsScalar<T>);
     auto getValue = [&](const auto& value_proto) -> T {
         if constexpr (std::is_same_v<T, bool>) {
@@ -87,6 +106,10 @@ ExtractBinaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::B
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             return static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
+            // an integer bound is widened when the other bound of a JSON field is a float
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                return static_cast<T>(value_proto.int64_val());
+            }
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             return static_cast<T>(value_proto.float_val());
         } else if constexpr (std::is_same_v<T, std::string>) {
@@ -98,7 +121,37 @@ ExtractBinaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::B
     };
     return std::make_unique<BinaryRangeExprImpl<T>>(field_id, data_type, expr_proto.lower_inclusive(),
                                                     expr_proto.upper_inclusive(), getValue(expr_proto.lower_value()),
-                                                    getValue(expr_proto.upper_value()));
+                                                    getValue(expr_proto.upper_value()), nested_path, val_case);
+}
+
+template <typename T>
+std::unique_ptr<ArrayContainsExprImpl<T>>
+ExtractArrayContainsExprImpl(FieldId field_id,
+                             DataType data_type,
+                             DataType element_type,
+                             const planpb::ArrayContainsExpr& expr_proto) {
+    static_assert(IsScalar<T>);
+    auto size = expr_proto.elements_size();
+    std::vector<T> elements(size);
+    for (int i = 0; i < size; ++i) {
+        auto& value_proto = expr_proto.elements(i);
+        if constexpr (std::is_same_v<T, bool>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kBoolVal);
+            elements[i] = static_cast<T>(value_proto.bool_val());
+        } else if constexpr (std::is_integral_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
+            elements[i] = static_cast<T>(value_proto.int64_val());
+        } else if constexpr (std::is_floating_point_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+            elements[i] = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            elements[i] = static_cast<T>(value_proto.string_val());
+        } else {
+            static_assert(always_false<T>);
+        }
+    }
+    return std::make_unique<ArrayContainsExprImpl<T>>(field_id, data_type, element_type, expr_proto.op(), elements);
 }
 
 template <typename T>
@@ -126,6 +179,16 @@ ExtractBinaryArithOpEvalRangeExprImpl(FieldId field_id,
         static_cast<OpType>(expr_proto.op()), getValue(expr_proto.value()));
 }
 
+static std::vector<std::string>
+ExtractNestedPath(const planpb::ColumnInfo& column_info) {
+    return {column_info.nested_path().begin(), column_info.nested_path().end()};
+}
+
+static bool
+IsNumericValCase(ValCase val_case) {
+    return val_case == planpb::GenericValue::kInt64Val || val_case == planpb::GenericValue::kFloatVal;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -149,6 +212,7 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.topk_ = query_info_proto.topk();
     search_info.round_decimal_ = query_info_proto.round_decimal();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    ParseRangeSearchParams(search_info);
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -171,6 +235,7 @@ ProtoParser::RetrievePlanNodeFromProto(const planpb::PlanNode& plan_node_proto)
 
     auto plan_node = [&]() -> std::unique_ptr<RetrievePlanNode> { return std::make_unique<RetrievePlanNode>(); }();
     plan_node->predicate_ = std::move(expr_opt);
+    plan_node->limit_ = plan_node_proto.limit();
     return plan_node;
 }
 
@@ -246,6 +311,24 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractUnaryRangeExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                // the type of the value decides how the documents are compared
+                auto nested_path = ExtractNestedPath(column_info);
+                auto val_case = expr_pb.value().val_case();
+                switch (val_case) {
+                    case planpb::GenericValue::kBoolVal:
+                        return ExtractUnaryRangeExprImpl<bool>(field_id, data_type, expr_pb, nested_path, val_case);
+                    case planpb::GenericValue::kInt64Val:
+                        return ExtractUnaryRangeExprImpl<int64_t>(field_id, data_type, expr_pb, nested_path, val_case);
+                    case planpb::GenericValue::kFloatVal:
+                        return ExtractUnaryRangeExprImpl<double>(field_id, data_type, expr_pb, nested_path, val_case);
+                    case planpb::GenericValue::kStringVal:
+                        return ExtractUnaryRangeExprImpl<std::string>(field_id, data_type, expr_pb, nested_path,
+                                                                      val_case);
+                    default:
+                        PanicInfo("unsupported value type of JSON field");
+                }
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -287,6 +370,33 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractBinaryRangeExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                auto nested_path = ExtractNestedPath(columnInfo);
+                auto lower_case = expr_pb.lower_value().val_case();
+                auto upper_case = expr_pb.upper_value().val_case();
+                if (lower_case != upper_case) {
+                    AssertInfo(IsNumericValCase(lower_case) && IsNumericValCase(upper_case),
+                               "bounds of range on JSON field are of different types");
+                    // mixed integer and float bounds are compared as floats
+                    return ExtractBinaryRangeExprImpl<double>(field_id, data_type, expr_pb, nested_path,
+                                                              planpb::GenericValue::kFloatVal);
+                }
+                switch (lower_case) {
+                    case planpb::GenericValue::kBoolVal:
+                        return ExtractBinaryRangeExprImpl<bool>(field_id, data_type, expr_pb, nested_path, lower_case);
+                    case planpb::GenericValue::kInt64Val:
+                        return ExtractBinaryRangeExprImpl<int64_t>(field_id, data_type, expr_pb, nested_path,
+                                                                   lower_case);
+                    case planpb::GenericValue::kFloatVal:
+                        return ExtractBinaryRangeExprImpl<double>(field_id, data_type, expr_pb, nested_path,
+                                                                  lower_case);
+                    case planpb::GenericValue::kStringVal:
+                        return ExtractBinaryRangeExprImpl<std::string>(field_id, data_type, expr_pb, nested_path,
+                                                                       lower_case);
+                    default:
+                        PanicInfo("unsupported value type of JSON field");
+                }
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -352,6 +462,9 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractTermExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                return ParseJsonTermExpr(expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -360,6 +473,101 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseJsonTermExpr(const proto::plan::TermExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    auto nested_path = ExtractNestedPath(column_info);
+
+    // values inside the documents may be of any type, so split the terms by type and OR them,
+    // integers and floats are kept together and compared as floats if both appear
+    std::map<ValCase, planpb::TermExpr> groups;
+    for (auto& value : expr_pb.values()) {
+        auto val_case = value.val_case();
+        if (IsNumericValCase(val_case)) {
+            val_case = planpb::GenericValue::kInt64Val;
+        }
+        auto& group = groups[val_case];
+        group.add_values()->CopyFrom(value);
+    }
+
+    auto extract = [&](ValCase val_case, const planpb::TermExpr& group) -> ExprPtr {
+        switch (val_case) {
+            case planpb::GenericValue::kBoolVal:
+                return ExtractTermExprImpl<bool>(field_id, data_type, group, nested_path, val_case);
+            case planpb::GenericValue::kInt64Val: {
+                auto all_int = std::all_of(group.values().begin(), group.values().end(), [](auto& value) {
+                    return value.val_case() == planpb::GenericValue::kInt64Val;
+                });
+                if (all_int) {
+                    return ExtractTermExprImpl<int64_t>(field_id, data_type, group, nested_path, val_case);
+                }
+                return ExtractTermExprImpl<double>(field_id, data_type, group, nested_path,
+                                                   planpb::GenericValue::kFloatVal);
+            }
+            case planpb::GenericValue::kStringVal:
+                return ExtractTermExprImpl<std::string>(field_id, data_type, group, nested_path, val_case);
+            default:
+                PanicInfo("unsupported value type of JSON field");
+        }
+    };
+
+    if (groups.empty()) {
+        return ExtractTermExprImpl<int64_t>(field_id, data_type, expr_pb, nested_path,
+                                            planpb::GenericValue::kInt64Val);
+    }
+    ExprPtr result;
+    for (auto& [val_case, group] : groups) {
+        auto expr = extract(val_case, group);
+        if (result == nullptr) {
+            result = std::move(expr);
+        } else {
+            result = std::make_unique<LogicalBinaryExpr>(LogicalBinaryExpr::OpType::LogicalOr, result, expr);
+        }
+    }
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto& field_meta = schema[field_id];
+    auto data_type = field_meta.get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    auto element_type = field_meta.get_element_type();
+    Assert(element_type == static_cast<DataType>(column_info.element_type()));
+
+    // integers of all widths are compared as int64, like the values parsed from the plan
+    auto result = [&]() -> ExprPtr {
+        switch (element_type) {
+            case DataType::BOOL: {
+                return ExtractArrayContainsExprImpl<bool>(field_id, data_type, element_type, expr_pb);
+            }
+            case DataType::INT8:
+            case DataType::INT16:
+            case DataType::INT32:
+            case DataType::INT64: {
+                return ExtractArrayContainsExprImpl<int64_t>(field_id, data_type, element_type, expr_pb);
+            }
+            case DataType::FLOAT: {
+                return ExtractArrayContainsExprImpl<float>(field_id, data_type, element_type, expr_pb);
+            }
+            case DataType::DOUBLE: {
+                return ExtractArrayContainsExprImpl<double>(field_id, data_type, element_type, expr_pb);
+            }
+            case DataType::VARCHAR: {
+                return ExtractArrayContainsExprImpl<std::string>(field_id, data_type, element_type, expr_pb);
+            }
+            default: {
+                PanicInfo("unsupported element type of array");
+            }
+        }
+    }();
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb) {
     auto op = static_cast<LogicalUnaryExpr::OpType>(expr_pb.op());
@@ -436,6 +644,9 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kArrayContainsExpr: {
+            return ParseArrayContainsExpr(expr_pb.array_contains_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
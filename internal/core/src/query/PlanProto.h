@@ -44,6 +44,12 @@ class ProtoParser {
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
+    ExprPtr
+    ParseJsonTermExpr(const proto::plan::TermExpr& expr_pb);
+
+    ExprPtr
+    ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb);
+
     ExprPtr
     ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb);
 
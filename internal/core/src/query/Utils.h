@@ -13,6 +13,8 @@
 
 #include <string>
 #include "query/Expr.h"
+#include "common/Consts.h"
+#include "common/QueryInfo.h"
 #include "common/Utils.h"
 
 namespace milvus::query {
@@ -35,4 +37,27 @@ Match<std::string>(const std::string& str, const std::string& val, OpType op) {
             PanicInfo("not supported");
     }
 }
+
+// the bounds of a range search are numbers or numeric strings, as validated by the proxy
+inline float
+ParseRangeValue(const nlohmann::json& value) {
+    if (value.is_number()) {
+        return value.get<float>();
+    }
+    AssertInfo(value.is_string(), "invalid range search param: " + value.dump());
+    return std::stof(value.get<std::string>());
+}
+
+// ParseRangeSearchParams sets the bounds of a range search from the search params, if any.
+inline void
+ParseRangeSearchParams(SearchInfo& search_info) {
+    auto& params = search_info.search_params_;
+    if (!params.contains(RADIUS)) {
+        return;
+    }
+    search_info.radius_ = ParseRangeValue(params[RADIUS]);
+    if (params.contains(RANGE_FILTER)) {
+        search_info.range_filter_ = ParseRangeValue(params[RANGE_FILTER]);
+    }
+}
 }  // namespace milvus::query
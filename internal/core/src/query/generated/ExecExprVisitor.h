@@ -44,6 +44,9 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArrayContainsExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
         : segment_(segment), row_count_(row_count), timestamp_(timestamp) {
@@ -80,6 +83,27 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> BitsetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldId field_id, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecUnaryRangeVisitorDispatcherJson(UnaryRangeExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecBinaryRangeVisitorDispatcherJson(BinaryRangeExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecTermVisitorImplJson(TermExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> BitsetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> BitsetType;
@@ -39,5 +39,8 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(ArrayContainsExpr&) = 0;
 };
 }  // namespace milvus::query
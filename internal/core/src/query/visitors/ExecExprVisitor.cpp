@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <deque>
 #include <optional>
 #include <unordered_set>
@@ -20,6 +21,7 @@
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/Utils.h"
 #include "query/Relational.h"
+#include "common/Json.h"
 
 namespace milvus::query {
 // THIS CONTAINS EXTRA BODY FOR VISITOR
@@ -58,6 +60,27 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> BitsetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldId field_id, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecUnaryRangeVisitorDispatcherJson(UnaryRangeExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecBinaryRangeVisitorDispatcherJson(BinaryRangeExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecTermVisitorImplJson(TermExpr& expr_raw) -> BitsetType;
+
+    template <typename T>
+    auto
+    ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> BitsetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> BitsetType;
@@ -436,6 +459,120 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecJsonVisitorImpl(FieldId field_id,
+                                     const std::vector<std::string>& nested_path,
+                                     ElementFunc element_func) -> BitsetType {
+    // JSON fields are never indexed, the documents are always evaluated on raw data
+    AssertInfo(segment_.num_chunk_index(field_id) == 0, "[ExecExprVisitor]JSON field shouldn't have index");
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    JsonPath path(nested_path);
+    std::deque<BitsetType> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        BitsetType result(this_size);
+        auto chunk = segment_.chunk_data<std::string>(field_id, chunk_id);
+        const std::string* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            // rows missing the path or holding another type never match
+            auto value = path.Find(data[index]);
+            result[index] = value.has_value() && element_func(value.value());
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecUnaryRangeVisitorDispatcherJson(UnaryRangeExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<T>&>(expr_raw);
+    auto op = expr.op_type_;
+    auto val = expr.value_;
+    auto cmp_func = [val](const nlohmann::json& x) { return CompareJson<T>(x, val); };
+    switch (op) {
+        case OpType::Equal: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() == 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::NotEqual: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() != 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::GreaterEqual: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() >= 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::GreaterThan: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() > 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::LessEqual: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() <= 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::LessThan: {
+            auto elem_func = [cmp_func](const nlohmann::json& x) {
+                auto cmp = cmp_func(x);
+                return cmp.has_value() && cmp.value() < 0;
+            };
+            return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+        }
+        case OpType::PrefixMatch: {
+            if constexpr (std::is_same_v<T, std::string>) {
+                auto elem_func = [val, op](const nlohmann::json& x) {
+                    return x.is_string() && Match(x.get_ref<const std::string&>(), val, op);
+                };
+                return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+            }
+            PanicInfo("prefix match on JSON field requires a string value");
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecBinaryRangeVisitorDispatcherJson(BinaryRangeExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<T>&>(expr_raw);
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    T val1 = expr.lower_value_;
+    T val2 = expr.upper_value_;
+
+    auto elem_func = [=](const nlohmann::json& x) {
+        auto lower = CompareJson<T>(x, val1);
+        auto upper = CompareJson<T>(x, val2);
+        if (!lower.has_value() || !upper.has_value()) {
+            return false;
+        }
+        return (lower_inclusive ? lower.value() >= 0 : lower.value() > 0) &&
+               (upper_inclusive ? upper.value() <= 0 : upper.value() < 0);
+    };
+    return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_id_];
@@ -475,6 +612,25 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            switch (expr.val_case_) {
+                case proto::plan::GenericValue::kBoolVal:
+                    res = ExecUnaryRangeVisitorDispatcherJson<bool>(expr);
+                    break;
+                case proto::plan::GenericValue::kInt64Val:
+                    res = ExecUnaryRangeVisitorDispatcherJson<int64_t>(expr);
+                    break;
+                case proto::plan::GenericValue::kFloatVal:
+                    res = ExecUnaryRangeVisitorDispatcherJson<double>(expr);
+                    break;
+                case proto::plan::GenericValue::kStringVal:
+                    res = ExecUnaryRangeVisitorDispatcherJson<std::string>(expr);
+                    break;
+                default:
+                    PanicInfo("unsupported value type of JSON field");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -559,6 +715,25 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            switch (expr.val_case_) {
+                case proto::plan::GenericValue::kBoolVal:
+                    res = ExecBinaryRangeVisitorDispatcherJson<bool>(expr);
+                    break;
+                case proto::plan::GenericValue::kInt64Val:
+                    res = ExecBinaryRangeVisitorDispatcherJson<int64_t>(expr);
+                    break;
+                case proto::plan::GenericValue::kFloatVal:
+                    res = ExecBinaryRangeVisitorDispatcherJson<double>(expr);
+                    break;
+                case proto::plan::GenericValue::kStringVal:
+                    res = ExecBinaryRangeVisitorDispatcherJson<std::string>(expr);
+                    break;
+                default:
+                    PanicInfo("unsupported value type of JSON field");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -858,6 +1033,21 @@ ExecExprVisitor::ExecTermVisitorImplTemplate<bool>(TermExpr& expr_raw) -> Bitset
     return ExecRangeVisitorImpl<T>(expr.field_id_, index_func, elem_func);
 }
 
+template <typename T>
+auto
+ExecExprVisitor::ExecTermVisitorImplJson(TermExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<TermExprImpl<T>&>(expr_raw);
+    const auto& terms = expr.terms_;
+    auto elem_func = [&terms](const nlohmann::json& x) {
+        // integers and floats inside the documents compare equal by value, so no hash lookup here
+        return std::any_of(terms.begin(), terms.end(), [&x](const T& term) {
+            auto cmp = CompareJson<T>(x, term);
+            return cmp.has_value() && cmp.value() == 0;
+        });
+    };
+    return ExecJsonVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(TermExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_id_];
@@ -897,10 +1087,138 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            switch (expr.val_case_) {
+                case proto::plan::GenericValue::kBoolVal:
+                    res = ExecTermVisitorImplJson<bool>(expr);
+                    break;
+                case proto::plan::GenericValue::kInt64Val:
+                    res = ExecTermVisitorImplJson<int64_t>(expr);
+                    break;
+                case proto::plan::GenericValue::kFloatVal:
+                    res = ExecTermVisitorImplJson<double>(expr);
+                    break;
+                case proto::plan::GenericValue::kStringVal:
+                    res = ExecTermVisitorImplJson<std::string>(expr);
+                    break;
+                default:
+                    PanicInfo("unsupported value type of JSON field");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
+
+// ArrayElements returns the elements of an array row, integers of all widths are widened to int64
+template <typename T>
+static std::vector<T>
+ArrayElements(const proto::schema::ScalarField& array, DataType element_type) {
+    if constexpr (std::is_same_v<T, bool>) {
+        return {array.bool_data().data().begin(), array.bool_data().data().end()};
+    } else if constexpr (std::is_integral_v<T>) {
+        if (element_type == DataType::INT64) {
+            return {array.long_data().data().begin(), array.long_data().data().end()};
+        }
+        return {array.int_data().data().begin(), array.int_data().data().end()};
+    } else if constexpr (std::is_same_v<T, float>) {
+        return {array.float_data().data().begin(), array.float_data().data().end()};
+    } else if constexpr (std::is_same_v<T, double>) {
+        return {array.double_data().data().begin(), array.double_data().data().end()};
+    } else if constexpr (std::is_same_v<T, std::string>) {
+        return {array.string_data().data().begin(), array.string_data().data().end()};
+    } else {
+        static_assert(always_false<T>);
+    }
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<ArrayContainsExprImpl<T>&>(expr_raw);
+    auto field_id = expr.field_id_;
+    auto element_type = expr.element_type_;
+    // arrays are never indexed, the rows are always evaluated on raw data
+    AssertInfo(segment_.num_chunk_index(field_id) == 0, "[ExecExprVisitor]Array field shouldn't have index");
+
+    std::vector<T> elements(expr.elements_);
+    std::sort(elements.begin(), elements.end());
+    auto contains = [&](const std::vector<T>& row) {
+        if (expr.op_ == proto::plan::ArrayContainsExpr::ContainsAny) {
+            return std::any_of(row.begin(), row.end(), [&](const T& value) {
+                return std::binary_search(elements.begin(), elements.end(), value);
+            });
+        }
+        return std::all_of(elements.begin(), elements.end(), [&](const T& element) {
+            return std::binary_search(row.begin(), row.end(), element);
+        });
+    };
+
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<BitsetType> results;
+    proto::schema::ScalarField array;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        BitsetType result(this_size);
+        auto chunk = segment_.chunk_data<std::string>(field_id, chunk_id);
+        const std::string* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            // each row is a serialized ScalarField, rows that can't be parsed never match
+            if (!array.ParseFromString(data[index])) {
+                result[index] = false;
+                continue;
+            }
+            auto row = ArrayElements<T>(array, element_type);
+            std::sort(row.begin(), row.end());
+            result[index] = contains(row);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+void
+ExecExprVisitor::visit(ArrayContainsExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(expr.element_type_ == field_meta.get_element_type(),
+               "[ExecExprVisitor]Element type of expr isn't field_meta element type");
+    BitsetType res;
+    switch (expr.element_type_) {
+        case DataType::BOOL: {
+            res = ExecArrayContainsVisitorImpl<bool>(expr);
+            break;
+        }
+        case DataType::INT8:
+        case DataType::INT16:
+        case DataType::INT32:
+        case DataType::INT64: {
+            res = ExecArrayContainsVisitorImpl<int64_t>(expr);
+            break;
+        }
+        case DataType::FLOAT: {
+            res = ExecArrayContainsVisitorImpl<float>(expr);
+            break;
+        }
+        case DataType::DOUBLE: {
+            res = ExecArrayContainsVisitorImpl<double>(expr);
+            break;
+        }
+        case DataType::VARCHAR: {
+            res = ExecArrayContainsVisitorImpl<std::string>(expr);
+            break;
+        }
+        default:
+            PanicInfo("unsupported element type of array");
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
 }  // namespace milvus::query
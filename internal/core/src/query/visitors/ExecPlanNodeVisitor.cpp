@@ -9,8 +9,10 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <utility>
 
+#include "common/Utils.h"
 #include "query/PlanImpl.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -58,14 +60,114 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
 static SearchResult
 empty_search_result(int64_t num_queries, SearchInfo& search_info) {
     SearchResult final_result;
-    SubSearchResult result(num_queries, search_info.topk_, search_info.metric_type_, search_info.round_decimal_);
+    // a range search result is as wide as the hits found
+    auto topk = search_info.radius_.has_value() ? 0 : search_info.topk_;
+    SubSearchResult result(num_queries, topk, search_info.metric_type_, search_info.round_decimal_);
     final_result.total_nq_ = num_queries;
-    final_result.unity_topK_ = search_info.topk_;
+    final_result.unity_topK_ = topk;
     final_result.seg_offsets_ = std::move(result.mutable_seg_offsets());
     final_result.distances_ = std::move(result.mutable_distances());
     return final_result;
 }
 
+// the first round of a range search searches this many hits per query, the k is doubled every next round
+constexpr int64_t RANGE_SEARCH_INITIAL_TOPK = 64;
+
+static bool
+InRadius(const SearchInfo& search_info, float distance) {
+    auto radius = search_info.radius_.value();
+    return PositivelyRelated(search_info.metric_type_) ? distance > radius : distance < radius;
+}
+
+static bool
+InRange(const SearchInfo& search_info, float distance) {
+    if (!InRadius(search_info, distance)) {
+        return false;
+    }
+    if (!search_info.range_filter_.has_value()) {
+        return true;
+    }
+    auto range_filter = search_info.range_filter_.value();
+    return PositivelyRelated(search_info.metric_type_) ? distance <= range_filter : distance >= range_filter;
+}
+
+// the size of the candidate list of HNSW and DiskANN must not be less than the k searched
+static void
+GrowCandidateParams(Config& search_params, int64_t topk) {
+    for (auto key : {"ef", "search_list"}) {
+        if (search_params.contains(key) && search_params[key].is_number_integer() &&
+            search_params[key].get<int64_t>() <= topk) {
+            search_params[key] = topk + 1;
+        }
+    }
+}
+
+// RangeSearch returns the hits within the radius and the range filter, topk_ is the max number of hits per query.
+// The hits are sorted by distance, so the segment is searched with a doubled k until the last hit of every query
+// is out of the radius, or every query gets topk_ hits in the range, or all the rows are searched. The hits out of
+// the range are removed, the result is as wide as the most hits of a query.
+static SearchResult
+RangeSearch(const segcore::SegmentInternalInterface& segment,
+            const SearchInfo& search_info,
+            const void* src_data,
+            int64_t num_queries,
+            Timestamp timestamp,
+            int64_t active_count,
+            const BitsetView& bitset) {
+    auto limit = search_info.topk_;
+    auto round_info = search_info;
+    round_info.topk_ = std::min(limit, RANGE_SEARCH_INITIAL_TOPK);
+    SearchResult result;
+    while (true) {
+        GrowCandidateParams(round_info.search_params_, round_info.topk_);
+        result = SearchResult();
+        segment.vector_search(round_info, src_data, num_queries, timestamp, bitset, result);
+        if (round_info.topk_ >= active_count) {
+            break;
+        }
+
+        auto topk = round_info.topk_;
+        bool exhausted = true;
+        for (int64_t i = 0; i < num_queries && exhausted; ++i) {
+            auto last = i * topk + topk - 1;
+            if (result.seg_offsets_[last] == INVALID_SEG_OFFSET || !InRadius(search_info, result.distances_[last])) {
+                continue;
+            }
+            auto hits = std::count_if(result.distances_.begin() + i * topk, result.distances_.begin() + last + 1,
+                                      [&](float distance) { return InRange(search_info, distance); });
+            exhausted = hits >= limit;
+        }
+        if (exhausted) {
+            break;
+        }
+        round_info.topk_ = std::min(topk * 2, active_count);
+    }
+
+    auto topk = round_info.topk_;
+    std::vector<std::vector<int64_t>> hits(num_queries);
+    int64_t width = 0;
+    for (int64_t i = 0; i < num_queries; ++i) {
+        for (int64_t j = i * topk; j < (i + 1) * topk && int64_t(hits[i].size()) < limit; ++j) {
+            if (result.seg_offsets_[j] != INVALID_SEG_OFFSET && InRange(search_info, result.distances_[j])) {
+                hits[i].push_back(j);
+            }
+        }
+        width = std::max(width, int64_t(hits[i].size()));
+    }
+
+    SubSearchResult range_result(num_queries, width, search_info.metric_type_, search_info.round_decimal_);
+    for (int64_t i = 0; i < num_queries; ++i) {
+        for (size_t k = 0; k < hits[i].size(); ++k) {
+            range_result.get_seg_offsets()[i * width + k] = result.seg_offsets_[hits[i][k]];
+            range_result.get_distances()[i * width + k] = result.distances_[hits[i][k]];
+        }
+    }
+    result.unity_topK_ = width;
+    result.seg_offsets_ = std::move(range_result.mutable_seg_offsets());
+    result.distances_ = std::move(range_result.mutable_distances());
+    return result;
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -97,6 +199,9 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder = std::make_unique<BitsetType>(active_count, false);
     }
     segment->mask_with_timestamps(*bitset_holder, timestamp_);
+    if (node.collection_ttl_timestamp_ > 0) {
+        segment->mask_with_collection_ttl(*bitset_holder, node.collection_ttl_timestamp_);
+    }
 
     segment->mask_with_delete(*bitset_holder, active_count, timestamp_);
     // if bitset_holder is all 1's, we got empty result
@@ -105,7 +210,12 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
     BitsetView final_view = *bitset_holder;
-    segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    if (node.search_info_.radius_.has_value()) {
+        search_result =
+            RangeSearch(*segment, node.search_info_, src_data, num_queries, timestamp_, active_count, final_view);
+    } else {
+        segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    }
 
     search_result_opt_ = std::move(search_result);
 }
@@ -131,6 +241,9 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (node.collection_ttl_timestamp_ > 0) {
+        segment->mask_with_collection_ttl(bitset_holder, node.collection_ttl_timestamp_);
+    }
 
     segment->mask_with_delete(bitset_holder, active_count, timestamp_);
     // if bitset_holder is all 1's, we got empty result
@@ -143,6 +256,11 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     auto seg_offsets = segment->search_ids(final_view, timestamp_);
     retrieve_result.result_offsets_.assign((int64_t*)seg_offsets.data(),
                                            (int64_t*)seg_offsets.data() + seg_offsets.size());
+    // the limited entities are the ones with the smallest primary keys, so that the pages of query iterators
+    // are able to continue from the last primary key
+    if (node.limit_ > 0) {
+        segment->sort_by_primary_key(retrieve_result.result_offsets_, node.limit_);
+    }
     retrieve_result_opt_ = std::move(retrieve_result);
 }
 
@@ -248,6 +248,47 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     json_opt_ = res;
 }
 
+template <typename T>
+static Json
+ArrayContainsExtract(const ArrayContainsExpr& expr_raw) {
+    auto expr = dynamic_cast<const ArrayContainsExprImpl<T>*>(&expr_raw);
+    AssertInfo(expr, "[ShowExprVisitor]ArrayContainsExpr cast to ArrayContainsExprImpl failed");
+    return Json{expr->elements_};
+}
+
+void
+ShowExprVisitor::visit(ArrayContainsExpr& expr) {
+    using proto::plan::ArrayContainsExpr_ArrayOp_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+    auto elements = [&] {
+        switch (expr.element_type_) {
+            case DataType::BOOL:
+                return ArrayContainsExtract<bool>(expr);
+            case DataType::INT8:
+            case DataType::INT16:
+            case DataType::INT32:
+            case DataType::INT64:
+                return ArrayContainsExtract<int64_t>(expr);
+            case DataType::FLOAT:
+                return ArrayContainsExtract<float>(expr);
+            case DataType::DOUBLE:
+                return ArrayContainsExtract<double>(expr);
+            case DataType::VARCHAR:
+                return ArrayContainsExtract<std::string>(expr);
+            default:
+                PanicInfo("unsupported type");
+        }
+    }();
+
+    Json res{{"expr_type", "ArrayContains"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"element_type", datatype_name(expr.element_type_)},
+             {"op", ArrayContainsExpr_ArrayOp_Name(expr.op_)},
+             {"elements", std::move(elements)}};
+    json_opt_ = res;
+}
+
 template <typename T>
 static Json
 BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
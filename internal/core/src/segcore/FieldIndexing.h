@@ -131,6 +131,10 @@ class IndexingRecord {
                     continue;
                 }
             }
+            // JSON documents and arrays are variable length and can't be indexed by value
+            if (field_meta.is_json() || field_meta.is_array()) {
+                continue;
+            }
 
             field_indexings_.try_emplace(field_id, CreateIndex(field_meta, segcore_config_));
         }
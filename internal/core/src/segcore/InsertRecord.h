@@ -208,6 +208,11 @@ struct InsertRecord {
                     this->append_field_data<std::string>(field_id, size_per_chunk);
                     break;
                 }
+                case DataType::ARRAY:
+                case DataType::JSON: {
+                    this->append_field_data<std::string>(field_id, size_per_chunk);
+                    break;
+                }
                 default: {
                     PanicInfo("unsupported");
                 }
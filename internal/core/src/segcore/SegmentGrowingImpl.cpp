@@ -242,7 +242,9 @@ SegmentGrowingImpl::bulk_subscript(FieldId field_id, const int64_t* seg_offsets,
             bulk_subscript_impl<double>(*vec_ptr, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
         }
-        case DataType::VARCHAR: {
+        case DataType::VARCHAR:
+        case DataType::ARRAY:
+        case DataType::JSON: {
             FixedVector<std::string> output(count);
             bulk_subscript_impl<std::string>(*vec_ptr, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
@@ -394,4 +396,15 @@ SegmentGrowingImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp tim
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_collection_ttl(BitsetType& bitset_chunk, Timestamp collection_ttl) const {
+    auto& ts_vec = this->get_insert_record().timestamps_;
+    auto size = std::min<int64_t>(bitset_chunk.size(), this->get_row_count());
+    for (int64_t i = 0; i < size; ++i) {
+        if (ts_vec[i] < collection_ttl) {
+            bitset_chunk.set(i);
+        }
+    }
+}
+
 }  // namespace milvus::segcore
@@ -98,6 +98,10 @@ class SegmentGrowingImpl : public SegmentGrowing {
     // return count of index that has index, i.e., [0, num_chunk_index) have built index
     int64_t
     num_chunk_index(FieldId field_id) const final {
+        // fields without growing index, such as JSON and ARRAY fields, are always read from raw data
+        if (!indexing_record_.is_in(field_id)) {
+            return 0;
+        }
         return indexing_record_.get_finished_ack();
     }
 
@@ -173,6 +177,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_collection_ttl(BitsetType& bitset_chunk, Timestamp collection_ttl) const override;
+
     void
     vector_search(SearchInfo& search_info,
                   const void* query_data,
@@ -10,7 +10,9 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include "SegmentInterface.h"
+#include <algorithm>
 #include <cstdint>
+#include <numeric>
 #include "common/SystemProperty.h"
 #include "common/Types.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
@@ -52,6 +54,39 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     }
 }
 
+void
+SegmentInternalInterface::sort_by_primary_key(std::vector<int64_t>& seg_offsets, int64_t limit) const {
+    auto pk_field_id_opt = get_schema().get_primary_field_id();
+    AssertInfo(pk_field_id_opt.has_value(), "Cannot get primary key offset from schema");
+    auto count = int64_t(seg_offsets.size());
+    auto field_data = bulk_subscript(pk_field_id_opt.value(), seg_offsets.data(), count);
+    std::vector<PkType> pks(count);
+    ParsePksFromFieldData(pks, *field_data.get());
+    std::vector<Timestamp> timestamps(count);
+    bulk_subscript(SystemFieldType::Timestamp, seg_offsets.data(), count, timestamps.data());
+
+    // the versions of a primary key are ordered by timestamp descending, so that the latest one comes first
+    std::vector<int64_t> indexes(count);
+    std::iota(indexes.begin(), indexes.end(), 0);
+    std::sort(indexes.begin(), indexes.end(), [&](int64_t i, int64_t j) {
+        if (pks[i] != pks[j]) {
+            return pks[i] < pks[j];
+        }
+        return timestamps[i] > timestamps[j];
+    });
+
+    // the duplicated primary keys are removed before the limit, otherwise they take the places of other entities
+    std::vector<int64_t> sorted_offsets;
+    sorted_offsets.reserve(std::min(limit, count));
+    for (int64_t i = 0; i < count && int64_t(sorted_offsets.size()) < limit; ++i) {
+        if (i > 0 && pks[indexes[i]] == pks[indexes[i - 1]]) {
+            continue;
+        }
+        sorted_offsets.push_back(seg_offsets[indexes[i]]);
+    }
+    seg_offsets = std::move(sorted_offsets);
+}
+
 std::unique_ptr<SearchResult>
 SegmentInternalInterface::Search(const query::Plan* plan,
                                  const query::PlaceholderGroup* placeholder_group,
@@ -152,6 +152,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // mask the rows inserted before collection_ttl, they are expired
+    virtual void
+    mask_with_collection_ttl(BitsetType& bitset_chunk, Timestamp collection_ttl) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -172,6 +176,11 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     search_ids(const IdArray& id_array, Timestamp timestamp) const = 0;
 
+    // keep the limit entities with the smallest primary keys, ordered by primary key,
+    // only the latest version of a duplicated primary key is kept
+    void
+    sort_by_primary_key(std::vector<int64_t>& seg_offsets, int64_t limit) const;
+
  protected:
     // internal API: return chunk_data in span
     virtual SpanBase
@@ -544,7 +544,9 @@ SegmentSealedImpl::bulk_subscript(FieldId field_id, const int64_t* seg_offsets,
             bulk_subscript_impl<double>(src_vec, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
         }
-        case DataType::VARCHAR: {
+        case DataType::VARCHAR:
+        case DataType::ARRAY:
+        case DataType::JSON: {
             FixedVector<std::string> output(count);
             bulk_subscript_impl<std::string>(src_vec, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
@@ -720,4 +722,16 @@ SegmentSealedImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp time
     bitset_chunk |= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_collection_ttl(BitsetType& bitset_chunk, Timestamp collection_ttl) const {
+    AssertInfo(insert_record_.timestamps_.num_chunk() == 1, "num chunk not equal to 1 for sealed segment");
+    const auto& timestamps_data = insert_record_.timestamps_.get_chunk(0);
+    auto size = std::min<int64_t>(bitset_chunk.size(), timestamps_data.size());
+    for (int64_t i = 0; i < size; ++i) {
+        if (timestamps_data[i] < collection_ttl) {
+            bitset_chunk.set(i);
+        }
+    }
+}
+
 }  // namespace milvus::segcore
@@ -141,6 +141,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_collection_ttl(BitsetType& bitset_chunk, Timestamp collection_ttl) const override;
+
     void
     vector_search(SearchInfo& search_info,
                   const void* query_data,
@@ -127,6 +127,12 @@ GetMetricType(CSearchPlan plan) {
     return strdup(metric_str.c_str());
 }
 
+void
+SetSearchPlanCollectionTTL(CSearchPlan plan, uint64_t collection_ttl) {
+    auto search_plan = static_cast<milvus::query::Plan*>(plan);
+    search_plan->plan_node_->collection_ttl_timestamp_ = collection_ttl;
+}
+
 void
 DeleteSearchPlan(CSearchPlan cPlan) {
     auto plan = (milvus::query::Plan*)cPlan;
@@ -170,6 +176,12 @@ CreateRetrievePlanByExpr(CCollection c_col,
     }
 }
 
+void
+SetRetrievePlanCollectionTTL(CRetrievePlan c_plan, uint64_t collection_ttl) {
+    auto plan = static_cast<milvus::query::RetrievePlan*>(c_plan);
+    plan->plan_node_->collection_ttl_timestamp_ = collection_ttl;
+}
+
 void
 DeleteRetrievePlan(CRetrievePlan c_plan) {
     auto plan = (milvus::query::RetrievePlan*)c_plan;
@@ -48,6 +48,9 @@ GetFieldID(CSearchPlan plan, int64_t* field_id);
 const char*
 GetMetricType(CSearchPlan plan);
 
+void
+SetSearchPlanCollectionTTL(CSearchPlan plan, uint64_t collection_ttl);
+
 void
 DeleteSearchPlan(CSearchPlan plan);
 
@@ -60,6 +63,9 @@ CreateRetrievePlanByExpr(CCollection c_col,
                          const int64_t size,
                          CRetrievePlan* res_plan);
 
+void
+SetRetrievePlanCollectionTTL(CRetrievePlan plan, uint64_t collection_ttl);
+
 void
 DeleteRetrievePlan(CRetrievePlan plan);
 
@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+#include <algorithm>
+#include <memory>
+
+#include "pb/plan.pb.h"
+#include "query/Expr.h"
+#include "query/generated/ExecExprVisitor.h"
+#include "query/PlanImpl.h"
+#include "query/PlanProto.h"
+#include "segcore/SegmentGrowingImpl.h"
+#include "test_utils/DataGen.h"
+
+using namespace milvus;
+using namespace milvus::query;
+using namespace milvus::segcore;
+
+namespace {
+// the schema proto has no enum value for ARRAY yet
+const auto ArrayProtoType = static_cast<proto::schema::DataType>(DataType::ARRAY);
+
+auto
+GenArraySchema() {
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fvec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto pk = schema->AddDebugField("int64", DataType::INT64);
+    schema->AddDebugField("array_int64", DataType::ARRAY, DataType::INT64);
+    schema->AddDebugField("array_int32", DataType::ARRAY, DataType::INT32);
+    schema->AddDebugField("array_double", DataType::ARRAY, DataType::DOUBLE);
+    schema->AddDebugField("array_varchar", DataType::ARRAY, DataType::VARCHAR);
+    schema->set_primary_field_id(pk);
+    return schema;
+}
+
+template <typename T>
+void
+SetGenericValue(proto::plan::GenericValue* generic, T value) {
+    if constexpr (std::is_same_v<T, bool>) {
+        generic->set_bool_val(value);
+    } else if constexpr (std::is_integral_v<T>) {
+        generic->set_int64_val(value);
+    } else if constexpr (std::is_floating_point_v<T>) {
+        generic->set_float_val(value);
+    } else {
+        generic->set_string_val(value);
+    }
+}
+
+template <typename T>
+std::unique_ptr<proto::plan::PlanNode>
+GenArrayContainsPlan(const FieldMeta& vec_meta,
+                     const FieldMeta& array_meta,
+                     proto::plan::ArrayContainsExpr_ArrayOp op,
+                     const std::vector<T>& elements) {
+    auto column_info = new proto::plan::ColumnInfo();
+    column_info->set_field_id(array_meta.get_id().get());
+    column_info->set_data_type(ArrayProtoType);
+    column_info->set_element_type(static_cast<proto::schema::DataType>(array_meta.get_element_type()));
+    auto array_contains_expr = new proto::plan::ArrayContainsExpr();
+    array_contains_expr->set_allocated_column_info(column_info);
+    array_contains_expr->set_op(op);
+    for (auto& element : elements) {
+        SetGenericValue(array_contains_expr->add_elements(), element);
+    }
+    auto predicate = new proto::plan::Expr();
+    predicate->set_allocated_array_contains_expr(array_contains_expr);
+
+    auto query_info = new proto::plan::QueryInfo();
+    query_info->set_topk(10);
+    query_info->set_metric_type("L2");
+    query_info->set_search_params(R"({"nprobe": 10})");
+    query_info->set_round_decimal(-1);
+    auto anns = new proto::plan::VectorANNS();
+    anns->set_is_binary(false);
+    anns->set_field_id(vec_meta.get_id().get());
+    anns->set_allocated_predicates(predicate);
+    anns->set_allocated_query_info(query_info);
+    anns->set_placeholder_tag("$0");
+    auto plan_node = std::make_unique<proto::plan::PlanNode>();
+    plan_node->set_allocated_vector_anns(anns);
+    return plan_node;
+}
+
+// returns the elements of every row of an array column, widened like the expression does
+template <typename T>
+std::vector<std::vector<T>>
+ParseArrays(const std::vector<std::string>& rows) {
+    std::vector<std::vector<T>> result;
+    for (auto& row : rows) {
+        proto::schema::ScalarField array;
+        AssertInfo(array.ParseFromString(row), "failed to parse array row");
+        if constexpr (std::is_same_v<T, std::string>) {
+            result.emplace_back(array.string_data().data().begin(), array.string_data().data().end());
+        } else if constexpr (std::is_floating_point_v<T>) {
+            result.emplace_back(array.double_data().data().begin(), array.double_data().data().end());
+        } else if (array.has_long_data()) {
+            result.emplace_back(array.long_data().data().begin(), array.long_data().data().end());
+        } else {
+            result.emplace_back(array.int_data().data().begin(), array.int_data().data().end());
+        }
+    }
+    return result;
+}
+
+template <typename T>
+bool
+RefContains(const std::vector<T>& row, const std::vector<T>& elements, proto::plan::ArrayContainsExpr_ArrayOp op) {
+    auto in_row = [&](const T& element) { return std::find(row.begin(), row.end(), element) != row.end(); };
+    if (op == proto::plan::ArrayContainsExpr::ContainsAny) {
+        return std::any_of(elements.begin(), elements.end(), in_row);
+    }
+    return std::all_of(elements.begin(), elements.end(), in_row);
+}
+
+template <typename T>
+void
+CheckArrayContains(const SegmentInternalInterface& segment,
+                   const Schema& schema,
+                   const FieldMeta& array_meta,
+                   const std::vector<std::vector<T>>& rows) {
+    auto& vec_meta = schema[FieldName("fvec")];
+    auto gen_elements = [](std::vector<int64_t> values) {
+        std::vector<T> elements;
+        for (auto v : values) {
+            if constexpr (std::is_same_v<T, std::string>) {
+                elements.push_back(std::to_string(v));
+            } else if constexpr (std::is_floating_point_v<T>) {
+                elements.push_back(v + 0.5);
+            } else {
+                elements.push_back(v);
+            }
+        }
+        return elements;
+    };
+    std::vector<std::vector<int64_t>> testcases{{}, {3}, {1, 2}, {0, 5, 9}, {7, 7}, {100}};
+    ExecExprVisitor visitor(segment, segment.get_row_count(), MAX_TIMESTAMP);
+    for (auto op : {proto::plan::ArrayContainsExpr::Contains, proto::plan::ArrayContainsExpr::ContainsAny}) {
+        for (auto& values : testcases) {
+            auto elements = gen_elements(values);
+            auto plan_proto = GenArrayContainsPlan(vec_meta, array_meta, op, elements);
+            auto plan = ProtoParser(schema).CreatePlan(*plan_proto);
+            auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+            ASSERT_EQ(final.size(), rows.size());
+            int matched = 0;
+            for (int i = 0; i < rows.size(); ++i) {
+                auto ref = RefContains(rows[i], elements, op);
+                ASSERT_EQ(final[i], ref) << plan_proto->DebugString() << "@" << i;
+                matched += ref;
+            }
+            // elements from the generated domain always hit some rows
+            if (!values.empty() && values[0] < 10) {
+                ASSERT_GT(matched, 0) << plan_proto->DebugString();
+            }
+        }
+    }
+}
+}  // namespace
+
+TEST(ArrayExpr, Schema) {
+    proto::schema::CollectionSchema schema_proto;
+    auto field = schema_proto.add_fields();
+    field->set_fieldid(100);
+    field->set_name("array");
+    field->set_data_type(ArrayProtoType);
+    auto type_param = field->add_type_params();
+    type_param->set_key("element_type");
+    type_param->set_value("VarChar");
+
+    auto schema = Schema::ParseFrom(schema_proto);
+    auto& field_meta = (*schema)[FieldId(100)];
+    ASSERT_TRUE(field_meta.is_array());
+    ASSERT_EQ(field_meta.get_element_type(), DataType::VARCHAR);
+}
+
+TEST(ArrayExpr, Contains) {
+    int64_t N = 1000;
+    auto schema = GenArraySchema();
+    auto growing = CreateGrowingSegment(schema);
+    std::map<std::string, std::vector<std::string>> columns;
+    for (int iter = 0; iter < 5; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        for (auto name : {"array_int64", "array_int32", "array_double", "array_varchar"}) {
+            auto rows = raw_data.get_col<std::string>((*schema)[FieldName(name)].get_id());
+            columns[name].insert(columns[name].end(), rows.begin(), rows.end());
+        }
+        growing->PreInsert(N);
+        growing->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto& segment = *dynamic_cast<SegmentGrowingImpl*>(growing.get());
+    CheckArrayContains(segment, *schema, (*schema)[FieldName("array_int64")],
+                       ParseArrays<int64_t>(columns["array_int64"]));
+    CheckArrayContains(segment, *schema, (*schema)[FieldName("array_int32")],
+                       ParseArrays<int64_t>(columns["array_int32"]));
+    CheckArrayContains(segment, *schema, (*schema)[FieldName("array_double")],
+                       ParseArrays<double>(columns["array_double"]));
+    CheckArrayContains(segment, *schema, (*schema)[FieldName("array_varchar")],
+                       ParseArrays<std::string>(columns["array_varchar"]));
+}
+
+TEST(ArrayExpr, Sealed) {
+    int64_t N = 1000;
+    auto schema = GenArraySchema();
+    auto raw_data = DataGen(schema, N);
+    auto sealed = SealedCreator(schema, raw_data);
+    auto& array_meta = (*schema)[FieldName("array_varchar")];
+    auto rows = raw_data.get_col<std::string>(array_meta.get_id());
+
+    auto& segment = *dynamic_cast<SegmentSealedImpl*>(sealed.get());
+    CheckArrayContains(segment, *schema, array_meta, ParseArrays<std::string>(rows));
+
+    // the arrays are returned as they were inserted
+    auto pk_fid = schema->get_primary_field_id().value();
+    std::vector<int64_t> pks{0, 1, N / 2, N - 1};
+    auto retrieve_plan = std::make_unique<RetrievePlan>(*schema);
+    retrieve_plan->plan_node_ = std::make_unique<RetrievePlanNode>();
+    retrieve_plan->plan_node_->predicate_ = std::make_unique<TermExprImpl<int64_t>>(pk_fid, DataType::INT64, pks);
+    retrieve_plan->field_ids_ = {pk_fid, array_meta.get_id()};
+    auto retrieve_results = sealed->Retrieve(retrieve_plan.get(), MAX_TIMESTAMP);
+    ASSERT_EQ(retrieve_results->fields_data_size(), 2);
+    auto& pk_data = retrieve_results->fields_data(0).scalars().long_data();
+    auto& array_data = retrieve_results->fields_data(1);
+    ASSERT_EQ(array_data.type(), ArrayProtoType);
+    ASSERT_EQ(array_data.scalars().bytes_data().data_size(), pks.size());
+    for (int i = 0; i < pks.size(); ++i) {
+        // the int64 column generated by DataGen is the offset of the row
+        ASSERT_EQ(array_data.scalars().bytes_data().data(i), rows[pk_data.data(i)]);
+    }
+}
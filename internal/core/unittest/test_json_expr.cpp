@@ -0,0 +1,439 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+#include <memory>
+#include <set>
+
+#include "common/Json.h"
+#include "pb/plan.pb.h"
+#include "query/Expr.h"
+#include "query/generated/ExecExprVisitor.h"
+#include "query/PlanImpl.h"
+#include "query/PlanProto.h"
+#include "segcore/SegmentGrowingImpl.h"
+#include "test_utils/DataGen.h"
+
+using namespace milvus;
+using namespace milvus::query;
+using namespace milvus::segcore;
+
+namespace {
+// the schema proto has no enum value for JSON yet
+const auto JsonProtoType = static_cast<proto::schema::DataType>(DataType::JSON);
+
+auto
+GenJsonSchema() {
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fvec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto pk = schema->AddDebugField("int64", DataType::INT64);
+    schema->AddDebugField("json", DataType::JSON);
+    schema->set_primary_field_id(pk);
+    return schema;
+}
+
+auto
+GenJsonColumnInfo(const FieldMeta& json_meta, const std::vector<std::string>& nested_path) {
+    auto column_info = new proto::plan::ColumnInfo();
+    column_info->set_field_id(json_meta.get_id().get());
+    column_info->set_data_type(JsonProtoType);
+    for (auto& key : nested_path) {
+        column_info->add_nested_path(key);
+    }
+    return column_info;
+}
+
+template <typename T>
+void
+SetGenericValue(proto::plan::GenericValue* generic, T value) {
+    if constexpr (std::is_same_v<T, bool>) {
+        generic->set_bool_val(value);
+    } else if constexpr (std::is_integral_v<T>) {
+        generic->set_int64_val(value);
+    } else if constexpr (std::is_floating_point_v<T>) {
+        generic->set_float_val(value);
+    } else {
+        generic->set_string_val(value);
+    }
+}
+
+std::unique_ptr<proto::plan::PlanNode>
+GenPlan(proto::plan::Expr* predicate, const FieldMeta& vec_meta) {
+    auto query_info = new proto::plan::QueryInfo();
+    query_info->set_topk(10);
+    query_info->set_metric_type("L2");
+    query_info->set_search_params(R"({"nprobe": 10})");
+    query_info->set_round_decimal(-1);
+    auto anns = new proto::plan::VectorANNS();
+    anns->set_is_binary(false);
+    anns->set_field_id(vec_meta.get_id().get());
+    anns->set_allocated_predicates(predicate);
+    anns->set_allocated_query_info(query_info);
+    anns->set_placeholder_tag("$0");
+    auto plan_node = std::make_unique<proto::plan::PlanNode>();
+    plan_node->set_allocated_vector_anns(anns);
+    return plan_node;
+}
+
+template <typename T>
+proto::plan::Expr*
+GenUnaryRangeExpr(const FieldMeta& json_meta,
+                  const std::vector<std::string>& nested_path,
+                  proto::plan::OpType op,
+                  T value) {
+    auto unary_range_expr = new proto::plan::UnaryRangeExpr();
+    unary_range_expr->set_allocated_column_info(GenJsonColumnInfo(json_meta, nested_path));
+    unary_range_expr->set_op(op);
+    SetGenericValue(unary_range_expr->mutable_value(), value);
+    auto expr = new proto::plan::Expr();
+    expr->set_allocated_unary_range_expr(unary_range_expr);
+    return expr;
+}
+
+// returns the value at the path, or nullptr if the path doesn't exist
+const nlohmann::json*
+Lookup(const nlohmann::json& doc, const std::vector<std::string>& nested_path) {
+    auto value = &doc;
+    for (auto& key : nested_path) {
+        if (!value->is_object() || !value->contains(key)) {
+            return nullptr;
+        }
+        value = &value->at(key);
+    }
+    return value;
+}
+
+struct JsonSegment {
+    SchemaPtr schema;
+    std::vector<nlohmann::json> docs;
+    SegmentGrowingPtr growing;
+    SegmentSealedPtr sealed;
+};
+
+JsonSegment
+GenJsonSegment(int64_t N, int num_iters) {
+    JsonSegment result;
+    result.schema = GenJsonSchema();
+    auto json_fid = result.schema->operator[](FieldName("json")).get_id();
+    result.growing = CreateGrowingSegment(result.schema);
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(result.schema, N, iter);
+        for (auto& doc : raw_data.get_col<std::string>(json_fid)) {
+            result.docs.push_back(nlohmann::json::parse(doc));
+        }
+        result.growing->PreInsert(N);
+        result.growing->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+    auto sealed_data = DataGen(result.schema, N, 0);
+    result.sealed = SealedCreator(result.schema, sealed_data);
+    return result;
+}
+}  // namespace
+
+TEST(JsonPath, Find) {
+    auto doc = R"({"a": {"b/c": {"x~y": 3}}, "s": "str", "arr": [1, 2]})";
+    auto value = JsonPath({"a", "b/c", "x~y"}).Find(doc);
+    ASSERT_TRUE(value.has_value());
+    ASSERT_EQ(CompareJson<int64_t>(value.value(), 3).value(), 0);
+    ASSERT_LT(CompareJson<double>(value.value(), 3.5).value(), 0);
+    ASSERT_FALSE(CompareJson<std::string>(value.value(), "3").has_value());
+
+    ASSERT_FALSE(JsonPath({"a", "z"}).Find(doc).has_value());
+    ASSERT_FALSE(JsonPath({"arr", "b"}).Find(doc).has_value());
+    ASSERT_FALSE(JsonPath({"a"}).Find("not a json").has_value());
+
+    auto str = JsonPath({"s"}).Find(doc);
+    ASSERT_TRUE(str.has_value());
+    ASSERT_LT(CompareJson<std::string>(str.value(), "su").value(), 0);
+    ASSERT_FALSE(CompareJson<int64_t>(str.value(), 1).has_value());
+}
+
+TEST(JsonExpr, UnaryRange) {
+    int64_t N = 1000;
+    auto segment = GenJsonSegment(N, 10);
+    auto& schema = *segment.schema;
+    auto& vec_meta = schema[FieldName("fvec")];
+    auto& json_meta = schema[FieldName("json")];
+
+    using Ref = std::function<bool(const nlohmann::json&)>;
+    auto int_ref = [](std::function<bool(int64_t)> cmp) -> Ref {
+        return [cmp](const nlohmann::json& v) { return v.is_number_integer() && cmp(v.get<int64_t>()); };
+    };
+    std::vector<std::tuple<proto::plan::Expr*, std::vector<std::string>, Ref>> testcases{
+        {GenUnaryRangeExpr<int64_t>(json_meta, {"int"}, proto::plan::OpType::GreaterThan, 1000), {"int"},
+         int_ref([](int64_t v) { return v > 1000; })},
+        {GenUnaryRangeExpr<int64_t>(json_meta, {"int"}, proto::plan::OpType::LessEqual, 500), {"int"},
+         int_ref([](int64_t v) { return v <= 500; })},
+        {GenUnaryRangeExpr<int64_t>(json_meta, {"nested", "int"}, proto::plan::OpType::Equal, 1500),
+         {"nested", "int"}, int_ref([](int64_t v) { return v == 1500; })},
+        // rows holding a string or missing the key never match, even with NotEqual
+        {GenUnaryRangeExpr<int64_t>(json_meta, {"int"}, proto::plan::OpType::NotEqual, 1500), {"int"},
+         int_ref([](int64_t v) { return v != 1500; })},
+        // float values are compared numerically with integers in the documents
+        {GenUnaryRangeExpr<double>(json_meta, {"double"}, proto::plan::OpType::GreaterEqual, 1000.5), {"double"},
+         [](const nlohmann::json& v) { return v.is_number() && v.get<double>() >= 1000.5; }},
+        {GenUnaryRangeExpr<double>(json_meta, {"int"}, proto::plan::OpType::LessThan, 100.5), {"int"},
+         [](const nlohmann::json& v) { return v.is_number() && v.get<double>() < 100.5; }},
+        {GenUnaryRangeExpr<bool>(json_meta, {"bool"}, proto::plan::OpType::Equal, true), {"bool"},
+         [](const nlohmann::json& v) { return v.is_boolean() && v.get<bool>(); }},
+        {GenUnaryRangeExpr<std::string>(json_meta, {"string"}, proto::plan::OpType::PrefixMatch, "1"), {"string"},
+         [](const nlohmann::json& v) { return v.is_string() && v.get<std::string>().rfind("1", 0) == 0; }},
+        {GenUnaryRangeExpr<std::string>(json_meta, {"int"}, proto::plan::OpType::GreaterThan, "5"), {"int"},
+         [](const nlohmann::json& v) { return v.is_string() && v.get<std::string>() > "5"; }},
+        {GenUnaryRangeExpr<int64_t>(json_meta, {"not_exist"}, proto::plan::OpType::GreaterEqual, 0), {"not_exist"},
+         [](const nlohmann::json& v) { return false; }},
+    };
+
+    auto growing = dynamic_cast<SegmentGrowingImpl*>(segment.growing.get());
+    ExecExprVisitor visitor(*growing, growing->get_row_count(), MAX_TIMESTAMP);
+    for (auto& [expr, nested_path, ref_func] : testcases) {
+        auto plan_proto = GenPlan(expr, vec_meta);
+        auto plan = ProtoParser(schema).CreatePlan(*plan_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        ASSERT_EQ(final.size(), segment.docs.size());
+        for (int i = 0; i < segment.docs.size(); ++i) {
+            auto value = Lookup(segment.docs[i], nested_path);
+            auto ref = value != nullptr && ref_func(*value);
+            ASSERT_EQ(final[i], ref) << plan_proto->DebugString() << "@" << i << "!!" << segment.docs[i].dump();
+        }
+    }
+}
+
+TEST(JsonExpr, BinaryRange) {
+    int64_t N = 1000;
+    auto segment = GenJsonSegment(N, 10);
+    auto& schema = *segment.schema;
+    auto& vec_meta = schema[FieldName("fvec")];
+    auto& json_meta = schema[FieldName("json")];
+
+    auto gen_expr = [&](const std::vector<std::string>& nested_path, bool lower_inclusive, bool upper_inclusive,
+                        auto lower, auto upper) {
+        auto binary_range_expr = new proto::plan::BinaryRangeExpr();
+        binary_range_expr->set_allocated_column_info(GenJsonColumnInfo(json_meta, nested_path));
+        binary_range_expr->set_lower_inclusive(lower_inclusive);
+        binary_range_expr->set_upper_inclusive(upper_inclusive);
+        SetGenericValue(binary_range_expr->mutable_lower_value(), lower);
+        SetGenericValue(binary_range_expr->mutable_upper_value(), upper);
+        auto expr = new proto::plan::Expr();
+        expr->set_allocated_binary_range_expr(binary_range_expr);
+        return expr;
+    };
+
+    using Ref = std::function<bool(const nlohmann::json&)>;
+    std::vector<std::tuple<proto::plan::Expr*, std::vector<std::string>, Ref>> testcases{
+        {gen_expr({"int"}, true, false, int64_t(500), int64_t(1500)), {"int"},
+         [](const nlohmann::json& v) {
+             return v.is_number_integer() && 500 <= v.get<int64_t>() && v.get<int64_t>() < 1500;
+         }},
+        {gen_expr({"nested", "int"}, false, true, int64_t(500), int64_t(1500)), {"nested", "int"},
+         [](const nlohmann::json& v) {
+             return v.is_number_integer() && 500 < v.get<int64_t>() && v.get<int64_t>() <= 1500;
+         }},
+        // mixed integer and float bounds
+        {gen_expr({"double"}, true, true, int64_t(500), 1500.5), {"double"},
+         [](const nlohmann::json& v) {
+             return v.is_number() && 500 <= v.get<double>() && v.get<double>() <= 1500.5;
+         }},
+        {gen_expr({"string"}, true, true, std::string("1"), std::string("5")), {"string"},
+         [](const nlohmann::json& v) {
+             return v.is_string() && "1" <= v.get<std::string>() && v.get<std::string>() <= "5";
+         }},
+    };
+
+    auto growing = dynamic_cast<SegmentGrowingImpl*>(segment.growing.get());
+    ExecExprVisitor visitor(*growing, growing->get_row_count(), MAX_TIMESTAMP);
+    for (auto& [expr, nested_path, ref_func] : testcases) {
+        auto plan_proto = GenPlan(expr, vec_meta);
+        auto plan = ProtoParser(schema).CreatePlan(*plan_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        ASSERT_EQ(final.size(), segment.docs.size());
+        for (int i = 0; i < segment.docs.size(); ++i) {
+            auto value = Lookup(segment.docs[i], nested_path);
+            auto ref = value != nullptr && ref_func(*value);
+            ASSERT_EQ(final[i], ref) << plan_proto->DebugString() << "@" << i << "!!" << segment.docs[i].dump();
+        }
+    }
+}
+
+TEST(JsonExpr, Term) {
+    int64_t N = 1000;
+    auto segment = GenJsonSegment(N, 10);
+    auto& schema = *segment.schema;
+    auto& vec_meta = schema[FieldName("fvec")];
+    auto& json_meta = schema[FieldName("json")];
+
+    // terms of different types, the documents hold either an integer or a string at "int"
+    auto term_expr = new proto::plan::TermExpr();
+    term_expr->set_allocated_column_info(GenJsonColumnInfo(json_meta, {"int"}));
+    std::set<int64_t> int_terms;
+    std::set<std::string> str_terms;
+    for (int64_t v = 0; v < 2 * N; v += 3) {
+        SetGenericValue(term_expr->add_values(), v);
+        int_terms.insert(v);
+        SetGenericValue(term_expr->add_values(), std::to_string(v + 1));
+        str_terms.insert(std::to_string(v + 1));
+    }
+    SetGenericValue(term_expr->add_values(), 7.0);
+    int_terms.insert(7);
+    auto expr = new proto::plan::Expr();
+    expr->set_allocated_term_expr(term_expr);
+
+    auto plan_proto = GenPlan(expr, vec_meta);
+    auto plan = ProtoParser(schema).CreatePlan(*plan_proto);
+    auto growing = dynamic_cast<SegmentGrowingImpl*>(segment.growing.get());
+    ExecExprVisitor visitor(*growing, growing->get_row_count(), MAX_TIMESTAMP);
+    auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+    ASSERT_EQ(final.size(), segment.docs.size());
+    int matched = 0;
+    for (int i = 0; i < segment.docs.size(); ++i) {
+        auto& value = segment.docs[i]["int"];
+        auto ref = value.is_number_integer() ? int_terms.count(value.get<int64_t>()) > 0
+                                             : str_terms.count(value.get<std::string>()) > 0;
+        ASSERT_EQ(final[i], ref) << "@" << i << "!!" << segment.docs[i].dump();
+        matched += ref;
+    }
+    ASSERT_GT(matched, 0);
+}
+
+TEST(JsonExpr, Sealed) {
+    int64_t N = 1000;
+    auto segment = GenJsonSegment(N, 1);
+    auto& schema = *segment.schema;
+    auto& vec_meta = schema[FieldName("fvec")];
+    auto& json_meta = schema[FieldName("json")];
+
+    auto expr = GenUnaryRangeExpr<int64_t>(json_meta, {"nested", "int"}, proto::plan::OpType::GreaterThan, 1000);
+    auto plan_proto = GenPlan(expr, vec_meta);
+    auto plan = ProtoParser(schema).CreatePlan(*plan_proto);
+    auto sealed = dynamic_cast<SegmentSealedImpl*>(segment.sealed.get());
+    ExecExprVisitor visitor(*sealed, sealed->get_row_count(), MAX_TIMESTAMP);
+    auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+    ASSERT_EQ(final.size(), N);
+    for (int i = 0; i < N; ++i) {
+        auto value = Lookup(segment.docs[i], {"nested", "int"});
+        auto ref = value != nullptr && value->get<int64_t>() > 1000;
+        ASSERT_EQ(final[i], ref) << "@" << i << "!!" << segment.docs[i].dump();
+    }
+
+    // the documents are returned as they were inserted
+    auto pk_fid = schema.get_primary_field_id().value();
+    std::vector<int64_t> pks{0, 1, N / 2, N - 1};
+    for (auto seg : std::vector<SegmentInterface*>{sealed, segment.growing.get()}) {
+        auto retrieve_plan = std::make_unique<RetrievePlan>(schema);
+        retrieve_plan->plan_node_ = std::make_unique<RetrievePlanNode>();
+        retrieve_plan->plan_node_->predicate_ = std::make_unique<TermExprImpl<int64_t>>(pk_fid, DataType::INT64, pks);
+        retrieve_plan->field_ids_ = {pk_fid, json_meta.get_id()};
+        auto retrieve_results = seg->Retrieve(retrieve_plan.get(), MAX_TIMESTAMP);
+        ASSERT_EQ(retrieve_results->fields_data_size(), 2);
+        auto& pk_data = retrieve_results->fields_data(0).scalars().long_data();
+        auto& json_data = retrieve_results->fields_data(1);
+        ASSERT_EQ(json_data.type(), JsonProtoType);
+        ASSERT_EQ(json_data.scalars().bytes_data().data_size(), pks.size());
+        for (int i = 0; i < pks.size(); ++i) {
+            // the int64 column generated by DataGen is the offset of the row
+            auto offset = pk_data.data(i);
+            ASSERT_EQ(nlohmann::json::parse(json_data.scalars().bytes_data().data(i)), segment.docs[offset]);
+        }
+    }
+}
+
+TEST(JsonExpr, DynamicField) {
+    // the undeclared fields of a dynamic schema are kept as the keys of the hidden "$meta" JSON field,
+    // the proxy folds them into one object per row and the parser filters on them by nested path.
+    int64_t N = 1000;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fvec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto pk = schema->AddDebugField("int64", DataType::INT64);
+    auto meta_fid = schema->AddDebugField("$meta", DataType::JSON);
+    schema->set_primary_field_id(pk);
+    auto& vec_meta = (*schema)[vec_fid];
+    auto& meta = (*schema)[meta_fid];
+
+    std::vector<std::string> colors{"red", "blue", "black"};
+    std::vector<nlohmann::json> rows;
+    auto raw_data = DataGen(schema, N);
+    for (auto& field_data : *raw_data.raw_->mutable_fields_data()) {
+        if (field_data.field_id() != meta_fid.get()) {
+            continue;
+        }
+        auto docs = field_data.mutable_scalars()->mutable_bytes_data()->mutable_data();
+        for (int i = 0; i < N; ++i) {
+            nlohmann::json row = nlohmann::json::object();
+            row["color"] = colors[i % colors.size()];
+            // rows inserted without some undeclared fields
+            if (i % 3 != 0) {
+                row["size"] = i % 20;
+            }
+            if (i % 5 == 0) {
+                row["tags"]["weight"] = i / 5 + 0.5;
+            }
+            (*docs)[i] = row.dump();
+            rows.push_back(row);
+        }
+    }
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+
+    auto term_expr = new proto::plan::TermExpr();
+    term_expr->set_allocated_column_info(GenJsonColumnInfo(meta, {"color"}));
+    SetGenericValue(term_expr->add_values(), std::string("red"));
+    SetGenericValue(term_expr->add_values(), std::string("blue"));
+    auto term = new proto::plan::Expr();
+    term->set_allocated_term_expr(term_expr);
+
+    auto binary_range_expr = new proto::plan::BinaryRangeExpr();
+    binary_range_expr->set_allocated_column_info(GenJsonColumnInfo(meta, {"size"}));
+    binary_range_expr->set_lower_inclusive(false);
+    binary_range_expr->set_upper_inclusive(false);
+    SetGenericValue(binary_range_expr->mutable_lower_value(), int64_t(1));
+    SetGenericValue(binary_range_expr->mutable_upper_value(), 10.5);
+    auto binary_range = new proto::plan::Expr();
+    binary_range->set_allocated_binary_range_expr(binary_range_expr);
+
+    using Ref = std::function<bool(const nlohmann::json&)>;
+    std::vector<std::tuple<proto::plan::Expr*, Ref>> testcases{
+        // size > 10
+        {GenUnaryRangeExpr<int64_t>(meta, {"size"}, proto::plan::OpType::GreaterThan, 10),
+         [](const nlohmann::json& row) { return row.contains("size") && row["size"].get<int64_t>() > 10; }},
+        // size != 5, rows without size never match
+        {GenUnaryRangeExpr<int64_t>(meta, {"size"}, proto::plan::OpType::NotEqual, 5),
+         [](const nlohmann::json& row) { return row.contains("size") && row["size"].get<int64_t>() != 5; }},
+        // color in ["red", "blue"]
+        {term, [](const nlohmann::json& row) { return row["color"] == "red" || row["color"] == "blue"; }},
+        // color like "bl%"
+        {GenUnaryRangeExpr<std::string>(meta, {"color"}, proto::plan::OpType::PrefixMatch, "bl"),
+         [](const nlohmann::json& row) { return row["color"].get<std::string>().rfind("bl", 0) == 0; }},
+        // 1 < size < 10.5
+        {binary_range,
+         [](const nlohmann::json& row) {
+             return row.contains("size") && 1 < row["size"].get<int64_t>() && row["size"].get<int64_t>() < 10.5;
+         }},
+        // tags["weight"] >= 100
+        {GenUnaryRangeExpr<double>(meta, {"tags", "weight"}, proto::plan::OpType::GreaterEqual, 100.0),
+         [](const nlohmann::json& row) { return row.contains("tags") && row["tags"]["weight"].get<double>() >= 100; }},
+    };
+
+    auto segment = dynamic_cast<SegmentGrowingImpl*>(growing.get());
+    ExecExprVisitor visitor(*segment, segment->get_row_count(), MAX_TIMESTAMP);
+    for (auto& [expr, ref_func] : testcases) {
+        auto plan_proto = GenPlan(expr, vec_meta);
+        auto plan = ProtoParser(*schema).CreatePlan(*plan_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        ASSERT_EQ(final.size(), N);
+        int matched = 0;
+        for (int i = 0; i < N; ++i) {
+            ASSERT_EQ(final[i], ref_func(rows[i])) << plan_proto->DebugString() << "@" << i << "!!" << rows[i].dump();
+            matched += final[i];
+        }
+        ASSERT_GT(matched, 0) << plan_proto->DebugString();
+    }
+}
@@ -810,3 +810,137 @@ TEST(Query, ExecWithPredicateBinary) {
     std::cout << json.dump(2);
     // ASSERT_EQ(json.dump(2), ref.dump(2));
 }
+
+TEST(Query, RangeSearch) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("counter", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+    int64_t N = 10000;
+    int64_t dim = 16;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateGrowingSegment(schema);
+    segment->PreInsert(N);
+    segment->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    // the queries are the first rows of the segment
+    auto num_queries = 5;
+    auto vecs = dataset.get_col<float>(vec_fid);
+    auto ph_group_raw = CreatePlaceholderGroupFromBlob(num_queries, dim, vecs.data());
+    auto distance = [&](int64_t query, int64_t row) {
+        float dis = 0;
+        for (int64_t d = 0; d < dim; ++d) {
+            auto diff = vecs[query * dim + d] - vecs[row * dim + d];
+            dis += diff * diff;
+        }
+        return dis;
+    };
+
+    auto search = [&](int64_t limit, float radius, float range_filter) {
+        auto dsl = json::parse(R"({
+            "bool": {
+                "must": [
+                {
+                    "vector": {
+                        "fakevec": {
+                            "metric_type": "L2",
+                            "params": {},
+                            "query": "$0",
+                            "round_decimal": -1
+                        }
+                    }
+                }
+                ]
+            }
+        })");
+        auto& vec_info = dsl["bool"]["must"][0]["vector"]["fakevec"];
+        vec_info["topk"] = limit;
+        vec_info["params"]["nprobe"] = 10;
+        vec_info["params"][RADIUS] = radius;
+        // the bounds may be numeric strings
+        vec_info["params"][RANGE_FILTER] = std::to_string(range_filter);
+        auto plan = CreatePlan(*schema, dsl.dump());
+        auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+        return segment->Search(plan.get(), ph_group.get(), 1000000);
+    };
+
+    // about one tenth of the rows are in the range
+    const float radius = 20;
+    const float range_filter = 1;
+    const float eps = 1e-3;
+    const int64_t limit = 5000;
+    auto sr = search(limit, radius, range_filter);
+    // the result is as wide as the most hits of a query rather than the limit
+    auto width = sr->unity_topK_;
+    ASSERT_LT(width, limit);
+    for (int64_t q = 0; q < num_queries; ++q) {
+        // the rows surely in or out of the range, those on the bounds may go either way
+        int64_t min_hits = 0, max_hits = 0;
+        for (int64_t row = 0; row < N; ++row) {
+            auto dis = distance(q, row);
+            min_hits += dis >= range_filter + eps && dis < radius - eps;
+            max_hits += dis >= range_filter - eps && dis < radius + eps;
+        }
+        // much more hits than a usual top k
+        ASSERT_GT(min_hits, 100);
+        ASSERT_LT(max_hits, limit);
+
+        int64_t hits = 0;
+        for (int64_t k = 0; k < width; ++k) {
+            auto offset = sr->seg_offsets_[q * width + k];
+            if (offset == INVALID_SEG_OFFSET) {
+                continue;
+            }
+            auto dis = sr->distances_[q * width + k];
+            ASSERT_GE(dis, range_filter);
+            ASSERT_LT(dis, radius);
+            ASSERT_NEAR(dis, distance(q, offset), eps);
+            hits++;
+        }
+        ASSERT_GE(hits, min_hits);
+        ASSERT_LE(hits, max_hits);
+        // the query itself is filtered out by range_filter
+        for (int64_t k = 0; k < width; ++k) {
+            ASSERT_NE(sr->seg_offsets_[q * width + k], q);
+        }
+    }
+
+    // the hits are bounded by the limit, they are the nearest ones out of the range filter
+    const int64_t small_limit = 50;
+    sr = search(small_limit, radius, range_filter);
+    ASSERT_EQ(sr->unity_topK_, small_limit);
+    for (int64_t q = 0; q < num_queries; ++q) {
+        int64_t nearer = 0;
+        auto farthest = sr->distances_[q * small_limit + small_limit - 1];
+        for (int64_t row = 0; row < N; ++row) {
+            auto dis = distance(q, row);
+            nearer += dis >= range_filter + eps && dis < farthest - eps;
+        }
+        ASSERT_LT(nearer, small_limit);
+        for (int64_t k = 0; k < small_limit; ++k) {
+            ASSERT_NE(sr->seg_offsets_[q * small_limit + k], INVALID_SEG_OFFSET);
+            ASSERT_GE(sr->distances_[q * small_limit + k], range_filter);
+            ASSERT_LT(sr->distances_[q * small_limit + k], radius);
+        }
+    }
+
+    // the hits more than the initial k of a range search are all found with a range filter excluding the nearest
+    // ones, since the search keeps going until the range is exhausted
+    const float wide_range_filter = 10;
+    sr = search(limit, radius, wide_range_filter);
+    width = sr->unity_topK_;
+    for (int64_t q = 0; q < num_queries; ++q) {
+        int64_t min_hits = 0;
+        for (int64_t row = 0; row < N; ++row) {
+            auto dis = distance(q, row);
+            min_hits += dis >= wide_range_filter + eps && dis < radius - eps;
+        }
+        int64_t hits = 0;
+        for (int64_t k = 0; k < width; ++k) {
+            hits += sr->seg_offsets_[q * width + k] != INVALID_SEG_OFFSET;
+        }
+        ASSERT_GE(hits, min_hits);
+    }
+}
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <gtest/gtest.h>
+#include <algorithm>
 
 #include "query/ExprImpl.h"
 #include "segcore/ScalarIndex.h"
@@ -378,3 +379,88 @@ TEST(Retrieve, Delete) {
         ASSERT_EQ(field2_data.data_size(), DIM * size);
     }
 }
+
+TEST(Retrieve, Limit) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, knowhere::metric::L2);
+    schema->set_primary_field_id(fid_64);
+
+    int64_t N = 100;
+    auto dataset = DataGen(schema, N);
+    // the primary keys are inserted in descending order
+    for (auto& field_data : *dataset.raw_->mutable_fields_data()) {
+        if (field_data.field_id() == fid_64.get()) {
+            auto pks = field_data.mutable_scalars()->mutable_long_data()->mutable_data();
+            std::reverse(pks->begin(), pks->end());
+        }
+    }
+    auto segment = CreateSealedSegment(schema);
+    SealedLoadFieldData(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(fid_64);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    std::vector<int64_t> values(i64_col.begin(), i64_col.begin() + N / 2);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>(fid_64, DataType::INT64, values);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_ids_ = std::vector<FieldId>{fid_64};
+    std::sort(values.begin(), values.end());
+
+    for (int64_t limit : {int64_t(1), int64_t(10), N}) {
+        plan->plan_node_->limit_ = limit;
+        auto retrieve_results = segment->Retrieve(plan.get(), 100);
+        auto size = std::min(limit, int64_t(values.size()));
+        ASSERT_EQ(retrieve_results->offset_size(), size);
+        auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+        ASSERT_EQ(field0_data.data_size(), size);
+        // the entities with the smallest primary keys are retrieved in the order of primary keys
+        for (int i = 0; i < size; ++i) {
+            ASSERT_EQ(field0_data.data(i), values[i]);
+            ASSERT_EQ(i64_col[retrieve_results->offset(i)], values[i]);
+        }
+    }
+}
+
+TEST(Retrieve, LimitWithDuplicatedPK) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, knowhere::metric::L2);
+    schema->set_primary_field_id(fid_64);
+
+    int64_t N = 100;
+    // every primary key is inserted twice, the second version has the larger timestamp
+    auto dataset = DataGen(schema, N, 42, 0, 2);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoadFieldData(dataset, *segment);
+
+    int64_t limit = 10;
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->limit_ = limit;
+    plan->field_ids_ = std::vector<FieldId>{fid_64};
+
+    // the pages continue from the last primary key of the previous page like query iterators,
+    // every page is full until all the primary keys are retrieved
+    int64_t cursor = -1;
+    int64_t expected = 0;
+    for (int64_t page = 0; page <= N / 2 / limit; ++page) {
+        plan->plan_node_->predicate_ = std::make_unique<query::UnaryRangeExprImpl<int64_t>>(
+            fid_64, DataType::INT64, proto::plan::OpType::GreaterThan, cursor);
+        auto retrieve_results = segment->Retrieve(plan.get(), N);
+        auto size = page < N / 2 / limit ? limit : 0;
+        ASSERT_EQ(retrieve_results->offset_size(), size);
+        auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+        ASSERT_EQ(field0_data.data_size(), size);
+        for (int i = 0; i < size; ++i) {
+            ASSERT_EQ(field0_data.data(i), expected++);
+            // the latest version of the primary key is kept
+            ASSERT_EQ(retrieve_results->offset(i), 2 * field0_data.data(i) + 1);
+        }
+        if (size > 0) {
+            cursor = field0_data.data(size - 1);
+        }
+    }
+}
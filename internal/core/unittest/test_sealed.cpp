@@ -851,3 +851,31 @@ TEST(Sealed, RealCount) {
     ASSERT_TRUE(status.ok());
     ASSERT_EQ(0, segment->get_real_count());
 }
+
+TEST(Sealed, DeleteWithSameTimestamp) {
+    auto schema = std::make_shared<Schema>();
+    auto pk = schema->AddDebugField("pk", DataType::INT64);
+    schema->set_primary_field_id(pk);
+    auto segment = CreateSealedSegment(schema);
+
+    int64_t c = 10;
+    auto dataset = DataGen(schema, c);
+    auto pks = dataset.get_col<int64_t>(pk);
+    SealedLoadFieldData(dataset, *segment);
+
+    // upsert writes the delete and the insert with the same timestamp, the insert is not deleted.
+    auto half = c / 2;
+    auto del_offset1 = segment->PreDelete(half);
+    auto del_ids = GenPKs(pks.begin(), pks.begin() + half);
+    auto del_tss1 = GenTss(half, dataset.timestamps_[0]);
+    auto status = segment->Delete(del_offset1, half, del_ids.get(), del_tss1.data());
+    ASSERT_TRUE(status.ok());
+    ASSERT_EQ(c, segment->get_real_count());
+
+    // a delete with a larger timestamp deletes the insert.
+    auto del_offset2 = segment->PreDelete(half);
+    auto del_tss2 = GenTss(half, dataset.timestamps_[0] + 1);
+    status = segment->Delete(del_offset2, half, del_ids.get(), del_tss2.data());
+    ASSERT_TRUE(status.ok());
+    ASSERT_EQ(c - half, segment->get_real_count());
+}
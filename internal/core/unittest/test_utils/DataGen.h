@@ -18,6 +18,7 @@
 #include <google/protobuf/text_format.h>
 
 #include "Constants.h"
+#include "common/Json.h"
 #include "common/Schema.h"
 #include "index/ScalarIndexSort.h"
 #include "index/StringIndexSort.h"
@@ -104,6 +105,13 @@ struct GeneratedData {
 
                     break;
                 }
+                case DataType::ARRAY:
+                case DataType::JSON: {
+                    auto ret_data = reinterpret_cast<std::string*>(ret.data());
+                    auto src_data = target_field_data.scalars().bytes_data().data();
+                    std::copy(src_data.begin(), src_data.end(), ret_data);
+                    break;
+                }
                 default: {
                     PanicInfo("unsupported");
                 }
@@ -241,6 +249,69 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0,
                 insert_cols(data, N, field_meta);
                 break;
             }
+            case DataType::JSON: {
+                // every tenth document misses the nested object and holds a string where the others hold numbers
+                vector<std::string> data(N);
+                for (int i = 0; i < N / repeat_count; i++) {
+                    auto value = static_cast<int64_t>(er() % (2 * N));
+                    nlohmann::json doc;
+                    if (i % 10 == 0) {
+                        doc["int"] = std::to_string(value);
+                    } else {
+                        doc["int"] = value;
+                        doc["double"] = value + 0.5;
+                        doc["nested"]["int"] = value;
+                    }
+                    doc["string"] = std::to_string(value);
+                    doc["bool"] = value % 2 == 0;
+                    for (int j = 0; j < repeat_count; j++) {
+                        data[i * repeat_count + j] = doc.dump();
+                    }
+                }
+                insert_cols(data, N, field_meta);
+                break;
+            }
+            case DataType::ARRAY: {
+                // up to 4 elements drawn from [0, 10), so that arrays overlap often
+                vector<std::string> data(N);
+                for (int i = 0; i < N / repeat_count; i++) {
+                    proto::schema::ScalarField array;
+                    auto length = er() % 5;
+                    for (int k = 0; k < length; k++) {
+                        auto value = static_cast<int64_t>(er() % 10);
+                        switch (field_meta.get_element_type()) {
+                            case DataType::BOOL:
+                                array.mutable_bool_data()->add_data(value % 2 == 0);
+                                break;
+                            case DataType::INT8:
+                            case DataType::INT16:
+                            case DataType::INT32:
+                                array.mutable_int_data()->add_data(value);
+                                break;
+                            case DataType::INT64:
+                                array.mutable_long_data()->add_data(value);
+                                break;
+                            case DataType::FLOAT:
+                                array.mutable_float_data()->add_data(value + 0.5);
+                                break;
+                            case DataType::DOUBLE:
+                                array.mutable_double_data()->add_data(value + 0.5);
+                                break;
+                            case DataType::VARCHAR:
+                                array.mutable_string_data()->add_data(std::to_string(value));
+                                break;
+                            default:
+                                throw std::runtime_error("unimplemented");
+                        }
+                    }
+                    auto serialized = array.SerializeAsString();
+                    for (int j = 0; j < repeat_count; j++) {
+                        data[i * repeat_count + j] = serialized;
+                    }
+                }
+                insert_cols(data, N, field_meta);
+                break;
+            }
             default: {
                 throw std::runtime_error("unimplemented");
             }
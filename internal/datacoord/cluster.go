@@ -120,6 +120,11 @@ func (c *Cluster) Import(ctx context.Context, nodeID int64, it *datapb.ImportTas
 	c.sessionManager.Import(ctx, nodeID, it)
 }
 
+// ExportSegment sends export segment requests to DataNodes whose ID==nodeID.
+func (c *Cluster) ExportSegment(ctx context.Context, nodeID int64, req *datapb.ExportSegmentRequest) error {
+	return c.sessionManager.ExportSegment(ctx, nodeID, req)
+}
+
 // ReCollectSegmentStats triggers a ReCollectSegmentStats call from session manager.
 func (c *Cluster) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	c.sessionManager.ReCollectSegmentStats(ctx, nodeID)
@@ -232,14 +232,22 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+	switch c.plans[planID].plan.GetType() {
+	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
 		c.flushCh <- result.GetSegmentID()
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, segment := range result.GetSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
@@ -284,6 +292,48 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	oldSegments, modSegments, newSegments, metricMutation, err := c.meta.PrepareCompleteClusteringCompactionMutation(plan.GetSegmentBinlogs(), result)
+	if err != nil {
+		return err
+	}
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+
+	log.Info("handleCompactionResult: altering metastore after clustering compaction", zap.Int("result segments", len(newSegments)))
+	if err := c.meta.alterMetaStoreAfterCompactionWithSegments(modSegments, newSegments); err != nil {
+		log.Warn("handleCompactionResult: fail to alter metastore after clustering compaction", zap.Error(err))
+		return fmt.Errorf("fail to alter metastore after compaction, err=%w", err)
+	}
+
+	var nodeID = c.plans[plan.GetPlanID()].dataNodeID
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:        plan.PlanID,
+		CompactedTo:   newSegments[0].GetID(),
+		CompactedFrom: newSegments[0].GetCompactionFrom(),
+		NumOfRows:     newSegments[0].GetNumOfRows(),
+		StatsLogs:     newSegments[0].GetStatslogs(),
+	}
+	for _, segment := range newSegments {
+		req.CompactedToSegments = append(req.CompactedToSegments, &datapb.CompactionSegment{
+			SegmentID:           segment.GetID(),
+			NumOfRows:           segment.GetNumOfRows(),
+			Field2StatslogPaths: segment.GetStatslogs(),
+		})
+	}
+
+	log.Info("handleCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("handleCompactionResult: fail to sync segments with node, reverting metastore",
+			zap.Int64("nodeID", nodeID), zap.String("reason", err.Error()))
+		return c.meta.revertAlterMetaStoreAfterCompactionWithSegments(oldSegments, newSegments)
+	}
+	// Apply metrics after successful meta update.
+	metricMutation.commit()
+
+	log.Info("handleCompactionResult: success to handle clustering compaction result")
+	return nil
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
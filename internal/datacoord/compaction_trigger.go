@@ -24,14 +24,17 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
@@ -72,6 +75,7 @@ type compactionTrigger struct {
 	signals                      chan *compactionSignal
 	compactionHandler            compactionPlanContext
 	globalTrigger                *time.Ticker
+	expiredTrigger               *time.Ticker
 	forceMu                      sync.Mutex
 	quit                         chan struct{}
 	wg                           sync.WaitGroup
@@ -108,7 +112,8 @@ func newCompactionTrigger(
 func (t *compactionTrigger) start() {
 	t.quit = make(chan struct{})
 	t.globalTrigger = time.NewTicker(Params.DataCoordCfg.GlobalCompactionInterval.GetAsDuration(time.Second))
-	t.wg.Add(2)
+	t.expiredTrigger = time.NewTicker(Params.DataCoordCfg.ExpiredCompactionCheckInterval.GetAsDuration(time.Second))
+	t.wg.Add(3)
 	go func() {
 		defer logutil.LogPanic()
 		defer t.wg.Done()
@@ -132,6 +137,7 @@ func (t *compactionTrigger) start() {
 	}()
 
 	go t.startGlobalCompactionLoop()
+	go t.startExpiredCompactionLoop()
 }
 
 func (t *compactionTrigger) startGlobalCompactionLoop() {
@@ -158,6 +164,79 @@ func (t *compactionTrigger) startGlobalCompactionLoop() {
 	}
 }
 
+// startExpiredCompactionLoop periodically triggers single compactions for segments holding too many ttl expired entities,
+// so that expired entities are cleaned up even if the segments are not touched by other compactions.
+func (t *compactionTrigger) startExpiredCompactionLoop() {
+	defer logutil.LogPanic()
+	defer t.wg.Done()
+
+	// If AutoCompaction disabled, expired loop will not start
+	if !Params.DataCoordCfg.EnableAutoCompaction.GetAsBool() {
+		return
+	}
+
+	for {
+		select {
+		case <-t.quit:
+			t.expiredTrigger.Stop()
+			log.Info("expired compaction loop exit")
+			return
+		case <-t.expiredTrigger.C:
+			t.triggerExpiredCompaction()
+		}
+	}
+}
+
+// triggerExpiredCompaction triggers a single compaction for each segment whose expired entities ratio
+// exceeds the threshold.
+func (t *compactionTrigger) triggerExpiredCompaction() {
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			isFlush(segment) &&
+			!segment.isCompacting && // not compacting now
+			!segment.GetIsImporting() // not importing now
+	})
+	if len(segments) == 0 {
+		return
+	}
+
+	ts, err := t.allocTs()
+	if err != nil {
+		log.Warn("allocate ts failed, skip to check expired segments", zap.Error(err))
+		return
+	}
+
+	compactTimes := make(map[UniqueID]*compactTime)
+	for _, segment := range segments {
+		ct, ok := compactTimes[segment.GetCollectionID()]
+		if !ok {
+			ct, err = t.getCompactTime(ts, segment.GetCollectionID())
+			if err != nil {
+				log.Warn("get compact time failed, skip to check expired segments",
+					zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+			}
+			compactTimes[segment.GetCollectionID()] = ct
+		}
+		if ct == nil || ct.expireTime == 0 || segment.GetNumOfRows() <= 0 {
+			continue
+		}
+
+		if !reachExpiredRatio(segment, ct.expireTime) {
+			continue
+		}
+		log.Info("segment has too many expired entities, trigger compaction",
+			zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int64("expired rows", estimateExpiredRows(segment, ct.expireTime)),
+			zap.Int64("total rows", segment.GetNumOfRows()))
+		err = t.triggerSingleCompaction(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), segment.GetInsertChannel())
+		if err != nil {
+			log.Warn("failed to trigger compaction for expired segment",
+				zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+	}
+}
+
 func (t *compactionTrigger) stop() {
 	close(t.quit)
 	t.wg.Wait()
@@ -382,7 +461,28 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			return
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		var plans []*datapb.CompactionPlan
+		clusteringKeyField, err := t.getClusteringKeyField(group.collectionID)
+		if err != nil {
+			log.Warn("get clustering key failed, skip to handle compaction",
+				zap.Int64("collectionID", group.collectionID),
+				zap.Error(err))
+			return
+		}
+		if signal.isForce && clusteringKeyField != nil {
+			// manual compaction of a collection with clustering key redistributes the rows by the key
+			plans, err = t.generateClusteringPlans(group.segments, clusteringKeyField.GetFieldID(), ct)
+			if err != nil {
+				log.Warn("failed to generate clustering plans, fall back to mix compaction",
+					zap.Int64("collectionID", group.collectionID),
+					zap.Int64("partitionID", group.partitionID),
+					zap.String("channel", group.channelName),
+					zap.Error(err))
+				plans = t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+			}
+		} else {
+			plans = t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		}
 		for _, plan := range plans {
 			segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
 
@@ -504,10 +604,18 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 	var prioritizedCandidates []*SegmentInfo
 	var smallCandidates []*SegmentInfo
 	var nonPlannedSegments []*SegmentInfo
+	var clusteredCandidates []*SegmentInfo
 
 	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
 	for _, segment := range segments {
 		segment := segment.ShadowClone()
+		if segment.GetIsClustered() {
+			// merging a clustered segment with others mixes up the clustering key ranges, so it's only compacted alone
+			if force || t.ShouldDoSingleCompaction(segment, isDiskIndex, compactTime) {
+				clusteredCandidates = append(clusteredCandidates, segment)
+			}
+			continue
+		}
 		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
 		if force || t.ShouldDoSingleCompaction(segment, isDiskIndex, compactTime) {
 			prioritizedCandidates = append(prioritizedCandidates, segment)
@@ -675,9 +783,243 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 			)
 		}
 	}
+	for _, segment := range clusteredCandidates {
+		plan := segmentsToPlan([]*SegmentInfo{segment}, compactTime)
+		log.Info("generate a plan for clustered segment",
+			zap.Int64("segment ID", segment.GetID()),
+			zap.Int64("target segment row", segment.GetNumOfRows()))
+		plans = append(plans, plan)
+	}
 	return plans
 }
 
+// getClusteringKeyField returns the clustering key field of the collection, nil if the collection has no clustering key
+func (t *compactionTrigger) getClusteringKeyField(collectionID UniqueID) (*schemapb.FieldSchema, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	coll, err := t.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("collection ID %d not found, err: %w", collectionID, err)
+	}
+	return typeutil.GetClusteringKeyField(coll.Schema), nil
+}
+
+// generateClusteringPlans generates clustering compaction plans, which sort the rows of the segments by the clustering key
+// and split them into segments of non-overlapping key ranges. The key ranges are planned across all the segments of the
+// channel and partition first, then every plan assigns the rows of its segments to the same ranges, so that the result
+// segments of different plans don't overlap with each other except in the same range.
+func (t *compactionTrigger) generateClusteringPlans(segments []*SegmentInfo, clusteringKeyField int64, compactTime *compactTime) ([]*datapb.CompactionPlan, error) {
+	candidates := make([]*segmentKeyRange, 0, len(segments))
+	for _, segment := range segments {
+		keyRange, err := t.getSegmentKeyRange(segment, clusteringKeyField)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, keyRange)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	maxSegmentRows := candidates[0].segment.GetMaxRowNum()
+	boundaries := planClusteringKeyBoundaries(candidates, maxSegmentRows)
+
+	// the segments of close key ranges are compacted together
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].min.EQ(candidates[j].min) {
+			return candidates[i].min.LT(candidates[j].min)
+		}
+		return candidates[i].segment.GetID() < candidates[j].segment.GetID()
+	})
+
+	var plans []*datapb.CompactionPlan
+	for _, bucket := range chunkClusteringCandidates(candidates) {
+		bucketSegments := lo.Map(bucket, func(keyRange *segmentKeyRange, _ int) *SegmentInfo { return keyRange.segment })
+		plan := segmentsToPlan(bucketSegments, compactTime)
+		plan.Type = datapb.CompactionType_ClusteringCompaction
+		plan.ClusteringKeyField = clusteringKeyField
+		plan.MaxSegmentRows = maxSegmentRows
+		plan.ClusteringKeyBoundaries = boundaries
+		log.Info("generate a clustering plan",
+			zap.Int64s("plan segment IDs", lo.Map(bucketSegments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })),
+			zap.Int64("clustering key field", clusteringKeyField),
+			zap.Int64("total rows", plan.GetTotalRows()),
+			zap.Int64("max segment rows", plan.GetMaxSegmentRows()),
+			zap.Int("key ranges", clusteringKeyRangeNum(boundaries)))
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// chunkClusteringCandidates splits the sorted candidates into the buckets of plans. DataNode sorts all the rows of a
+// plan in memory, so a bucket is bounded by both MaxSegmentToMerge and ClusteringCompactionMaxPlanSize, except that
+// a segment larger than the max plan size makes a bucket by itself.
+func chunkClusteringCandidates(candidates []*segmentKeyRange) [][]*segmentKeyRange {
+	maxNum := Params.DataCoordCfg.MaxSegmentToMerge.GetAsInt()
+	maxSize := Params.DataCoordCfg.ClusteringCompactionMaxPlanSize.GetAsInt64() * 1024 * 1024
+
+	var (
+		buckets    [][]*segmentKeyRange
+		bucket     []*segmentKeyRange
+		bucketSize int64
+	)
+	for _, candidate := range candidates {
+		size := candidate.segment.getSegmentSize()
+		if len(bucket) > 0 && (len(bucket) >= maxNum || bucketSize+size > maxSize) {
+			buckets = append(buckets, bucket)
+			bucket, bucketSize = nil, 0
+		}
+		bucket = append(bucket, candidate)
+		bucketSize += size
+	}
+	if len(bucket) > 0 {
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// segmentKeyRange is the clustering key range of a segment
+type segmentKeyRange struct {
+	segment  *SegmentInfo
+	min, max storage.PrimaryKey
+}
+
+// getSegmentKeyRange reads the min/max of the clustering key from the stats logs of the segment
+func (t *compactionTrigger) getSegmentKeyRange(segment *SegmentInfo, clusteringKeyField int64) (*segmentKeyRange, error) {
+	var paths []string
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		if fieldBinlog.GetFieldID() != clusteringKeyField {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no clustering key stats of segment %d", segment.GetID())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	values, err := t.meta.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read clustering key stats of segment %d, err: %w", segment.GetID(), err)
+	}
+	blobs := lo.Map(values, func(value []byte, _ int) *storage.Blob { return &storage.Blob{Value: value} })
+	fieldStats, err := storage.DeserializeFieldStats(blobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize clustering key stats of segment %d, err: %w", segment.GetID(), err)
+	}
+
+	merged := &storage.FieldStats{FieldID: clusteringKeyField}
+	for _, stats := range fieldStats {
+		merged.Merge(stats)
+	}
+	if merged.Min == nil || merged.Max == nil {
+		return nil, fmt.Errorf("empty clustering key stats of segment %d", segment.GetID())
+	}
+	return &segmentKeyRange{segment: segment, min: merged.Min, max: merged.Max}, nil
+}
+
+// planClusteringKeyBoundaries splits the clustering key ranges of the segments into ranges of about maxSegmentRows rows,
+// the rows of a segment are assumed to be evenly distributed in its key range. The ascending boundaries of the ranges
+// are returned, nil if all the rows fit in one range.
+func planClusteringKeyBoundaries(keyRanges []*segmentKeyRange, maxSegmentRows int64) *schemapb.ScalarField {
+	var totalRows int64
+	for _, keyRange := range keyRanges {
+		totalRows += keyRange.segment.GetNumOfRows()
+	}
+	if maxSegmentRows <= 0 || totalRows <= maxSegmentRows {
+		return nil
+	}
+	rangeNum := (totalRows + maxSegmentRows - 1) / maxSegmentRows
+
+	// rowsBelow estimates the number of rows whose keys are less than the key
+	rowsBelow := func(key storage.PrimaryKey) float64 {
+		var rows float64
+		for _, keyRange := range keyRanges {
+			numRows := float64(keyRange.segment.GetNumOfRows())
+			switch {
+			case key.LE(keyRange.min):
+			case key.GT(keyRange.max):
+				rows += numRows
+			default:
+				if minKey, ok := keyRange.min.(*storage.Int64PrimaryKey); ok {
+					maxKey := keyRange.max.(*storage.Int64PrimaryKey)
+					value := key.(*storage.Int64PrimaryKey)
+					rows += numRows * (float64(value.Value) - float64(minKey.Value)) / (float64(maxKey.Value) - float64(minKey.Value) + 1)
+				} else {
+					rows += numRows / 2
+				}
+			}
+		}
+		return rows
+	}
+
+	switch keyRanges[0].min.(type) {
+	case *storage.Int64PrimaryKey:
+		lower, upper := keyRanges[0].min.GetValue().(int64), keyRanges[0].max.GetValue().(int64)
+		for _, keyRange := range keyRanges {
+			if keyRange.min.GetValue().(int64) < lower {
+				lower = keyRange.min.GetValue().(int64)
+			}
+			if keyRange.max.GetValue().(int64) > upper {
+				upper = keyRange.max.GetValue().(int64)
+			}
+		}
+		var boundaries []int64
+		for i := int64(1); i < rangeNum; i++ {
+			target := float64(totalRows) * float64(i) / float64(rangeNum)
+			// the smallest key whose rows below reach the target
+			low, high := lower, upper
+			if len(boundaries) > 0 {
+				if boundaries[len(boundaries)-1] == upper {
+					break
+				}
+				low = boundaries[len(boundaries)-1] + 1
+			}
+			for low < high {
+				mid := low + int64((uint64(high)-uint64(low))/2)
+				if rowsBelow(storage.NewInt64PrimaryKey(mid)) >= target {
+					high = mid
+				} else {
+					low = mid + 1
+				}
+			}
+			if low > lower {
+				boundaries = append(boundaries, low)
+			}
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: boundaries}}}
+
+	default:
+		// the boundaries of strings are chosen from the min/max keys of the segments
+		keys := make([]string, 0, 2*len(keyRanges))
+		for _, keyRange := range keyRanges {
+			keys = append(keys, keyRange.min.GetValue().(string), keyRange.max.GetValue().(string))
+		}
+		sort.Strings(keys)
+		keys = lo.Uniq(keys)
+		var boundaries []string
+		next := 1
+		for i := int64(1); i < rangeNum; i++ {
+			target := float64(totalRows) * float64(i) / float64(rangeNum)
+			for next < len(keys) && rowsBelow(storage.NewVarCharPrimaryKey(keys[next])) < target {
+				next++
+			}
+			if next < len(keys) {
+				boundaries = append(boundaries, keys[next])
+				next++
+			}
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: boundaries}}}
+	}
+}
+
+// clusteringKeyRangeNum returns the number of key ranges split by the boundaries
+func clusteringKeyRangeNum(boundaries *schemapb.ScalarField) int {
+	return len(boundaries.GetLongData().GetData()) + len(boundaries.GetStringData().GetData()) + 1
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel:    compactTime.travelTime,
@@ -832,6 +1174,13 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 		return true
 	}
 
+	// keep consistent with the expired compaction trigger, otherwise the segments it picks never get a plan
+	if reachExpiredRatio(segment, compactTime.expireTime) {
+		log.Info("estimated expired entities is too much, trigger compaction", zap.Int64("segment", segment.ID),
+			zap.Int64("estimated expired rows", estimateExpiredRows(segment, compactTime.expireTime)))
+		return true
+	}
+
 	// single compaction only merge insert and delta log beyond the timetravel
 	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
 	// to ensure that all insert logs is beyond the timetravel.
@@ -861,6 +1210,40 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 	return false
 }
 
+// estimateExpiredRows estimates the number of entities inserted before expireTime in the segment.
+// Every field holds the same rows, so only the binlogs of one field are counted. Entities of a binlog partially
+// expired are estimated in proportion to the time range [TimestampFrom, TimestampTo] of the binlog.
+func estimateExpiredRows(segment *SegmentInfo, expireTime Timestamp) int64 {
+	if expireTime == 0 || len(segment.GetBinlogs()) == 0 {
+		return 0
+	}
+
+	expirePhysical, _ := tsoutil.ParseHybridTs(expireTime)
+	var expiredRows int64
+	for _, l := range segment.GetBinlogs()[0].GetBinlogs() {
+		switch {
+		case l.GetTimestampTo() < expireTime:
+			expiredRows += l.GetEntriesNum()
+		case l.GetTimestampFrom() < expireTime:
+			from, _ := tsoutil.ParseHybridTs(l.GetTimestampFrom())
+			to, _ := tsoutil.ParseHybridTs(l.GetTimestampTo())
+			if to > from {
+				expiredRows += l.GetEntriesNum() * (expirePhysical - from) / (to - from)
+			}
+		}
+	}
+	return expiredRows
+}
+
+// reachExpiredRatio returns whether the estimated expired entities of the segment reach SingleCompactionExpiredRatio.
+func reachExpiredRatio(segment *SegmentInfo, expireTime Timestamp) bool {
+	if segment.GetNumOfRows() <= 0 {
+		return false
+	}
+	expiredRows := estimateExpiredRows(segment, expireTime)
+	return float64(expiredRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionExpiredRatio.GetAsFloat()
+}
+
 func isFlush(segment *SegmentInfo) bool {
 	return segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing
 }
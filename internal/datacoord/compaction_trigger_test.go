@@ -17,8 +17,12 @@
 package datacoord
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"path"
 	"sort"
+	"strconv"
 	"testing"
 	"time"
 
@@ -30,7 +34,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type spyCompactionHandler struct {
@@ -1822,3 +1828,336 @@ func Test_getCompactTime(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ct)
 }
+
+func Test_compactionTrigger_clustering(t *testing.T) {
+	Params.Init()
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:    102,
+		Name:       "cluster",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKeyKey, Value: "true"}},
+	})
+	collections := map[UniqueID]*collectionInfo{
+		1: {ID: 1, Schema: schema, Partitions: []UniqueID{1}},
+		2: {ID: 2, Schema: newTestSchema(), Partitions: []UniqueID{1}},
+	}
+	m := &meta{segments: NewSegmentsInfo(), collections: collections}
+	tr := newCompactionTrigger(m, &compactionPlanHandler{}, newMockAllocator(),
+		&SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}}, nil, &ServerHandler{
+			&Server{
+				meta: m,
+			},
+		})
+
+	field, err := tr.getClusteringKeyField(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(102), field.GetFieldID())
+	field, err = tr.getClusteringKeyField(2)
+	assert.NoError(t, err)
+	assert.Nil(t, field)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	m.chunkManager = cm
+	// newSegment creates a segment whose clustering keys are in [min, max]
+	newSegment := func(id int64, numRows int64, min, max int64) *SegmentInfo {
+		statsWriter := &storage.StatsWriter{}
+		err := statsWriter.GenerateFieldStats(102, schemapb.DataType_Int64, &storage.Int64FieldData{Data: []int64{min, max}}, false)
+		require.NoError(t, err)
+		logPath := path.Join(cm.RootPath(), "stats_log", strconv.FormatInt(id, 10))
+		require.NoError(t, cm.Write(context.Background(), logPath, statsWriter.GetBuffer()))
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				PartitionID:   1,
+				NumOfRows:     numRows,
+				MaxRowNum:     300,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Flushed,
+				Statslogs:     []*datapb.FieldBinlog{{FieldID: 102, Binlogs: []*datapb.Binlog{{LogPath: logPath}}}},
+			},
+		}
+	}
+
+	t.Run("plan key ranges across segments", func(t *testing.T) {
+		maxNum := Params.DataCoordCfg.MaxSegmentToMerge.GetAsInt()
+		var segments []*SegmentInfo
+		// the key ranges of the segments are [0, 99], [100, 199], ... in the reverse order of segment IDs
+		for i := maxNum + 1; i > 0; i-- {
+			segments = append(segments, newSegment(int64(i), 100, int64(maxNum+1-i)*100, int64(maxNum+1-i)*100+99))
+		}
+		plans, err := tr.generateClusteringPlans(segments, 102, &compactTime{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(plans))
+		assert.Equal(t, maxNum, len(plans[0].GetSegmentBinlogs()))
+		assert.Equal(t, 1, len(plans[1].GetSegmentBinlogs()))
+		// the segments of the smallest keys are compacted together
+		assert.Equal(t, int64(maxNum+1), plans[0].GetSegmentBinlogs()[0].GetSegmentID())
+		assert.Equal(t, int64(1), plans[1].GetSegmentBinlogs()[0].GetSegmentID())
+
+		// the rows are evenly split into ranges of at most 300 rows, there is one row of each key
+		totalRows := int64(maxNum+1) * 100
+		rangeNum := (totalRows + 299) / 300
+		var expected []int64
+		for i := int64(1); i < rangeNum; i++ {
+			expected = append(expected, int64(math.Ceil(float64(totalRows)*float64(i)/float64(rangeNum))))
+		}
+		for _, plan := range plans {
+			assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plan.GetType())
+			assert.Equal(t, int64(102), plan.GetClusteringKeyField())
+			assert.Equal(t, int64(300), plan.GetMaxSegmentRows())
+			assert.Equal(t, int64(100*len(plan.GetSegmentBinlogs())), plan.GetTotalRows())
+			assert.Equal(t, expected, plan.GetClusteringKeyBoundaries().GetLongData().GetData())
+		}
+	})
+
+	t.Run("bounded plan size", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.ClusteringCompactionMaxPlanSize.Key, "1")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.ClusteringCompactionMaxPlanSize.Key)
+
+		var segments []*SegmentInfo
+		for i := int64(1); i <= 3; i++ {
+			segment := newSegment(i, 100, i*100, i*100+99)
+			segment.Binlogs = []*datapb.FieldBinlog{{FieldID: 102, Binlogs: []*datapb.Binlog{{LogSize: 400 * 1024}}}}
+			segments = append(segments, segment)
+		}
+		// a segment larger than the max plan size is compacted alone
+		large := newSegment(4, 100, 400, 499)
+		large.Binlogs = []*datapb.FieldBinlog{{FieldID: 102, Binlogs: []*datapb.Binlog{{LogSize: 2 * 1024 * 1024}}}}
+		segments = append(segments, large)
+
+		plans, err := tr.generateClusteringPlans(segments, 102, &compactTime{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(plans))
+		assert.Equal(t, []int64{1, 2}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+		assert.Equal(t, []int64{3}, fetchSegIDs(plans[1].GetSegmentBinlogs()))
+		assert.Equal(t, []int64{4}, fetchSegIDs(plans[2].GetSegmentBinlogs()))
+	})
+
+	t.Run("overlapping segments", func(t *testing.T) {
+		// the rows are evenly distributed in [0, 599]
+		segments := []*SegmentInfo{newSegment(1, 300, 0, 599), newSegment(2, 300, 0, 599)}
+		plans, err := tr.generateClusteringPlans(segments, 102, &compactTime{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(plans))
+		assert.Equal(t, []int64{300}, plans[0].GetClusteringKeyBoundaries().GetLongData().GetData())
+	})
+
+	t.Run("all rows in one range", func(t *testing.T) {
+		plans, err := tr.generateClusteringPlans([]*SegmentInfo{newSegment(1, 100, 0, 99), newSegment(2, 100, 50, 149)}, 102, &compactTime{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(plans))
+		assert.Nil(t, plans[0].GetClusteringKeyBoundaries())
+	})
+
+	t.Run("no clustering key stats", func(t *testing.T) {
+		segment := newSegment(1, 100, 0, 99)
+		segment.Statslogs = nil
+		_, err := tr.generateClusteringPlans([]*SegmentInfo{segment}, 102, &compactTime{})
+		assert.Error(t, err)
+
+		segment.Statslogs = []*datapb.FieldBinlog{{FieldID: 102, Binlogs: []*datapb.Binlog{{LogPath: "not_exist"}}}}
+		_, err = tr.generateClusteringPlans([]*SegmentInfo{segment}, 102, &compactTime{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_compactionTrigger_generatePlansWithClusteredSegments(t *testing.T) {
+	Params.Init()
+	newSegment := func(id int64, clustered bool) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				PartitionID:   1,
+				NumOfRows:     10,
+				MaxRowNum:     300,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Flushed,
+				IsClustered:   clustered,
+			},
+		}
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, false), newSegment(2, false), newSegment(3, false),
+		newSegment(4, true), newSegment(5, true),
+	}
+	tr := &compactionTrigger{}
+
+	// the small clustered segments are not merged with any other segment
+	plans := tr.generatePlans(segments, false, false, &compactTime{travelTime: 200})
+	require.Equal(t, 1, len(plans))
+	assert.ElementsMatch(t, []int64{1, 2, 3}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+
+	// forced compaction compacts every clustered segment alone
+	plans = tr.generatePlans(segments, true, false, &compactTime{travelTime: 200})
+	require.Equal(t, 3, len(plans))
+	assert.ElementsMatch(t, []int64{1, 2, 3}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+	assert.ElementsMatch(t, []int64{4, 5}, append(fetchSegIDs(plans[1].GetSegmentBinlogs()), fetchSegIDs(plans[2].GetSegmentBinlogs())...))
+	assert.Equal(t, 1, len(plans[1].GetSegmentBinlogs()))
+	assert.Equal(t, 1, len(plans[2].GetSegmentBinlogs()))
+}
+
+func Test_planClusteringKeyBoundaries(t *testing.T) {
+	newKeyRange := func(numRows int64, min, max storage.PrimaryKey) *segmentKeyRange {
+		return &segmentKeyRange{
+			segment: &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{NumOfRows: numRows}},
+			min:     min,
+			max:     max,
+		}
+	}
+
+	t.Run("skewed int keys", func(t *testing.T) {
+		// most rows are in the small segment range
+		keyRanges := []*segmentKeyRange{
+			newKeyRange(300, storage.NewInt64PrimaryKey(0), storage.NewInt64PrimaryKey(29)),
+			newKeyRange(100, storage.NewInt64PrimaryKey(30), storage.NewInt64PrimaryKey(10029)),
+		}
+		boundaries := planClusteringKeyBoundaries(keyRanges, 100)
+		assert.Equal(t, []int64{10, 20, 30}, boundaries.GetLongData().GetData())
+	})
+
+	t.Run("extreme int keys", func(t *testing.T) {
+		keyRanges := []*segmentKeyRange{
+			newKeyRange(100, storage.NewInt64PrimaryKey(math.MinInt64), storage.NewInt64PrimaryKey(math.MaxInt64)),
+			newKeyRange(100, storage.NewInt64PrimaryKey(math.MaxInt64), storage.NewInt64PrimaryKey(math.MaxInt64)),
+		}
+		boundaries := planClusteringKeyBoundaries(keyRanges, 100).GetLongData().GetData()
+		assert.Equal(t, 1, len(boundaries))
+	})
+
+	t.Run("string keys", func(t *testing.T) {
+		keyRanges := []*segmentKeyRange{
+			newKeyRange(100, storage.NewVarCharPrimaryKey("a"), storage.NewVarCharPrimaryKey("c")),
+			newKeyRange(100, storage.NewVarCharPrimaryKey("d"), storage.NewVarCharPrimaryKey("f")),
+			newKeyRange(100, storage.NewVarCharPrimaryKey("g"), storage.NewVarCharPrimaryKey("i")),
+		}
+		boundaries := planClusteringKeyBoundaries(keyRanges, 100)
+		assert.Equal(t, []string{"d", "g"}, boundaries.GetStringData().GetData())
+	})
+}
+
+func Test_estimateExpiredRows(t *testing.T) {
+	now := time.Now()
+	tsAt := func(d time.Duration) Timestamp {
+		return tsoutil.ComposeTSByTime(now.Add(d), 0)
+	}
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:        1,
+			NumOfRows: 300,
+			Binlogs: []*datapb.FieldBinlog{
+				{
+					FieldID: 1,
+					Binlogs: []*datapb.Binlog{
+						{EntriesNum: 100, TimestampFrom: tsAt(-3 * time.Hour), TimestampTo: tsAt(-2 * time.Hour)},
+						{EntriesNum: 100, TimestampFrom: tsAt(-2 * time.Hour), TimestampTo: tsAt(-1 * time.Hour)},
+						{EntriesNum: 100, TimestampFrom: tsAt(-1 * time.Hour), TimestampTo: tsAt(0)},
+					},
+				},
+				{
+					FieldID: 2,
+					Binlogs: []*datapb.Binlog{
+						{EntriesNum: 300, TimestampFrom: tsAt(-3 * time.Hour), TimestampTo: tsAt(0)},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, int64(0), estimateExpiredRows(segment, 0))
+	assert.Equal(t, int64(0), estimateExpiredRows(segment, tsAt(-4*time.Hour)))
+	assert.Equal(t, int64(100), estimateExpiredRows(segment, tsAt(-2*time.Hour+time.Millisecond)))
+	assert.Equal(t, int64(150), estimateExpiredRows(segment, tsAt(-90*time.Minute)))
+	assert.Equal(t, int64(300), estimateExpiredRows(segment, tsAt(time.Hour)))
+	assert.Equal(t, int64(0), estimateExpiredRows(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{}}, tsAt(0)))
+}
+
+type fixedTsAllocator struct {
+	MockAllocator
+	ts Timestamp
+}
+
+func (m *fixedTsAllocator) allocTimestamp(ctx context.Context) (Timestamp, error) {
+	return m.ts, nil
+}
+
+func Test_compactionTrigger_triggerExpiredCompaction(t *testing.T) {
+	now := time.Now()
+	tsAt := func(d time.Duration) Timestamp {
+		return tsoutil.ComposeTSByTime(now.Add(d), 0)
+	}
+	newSegment := func(id, collectionID UniqueID, from, to Timestamp) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  collectionID,
+				PartitionID:   1,
+				NumOfRows:     100,
+				MaxRowNum:     300,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{
+					{
+						FieldID: 1,
+						Binlogs: []*datapb.Binlog{
+							{EntriesNum: 100, TimestampFrom: from, TimestampTo: to},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	m := &meta{
+		segments: &SegmentsInfo{
+			map[int64]*SegmentInfo{
+				// mostly expired
+				1: newSegment(1, 2, tsAt(-3*time.Hour), tsAt(-30*time.Minute)),
+				// not expired
+				2: newSegment(2, 2, tsAt(-30*time.Minute), tsAt(0)),
+				// collection without ttl
+				3: newSegment(3, 3, tsAt(-3*time.Hour), tsAt(-2*time.Hour)),
+			},
+		},
+		collections: map[int64]*collectionInfo{
+			2: {
+				ID: 2,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: 201, DataType: schemapb.DataType_FloatVector},
+					},
+				},
+				Properties: map[string]string{common.CollectionTTLConfigKey: "3600"},
+			},
+			3: {
+				ID:         3,
+				Properties: map[string]string{common.CollectionTTLConfigKey: "0"},
+			},
+		},
+	}
+
+	spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 10)}
+	tr := &compactionTrigger{
+		meta:              m,
+		handler:           newMockHandlerWithMeta(m),
+		allocator:         &fixedTsAllocator{ts: tsAt(0)},
+		signals:           make(chan *compactionSignal, 100),
+		compactionHandler: spy,
+		indexCoord:        newMockIndexCoord(),
+	}
+	tr.triggerExpiredCompaction()
+
+	assert.Equal(t, 1, len(tr.signals))
+	signal := <-tr.signals
+	assert.Equal(t, UniqueID(1), signal.segmentID)
+	assert.Equal(t, UniqueID(2), signal.collectionID)
+	assert.False(t, signal.isGlobal)
+
+	// the signal must end up with a plan compacting the expired segment
+	tr.handleSignal(signal)
+	require.Equal(t, 1, len(spy.spyChan))
+	plan := <-spy.spyChan
+	assert.Contains(t, fetchSegIDs(plan.GetSegmentBinlogs()), UniqueID(1))
+}
@@ -73,9 +73,14 @@ type collectionInfo struct {
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string, chunkManager storage.ChunkManager) (*meta, error) {
+	return newMetaWithCatalog(ctx, &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath}, chunkManager)
+}
+
+// newMetaWithCatalog creates meta from the given catalog and reloads the segments and channel checkpoints from it
+func newMetaWithCatalog(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManager storage.ChunkManager) (*meta, error) {
 	mt := &meta{
 		ctx:          ctx,
-		catalog:      &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath},
+		catalog:      catalog,
 		collections:  make(map[UniqueID]*collectionInfo),
 		segments:     NewSegmentsInfo(),
 		channelCPs:   make(map[string]*internalpb.MsgPosition),
@@ -241,6 +246,39 @@ func (m *meta) GetTotalBinlogSize() int64 {
 	return totalHealthySize
 }
 
+// GetCollectionBinlogSize returns the total size (bytes) of healthy segments of each collection.
+func (m *meta) GetCollectionBinlogSize() map[UniqueID]int64 {
+	m.RLock()
+	defer m.RUnlock()
+	collectionBinlogSize := make(map[UniqueID]int64)
+	segments := m.segments.GetSegments()
+	for _, segment := range segments {
+		if isSegmentHealthy(segment) {
+			collectionBinlogSize[segment.GetCollectionID()] += segment.getSegmentSize()
+		}
+	}
+	return collectionBinlogSize
+}
+
+// GetPartitionBinlogSize returns the total size (bytes) of healthy segments of each partition,
+// the sizes are grouped by collection.
+func (m *meta) GetPartitionBinlogSize() map[UniqueID]map[UniqueID]int64 {
+	m.RLock()
+	defer m.RUnlock()
+	partitionBinlogSize := make(map[UniqueID]map[UniqueID]int64)
+	segments := m.segments.GetSegments()
+	for _, segment := range segments {
+		if !isSegmentHealthy(segment) {
+			continue
+		}
+		if _, ok := partitionBinlogSize[segment.GetCollectionID()]; !ok {
+			partitionBinlogSize[segment.GetCollectionID()] = make(map[UniqueID]int64)
+		}
+		partitionBinlogSize[segment.GetCollectionID()][segment.GetPartitionID()] += segment.getSegmentSize()
+	}
+	return partitionBinlogSize
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(segment *SegmentInfo) error {
 	log.Info("meta update: adding segment",
@@ -901,6 +939,33 @@ func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
 // The compactedTo segment could contain 0 numRows
 func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
 	result *datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, *SegmentInfo, *segMetricMutation, error) {
+	oldSegments, modSegments, newSegments, metricMutation, err := m.prepareCompactionMutation(compactionLogs, []*datapb.CompactionSegment{{
+		SegmentID:           result.GetSegmentID(),
+		NumOfRows:           result.GetNumOfRows(),
+		InsertLogs:          result.GetInsertLogs(),
+		Field2StatslogPaths: result.GetField2StatslogPaths(),
+		Deltalogs:           result.GetDeltalogs(),
+	}}, false)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return oldSegments, modSegments, newSegments[0], metricMutation, nil
+}
+
+// PrepareCompleteClusteringCompactionMutation is the same as PrepareCompleteCompactionMutation,
+// except that the compactedFrom segments are compacted to all the result segments of the clustering compaction.
+func (m *meta) PrepareCompleteClusteringCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	result *datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, []*SegmentInfo, *segMetricMutation, error) {
+	if len(result.GetSegments()) == 0 {
+		return nil, nil, nil, nil, fmt.Errorf("no result segment of clustering compaction plan %d", result.GetPlanID())
+	}
+	return m.prepareCompactionMutation(compactionLogs, result.GetSegments(), true)
+}
+
+// prepareCompactionMutation prepares the mutation of compacting the compactedFrom segments to the targets,
+// the targets are marked as clustered if they are generated by clustering compaction.
+func (m *meta) prepareCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	targets []*datapb.CompactionSegment, clustered bool) ([]*SegmentInfo, []*SegmentInfo, []*SegmentInfo, *segMetricMutation, error) {
 	log.Info("meta update: prepare for complete compaction mutation")
 	m.Lock()
 	defer m.Unlock()
@@ -949,43 +1014,54 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	}
 
 	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
-	copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, result.GetSegmentID())
-	if err != nil {
-		return nil, nil, nil, nil, err
-	}
-	deltalogs := append(result.GetDeltalogs(), copiedDeltalogs...)
 
 	compactionFrom := make([]UniqueID, 0, len(modSegments))
 	for _, s := range modSegments {
 		compactionFrom = append(compactionFrom, s.GetID())
 	}
+	// a clustered segment compacted alone keeps its clustering key range
+	if len(modSegments) == 1 && modSegments[0].GetIsClustered() {
+		clustered = true
+	}
 
-	segmentInfo := &datapb.SegmentInfo{
-		ID:                  result.GetSegmentID(),
-		CollectionID:        modSegments[0].CollectionID,
-		PartitionID:         modSegments[0].PartitionID,
-		InsertChannel:       modSegments[0].InsertChannel,
-		NumOfRows:           result.NumOfRows,
-		State:               commonpb.SegmentState_Flushing,
-		MaxRowNum:           modSegments[0].MaxRowNum,
-		Binlogs:             result.GetInsertLogs(),
-		Statslogs:           result.GetField2StatslogPaths(),
-		Deltalogs:           deltalogs,
-		StartPosition:       startPosition,
-		DmlPosition:         dmlPosition,
-		CreatedByCompaction: true,
-		CompactionFrom:      compactionFrom,
-	}
-	segment := NewSegmentInfo(segmentInfo)
-	metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
-	log.Info("meta update: prepare for complete compaction mutation - complete",
-		zap.Int64("collection ID", segment.GetCollectionID()),
-		zap.Int64("partition ID", segment.GetPartitionID()),
-		zap.Int64("new segment ID", segment.GetID()),
-		zap.Int64("new segment num of rows", segment.GetNumOfRows()),
-		zap.Any("compacted from", segment.GetCompactionFrom()))
-
-	return oldSegments, modSegments, segment, metricMutation, nil
+	segments := make([]*SegmentInfo, 0, len(targets))
+	for _, target := range targets {
+		// the new added delta logs are copied to every compactedTo segment
+		copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, target.GetSegmentID())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		deltalogs := append(target.GetDeltalogs(), copiedDeltalogs...)
+
+		segmentInfo := &datapb.SegmentInfo{
+			ID:                  target.GetSegmentID(),
+			CollectionID:        modSegments[0].CollectionID,
+			PartitionID:         modSegments[0].PartitionID,
+			InsertChannel:       modSegments[0].InsertChannel,
+			NumOfRows:           target.GetNumOfRows(),
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           modSegments[0].MaxRowNum,
+			Binlogs:             target.GetInsertLogs(),
+			Statslogs:           target.GetField2StatslogPaths(),
+			Deltalogs:           deltalogs,
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+			IsClustered:         clustered,
+		}
+		segment := NewSegmentInfo(segmentInfo)
+		metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
+		log.Info("meta update: prepare for complete compaction mutation - complete",
+			zap.Int64("collection ID", segment.GetCollectionID()),
+			zap.Int64("partition ID", segment.GetPartitionID()),
+			zap.Int64("new segment ID", segment.GetID()),
+			zap.Int64("new segment num of rows", segment.GetNumOfRows()),
+			zap.Any("compacted from", segment.GetCompactionFrom()))
+		segments = append(segments, segment)
+	}
+
+	return oldSegments, modSegments, segments, metricMutation, nil
 }
 
 func (m *meta) copyDeltaFiles(binlogs []*datapb.FieldBinlog, collectionID, partitionID, targetSegmentID int64) ([]*datapb.FieldBinlog, error) {
@@ -1011,17 +1087,24 @@ func (m *meta) copyDeltaFiles(binlogs []*datapb.FieldBinlog, collectionID, parti
 }
 
 func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegment *SegmentInfo) error {
+	return m.alterMetaStoreAfterCompactionWithSegments(modSegments, []*SegmentInfo{newSegment})
+}
+
+// alterMetaStoreAfterCompactionWithSegments alters the compactedFrom segments and adds all the compactedTo segments
+func (m *meta) alterMetaStoreAfterCompactionWithSegments(modSegments []*SegmentInfo, newSegments []*SegmentInfo) error {
 	var modSegIDs []int64
 	for _, seg := range modSegments {
 		modSegIDs = append(modSegIDs, seg.GetID())
 	}
-	log.Info("meta update: alter meta store for compaction updates",
-		zap.Int64s("compact from segments (segments to be updated as dropped)", modSegIDs),
-		zap.Int64("new segmentId", newSegment.GetID()),
-		zap.Int("binlog", len(newSegment.GetBinlogs())),
-		zap.Int("stats log", len(newSegment.GetStatslogs())),
-		zap.Int("delta logs", len(newSegment.GetDeltalogs())),
-		zap.Int64("compact to segment", newSegment.GetID()))
+	for _, newSegment := range newSegments {
+		log.Info("meta update: alter meta store for compaction updates",
+			zap.Int64s("compact from segments (segments to be updated as dropped)", modSegIDs),
+			zap.Int64("new segmentId", newSegment.GetID()),
+			zap.Int("binlog", len(newSegment.GetBinlogs())),
+			zap.Int("stats log", len(newSegment.GetStatslogs())),
+			zap.Int("delta logs", len(newSegment.GetDeltalogs())),
+			zap.Int64("compact to segment", newSegment.GetID()))
+	}
 
 	m.Lock()
 	defer m.Unlock()
@@ -1029,8 +1112,11 @@ func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegm
 	modInfos := lo.Map(modSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
 		return item.SegmentInfo
 	})
+	newInfos := lo.Map(newSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	})
 
-	if err := m.catalog.AlterSegmentsAndAddNewSegment(m.ctx, modInfos, newSegment.SegmentInfo); err != nil {
+	if err := m.catalog.AlterSegmentsAndAddNewSegments(m.ctx, modInfos, newInfos); err != nil {
 		return err
 	}
 
@@ -1038,20 +1124,29 @@ func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegm
 		m.segments.SetSegment(s.GetID(), s)
 	}
 
-	if newSegment.GetNumOfRows() > 0 {
-		m.segments.SetSegment(newSegment.GetID(), newSegment)
+	for _, newSegment := range newSegments {
+		if newSegment.GetNumOfRows() > 0 {
+			m.segments.SetSegment(newSegment.GetID(), newSegment)
+		}
 	}
 
 	return nil
 }
 
 func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, removalSegment *SegmentInfo) error {
-	log.Info("meta update: revert metastore after compaction failure",
-		zap.Int64("collectionID", removalSegment.CollectionID),
-		zap.Int64("partitionID", removalSegment.PartitionID),
-		zap.Int64("compactedTo (segment to remove)", removalSegment.ID),
-		zap.Int64s("compactedFrom (segments to add back)", removalSegment.GetCompactionFrom()),
-	)
+	return m.revertAlterMetaStoreAfterCompactionWithSegments(oldSegments, []*SegmentInfo{removalSegment})
+}
+
+// revertAlterMetaStoreAfterCompactionWithSegments adds back the compactedFrom segments and removes all the compactedTo segments
+func (m *meta) revertAlterMetaStoreAfterCompactionWithSegments(oldSegments []*SegmentInfo, removalSegments []*SegmentInfo) error {
+	for _, removalSegment := range removalSegments {
+		log.Info("meta update: revert metastore after compaction failure",
+			zap.Int64("collectionID", removalSegment.CollectionID),
+			zap.Int64("partitionID", removalSegment.PartitionID),
+			zap.Int64("compactedTo (segment to remove)", removalSegment.ID),
+			zap.Int64s("compactedFrom (segments to add back)", removalSegment.GetCompactionFrom()),
+		)
+	}
 
 	m.Lock()
 	defer m.Unlock()
@@ -1059,8 +1154,11 @@ func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, r
 	oldSegmentInfos := lo.Map(oldSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
 		return item.SegmentInfo
 	})
+	removalSegmentInfos := lo.Map(removalSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	})
 
-	if err := m.catalog.RevertAlterSegmentsAndAddNewSegment(m.ctx, oldSegmentInfos, removalSegment.SegmentInfo); err != nil {
+	if err := m.catalog.RevertAlterSegmentsAndAddNewSegments(m.ctx, oldSegmentInfos, removalSegmentInfos); err != nil {
 		return err
 	}
 
@@ -1068,8 +1166,10 @@ func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, r
 		m.segments.SetSegment(s.GetID(), s)
 	}
 
-	if removalSegment.GetNumOfRows() > 0 {
-		m.segments.DropSegment(removalSegment.GetID())
+	for _, removalSegment := range removalSegments {
+		if removalSegment.GetNumOfRows() > 0 {
+			m.segments.DropSegment(removalSegment.GetID())
+		}
 	}
 	return nil
 }
@@ -491,6 +491,23 @@ func TestMeta_Basic(t *testing.T) {
 		// check TotalBinlogSize
 		size = meta.GetTotalBinlogSize()
 		assert.Equal(t, int64(size0+size1), size)
+
+		// check CollectionBinlogSize
+		collSize := meta.GetCollectionBinlogSize()
+		assert.Equal(t, int64(size0+size1), collSize[collID])
+
+		// add seg2 with size1 into another partition
+		segID2, err := mockAllocator.allocID(ctx)
+		assert.Nil(t, err)
+		segInfo2 := buildSegment(collID, partID1, segID2, channelName, false)
+		segInfo2.size = size1
+		err = meta.AddSegment(segInfo2)
+		assert.Nil(t, err)
+
+		// check PartitionBinlogSize
+		partSize := meta.GetPartitionBinlogSize()
+		assert.Equal(t, int64(size0+size1), partSize[collID][partID0])
+		assert.Equal(t, int64(size1), partSize[collID][partID1])
 	})
 }
 
@@ -786,6 +803,52 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	assert.EqualValues(t, inCompactionResult.GetField2StatslogPaths(), newSegment.GetStatslogs())
 	assert.EqualValues(t, inCompactionResult.GetDeltalogs(), newSegment.GetDeltalogs())
 	assert.NotZero(t, newSegment.lastFlushTime)
+	assert.False(t, newSegment.GetIsClustered())
+}
+
+func TestMeta_PrepareCompleteClusteringCompactionMutation(t *testing.T) {
+	newSegment := func(id UniqueID, clustered bool) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			NumOfRows:    1,
+			IsClustered:  clustered,
+		}}
+	}
+	m := &meta{
+		catalog: &datacoord.Catalog{Txn: memkv.NewMemoryKV()},
+		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			1: newSegment(1, false),
+			2: newSegment(2, false),
+			3: newSegment(3, true),
+		}},
+	}
+
+	_, _, _, _, err := m.PrepareCompleteClusteringCompactionMutation(
+		[]*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}}, &datapb.CompactionResult{PlanID: 1})
+	assert.Error(t, err)
+
+	_, afterCompact, newSegments, _, err := m.PrepareCompleteClusteringCompactionMutation(
+		[]*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}},
+		&datapb.CompactionResult{
+			PlanID:   1,
+			Segments: []*datapb.CompactionSegment{{SegmentID: 4, NumOfRows: 1}, {SegmentID: 5, NumOfRows: 1}},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(afterCompact))
+	require.Equal(t, 2, len(newSegments))
+	for _, segment := range newSegments {
+		assert.True(t, segment.GetIsClustered())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+	}
+
+	// a clustered segment compacted alone is still clustered
+	_, _, newSegment3, _, err := m.PrepareCompleteCompactionMutation(
+		[]*datapb.CompactionSegmentBinlogs{{SegmentID: 3}}, &datapb.CompactionResult{PlanID: 2, SegmentID: 6, NumOfRows: 1})
+	assert.NoError(t, err)
+	assert.True(t, newSegment3.GetIsClustered())
 }
 
 func Test_meta_SetSegmentCompacting(t *testing.T) {
@@ -35,7 +35,9 @@ import (
 // getQuotaMetrics returns DataCoordQuotaMetrics.
 func (s *Server) getQuotaMetrics() *metricsinfo.DataCoordQuotaMetrics {
 	return &metricsinfo.DataCoordQuotaMetrics{
-		TotalBinlogSize: s.meta.GetTotalBinlogSize(),
+		TotalBinlogSize:      s.meta.GetTotalBinlogSize(),
+		CollectionBinlogSize: s.meta.GetCollectionBinlogSize(),
+		PartitionBinlogSize:  s.meta.GetPartitionBinlogSize(),
 	}
 }
 
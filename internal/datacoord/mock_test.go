@@ -259,6 +259,10 @@ func (c *mockDataNodeClient) Import(ctx context.Context, in *datapb.ImportTaskRe
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) ExportSegment(ctx context.Context, in *datapb.ExportSegmentRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	return c.addImportSegmentResp, nil
 }
@@ -273,14 +277,27 @@ func (c *mockDataNodeClient) Stop() error {
 }
 
 type mockRootCoordService struct {
-	state commonpb.StateCode
-	cnt   int64
+	state        commonpb.StateCode
+	cnt          int64
+	exportResult *rootcoordpb.ExportSegmentResult
 }
 
 func (m *mockRootCoordService) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) CreateDatabase(ctx context.Context, req *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropDatabase(ctx context.Context, req *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListDatabases(ctx context.Context, req *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordService) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	panic("implement me")
 }
@@ -293,6 +310,10 @@ func (m *mockRootCoordService) AlterAlias(ctx context.Context, req *milvuspb.Alt
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) RenameCollection(ctx context.Context, req *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func newMockRootCoordService() *mockRootCoordService {
 	return &mockRootCoordService{state: commonpb.StateCode_Healthy}
 }
@@ -543,6 +564,21 @@ func (m *mockRootCoordService) ReportImport(ctx context.Context, req *rootcoordp
 	}, nil
 }
 
+func (m *mockRootCoordService) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	m.exportResult = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 type mockCompactionHandler struct {
 	methods map[string]interface{}
 }
@@ -231,7 +231,7 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -340,7 +340,7 @@ func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID,
 	channelName string, segmentState commonpb.SegmentState) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	id, err := s.allocator.allocID(ctx)
 	if err != nil {
 		log.Error("failed to open new segment while allocID", zap.Error(err))
@@ -392,7 +392,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 // DropSegment drop the segment from manager.
 func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	for i, id := range s.segments {
@@ -415,7 +415,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 // SealAllSegments seals all segments of collection with collectionID and return sealed segments
 func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, segIDs []UniqueID) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	var ret []UniqueID
@@ -449,7 +449,7 @@ func (s *SegmentManager) GetFlushableSegments(ctx context.Context, channel strin
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	// TODO:move tryToSealSegment and dropEmptySealedSegment outside
 	if err := s.tryToSealSegment(t, channel); err != nil {
 		return nil, err
@@ -37,12 +37,18 @@ import (
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -456,8 +462,25 @@ func (s *Server) initMeta(chunkManagerRootPath string, chunkManager storage.Chun
 
 	s.kvClient = etcdKV
 	reloadEtcdFn := func() error {
+		var catalog metastore.DataCoordCatalog
+		switch Params.MetaStoreCfg.MetaStoreType.GetValue() {
+		case util.MetaStoreTypeEtcd:
+			catalog = &datacoord.Catalog{Txn: s.kvClient, ChunkManagerRootPath: chunkManagerRootPath}
+		case util.MetaStoreTypeMysql, util.MetaStoreTypeSQLite:
+			// connect to database
+			err := dbcore.Connect(Params.MetaStoreCfg.MetaStoreType.GetValue(), &Params.DBCfg)
+			if err != nil {
+				return err
+			}
+
+			// the flushed segment events watched by IndexCoord are still published to etcd
+			catalog = dbdatacoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), s.kvClient, chunkManagerRootPath)
+		default:
+			return retry.Unrecoverable(fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType.GetValue()))
+		}
+
 		var err error
-		s.meta, err = newMeta(s.ctx, s.kvClient, chunkManagerRootPath, chunkManager)
+		s.meta, err = newMetaWithCatalog(s.ctx, catalog, chunkManager)
 		if err != nil {
 			return err
 		}
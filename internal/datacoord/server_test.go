@@ -3315,6 +3315,90 @@ func TestDataCoord_Import(t *testing.T) {
 	})
 }
 
+func TestDataCoord_ExportSegment(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  1,
+			Address: "localhost:8081",
+		})
+
+		resp, err := svr.ExportSegment(svr.ctx, &datapb.ExportSegmentRequest{
+			ExportTask: &datapb.ExportSegmentTask{
+				TaskId:  100,
+				Segment: &datapb.SegmentBinlogs{SegmentID: 1},
+			},
+			WorkingNodes: []int64{0},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, resp.GetDatanodeId())
+	})
+
+	t.Run("no free node", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+
+		resp, err := svr.ExportSegment(svr.ctx, &datapb.ExportSegmentRequest{
+			ExportTask:   &datapb.ExportSegmentTask{TaskId: 100},
+			WorkingNodes: []int64{0},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("no datanode available", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.ExportSegment(svr.ctx, &datapb.ExportSegmentRequest{
+			ExportTask: &datapb.ExportSegmentTask{TaskId: 100},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("report failed dataNode", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		// the dataNode 2 is not registered, the request can't be sent to it
+		svr.exportSegment(2, &datapb.ExportSegmentRequest{
+			ExportTask: &datapb.ExportSegmentTask{
+				TaskId:  100,
+				Segment: &datapb.SegmentBinlogs{SegmentID: 1},
+			},
+		})
+		result := svr.rootCoordClient.(*mockRootCoordService).exportResult
+		assert.NotNil(t, result)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 100, result.GetTaskId())
+		assert.EqualValues(t, 1, result.GetSegmentId())
+		assert.EqualValues(t, 2, result.GetDatanodeId())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.ExportSegment(svr.ctx, &datapb.ExportSegmentRequest{
+			ExportTask: &datapb.ExportSegmentTask{TaskId: 100},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.GetStatus().GetReason())
+	})
+}
+
 func TestDataCoord_SaveImportSegment(t *testing.T) {
 	t.Run("test add segment", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
@@ -76,7 +78,7 @@ func (s *Server) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	log.Info("receive flush request", zap.Int64("dbID", req.GetDbID()), zap.Int64("collectionID", req.GetCollectionID()))
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "DataCoord-Flush")
-	defer sp.Finish()
+	defer sp.End()
 	resp := &datapb.FlushResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -1189,6 +1191,80 @@ func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*da
 	return resp, nil
 }
 
+// ExportSegment sends a segment of an export task to a free dataNode, the dataNodes working on the other segments
+// of the task are excluded.
+// It returns a failed status if no dataNode is available or if any error occurs.
+func (s *Server) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	log.Info("DataCoord receives export segment request",
+		zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+		zap.Int64("segment ID", req.GetExportTask().GetSegment().GetSegmentID()),
+		zap.Int64s("working dataNodes", req.GetWorkingNodes()))
+	resp := &datapb.ExportSegmentResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Error("failed to export segment for closed DataCoord service")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	nodes := s.sessionManager.getLiveNodeIDs()
+	if len(nodes) == 0 {
+		log.Error("export segment failed as all DataNodes are offline")
+		resp.Status.Reason = "all DataNodes are offline"
+		return resp, nil
+	}
+
+	avaNodes := getDiff(nodes, req.GetWorkingNodes())
+	if len(avaNodes) == 0 {
+		// No dataNode is available, reject the request, the segment is dispatched again later.
+		msg := "all DataNodes are busy working on the export task, the segment has been rejected and wait for idle datanode"
+		log.Info(msg, zap.Int64("task ID", req.GetExportTask().GetTaskId()))
+		resp.Status.Reason = msg
+		return resp, nil
+	}
+	resp.DatanodeId = avaNodes[rand.Intn(len(avaNodes))]
+	log.Info("picking a free dataNode for export segment",
+		zap.Any("all dataNodes", nodes),
+		zap.Int64("picking free dataNode with ID", resp.GetDatanodeId()))
+	go s.exportSegment(resp.GetDatanodeId(), req)
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// exportSegment sends the segment to the dataNode, the dataNode reports the result to RootCoord. If the dataNode
+// fails to accept or finish the request, for example it is offline, a failed result is reported to RootCoord
+// instead, so that the export task does not wait for a result that never comes.
+func (s *Server) exportSegment(nodeID int64, req *datapb.ExportSegmentRequest) {
+	err := s.cluster.ExportSegment(s.ctx, nodeID, req)
+	if err == nil {
+		return
+	}
+	result := &rootcoordpb.ExportSegmentResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("failed to export segment on DataNode %d: %s", nodeID, err.Error()),
+		},
+		TaskId:     req.GetExportTask().GetTaskId(),
+		SegmentId:  req.GetExportTask().GetSegment().GetSegmentID(),
+		DatanodeId: nodeID,
+	}
+	status, err := s.rootCoordClient.ReportExport(s.ctx, result)
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		log.Warn("failed to report the failed export segment to RootCoord",
+			zap.Int64("task ID", result.GetTaskId()),
+			zap.Int64("segment ID", result.GetSegmentId()),
+			zap.Error(err))
+	}
+}
+
 // UpdateSegmentStatistics updates a segment's stats.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	resp := &commonpb.Status{
@@ -38,6 +38,8 @@ const (
 	importTimeout     = 3 * time.Hour
 	reCollectTimeout  = 5 * time.Second
 	addSegmentTimeout = 30 * time.Second
+	// exportSegmentTimeout is the same as the timeout of an export segment in RootCoord.
+	exportSegmentTimeout = time.Hour
 )
 
 // SessionManager provides the grpc interfaces of cluster
@@ -206,6 +208,31 @@ func (c *SessionManager) execImport(ctx context.Context, nodeID int64, itr *data
 	log.Info("success to import", zap.Int64("node", nodeID), zap.Any("import task", itr))
 }
 
+// ExportSegment is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously,
+// the DataNode reports the result of the export to RootCoord, an error is returned if the DataNode fails to
+// accept the request.
+func (c *SessionManager) ExportSegment(ctx context.Context, nodeID int64, req *datapb.ExportSegmentRequest) error {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client for export segment", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, exportSegmentTimeout)
+	defer cancel()
+	resp, err := cli.ExportSegment(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to export segment", zap.Int64("node", nodeID),
+			zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+			zap.Int64("segment ID", req.GetExportTask().GetSegment().GetSegmentID()), zap.Error(err))
+		return err
+	}
+
+	log.Info("success to export segment", zap.Int64("node", nodeID),
+		zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+		zap.Int64("segment ID", req.GetExportTask().GetSegment().GetSegmentID()))
+	return nil
+}
+
 // ReCollectSegmentStats collects segment stats info from DataNodes, after DataCoord reboots.
 func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	go c.execReCollectSegmentStats(ctx, nodeID)
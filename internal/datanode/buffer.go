@@ -163,6 +163,37 @@ func (bm *DelBufferManager) CompactSegBuf(compactedToSegID UniqueID, compactedFr
 	}
 }
 
+// CopySegBuf copies the delete buffers of the compacted segments into the target segment, the buffers of the
+// compacted segments are kept, since they are compacted into more than one segment by a clustering compaction.
+func (bm *DelBufferManager) CopySegBuf(targetSegID UniqueID, compactedFromSegIDs []UniqueID) {
+	targetDelBuf, loaded := bm.Load(targetSegID)
+	if !loaded {
+		targetDelBuf = newDelDataBuf()
+		targetDelBuf.item.segmentID = targetSegID
+	}
+
+	var copiedSize int64
+	for _, segID := range compactedFromSegIDs {
+		if delDataBuf, ok := bm.Load(segID); ok {
+			targetDelBuf.mergeDelDataBuf(delDataBuf)
+			copiedSize += delDataBuf.item.memorySize
+		}
+	}
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	// only store delBuf if EntriesNum > 0
+	if targetDelBuf.EntriesNum > 0 {
+		if loaded {
+			bm.delBufHeap.update(targetDelBuf.item, targetDelBuf.item.memorySize)
+		} else {
+			heap.Push(bm.delBufHeap, targetDelBuf.item)
+		}
+		// unlike compacting, the copied deletes are new added into the memory
+		bm.delMemorySize += copiedSize
+		bm.channel.setCurDeleteBuffer(targetSegID, targetDelBuf)
+	}
+}
+
 func (bm *DelBufferManager) ShouldFlushSegments() []UniqueID {
 	bm.mu.Lock()
 	defer bm.mu.Unlock()
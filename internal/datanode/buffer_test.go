@@ -199,3 +199,35 @@ func Test_CompactSegBuff(t *testing.T) {
 	})
 	assert.Equal(t, Timestamp(200), cp.Timestamp) // evict all buffer, use ttPos as cp
 }
+
+func Test_CopySegBuff(t *testing.T) {
+	channelSegments := make(map[UniqueID]*Segment)
+	delBufferManager := &DelBufferManager{
+		channel: &ChannelMeta{
+			segments: channelSegments,
+		},
+		delMemorySize: 0,
+		delBufHeap:    &PriorityQueue{},
+	}
+	var segID1 UniqueID = 1111
+	var segID2 UniqueID = 2222
+	var targetSegID UniqueID = 3333
+	channelSegments[segID1] = &Segment{}
+	channelSegments[segID2] = &Segment{}
+	channelSegments[targetSegID] = &Segment{}
+
+	delBufferManager.StoreNewDeletes(segID1, []primaryKey{newInt64PrimaryKey(1)}, []Timestamp{10},
+		TimeRange{timestampMin: 10, timestampMax: 10}, &internalpb.MsgPosition{Timestamp: 10}, &internalpb.MsgPosition{Timestamp: 10})
+	delBufferManager.StoreNewDeletes(segID2, []primaryKey{newInt64PrimaryKey(2)}, []Timestamp{20},
+		TimeRange{timestampMin: 20, timestampMax: 20}, &internalpb.MsgPosition{Timestamp: 20}, &internalpb.MsgPosition{Timestamp: 20})
+	memorySize := delBufferManager.delMemorySize
+
+	delBufferManager.CopySegBuf(targetSegID, []UniqueID{segID1, segID2})
+
+	// the buffers of the compacted segments are kept
+	assert.Equal(t, int64(1), delBufferManager.GetEntriesNum(segID1))
+	assert.Equal(t, int64(1), delBufferManager.GetEntriesNum(segID2))
+	assert.Equal(t, int64(2), delBufferManager.GetEntriesNum(targetSegID))
+	assert.Equal(t, 2*memorySize, delBufferManager.delMemorySize)
+	assert.Equal(t, 3, delBufferManager.delBufHeap.Len())
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -240,10 +241,9 @@ func (t *compactionTask) merge(
 	mergeStart := time.Now()
 
 	var (
-		maxRowsPerBinlog int   // maximum rows populating one binlog
-		numBinlogs       int   // binlog number
-		numRows          int64 // the number of rows uploaded
-		expired          int64 // the number of expired entity
+		numBinlogs int   // binlog number
+		numRows    int64 // the number of rows uploaded
+		expired    int64 // the number of expired entity
 
 		// statslog generation
 		pkID   UniqueID
@@ -259,38 +259,6 @@ func (t *compactionTask) merge(
 		statPaths      = make([]*datapb.FieldBinlog, 0)
 	)
 
-	isDeletedValue := func(v *storage.Value) bool {
-		ts, ok := delta[v.PK.GetValue()]
-		if ok && uint64(v.Timestamp) <= ts {
-			return true
-		}
-		return false
-	}
-
-	addInsertFieldPath := func(inPaths map[UniqueID]*datapb.FieldBinlog) {
-		for fID, path := range inPaths {
-			tmpBinlog, ok := insertField2Path[fID]
-			if !ok {
-				tmpBinlog = path
-			} else {
-				tmpBinlog.Binlogs = append(tmpBinlog.Binlogs, path.GetBinlogs()...)
-			}
-			insertField2Path[fID] = tmpBinlog
-		}
-	}
-
-	addStatFieldPath := func(statPaths map[UniqueID]*datapb.FieldBinlog) {
-		for fID, path := range statPaths {
-			tmpBinlog, ok := statField2Path[fID]
-			if !ok {
-				tmpBinlog = path
-			} else {
-				tmpBinlog.Binlogs = append(tmpBinlog.Binlogs, path.GetBinlogs()...)
-			}
-			statField2Path[fID] = tmpBinlog
-		}
-	}
-
 	// get pkID, pkType, dim
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
@@ -300,19 +268,12 @@ func (t *compactionTask) merge(
 		}
 	}
 
-	// estimate Rows per binlog
-	// TODO should not convert size to row because we already know the size, this is especially important on varchar types.
-	size, err := typeutil.EstimateSizePerRecord(meta.GetSchema())
+	maxRowsPerBinlog, err := estimateMaxRowsPerBinlog(meta.GetSchema())
 	if err != nil {
 		log.Warn("failed to estimate size per record", zap.Error(err))
 		return nil, nil, 0, err
 	}
 
-	maxRowsPerBinlog = int(Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() / int64(size))
-	if Params.DataNodeCfg.BinLogMaxSize.GetAsInt64()%int64(size) != 0 {
-		maxRowsPerBinlog++
-	}
-
 	expired = 0
 	numRows = 0
 	numBinlogs = 0
@@ -343,7 +304,7 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
-			if isDeletedValue(v) {
+			if isDeletedValue(delta, v) {
 				continue
 			}
 
@@ -376,8 +337,8 @@ func (t *compactionTask) merge(
 					return nil, nil, 0, err
 				}
 				uploadInsertTimeCost += time.Since(uploadInsertStart)
-				addInsertFieldPath(inPaths)
-				addStatFieldPath(statsPaths)
+				mergeFieldBinlogs(insertField2Path, inPaths)
+				mergeFieldBinlogs(statField2Path, statsPaths)
 
 				fID2Content = make(map[int64][]interface{})
 				currentRows = 0
@@ -395,8 +356,8 @@ func (t *compactionTask) merge(
 		}
 		uploadInsertTimeCost += time.Since(uploadInsertStart)
 
-		addInsertFieldPath(inPaths)
-		addStatFieldPath(statsPaths)
+		mergeFieldBinlogs(insertField2Path, inPaths)
+		mergeFieldBinlogs(statField2Path, statsPaths)
 
 		numRows += int64(currentRows)
 		numBinlogs++
@@ -419,6 +380,267 @@ func (t *compactionTask) merge(
 	return insertPaths, statPaths, numRows, nil
 }
 
+// estimateMaxRowsPerBinlog returns the maximum rows populating one binlog
+func estimateMaxRowsPerBinlog(schema *schemapb.CollectionSchema) (int, error) {
+	// TODO should not convert size to row because we already know the size, this is especially important on varchar types.
+	size, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+
+	maxRowsPerBinlog := int(Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() / int64(size))
+	if Params.DataNodeCfg.BinLogMaxSize.GetAsInt64()%int64(size) != 0 {
+		maxRowsPerBinlog++
+	}
+	return maxRowsPerBinlog, nil
+}
+
+// isDeletedValue returns true if the entity is deleted by the merged delta logs
+func isDeletedValue(delta map[interface{}]Timestamp, v *storage.Value) bool {
+	ts, ok := delta[v.PK.GetValue()]
+	// upsert writes the delete and the insert with the same timestamp,
+	// an entity is only deleted by a delete with a larger timestamp
+	if ok && uint64(v.Timestamp) < ts {
+		return true
+	}
+	return false
+}
+
+// clusteringKeyOf converts the clustering key value of a row to a comparable key
+func clusteringKeyOf(value interface{}) (storage.PrimaryKey, error) {
+	switch v := value.(type) {
+	case int8:
+		return storage.NewInt64PrimaryKey(int64(v)), nil
+	case int16:
+		return storage.NewInt64PrimaryKey(int64(v)), nil
+	case int32:
+		return storage.NewInt64PrimaryKey(int64(v)), nil
+	case int64:
+		return storage.NewInt64PrimaryKey(v), nil
+	case string:
+		return storage.NewVarCharPrimaryKey(v), nil
+	default:
+		return nil, errTransferType
+	}
+}
+
+// clusteringKeyBoundaries converts the boundaries of the clustering key ranges in the plan to comparable keys
+func clusteringKeyBoundaries(boundaries *schemapb.ScalarField) ([]storage.PrimaryKey, error) {
+	var keys []storage.PrimaryKey
+	switch {
+	case boundaries == nil:
+	case boundaries.GetLongData() != nil:
+		for _, value := range boundaries.GetLongData().GetData() {
+			keys = append(keys, storage.NewInt64PrimaryKey(value))
+		}
+	case boundaries.GetStringData() != nil:
+		for _, value := range boundaries.GetStringData().GetData() {
+			keys = append(keys, storage.NewVarCharPrimaryKey(value))
+		}
+	default:
+		return nil, errIllegalCompactionPlan
+	}
+	return keys, nil
+}
+
+// cluster sorts the remaining rows of the segments by the clustering key and assigns them to the key ranges planned
+// by DataCoord, the rows of each range are split into segments of at most MaxSegmentRows rows, so that the clustering
+// key ranges of the result segments don't overlap with each other, nor with the ranges of the other plans.
+// The first result segment takes targetSegID, the IDs of the others are allocated on demand.
+// All the remaining rows of the plan are sorted in memory, DataCoord bounds the size of a clustering plan by
+// dataCoord.compaction.clustering.maxPlanSize.
+func (t *compactionTask) cluster(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	targetSegID UniqueID,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp) ([]*datapb.CompactionSegment, error) {
+	log := log.With(zap.Int64("planID", t.getPlanID()))
+	clusterStart := time.Now()
+
+	var (
+		pkID   UniqueID
+		pkType schemapb.DataType
+
+		clusteringKeyField = t.plan.GetClusteringKeyField()
+		fID2Type           = make(map[UniqueID]schemapb.DataType)
+
+		rows    []map[UniqueID]interface{}
+		keys    []storage.PrimaryKey
+		expired int64
+	)
+
+	for _, fs := range meta.GetSchema().GetFields() {
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+	}
+	if _, ok := fID2Type[clusteringKeyField]; !ok {
+		log.Warn("clustering key field not found in schema", zap.Int64("fieldID", clusteringKeyField))
+		return nil, errIllegalCompactionPlan
+	}
+	boundaries, err := clusteringKeyBoundaries(t.plan.GetClusteringKeyBoundaries())
+	if err != nil {
+		log.Warn("invalid clustering key boundaries", zap.Error(err))
+		return nil, err
+	}
+	// rangeOf returns the index of the key range which the key belongs to
+	rangeOf := func(key storage.PrimaryKey) int {
+		return sort.Search(len(boundaries), func(i int) bool {
+			return key.LT(boundaries[i])
+		})
+	}
+
+	maxRowsPerBinlog, err := estimateMaxRowsPerBinlog(meta.GetSchema())
+	if err != nil {
+		log.Warn("failed to estimate size per record", zap.Error(err))
+		return nil, err
+	}
+
+	currentTs := t.GetCurrentTime()
+	for _, path := range unMergedInsertlogs {
+		data, err := t.download(ctxTimeout, path)
+		if err != nil {
+			log.Warn("download insertlogs wrong", zap.Error(err))
+			return nil, err
+		}
+
+		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
+		if err != nil {
+			log.Warn("new insert binlogs Itr wrong", zap.Error(err))
+			return nil, err
+		}
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				log.Warn("transfer interface to Value wrong")
+				return nil, errors.New("unexpected error")
+			}
+
+			if isDeletedValue(delta, v) {
+				continue
+			}
+			if t.isExpiredEntity(Timestamp(v.Timestamp), currentTs) {
+				expired++
+				continue
+			}
+
+			row, ok := v.Value.(map[UniqueID]interface{})
+			if !ok {
+				log.Warn("transfer interface to map wrong")
+				return nil, errors.New("unexpected error")
+			}
+			key, err := clusteringKeyOf(row[clusteringKeyField])
+			if err != nil {
+				log.Warn("transfer clustering key wrong", zap.Int64("fieldID", clusteringKeyField), zap.Error(err))
+				return nil, err
+			}
+			rows = append(rows, row)
+			keys = append(keys, key)
+		}
+	}
+
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return keys[order[i]].LT(keys[order[j]])
+	})
+
+	maxSegmentRows := int(t.plan.GetMaxSegmentRows())
+	if maxSegmentRows <= 0 {
+		maxSegmentRows = len(rows)
+	}
+
+	// the sorted rows are split at the boundaries of the key ranges and every maxSegmentRows rows of a range,
+	// a segment with no row is still generated if all the rows are deleted
+	spans := make([][2]int, 0)
+	for start := 0; start < len(order); {
+		keyRange := rangeOf(keys[order[start]])
+		end := start + 1
+		for end < len(order) && end-start < maxSegmentRows && rangeOf(keys[order[end]]) == keyRange {
+			end++
+		}
+		spans = append(spans, [2]int{start, end})
+		start = end
+	}
+	if len(spans) == 0 {
+		spans = append(spans, [2]int{0, 0})
+	}
+
+	segments := make([]*datapb.CompactionSegment, 0, len(spans))
+	for _, span := range spans {
+		start, end := span[0], span[1]
+
+		segID := targetSegID
+		if len(segments) > 0 {
+			segID, err = t.allocID()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		segment := &datapb.CompactionSegment{
+			SegmentID: segID,
+			NumOfRows: int64(end - start),
+		}
+		insertField2Path := make(map[UniqueID]*datapb.FieldBinlog)
+		statField2Path := make(map[UniqueID]*datapb.FieldBinlog)
+		for binlogStart := start; binlogStart < end; binlogStart += maxRowsPerBinlog {
+			binlogEnd := binlogStart + maxRowsPerBinlog
+			if binlogEnd > end {
+				binlogEnd = end
+			}
+
+			fID2Content := make(map[UniqueID][]interface{})
+			for _, idx := range order[binlogStart:binlogEnd] {
+				for fID, value := range rows[idx] {
+					fID2Content[fID] = append(fID2Content[fID], value)
+				}
+			}
+			inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, segID, partID, meta, fID2Content, fID2Type)
+			if err != nil {
+				log.Warn("failed to upload single insert log", zap.Error(err))
+				return nil, err
+			}
+			mergeFieldBinlogs(insertField2Path, inPaths)
+			mergeFieldBinlogs(statField2Path, statsPaths)
+		}
+
+		for _, path := range insertField2Path {
+			segment.InsertLogs = append(segment.InsertLogs, path)
+		}
+		for _, path := range statField2Path {
+			segment.Field2StatslogPaths = append(segment.Field2StatslogPaths, path)
+		}
+		segments = append(segments, segment)
+	}
+
+	log.Info("cluster end", zap.Int("remaining insert numRows", len(rows)),
+		zap.Int64("expired entities", expired), zap.Int("result segment number", len(segments)),
+		zap.Float64("cluster elapse in ms", nano2Milli(time.Since(clusterStart))))
+
+	return segments, nil
+}
+
+// mergeFieldBinlogs appends the binlogs of paths into field2Path by field
+func mergeFieldBinlogs(field2Path map[UniqueID]*datapb.FieldBinlog, paths map[UniqueID]*datapb.FieldBinlog) {
+	for fID, path := range paths {
+		tmpBinlog, ok := field2Path[fID]
+		if !ok {
+			tmpBinlog = path
+		} else {
+			tmpBinlog.Binlogs = append(tmpBinlog.Binlogs, path.GetBinlogs()...)
+		}
+		field2Path[fID] = tmpBinlog
+	}
+}
+
 func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	compactStart := time.Now()
 	if ok := funcutil.CheckCtxValid(t.ctx); !ok {
@@ -441,7 +663,8 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		log.Warn("compact wrong, there's no segments in segment binlogs")
 		return nil, errIllegalCompactionPlan
 
-	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction:
+	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction ||
+		t.plan.GetType() == datapb.CompactionType_ClusteringCompaction:
 		targetSegID, err = t.allocID()
 		if err != nil {
 			log.Warn("compact wrong", zap.Error(err))
@@ -635,38 +858,60 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
-	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
-	if err != nil {
-		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
-		return nil, err
+	var segments []*datapb.CompactionSegment
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		segments, err = t.cluster(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+	} else {
+		inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+		segments = []*datapb.CompactionSegment{{
+			SegmentID:           targetSegID,
+			NumOfRows:           numRows,
+			InsertLogs:          inPaths,
+			Field2StatslogPaths: statsPaths,
+		}}
 	}
 
+	// the remaining delta logs are kept by every result segment since it's unknown which one holds the deleted entity
 	uploadDeltaStart := time.Now()
-	deltaInfo, err := t.uploadDeltaLog(ctxTimeout, targetSegID, partID, deltaBuf.delData, meta)
-	if err != nil {
-		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
-		return nil, err
-	}
-	log.Info("upload delta log elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(uploadDeltaStart))))
+	for _, segment := range segments {
+		deltaInfo, err := t.uploadDeltaLog(ctxTimeout, segment.GetSegmentID(), partID, deltaBuf.delData, meta)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
 
-	for _, fbl := range deltaInfo {
-		for _, deltaLogInfo := range fbl.GetBinlogs() {
-			deltaLogInfo.LogSize = deltaBuf.GetLogSize()
-			deltaLogInfo.TimestampFrom = deltaBuf.GetTimestampFrom()
-			deltaLogInfo.TimestampTo = deltaBuf.GetTimestampTo()
-			deltaLogInfo.EntriesNum = deltaBuf.GetEntriesNum()
+		for _, fbl := range deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.LogSize = deltaBuf.GetLogSize()
+				deltaLogInfo.TimestampFrom = deltaBuf.GetTimestampFrom()
+				deltaLogInfo.TimestampTo = deltaBuf.GetTimestampTo()
+				deltaLogInfo.EntriesNum = deltaBuf.GetEntriesNum()
+			}
 		}
+		segment.Deltalogs = deltaInfo
 	}
+	log.Info("upload delta log elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(uploadDeltaStart))))
 
 	pack := &datapb.CompactionResult{
 		PlanID:              t.plan.GetPlanID(),
-		SegmentID:           targetSegID,
-		InsertLogs:          inPaths,
-		Field2StatslogPaths: statsPaths,
-		Deltalogs:           deltaInfo,
-		NumOfRows:           numRows,
+		SegmentID:           segments[0].GetSegmentID(),
+		InsertLogs:          segments[0].GetInsertLogs(),
+		Field2StatslogPaths: segments[0].GetField2StatslogPaths(),
+		Deltalogs:           segments[0].GetDeltalogs(),
+		NumOfRows:           segments[0].GetNumOfRows(),
 		Channel:             t.plan.GetChannel(),
 	}
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		pack.Segments = segments
+	}
 
 	uninjectStart := time.Now()
 	ti.injectDone(true)
@@ -678,10 +923,11 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	log.Info("compaction done",
 		zap.Int64("planID", t.plan.GetPlanID()),
 		zap.Int64("targetSegmentID", targetSegID),
+		zap.Int("num of target segments", len(segments)),
 		zap.Int64s("compactedFrom", segIDs),
-		zap.Int("num of binlog paths", len(inPaths)),
-		zap.Int("num of stats paths", len(statsPaths)),
-		zap.Int("num of delta paths", len(deltaInfo)),
+		zap.Int("num of binlog paths", len(pack.GetInsertLogs())),
+		zap.Int("num of stats paths", len(pack.GetField2StatslogPaths())),
+		zap.Int("num of delta paths", len(pack.GetDeltalogs())),
 	)
 
 	log.Info("overall elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(compactStart))))
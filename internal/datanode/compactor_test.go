@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -458,6 +459,146 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		})
 	})
 
+	t.Run("Test cluster", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+		meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+		for _, field := range meta.GetSchema().GetFields() {
+			if field.GetFieldID() == 105 {
+				field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.ClusteringKeyKey, Value: "true"})
+			}
+		}
+
+		alloc := NewAllocatorFactory(1)
+		mockbIO := &binlogIO{cm, alloc}
+		inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, genInsertDataWithExpiredTS(), meta)
+		assert.NoError(t, err)
+		var ps []string
+		for _, path := range inpath {
+			ps = append(ps, path.GetBinlogs()[0].GetLogPath())
+		}
+		allPaths := [][]string{ps}
+
+		t.Run("cluster into segments", func(t *testing.T) {
+			ct := &compactionTask{
+				downloader:         mockbIO,
+				uploader:           mockbIO,
+				allocatorInterface: alloc,
+				plan: &datapb.CompactionPlan{
+					Type:               datapb.CompactionType_ClusteringCompaction,
+					ClusteringKeyField: 105,
+					MaxSegmentRows:     1,
+				},
+			}
+			segments, err := ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{})
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(segments))
+			assert.Equal(t, int64(2), segments[0].GetSegmentID())
+			assert.Equal(t, int64(19530), segments[1].GetSegmentID())
+			for _, segment := range segments {
+				assert.Equal(t, int64(1), segment.GetNumOfRows())
+				assert.Equal(t, 12, len(segment.GetInsertLogs()))
+				// pk stats and clustering key stats
+				assert.Equal(t, 2, len(segment.GetField2StatslogPaths()))
+			}
+		})
+
+		t.Run("cluster by key ranges", func(t *testing.T) {
+			newPlan := func(boundaries ...int64) *datapb.CompactionPlan {
+				return &datapb.CompactionPlan{
+					Type:               datapb.CompactionType_ClusteringCompaction,
+					ClusteringKeyField: 105,
+					MaxSegmentRows:     10,
+					ClusteringKeyBoundaries: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: boundaries}},
+					},
+				}
+			}
+			ct := &compactionTask{
+				downloader:         mockbIO,
+				uploader:           mockbIO,
+				allocatorInterface: alloc,
+			}
+
+			// the keys 9 and 10 belong to different ranges
+			ct.plan = newPlan(5, 10)
+			segments, err := ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{})
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(segments))
+			for _, segment := range segments {
+				assert.Equal(t, int64(1), segment.GetNumOfRows())
+			}
+
+			// the keys 9 and 10 belong to the same range
+			ct.plan = newPlan(5, 20)
+			segments, err = ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(segments))
+			assert.Equal(t, int64(2), segments[0].GetNumOfRows())
+
+			ct.plan.ClusteringKeyBoundaries = &schemapb.ScalarField{}
+			_, err = ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{})
+			assert.Error(t, err)
+		})
+
+		t.Run("all rows deleted", func(t *testing.T) {
+			ct := &compactionTask{
+				downloader:         mockbIO,
+				uploader:           mockbIO,
+				allocatorInterface: alloc,
+				plan: &datapb.CompactionPlan{
+					Type:               datapb.CompactionType_ClusteringCompaction,
+					ClusteringKeyField: 105,
+					MaxSegmentRows:     1,
+				},
+			}
+			segments, err := ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{1: math.MaxUint64, 2: math.MaxUint64})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(segments))
+			assert.Equal(t, int64(2), segments[0].GetSegmentID())
+			assert.Equal(t, int64(0), segments[0].GetNumOfRows())
+		})
+
+		t.Run("clustering key not found", func(t *testing.T) {
+			ct := &compactionTask{
+				downloader: mockbIO,
+				uploader:   mockbIO,
+				plan: &datapb.CompactionPlan{
+					Type:               datapb.CompactionType_ClusteringCompaction,
+					ClusteringKeyField: 999,
+				},
+			}
+			_, err := ct.cluster(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{})
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("Test isDeletedValue", func(t *testing.T) {
+		delta := map[interface{}]Timestamp{
+			int64(1): 100,
+		}
+		tests := []struct {
+			description string
+			pk          int64
+			ts          int64
+			isDeleted   bool
+		}{
+			{"inserted before the delete", 1, 99, true},
+			// upsert writes the delete and the insert with the same timestamp
+			{"inserted with the delete", 1, 100, false},
+			{"inserted after the delete", 1, 101, false},
+			{"not deleted", 2, 99, false},
+		}
+		for _, test := range tests {
+			t.Run(test.description, func(t *testing.T) {
+				v := &storage.Value{
+					PK:        storage.NewInt64PrimaryKey(test.pk),
+					Timestamp: test.ts,
+				}
+				assert.Equal(t, test.isDeleted, isDeletedValue(delta, v))
+			})
+		}
+	})
+
 	t.Run("Test isExpiredEntity", func(t *testing.T) {
 		t.Run("When CompactionEntityExpiration is set math.MaxInt64", func(t *testing.T) {
 			ct := &compactionTask{
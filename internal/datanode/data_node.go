@@ -74,6 +74,10 @@ const (
 	// ImportCallTimeout is the timeout used in Import() method calls
 	// This value is equal to RootCoord's task expire time
 	ImportCallTimeout = 15 * 60 * time.Second
+
+	// ExportCallTimeout is the timeout used in ExportSegment() method calls
+	// This value is equal to RootCoord's timeout of exporting one segment
+	ExportCallTimeout = 60 * 60 * time.Second
 )
 
 var getFlowGraphServiceAttempts = uint(50)
@@ -25,7 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -104,7 +104,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
-	var spans []opentracing.Span
+	var spans []oteltrace.Span
 	for _, msg := range msMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -232,7 +232,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 	fgMsg.endPositions = append(fgMsg.endPositions, msMsg.EndPositions()...)
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	return []Msg{&fgMsg}
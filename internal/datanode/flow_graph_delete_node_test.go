@@ -215,6 +215,45 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		}
 	})
 
+	t.Run("Test delete of upsert", func(t *testing.T) {
+		channel := &ChannelMeta{
+			channelName: chanName,
+			segments:    make(map[UniqueID]*Segment),
+		}
+		oldSeg := &Segment{segmentID: 1}
+		oldSeg.setType(datapb.SegmentType_Flushed)
+		oldSeg.updatePKRange(&storage.Int64FieldData{Data: []int64{1, 2}})
+		newSeg := &Segment{segmentID: 2}
+		newSeg.setType(datapb.SegmentType_New)
+		// the insert of an upsert is buffered by insertBufferNode before its delete reaches deleteNode
+		newSeg.updatePKRange(&storage.Int64FieldData{Data: []int64{1}})
+		channel.segments[oldSeg.segmentID] = oldSeg
+		channel.segments[newSeg.segmentID] = newSeg
+
+		fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, channel, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+		c := &nodeConfig{
+			channel:      channel,
+			allocator:    &allocator{},
+			vChannelName: chanName,
+		}
+		delBufManager := &DelBufferManager{
+			channel:       channel,
+			delMemorySize: 0,
+			delBufHeap:    &PriorityQueue{},
+		}
+		dn, err := newDeleteNode(context.Background(), fm, delBufManager, make(chan string, 1), c)
+		assert.Nil(t, err)
+
+		// the delete is kept for both segments with the timestamp of the upsert, it only deletes the entities
+		// inserted before the upsert, the new segment may hold an older entity with the same pk too
+		upsertTs := Timestamp(100)
+		segID2Pks, segID2Tss := dn.filterSegmentByPK(0, []primaryKey{newInt64PrimaryKey(1)}, []Timestamp{upsertTs})
+		for _, segID := range []UniqueID{oldSeg.segmentID, newSeg.segmentID} {
+			assert.Equal(t, 1, len(segID2Pks[segID]))
+			assert.Equal(t, []uint64{upsertTs}, segID2Tss[segID])
+		}
+	})
+
 	channel := genMockChannel(segIDs, int64Pks, chanName)
 	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, channel, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
 	t.Run("Test get segment by int64 primary keys", func(te *testing.T) {
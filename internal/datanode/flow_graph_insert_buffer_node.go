@@ -24,7 +24,7 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -113,7 +113,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		ibNode.flushManager.startDropping()
 	}
 
-	var spans []opentracing.Span
+	var spans []oteltrace.Span
 	for _, msg := range fgMsg.insertMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -182,7 +182,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	}
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	// send delete msg to DeleteNode
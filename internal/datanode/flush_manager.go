@@ -432,7 +432,17 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	}, field2Insert, field2Stats, flushed, dropped, pos)
 
 	metrics.DataNodeEncodeBufferLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return statsBinlogs, nil
+
+	// only the pk stats are returned to update the bloom filter, the others are min/max stats of the scalar fields
+	pkStatsBinlogs := lo.Filter(statsBinlogs, func(blob *Blob, _ int) bool {
+		for _, field := range meta.GetSchema().GetFields() {
+			if field.GetIsPrimaryKey() {
+				return blob.GetKey() == strconv.FormatInt(field.GetFieldID(), 10)
+			}
+		}
+		return true
+	})
+	return pkStatsBinlogs, nil
 }
 
 // notify flush manager del buffer data
@@ -191,6 +191,9 @@ type RootCoordFactory struct {
 
 	ReportImportErr        bool
 	ReportImportNotSuccess bool
+
+	ReportExportErr bool
+	ExportResult    *rootcoordpb.ExportSegmentResult
 }
 
 type DataCoordFactory struct {
@@ -1058,6 +1061,16 @@ func (m *RootCoordFactory) ReportImport(ctx context.Context, req *rootcoordpb.Im
 	}, nil
 }
 
+func (m *RootCoordFactory) ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	if m.ReportExportErr {
+		return nil, fmt.Errorf("mock report export error")
+	}
+	m.ExportResult = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // FailMessageStreamFactory mock MessageStreamFactory failure
 type FailMessageStreamFactory struct {
 	dependency.Factory
@@ -34,11 +34,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
@@ -46,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/segcorewrapper"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -415,28 +418,53 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		return status, nil
 	}
 
+	// a clustering compaction compacts the segments into more than one target segment
+	compactedTo := req.GetCompactedToSegments()
+	if len(compactedTo) == 0 {
+		compactedTo = []*datapb.CompactionSegment{{
+			SegmentID:           req.GetCompactedTo(),
+			NumOfRows:           req.GetNumOfRows(),
+			Field2StatslogPaths: req.GetStatsLogs(),
+		}}
+	}
+
 	// oneSegment is definitely in the channel, guaranteed by the check before.
 	collID, partID, _ := channel.getCollectionAndPartitionID(oneSegment)
-	targetSeg := &Segment{
-		collectionID: collID,
-		partitionID:  partID,
-		segmentID:    req.GetCompactedTo(),
-		numRows:      req.GetNumOfRows(),
-	}
+	targetSegs := make([]*Segment, 0, len(compactedTo))
+	for _, target := range compactedTo {
+		targetSeg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    target.GetSegmentID(),
+			numRows:      target.GetNumOfRows(),
+		}
 
-	err := channel.InitPKstats(ctx, targetSeg, req.GetStatsLogs(), tsoutil.GetCurrentTime())
-	if err != nil {
-		status.Reason = fmt.Sprintf("init pk stats fail, err=%s", err.Error())
-		return status, nil
+		err := channel.InitPKstats(ctx, targetSeg, target.GetField2StatslogPaths(), tsoutil.GetCurrentTime())
+		if err != nil {
+			status.Reason = fmt.Sprintf("init pk stats fail, err=%s", err.Error())
+			return status, nil
+		}
+		targetSegs = append(targetSegs, targetSeg)
 	}
 
 	// block all flow graph so it's safe to remove segment
 	ds.fg.Blockall()
 	defer ds.fg.Unblock()
-	if err := channel.mergeFlushedSegments(targetSeg, req.GetPlanID(), req.GetCompactedFrom()); err != nil {
+	if err := channel.mergeFlushedSegments(targetSegs[0], req.GetPlanID(), req.GetCompactedFrom()); err != nil {
 		status.Reason = err.Error()
 		return status, nil
 	}
+	for _, targetSeg := range targetSegs[1:] {
+		// the compacted segments are already marked as compacted to the first target segment
+		if err := channel.mergeFlushedSegments(targetSeg, req.GetPlanID(), nil); err != nil {
+			status.Reason = err.Error()
+			return status, nil
+		}
+		// the buffered deletes of the compacted segments may hit any of the target segments
+		if targetSeg.numRows > 0 {
+			ds.delBufferManager.CopySegBuf(targetSeg.segmentID, req.GetCompactedFrom())
+		}
+	}
 
 	status.ErrorCode = commonpb.ErrorCode_Success
 	return status, nil
@@ -570,12 +598,23 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	// todo: pass tsStart and tsStart after import_wrapper support
 	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetImportTask().GetInfos())
 	isBackup := importutil.IsBackup(req.GetImportTask().GetInfos())
+	isDryRun := importutil.IsDryRun(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	csvOptions, err := importutil.ParseCSVOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	// a resumed task continues from the checkpoint reported by the lost DataNode
+	checkpoint, err := importutil.ParseCheckpoint(req.GetImportTask().GetInfos())
 	if err != nil {
 		return returnFailFunc(err)
 	}
 	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup,
+			DryRun: isDryRun, Checkpoint: checkpoint, CSV: csvOptions})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -586,6 +625,149 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	return resp, nil
 }
 
+// ExportSegment writes the entities of a flushed segment visible at the snapshot timestamp into files on MinIO/S3
+// storage, the written files are reported to RootCoord
+func (node *DataNode) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*commonpb.Status, error) {
+	task := req.GetExportTask()
+	log.Info("DataNode receive export segment request",
+		zap.Int64("task ID", task.GetTaskId()),
+		zap.Int64("collection ID", task.GetCollectionId()),
+		zap.Int64("segment ID", task.GetSegment().GetSegmentID()),
+		zap.String("path", task.GetPath()),
+		zap.String("format", task.GetFormat()),
+		zap.Int64s("working dataNodes", req.GetWorkingNodes()))
+
+	exportResult := &rootcoordpb.ExportSegmentResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     task.GetTaskId(),
+		SegmentId:  task.GetSegment().GetSegmentID(),
+		DatanodeId: paramtable.GetNodeID(),
+	}
+
+	// Spawn a new context to ignore cancellation from parental context.
+	newCtx, cancel := context.WithTimeout(context.TODO(), ExportCallTimeout)
+	defer cancel()
+
+	var err error
+	if !node.isHealthy() {
+		err = errDataNodeIsUnhealthy(paramtable.GetNodeID())
+	} else {
+		exportResult.Files, exportResult.RowCount, err = exportSegment(newCtx, node.chunkManager, task)
+	}
+	if err != nil {
+		log.Warn("DataNode export segment failed",
+			zap.Int64("task ID", task.GetTaskId()),
+			zap.Int64("segment ID", task.GetSegment().GetSegmentID()),
+			zap.Error(err))
+		exportResult.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+	}
+
+	// the export task on RootCoord waits for the result of the segment, report it even if the export failed
+	status, err := node.rootCoord.ReportExport(newCtx, exportResult)
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		log.Warn("fail to report export segment result to RootCoord",
+			zap.Int64("task ID", task.GetTaskId()),
+			zap.Int64("segment ID", task.GetSegment().GetSegmentID()),
+			zap.Error(err))
+		// the export task has failed or can't know the written files, nobody else removes them
+		if len(exportResult.GetFiles()) > 0 {
+			if removeErr := node.chunkManager.MultiRemove(newCtx, exportResult.GetFiles()); removeErr != nil {
+				log.Warn("fail to remove the files of unreported export segment",
+					zap.Int64("task ID", task.GetTaskId()),
+					zap.Strings("files", exportResult.GetFiles()),
+					zap.Error(removeErr))
+			}
+		}
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return exportResult.GetStatus(), nil
+}
+
+// exportSegment reads the segment at the snapshot timestamp, filters the entities by the boolean expression and
+// writes them into files named by the segment id and the DataNode id, so that the files of a segment dispatched
+// again to another DataNode never overwrite each other. It returns the written files and the row count, the
+// segments whose entities are all filtered out write no file.
+func exportSegment(ctx context.Context, cm storage.ChunkManager, task *datapb.ExportSegmentTask) ([]string, int64, error) {
+	schema := task.GetSchema()
+	var plan *planpb.PlanNode
+	if task.GetExpr() != "" {
+		var err error
+		plan, err = planparserv2.CreateRetrievePlan(schema, task.GetExpr())
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid export expr: %w", err)
+		}
+	}
+
+	segment := task.GetSegment()
+	sd, err := storage.ReadSegmentDataAt(ctx, cm, schema, exportBinlogPaths(segment), task.GetTimestamp())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read segment %d: %w", segment.GetSegmentID(), err)
+	}
+	if plan != nil {
+		if err := filterSegmentData(sd, plan, task.GetTimestamp()); err != nil {
+			return nil, 0, fmt.Errorf("failed to filter segment %d: %w", segment.GetSegmentID(), err)
+		}
+	}
+	if sd.RowNum() == 0 {
+		return nil, 0, nil
+	}
+
+	sd.DropSystemFields()
+	files, err := storage.WriteSegmentFiles(ctx, cm, sd, task.GetFormat(), task.GetPath(), fmt.Sprintf("%d_%d", segment.GetSegmentID(), paramtable.GetNodeID()))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to write segment %d: %w", segment.GetSegmentID(), err)
+	}
+	return files, int64(sd.RowNum()), nil
+}
+
+// exportBinlogPaths collects the insert logs and delta logs of a segment, the stats logs are not needed by export.
+func exportBinlogPaths(segment *datapb.SegmentBinlogs) *storage.SegmentBinlogPaths {
+	paths := &storage.SegmentBinlogPaths{}
+	for _, fieldBinlog := range segment.GetFieldBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths.InsertLogs = append(paths.InsertLogs, binlog.GetLogPath())
+		}
+	}
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths.DeltaLogs = append(paths.DeltaLogs, binlog.GetLogPath())
+		}
+	}
+	return paths
+}
+
+// filterSegmentData keeps the rows of the segment data which match the filter of the retrieve plan, the filter is
+// evaluated by segcore on the rows as a query does.
+func filterSegmentData(sd *storage.SegmentData, plan *planpb.PlanNode, ts Timestamp) error {
+	matched, err := segcorewrapper.Retrieve(sd.Schema, sd.Data, plan, ts)
+	if err != nil {
+		return err
+	}
+	matchedSet := make(map[int]struct{}, len(matched))
+	for _, offset := range matched {
+		matchedSet[int(offset)] = struct{}{}
+	}
+	offsets := make([]int, 0, len(matched))
+	for _, offset := range sd.Offsets {
+		if _, ok := matchedSet[offset]; ok {
+			offsets = append(offsets, offset)
+		}
+	}
+	sd.Offsets = offsets
+	return nil
+}
+
 // AddImportSegment adds the import segment to the current DataNode.
 func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	log.Info("adding segment to DataNode flow graph",
@@ -18,8 +18,10 @@ package datanode
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/rand"
+	"path"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -32,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -546,6 +549,111 @@ func (s *DataNodeServicesSuite) TestImport() {
 	})
 }
 
+func (s *DataNodeServicesSuite) TestExportSegment() {
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{10, 10, 30}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			101:                   &storage.FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{0, 1, 2, 3, 4, 5}, Dim: 2},
+		},
+	}
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: 100, Schema: schema})
+	insertBlobs, _, err := codec.Serialize(10, 1000, insertData)
+	s.Require().NoError(err)
+	segment := &datapb.SegmentBinlogs{SegmentID: 1000}
+	for _, blob := range insertBlobs {
+		logPath := path.Join(s.node.chunkManager.RootPath(), "export", "insert_log", blob.Key)
+		s.Require().NoError(s.node.chunkManager.Write(s.ctx, logPath, blob.Value))
+		segment.FieldBinlogs = append(segment.FieldBinlogs, &datapb.FieldBinlog{
+			Binlogs: []*datapb.Binlog{{LogPath: logPath}},
+		})
+	}
+	exportPath := path.Join(s.node.chunkManager.RootPath(), "export", "output")
+	newRequest := func(expr string) *datapb.ExportSegmentRequest {
+		return &datapb.ExportSegmentRequest{
+			ExportTask: &datapb.ExportSegmentTask{
+				TaskId:       1,
+				CollectionId: 100,
+				Schema:       schema,
+				Segment:      segment,
+				Path:         exportPath,
+				Format:       storage.ExportFormatJSON,
+				Timestamp:    20,
+				Expr:         expr,
+			},
+		}
+	}
+
+	s.Run("normal case", func() {
+		rootCoord := &RootCoordFactory{}
+		s.node.rootCoord = rootCoord
+		// the row of pk 3 is inserted after the snapshot
+		stat, err := s.node.ExportSegment(s.ctx, newRequest("pk > 1"))
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_Success, stat.GetErrorCode())
+		s.Assert().Equal(commonpb.ErrorCode_Success, rootCoord.ExportResult.GetStatus().GetErrorCode())
+		s.Assert().Equal(int64(1), rootCoord.ExportResult.GetTaskId())
+		s.Assert().Equal(int64(1000), rootCoord.ExportResult.GetSegmentId())
+		s.Assert().Equal(int64(1), rootCoord.ExportResult.GetRowCount())
+		s.Assert().Equal([]string{path.Join(exportPath, fmt.Sprintf("1000_%d.json", paramtable.GetNodeID()))}, rootCoord.ExportResult.GetFiles())
+
+		// no file is written if all the entities are filtered out
+		stat, err = s.node.ExportSegment(s.ctx, newRequest("pk > 10"))
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_Success, stat.GetErrorCode())
+		s.Assert().Equal(int64(0), rootCoord.ExportResult.GetRowCount())
+		s.Assert().Empty(rootCoord.ExportResult.GetFiles())
+	})
+
+	s.Run("export failed", func() {
+		rootCoord := &RootCoordFactory{}
+		s.node.rootCoord = rootCoord
+		stat, err := s.node.ExportSegment(s.ctx, newRequest("unknown > 1"))
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, rootCoord.ExportResult.GetStatus().GetErrorCode())
+
+		req := newRequest("")
+		req.ExportTask.Format = "xml"
+		stat, err = s.node.ExportSegment(s.ctx, req)
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, rootCoord.ExportResult.GetStatus().GetErrorCode())
+	})
+
+	s.Run("report export error", func() {
+		s.node.rootCoord = &RootCoordFactory{ReportExportErr: true}
+		stat, err := s.node.ExportSegment(s.ctx, newRequest(""))
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		// the written file is removed as it can't be reported
+		exist, err := s.node.chunkManager.Exist(s.ctx, path.Join(exportPath, fmt.Sprintf("1000_%d.json", paramtable.GetNodeID())))
+		s.Assert().NoError(err)
+		s.Assert().False(exist)
+	})
+
+	s.Run("not healthy", func() {
+		rootCoord := &RootCoordFactory{}
+		s.node.rootCoord = rootCoord
+		s.node.stateCode.Store(commonpb.StateCode_Abnormal)
+		stat, err := s.node.ExportSegment(s.ctx, newRequest(""))
+		s.Assert().NoError(err)
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		s.Assert().Equal(commonpb.ErrorCode_UnexpectedError, rootCoord.ExportResult.GetStatus().GetErrorCode())
+	})
+}
+
 func (s *DataNodeServicesSuite) TestAddImportSegment() {
 	s.Run("test AddSegment", func() {
 		s.node.rootCoord = &RootCoordFactory{
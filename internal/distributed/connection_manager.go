@@ -26,7 +26,6 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -36,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
@@ -403,7 +403,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithMax(3),
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
-						grpc_opentracing.UnaryClientInterceptor(opts...),
+						otelgrpc.UnaryClientInterceptor(opts...),
 					)),
 				grpc.WithStreamInterceptor(
 					grpc_middleware.ChainStreamClient(
@@ -411,7 +411,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithMax(3),
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
-						grpc_opentracing.StreamClientInterceptor(opts...),
+						otelgrpc.StreamClientInterceptor(opts...),
 					)),
 			)
 			if err != nil {
@@ -637,6 +637,25 @@ func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 	return ret.(*datapb.ImportTaskResponse), err
 }
 
+// ExportSegment sends a segment of an export task to a free DataNode
+func (c *Client) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.sess.ServerID)),
+	)
+	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataCoordClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ExportSegment(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ExportSegmentResponse), err
+}
+
 // UpdateSegmentStatistics is the client side caller of UpdateSegmentStatistics.
 func (c *Client) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	req = typeutil.Clone(req)
@@ -62,6 +62,7 @@ type MockDataCoord struct {
 	dropVChanResp             *datapb.DropVirtualChannelResponse
 	setSegmentStateResp       *datapb.SetSegmentStateResponse
 	importResp                *datapb.ImportTaskResponse
+	exportSegmentResp         *datapb.ExportSegmentResponse
 	updateSegStatResp         *commonpb.Status
 	updateChanPos             *commonpb.Status
 	acquireSegLockResp        *commonpb.Status
@@ -201,6 +202,10 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return m.importResp, m.err
 }
 
+func (m *MockDataCoord) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	return m.exportSegmentResp, m.err
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return m.updateSegStatResp, m.err
 }
@@ -474,6 +479,17 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("export segment", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			exportSegmentResp: &datapb.ExportSegmentResponse{
+				Status: &commonpb.Status{},
+			},
+		}
+		resp, err := server.ExportSegment(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("update seg stat", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			updateSegStatResp: &commonpb.Status{
@@ -262,6 +262,24 @@ func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*co
 	return ret.(*commonpb.Status), err
 }
 
+// ExportSegment writes the entities of a flushed segment into files on MinIO/S3 storage
+func (c *Client) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
+	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ExportSegment(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 func (c *Client) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
 	req = typeutil.Clone(req)
 	commonpbutil.UpdateMsgBase(
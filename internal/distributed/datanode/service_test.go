@@ -131,6 +131,10 @@ func (m *MockDataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest
 	return m.status, m.err
 }
 
+func (m *MockDataNode) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
 	return m.resendResp, m.err
 }
@@ -297,6 +301,15 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("ExportSegment", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.ExportSegment(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("ResendSegmentStats", func(t *testing.T) {
 		server.datanode = &MockDataNode{
 			resendResp: &datapb.ResendSegmentStatsResponse{},
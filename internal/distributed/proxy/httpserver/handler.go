@@ -5,7 +5,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -34,6 +38,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.POST("/collection/rename", wrapHandler(h.handleRenameCollection))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -55,6 +60,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
+	router.PUT("/entities", wrapHandler(h.handleUpsert))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
 
@@ -75,6 +81,9 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
 	router.GET("/import/tasks", wrapHandler(h.handleListImportTasks))
 
+	router.POST("/export", wrapHandler(h.handleExport))
+	router.GET("/export/state", wrapHandler(h.handleGetExportState))
+
 	router.POST("/credential", wrapHandler(h.handleCreateCredential))
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
 	router.DELETE("/credential", wrapHandler(h.handleDeleteCredential))
@@ -127,6 +136,15 @@ func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
 	return h.proxy.DropCollection(c, &req)
 }
 
+func (h *Handlers) handleRenameCollection(c *gin.Context) (interface{}, error) {
+	req := proxypb.RenameCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RenameCollection(c, &req)
+}
+
 func (h *Handlers) handleHasCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.HasCollectionRequest{}
 	err := shouldBind(c, &req)
@@ -338,6 +356,19 @@ func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	return h.proxy.Delete(c, &req)
 }
 
+func (h *Handlers) handleUpsert(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedInsertRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	req, err := wrappedReq.AsUpsertRequest()
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert body to pb failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Upsert(c, req)
+}
+
 func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	wrappedReq := SearchRequest{}
 	err := shouldBind(c, &wrappedReq)
@@ -352,7 +383,7 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 		Dsl:                wrappedReq.Dsl,
 		DslType:            wrappedReq.DslType,
 		OutputFields:       wrappedReq.OutputFields,
-		SearchParams:       wrappedReq.SearchParams,
+		SearchParams:       wrappedReq.AsSearchParams(),
 		TravelTimestamp:    wrappedReq.TravelTimestamp,
 		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
 		Nq:                 wrappedReq.Nq,
@@ -371,7 +402,22 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Query(c, &req)
+	// the cursor of query iterator is sent in the grpc header, which is recorded by the stream and returned in the body
+	stream := &headerStream{method: "Query"}
+	resp, err := h.proxy.Query(grpc.NewContextWithServerTransportStream(c, stream), &req)
+	if err != nil {
+		return nil, err
+	}
+	cursors := stream.header.Get(common.QueryIteratorCursorHeader)
+	if len(cursors) == 0 {
+		return resp, nil
+	}
+	return &QueryResultsWithCursor{
+		Status:         resp.GetStatus(),
+		FieldsData:     resp.GetFieldsData(),
+		CollectionName: resp.GetCollectionName(),
+		IteratorCursor: cursors[0],
+	}, nil
 }
 
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
@@ -507,6 +553,24 @@ func (h *Handlers) handleListImportTasks(c *gin.Context) (interface{}, error) {
 	return h.proxy.ListImportTasks(c, &req)
 }
 
+func (h *Handlers) handleExport(c *gin.Context) (interface{}, error) {
+	req := proxypb.ExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Export(c, &req)
+}
+
+func (h *Handlers) handleGetExportState(c *gin.Context) (interface{}, error) {
+	req := proxypb.GetExportStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetExportState(c, &req)
+}
+
 func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateCredentialRequest{}
 	err := shouldBind(c, &req)
@@ -15,8 +15,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func Test_WrappedInsertRequest_JSONMarshal_AsInsertRequest(t *testing.T) {
@@ -56,6 +60,10 @@ func (m *mockProxyComponent) DropCollection(ctx context.Context, request *milvus
 	return testStatus, nil
 }
 
+func (m *mockProxyComponent) RenameCollection(ctx context.Context, request *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (m *mockProxyComponent) HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
 	return &milvuspb.BoolResponse{Status: testStatus}, nil
 }
@@ -156,6 +164,13 @@ func (m *mockProxyComponent) Delete(ctx context.Context, request *milvuspb.Delet
 	return &milvuspb.MutationResult{Acknowledged: true}, nil
 }
 
+func (m *mockProxyComponent) Upsert(ctx context.Context, request *proxypb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if request.CollectionName == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &milvuspb.MutationResult{Acknowledged: true}, nil
+}
+
 var searchResult = milvuspb.SearchResults{
 	Results: &schemapb.SearchResultData{
 		TopK: 10,
@@ -177,6 +192,11 @@ func (m *mockProxyComponent) Query(ctx context.Context, request *milvuspb.QueryR
 	if request.Expr == "" {
 		return nil, errors.New("body parse err")
 	}
+	if len(request.GetQueryParams()) > 0 {
+		if err := grpc.SetHeader(ctx, metadata.Pairs(common.QueryIteratorCursorHeader, "cursor")); err != nil {
+			return nil, err
+		}
+	}
 	return &queryResult, nil
 }
 
@@ -254,6 +274,14 @@ func (m *mockProxyComponent) ListImportTasks(ctx context.Context, request *milvu
 	return &milvuspb.ListImportTasksResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) Export(ctx context.Context, request *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	return &proxypb.ExportResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetExportState(ctx context.Context, request *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	return &proxypb.GetExportStateResponse{Status: testStatus}, nil
+}
+
 func (m *mockProxyComponent) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -374,6 +402,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/collection/rename", emptyBody,
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -442,6 +474,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodDelete, "/entities", milvuspb.DeleteRequest{Expr: "some expr"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
 		},
+		{
+			http.MethodPut, "/entities", &proxypb.UpsertRequest{CollectionName: "c1"},
+			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
+		},
 		{
 			http.MethodPost, "/search", milvuspb.SearchRequest{Dsl: "some dsl"},
 			http.StatusOK, &searchResult,
@@ -450,6 +486,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr", QueryParams: []*commonpb.KeyValuePair{{Key: "iterator", Value: "true"}}},
+			http.StatusOK, &QueryResultsWithCursor{CollectionName: "test", IteratorCursor: "cursor"},
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
@@ -509,6 +549,14 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/tasks", emptyBody,
 			http.StatusOK, &milvuspb.ListImportTasksResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/export", emptyBody,
+			http.StatusOK, &proxypb.ExportResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/state", emptyBody,
+			http.StatusOK, &proxypb.GetExportStateResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/credential", emptyBody,
 			http.StatusOK, testStatus,
@@ -6,11 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 // We wrap original protobuf structure for 2 reasons:
@@ -67,6 +70,23 @@ func (w *WrappedInsertRequest) AsInsertRequest() (*milvuspb.InsertRequest, error
 	}, nil
 }
 
+// AsUpsertRequest converts the wrapped request to an UpsertRequest, upsert shares the body format with insert
+func (w *WrappedInsertRequest) AsUpsertRequest() (*proxypb.UpsertRequest, error) {
+	fieldData, err := convertFieldDataArray(w.FieldsData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert field data failed: %v", errBadRequest, err)
+	}
+	return &proxypb.UpsertRequest{
+		Base:           w.Base,
+		DbName:         w.DbName,
+		CollectionName: w.CollectionName,
+		PartitionName:  w.PartitionName,
+		FieldsData:     fieldData,
+		HashKeys:       w.HashKeys,
+		NumRows:        w.NumRows,
+	}, nil
+}
+
 // FieldData is the field data in RESTful request that can be convertd to schemapb.FieldData
 type FieldData struct {
 	Type      schemapb.DataType `json:"type,omitempty"`
@@ -248,6 +268,21 @@ type SearchRequest struct {
 	TravelTimestamp    uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
+	// GroupByField groups the search results by a scalar field, GroupSize is the max number of hits in a group
+	GroupByField string `json:"group_by_field,omitempty"`
+	GroupSize    int64  `json:"group_size,omitempty"`
+}
+
+// AsSearchParams returns the search params with the group by params appended
+func (r *SearchRequest) AsSearchParams() []*commonpb.KeyValuePair {
+	searchParams := append([]*commonpb.KeyValuePair{}, r.SearchParams...)
+	if r.GroupByField != "" {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: common.GroupByFieldKey, Value: r.GroupByField})
+		if r.GroupSize > 0 {
+			searchParams = append(searchParams, &commonpb.KeyValuePair{Key: common.GroupSizeKey, Value: strconv.FormatInt(r.GroupSize, 10)})
+		}
+	}
+	return searchParams
 }
 
 func binaryVector2Bytes(vectors [][]byte) []byte {
@@ -378,3 +413,12 @@ type VectorIDs struct {
 	PartitionNames []string `json:"partition_names"`
 	IDArray        []int64  `json:"id_array,omitempty"`
 }
+
+// QueryResultsWithCursor is the QueryResults of query iterator, the cursor of the next page is returned
+// in the body, an empty cursor means the iterator is exhausted.
+type QueryResultsWithCursor struct {
+	Status         *commonpb.Status      `json:"status,omitempty"`
+	FieldsData     []*schemapb.FieldData `json:"fields_data,omitempty"`
+	CollectionName string                `json:"collection_name,omitempty"`
+	IteratorCursor string                `json:"iterator_cursor"`
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -283,3 +285,22 @@ func TestVectorsArray_AsPbVectorArray(t *testing.T) {
 		assert.Equal(t, ids, ints.IntId.Data)
 	})
 }
+
+func TestSearchRequest_AsSearchParams(t *testing.T) {
+	req := SearchRequest{
+		SearchParams: []*commonpb.KeyValuePair{{Key: "topk", Value: "10"}},
+	}
+	assert.Equal(t, 1, len(req.AsSearchParams()))
+
+	req.GroupByField = "doc_id"
+	params := req.AsSearchParams()
+	assert.Equal(t, 2, len(params))
+	assert.Equal(t, common.GroupByFieldKey, params[1].GetKey())
+	assert.Equal(t, "doc_id", params[1].GetValue())
+
+	req.GroupSize = 3
+	params = req.AsSearchParams()
+	assert.Equal(t, 3, len(params))
+	assert.Equal(t, common.GroupSizeKey, params[2].GetKey())
+	assert.Equal(t, "3", params[2].GetValue())
+}
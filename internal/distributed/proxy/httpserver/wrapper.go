@@ -7,6 +7,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/grpc/metadata"
+
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 )
 
@@ -20,6 +22,29 @@ type handlerFunc func(c *gin.Context) (interface{}, error)
 // ErrResponse of server
 type ErrResponse = commonpb.Status
 
+// headerStream records the grpc headers set by the proxy, so that the handlers are able to return them in the body.
+type headerStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return s.method
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
 // wrapHandler wraps a handlerFunc into a gin.HandlerFunc
 func wrapHandler(handle handlerFunc) gin.HandlerFunc {
 	return func(c *gin.Context) {
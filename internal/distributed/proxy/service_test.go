@@ -167,6 +167,18 @@ func (m *MockRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPa
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, req *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, req *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, req *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -179,6 +191,10 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) RenameCollection(ctx context.Context, req *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -223,6 +239,18 @@ func (m *MockRootCoord) ReportImport(ctx context.Context, req *rootcoordpb.Impor
 	return nil, nil
 }
 
+func (m *MockRootCoord) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -595,6 +623,10 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return nil, nil
 }
 
+func (m *MockDataCoord) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -750,6 +782,10 @@ func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *proxypb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return nil, nil
 }
@@ -794,6 +830,18 @@ func (m *MockProxy) LoadBalance(ctx context.Context, request *milvuspb.LoadBalan
 	return nil, nil
 }
 
+func (m *MockProxy) CreateDatabase(ctx context.Context, request *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, request *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, request *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -806,6 +854,10 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) RenameCollection(ctx context.Context, request *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRates(ctx context.Context, request *proxypb.SetRatesRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -872,6 +924,14 @@ func (m *MockProxy) ListImportTasks(ctx context.Context, in *milvuspb.ListImport
 	return nil, nil
 }
 
+func (m *MockProxy) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return nil, nil
 }
@@ -1204,6 +1264,26 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		_, err := server.Upsert(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateDatabase", func(t *testing.T) {
+		_, err := server.CreateDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabase", func(t *testing.T) {
+		_, err := server.DropDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabases", func(t *testing.T) {
+		_, err := server.ListDatabases(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Search", func(t *testing.T) {
 		_, err := server.Search(ctx, nil)
 		assert.Nil(t, err)
@@ -1274,6 +1354,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("RenameCollection", func(t *testing.T) {
+		_, err := server.RenameCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		_, err := server.Export(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetExportState", func(t *testing.T) {
+		_, err := server.GetExportState(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
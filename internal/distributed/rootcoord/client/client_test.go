@@ -148,6 +148,10 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.AlterAlias(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.RenameCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.Import(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -160,6 +164,14 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ReportImport(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.Export(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetExportState(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.CreateCredential(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -350,6 +362,10 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.AlterAlias(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.RenameCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.Import(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -362,6 +378,14 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ReportImport(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.Export(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetExportState(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CreateCredential(shortCtx, nil)
 		retCheck(rTimeout, err)
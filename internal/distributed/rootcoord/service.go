@@ -25,8 +25,8 @@ import (
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -78,6 +78,21 @@ func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthR
 	return s.rootCoord.CheckHealth(ctx, request)
 }
 
+// CreateDatabase creates a database.
+func (s *Server) CreateDatabase(ctx context.Context, request *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, request)
+}
+
+// DropDatabase drops the specified database.
+func (s *Server) DropDatabase(ctx context.Context, request *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, request)
+}
+
+// ListDatabases lists all the databases.
+func (s *Server) ListDatabases(ctx context.Context, request *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, request)
+}
+
 // CreateAlias creates an alias for specified collection.
 func (s *Server) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreateAlias(ctx, request)
@@ -93,6 +108,11 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// RenameCollection renames the specified collection.
+func (s *Server) RenameCollection(ctx context.Context, request *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, request)
+}
+
 // NewServer create a new RootCoord grpc server.
 func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
@@ -248,10 +268,10 @@ func (s *Server) startGrpcLoop(port int) {
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			ot.UnaryServerInterceptor(opts...),
+			otelgrpc.UnaryServerInterceptor(opts...),
 			logutil.UnaryTraceLoggerInterceptor)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			ot.StreamServerInterceptor(opts...),
+			otelgrpc.StreamServerInterceptor(opts...),
 			logutil.StreamTraceLoggerInterceptor)))
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
 
@@ -280,7 +300,7 @@ func (s *Server) Stop() error {
 	log.Debug("Rootcoord stop", zap.String("Address", Params.GetAddress()))
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
-			log.Error("Failed to close opentracing", zap.Error(err))
+			log.Error("Failed to close tracer", zap.Error(err))
 		}
 	}
 	if s.etcdCli != nil {
@@ -431,6 +451,21 @@ func (s *Server) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult)
 	return s.rootCoord.ReportImport(ctx, in)
 }
 
+// Export writes the entities of a collection to files on MinIO/S3 storage
+func (s *Server) Export(ctx context.Context, in *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	return s.rootCoord.Export(ctx, in)
+}
+
+// GetExportState gets the state of an export task
+func (s *Server) GetExportState(ctx context.Context, in *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	return s.rootCoord.GetExportState(ctx, in)
+}
+
+// ReportExport reports the result of a segment of an export task to rootcoord
+func (s *Server) ReportExport(ctx context.Context, in *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	return s.rootCoord.ReportExport(ctx, in)
+}
+
 func (s *Server) CreateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return s.rootCoord.CreateCredential(ctx, request)
 }
@@ -22,14 +22,14 @@ import (
 	"errors"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
 )
 
 // TaskQueue is a queue used to store tasks.
@@ -238,11 +238,9 @@ func (sched *TaskScheduler) scheduleIndexAddTask() task {
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.Ctx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-		})
-	defer span.Finish()
-	span.LogFields(oplog.String("scheduler process PreExecute", t.Name()))
+		oteltrace.WithAttributes(attribute.String("Type", t.Name())))
+	defer span.End()
+	span.AddEvent("scheduler process PreExecute")
 	err := t.PreExecute(ctx)
 
 	defer func() {
@@ -253,20 +251,20 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.String("scheduler process AddActiveTask", t.Name()))
+	span.AddEvent("scheduler process AddActiveTask")
 	q.AddActiveTask(t)
 	defer func() {
-		span.LogFields(oplog.String("scheduler process PopActiveTask", t.Name()))
+		span.AddEvent("scheduler process PopActiveTask")
 		q.PopActiveTask(t.ID())
 	}()
 
-	span.LogFields(oplog.String("scheduler process Execute", t.Name()))
+	span.AddEvent("scheduler process Execute")
 	err = t.Execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
 		return
 	}
-	span.LogFields(oplog.String("scheduler process PostExecute", t.Name()))
+	span.AddEvent("scheduler process PostExecute")
 	err = t.PostExecute(ctx)
 }
 
@@ -3,6 +3,7 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"sync"
 	"time"
@@ -110,6 +111,15 @@ func (c *mockChunkmgr) Write(ctx context.Context, filePath string, content []byt
 	return nil
 }
 
+func (c *mockChunkmgr) WriteFrom(ctx context.Context, filePath string, reader io.Reader) error {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	c.indexedData.Store(filePath, content)
+	return nil
+}
+
 func (c *mockChunkmgr) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	// TODO
 	return errNotImplErr
@@ -37,7 +37,6 @@ import (
 
 	"errors"
 
-	"github.com/uber/jaeger-client-go/utils"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
@@ -50,8 +49,6 @@ var (
 	_globalLevelLogger sync.Map
 )
 
-var rateLimiter *utils.ReconfigurableRateLimiter
-
 func init() {
 	l, p := newStdLogger()
 
@@ -62,7 +59,7 @@ func init() {
 	s := _globalL.Load().(*zap.Logger).Sugar()
 	_globalS.Store(s)
 
-	r := utils.NewRateLimiter(1.0, 60.0)
+	r := NewRateLimiter(1.0, 60.0)
 	_globalR.Store(r)
 
 }
@@ -167,9 +164,9 @@ func S() *zap.SugaredLogger {
 	return _globalS.Load().(*zap.SugaredLogger)
 }
 
-// R returns utils.ReconfigurableRateLimiter.
-func R() *utils.ReconfigurableRateLimiter {
-	return _globalR.Load().(*utils.ReconfigurableRateLimiter)
+// R returns the ReconfigurableRateLimiter used by rated logs.
+func R() *ReconfigurableRateLimiter {
+	return _globalR.Load().(*ReconfigurableRateLimiter)
 }
 
 func ctxL() *zap.Logger {
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+// ReconfigurableRateLimiter limits the rate of rated logs with a credit balance.
+// The balance is refilled by creditsPerSecond as time passes, up to maxBalance,
+// and every rated log pays its cost from the balance.
+type ReconfigurableRateLimiter struct {
+	mu sync.Mutex
+
+	creditsPerSecond float64
+	balance          float64
+	maxBalance       float64
+	lastTick         time.Time
+}
+
+// NewRateLimiter creates a ReconfigurableRateLimiter with a full balance.
+func NewRateLimiter(creditsPerSecond, maxBalance float64) *ReconfigurableRateLimiter {
+	return &ReconfigurableRateLimiter{
+		creditsPerSecond: creditsPerSecond,
+		balance:          maxBalance,
+		maxBalance:       maxBalance,
+		lastTick:         time.Now(),
+	}
+}
+
+// CheckCredit pays cost from the balance and returns true if the balance is enough,
+// otherwise it leaves the balance unchanged and returns false.
+func (r *ReconfigurableRateLimiter) CheckCredit(cost float64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill()
+	if r.balance < cost {
+		return false
+	}
+	r.balance -= cost
+	return true
+}
+
+// Update changes the rate and the max balance, scaling the current balance to the new max balance.
+func (r *ReconfigurableRateLimiter) Update(creditsPerSecond, maxBalance float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill()
+	if r.maxBalance > 0 {
+		r.balance = r.balance * maxBalance / r.maxBalance
+	}
+	r.creditsPerSecond = creditsPerSecond
+	r.maxBalance = maxBalance
+}
+
+// refill must be called with mu held.
+func (r *ReconfigurableRateLimiter) refill() {
+	now := time.Now()
+	r.balance += now.Sub(r.lastTick).Seconds() * r.creditsPerSecond
+	if r.balance > r.maxBalance {
+		r.balance = r.maxBalance
+	}
+	r.lastTick = now
+}
@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconfigurableRateLimiter(t *testing.T) {
+	r := NewRateLimiter(1.0, 2.0)
+	assert.True(t, r.CheckCredit(1.0))
+	assert.True(t, r.CheckCredit(1.0))
+	assert.False(t, r.CheckCredit(1.0))
+
+	r.lastTick = r.lastTick.Add(-time.Second)
+	assert.True(t, r.CheckCredit(1.0))
+	assert.False(t, r.CheckCredit(1.0))
+
+	r.Update(1.0, 4.0)
+	r.lastTick = r.lastTick.Add(-10 * time.Second)
+	assert.True(t, r.CheckCredit(4.0))
+	assert.False(t, r.CheckCredit(1.0))
+}
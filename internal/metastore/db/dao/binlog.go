@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type binlogDb struct {
+	db *gorm.DB
+}
+
+func (s *binlogDb) List(tenantID string) ([]*dbmodel.Binlog, error) {
+	var r []*dbmodel.Binlog
+
+	err := s.db.Model(&dbmodel.Binlog{}).Where("tenant_id = ?", tenantID).Order("id").Find(&r).Error
+	if err != nil {
+		log.Error("list binlogs failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *binlogDb) Insert(in []*dbmodel.Binlog) error {
+	err := s.db.CreateInBatches(in, 100).Error
+	if err != nil {
+		log.Error("insert binlogs failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *binlogDb) DeleteBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND segment_id IN ?", tenantID, segmentIDs).Delete(&dbmodel.Binlog{}).Error
+	if err != nil {
+		log.Error("delete binlogs by segment ids failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
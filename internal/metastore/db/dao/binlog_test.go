@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinlog_List(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			SegmentID:    segmentID1,
+			FieldID:      fieldID1,
+			LogType:      dbmodel.InsertBinlog,
+			LogID:        1,
+			NumEntries:   NumRows,
+			LogPath:      "files/insert_log/101/3001/2001/501/1",
+			LogSize:      1024,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "segment_id", "field_id", "log_type", "log_id", "num_entries", "log_path", "log_size", "created_at", "updated_at"}).
+				AddRow(binlogs[0].TenantID, binlogs[0].CollectionID, binlogs[0].PartitionID, binlogs[0].SegmentID, binlogs[0].FieldID, binlogs[0].LogType, binlogs[0].LogID, binlogs[0].NumEntries, binlogs[0].LogPath, binlogs[0].LogSize, binlogs[0].CreatedAt, binlogs[0].UpdatedAt))
+
+	// actual
+	res, err := binlogTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, binlogs, res)
+}
+
+func TestBinlog_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := binlogTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestBinlog_Insert(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			SegmentID:     segmentID1,
+			FieldID:       fieldID1,
+			LogType:       dbmodel.StatsBinlog,
+			LogID:         1,
+			NumEntries:    NumRows,
+			TimestampFrom: 1,
+			TimestampTo:   ts,
+			LogPath:       "files/stats_log/101/3001/2001/501/1",
+			LogSize:       1024,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`field_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(tenantID, collID1, partitionID1, segmentID1, fieldID1, dbmodel.StatsBinlog, int64(1), int64(NumRows), uint64(1), ts, binlogs[0].LogPath, int64(1024), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.Nil(t, err)
+}
+
+func TestBinlog_Insert_Error(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`collection_id`,`partition_id`,`segment_id`,`field_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.Error(t, err)
+}
+
+func TestBinlog_DeleteBySegmentIDs(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(tenantID, segmentID1).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.DeleteBySegmentIDs(tenantID, []int64{segmentID1})
+	assert.Nil(t, err)
+}
+
+func TestBinlog_DeleteBySegmentIDs_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.DeleteBySegmentIDs(tenantID, []int64{segmentID1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type channelCheckpointDb struct {
+	db *gorm.DB
+}
+
+func (s *channelCheckpointDb) List(tenantID string) ([]*dbmodel.ChannelCheckpoint, error) {
+	var r []*dbmodel.ChannelCheckpoint
+
+	err := s.db.Model(&dbmodel.ChannelCheckpoint{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list channel checkpoints failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *channelCheckpointDb) Upsert(in *dbmodel.ChannelCheckpoint) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, virtual_channel)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "virtual_channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position", "updated_at"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert channel checkpoint failed", zap.String("tenant", in.TenantID), zap.String("vChannel", in.VirtualChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *channelCheckpointDb) Delete(tenantID string, vChannel string) error {
+	err := s.db.Where("tenant_id = ? AND virtual_channel = ?", tenantID, vChannel).Delete(&dbmodel.ChannelCheckpoint{}).Error
+	if err != nil {
+		log.Error("delete channel checkpoint failed", zap.String("tenant", tenantID), zap.String("vChannel", vChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+type removedChannelDb struct {
+	db *gorm.DB
+}
+
+func (s *removedChannelDb) Exist(tenantID string, vChannel string) (bool, error) {
+	var r dbmodel.RemovedChannel
+
+	err := s.db.Model(&dbmodel.RemovedChannel{}).Where("tenant_id = ? AND virtual_channel = ?", tenantID, vChannel).Take(&r).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		log.Error("get removed channel failed", zap.String("tenant", tenantID), zap.String("vChannel", vChannel), zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *removedChannelDb) Insert(in *dbmodel.RemovedChannel) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, virtual_channel)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "virtual_channel"}},
+		DoNothing: true,
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("insert removed channel failed", zap.String("tenant", in.TenantID), zap.String("vChannel", in.VirtualChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *removedChannelDb) Delete(tenantID string, vChannel string) error {
+	err := s.db.Where("tenant_id = ? AND virtual_channel = ?", tenantID, vChannel).Delete(&dbmodel.RemovedChannel{}).Error
+	if err != nil {
+		log.Error("delete removed channel failed", zap.String("tenant", tenantID), zap.String("vChannel", vChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+const vChannel = "test_virtual_channel_1"
+
+func TestChannelCheckpoint_List(t *testing.T) {
+	var checkpoints = []*dbmodel.ChannelCheckpoint{
+		{
+			TenantID:       tenantID,
+			VirtualChannel: vChannel,
+			Position:       `{"channel_name":"test_virtual_channel_1","timestamp":10}`,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "virtual_channel", "position", "created_at", "updated_at"}).
+				AddRow(checkpoints[0].TenantID, checkpoints[0].VirtualChannel, checkpoints[0].Position, checkpoints[0].CreatedAt, checkpoints[0].UpdatedAt))
+
+	// actual
+	res, err := channelCPTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, checkpoints, res)
+}
+
+func TestChannelCheckpoint_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := channelCPTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Upsert(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:       tenantID,
+		VirtualChannel: vChannel,
+		Position:       `{"channel_name":"test_virtual_channel_1","timestamp":10}`,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`virtual_channel`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, vChannel, checkpoint.Position, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCPTestDb.Upsert(checkpoint)
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_Upsert_Error(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:       tenantID,
+		VirtualChannel: vChannel,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`virtual_channel`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCPTestDb.Upsert(checkpoint)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND virtual_channel = ?").
+		WithArgs(tenantID, vChannel).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCPTestDb.Delete(tenantID, vChannel)
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND virtual_channel = ?").
+		WithArgs(tenantID, vChannel).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCPTestDb.Delete(tenantID, vChannel)
+	assert.Error(t, err)
+}
+
+func TestRemovedChannel_Exist(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `removed_channels` WHERE tenant_id = ? AND virtual_channel = ? LIMIT 1").
+		WithArgs(tenantID, vChannel).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "virtual_channel"}).
+				AddRow(tenantID, vChannel))
+
+	// actual
+	exist, err := removedChTestDb.Exist(tenantID, vChannel)
+	assert.Nil(t, err)
+	assert.True(t, exist)
+}
+
+func TestRemovedChannel_Exist_NotFound(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `removed_channels` WHERE tenant_id = ? AND virtual_channel = ? LIMIT 1").
+		WithArgs(tenantID, vChannel).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "virtual_channel"}))
+
+	// actual
+	exist, err := removedChTestDb.Exist(tenantID, vChannel)
+	assert.Nil(t, err)
+	assert.False(t, exist)
+}
+
+func TestRemovedChannel_Exist_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `removed_channels` WHERE tenant_id = ? AND virtual_channel = ? LIMIT 1").
+		WithArgs(tenantID, vChannel).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	exist, err := removedChTestDb.Exist(tenantID, vChannel)
+	assert.Error(t, err)
+	assert.False(t, exist)
+}
+
+func TestRemovedChannel_Insert(t *testing.T) {
+	var removed = &dbmodel.RemovedChannel{
+		TenantID:       tenantID,
+		VirtualChannel: vChannel,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `removed_channels` (`tenant_id`,`virtual_channel`,`created_at`,`updated_at`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(tenantID, vChannel, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := removedChTestDb.Insert(removed)
+	assert.Nil(t, err)
+}
+
+func TestRemovedChannel_Insert_Error(t *testing.T) {
+	var removed = &dbmodel.RemovedChannel{
+		TenantID:       tenantID,
+		VirtualChannel: vChannel,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `removed_channels` (`tenant_id`,`virtual_channel`,`created_at`,`updated_at`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := removedChTestDb.Insert(removed)
+	assert.Error(t, err)
+}
+
+func TestRemovedChannel_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `removed_channels` WHERE tenant_id = ? AND virtual_channel = ?").
+		WithArgs(tenantID, vChannel).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := removedChTestDb.Delete(tenantID, vChannel)
+	assert.Nil(t, err)
+}
+
+func TestRemovedChannel_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `removed_channels` WHERE tenant_id = ? AND virtual_channel = ?").
+		WithArgs(tenantID, vChannel).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := removedChTestDb.Delete(tenantID, vChannel)
+	assert.Error(t, err)
+}
@@ -18,7 +18,7 @@ type collAliasDb struct {
 
 func (s *collAliasDb) Insert(in []*dbmodel.CollectionAlias) error {
 	err := s.db.Clauses(clause.OnConflict{
-		// constraint UNIQUE (tenant_id, collection_alias, ts)
+		// constraint UNIQUE (tenant_id, db_id, collection_alias, ts)
 		DoNothing: true,
 	}).Create(&in).Error
 
@@ -30,16 +30,16 @@ func (s *collAliasDb) Insert(in []*dbmodel.CollectionAlias) error {
 	return nil
 }
 
-func (s *collAliasDb) GetCollectionIDByAlias(tenantID string, alias string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+func (s *collAliasDb) GetCollectionIDByAlias(tenantID string, dbID int64, alias string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
 	var r dbmodel.CollectionAlias
 
-	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id").Where("tenant_id = ? AND collection_alias = ? AND ts <= ?", tenantID, alias, ts).Order("ts desc").Take(&r).Error
+	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id").Where("tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ?", tenantID, dbID, alias, ts).Order("ts desc").Take(&r).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return 0, fmt.Errorf("get collection_id by alias not found, alias=%s, ts=%d", alias, ts)
 	}
 	if err != nil {
-		log.Error("get collection_id by alias failed", zap.String("tenant", tenantID), zap.String("alias", alias), zap.Uint64("ts", ts), zap.Error(err))
+		log.Error("get collection_id by alias failed", zap.String("tenant", tenantID), zap.Int64("dbID", dbID), zap.String("alias", alias), zap.Uint64("ts", ts), zap.Error(err))
 		return 0, err
 	}
 
@@ -67,7 +67,7 @@ func (s *collAliasDb) List(tenantID string, cidTsPairs []*dbmodel.CollectionAlia
 		inValues = append(inValues, in)
 	}
 
-	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("collection_id, collection_alias").
+	err := s.db.Model(&dbmodel.CollectionAlias{}).Select("db_id, collection_id, collection_alias").
 		Where("tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ?", tenantID, inValues).Find(&collAliases).Error
 	if err != nil {
 		log.Error("list alias by collection_id and alias pairs failed", zap.String("tenant", tenantID), zap.Any("collIdTs", inValues), zap.Error(err))
@@ -27,8 +27,8 @@ func TestCollectionAlias_Insert(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collAliases[0].TenantID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`db_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collAliases[0].TenantID, collAliases[0].DbID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(100, 2))
 	mock.ExpectCommit()
 
@@ -52,8 +52,8 @@ func TestCollectionAlias_Insert_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collAliases[0].TenantID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`db_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collAliases[0].TenantID, collAliases[0].DbID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
@@ -66,14 +66,14 @@ func TestCollectionAlias_GetCollectionIDByName(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, alias, ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id"}).
 				AddRow(collID1))
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, dbID, alias, ts)
 	assert.Nil(t, err)
 	assert.Equal(t, collID1, res)
 }
@@ -82,12 +82,12 @@ func TestCollectionAlias_GetCollectionIDByName_Error(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, alias, ts).
 		WillReturnError(errors.New("test error"))
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, dbID, alias, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -96,12 +96,12 @@ func TestCollectionAlias_GetCollectionIDByName_ErrRecordNotFound(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, alias, ts).
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, dbID, alias, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -167,12 +167,12 @@ func TestCollectionAlias_List(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
+	mock.ExpectQuery("SELECT db_id, collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
 		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"collection_id", "collection_alias"}).
-				AddRow(collID1, "test_alias_1").
-				AddRow(collID2, "test_alias_2"))
+			sqlmock.NewRows([]string{"db_id", "collection_id", "collection_alias"}).
+				AddRow(0, collID1, "test_alias_1").
+				AddRow(0, collID2, "test_alias_2"))
 
 	// actual
 	res, err := aliasTestDb.List(tenantID, cidTsPairs)
@@ -193,7 +193,7 @@ func TestCollectionAlias_List_Error(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
+	mock.ExpectQuery("SELECT db_id, collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
 		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
 		WillReturnError(errors.New("test error"))
 
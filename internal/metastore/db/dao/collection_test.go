@@ -23,6 +23,7 @@ import (
 
 const (
 	tenantID      = "test_tenant"
+	dbID          = int64(1)
 	noTs          = typeutil.Timestamp(0)
 	ts            = typeutil.Timestamp(10)
 	collID1       = typeutil.UniqueID(101)
@@ -39,6 +40,7 @@ const (
 
 var (
 	mock            sqlmock.Sqlmock
+	dbTestDb        dbmodel.IDatabaseDb
 	collTestDb      dbmodel.ICollectionDb
 	aliasTestDb     dbmodel.ICollAliasDb
 	channelTestDb   dbmodel.ICollChannelDb
@@ -51,6 +53,14 @@ var (
 	userRoleTestDb  dbmodel.IUserRoleDb
 	grantTestDb     dbmodel.IGrantDb
 	grantIDTestDb   dbmodel.IGrantIDDb
+	segmentTestDb   dbmodel.ISegmentDb
+	binlogTestDb    dbmodel.IBinlogDb
+	segEventTestDb  dbmodel.ISegmentEventDb
+	channelCPTestDb dbmodel.IChannelCheckpointDb
+	removedChTestDb dbmodel.IRemovedChannelDb
+	collLoadTestDb  dbmodel.ICollectionLoadInfoDb
+	partLoadTestDb  dbmodel.IPartitionLoadInfoDb
+	replicaTestDb   dbmodel.IReplicaDb
 
 	properties = []*commonpb.KeyValuePair{
 		{
@@ -86,6 +96,7 @@ func TestMain(m *testing.M) {
 	// set mocked database
 	dbcore.SetGlobalDB(DB)
 
+	dbTestDb = NewMetaDomain().DatabaseDb(ctx)
 	collTestDb = NewMetaDomain().CollectionDb(ctx)
 	aliasTestDb = NewMetaDomain().CollAliasDb(ctx)
 	channelTestDb = NewMetaDomain().CollChannelDb(ctx)
@@ -98,6 +109,14 @@ func TestMain(m *testing.M) {
 	userRoleTestDb = NewMetaDomain().UserRoleDb(ctx)
 	grantTestDb = NewMetaDomain().GrantDb(ctx)
 	grantIDTestDb = NewMetaDomain().GrantIDDb(ctx)
+	segmentTestDb = NewMetaDomain().SegmentDb(ctx)
+	binlogTestDb = NewMetaDomain().BinlogDb(ctx)
+	segEventTestDb = NewMetaDomain().SegmentEventDb(ctx)
+	channelCPTestDb = NewMetaDomain().ChannelCheckpointDb(ctx)
+	removedChTestDb = NewMetaDomain().RemovedChannelDb(ctx)
+	collLoadTestDb = NewMetaDomain().CollectionLoadInfoDb(ctx)
+	partLoadTestDb = NewMetaDomain().PartitionLoadInfoDb(ctx)
+	replicaTestDb = NewMetaDomain().ReplicaDb(ctx)
 
 	// m.Run entry for executing tests
 	os.Exit(m.Run())
@@ -310,14 +329,14 @@ func TestCollection_GetCollectionIDByName(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, collectionName, ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id"}).
 				AddRow(collID1))
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, dbID, collectionName, ts)
 	assert.Nil(t, err)
 	assert.Equal(t, collID1, res)
 }
@@ -326,12 +345,12 @@ func TestCollection_GetCollectionIDByName_Error(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, collectionName, ts).
 		WillReturnError(errors.New("test error"))
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, dbID, collectionName, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -340,12 +359,12 @@ func TestCollection_GetCollectionIDByName_ErrRecordNotFound(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, dbID, collectionName, ts).
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, dbID, collectionName, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -369,8 +388,8 @@ func TestCollection_Insert(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`db_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.DbID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -397,8 +416,8 @@ func TestCollection_Insert_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`db_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.DbID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
@@ -492,3 +511,31 @@ func Test_collectionDb_Update(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_collectionDb_RenameCollection(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		// expectation
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `collections` SET `collection_name`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+			WithArgs("new_collection_name", sqlmock.AnyArg(), tenantID, collID1).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mock.ExpectCommit()
+
+		// actual
+		err := collTestDb.RenameCollection(tenantID, collID1, "new_collection_name")
+		assert.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// expectation
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `collections` SET `collection_name`=?,`updated_at`=? WHERE tenant_id = ? AND collection_id = ?").
+			WithArgs("new_collection_name", sqlmock.AnyArg(), tenantID, collID1).
+			WillReturnError(errors.New("error mock RenameCollection"))
+		mock.ExpectRollback()
+
+		// actual
+		err := collTestDb.RenameCollection(tenantID, collID1, "new_collection_name")
+		assert.Error(t, err)
+	})
+}
@@ -13,6 +13,10 @@ func NewMetaDomain() *metaDomain {
 	return &metaDomain{}
 }
 
+func (*metaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
+	return &databaseDb{dbcore.GetDB(ctx)}
+}
+
 func (*metaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return &collectionDb{dbcore.GetDB(ctx)}
 }
@@ -60,3 +64,35 @@ func (d *metaDomain) GrantDb(ctx context.Context) dbmodel.IGrantDb {
 func (d *metaDomain) GrantIDDb(ctx context.Context) dbmodel.IGrantIDDb {
 	return &grantIDDb{dbcore.GetDB(ctx)}
 }
+
+func (d *metaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) BinlogDb(ctx context.Context) dbmodel.IBinlogDb {
+	return &binlogDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) SegmentEventDb(ctx context.Context) dbmodel.ISegmentEventDb {
+	return &segmentEventDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) ChannelCheckpointDb(ctx context.Context) dbmodel.IChannelCheckpointDb {
+	return &channelCheckpointDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) RemovedChannelDb(ctx context.Context) dbmodel.IRemovedChannelDb {
+	return &removedChannelDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) CollectionLoadInfoDb(ctx context.Context) dbmodel.ICollectionLoadInfoDb {
+	return &collectionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) PartitionLoadInfoDb(ctx context.Context) dbmodel.IPartitionLoadInfoDb {
+	return &partitionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) ReplicaDb(ctx context.Context) dbmodel.IReplicaDb {
+	return &replicaDb{dbcore.GetDB(ctx)}
+}
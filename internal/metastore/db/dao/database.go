@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type databaseDb struct {
+	db *gorm.DB
+}
+
+// Insert used in create & drop database, needs be an idempotent operation, so we use DoNothing strategy here so it will not throw exception for retry, equivalent to kv catalog
+func (s *databaseDb) Insert(in *dbmodel.Database) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, db_id, ts)
+		DoNothing: true,
+	}).Create(&in).Error
+
+	if err != nil {
+		log.Error("insert database failed", zap.String("tenant", in.TenantID), zap.Int64("dbID", in.DbID), zap.Uint64("ts", in.Ts), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *databaseDb) ListDbIDTs(tenantID string, ts typeutil.Timestamp) ([]*dbmodel.Database, error) {
+	var r []*dbmodel.Database
+
+	err := s.db.Model(&dbmodel.Database{}).Select("db_id, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, ts).Group("db_id").Find(&r).Error
+	if err != nil {
+		log.Error("list db_id & latest ts pairs in databases failed", zap.String("tenant", tenantID), zap.Uint64("ts", ts), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *databaseDb) List(tenantID string, dbIDTsPairs []*dbmodel.Database) ([]*dbmodel.Database, error) {
+	var dbs []*dbmodel.Database
+
+	inValues := make([][]interface{}, 0, len(dbIDTsPairs))
+	for _, pair := range dbIDTsPairs {
+		in := []interface{}{pair.DbID, pair.Ts}
+		inValues = append(inValues, in)
+	}
+
+	err := s.db.Model(&dbmodel.Database{}).Select("db_id, db_name, ts").
+		Where("tenant_id = ? AND is_deleted = false AND (db_id, ts) IN ?", tenantID, inValues).Find(&dbs).Error
+	if err != nil {
+		log.Error("list databases by db_id and ts pairs failed", zap.String("tenant", tenantID), zap.Any("dbIdTs", inValues), zap.Error(err))
+		return nil, err
+	}
+
+	return dbs, nil
+}
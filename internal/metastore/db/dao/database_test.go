@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabase_Insert(t *testing.T) {
+	var database = &dbmodel.Database{
+		TenantID:  tenantID,
+		DbID:      dbID,
+		DbName:    "test_db_name_1",
+		Ts:        ts,
+		IsDeleted: false,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `databases` (`tenant_id`,`db_id`,`db_name`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(database.TenantID, database.DbID, database.DbName, database.Ts, database.IsDeleted, database.CreatedAt, database.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := dbTestDb.Insert(database)
+	assert.Nil(t, err)
+}
+
+func TestDatabase_Insert_Error(t *testing.T) {
+	var database = &dbmodel.Database{
+		TenantID:  tenantID,
+		DbID:      dbID,
+		DbName:    "test_db_name_1",
+		Ts:        ts,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `databases` (`tenant_id`,`db_id`,`db_name`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(database.TenantID, database.DbID, database.DbName, database.Ts, database.IsDeleted, database.CreatedAt, database.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := dbTestDb.Insert(database)
+	assert.Error(t, err)
+}
+
+func TestDatabase_ListDbIDTs(t *testing.T) {
+	var pairs = []*dbmodel.Database{
+		{
+			DbID: dbID,
+			Ts:   typeutil.Timestamp(2),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT db_id, MAX(ts) ts FROM `databases` WHERE tenant_id = ? AND ts <= ? GROUP BY `db_id`").
+		WithArgs(tenantID, ts).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"db_id", "ts"}).
+				AddRow(dbID, typeutil.Timestamp(2)))
+
+	// actual
+	res, err := dbTestDb.ListDbIDTs(tenantID, ts)
+	assert.Nil(t, err)
+	assert.Equal(t, pairs, res)
+}
+
+func TestDatabase_ListDbIDTs_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT db_id, MAX(ts) ts FROM `databases` WHERE tenant_id = ? AND ts <= ? GROUP BY `db_id`").
+		WithArgs(tenantID, ts).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := dbTestDb.ListDbIDTs(tenantID, ts)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestDatabase_List(t *testing.T) {
+	var pairs = []*dbmodel.Database{
+		{
+			DbID: dbID,
+			Ts:   typeutil.Timestamp(2),
+		},
+	}
+	var out = []*dbmodel.Database{
+		{
+			DbID:   dbID,
+			DbName: "test_db_name_1",
+			Ts:     typeutil.Timestamp(2),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT db_id, db_name, ts FROM `databases` WHERE tenant_id = ? AND is_deleted = false AND (db_id, ts) IN ((?,?))").
+		WithArgs(tenantID, pairs[0].DbID, pairs[0].Ts).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"db_id", "db_name", "ts"}).
+				AddRow(dbID, "test_db_name_1", typeutil.Timestamp(2)))
+
+	// actual
+	res, err := dbTestDb.List(tenantID, pairs)
+	assert.Nil(t, err)
+	assert.Equal(t, out, res)
+}
@@ -0,0 +1,353 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+const replicaID1 = int64(4001)
+
+func TestCollectionLoadInfo_List(t *testing.T) {
+	var infos = []*dbmodel.CollectionLoadInfo{
+		{
+			TenantID:           tenantID,
+			CollectionID:       collID1,
+			ReleasedPartitions: "[]",
+			ReplicaNumber:      1,
+			Status:             2,
+			FieldIndexID:       `{"501":1001}`,
+			CreatedAt:          time.Now(),
+			UpdatedAt:          time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "released_partitions", "replica_number", "status", "field_index_id", "created_at", "updated_at"}).
+				AddRow(infos[0].TenantID, infos[0].CollectionID, infos[0].ReleasedPartitions, infos[0].ReplicaNumber, infos[0].Status, infos[0].FieldIndexID, infos[0].CreatedAt, infos[0].UpdatedAt))
+
+	// actual
+	res, err := collLoadTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, infos, res)
+}
+
+func TestCollectionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := collLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       collID1,
+		ReleasedPartitions: "[]",
+		ReplicaNumber:      1,
+		Status:             2,
+		FieldIndexID:       `{"501":1001}`,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, collID1, info.ReleasedPartitions, info.ReplicaNumber, info.Status, info.FieldIndexID, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert_Error(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_List(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			ReplicaNumber: 1,
+			Status:        2,
+			FieldIndexID:  `{"501":1001}`,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "replica_number", "status", "field_index_id", "created_at", "updated_at"}).
+				AddRow(infos[0].TenantID, infos[0].CollectionID, infos[0].PartitionID, infos[0].ReplicaNumber, infos[0].Status, infos[0].FieldIndexID, infos[0].CreatedAt, infos[0].UpdatedAt))
+
+	// actual
+	res, err := partLoadTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, infos, res)
+}
+
+func TestPartitionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := partLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			ReplicaNumber: 1,
+			Status:        2,
+			FieldIndexID:  `{"501":1001}`,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, collID1, partitionID1, infos[0].ReplicaNumber, infos[0].Status, infos[0].FieldIndexID, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert_Error(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []int64{partitionID1})
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []int64{partitionID1})
+	assert.Error(t, err)
+}
+
+func TestReplica_List(t *testing.T) {
+	var replicas = []*dbmodel.Replica{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			ReplicaID:    replicaID1,
+			Nodes:        "[1,2]",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "replica_id", "nodes", "created_at", "updated_at"}).
+				AddRow(replicas[0].TenantID, replicas[0].CollectionID, replicas[0].ReplicaID, replicas[0].Nodes, replicas[0].CreatedAt, replicas[0].UpdatedAt))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, replicas, res)
+}
+
+func TestReplica_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestReplica_Upsert(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    replicaID1,
+		Nodes:        "[1,2]",
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`nodes`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, collID1, replicaID1, replica.Nodes, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Nil(t, err)
+}
+
+func TestReplica_Upsert_Error(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    replicaID1,
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`nodes`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Error(t, err)
+}
+
+func TestReplica_DeleteByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_DeleteByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestReplica_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, replicaID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, replicaID1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, replicaID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, replicaID1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type segmentEventDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentEventDb) List(tenantID string) ([]*dbmodel.SegmentEvent, error) {
+	var r []*dbmodel.SegmentEvent
+
+	err := s.db.Model(&dbmodel.SegmentEvent{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list segment events failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentEventDb) Upsert(in []*dbmodel.SegmentEvent) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"event_key", "event", "updated_at"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert segment events failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentEventDb) Delete(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND segment_id IN ?", tenantID, segmentIDs).Delete(&dbmodel.SegmentEvent{}).Error
+	if err != nil {
+		log.Error("delete segment events failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
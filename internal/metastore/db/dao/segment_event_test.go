@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentEvent_List(t *testing.T) {
+	var events = []*dbmodel.SegmentEvent{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			EventKey:  "flushed-segment/1/2/3",
+			Event:     []byte("event"),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segment_events` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "segment_id", "event_key", "event", "created_at", "updated_at"}).
+				AddRow(events[0].TenantID, events[0].SegmentID, events[0].EventKey, events[0].Event, events[0].CreatedAt, events[0].UpdatedAt))
+
+	// actual
+	res, err := segEventTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, events, res)
+}
+
+func TestSegmentEvent_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segment_events` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := segEventTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegmentEvent_Upsert(t *testing.T) {
+	var events = []*dbmodel.SegmentEvent{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			EventKey:  "flushed-segment/1/2/3",
+			Event:     []byte("event"),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segment_events` (`tenant_id`,`segment_id`,`event_key`,`event`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `event_key`=VALUES(`event_key`),`event`=VALUES(`event`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, segmentID1, "flushed-segment/1/2/3", []byte("event"), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segEventTestDb.Upsert(events)
+	assert.Nil(t, err)
+}
+
+func TestSegmentEvent_Upsert_Error(t *testing.T) {
+	var events = []*dbmodel.SegmentEvent{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segment_events` (`tenant_id`,`segment_id`,`event_key`,`event`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `event_key`=VALUES(`event_key`),`event`=VALUES(`event`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segEventTestDb.Upsert(events)
+	assert.Error(t, err)
+}
+
+func TestSegmentEvent_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segment_events` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := segEventTestDb.Delete(tenantID, []int64{segmentID1, segmentID2})
+	assert.Nil(t, err)
+}
+
+func TestSegmentEvent_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segment_events` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segEventTestDb.Delete(tenantID, []int64{segmentID1, segmentID2})
+	assert.Error(t, err)
+}
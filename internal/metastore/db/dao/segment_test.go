@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_List(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			NumRows:      NumRows,
+			DmChannel:    "test_virtual_channel_1",
+			SegmentState: 3,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "segment_id", "collection_id", "partition_id", "num_rows", "dm_channel", "segment_state", "created_at", "updated_at"}).
+				AddRow(segments[0].TenantID, segments[0].SegmentID, segments[0].CollectionID, segments[0].PartitionID, segments[0].NumRows, segments[0].DmChannel, segments[0].SegmentState, segments[0].CreatedAt, segments[0].UpdatedAt))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, segments, res)
+}
+
+func TestSegment_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegment_Upsert(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			NumRows:      NumRows,
+			DmChannel:    "test_virtual_channel_1",
+			SegmentState: 3,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`num_rows`,`max_row_num`,`dm_channel`,`dml_position`,`start_position`,`compaction_from`,`created_by_compaction`,`segment_state`,`last_expire_time`,`dropped_at`,`is_importing`,`is_fake`,`is_clustered`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `num_rows`=VALUES(`num_rows`),`max_row_num`=VALUES(`max_row_num`),`dml_position`=VALUES(`dml_position`),`start_position`=VALUES(`start_position`),`compaction_from`=VALUES(`compaction_from`),`created_by_compaction`=VALUES(`created_by_compaction`),`segment_state`=VALUES(`segment_state`),`last_expire_time`=VALUES(`last_expire_time`),`dropped_at`=VALUES(`dropped_at`),`is_importing`=VALUES(`is_importing`),`is_fake`=VALUES(`is_fake`),`is_clustered`=VALUES(`is_clustered`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(tenantID, segmentID1, collID1, partitionID1, int64(NumRows), int64(0), "test_virtual_channel_1", "", "", "", false, int32(3), uint64(0), uint64(0), false, false, false, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Nil(t, err)
+}
+
+func TestSegment_Upsert_Error(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`num_rows`,`max_row_num`,`dm_channel`,`dml_position`,`start_position`,`compaction_from`,`created_by_compaction`,`segment_state`,`last_expire_time`,`dropped_at`,`is_importing`,`is_fake`,`is_clustered`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `num_rows`=VALUES(`num_rows`),`max_row_num`=VALUES(`max_row_num`),`dml_position`=VALUES(`dml_position`),`start_position`=VALUES(`start_position`),`compaction_from`=VALUES(`compaction_from`),`created_by_compaction`=VALUES(`created_by_compaction`),`segment_state`=VALUES(`segment_state`),`last_expire_time`=VALUES(`last_expire_time`),`dropped_at`=VALUES(`dropped_at`),`is_importing`=VALUES(`is_importing`),`is_fake`=VALUES(`is_fake`),`is_clustered`=VALUES(`is_clustered`),`updated_at`=VALUES(`updated_at`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Error(t, err)
+}
+
+func TestSegment_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, []int64{segmentID1, segmentID2})
+	assert.Nil(t, err)
+}
+
+func TestSegment_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, []int64{segmentID1, segmentID2})
+	assert.Error(t, err)
+}
@@ -0,0 +1,583 @@
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type Catalog struct {
+	metaDomain           dbmodel.IMetaDomain
+	txImpl               dbmodel.ITransaction
+	chunkManagerRootPath string
+	// eventKV is only used to publish the flushed segment events watched by IndexCoord,
+	// the segment meta itself is always stored in the database. The events are saved in the
+	// segment_events table in the same transaction as the segments, and removed after published.
+	eventKV kv.TxnKV
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain, eventKV kv.TxnKV, chunkManagerRootPath string) *Catalog {
+	return &Catalog{
+		txImpl:               txImpl,
+		metaDomain:           metaDomain,
+		chunkManagerRootPath: chunkManagerRootPath,
+		eventKV:              eventKV,
+	}
+}
+
+func (tc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	// publish the events left by the last run, which committed the segments but failed to publish
+	events, err := tc.metaDomain.SegmentEventDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.publishSegmentEvents(ctx, tenantID, events); err != nil {
+		return nil, err
+	}
+
+	segments, err := tc.metaDomain.SegmentDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	binlogs, err := tc.metaDomain.BinlogDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	binlogsBySegment := make(map[typeutil.UniqueID][]*dbmodel.Binlog)
+	for _, binlog := range binlogs {
+		binlogsBySegment[binlog.SegmentID] = append(binlogsBySegment[binlog.SegmentID], binlog)
+	}
+
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		segmentInfo, err := unmarshalSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		segmentInfo.Binlogs, segmentInfo.Deltalogs, segmentInfo.Statslogs = unmarshalBinlogs(binlogsBySegment[segment.SegmentID])
+		result = append(result, segmentInfo)
+	}
+
+	return result, nil
+}
+
+func (tc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	return tc.saveSegments(ctx, []*datapb.SegmentInfo{segment})
+}
+
+func (tc *Catalog) AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error {
+	if len(newSegments) == 0 {
+		return nil
+	}
+	return tc.saveSegments(ctx, newSegments)
+}
+
+func (tc *Catalog) AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+	var events []*dbmodel.SegmentEvent
+	if newSegment.GetState() == commonpb.SegmentState_Flushed && oldSegment.GetState() != commonpb.SegmentState_Flushed {
+		event, err := buildFlushedSegmentEvent(tenantID, newSegment, &datapb.SegmentInfo{ID: newSegment.GetID()})
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	return tc.saveSegmentsWithEvents(ctx, []*datapb.SegmentInfo{newSegment}, events)
+}
+
+func (tc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	var newSegments []*datapb.SegmentInfo
+	if newSegment != nil {
+		newSegments = append(newSegments, newSegment)
+	}
+	return tc.AlterSegmentsAndAddNewSegments(ctx, segments, newSegments)
+}
+
+// AlterSegmentsAndAddNewSegments alters the segments and adds the new segments in one transaction
+func (tc *Catalog) AlterSegmentsAndAddNewSegments(ctx context.Context, segments []*datapb.SegmentInfo, newSegments []*datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+	toSave := make([]*datapb.SegmentInfo, 0, len(segments)+len(newSegments))
+	toSave = append(toSave, segments...)
+
+	var events []*dbmodel.SegmentEvent
+	for _, newSegment := range newSegments {
+		if newSegment.GetNumOfRows() > 0 {
+			toSave = append(toSave, newSegment)
+		} else {
+			// should be a faked segment, only the flushed segment event is needed
+			fakeSegment := proto.Clone(newSegment).(*datapb.SegmentInfo)
+			fakeSegment.IsFake = true
+			event, err := buildFlushedSegmentEvent(tenantID, fakeSegment, fakeSegment)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+	}
+
+	return tc.saveSegmentsWithEvents(ctx, toSave, events)
+}
+
+// RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
+func (tc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+	var removeSegments []*datapb.SegmentInfo
+	if removeSegment != nil {
+		removeSegments = append(removeSegments, removeSegment)
+	}
+	return tc.RevertAlterSegmentsAndAddNewSegments(ctx, oldSegments, removeSegments)
+}
+
+// RevertAlterSegmentsAndAddNewSegments reverts the metastore operation of AlterSegmentsAndAddNewSegments
+func (tc *Catalog) RevertAlterSegmentsAndAddNewSegments(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegments []*datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.saveSegmentsInTx(txCtx, tenantID, oldSegments); err != nil {
+			return err
+		}
+
+		if len(removeSegments) > 0 {
+			segmentIDs := make([]typeutil.UniqueID, 0, len(removeSegments))
+			for _, removeSegment := range removeSegments {
+				segmentIDs = append(segmentIDs, removeSegment.GetID())
+			}
+			return tc.dropSegmentsInTx(txCtx, tenantID, segmentIDs)
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	// only the segment info is updated, the binlogs are kept for garbage collection
+	dbSegments := make([]*dbmodel.Segment, 0, len(segments))
+	for _, s := range segments {
+		dbSegment, err := marshalSegment(tenantID, s)
+		if err != nil {
+			return err
+		}
+		dbSegments = append(dbSegments, dbSegment)
+	}
+
+	return tc.metaDomain.SegmentDb(ctx).Upsert(dbSegments)
+}
+
+func (tc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.dropSegmentsInTx(txCtx, tenantID, []typeutil.UniqueID{segment.GetID()})
+	})
+}
+
+func (tc *Catalog) MarkChannelDeleted(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.RemovedChannelDb(ctx).Insert(&dbmodel.RemovedChannel{
+		TenantID:       tenantID,
+		VirtualChannel: channel,
+	})
+	if err != nil {
+		log.Error("Failed to mark channel dropped", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) IsChannelDropped(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	dropped, err := tc.metaDomain.RemovedChannelDb(ctx).Exist(tenantID, channel)
+	if err != nil {
+		return false
+	}
+	return dropped
+}
+
+// DropChannel removes channel remove flag after whole procedure is finished
+func (tc *Catalog) DropChannel(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.RemovedChannelDb(ctx).Delete(tenantID, channel)
+}
+
+func (tc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	checkpoints, err := tc.metaDomain.ChannelCheckpointDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*internalpb.MsgPosition)
+	for _, checkpoint := range checkpoints {
+		channelCP, err := unmarshalPosition(checkpoint.Position)
+		if err != nil {
+			log.Error("unmarshal channelCP failed when ListChannelCheckpoint", zap.String("vChannel", checkpoint.VirtualChannel), zap.Error(err))
+			return nil, err
+		}
+		channelCPs[checkpoint.VirtualChannel] = channelCP
+	}
+
+	return channelCPs, nil
+}
+
+func (tc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	position, err := marshalPosition(pos)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Upsert(&dbmodel.ChannelCheckpoint{
+		TenantID:       tenantID,
+		VirtualChannel: vChannel,
+		Position:       position,
+	})
+}
+
+func (tc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Delete(tenantID, vChannel)
+}
+
+// saveSegments saves the segment infos and replaces all their binlogs in one transaction.
+func (tc *Catalog) saveSegments(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegmentsInTx(txCtx, tenantID, segments)
+	})
+}
+
+func (tc *Catalog) saveSegmentsInTx(txCtx context.Context, tenantID string, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	segmentIDs := make([]typeutil.UniqueID, 0, len(segments))
+	dbSegments := make([]*dbmodel.Segment, 0, len(segments))
+	var dbBinlogs []*dbmodel.Binlog
+	for _, segment := range segments {
+		dbSegment, err := marshalSegment(tenantID, segment)
+		if err != nil {
+			return err
+		}
+		binlogs, err := tc.marshalBinlogs(tenantID, segment)
+		if err != nil {
+			return err
+		}
+		segmentIDs = append(segmentIDs, segment.GetID())
+		dbSegments = append(dbSegments, dbSegment)
+		dbBinlogs = append(dbBinlogs, binlogs...)
+	}
+
+	if err := tc.metaDomain.SegmentDb(txCtx).Upsert(dbSegments); err != nil {
+		return err
+	}
+	if err := tc.metaDomain.BinlogDb(txCtx).DeleteBySegmentIDs(tenantID, segmentIDs); err != nil {
+		return err
+	}
+	if len(dbBinlogs) == 0 {
+		return nil
+	}
+	return tc.metaDomain.BinlogDb(txCtx).Insert(dbBinlogs)
+}
+
+func (tc *Catalog) dropSegmentsInTx(txCtx context.Context, tenantID string, segmentIDs []typeutil.UniqueID) error {
+	if err := tc.metaDomain.SegmentDb(txCtx).Delete(tenantID, segmentIDs); err != nil {
+		return err
+	}
+	return tc.metaDomain.BinlogDb(txCtx).DeleteBySegmentIDs(tenantID, segmentIDs)
+}
+
+// saveSegmentsWithEvents saves the segments and the flushed segment events in one transaction,
+// then publishes the events.
+func (tc *Catalog) saveSegmentsWithEvents(ctx context.Context, segments []*datapb.SegmentInfo, events []*dbmodel.SegmentEvent) error {
+	if len(events) == 0 {
+		return tc.saveSegments(ctx, segments)
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.saveSegmentsInTx(txCtx, tenantID, segments); err != nil {
+			return err
+		}
+		return tc.metaDomain.SegmentEventDb(txCtx).Upsert(events)
+	})
+	if err != nil {
+		return err
+	}
+	return tc.publishSegmentEvents(ctx, tenantID, events)
+}
+
+// publishSegmentEvents notifies IndexCoord that the segments are flushed, and removes the published events.
+// The events left by a failure are published again by ListSegments, IndexCoord ignores the duplicated ones.
+func (tc *Catalog) publishSegmentEvents(ctx context.Context, tenantID string, events []*dbmodel.SegmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	kvs := make(map[string]string, len(events))
+	segmentIDs := make([]typeutil.UniqueID, 0, len(events))
+	for _, event := range events {
+		kvs[event.EventKey] = string(event.Event)
+		segmentIDs = append(segmentIDs, event.SegmentID)
+	}
+	if err := tc.eventKV.MultiSave(kvs); err != nil {
+		log.Warn("failed to publish flushed segment events", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+	return tc.metaDomain.SegmentEventDb(ctx).Delete(tenantID, segmentIDs)
+}
+
+func buildFlushedSegmentEvent(tenantID string, segment *datapb.SegmentInfo, event *datapb.SegmentInfo) (*dbmodel.SegmentEvent, error) {
+	segBytes, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal segment: %d, err: %w", segment.GetID(), err)
+	}
+	return &dbmodel.SegmentEvent{
+		TenantID:  tenantID,
+		SegmentID: segment.GetID(),
+		EventKey:  buildFlushedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()),
+		Event:     segBytes,
+	}, nil
+}
+
+func (tc *Catalog) marshalBinlogs(tenantID string, segment *datapb.SegmentInfo) ([]*dbmodel.Binlog, error) {
+	var result []*dbmodel.Binlog
+	appendLogs := func(logType int32, fieldBinlogs []*datapb.FieldBinlog) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				logID, logPath, err := tc.completeLogIDAndPath(logType, segment, fieldBinlog.GetFieldID(), binlog)
+				if err != nil {
+					return err
+				}
+				result = append(result, &dbmodel.Binlog{
+					TenantID:      tenantID,
+					CollectionID:  segment.GetCollectionID(),
+					PartitionID:   segment.GetPartitionID(),
+					SegmentID:     segment.GetID(),
+					FieldID:       fieldBinlog.GetFieldID(),
+					LogType:       logType,
+					LogID:         logID,
+					NumEntries:    binlog.GetEntriesNum(),
+					TimestampFrom: binlog.GetTimestampFrom(),
+					TimestampTo:   binlog.GetTimestampTo(),
+					LogPath:       logPath,
+					LogSize:       binlog.GetLogSize(),
+				})
+			}
+		}
+		return nil
+	}
+
+	if err := appendLogs(dbmodel.InsertBinlog, segment.GetBinlogs()); err != nil {
+		return nil, err
+	}
+	if err := appendLogs(dbmodel.DeleteBinlog, segment.GetDeltalogs()); err != nil {
+		return nil, err
+	}
+	if err := appendLogs(dbmodel.StatsBinlog, segment.GetStatslogs()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// completeLogIDAndPath returns both log id and log path of a binlog, the missing one is built from the other.
+func (tc *Catalog) completeLogIDAndPath(logType int32, segment *datapb.SegmentInfo, fieldID typeutil.UniqueID, binlog *datapb.Binlog) (typeutil.UniqueID, string, error) {
+	logID, logPath := binlog.GetLogID(), binlog.GetLogPath()
+	if logPath != "" {
+		if logID == 0 {
+			idx := strings.LastIndex(logPath, "/")
+			id, err := strconv.ParseInt(logPath[idx+1:], 10, 64)
+			if err != nil {
+				return 0, "", fmt.Errorf("invalid binlog path: %s", logPath)
+			}
+			logID = id
+		}
+		return logID, logPath, nil
+	}
+
+	switch logType {
+	case dbmodel.InsertBinlog:
+		logPath = metautil.BuildInsertLogPath(tc.chunkManagerRootPath, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), fieldID, logID)
+	case dbmodel.DeleteBinlog:
+		logPath = metautil.BuildDeltaLogPath(tc.chunkManagerRootPath, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), logID)
+	case dbmodel.StatsBinlog:
+		logPath = metautil.BuildStatsLogPath(tc.chunkManagerRootPath, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), fieldID, logID)
+	default:
+		return 0, "", fmt.Errorf("invalid binlog type: %d", logType)
+	}
+	return logID, logPath, nil
+}
+
+func unmarshalBinlogs(binlogs []*dbmodel.Binlog) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, []*datapb.FieldBinlog) {
+	var insertLogs, deltaLogs, statsLogs []*datapb.FieldBinlog
+	appendLog := func(fieldBinlogs []*datapb.FieldBinlog, binlog *dbmodel.Binlog) []*datapb.FieldBinlog {
+		l := &datapb.Binlog{
+			EntriesNum:    binlog.NumEntries,
+			TimestampFrom: binlog.TimestampFrom,
+			TimestampTo:   binlog.TimestampTo,
+			LogPath:       binlog.LogPath,
+			LogSize:       binlog.LogSize,
+			LogID:         binlog.LogID,
+		}
+		for _, fieldBinlog := range fieldBinlogs {
+			if fieldBinlog.GetFieldID() == binlog.FieldID {
+				fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, l)
+				return fieldBinlogs
+			}
+		}
+		return append(fieldBinlogs, &datapb.FieldBinlog{FieldID: binlog.FieldID, Binlogs: []*datapb.Binlog{l}})
+	}
+
+	for _, binlog := range binlogs {
+		switch binlog.LogType {
+		case dbmodel.InsertBinlog:
+			insertLogs = appendLog(insertLogs, binlog)
+		case dbmodel.DeleteBinlog:
+			deltaLogs = appendLog(deltaLogs, binlog)
+		case dbmodel.StatsBinlog:
+			statsLogs = appendLog(statsLogs, binlog)
+		}
+	}
+	return insertLogs, deltaLogs, statsLogs
+}
+
+func marshalSegment(tenantID string, segment *datapb.SegmentInfo) (*dbmodel.Segment, error) {
+	noBinlogsSegment := proto.Clone(segment).(*datapb.SegmentInfo)
+	noBinlogsSegment.Binlogs = nil
+	noBinlogsSegment.Deltalogs = nil
+	noBinlogsSegment.Statslogs = nil
+	// `segment` is not mutated above. Also, `noBinlogsSegment` is a cloned version of `segment`.
+	segmentutil.ReCalcRowCount(segment, noBinlogsSegment)
+
+	dmlPosition, err := marshalPosition(noBinlogsSegment.GetDmlPosition())
+	if err != nil {
+		return nil, err
+	}
+	startPosition, err := marshalPosition(noBinlogsSegment.GetStartPosition())
+	if err != nil {
+		return nil, err
+	}
+	compactionFrom, err := json.Marshal(noBinlogsSegment.GetCompactionFrom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbmodel.Segment{
+		TenantID:            tenantID,
+		SegmentID:           noBinlogsSegment.GetID(),
+		CollectionID:        noBinlogsSegment.GetCollectionID(),
+		PartitionID:         noBinlogsSegment.GetPartitionID(),
+		NumRows:             noBinlogsSegment.GetNumOfRows(),
+		MaxRowNum:           noBinlogsSegment.GetMaxRowNum(),
+		DmChannel:           noBinlogsSegment.GetInsertChannel(),
+		DmlPosition:         dmlPosition,
+		StartPosition:       startPosition,
+		CompactionFrom:      string(compactionFrom),
+		CreatedByCompaction: noBinlogsSegment.GetCreatedByCompaction(),
+		SegmentState:        int32(noBinlogsSegment.GetState()),
+		LastExpireTime:      noBinlogsSegment.GetLastExpireTime(),
+		DroppedAt:           noBinlogsSegment.GetDroppedAt(),
+		IsImporting:         noBinlogsSegment.GetIsImporting(),
+		IsFake:              noBinlogsSegment.GetIsFake(),
+		IsClustered:         noBinlogsSegment.GetIsClustered(),
+	}, nil
+}
+
+func unmarshalSegment(segment *dbmodel.Segment) (*datapb.SegmentInfo, error) {
+	dmlPosition, err := unmarshalPosition(segment.DmlPosition)
+	if err != nil {
+		log.Error("unmarshal dml position of segment failed", zap.Int64("segmentID", segment.SegmentID), zap.Error(err))
+		return nil, err
+	}
+	startPosition, err := unmarshalPosition(segment.StartPosition)
+	if err != nil {
+		log.Error("unmarshal start position of segment failed", zap.Int64("segmentID", segment.SegmentID), zap.Error(err))
+		return nil, err
+	}
+	var compactionFrom []int64
+	if segment.CompactionFrom != "" {
+		if err := json.Unmarshal([]byte(segment.CompactionFrom), &compactionFrom); err != nil {
+			log.Error("unmarshal compaction from of segment failed", zap.Int64("segmentID", segment.SegmentID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return &datapb.SegmentInfo{
+		ID:                  segment.SegmentID,
+		CollectionID:        segment.CollectionID,
+		PartitionID:         segment.PartitionID,
+		InsertChannel:       segment.DmChannel,
+		NumOfRows:           segment.NumRows,
+		State:               commonpb.SegmentState(segment.SegmentState),
+		MaxRowNum:           segment.MaxRowNum,
+		LastExpireTime:      segment.LastExpireTime,
+		StartPosition:       startPosition,
+		DmlPosition:         dmlPosition,
+		CreatedByCompaction: segment.CreatedByCompaction,
+		CompactionFrom:      compactionFrom,
+		DroppedAt:           segment.DroppedAt,
+		IsImporting:         segment.IsImporting,
+		IsFake:              segment.IsFake,
+		IsClustered:         segment.IsClustered,
+	}, nil
+}
+
+func marshalPosition(pos *internalpb.MsgPosition) (string, error) {
+	if pos == nil {
+		return "", nil
+	}
+	posBytes, err := json.Marshal(pos)
+	if err != nil {
+		return "", err
+	}
+	return string(posBytes), nil
+}
+
+func unmarshalPosition(pos string) (*internalpb.MsgPosition, error) {
+	if pos == "" {
+		return nil, nil
+	}
+	result := &internalpb.MsgPosition{}
+	if err := json.Unmarshal([]byte(pos), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// buildFlushedSegmentPath common logic mapping segment info to corresponding key of IndexCoord in kv store
+func buildFlushedSegmentPath(collectionID typeutil.UniqueID, partitionID typeutil.UniqueID, segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, collectionID, partitionID, segmentID)
+}
@@ -0,0 +1,445 @@
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenantID     = "test_tenant"
+	rootPath     = "files"
+	collID1      = typeutil.UniqueID(101)
+	partitionID1 = typeutil.UniqueID(500)
+	fieldID1     = typeutil.UniqueID(1000)
+	segmentID1   = typeutil.UniqueID(2000)
+	segmentID2   = typeutil.UniqueID(2001)
+	vChannel1    = "test_vchannel_1"
+)
+
+var (
+	ctx                  context.Context
+	metaDomainMock       *mocks.IMetaDomain
+	segmentDbMock        *mocks.ISegmentDb
+	binlogDbMock         *mocks.IBinlogDb
+	segmentEventDbMock   *mocks.ISegmentEventDb
+	channelCPDbMock      *mocks.IChannelCheckpointDb
+	removedChannelDbMock *mocks.IRemovedChannelDb
+	eventKVMock          *kvmocks.TxnKV
+
+	mockCatalog *Catalog
+)
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	ctx = contextutil.WithTenantID(context.Background(), tenantID)
+
+	segmentDbMock = &mocks.ISegmentDb{}
+	binlogDbMock = &mocks.IBinlogDb{}
+	segmentEventDbMock = &mocks.ISegmentEventDb{}
+	channelCPDbMock = &mocks.IChannelCheckpointDb{}
+	removedChannelDbMock = &mocks.IRemovedChannelDb{}
+	eventKVMock = &kvmocks.TxnKV{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("SegmentDb", ctx).Return(segmentDbMock)
+	metaDomainMock.On("BinlogDb", ctx).Return(binlogDbMock)
+	metaDomainMock.On("SegmentEventDb", ctx).Return(segmentEventDbMock)
+	metaDomainMock.On("ChannelCheckpointDb", ctx).Return(channelCPDbMock)
+	metaDomainMock.On("RemovedChannelDb", ctx).Return(removedChannelDbMock)
+
+	mockCatalog = NewTableCatalog(&NoopTransaction{}, metaDomainMock, eventKVMock, rootPath)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestSegment(segmentID typeutil.UniqueID, state commonpb.SegmentState) *datapb.SegmentInfo {
+	return &datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		InsertChannel: vChannel1,
+		NumOfRows:     100,
+		State:         state,
+		MaxRowNum:     1000,
+		StartPosition: &internalpb.MsgPosition{ChannelName: vChannel1, MsgID: []byte{1, 2, 3}, Timestamp: 100},
+		DmlPosition:   &internalpb.MsgPosition{ChannelName: vChannel1, MsgID: []byte{4, 5, 6}, Timestamp: 200},
+		Binlogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{
+					{EntriesNum: 60, LogID: 1, LogSize: 10},
+					{EntriesNum: 40, LogID: 2, LogSize: 10},
+				},
+			},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{
+				Binlogs: []*datapb.Binlog{{EntriesNum: 5, LogPath: "files/delta_log/101/500/2000/3"}},
+			},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{{EntriesNum: 100, LogID: 4}},
+			},
+		},
+		CompactionFrom: []int64{1, 2},
+	}
+}
+
+func TestTableCatalog_ListSegments(t *testing.T) {
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Flushed)
+	dbSegment, err := marshalSegment(tenantID, segment)
+	require.NoError(t, err)
+	dbBinlogs, err := mockCatalog.marshalBinlogs(tenantID, segment)
+	require.NoError(t, err)
+
+	// expectation
+	segmentEventDbMock.On("List", tenantID).Return(nil, nil).Once()
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{dbSegment}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(dbBinlogs, nil).Once()
+
+	// actual
+	res, err := mockCatalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+
+	expected := proto.Clone(segment).(*datapb.SegmentInfo)
+	expected.Binlogs[0].Binlogs[0].LogPath = "files/insert_log/101/500/2000/1000/1"
+	expected.Binlogs[0].Binlogs[1].LogPath = "files/insert_log/101/500/2000/1000/2"
+	expected.Deltalogs[0].Binlogs[0].LogID = 3
+	expected.Statslogs[0].Binlogs[0].LogPath = "files/stats_log/101/500/2000/1000/4"
+	require.True(t, proto.Equal(expected, res[0]))
+}
+
+func TestTableCatalog_ListSegments_Error(t *testing.T) {
+	errTest := errors.New("test error")
+
+	segmentEventDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err := mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+
+	segmentEventDbMock.On("List", tenantID).Return(nil, nil).Once()
+	segmentDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err = mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+
+	segmentEventDbMock.On("List", tenantID).Return(nil, nil).Once()
+	segmentDbMock.On("List", tenantID).Return(nil, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err = mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+
+	segmentEventDbMock.On("List", tenantID).Return(nil, nil).Once()
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{{SegmentID: segmentID1, DmlPosition: "invalid"}}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, nil).Once()
+	_, err = mockCatalog.ListSegments(ctx)
+	require.Error(t, err)
+}
+
+func TestTableCatalog_AddSegment(t *testing.T) {
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Growing)
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].TenantID == tenantID && in[0].SegmentID == segmentID1 && in[0].NumRows == 100 &&
+			in[0].CompactionFrom == "[1,2]"
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.MatchedBy(func(in []*dbmodel.Binlog) bool {
+		return len(in) == 4 &&
+			in[0].LogType == dbmodel.InsertBinlog && in[0].LogPath == "files/insert_log/101/500/2000/1000/1" &&
+			in[2].LogType == dbmodel.DeleteBinlog && in[2].LogID == 3 &&
+			in[3].LogType == dbmodel.StatsBinlog && in[3].LogPath == "files/stats_log/101/500/2000/1000/4"
+	})).Return(nil).Once()
+
+	// actual
+	err := mockCatalog.AddSegment(ctx, segment)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_AddSegment_Error(t *testing.T) {
+	errTest := errors.New("test error")
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Growing)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err := mockCatalog.AddSegment(ctx, segment)
+	require.Equal(t, errTest, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AddSegment(ctx, segment)
+	require.Equal(t, errTest, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AddSegment(ctx, segment)
+	require.Equal(t, errTest, err)
+
+	segment.Deltalogs[0].Binlogs[0].LogPath = "files/delta_log/invalid"
+	err = mockCatalog.AddSegment(ctx, segment)
+	require.Error(t, err)
+}
+
+func TestTableCatalog_AlterSegments(t *testing.T) {
+	err := mockCatalog.AlterSegments(ctx, nil)
+	require.NoError(t, err)
+
+	segment1 := newTestSegment(segmentID1, commonpb.SegmentState_Flushed)
+	segment2 := newTestSegment(segmentID2, commonpb.SegmentState_Flushed)
+	segment2.Binlogs, segment2.Deltalogs, segment2.Statslogs = nil, nil, nil
+
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 2
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1, segmentID2}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.MatchedBy(func(in []*dbmodel.Binlog) bool {
+		return len(in) == 4
+	})).Return(nil).Once()
+
+	err = mockCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{segment1, segment2})
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_AlterSegment(t *testing.T) {
+	oldSegment := newTestSegment(segmentID1, commonpb.SegmentState_Flushing)
+	newSegment := newTestSegment(segmentID1, commonpb.SegmentState_Flushed)
+	newSegment.Binlogs, newSegment.Deltalogs, newSegment.Statslogs = nil, nil, nil
+
+	// the event is saved with the segment, and removed after published
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	segmentEventDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.SegmentEvent) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID1 && in[0].EventKey == "flushed-segment/101/500/2000"
+	})).Return(nil).Once()
+	eventKVMock.On("MultiSave", mock.MatchedBy(func(kvs map[string]string) bool {
+		_, ok := kvs["flushed-segment/101/500/2000"]
+		return len(kvs) == 1 && ok
+	})).Return(nil).Once()
+	segmentEventDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+
+	err := mockCatalog.AlterSegment(ctx, newSegment, oldSegment)
+	require.NoError(t, err)
+	eventKVMock.AssertExpectations(t)
+	segmentEventDbMock.AssertExpectations(t)
+
+	// the event is not saved if the transaction fails
+	errTest := errors.New("test error")
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AlterSegment(ctx, newSegment, oldSegment)
+	require.Equal(t, errTest, err)
+	segmentEventDbMock.AssertExpectations(t)
+
+	// no event for segments already flushed
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	err = mockCatalog.AlterSegment(ctx, newSegment, newSegment)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegment(t *testing.T) {
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Dropped)
+	newSegment := newTestSegment(segmentID2, commonpb.SegmentState_Flushed)
+
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 2 && in[1].SegmentID == segmentID2
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1, segmentID2}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+
+	err := mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{segment}, newSegment)
+	require.NoError(t, err)
+
+	// fake segment
+	fakeSegment := &datapb.SegmentInfo{ID: segmentID2, CollectionID: collID1, PartitionID: partitionID1, State: commonpb.SegmentState_Flushed}
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID1
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	segmentEventDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.SegmentEvent) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID2
+	})).Return(nil).Once()
+	eventKVMock.On("MultiSave", mock.MatchedBy(func(kvs map[string]string) bool {
+		info := &datapb.SegmentInfo{}
+		return len(kvs) == 1 && proto.Unmarshal([]byte(kvs["flushed-segment/101/500/2001"]), info) == nil && info.GetIsFake()
+	})).Return(nil).Once()
+	segmentEventDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID2}).Return(nil).Once()
+
+	err = mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{segment}, fakeSegment)
+	require.NoError(t, err)
+	eventKVMock.AssertExpectations(t)
+}
+
+func TestTableCatalog_PublishSegmentEvents(t *testing.T) {
+	oldSegment := newTestSegment(segmentID1, commonpb.SegmentState_Flushing)
+	newSegment := newTestSegment(segmentID1, commonpb.SegmentState_Flushed)
+	newSegment.Binlogs, newSegment.Deltalogs, newSegment.Statslogs = nil, nil, nil
+
+	// the segment is committed with its event, but publishing fails
+	var saved []*dbmodel.SegmentEvent
+	errTest := errors.New("test error")
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	segmentEventDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).([]*dbmodel.SegmentEvent)
+	}).Return(nil).Once()
+	eventKVMock.On("MultiSave", mock.Anything).Return(errTest).Once()
+	err := mockCatalog.AlterSegment(ctx, newSegment, oldSegment)
+	require.Equal(t, errTest, err)
+	require.Equal(t, 1, len(saved))
+
+	// the event left in the database is published when the segments are loaded
+	segmentEventDbMock.On("List", tenantID).Return(saved, nil).Once()
+	eventKVMock.On("MultiSave", map[string]string{"flushed-segment/101/500/2000": string(saved[0].Event)}).Return(nil).Once()
+	segmentEventDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	segmentDbMock.On("List", tenantID).Return(nil, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, nil).Once()
+	_, err = mockCatalog.ListSegments(ctx)
+	require.NoError(t, err)
+	eventKVMock.AssertExpectations(t)
+	segmentEventDbMock.AssertExpectations(t)
+
+	// the segments can't be loaded if the events are not published
+	segmentEventDbMock.On("List", tenantID).Return(saved, nil).Once()
+	eventKVMock.On("MultiSave", mock.Anything).Return(errTest).Once()
+	_, err = mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegments(t *testing.T) {
+	const segmentID3 = typeutil.UniqueID(2003)
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Dropped)
+	newSegment1 := newTestSegment(segmentID2, commonpb.SegmentState_Flushed)
+	newSegment2 := newTestSegment(segmentID3, commonpb.SegmentState_Flushed)
+
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 3 && in[1].SegmentID == segmentID2 && in[2].SegmentID == segmentID3
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1, segmentID2, segmentID3}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+
+	err := mockCatalog.AlterSegmentsAndAddNewSegments(ctx, []*datapb.SegmentInfo{segment}, []*datapb.SegmentInfo{newSegment1, newSegment2})
+	require.NoError(t, err)
+
+	// revert
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID2, segmentID3}).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID2, segmentID3}).Return(nil).Once()
+
+	err = mockCatalog.RevertAlterSegmentsAndAddNewSegments(ctx, []*datapb.SegmentInfo{segment}, []*datapb.SegmentInfo{newSegment1, newSegment2})
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_RevertAlterSegmentsAndAddNewSegment(t *testing.T) {
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Flushed)
+	removeSegment := newTestSegment(segmentID2, commonpb.SegmentState_Flushed)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID2}).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID2}).Return(nil).Once()
+
+	err := mockCatalog.RevertAlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{segment}, removeSegment)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_SaveDroppedSegmentsInBatch(t *testing.T) {
+	err := mockCatalog.SaveDroppedSegmentsInBatch(ctx, nil)
+	require.NoError(t, err)
+
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Dropped)
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].SegmentState == int32(commonpb.SegmentState_Dropped)
+	})).Return(nil).Once()
+
+	err = mockCatalog.SaveDroppedSegmentsInBatch(ctx, []*datapb.SegmentInfo{segment})
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_DropSegment(t *testing.T) {
+	segment := newTestSegment(segmentID1, commonpb.SegmentState_Dropped)
+
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentIDs", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	err := mockCatalog.DropSegment(ctx, segment)
+	require.NoError(t, err)
+
+	errTest := errors.New("test error")
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(errTest).Once()
+	err = mockCatalog.DropSegment(ctx, segment)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_ChannelRemoveFlag(t *testing.T) {
+	removedChannelDbMock.On("Insert", &dbmodel.RemovedChannel{TenantID: tenantID, VirtualChannel: vChannel1}).Return(nil).Once()
+	err := mockCatalog.MarkChannelDeleted(ctx, vChannel1)
+	require.NoError(t, err)
+
+	removedChannelDbMock.On("Exist", tenantID, vChannel1).Return(true, nil).Once()
+	require.True(t, mockCatalog.IsChannelDropped(ctx, vChannel1))
+
+	removedChannelDbMock.On("Exist", tenantID, vChannel1).Return(false, errors.New("test error")).Once()
+	require.False(t, mockCatalog.IsChannelDropped(ctx, vChannel1))
+
+	removedChannelDbMock.On("Delete", tenantID, vChannel1).Return(nil).Once()
+	err = mockCatalog.DropChannel(ctx, vChannel1)
+	require.NoError(t, err)
+
+	errTest := errors.New("test error")
+	removedChannelDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.MarkChannelDeleted(ctx, vChannel1)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_ChannelCheckpoint(t *testing.T) {
+	pos := &internalpb.MsgPosition{ChannelName: vChannel1, MsgID: []byte{1, 2, 3}, MsgGroup: "group", Timestamp: 100}
+	var saved *dbmodel.ChannelCheckpoint
+	channelCPDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*dbmodel.ChannelCheckpoint)
+	}).Return(nil).Once()
+
+	err := mockCatalog.SaveChannelCheckpoint(ctx, vChannel1, pos)
+	require.NoError(t, err)
+	require.Equal(t, tenantID, saved.TenantID)
+	require.Equal(t, vChannel1, saved.VirtualChannel)
+
+	channelCPDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{saved}, nil).Once()
+	cps, err := mockCatalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cps))
+	require.True(t, proto.Equal(pos, cps[vChannel1]))
+
+	channelCPDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{{VirtualChannel: vChannel1, Position: "invalid"}}, nil).Once()
+	_, err = mockCatalog.ListChannelCheckpoint(ctx)
+	require.Error(t, err)
+
+	channelCPDbMock.On("Delete", tenantID, vChannel1).Return(nil).Once()
+	err = mockCatalog.DropChannelCheckpoint(ctx, vChannel1)
+	require.NoError(t, err)
+}
@@ -4,23 +4,48 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
 var (
 	globalDB *gorm.DB
+
+	connectMu sync.Mutex
+	// the data source of globalDB, set by Connect
+	connectedDSN string
 )
 
-func Connect(cfg *paramtable.MetaDBConfig) error {
-	// load config
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		cfg.Username.GetValue(), cfg.Password.GetValue(), cfg.Address.GetValue(), cfg.Port.GetAsInt(), cfg.DBName.GetValue())
+// Connect connects to the meta database of the given meta store type, mysql or sqlite.
+// The tables of sqlite are migrated after connected, tables of mysql are created by scripts/sql/meta.sql.
+// The coordinators running in one process share the connection, Connect returns nil if it's already
+// connected to the same database, and an error if it's connected to another one.
+func Connect(metaStoreType string, cfg *paramtable.MetaDBConfig) error {
+	dialector, fields, err := newDialector(metaStoreType, cfg)
+	if err != nil {
+		log.Error("fail to connect db", zap.Error(err))
+		return err
+	}
+
+	connectMu.Lock()
+	defer connectMu.Unlock()
+	dsn := metaStoreType + ":" + dialectorDSN(dialector)
+	if globalDB != nil && connectedDSN != "" {
+		if connectedDSN != dsn {
+			log.Error("db is already connected to another database", fields...)
+			return fmt.Errorf("db is already connected to another %s database", metaStoreType)
+		}
+		log.Info("db is already connected", fields...)
+		return nil
+	}
 
 	var ormLogger logger.Interface
 	if cfg.LogLevel.GetValue() == "debug" {
@@ -29,45 +54,86 @@ func Connect(cfg *paramtable.MetaDBConfig) error {
 		ormLogger = logger.Default
 	}
 
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger:          ormLogger,
 		CreateBatchSize: 100,
+		// keep the same as scripts/sql/meta.sql, which has no foreign key
+		DisableForeignKeyConstraintWhenMigrating: true,
 	})
 	if err != nil {
-		log.Error("fail to connect db",
-			zap.String("host", cfg.Address.GetValue()),
-			zap.Int("port", cfg.Port.GetAsInt()),
-			zap.String("database", cfg.DBName.GetValue()),
-			zap.Error(err))
+		log.Error("fail to connect db", append(fields, zap.Error(err))...)
 		return err
 	}
 
 	idb, err := db.DB()
 	if err != nil {
-		log.Error("fail to create db instance",
-			zap.String("host", cfg.Address.GetValue()),
-			zap.Int("port", cfg.Port.GetAsInt()),
-			zap.String("database", cfg.DBName.GetValue()),
-			zap.Error(err))
+		log.Error("fail to create db instance", append(fields, zap.Error(err))...)
 		return err
 	}
-	idb.SetMaxIdleConns(cfg.MaxIdleConns.GetAsInt())
-	idb.SetMaxOpenConns(cfg.MaxOpenConns.GetAsInt())
+	if metaStoreType == util.MetaStoreTypeSQLite {
+		// sqlite allows only one writer at a time, and each connection of an in-memory database has its own data,
+		// so all the operations share one connection.
+		idb.SetMaxIdleConns(1)
+		idb.SetMaxOpenConns(1)
+
+		if err := Migrate(db); err != nil {
+			log.Error("fail to migrate db", append(fields, zap.Error(err))...)
+			return err
+		}
+	} else {
+		idb.SetMaxIdleConns(cfg.MaxIdleConns.GetAsInt())
+		idb.SetMaxOpenConns(cfg.MaxOpenConns.GetAsInt())
+	}
 
 	globalDB = db
+	connectedDSN = dsn
 
-	log.Info("db connected success",
-		zap.String("host", cfg.Address.GetValue()),
-		zap.Int("port", cfg.Port.GetAsInt()),
-		zap.String("database", cfg.DBName.GetValue()),
-		zap.Error(err))
+	log.Info("db connected success", fields...)
 
 	return nil
 }
 
+func newDialector(metaStoreType string, cfg *paramtable.MetaDBConfig) (gorm.Dialector, []zap.Field, error) {
+	switch metaStoreType {
+	case util.MetaStoreTypeMysql:
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			cfg.Username.GetValue(), cfg.Password.GetValue(), cfg.Address.GetValue(), cfg.Port.GetAsInt(), cfg.DBName.GetValue())
+		fields := []zap.Field{
+			zap.String("host", cfg.Address.GetValue()),
+			zap.Int("port", cfg.Port.GetAsInt()),
+			zap.String("database", cfg.DBName.GetValue()),
+		}
+		return mysql.Open(dsn), fields, nil
+	case util.MetaStoreTypeSQLite:
+		path := cfg.SQLitePath.GetValue()
+		if path == "" {
+			return nil, nil, fmt.Errorf("sqlite path is empty")
+		}
+		// wait for the lock instead of failing immediately if the database file is locked by another process
+		dsn := fmt.Sprintf("file:%s?_busy_timeout=5000", path)
+		return sqlite.Open(dsn), []zap.Field{zap.String("path", path)}, nil
+	default:
+		return nil, nil, fmt.Errorf("not supported meta db: %s", metaStoreType)
+	}
+}
+
+func dialectorDSN(dialector gorm.Dialector) string {
+	switch d := dialector.(type) {
+	case *mysql.Dialector:
+		return d.DSN
+	case *sqlite.Dialector:
+		return d.DSN
+	default:
+		return ""
+	}
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
+	connectMu.Lock()
+	defer connectMu.Unlock()
 	globalDB = db
+	connectedDSN = ""
 }
 
 type ctxTransactionKey struct{}
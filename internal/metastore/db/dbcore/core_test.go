@@ -0,0 +1,117 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newSQLiteConfig(t *testing.T, path string) *paramtable.MetaDBConfig {
+	base := paramtable.NewBaseTableFromYamlOnly("../../../../configs/milvus.yaml")
+	require.NoError(t, base.Save("sqlite.path", path))
+	require.NoError(t, base.Save("log.level", "info"))
+	cfg := &paramtable.MetaDBConfig{}
+	cfg.Init(base)
+	return cfg
+}
+
+func connectSQLite(t *testing.T, cfg *paramtable.MetaDBConfig) {
+	require.NoError(t, Connect(util.MetaStoreTypeSQLite, cfg))
+	t.Cleanup(func() { SetGlobalDB(nil) })
+}
+
+func TestConnect_SQLite(t *testing.T) {
+	cfg := newSQLiteConfig(t, path.Join(t.TempDir(), "meta.db"))
+	connectSQLite(t, cfg)
+
+	ctx := context.Background()
+	for _, table := range []interface{}{&dbmodel.Collection{}, &dbmodel.Field{}, &dbmodel.Grant{}, &dbmodel.GrantID{}} {
+		assert.True(t, GetDB(ctx).Migrator().HasTable(table))
+	}
+	assert.True(t, GetDB(ctx).Migrator().HasIndex("collections", "uk_collections_tenant_id_collection_id_ts"))
+
+	// migrate again on existing tables
+	assert.NoError(t, Migrate(GetDB(ctx)))
+
+	coll := &dbmodel.Collection{TenantID: "", CollectionID: 1, CollectionName: "coll", Ts: 100}
+	assert.NoError(t, GetDB(ctx).Create(coll).Error)
+	// violates the unique index
+	assert.Error(t, GetDB(ctx).Create(&dbmodel.Collection{TenantID: "", CollectionID: 1, CollectionName: "coll", Ts: 100}).Error)
+
+	// committed
+	err := NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		return GetDB(txCtx).Create(&dbmodel.Collection{CollectionID: 2, CollectionName: "coll2", Ts: 100}).Error
+	})
+	assert.NoError(t, err)
+
+	// rolled back
+	err = NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		if err := GetDB(txCtx).Create(&dbmodel.Collection{CollectionID: 3, CollectionName: "coll3", Ts: 100}).Error; err != nil {
+			return err
+		}
+		return errors.New("mock error")
+	})
+	assert.Error(t, err)
+
+	var colls []*dbmodel.Collection
+	assert.NoError(t, GetDB(ctx).Order("collection_id").Find(&colls).Error)
+	assert.Equal(t, 2, len(colls))
+	assert.Equal(t, int64(1), colls[0].CollectionID)
+	assert.Equal(t, int64(2), colls[1].CollectionID)
+}
+
+func TestConnect_SQLiteInMemory(t *testing.T) {
+	cfg := newSQLiteConfig(t, ":memory:")
+	connectSQLite(t, cfg)
+
+	ctx := context.Background()
+	err := NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		return GetDB(txCtx).Create(&dbmodel.Role{Name: "role"}).Error
+	})
+	assert.NoError(t, err)
+
+	var role dbmodel.Role
+	assert.NoError(t, GetDB(ctx).Where("name = ?", "role").Take(&role).Error)
+	assert.NotZero(t, role.ID)
+}
+
+func TestConnect_Twice(t *testing.T) {
+	dir := t.TempDir()
+	cfg := newSQLiteConfig(t, path.Join(dir, "meta.db"))
+	connectSQLite(t, cfg)
+	db := globalDB
+
+	ctx := context.Background()
+	assert.NoError(t, GetDB(ctx).Create(&dbmodel.Role{Name: "role"}).Error)
+
+	// the second call reuses the connection
+	assert.NoError(t, Connect(util.MetaStoreTypeSQLite, cfg))
+	assert.Same(t, db, globalDB)
+	var roles []*dbmodel.Role
+	assert.NoError(t, GetDB(ctx).Find(&roles).Error)
+	assert.Equal(t, 1, len(roles))
+
+	// can't switch to another database
+	assert.Error(t, Connect(util.MetaStoreTypeSQLite, newSQLiteConfig(t, path.Join(dir, "another.db"))))
+	assert.Same(t, db, globalDB)
+}
+
+func TestConnect_Error(t *testing.T) {
+	cfg := newSQLiteConfig(t, "")
+	assert.Error(t, Connect(util.MetaStoreTypeSQLite, cfg))
+	assert.Error(t, Connect(util.MetaStoreTypeEtcd, cfg))
+}
+
+func TestGetDB(t *testing.T) {
+	db := &gorm.DB{}
+	ctx := CtxWithTransaction(context.Background(), db)
+	assert.Equal(t, db, GetDB(ctx))
+}
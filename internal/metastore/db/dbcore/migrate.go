@@ -0,0 +1,97 @@
+package dbcore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type tableIndex struct {
+	table   string
+	name    string
+	unique  bool
+	columns []string
+}
+
+// the indexes are the same as scripts/sql/meta.sql, index names are prefixed with table name since
+// index names of sqlite are unique in the whole database.
+var tableIndexes = []tableIndex{
+	{"databases", "uk_databases_tenant_id_db_id_ts", true, []string{"tenant_id", "db_id", "ts"}},
+	{"collections", "uk_collections_tenant_id_collection_id_ts", true, []string{"tenant_id", "collection_id", "ts"}},
+	{"collection_aliases", "uk_collection_aliases_tenant_id_db_id_collection_alias_ts", true, []string{"tenant_id", "db_id", "collection_alias", "ts"}},
+	{"collection_aliases", "idx_collection_aliases_tenant_id_collection_id_ts", false, []string{"tenant_id", "collection_id", "ts"}},
+	{"collection_channels", "uk_collection_channels_tenant_id_collection_id_virtual_channel_name_ts", true, []string{"tenant_id", "collection_id", "virtual_channel_name", "ts"}},
+	{"collection_channels", "idx_collection_channels_tenant_id_collection_id_ts", false, []string{"tenant_id", "collection_id", "ts"}},
+	{"field_schemas", "uk_field_schemas_tenant_id_collection_id_field_name_ts", true, []string{"tenant_id", "collection_id", "field_name", "ts"}},
+	{"field_schemas", "idx_field_schemas_tenant_id_collection_id_field_id_ts", false, []string{"tenant_id", "collection_id", "field_id", "ts"}},
+	{"partitions", "uk_partitions_tenant_id_collection_id_partition_name_ts", true, []string{"tenant_id", "collection_id", "partition_name", "ts"}},
+	{"partitions", "idx_partitions_tenant_id_collection_id_partition_id_ts", false, []string{"tenant_id", "collection_id", "partition_id", "ts"}},
+	{"indexes", "idx_indexes_tenant_id_collection_id_index_id", false, []string{"tenant_id", "collection_id", "index_id"}},
+	{"segment_indexes", "uk_segment_indexes_tenant_id_segment_id_index_id", true, []string{"tenant_id", "segment_id", "index_id"}},
+	{"segment_indexes", "idx_segment_indexes_tenant_id_collection_id_segment_id_index_id", false, []string{"tenant_id", "collection_id", "segment_id", "index_id"}},
+	{"credential_users", "idx_credential_users_tenant_id_username", false, []string{"tenant_id", "username"}},
+	{"role", "idx_role_tenant_name", false, []string{"tenant_id", "name", "is_deleted"}},
+	{"user_role", "idx_role_mapping_tenant_user_role", false, []string{"tenant_id", "user_id", "role_id", "is_deleted"}},
+	{"grant", "idx_grant_principal_resource_tenant", false, []string{"tenant_id", "role_id", "object", "object_name", "is_deleted"}},
+	{"grant_id", "idx_grant_id_tenant_grantor", false, []string{"tenant_id", "grant_id", "grantor_id", "is_deleted"}},
+	{"segments", "uk_segments_tenant_id_segment_id", true, []string{"tenant_id", "segment_id"}},
+	{"binlogs", "idx_binlogs_tenant_id_segment_id_log_type", false, []string{"tenant_id", "segment_id", "log_type"}},
+	{"segment_events", "uk_segment_events_tenant_id_segment_id", true, []string{"tenant_id", "segment_id"}},
+	{"channel_checkpoints", "uk_channel_checkpoints_tenant_id_virtual_channel", true, []string{"tenant_id", "virtual_channel"}},
+	{"removed_channels", "uk_removed_channels_tenant_id_virtual_channel", true, []string{"tenant_id", "virtual_channel"}},
+	{"collection_load_infos", "uk_collection_load_infos_tenant_id_collection_id", true, []string{"tenant_id", "collection_id"}},
+	{"partition_load_infos", "uk_partition_load_infos_tenant_id_collection_id_partition_id", true, []string{"tenant_id", "collection_id", "partition_id"}},
+	{"replicas", "uk_replicas_tenant_id_collection_id_replica_id", true, []string{"tenant_id", "collection_id", "replica_id"}},
+}
+
+// Migrate creates the tables of dbmodel and their indexes if not exist, and adds the missing columns of existing tables.
+func Migrate(db *gorm.DB) error {
+	err := db.AutoMigrate(
+		&dbmodel.Database{},
+		&dbmodel.Collection{},
+		&dbmodel.CollectionAlias{},
+		&dbmodel.CollectionChannel{},
+		&dbmodel.Field{},
+		&dbmodel.Partition{},
+		&dbmodel.Index{},
+		&dbmodel.SegmentIndex{},
+		&dbmodel.User{},
+		&dbmodel.Role{},
+		&dbmodel.UserRole{},
+		&dbmodel.Grant{},
+		&dbmodel.GrantID{},
+		&dbmodel.Segment{},
+		&dbmodel.Binlog{},
+		&dbmodel.SegmentEvent{},
+		&dbmodel.ChannelCheckpoint{},
+		&dbmodel.RemovedChannel{},
+		&dbmodel.CollectionLoadInfo{},
+		&dbmodel.PartitionLoadInfo{},
+		&dbmodel.Replica{},
+	)
+	if err != nil {
+		return err
+	}
+
+	migrator := db.Migrator()
+	for _, idx := range tableIndexes {
+		if migrator.HasIndex(idx.table, idx.name) {
+			continue
+		}
+		unique := ""
+		if idx.unique {
+			unique = "UNIQUE "
+		}
+		columns := make([]string, 0, len(idx.columns))
+		for _, column := range idx.columns {
+			columns = append(columns, db.Statement.Quote(column))
+		}
+		sql := fmt.Sprintf("CREATE %sINDEX %s ON %s (%s)", unique, db.Statement.Quote(idx.name), db.Statement.Quote(idx.table), strings.Join(columns, ", "))
+		if err := db.Exec(sql).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// log types of the binlogs table, the values are the same as storage.BinlogType
+const (
+	InsertBinlog int32 = 0
+	DeleteBinlog int32 = 1
+	StatsBinlog  int32 = 4
+)
+
+type Binlog struct {
+	ID            int64              `gorm:"id"`
+	TenantID      string             `gorm:"tenant_id"`
+	CollectionID  int64              `gorm:"collection_id"`
+	PartitionID   int64              `gorm:"partition_id"`
+	SegmentID     int64              `gorm:"segment_id"`
+	FieldID       int64              `gorm:"field_id"`
+	LogType       int32              `gorm:"log_type"`
+	LogID         int64              `gorm:"log_id"`
+	NumEntries    int64              `gorm:"num_entries"`
+	TimestampFrom typeutil.Timestamp `gorm:"timestamp_from"`
+	TimestampTo   typeutil.Timestamp `gorm:"timestamp_to"`
+	LogPath       string             `gorm:"log_path"`
+	LogSize       int64              `gorm:"log_size"`
+	CreatedAt     time.Time          `gorm:"created_at"`
+	UpdatedAt     time.Time          `gorm:"updated_at"`
+}
+
+func (v Binlog) TableName() string {
+	return "binlogs"
+}
+
+//go:generate mockery --name=IBinlogDb
+type IBinlogDb interface {
+	List(tenantID string) ([]*Binlog, error)
+	Insert(in []*Binlog) error
+	DeleteBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
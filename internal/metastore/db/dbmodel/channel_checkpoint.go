@@ -0,0 +1,45 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type ChannelCheckpoint struct {
+	ID             int64     `gorm:"id"`
+	TenantID       string    `gorm:"tenant_id"`
+	VirtualChannel string    `gorm:"virtual_channel"`
+	Position       string    `gorm:"position"`
+	CreatedAt      time.Time `gorm:"created_at"`
+	UpdatedAt      time.Time `gorm:"updated_at"`
+}
+
+func (v ChannelCheckpoint) TableName() string {
+	return "channel_checkpoints"
+}
+
+//go:generate mockery --name=IChannelCheckpointDb
+type IChannelCheckpointDb interface {
+	List(tenantID string) ([]*ChannelCheckpoint, error)
+	Upsert(in *ChannelCheckpoint) error
+	Delete(tenantID string, vChannel string) error
+}
+
+// RemovedChannel is the remove flag of a virtual channel, it exists until the whole drop procedure is finished
+type RemovedChannel struct {
+	ID             int64     `gorm:"id"`
+	TenantID       string    `gorm:"tenant_id"`
+	VirtualChannel string    `gorm:"virtual_channel"`
+	CreatedAt      time.Time `gorm:"created_at"`
+	UpdatedAt      time.Time `gorm:"updated_at"`
+}
+
+func (v RemovedChannel) TableName() string {
+	return "removed_channels"
+}
+
+//go:generate mockery --name=IRemovedChannelDb
+type IRemovedChannelDb interface {
+	Exist(tenantID string, vChannel string) (bool, error)
+	Insert(in *RemovedChannel) error
+	Delete(tenantID string, vChannel string) error
+}
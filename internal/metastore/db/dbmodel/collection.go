@@ -15,6 +15,7 @@ import (
 type Collection struct {
 	ID               int64              `gorm:"id"`
 	TenantID         string             `gorm:"tenant_id"`
+	DbID             int64              `gorm:"db_id"`
 	CollectionID     int64              `gorm:"collection_id"`
 	CollectionName   string             `gorm:"collection_name"`
 	Description      string             `gorm:"description"`
@@ -40,9 +41,11 @@ type ICollectionDb interface {
 	GetCollectionIDTs(tenantID string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*Collection, error)
 	ListCollectionIDTs(tenantID string, ts typeutil.Timestamp) ([]*Collection, error)
 	Get(tenantID string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*Collection, error)
-	GetCollectionIDByName(tenantID string, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
+	GetCollectionIDByName(tenantID string, dbID int64, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
 	Insert(in *Collection) error
 	Update(in *Collection) error
+	// RenameCollection changes the name of all the records of the collection, the collection name isn't versioned by ts.
+	RenameCollection(tenantID string, collectionID typeutil.UniqueID, collectionName string) error
 }
 
 // model <---> db
@@ -66,6 +69,7 @@ func UnmarshalCollectionModel(coll *Collection) (*model.Collection, error) {
 
 	return &model.Collection{
 		TenantID:         coll.TenantID,
+		DBID:             coll.DbID,
 		CollectionID:     coll.CollectionID,
 		Name:             coll.CollectionName,
 		Description:      coll.Description,
@@ -9,6 +9,7 @@ import (
 type CollectionAlias struct {
 	ID              int64              `gorm:"id"`
 	TenantID        string             `gorm:"tenant_id"`
+	DbID            int64              `gorm:"db_id"`
 	CollectionID    int64              `gorm:"collection_id"`
 	CollectionAlias string             `gorm:"collection_alias"`
 	Ts              typeutil.Timestamp `gorm:"ts"`
@@ -24,7 +25,7 @@ func (v CollectionAlias) TableName() string {
 //go:generate mockery --name=ICollAliasDb
 type ICollAliasDb interface {
 	Insert(in []*CollectionAlias) error
-	GetCollectionIDByAlias(tenantID string, alias string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
+	GetCollectionIDByAlias(tenantID string, dbID int64, alias string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
 	ListCollectionIDTs(tenantID string, ts typeutil.Timestamp) ([]*CollectionAlias, error)
 	List(tenantID string, cidTsPairs []*CollectionAlias) ([]*CollectionAlias, error)
 }
@@ -4,6 +4,7 @@ import "context"
 
 //go:generate mockery --name=IMetaDomain
 type IMetaDomain interface {
+	DatabaseDb(ctx context.Context) IDatabaseDb
 	CollectionDb(ctx context.Context) ICollectionDb
 	FieldDb(ctx context.Context) IFieldDb
 	CollChannelDb(ctx context.Context) ICollChannelDb
@@ -16,6 +17,14 @@ type IMetaDomain interface {
 	UserRoleDb(ctx context.Context) IUserRoleDb
 	GrantDb(ctx context.Context) IGrantDb
 	GrantIDDb(ctx context.Context) IGrantIDDb
+	SegmentDb(ctx context.Context) ISegmentDb
+	BinlogDb(ctx context.Context) IBinlogDb
+	SegmentEventDb(ctx context.Context) ISegmentEventDb
+	ChannelCheckpointDb(ctx context.Context) IChannelCheckpointDb
+	RemovedChannelDb(ctx context.Context) IRemovedChannelDb
+	CollectionLoadInfoDb(ctx context.Context) ICollectionLoadInfoDb
+	PartitionLoadInfoDb(ctx context.Context) IPartitionLoadInfoDb
+	ReplicaDb(ctx context.Context) IReplicaDb
 }
 
 type ITransaction interface {
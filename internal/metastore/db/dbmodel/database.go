@@ -0,0 +1,42 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Database struct {
+	ID        int64              `gorm:"id"`
+	TenantID  string             `gorm:"tenant_id"`
+	DbID      int64              `gorm:"db_id"`
+	DbName    string             `gorm:"db_name"`
+	Ts        typeutil.Timestamp `gorm:"ts"`
+	IsDeleted bool               `gorm:"is_deleted"`
+	CreatedAt time.Time          `gorm:"created_at"`
+	UpdatedAt time.Time          `gorm:"updated_at"`
+}
+
+func (v Database) TableName() string {
+	return "databases"
+}
+
+//go:generate mockery --name=IDatabaseDb
+type IDatabaseDb interface {
+	Insert(in *Database) error
+	// ListDbIDTs get the largest timestamp that less than or equal to param ts of each database, no matter is_deleted is true or false.
+	ListDbIDTs(tenantID string, ts typeutil.Timestamp) ([]*Database, error)
+	List(tenantID string, dbIDTsPairs []*Database) ([]*Database, error)
+}
+
+// model <---> db
+
+func UnmarshalDatabaseModel(db *Database) *model.Database {
+	return &model.Database{
+		TenantID:    db.TenantID,
+		ID:          db.DbID,
+		Name:        db.DbName,
+		CreatedTime: db.Ts,
+	}
+}
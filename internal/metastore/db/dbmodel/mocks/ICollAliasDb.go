@@ -12,20 +12,20 @@ type ICollAliasDb struct {
 	mock.Mock
 }
 
-// GetCollectionIDByAlias provides a mock function with given fields: tenantID, alias, ts
-func (_m *ICollAliasDb) GetCollectionIDByAlias(tenantID string, alias string, ts uint64) (int64, error) {
-	ret := _m.Called(tenantID, alias, ts)
+// GetCollectionIDByAlias provides a mock function with given fields: tenantID, dbID, alias, ts
+func (_m *ICollAliasDb) GetCollectionIDByAlias(tenantID string, dbID int64, alias string, ts uint64) (int64, error) {
+	ret := _m.Called(tenantID, dbID, alias, ts)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, string, uint64) int64); ok {
-		r0 = rf(tenantID, alias, ts)
+	if rf, ok := ret.Get(0).(func(string, int64, string, uint64) int64); ok {
+		r0 = rf(tenantID, dbID, alias, ts)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, uint64) error); ok {
-		r1 = rf(tenantID, alias, ts)
+	if rf, ok := ret.Get(1).(func(string, int64, string, uint64) error); ok {
+		r1 = rf(tenantID, dbID, alias, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,20 +35,20 @@ func (_m *ICollectionDb) Get(tenantID string, collectionID int64, ts uint64) (*d
 	return r0, r1
 }
 
-// GetCollectionIDByName provides a mock function with given fields: tenantID, collectionName, ts
-func (_m *ICollectionDb) GetCollectionIDByName(tenantID string, collectionName string, ts uint64) (int64, error) {
-	ret := _m.Called(tenantID, collectionName, ts)
+// GetCollectionIDByName provides a mock function with given fields: tenantID, dbID, collectionName, ts
+func (_m *ICollectionDb) GetCollectionIDByName(tenantID string, dbID int64, collectionName string, ts uint64) (int64, error) {
+	ret := _m.Called(tenantID, dbID, collectionName, ts)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, string, uint64) int64); ok {
-		r0 = rf(tenantID, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(string, int64, string, uint64) int64); ok {
+		r0 = rf(tenantID, dbID, collectionName, ts)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, uint64) error); ok {
-		r1 = rf(tenantID, collectionName, ts)
+	if rf, ok := ret.Get(1).(func(string, int64, string, uint64) error); ok {
+		r1 = rf(tenantID, dbID, collectionName, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,6 +116,20 @@ func (_m *ICollectionDb) ListCollectionIDTs(tenantID string, ts uint64) ([]*dbmo
 	return r0, r1
 }
 
+// RenameCollection provides a mock function with given fields: tenantID, collectionID, collectionName
+func (_m *ICollectionDb) RenameCollection(tenantID string, collectionID int64, collectionName string) error {
+	ret := _m.Called(tenantID, collectionID, collectionName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, string) error); ok {
+		r0 = rf(tenantID, collectionID, collectionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: in
 func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	ret := _m.Called(in)
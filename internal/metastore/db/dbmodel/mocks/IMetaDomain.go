@@ -14,6 +14,38 @@ type IMetaDomain struct {
 	mock.Mock
 }
 
+// BinlogDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) BinlogDb(ctx context.Context) dbmodel.IBinlogDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IBinlogDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IBinlogDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IBinlogDb)
+		}
+	}
+
+	return r0
+}
+
+// ChannelCheckpointDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ChannelCheckpointDb(ctx context.Context) dbmodel.IChannelCheckpointDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IChannelCheckpointDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IChannelCheckpointDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IChannelCheckpointDb)
+		}
+	}
+
+	return r0
+}
+
 // CollAliasDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollAliasDb(ctx context.Context) dbmodel.ICollAliasDb {
 	ret := _m.Called(ctx)
@@ -62,6 +94,38 @@ func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return r0
 }
 
+// CollectionLoadInfoDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionLoadInfoDb(ctx context.Context) dbmodel.ICollectionLoadInfoDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ICollectionLoadInfoDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionLoadInfoDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionLoadInfoDb)
+		}
+	}
+
+	return r0
+}
+
+// DatabaseDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IDatabaseDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IDatabaseDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IDatabaseDb)
+		}
+	}
+
+	return r0
+}
+
 // FieldDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) FieldDb(ctx context.Context) dbmodel.IFieldDb {
 	ret := _m.Called(ctx)
@@ -142,6 +206,54 @@ func (_m *IMetaDomain) PartitionDb(ctx context.Context) dbmodel.IPartitionDb {
 	return r0
 }
 
+// PartitionLoadInfoDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) PartitionLoadInfoDb(ctx context.Context) dbmodel.IPartitionLoadInfoDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IPartitionLoadInfoDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IPartitionLoadInfoDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IPartitionLoadInfoDb)
+		}
+	}
+
+	return r0
+}
+
+// RemovedChannelDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) RemovedChannelDb(ctx context.Context) dbmodel.IRemovedChannelDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IRemovedChannelDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IRemovedChannelDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IRemovedChannelDb)
+		}
+	}
+
+	return r0
+}
+
+// ReplicaDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ReplicaDb(ctx context.Context) dbmodel.IReplicaDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IReplicaDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IReplicaDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IReplicaDb)
+		}
+	}
+
+	return r0
+}
+
 // RoleDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) RoleDb(ctx context.Context) dbmodel.IRoleDb {
 	ret := _m.Called(ctx)
@@ -158,6 +270,38 @@ func (_m *IMetaDomain) RoleDb(ctx context.Context) dbmodel.IRoleDb {
 	return r0
 }
 
+// SegmentDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ISegmentDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ISegmentDb)
+		}
+	}
+
+	return r0
+}
+
+// SegmentEventDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) SegmentEventDb(ctx context.Context) dbmodel.ISegmentEventDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ISegmentEventDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentEventDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ISegmentEventDb)
+		}
+	}
+
+	return r0
+}
+
 // SegmentIndexDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) SegmentIndexDb(ctx context.Context) dbmodel.ISegmentIndexDb {
 	ret := _m.Called(ctx)
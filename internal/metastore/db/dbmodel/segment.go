@@ -0,0 +1,41 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Segment struct {
+	ID                  int64              `gorm:"id"`
+	TenantID            string             `gorm:"tenant_id"`
+	SegmentID           int64              `gorm:"segment_id"`
+	CollectionID        int64              `gorm:"collection_id"`
+	PartitionID         int64              `gorm:"partition_id"`
+	NumRows             int64              `gorm:"num_rows"`
+	MaxRowNum           int64              `gorm:"max_row_num"`
+	DmChannel           string             `gorm:"dm_channel"`
+	DmlPosition         string             `gorm:"dml_position"`
+	StartPosition       string             `gorm:"start_position"`
+	CompactionFrom      string             `gorm:"compaction_from"`
+	CreatedByCompaction bool               `gorm:"created_by_compaction"`
+	SegmentState        int32              `gorm:"segment_state"`
+	LastExpireTime      typeutil.Timestamp `gorm:"last_expire_time"`
+	DroppedAt           typeutil.Timestamp `gorm:"dropped_at"`
+	IsImporting         bool               `gorm:"is_importing"`
+	IsFake              bool               `gorm:"is_fake"`
+	IsClustered         bool               `gorm:"is_clustered"`
+	CreatedAt           time.Time          `gorm:"created_at"`
+	UpdatedAt           time.Time          `gorm:"updated_at"`
+}
+
+func (v Segment) TableName() string {
+	return "segments"
+}
+
+//go:generate mockery --name=ISegmentDb
+type ISegmentDb interface {
+	List(tenantID string) ([]*Segment, error)
+	Upsert(in []*Segment) error
+	Delete(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
@@ -0,0 +1,30 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// SegmentEvent is a flushed segment event waiting to be published to the event kv watched by IndexCoord.
+// It's written in the same transaction as the segment and deleted after the event is published.
+type SegmentEvent struct {
+	ID        int64     `gorm:"id"`
+	TenantID  string    `gorm:"tenant_id"`
+	SegmentID int64     `gorm:"segment_id"`
+	EventKey  string    `gorm:"event_key"`
+	Event     []byte    `gorm:"event"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v SegmentEvent) TableName() string {
+	return "segment_events"
+}
+
+//go:generate mockery --name=ISegmentEventDb
+type ISegmentEventDb interface {
+	List(tenantID string) ([]*SegmentEvent, error)
+	Upsert(in []*SegmentEvent) error
+	Delete(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
@@ -0,0 +1,222 @@
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"go.uber.org/zap"
+)
+
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+	}
+}
+
+// QueryCoordCatalog carries no context, the default tenant is used.
+func (tc *Catalog) context() context.Context {
+	return context.TODO()
+}
+
+func (tc *Catalog) SaveCollection(info *querypb.CollectionLoadInfo) error {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	releasedPartitions, err := json.Marshal(info.GetReleasedPartitions())
+	if err != nil {
+		return err
+	}
+	fieldIndexID, err := json.Marshal(info.GetFieldIndexID())
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).Upsert(&dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       info.GetCollectionID(),
+		ReleasedPartitions: string(releasedPartitions),
+		ReplicaNumber:      info.GetReplicaNumber(),
+		Status:             int32(info.GetStatus()),
+		FieldIndexID:       string(fieldIndexID),
+	})
+}
+
+func (tc *Catalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
+	if len(info) == 0 {
+		return nil
+	}
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	partitions := make([]*dbmodel.PartitionLoadInfo, 0, len(info))
+	for _, partition := range info {
+		fieldIndexID, err := json.Marshal(partition.GetFieldIndexID())
+		if err != nil {
+			return err
+		}
+		partitions = append(partitions, &dbmodel.PartitionLoadInfo{
+			TenantID:      tenantID,
+			CollectionID:  partition.GetCollectionID(),
+			PartitionID:   partition.GetPartitionID(),
+			ReplicaNumber: partition.GetReplicaNumber(),
+			Status:        int32(partition.GetStatus()),
+			FieldIndexID:  string(fieldIndexID),
+		})
+	}
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Upsert(partitions)
+}
+
+func (tc *Catalog) SaveReplica(replica *querypb.Replica) error {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	nodes, err := json.Marshal(replica.GetNodes())
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ReplicaDb(ctx).Upsert(&dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: replica.GetCollectionID(),
+		ReplicaID:    replica.GetID(),
+		Nodes:        string(nodes),
+	})
+}
+
+func (tc *Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	infos, err := tc.metaDomain.CollectionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.CollectionLoadInfo, 0, len(infos))
+	for _, info := range infos {
+		var releasedPartitions []int64
+		if err := unmarshalJSON(info.ReleasedPartitions, &releasedPartitions); err != nil {
+			log.Error("unmarshal released partitions of collection load info failed", zap.Int64("collID", info.CollectionID), zap.Error(err))
+			return nil, err
+		}
+		var fieldIndexID map[int64]int64
+		if err := unmarshalJSON(info.FieldIndexID, &fieldIndexID); err != nil {
+			log.Error("unmarshal field index id of collection load info failed", zap.Int64("collID", info.CollectionID), zap.Error(err))
+			return nil, err
+		}
+		ret = append(ret, &querypb.CollectionLoadInfo{
+			CollectionID:       info.CollectionID,
+			ReleasedPartitions: releasedPartitions,
+			ReplicaNumber:      info.ReplicaNumber,
+			Status:             querypb.LoadStatus(info.Status),
+			FieldIndexID:       fieldIndexID,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	infos, err := tc.metaDomain.PartitionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[int64][]*querypb.PartitionLoadInfo)
+	for _, info := range infos {
+		var fieldIndexID map[int64]int64
+		if err := unmarshalJSON(info.FieldIndexID, &fieldIndexID); err != nil {
+			log.Error("unmarshal field index id of partition load info failed", zap.Int64("collID", info.CollectionID),
+				zap.Int64("partitionID", info.PartitionID), zap.Error(err))
+			return nil, err
+		}
+		ret[info.CollectionID] = append(ret[info.CollectionID], &querypb.PartitionLoadInfo{
+			CollectionID:  info.CollectionID,
+			PartitionID:   info.PartitionID,
+			ReplicaNumber: info.ReplicaNumber,
+			Status:        querypb.LoadStatus(info.Status),
+			FieldIndexID:  fieldIndexID,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetReplicas() ([]*querypb.Replica, error) {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	replicas, err := tc.metaDomain.ReplicaDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.Replica, 0, len(replicas))
+	for _, replica := range replicas {
+		var nodes []int64
+		if err := unmarshalJSON(replica.Nodes, &nodes); err != nil {
+			log.Error("unmarshal nodes of replica failed", zap.Int64("collID", replica.CollectionID),
+				zap.Int64("replicaID", replica.ReplicaID), zap.Error(err))
+			return nil, err
+		}
+		ret = append(ret, &querypb.Replica{
+			ID:           replica.ReplicaID,
+			CollectionID: replica.CollectionID,
+			Nodes:        nodes,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) ReleaseCollection(id int64) error {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).Delete(tenantID, id)
+}
+
+func (tc *Catalog) ReleasePartition(collection int64, partitions ...int64) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Delete(tenantID, collection, partitions)
+}
+
+func (tc *Catalog) ReleaseReplicas(collectionID int64) error {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).DeleteByCollectionID(tenantID, collectionID)
+}
+
+func (tc *Catalog) ReleaseReplica(collection, replica int64) error {
+	ctx := tc.context()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).Delete(tenantID, collection, replica)
+}
+
+func unmarshalJSON(data string, v interface{}) error {
+	if data == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(data), v)
+}
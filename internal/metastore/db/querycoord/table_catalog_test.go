@@ -0,0 +1,170 @@
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	collID1      = int64(101)
+	partitionID1 = int64(500)
+	partitionID2 = int64(501)
+	replicaID1   = int64(1000)
+)
+
+var (
+	ctx            = context.TODO()
+	metaDomainMock *mocks.IMetaDomain
+	collLoadDbMock *mocks.ICollectionLoadInfoDb
+	partLoadDbMock *mocks.IPartitionLoadInfoDb
+	replicaDbMock  *mocks.IReplicaDb
+	errTest        = errors.New("test error")
+
+	mockCatalog *Catalog
+)
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	collLoadDbMock = &mocks.ICollectionLoadInfoDb{}
+	partLoadDbMock = &mocks.IPartitionLoadInfoDb{}
+	replicaDbMock = &mocks.IReplicaDb{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("CollectionLoadInfoDb", ctx).Return(collLoadDbMock)
+	metaDomainMock.On("PartitionLoadInfoDb", ctx).Return(partLoadDbMock)
+	metaDomainMock.On("ReplicaDb", ctx).Return(replicaDbMock)
+
+	mockCatalog = NewTableCatalog(&NoopTransaction{}, metaDomainMock)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestTableCatalog_Collection(t *testing.T) {
+	info := &querypb.CollectionLoadInfo{
+		CollectionID:       collID1,
+		ReleasedPartitions: []int64{partitionID2},
+		ReplicaNumber:      2,
+		Status:             querypb.LoadStatus_Loaded,
+		FieldIndexID:       map[int64]int64{100: 1001, 101: 1002},
+	}
+
+	var saved *dbmodel.CollectionLoadInfo
+	collLoadDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*dbmodel.CollectionLoadInfo)
+	}).Return(nil).Once()
+	err := mockCatalog.SaveCollection(info)
+	require.NoError(t, err)
+	require.Equal(t, collID1, saved.CollectionID)
+
+	collLoadDbMock.On("List", "").Return([]*dbmodel.CollectionLoadInfo{saved}, nil).Once()
+	infos, err := mockCatalog.GetCollections()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(infos))
+	require.True(t, proto.Equal(info, infos[0]))
+
+	collLoadDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetCollections()
+	require.Equal(t, errTest, err)
+
+	collLoadDbMock.On("List", "").Return([]*dbmodel.CollectionLoadInfo{{CollectionID: collID1, FieldIndexID: "invalid"}}, nil).Once()
+	_, err = mockCatalog.GetCollections()
+	require.Error(t, err)
+
+	collLoadDbMock.On("Delete", "", collID1).Return(nil).Once()
+	err = mockCatalog.ReleaseCollection(collID1)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_Partition(t *testing.T) {
+	infos := []*querypb.PartitionLoadInfo{
+		{
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			ReplicaNumber: 1,
+			Status:        querypb.LoadStatus_Loading,
+			FieldIndexID:  map[int64]int64{100: 1001},
+		},
+		{
+			CollectionID:  collID1,
+			PartitionID:   partitionID2,
+			ReplicaNumber: 1,
+			Status:        querypb.LoadStatus_Loaded,
+		},
+	}
+
+	err := mockCatalog.SavePartition()
+	require.NoError(t, err)
+
+	var saved []*dbmodel.PartitionLoadInfo
+	partLoadDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).([]*dbmodel.PartitionLoadInfo)
+	}).Return(nil).Once()
+	err = mockCatalog.SavePartition(infos...)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(saved))
+
+	partLoadDbMock.On("List", "").Return(saved, nil).Once()
+	partitions, err := mockCatalog.GetPartitions()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(partitions[collID1]))
+	require.True(t, proto.Equal(infos[0], partitions[collID1][0]))
+	require.Equal(t, partitionID2, partitions[collID1][1].GetPartitionID())
+
+	partLoadDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetPartitions()
+	require.Equal(t, errTest, err)
+
+	partLoadDbMock.On("Delete", "", collID1, []int64{partitionID1, partitionID2}).Return(nil).Once()
+	err = mockCatalog.ReleasePartition(collID1, partitionID1, partitionID2)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_Replica(t *testing.T) {
+	replica := &querypb.Replica{
+		ID:           replicaID1,
+		CollectionID: collID1,
+		Nodes:        []int64{1, 2, 3},
+	}
+
+	var saved *dbmodel.Replica
+	replicaDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*dbmodel.Replica)
+	}).Return(nil).Once()
+	err := mockCatalog.SaveReplica(replica)
+	require.NoError(t, err)
+	require.Equal(t, "[1,2,3]", saved.Nodes)
+
+	replicaDbMock.On("List", "").Return([]*dbmodel.Replica{saved}, nil).Once()
+	replicas, err := mockCatalog.GetReplicas()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(replicas))
+	require.True(t, proto.Equal(replica, replicas[0]))
+
+	replicaDbMock.On("List", "").Return([]*dbmodel.Replica{{ReplicaID: replicaID1, Nodes: "invalid"}}, nil).Once()
+	_, err = mockCatalog.GetReplicas()
+	require.Error(t, err)
+
+	replicaDbMock.On("Delete", "", collID1, replicaID1).Return(nil).Once()
+	err = mockCatalog.ReleaseReplica(collID1, replicaID1)
+	require.NoError(t, err)
+
+	replicaDbMock.On("DeleteByCollectionID", "", collID1).Return(errTest).Once()
+	err = mockCatalog.ReleaseReplicas(collID1)
+	require.Equal(t, errTest, err)
+}
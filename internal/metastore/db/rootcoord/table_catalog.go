@@ -37,6 +37,57 @@ func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain
 	}
 }
 
+func (tc *Catalog) CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.DatabaseDb(ctx).Insert(&dbmodel.Database{
+		TenantID: tenantID,
+		DbID:     db.ID,
+		DbName:   db.Name,
+		Ts:       ts,
+	})
+}
+
+func (tc *Catalog) DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	// insert a mark-deleted record for databases
+	return tc.metaDomain.DatabaseDb(ctx).Insert(&dbmodel.Database{
+		TenantID:  tenantID,
+		DbID:      dbID,
+		Ts:        ts,
+		IsDeleted: true,
+	})
+}
+
+// ListDatabases find the latest record of each database with ts <= @param ts, the dropped ones are filtered out.
+func (tc *Catalog) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	// 1. find each db_id with latest ts <= @param ts
+	dbIDTsPairs, err := tc.metaDomain.DatabaseDb(ctx).ListDbIDTs(tenantID, ts)
+	if err != nil {
+		log.Error("list latest ts and corresponding db_id in databases failed", zap.Uint64("ts", ts), zap.Error(err))
+		return nil, err
+	}
+	if len(dbIDTsPairs) == 0 {
+		return []*model.Database{}, nil
+	}
+
+	// 2. select with IN clause
+	dbs, err := tc.metaDomain.DatabaseDb(ctx).List(tenantID, dbIDTsPairs)
+	if err != nil {
+		log.Error("list databases failed", zap.Uint64("ts", ts), zap.Error(err))
+		return nil, err
+	}
+
+	r := make([]*model.Database, 0, len(dbs))
+	for _, db := range dbs {
+		r = append(r, dbmodel.UnmarshalDatabaseModel(db))
+	}
+	return r, nil
+}
+
 func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Collection, ts typeutil.Timestamp) error {
 	tenantID := contextutil.TenantID(ctx)
 
@@ -59,6 +110,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 
 		err = tc.metaDomain.CollectionDb(txCtx).Insert(&dbmodel.Collection{
 			TenantID:         tenantID,
+			DbID:             collection.DBID,
 			CollectionID:     collection.CollectionID,
 			CollectionName:   collection.Name,
 			Description:      collection.Description,
@@ -218,11 +270,11 @@ func (tc *Catalog) populateCollection(ctx context.Context, collectionID typeutil
 	return mCollection, nil
 }
 
-func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (tc *Catalog) GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
-	// Since collection name will not change for different ts
-	collectionID, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDByName(tenantID, collectionName, ts)
+	// Since collection name is renamed for all the ts
+	collectionID, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDByName(tenantID, dbID, collectionName, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -236,8 +288,9 @@ func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 // [collection2, t2, is_deleted=false]
 // [collection3, t3, is_deleted=false]
 // t1, t2, t3 are the largest timestamp that less than or equal to @param ts
-// the final result will only return collection2 and collection3 since collection1 is deleted
-func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+// the final result will only return collection2 and collection3 since collection1 is deleted,
+// and only the collections belong to the database @param dbID are returned.
+func (tc *Catalog) ListCollections(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// 1. find each collection_id with latest ts <= @param ts
@@ -246,7 +299,7 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 	if len(cidTsPairs) == 0 {
-		return map[string]*model.Collection{}, nil
+		return []*model.Collection{}, nil
 	}
 
 	// 2. populate each collection
@@ -272,9 +325,11 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 
-	r := map[string]*model.Collection{}
+	r := make([]*model.Collection, 0, len(collections))
 	for _, c := range collections {
-		r[c.Name] = c
+		if c.DBID == dbID {
+			r = append(r, c)
+		}
 	}
 
 	return r, nil
@@ -330,6 +385,7 @@ func (tc *Catalog) DropCollection(ctx context.Context, collection *model.Collect
 			for _, alias := range collection.Aliases {
 				collAliases = append(collAliases, &dbmodel.CollectionAlias{
 					TenantID:        tenantID,
+					DbID:            collection.DBID,
 					CollectionID:    collection.CollectionID,
 					CollectionAlias: alias,
 					Ts:              ts,
@@ -424,7 +480,15 @@ func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		Properties:       properties,
 	}
 
-	return tc.metaDomain.CollectionDb(ctx).Update(coll)
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// collection is looked up by name with the latest record, rename all the records to avoid finding it by the old name.
+		if oldColl.Name != newColl.Name {
+			if err := tc.metaDomain.CollectionDb(txCtx).RenameCollection(tenantID, newColl.CollectionID, newColl.Name); err != nil {
+				return err
+			}
+		}
+		return tc.metaDomain.CollectionDb(txCtx).Update(coll)
+	})
 }
 
 func (tc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
@@ -503,6 +567,7 @@ func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 
 	collAlias := &dbmodel.CollectionAlias{
 		TenantID:        tenantID,
+		DbID:            alias.DBID,
 		CollectionID:    alias.CollectionID,
 		CollectionAlias: alias.Name,
 		Ts:              ts,
@@ -516,16 +581,17 @@ func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 	return nil
 }
 
-func (tc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
+func (tc *Catalog) DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error {
 	tenantID := contextutil.TenantID(ctx)
 
-	collectionID, err := tc.metaDomain.CollAliasDb(ctx).GetCollectionIDByAlias(tenantID, alias, ts)
+	collectionID, err := tc.metaDomain.CollAliasDb(ctx).GetCollectionIDByAlias(tenantID, dbID, alias, ts)
 	if err != nil {
 		return err
 	}
 
 	collAlias := &dbmodel.CollectionAlias{
 		TenantID:        tenantID,
+		DbID:            dbID,
 		CollectionID:    collectionID,
 		CollectionAlias: alias,
 		Ts:              ts,
@@ -575,6 +641,7 @@ func (tc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*m
 	r := make([]*model.Alias, 0, len(collAliases))
 	for _, record := range collAliases {
 		r = append(r, &model.Alias{
+			DBID:         record.DbID,
 			CollectionID: record.CollectionID,
 			Name:         record.CollectionAlias,
 		})
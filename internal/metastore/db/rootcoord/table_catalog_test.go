@@ -28,6 +28,7 @@ import (
 
 const (
 	tenantID      = "test_tenant"
+	dbID1         = int64(1)
 	noTs          = typeutil.Timestamp(0)
 	ts            = typeutil.Timestamp(10)
 	collID1       = typeutil.UniqueID(101)
@@ -48,6 +49,7 @@ const (
 var (
 	ctx               context.Context
 	metaDomainMock    *mocks.IMetaDomain
+	databaseDbMock    *mocks.IDatabaseDb
 	collDbMock        *mocks.ICollectionDb
 	fieldDbMock       *mocks.IFieldDb
 	partitionDbMock   *mocks.IPartitionDb
@@ -68,6 +70,7 @@ var (
 func TestMain(m *testing.M) {
 	ctx = contextutil.WithTenantID(context.Background(), tenantID)
 
+	databaseDbMock = &mocks.IDatabaseDb{}
 	collDbMock = &mocks.ICollectionDb{}
 	fieldDbMock = &mocks.IFieldDb{}
 	partitionDbMock = &mocks.IPartitionDb{}
@@ -82,6 +85,7 @@ func TestMain(m *testing.M) {
 	grantIDDbMock = &mocks.IGrantIDDb{}
 
 	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("DatabaseDb", ctx).Return(databaseDbMock)
 	metaDomainMock.On("CollectionDb", ctx).Return(collDbMock)
 	metaDomainMock.On("FieldDb", ctx).Return(fieldDbMock)
 	metaDomainMock.On("PartitionDb", ctx).Return(partitionDbMock)
@@ -441,7 +445,7 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 	}
 
 	// expectation
-	collDbMock.On("GetCollectionIDByName", tenantID, collName1, ts).Return(collID1, nil).Once()
+	collDbMock.On("GetCollectionIDByName", tenantID, dbID1, collName1, ts).Return(collID1, nil).Once()
 	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(&dbmodel.Collection{CollectionID: collID1, Ts: ts}, nil).Once()
 	collDbMock.On("Get", tenantID, collID1, ts).Return(coll, nil).Once()
 	fieldDbMock.On("GetByCollectionID", tenantID, collID1, ts).Return(fields, nil).Once()
@@ -450,7 +454,7 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 	indexDbMock.On("Get", tenantID, collID1).Return(indexes, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, dbID1, collName1, ts)
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, coll.TenantID, res.TenantID)
@@ -469,10 +473,10 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 func TestTableCatalog_GetCollectionByName_SelectCollIDError(t *testing.T) {
 	// expectation
 	errTest := errors.New("select fields error")
-	collDbMock.On("GetCollectionIDByName", tenantID, collName1, ts).Return(typeutil.UniqueID(0), errTest).Once()
+	collDbMock.On("GetCollectionIDByName", tenantID, dbID1, collName1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, dbID1, collName1, ts)
 	require.Nil(t, res)
 	require.Error(t, gotErr)
 }
@@ -480,6 +484,7 @@ func TestTableCatalog_GetCollectionByName_SelectCollIDError(t *testing.T) {
 func TestTableCatalog_ListCollections(t *testing.T) {
 	coll := &dbmodel.Collection{
 		TenantID:       tenantID,
+		DbID:           dbID1,
 		CollectionID:   collID1,
 		CollectionName: collName1,
 		AutoID:         true,
@@ -532,21 +537,87 @@ func TestTableCatalog_ListCollections(t *testing.T) {
 	indexDbMock.On("Get", tenantID, collID1).Return(indexes, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListCollections(ctx, ts)
+	res, gotErr := mockCatalog.ListCollections(ctx, dbID1, ts)
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, 1, len(res))
-	require.Equal(t, coll.TenantID, res[coll.CollectionName].TenantID)
-	require.Equal(t, coll.CollectionID, res[coll.CollectionName].CollectionID)
-	require.Equal(t, coll.CollectionName, res[coll.CollectionName].Name)
-	require.Equal(t, coll.AutoID, res[coll.CollectionName].AutoID)
-	require.Equal(t, coll.Ts, res[coll.CollectionName].CreateTime)
-	require.Empty(t, res[coll.CollectionName].StartPositions)
+	require.Equal(t, coll.TenantID, res[0].TenantID)
+	require.Equal(t, coll.DbID, res[0].DBID)
+	require.Equal(t, coll.CollectionID, res[0].CollectionID)
+	require.Equal(t, coll.CollectionName, res[0].Name)
+	require.Equal(t, coll.AutoID, res[0].AutoID)
+	require.Equal(t, coll.Ts, res[0].CreateTime)
+	require.Empty(t, res[0].StartPositions)
 	// partitions/fields/channels
-	require.NotEmpty(t, res[coll.CollectionName].Partitions)
-	require.NotEmpty(t, res[coll.CollectionName].Fields)
-	require.NotEmpty(t, res[coll.CollectionName].VirtualChannelNames)
-	require.NotEmpty(t, res[coll.CollectionName].PhysicalChannelNames)
+	require.NotEmpty(t, res[0].Partitions)
+	require.NotEmpty(t, res[0].Fields)
+	require.NotEmpty(t, res[0].VirtualChannelNames)
+	require.NotEmpty(t, res[0].PhysicalChannelNames)
+}
+
+func TestTableCatalog_ListCollections_OtherDatabase(t *testing.T) {
+	coll := &dbmodel.Collection{
+		TenantID:       tenantID,
+		CollectionID:   collID1,
+		CollectionName: collName1,
+		Ts:             ts,
+	}
+
+	// expectation
+	collDbMock.On("ListCollectionIDTs", tenantID, ts).Return([]*dbmodel.Collection{{CollectionID: collID1, Ts: ts}}, nil).Once()
+	collDbMock.On("Get", tenantID, collID1, ts).Return(coll, nil).Once()
+	fieldDbMock.On("GetByCollectionID", tenantID, collID1, ts).Return([]*dbmodel.Field{}, nil).Once()
+	partitionDbMock.On("GetByCollectionID", tenantID, collID1, ts).Return([]*dbmodel.Partition{}, nil).Once()
+	collChannelDbMock.On("GetByCollectionID", tenantID, collID1, ts).Return([]*dbmodel.CollectionChannel{}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListCollections(ctx, dbID1, ts)
+	require.NoError(t, gotErr)
+	require.Equal(t, 0, len(res))
+}
+
+func TestTableCatalog_CreateDatabase(t *testing.T) {
+	// expectation
+	databaseDbMock.On("Insert", &dbmodel.Database{TenantID: tenantID, DbID: dbID1, DbName: "db1", Ts: ts}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.CreateDatabase(ctx, &model.Database{ID: dbID1, Name: "db1"}, ts)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_DropDatabase(t *testing.T) {
+	// expectation
+	databaseDbMock.On("Insert", &dbmodel.Database{TenantID: tenantID, DbID: dbID1, Ts: ts, IsDeleted: true}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.DropDatabase(ctx, dbID1, ts)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_ListDatabases(t *testing.T) {
+	pairs := []*dbmodel.Database{{DbID: dbID1, Ts: ts}}
+
+	// expectation
+	databaseDbMock.On("ListDbIDTs", tenantID, ts).Return(pairs, nil).Once()
+	databaseDbMock.On("List", tenantID, pairs).Return([]*dbmodel.Database{{DbID: dbID1, DbName: "db1", Ts: ts}}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListDatabases(ctx, ts)
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, dbID1, res[0].ID)
+	require.Equal(t, "db1", res[0].Name)
+	require.Equal(t, ts, res[0].CreatedTime)
+}
+
+func TestTableCatalog_ListDatabases_Error(t *testing.T) {
+	// expectation
+	errTest := errors.New("test error")
+	databaseDbMock.On("ListDbIDTs", tenantID, ts).Return(nil, errTest).Once()
+
+	// actual
+	_, gotErr := mockCatalog.ListDatabases(ctx, ts)
+	require.Error(t, gotErr)
 }
 
 func TestTableCatalog_CollectionExists(t *testing.T) {
@@ -791,6 +862,30 @@ func TestCatalog_AlterCollection(t *testing.T) {
 	require.NoError(t, gotErr)
 }
 
+func TestCatalog_AlterCollection_Rename(t *testing.T) {
+	coll := &model.Collection{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		Name:         collName1,
+		State:        pb.CollectionState_CollectionCreated,
+	}
+	newColl := coll.Clone()
+	newColl.Name = "new_name"
+
+	collDbMock.On("RenameCollection", tenantID, collID1, "new_name").Return(nil).Once()
+	collDbMock.On("Update", mock.Anything).Return(nil).Once()
+
+	gotErr := mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
+	require.NoError(t, gotErr)
+
+	// rename failed
+	errTest := errors.New("test error")
+	collDbMock.On("RenameCollection", tenantID, collID1, "new_name").Return(errTest).Once()
+
+	gotErr = mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
+	require.Error(t, gotErr)
+}
+
 func TestTableCatalog_AlterCollection_TsNot0_AlterTypeError(t *testing.T) {
 	coll := &model.Collection{
 		TenantID:     tenantID,
@@ -964,32 +1059,32 @@ func TestTableCatalog_CreateAlias_InsertAliasError(t *testing.T) {
 
 func TestTableCatalog_DropAlias_TsNot0(t *testing.T) {
 	// expectation
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(collID1, nil).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, dbID1, collAlias1, ts).Return(collID1, nil).Once()
 	aliasDbMock.On("Insert", mock.Anything).Return(nil).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, dbID1, collAlias1, ts)
 	require.NoError(t, gotErr)
 }
 
 func TestTableCatalog_DropAlias_TsNot0_SelectCollectionIDByAliasError(t *testing.T) {
 	// expectation
 	errTest := errors.New("test error")
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(typeutil.UniqueID(0), errTest).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, dbID1, collAlias1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, dbID1, collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
 func TestTableCatalog_DropAlias_TsNot0_InsertIndexError(t *testing.T) {
 	// expectation
 	errTest := errors.New("test error")
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(collID1, nil).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, dbID1, collAlias1, ts).Return(collID1, nil).Once()
 	aliasDbMock.On("Insert", mock.Anything).Return(errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, dbID1, collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
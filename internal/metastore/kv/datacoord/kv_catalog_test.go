@@ -495,6 +495,34 @@ func Test_AlterSegmentsAndAddNewSegment(t *testing.T) {
 	})
 }
 
+func Test_AlterSegmentsAndAddNewSegments(t *testing.T) {
+	txn := &MockedTxnKV{}
+	savedKvs := make(map[string]string, 0)
+	txn.multiSave = func(kvs map[string]string) error {
+		maps.Copy(savedKvs, kvs)
+		return nil
+	}
+	txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+		return []string{}, []string{}, nil
+	}
+
+	emptySegment := &datapb.SegmentInfo{
+		ID:           100,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		State:        commonpb.SegmentState_Flushed,
+	}
+
+	catalog := &Catalog{txn, "a"}
+	err := catalog.AlterSegmentsAndAddNewSegments(context.TODO(), []*datapb.SegmentInfo{droppedSegment}, []*datapb.SegmentInfo{segment1, emptySegment})
+	assert.NoError(t, err)
+	verifySavedKvsForDroppedSegment(t, savedKvs)
+	verifySavedKvsForSegment(t, savedKvs)
+	// the empty segment is saved as a fake flushed segment
+	_, ok := savedKvs[buildFlushedSegmentPath(collectionID, partitionID, emptySegment.GetID())]
+	assert.True(t, ok)
+}
+
 func Test_DropSegment(t *testing.T) {
 	t.Run("remove failed", func(t *testing.T) {
 		txn := &MockedTxnKV{}
@@ -622,6 +650,19 @@ func TestCatalog_RevertAlterSegmentsAndAddNewSegment(t *testing.T) {
 		err := catalog.RevertAlterSegmentsAndAddNewSegment(context.TODO(), []*datapb.SegmentInfo{segment1}, droppedSegment)
 		assert.NoError(t, err)
 	})
+
+	t.Run("revert more than one segment", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		var removals []string
+		txn.EXPECT().MultiSaveAndRemove(mock.Anything, mock.Anything).Run(func(saves map[string]string, removes []string) {
+			removals = removes
+		}).Return(nil)
+		catalog := &Catalog{txn, ""}
+		err := catalog.RevertAlterSegmentsAndAddNewSegments(context.TODO(), []*datapb.SegmentInfo{droppedSegment}, []*datapb.SegmentInfo{segment1, droppedSegment})
+		assert.NoError(t, err)
+		assert.Contains(t, removals, buildSegmentPath(collectionID, partitionID, segment1.GetID()))
+		assert.Contains(t, removals, buildSegmentPath(collectionID, partitionID, droppedSegment.GetID()))
+	})
 }
 
 func TestChannelCP(t *testing.T) {
@@ -35,6 +35,8 @@ const (
 // prefix/partitions/collection_id/partition_id		-> PartitionInfo
 // prefix/aliases/alias_name						-> AliasInfo
 // prefix/fields/collection_id/field_id				-> FieldSchema
+// prefix/database/db-info/db_id					-> DatabaseInfo
+// prefix/database/alias/db_id/alias_name			-> AliasInfo
 type Catalog struct {
 	Txn      kv.TxnKV
 	Snapshot kv.SnapShotKV
@@ -68,6 +70,19 @@ func BuildAliasKey(aliasName string) string {
 	return fmt.Sprintf("%s/%s", AliasMetaPrefix, aliasName)
 }
 
+// BuildAliasKeyWithDB returns the key of the alias in the database, aliases in the default
+// database are kept under the same key as before databases were introduced.
+func BuildAliasKeyWithDB(dbID int64, aliasName string) string {
+	if dbID == util.DefaultDBID {
+		return BuildAliasKey(aliasName)
+	}
+	return fmt.Sprintf("%s/%d/%s", DBAliasMetaPrefix, dbID, aliasName)
+}
+
+func BuildDatabaseKey(dbID int64) string {
+	return fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbID)
+}
+
 func batchMultiSaveAndRemoveWithPrefix(snapshot kv.SnapShotKV, maxTxnNum int, saves map[string]string, removals []string, ts typeutil.Timestamp) error {
 	saveFn := func(partialKvs map[string]string) error {
 		return snapshot.MultiSave(partialKvs, ts)
@@ -82,6 +97,40 @@ func batchMultiSaveAndRemoveWithPrefix(snapshot kv.SnapShotKV, maxTxnNum int, sa
 	return etcd.RemoveByBatch(removals, removeFn)
 }
 
+func (kc *Catalog) CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error {
+	k := BuildDatabaseKey(db.ID)
+	dbInfo := model.MarshalDatabaseModel(db)
+	v, err := proto.Marshal(dbInfo)
+	if err != nil {
+		log.Error("create database marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return kc.Snapshot.Save(k, string(v), ts)
+}
+
+func (kc *Catalog) DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error {
+	k := BuildDatabaseKey(dbID)
+	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k}, ts)
+}
+
+func (kc *Catalog) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
+	_, vals, err := kc.Snapshot.LoadWithPrefix(DatabaseMetaPrefix, ts)
+	if err != nil {
+		log.Error("list databases fail", zap.String("prefix", DatabaseMetaPrefix), zap.Uint64("ts", ts), zap.Error(err))
+		return nil, err
+	}
+
+	dbs := make([]*model.Database, 0, len(vals))
+	for _, val := range vals {
+		dbInfo := &pb.DatabaseInfo{}
+		if err := proto.Unmarshal([]byte(val), dbInfo); err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, model.UnmarshalDatabaseModel(dbInfo))
+	}
+	return dbs, nil
+}
+
 func (kc *Catalog) CreateCollection(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("cannot create collection with state: %s, collection: %s", coll.State.String(), coll.Name)
@@ -203,7 +252,7 @@ func (kc *Catalog) CreatePartition(ctx context.Context, partition *model.Partiti
 
 func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
 	oldKBefore210 := BuildAliasKey210(alias.Name)
-	k := BuildAliasKey(alias.Name)
+	k := BuildAliasKeyWithDB(alias.DBID, alias.Name)
 	aliasInfo := model.MarshalAliasModel(alias)
 	v, err := proto.Marshal(aliasInfo)
 	if err != nil {
@@ -334,6 +383,7 @@ func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Col
 	for _, alias := range collectionInfo.Aliases {
 		delMetakeysSnap = append(delMetakeysSnap,
 			BuildAliasKey210(alias),
+			BuildAliasKeyWithDB(collectionInfo.DBID, alias),
 		)
 	}
 	// Snapshot will list all (k, v) pairs and then use Txn.MultiSave to save tombstone for these keys when it prepares
@@ -465,13 +515,16 @@ func (kc *Catalog) DropCredential(ctx context.Context, username string) error {
 	return nil
 }
 
-func (kc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
+func (kc *Catalog) DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error {
+	k := BuildAliasKeyWithDB(dbID, alias)
+	if dbID != util.DefaultDBID {
+		return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k}, ts)
+	}
 	oldKBefore210 := BuildAliasKey210(alias)
-	k := BuildAliasKey(alias)
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k, oldKBefore210}, ts)
 }
 
-func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (kc *Catalog) GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Warn("get collection meta fail", zap.String("collectionName", collectionName), zap.Error(err))
@@ -485,7 +538,7 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 			log.Warn("get collection meta unmarshal fail", zap.String("collectionName", collectionName), zap.Error(err))
 			continue
 		}
-		if colMeta.Schema.Name == collectionName {
+		if colMeta.GetDbId() == dbID && colMeta.Schema.Name == collectionName {
 			// compatibility handled by kc.GetCollectionByID.
 			return kc.GetCollectionByID(ctx, colMeta.GetID(), ts)
 		}
@@ -494,7 +547,7 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 	return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s, at timestamp = %d", collectionName, ts))
 }
 
-func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (kc *Catalog) ListCollections(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Error("get collections meta fail",
@@ -504,7 +557,7 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, nil
 	}
 
-	colls := make(map[string]*model.Collection)
+	colls := make([]*model.Collection, 0, len(vals))
 	for _, val := range vals {
 		collMeta := pb.CollectionInfo{}
 		err := proto.Unmarshal([]byte(val), &collMeta)
@@ -512,11 +565,14 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 			log.Warn("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
+		if collMeta.GetDbId() != dbID {
+			continue
+		}
 		collection, err := kc.GetCollectionByID(ctx, collMeta.GetID(), ts)
 		if err != nil {
 			return nil, err
 		}
-		colls[collMeta.Schema.Name] = collection
+		colls = append(colls, collection)
 	}
 
 	return colls, nil
@@ -549,6 +605,11 @@ func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestam
 	if err != nil {
 		return nil, err
 	}
+	_, dbValues, err := kc.Snapshot.LoadWithPrefix(DBAliasMetaPrefix, ts)
+	if err != nil {
+		return nil, err
+	}
+	values = append(values, dbValues...)
 	// aliases after 210 stored by AliasInfo.
 	aliases := make([]*model.Alias, 0, len(values))
 	for _, value := range values {
@@ -559,6 +620,7 @@ func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestam
 		}
 		aliases = append(aliases, &model.Alias{
 			Name:         info.GetAliasName(),
+			DBID:         info.GetDbId(),
 			CollectionID: info.GetCollectionId(),
 			CreatedTime:  info.GetCreatedTime(),
 		})
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -445,6 +446,113 @@ func TestCatalog_listFieldsAfter210(t *testing.T) {
 	})
 }
 
+func TestCatalog_Database(t *testing.T) {
+	ctx := context.Background()
+	db := &model.Database{ID: 1, Name: "db1", CreatedTime: 100}
+
+	t.Run("create and list", func(t *testing.T) {
+		saved := make(map[string]string)
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+			saved[key] = value
+			return nil
+		}
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			assert.Equal(t, DatabaseMetaPrefix, key)
+			keys := make([]string, 0, len(saved))
+			values := make([]string, 0, len(saved))
+			for k, v := range saved {
+				keys = append(keys, k)
+				values = append(values, v)
+			}
+			return keys, values, nil
+		}
+		kc := Catalog{Snapshot: snapshot}
+
+		err := kc.CreateDatabase(ctx, db, 100)
+		assert.NoError(t, err)
+		_, ok := saved[BuildDatabaseKey(1)]
+		assert.True(t, ok)
+
+		dbs, err := kc.ListDatabases(ctx, typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(dbs))
+		assert.True(t, db.Equal(*dbs[0]))
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return []string{"key"}, []string{"not in pb format"}, nil
+		}
+		kc := Catalog{Snapshot: snapshot}
+		_, err := kc.ListDatabases(ctx, typeutil.MaxTimestamp)
+		assert.Error(t, err)
+
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, errors.New("mock")
+		}
+		_, err = kc.ListDatabases(ctx, typeutil.MaxTimestamp)
+		assert.Error(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+			assert.ElementsMatch(t, []string{BuildDatabaseKey(1)}, removals)
+			return nil
+		}
+		kc := Catalog{Snapshot: snapshot}
+		err := kc.DropDatabase(ctx, 1, 100)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_CollectionsInDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	coll1 := &pb.CollectionInfo{ID: 1, Schema: &schemapb.CollectionSchema{Name: "coll"}}
+	coll2 := &pb.CollectionInfo{ID: 2, DbId: 10, Schema: &schemapb.CollectionSchema{Name: "coll"}}
+	values := make(map[int64]string)
+	for _, coll := range []*pb.CollectionInfo{coll1, coll2} {
+		value, err := proto.Marshal(coll)
+		assert.NoError(t, err)
+		values[coll.GetID()] = string(value)
+	}
+
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+		if key == CollectionMetaPrefix {
+			return []string{"key1", "key2"}, []string{values[1], values[2]}, nil
+		}
+		return nil, nil, nil
+	}
+	snapshot.LoadFunc = func(key string, ts typeutil.Timestamp) (string, error) {
+		if key == BuildCollectionKey(2) {
+			return values[2], nil
+		}
+		return values[1], nil
+	}
+	kc := Catalog{Snapshot: snapshot}
+
+	got, err := kc.GetCollectionByName(ctx, 10, "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got.CollectionID)
+	assert.Equal(t, int64(10), got.DBID)
+
+	got, err = kc.GetCollectionByName(ctx, util.DefaultDBID, "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), got.CollectionID)
+
+	_, err = kc.GetCollectionByName(ctx, 11, "coll", typeutil.MaxTimestamp)
+	assert.Error(t, err)
+
+	colls, err := kc.ListCollections(ctx, 10, typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(colls))
+	assert.Equal(t, int64(2), colls[0].CollectionID)
+}
+
 func TestCatalog_AlterAliasV2(t *testing.T) {
 	ctx := context.Background()
 
@@ -578,14 +686,21 @@ func TestCatalog_DropAliasV2(t *testing.T) {
 
 	kc := Catalog{Snapshot: snapshot}
 
-	err := kc.DropAlias(ctx, "alias", 0)
+	err := kc.DropAlias(ctx, util.DefaultDBID, "alias", 0)
 	assert.Error(t, err)
 
+	var removed []string
 	snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		removed = removals
 		return nil
 	}
-	err = kc.DropAlias(ctx, "alias", 0)
+	err = kc.DropAlias(ctx, util.DefaultDBID, "alias", 0)
 	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{BuildAliasKey("alias"), BuildAliasKey210("alias")}, removed)
+
+	err = kc.DropAlias(ctx, 1, "alias", 0)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{BuildAliasKeyWithDB(1, "alias")}, removed)
 }
 
 func TestCatalog_listAliasesBefore210(t *testing.T) {
@@ -674,8 +789,15 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 		value, err := proto.Marshal(coll)
 		assert.NoError(t, err)
 
+		dbAlias := &pb.AliasInfo{CollectionId: 101, DbId: 1}
+		dbValue, err := proto.Marshal(dbAlias)
+		assert.NoError(t, err)
+
 		snapshot := kv.NewMockSnapshotKV()
 		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			if key == DBAliasMetaPrefix {
+				return []string{"key1"}, []string{string(dbValue)}, nil
+			}
 			return []string{"key"}, []string{string(value)}, nil
 		}
 
@@ -683,8 +805,11 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 
 		got, err := kc.listAliasesAfter210(ctx, 0)
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(got))
+		assert.Equal(t, 2, len(got))
 		assert.Equal(t, int64(100), got[0].CollectionID)
+		assert.Equal(t, util.DefaultDBID, got[0].DBID)
+		assert.Equal(t, int64(101), got[1].CollectionID)
+		assert.Equal(t, int64(1), got[1].DBID)
 	})
 }
 
@@ -740,6 +865,9 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 			if key == AliasMetaPrefix {
 				return []string{"key1"}, []string{string(value2)}, nil
 			}
+			if key == DBAliasMetaPrefix {
+				return nil, nil, nil
+			}
 			return []string{"key"}, []string{string(value)}, nil
 		}
 
@@ -830,6 +958,30 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, pb.CollectionState_CollectionCreated, got.State)
 	})
 
+	t.Run("modify, rename", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+			kvs[key] = value
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		var collectionID int64 = 1
+		oldC := &model.Collection{CollectionID: collectionID, DBID: 2, Name: "old", State: pb.CollectionState_CollectionCreated}
+		newC := &model.Collection{CollectionID: collectionID, DBID: 2, Name: "new", State: pb.CollectionState_CollectionCreated}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		value, ok := kvs[BuildCollectionKey(collectionID)]
+		assert.True(t, ok)
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(value), &collPb)
+		assert.NoError(t, err)
+		got := model.UnmarshalCollectionModel(&collPb)
+		assert.Equal(t, "new", got.Name)
+		assert.Equal(t, int64(2), got.DBID)
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
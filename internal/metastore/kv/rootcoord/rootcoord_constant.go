@@ -14,6 +14,13 @@ const (
 	// CollectionAliasMetaPrefix210 prefix for collection alias meta
 	CollectionAliasMetaPrefix210 = ComponentPrefix + "/collection-alias"
 
+	// DatabasePrefix prefix for database related meta
+	DatabasePrefix = ComponentPrefix + "/database"
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = DatabasePrefix + "/db-info"
+	// DBAliasMetaPrefix prefix for the aliases of the collections not in the default database
+	DBAliasMetaPrefix = DatabasePrefix + "/alias"
+
 	SnapshotsSep   = "_ts"
 	SnapshotPrefix = "snapshots"
 
@@ -4,6 +4,7 @@ import pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 
 type Alias struct {
 	Name         string
+	DBID         int64
 	CollectionID int64
 	CreatedTime  uint64
 	State        pb.AliasState
@@ -16,6 +17,7 @@ func (a Alias) Available() bool {
 func (a Alias) Clone() *Alias {
 	return &Alias{
 		Name:         a.Name,
+		DBID:         a.DBID,
 		CollectionID: a.CollectionID,
 		CreatedTime:  a.CreatedTime,
 		State:        a.State,
@@ -24,12 +26,14 @@ func (a Alias) Clone() *Alias {
 
 func (a Alias) Equal(other Alias) bool {
 	return a.Name == other.Name &&
+		a.DBID == other.DBID &&
 		a.CollectionID == other.CollectionID
 }
 
 func MarshalAliasModel(alias *Alias) *pb.AliasInfo {
 	return &pb.AliasInfo{
 		AliasName:    alias.Name,
+		DbId:         alias.DBID,
 		CollectionId: alias.CollectionID,
 		CreatedTime:  alias.CreatedTime,
 		State:        alias.State,
@@ -39,6 +43,7 @@ func MarshalAliasModel(alias *Alias) *pb.AliasInfo {
 func UnmarshalAliasModel(info *pb.AliasInfo) *Alias {
 	return &Alias{
 		Name:         info.GetAliasName(),
+		DBID:         info.GetDbId(),
 		CollectionID: info.GetCollectionId(),
 		CreatedTime:  info.GetCreatedTime(),
 		State:        info.GetState(),
@@ -10,6 +10,7 @@ import (
 
 type Collection struct {
 	TenantID             string
+	DBID                 int64
 	CollectionID         int64
 	Partitions           []*Partition
 	Name                 string
@@ -34,6 +35,7 @@ func (c Collection) Available() bool {
 func (c Collection) Clone() *Collection {
 	return &Collection{
 		TenantID:             c.TenantID,
+		DBID:                 c.DBID,
 		CollectionID:         c.CollectionID,
 		Name:                 c.Name,
 		Description:          c.Description,
@@ -61,6 +63,7 @@ func (c Collection) GetPartitionNum(filterUnavailable bool) int {
 
 func (c Collection) Equal(other Collection) bool {
 	return c.TenantID == other.TenantID &&
+		c.DBID == other.DBID &&
 		CheckPartitionsEqual(c.Partitions, other.Partitions) &&
 		c.Name == other.Name &&
 		c.Description == other.Description &&
@@ -86,6 +89,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 	}
 
 	return &Collection{
+		DBID:                 coll.DbId,
 		CollectionID:         coll.ID,
 		Name:                 coll.Schema.Name,
 		Description:          coll.Schema.Description,
@@ -149,6 +153,7 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 	}
 
 	collectionPb := &pb.CollectionInfo{
+		DbId:                 coll.DBID,
 		ID:                   coll.CollectionID,
 		Schema:               collSchema,
 		CreateTime:           coll.CreateTime,
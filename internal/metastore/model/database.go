@@ -0,0 +1,77 @@
+package model
+
+import (
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+type Database struct {
+	TenantID    string
+	ID          int64
+	Name        string
+	State       pb.DatabaseState
+	CreatedTime uint64
+}
+
+// NewDefaultDatabase returns the default database, it always exists and is never persisted.
+func NewDefaultDatabase() *Database {
+	return &Database{
+		ID:    util.DefaultDBID,
+		Name:  util.DefaultDBName,
+		State: pb.DatabaseState_DatabaseCreated,
+	}
+}
+
+func (d Database) Available() bool {
+	return d.State == pb.DatabaseState_DatabaseCreated
+}
+
+func (d Database) IsDefault() bool {
+	return d.ID == util.DefaultDBID
+}
+
+func (d Database) Clone() *Database {
+	return &Database{
+		TenantID:    d.TenantID,
+		ID:          d.ID,
+		Name:        d.Name,
+		State:       d.State,
+		CreatedTime: d.CreatedTime,
+	}
+}
+
+func (d Database) Equal(other Database) bool {
+	return d.TenantID == other.TenantID &&
+		d.ID == other.ID &&
+		d.Name == other.Name &&
+		d.State == other.State &&
+		d.CreatedTime == other.CreatedTime
+}
+
+func MarshalDatabaseModel(db *Database) *pb.DatabaseInfo {
+	if db == nil {
+		return nil
+	}
+
+	return &pb.DatabaseInfo{
+		TenantId:    db.TenantID,
+		Id:          db.ID,
+		Name:        db.Name,
+		State:       db.State,
+		CreatedTime: db.CreatedTime,
+	}
+}
+
+func UnmarshalDatabaseModel(info *pb.DatabaseInfo) *Database {
+	if info == nil {
+		return nil
+	}
+
+	return &Database{
+		TenantID:    info.GetTenantId(),
+		ID:          info.GetId(),
+		Name:        info.GetName(),
+		State:       info.GetState(),
+		CreatedTime: info.GetCreatedTime(),
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	dbModel = &Database{
+		TenantID:    "tenant",
+		ID:          100,
+		Name:        "db1",
+		State:       etcdpb.DatabaseState_DatabaseCreated,
+		CreatedTime: 10000,
+	}
+
+	dbPb = &etcdpb.DatabaseInfo{
+		TenantId:    "tenant",
+		Id:          100,
+		Name:        "db1",
+		State:       etcdpb.DatabaseState_DatabaseCreated,
+		CreatedTime: 10000,
+	}
+)
+
+func TestMarshalDatabaseModel(t *testing.T) {
+	ret := MarshalDatabaseModel(dbModel)
+	assert.Equal(t, dbPb, ret)
+	assert.Nil(t, MarshalDatabaseModel(nil))
+}
+
+func TestUnmarshalDatabaseModel(t *testing.T) {
+	ret := UnmarshalDatabaseModel(dbPb)
+	assert.Equal(t, dbModel, ret)
+	assert.Nil(t, UnmarshalDatabaseModel(nil))
+}
+
+func TestDatabase_Available(t *testing.T) {
+	tests := []struct {
+		state etcdpb.DatabaseState
+		want  bool
+	}{
+		{state: etcdpb.DatabaseState_DatabaseCreated, want: true},
+		{state: etcdpb.DatabaseState_DatabaseCreating, want: false},
+		{state: etcdpb.DatabaseState_DatabaseDropping, want: false},
+		{state: etcdpb.DatabaseState_DatabaseDropped, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state.String(), func(t *testing.T) {
+			db := Database{State: tt.state}
+			assert.Equal(t, tt.want, db.Available())
+		})
+	}
+}
+
+func TestDatabase_Clone(t *testing.T) {
+	clone := dbModel.Clone()
+	assert.True(t, clone.Equal(*dbModel))
+	clone.Name = "db2"
+	assert.False(t, clone.Equal(*dbModel))
+}
+
+func TestNewDefaultDatabase(t *testing.T) {
+	db := NewDefaultDatabase()
+	assert.True(t, db.IsDefault())
+	assert.True(t, db.Available())
+	assert.Equal(t, util.DefaultDBName, db.Name)
+	assert.False(t, dbModel.IsDefault())
+}
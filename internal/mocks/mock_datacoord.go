@@ -264,6 +264,53 @@ func (_c *DataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChann
 	return _c
 }
 
+// ExportSegment provides a mock function with given fields: ctx, req
+func (_m *DataCoord) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.ExportSegmentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportSegmentRequest) *datapb.ExportSegmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ExportSegmentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportSegmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_ExportSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSegment'
+type DataCoord_ExportSegment_Call struct {
+	*mock.Call
+}
+
+// ExportSegment is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportSegmentRequest
+func (_e *DataCoord_Expecter) ExportSegment(ctx interface{}, req interface{}) *DataCoord_ExportSegment_Call {
+	return &DataCoord_ExportSegment_Call{Call: _e.mock.On("ExportSegment", ctx, req)}
+}
+
+func (_c *DataCoord_ExportSegment_Call) Run(run func(ctx context.Context, req *datapb.ExportSegmentRequest)) *DataCoord_ExportSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportSegmentRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_ExportSegment_Call) Return(_a0 *datapb.ExportSegmentResponse, _a1 error) *DataCoord_ExportSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, req
 func (_m *DataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(ctx, req)
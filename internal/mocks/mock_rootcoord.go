@@ -407,6 +407,53 @@ func (_c *RootCoord_CreateCredential_Call) Return(_a0 *commonpb.Status, _a1 erro
 	return _c
 }
 
+// CreateDatabase provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CreateDatabase(ctx context.Context, req *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateDatabaseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatabase'
+type RootCoord_CreateDatabase_Call struct {
+	*mock.Call
+}
+
+// CreateDatabase is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.CreateDatabaseRequest
+func (_e *RootCoord_Expecter) CreateDatabase(ctx interface{}, req interface{}) *RootCoord_CreateDatabase_Call {
+	return &RootCoord_CreateDatabase_Call{Call: _e.mock.On("CreateDatabase", ctx, req)}
+}
+
+func (_c *RootCoord_CreateDatabase_Call) Run(run func(ctx context.Context, req *proxypb.CreateDatabaseRequest)) *RootCoord_CreateDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CreateDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreatePartition provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -689,6 +736,53 @@ func (_c *RootCoord_DropCollection_Call) Return(_a0 *commonpb.Status, _a1 error)
 	return _c
 }
 
+// DropDatabase provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DropDatabase(ctx context.Context, req *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropDatabaseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabase'
+type RootCoord_DropDatabase_Call struct {
+	*mock.Call
+}
+
+// DropDatabase is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.DropDatabaseRequest
+func (_e *RootCoord_Expecter) DropDatabase(ctx interface{}, req interface{}) *RootCoord_DropDatabase_Call {
+	return &RootCoord_DropDatabase_Call{Call: _e.mock.On("DropDatabase", ctx, req)}
+}
+
+func (_c *RootCoord_DropDatabase_Call) Run(run func(ctx context.Context, req *proxypb.DropDatabaseRequest)) *RootCoord_DropDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DropDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropPartition provides a mock function with given fields: ctx, req
 func (_m *RootCoord) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -783,6 +877,53 @@ func (_c *RootCoord_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *Root
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *RootCoord) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.ExportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ExportRequest) *proxypb.ExportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ExportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type RootCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.ExportRequest
+func (_e *RootCoord_Expecter) Export(ctx interface{}, req interface{}) *RootCoord_Export_Call {
+	return &RootCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *RootCoord_Export_Call) Run(run func(ctx context.Context, req *proxypb.ExportRequest)) *RootCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_Export_Call) Return(_a0 *proxypb.ExportResponse, _a1 error) *RootCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *RootCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -876,6 +1017,53 @@ func (_c *RootCoord_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialRes
 	return _c
 }
 
+// GetExportState provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.GetExportStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetExportStateRequest) *proxypb.GetExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.GetExportStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.GetExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportState'
+type RootCoord_GetExportState_Call struct {
+	*mock.Call
+}
+
+// GetExportState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.GetExportStateRequest
+func (_e *RootCoord_Expecter) GetExportState(ctx interface{}, req interface{}) *RootCoord_GetExportState_Call {
+	return &RootCoord_GetExportState_Call{Call: _e.mock.On("GetExportState", ctx, req)}
+}
+
+func (_c *RootCoord_GetExportState_Call) Run(run func(ctx context.Context, req *proxypb.GetExportStateRequest)) *RootCoord_GetExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.GetExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetExportState_Call) Return(_a0 *proxypb.GetExportStateResponse, _a1 error) *RootCoord_GetExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetImportState provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1333,6 +1521,53 @@ func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersRespon
 	return _c
 }
 
+// ListDatabases provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListDatabases(ctx context.Context, req *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.ListDatabasesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListDatabasesRequest) *proxypb.ListDatabasesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListDatabasesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListDatabasesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDatabases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabases'
+type RootCoord_ListDatabases_Call struct {
+	*mock.Call
+}
+
+// ListDatabases is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.ListDatabasesRequest
+func (_e *RootCoord_Expecter) ListDatabases(ctx interface{}, req interface{}) *RootCoord_ListDatabases_Call {
+	return &RootCoord_ListDatabases_Call{Call: _e.mock.On("ListDatabases", ctx, req)}
+}
+
+func (_c *RootCoord_ListDatabases_Call) Run(run func(ctx context.Context, req *proxypb.ListDatabasesRequest)) *RootCoord_ListDatabases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListDatabasesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDatabases_Call) Return(_a0 *proxypb.ListDatabasesResponse, _a1 error) *RootCoord_ListDatabases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1557,6 +1792,100 @@ func (_c *RootCoord_Register_Call) Return(_a0 error) *RootCoord_Register_Call {
 	return _c
 }
 
+// RenameCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RenameCollection(ctx context.Context, req *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RenameCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RenameCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
+type RootCoord_RenameCollection_Call struct {
+	*mock.Call
+}
+
+// RenameCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.RenameCollectionRequest
+func (_e *RootCoord_Expecter) RenameCollection(ctx interface{}, req interface{}) *RootCoord_RenameCollection_Call {
+	return &RootCoord_RenameCollection_Call{Call: _e.mock.On("RenameCollection", ctx, req)}
+}
+
+func (_c *RootCoord_RenameCollection_Call) Run(run func(ctx context.Context, req *proxypb.RenameCollectionRequest)) *RootCoord_RenameCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RenameCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RenameCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RenameCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ReportExport provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ExportSegmentResult) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ExportSegmentResult) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ReportExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExport'
+type RootCoord_ReportExport_Call struct {
+	*mock.Call
+}
+
+// ReportExport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ExportSegmentResult
+func (_e *RootCoord_Expecter) ReportExport(ctx interface{}, req interface{}) *RootCoord_ReportExport_Call {
+	return &RootCoord_ReportExport_Call{Call: _e.mock.On("ReportExport", ctx, req)}
+}
+
+func (_c *RootCoord_ReportExport_Call) Run(run func(ctx context.Context, req *rootcoordpb.ExportSegmentResult)) *RootCoord_ReportExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ExportSegmentResult))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ReportExport_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_ReportExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ReportImport provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
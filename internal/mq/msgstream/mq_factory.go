@@ -26,6 +26,7 @@ import (
 	rmqimplserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	natswrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/nats"
 	pulsarmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
 	rmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -258,3 +259,45 @@ func NewKmsFactory(config *paramtable.KafkaConfig) Factory {
 	}
 	return f
 }
+
+type NmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	config            *paramtable.NatsConfig
+	ReceiveBufSize    int64
+}
+
+func (f *NmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := natswrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *NmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := natswrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *NmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+func (f *NmsFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subname string) error {
+		// nats consumers are ephemeral, nothing to clean up on the server side
+		return nil
+	}
+}
+
+func NewNmsFactory(config *paramtable.NatsConfig) Factory {
+	f := &NmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    1024,
+		config:            config,
+	}
+	return f
+}
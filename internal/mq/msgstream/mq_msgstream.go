@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -35,7 +36,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	"github.com/opentracing/opentracing-go"
 )
 
 var _ MsgStream = (*mqMsgStream)(nil)
@@ -272,7 +272,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 
 			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
-			trace.InjectContextToMsgProperties(sp.Context(), msg.Properties)
+			trace.InjectContextToMsgProperties(spanCtx, msg.Properties)
 
 			ms.producerLock.Lock()
 			if _, err := ms.producers[channel].Send(
@@ -281,10 +281,10 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 			); err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return err
 			}
-			sp.Finish()
+			sp.End()
 			ms.producerLock.Unlock()
 		}
 	}
@@ -313,7 +313,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, erro
 
 		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
-		trace.InjectContextToMsgProperties(sp.Context(), msg.Properties)
+		trace.InjectContextToMsgProperties(spanCtx, msg.Properties)
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
@@ -321,13 +321,13 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, erro
 			if err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return ids, err
 			}
 			ids[channel] = append(ids[channel], id)
 		}
 		ms.producerLock.Unlock()
-		sp.Finish()
+		sp.End()
 	}
 	return ids, nil
 }
@@ -393,7 +393,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 
 			sp, ok := ExtractFromMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				tsMsg.SetTraceCtx(oteltrace.ContextWithSpan(context.Background(), sp))
 			}
 
 			msgPack := MsgPack{
@@ -407,7 +407,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				return
 			}
 
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
@@ -696,7 +696,7 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 
 			sp, ok := ExtractFromMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				tsMsg.SetTraceCtx(oteltrace.ContextWithSpan(context.Background(), sp))
 			}
 
 			ms.chanMsgBufMutex.Lock()
@@ -707,10 +707,10 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				ms.chanTtMsgTimeMutex.Lock()
 				ms.chanTtMsgTime[consumer] = tsMsg.(*TimeTickMsg).Base.Timestamp
 				ms.chanTtMsgTimeMutex.Unlock()
-				sp.Finish()
+				sp.End()
 				return
 			}
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
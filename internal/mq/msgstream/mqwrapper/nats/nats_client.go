@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// natsClient implements Client on NATS JetStream, each topic is persisted by a stream with the same name
+// which captures the subject of the topic.
+type natsClient struct {
+	conn      *nats.Conn
+	js        nats.JetStreamContext
+	retention time.Duration
+}
+
+// Check natsClient implements Client interface
+var _ mqwrapper.Client = &natsClient{}
+
+// NewClient connects to the nats server of address, retention is the max age of the messages kept in a stream
+func NewClient(address string, retention time.Duration) (*natsClient, error) {
+	conn, err := nats.Connect(address, nats.MaxReconnects(-1))
+	if err != nil {
+		log.Error("failed to connect nats server", zap.String("address", address), zap.Error(err))
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &natsClient{conn: conn, js: js, retention: retention}, nil
+}
+
+// NewClientWithConfig creates a nats client from the nats configs
+func NewClientWithConfig(config *paramtable.NatsConfig) (*natsClient, error) {
+	retention := time.Duration(config.RetentionTimeInMinutes.GetAsInt64()) * time.Minute
+	return NewClient(config.Address.GetValue(), retention)
+}
+
+// ensureStream creates the stream of topic if it does not exist
+func (nc *natsClient) ensureStream(topic string) error {
+	_, err := nc.js.StreamInfo(topic)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+
+	_, err = nc.js.AddStream(&nats.StreamConfig{
+		Name:      topic,
+		Subjects:  []string{topic},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+		MaxAge:    nc.retention,
+	})
+	if err != nil {
+		log.Error("failed to create nats stream", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// CreateProducer creates a producer instance
+func (nc *natsClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return &natsProducer{js: nc.js, topic: options.Topic}, nil
+}
+
+// Subscribe creates a consumer instance and subscribe a topic
+func (nc *natsClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return newNatsConsumer(nc.js, options)
+}
+
+// EarliestMessageID returns the id of the first message of a stream
+func (nc *natsClient) EarliestMessageID() mqwrapper.MessageID {
+	return &natsID{messageID: 1}
+}
+
+// StringToMsgID converts string id to MessageID
+func (nc *natsClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: seq}, nil
+}
+
+// BytesToMsgID converts a byte array to messageID
+func (nc *natsClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	return &natsID{messageID: DeserializeNatsID(id)}, nil
+}
+
+// Close closes the connection to the nats server
+func (nc *natsClient) Close() {
+	nc.conn.Close()
+}
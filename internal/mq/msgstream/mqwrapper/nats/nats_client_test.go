@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/assert"
+)
+
+var natsAddress string
+
+func TestMain(m *testing.M) {
+	storeDir, err := os.MkdirTemp("", "nats-test")
+	if err != nil {
+		fmt.Printf("Failed to create JetStream store dir: %s\n", err)
+		os.Exit(1)
+	}
+	defer os.RemoveAll(storeDir)
+
+	// an embedded nats server with JetStream enabled, listening on a random port
+	opts := &server.Options{Host: "127.0.0.1", Port: server.RANDOM_PORT, JetStream: true, StoreDir: storeDir, NoSigs: true}
+	ns, err := server.NewServer(opts)
+	if err != nil {
+		fmt.Printf("Failed to create nats server: %s\n", err)
+		os.Exit(1)
+	}
+	go ns.Start()
+	if !ns.ReadyForConnections(10 * time.Second) {
+		fmt.Println("nats server is not ready")
+		os.Exit(1)
+	}
+	natsAddress = ns.ClientURL()
+
+	exitCode := m.Run()
+	ns.Shutdown()
+	os.Exit(exitCode)
+}
+
+func newTestClient(t *testing.T) *natsClient {
+	client, err := NewClient(natsAddress, time.Hour)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	return client
+}
+
+func newTopicName() string {
+	rand.Seed(time.Now().UnixNano())
+	return fmt.Sprintf("test-topic-%d", rand.Int())
+}
+
+func produceData(ctx context.Context, t *testing.T, client *natsClient, topic string, data []string) []mqwrapper.MessageID {
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+
+	ids := make([]mqwrapper.MessageID, 0, len(data))
+	for _, v := range data {
+		id, err := producer.Send(ctx, &mqwrapper.ProducerMessage{
+			Payload:    []byte(v),
+			Properties: map[string]string{common.TraceIDKey: v},
+		})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeData(t *testing.T, consumer mqwrapper.Consumer, n int) []mqwrapper.Message {
+	msgs := make([]mqwrapper.Message, 0, n)
+	for i := 0; i < n; i++ {
+		select {
+		case msg := <-consumer.Chan():
+			consumer.Ack(msg)
+			msgs = append(msgs, msg)
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "consume timeout")
+		}
+	}
+	return msgs
+}
+
+func TestNatsClient_ProduceAndConsume(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	topic := newTopicName()
+	ids := produceData(ctx, t, client, topic, []string{"111", "222", "333"})
+	assert.Equal(t, uint64(1), ids[0].(*natsID).messageID)
+	assert.Equal(t, uint64(3), ids[2].(*natsID).messageID)
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     16,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+	assert.Equal(t, "sub", consumer.Subscription())
+
+	msgs := consumeData(t, consumer, 3)
+	for i, v := range []string{"111", "222", "333"} {
+		assert.Equal(t, v, string(msgs[i].Payload()))
+		assert.Equal(t, v, msgs[i].Properties()[common.TraceIDKey])
+		assert.Equal(t, topic, msgs[i].Topic())
+		equal, err := msgs[i].ID().Equal(ids[i].Serialize())
+		assert.NoError(t, err)
+		assert.True(t, equal)
+	}
+	assert.True(t, msgs[0].ID().AtEarliestPosition())
+}
+
+func TestNatsClient_ConsumeFromLatest(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	topic := newTopicName()
+	produceData(ctx, t, client, topic, []string{"111", "222"})
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	produceData(ctx, t, client, topic, []string{"333"})
+	msgs := consumeData(t, consumer, 1)
+	assert.Equal(t, "333", string(msgs[0].Payload()))
+	assert.Equal(t, uint64(3), msgs[0].ID().(*natsID).messageID)
+}
+
+func TestNatsClient_Seek(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	topic := newTopicName()
+	ids := produceData(ctx, t, client, topic, []string{"111", "222", "333"})
+
+	subscribe := func() mqwrapper.Consumer {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		assert.NoError(t, err)
+		return consumer
+	}
+
+	t.Run("chan without seek", func(t *testing.T) {
+		consumer := subscribe()
+		defer consumer.Close()
+		assert.Panics(t, func() { consumer.Chan() })
+	})
+
+	t.Run("seek inclusive", func(t *testing.T) {
+		consumer := subscribe()
+		defer consumer.Close()
+		err := consumer.Seek(ids[1], true)
+		assert.NoError(t, err)
+		msgs := consumeData(t, consumer, 1)
+		assert.Equal(t, "222", string(msgs[0].Payload()))
+
+		// seek again is not allowed
+		err = consumer.Seek(ids[0], true)
+		assert.Error(t, err)
+	})
+
+	t.Run("seek exclusive", func(t *testing.T) {
+		consumer := subscribe()
+		defer consumer.Close()
+		err := consumer.Seek(ids[1], false)
+		assert.NoError(t, err)
+		msgs := consumeData(t, consumer, 1)
+		assert.Equal(t, "333", string(msgs[0].Payload()))
+	})
+
+	t.Run("seek from serialized id", func(t *testing.T) {
+		consumer := subscribe()
+		defer consumer.Close()
+		id, err := client.BytesToMsgID(ids[0].Serialize())
+		assert.NoError(t, err)
+		err = consumer.Seek(id, true)
+		assert.NoError(t, err)
+		msgs := consumeData(t, consumer, 3)
+		assert.Equal(t, "333", string(msgs[2].Payload()))
+	})
+}
+
+func TestNatsClient_GetLatestMsgID(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	topic := newTopicName()
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	latestID, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), latestID.(*natsID).messageID)
+
+	ids := produceData(ctx, t, client, topic, []string{"111", "222"})
+	latestID, err = consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	equal, err := latestID.Equal(ids[1].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, equal)
+}
+
+func TestNatsClient_MsgID(t *testing.T) {
+	client := newTestClient(t)
+	defer client.Close()
+
+	assert.True(t, client.EarliestMessageID().AtEarliestPosition())
+
+	id, err := client.StringToMsgID("5")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), id.(*natsID).messageID)
+	_, err = client.StringToMsgID("invalid")
+	assert.Error(t, err)
+
+	id2, err := client.BytesToMsgID(id.Serialize())
+	assert.NoError(t, err)
+	equal, err := id2.Equal(id.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, equal)
+}
+
+func TestNatsClient_ConnectFailed(t *testing.T) {
+	_, err := NewClient("nats://127.0.0.1:1", time.Hour)
+	assert.Error(t, err)
+}
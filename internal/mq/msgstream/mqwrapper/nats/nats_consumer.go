@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// nextMsgTimeout is how long the consume loop waits for a message before checking whether the consumer is closed
+const nextMsgTimeout = 100 * time.Millisecond
+
+// Consumer reads a topic through an ordered JetStream consumer. Ordered consumers are ephemeral,
+// the consuming position is decided by the subscription initial position or by Seek.
+type Consumer struct {
+	js         nats.JetStreamContext
+	topic      string
+	subName    string
+	sub        *nats.Subscription
+	msgChannel chan mqwrapper.Message
+	chanOnce   sync.Once
+	closeOnce  sync.Once
+	closeCh    chan struct{}
+	wg         sync.WaitGroup
+}
+
+// Check Consumer implements Consumer interface
+var _ mqwrapper.Consumer = &Consumer{}
+
+func newNatsConsumer(js nats.JetStreamContext, options mqwrapper.ConsumerOptions) (*Consumer, error) {
+	bufSize := options.BufSize
+	if bufSize <= 0 {
+		bufSize = 1024
+	}
+	nc := &Consumer{
+		js:         js,
+		topic:      options.Topic,
+		subName:    options.SubscriptionName,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		closeCh:    make(chan struct{}),
+	}
+
+	// if it's unknown, we leave the subscribe to seek
+	switch options.SubscriptionInitialPosition {
+	case mqwrapper.SubscriptionPositionEarliest:
+		return nc, nc.subscribe(nats.DeliverAll())
+	case mqwrapper.SubscriptionPositionLatest:
+		return nc, nc.subscribe(nats.DeliverNew())
+	}
+	return nc, nil
+}
+
+func (nc *Consumer) subscribe(deliverPolicy nats.SubOpt) error {
+	sub, err := nc.js.SubscribeSync(nc.topic, nats.BindStream(nc.topic), nats.OrderedConsumer(), deliverPolicy)
+	if err != nil {
+		log.Error("nats consumer subscribe failed", zap.String("topic", nc.topic), zap.String("subName", nc.subName), zap.Error(err))
+		return err
+	}
+	nc.sub = sub
+	return nil
+}
+
+// Subscription returns the subscription name of the consumer
+func (nc *Consumer) Subscription() string {
+	return nc.subName
+}
+
+// Chan provides a channel to read consumed message.
+func (nc *Consumer) Chan() <-chan mqwrapper.Message {
+	if nc.sub == nil {
+		log.Error("can not chan with not subscribed consumer", zap.String("topic", nc.topic), zap.String("subName", nc.subName))
+		panic("failed to chan a nats consumer without subscription")
+	}
+	nc.chanOnce.Do(func() {
+		nc.wg.Add(1)
+		go nc.consume()
+	})
+	return nc.msgChannel
+}
+
+func (nc *Consumer) consume() {
+	defer nc.wg.Done()
+	defer close(nc.msgChannel)
+	for {
+		select {
+		case <-nc.closeCh:
+			return
+		default:
+		}
+
+		msg, err := nc.sub.NextMsg(nextMsgTimeout)
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) {
+				continue
+			}
+			log.Warn("nats consumer failed to get next msg", zap.String("topic", nc.topic), zap.String("subName", nc.subName), zap.Error(err))
+			if errors.Is(err, nats.ErrBadSubscription) || errors.Is(err, nats.ErrConnectionClosed) {
+				return
+			}
+			continue
+		}
+
+		natsMsg, err := newNatsMessage(msg)
+		if err != nil {
+			log.Warn("nats consumer got a msg without metadata", zap.String("topic", nc.topic), zap.Error(err))
+			continue
+		}
+		select {
+		case nc.msgChannel <- natsMsg:
+		case <-nc.closeCh:
+			return
+		}
+	}
+}
+
+// Seek subscribes the topic from the message id, the message of id is skipped if inclusive is false
+func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	if nc.sub != nil {
+		return errors.New("nats consumer is already subscribed, can not seek again")
+	}
+
+	seq := id.(*natsID).messageID
+	if !inclusive {
+		seq++
+	}
+	log.Info("nats consumer seek", zap.String("topic", nc.topic), zap.Uint64("start sequence", seq), zap.Bool("inclusive", inclusive))
+	if seq == 0 {
+		return nc.subscribe(nats.DeliverAll())
+	}
+	return nc.subscribe(nats.StartSequence(seq))
+}
+
+// Ack does nothing, the messages are kept by the retention limits of the stream
+func (nc *Consumer) Ack(message mqwrapper.Message) {
+}
+
+// GetLatestMsgID returns the id of the last message of the stream
+func (nc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	info, err := nc.js.StreamInfo(nc.topic)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: info.State.LastSeq}, nil
+}
+
+// Close stops consuming and unsubscribes the topic
+func (nc *Consumer) Close() {
+	nc.closeOnce.Do(func() {
+		close(nc.closeCh)
+		nc.wg.Wait()
+		if nc.sub != nil {
+			if err := nc.sub.Unsubscribe(); err != nil {
+				log.Warn("nats consumer failed to unsubscribe", zap.String("topic", nc.topic), zap.Error(err))
+			}
+		}
+	})
+}
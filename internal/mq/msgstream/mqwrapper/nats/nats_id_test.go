@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNatsID_AtEarliestPosition(t *testing.T) {
+	assert.True(t, (&natsID{messageID: 0}).AtEarliestPosition())
+	assert.True(t, (&natsID{messageID: 1}).AtEarliestPosition())
+	assert.False(t, (&natsID{messageID: 2}).AtEarliestPosition())
+}
+
+func TestNatsID_LessOrEqualThan(t *testing.T) {
+	nid1 := &natsID{messageID: 1}
+	nid2 := &natsID{messageID: math.MaxUint64}
+
+	ret, err := nid1.LessOrEqualThan(nid2.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+
+	ret, err = nid2.LessOrEqualThan(nid1.Serialize())
+	assert.NoError(t, err)
+	assert.False(t, ret)
+
+	ret, err = nid1.LessOrEqualThan(nid1.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+}
+
+func TestNatsID_Equal(t *testing.T) {
+	nid1 := &natsID{messageID: 1}
+	nid2 := &natsID{messageID: 2}
+
+	ret, err := nid1.Equal(nid1.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+
+	ret, err = nid1.Equal(nid2.Serialize())
+	assert.NoError(t, err)
+	assert.False(t, ret)
+}
+
+func Test_SerializeNatsID(t *testing.T) {
+	bin := SerializeNatsID(12)
+	assert.Equal(t, 8, len(bin))
+	assert.Equal(t, uint64(12), DeserializeNatsID(bin))
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/nats-io/nats.go"
+)
+
+// natsMessage wraps a message received from a JetStream consumer
+type natsMessage struct {
+	msg *nats.Msg
+	id  *natsID
+}
+
+// Check natsMessage implements Message interface
+var _ mqwrapper.Message = &natsMessage{}
+
+func newNatsMessage(msg *nats.Msg) (*natsMessage, error) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	return &natsMessage{msg: msg, id: &natsID{messageID: meta.Sequence.Stream}}, nil
+}
+
+// Topic returns the topic name of the message
+func (nm *natsMessage) Topic() string {
+	return nm.msg.Subject
+}
+
+// Properties returns the properties of the message, they are carried by the message headers
+func (nm *natsMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(nm.msg.Header))
+	for key := range nm.msg.Header {
+		properties[key] = nm.msg.Header.Get(key)
+	}
+	return properties
+}
+
+// Payload returns the payload of the message
+func (nm *natsMessage) Payload() []byte {
+	return nm.msg.Data
+}
+
+// ID returns the stream sequence of the message
+func (nm *natsMessage) ID() mqwrapper.MessageID {
+	return nm.id
+}
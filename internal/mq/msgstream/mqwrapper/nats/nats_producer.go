@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/nats-io/nats.go"
+)
+
+// natsProducer publishes messages to the subject of a topic, they are persisted by the stream of the topic
+type natsProducer struct {
+	js    nats.JetStreamContext
+	topic string
+}
+
+// Check natsProducer implements Producer interface
+var _ mqwrapper.Producer = &natsProducer{}
+
+// Topic returns the topic of nats producer
+func (np *natsProducer) Topic() string {
+	return np.topic
+}
+
+// Send publishes the message and waits until it is persisted by the stream
+func (np *natsProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	msg := nats.NewMsg(np.topic)
+	msg.Data = message.Payload
+	for key, value := range message.Properties {
+		msg.Header.Set(key, value)
+	}
+
+	ack, err := np.js.PublishMsg(msg, nats.Context(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: ack.Sequence}, nil
+}
+
+// Close does nothing, the connection is owned by the client
+func (np *natsProducer) Close() {
+}
@@ -64,12 +64,12 @@ type BaseMsg struct {
 	MsgPosition    *MsgPosition
 }
 
-// TraceCtx returns the context of opentracing
+// TraceCtx returns the context of tracing
 func (bm *BaseMsg) TraceCtx() context.Context {
 	return bm.Ctx
 }
 
-// SetTraceCtx is used to set context for opentracing
+// SetTraceCtx is used to set context for tracing
 func (bm *BaseMsg) SetTraceCtx(ctx context.Context) {
 	bm.Ctx = ctx
 }
@@ -19,11 +19,11 @@ package msgstream
 import (
 	"context"
 	"errors"
+	"fmt"
 	"runtime"
 
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
-	"github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -31,61 +31,56 @@ import (
 
 // ExtractFromMsgProperties extracts trace span from msg.properties.
 // And it will attach some default tags to the span.
-func ExtractFromMsgProperties(msg TsMsg, properties map[string]string) (opentracing.Span, bool) {
+func ExtractFromMsgProperties(msg TsMsg, properties map[string]string) (oteltrace.Span, bool) {
 	if !allowTrace(msg) {
 		return trace.NoopSpan(), false
 	}
-	tracer := opentracing.GlobalTracer()
-	sc, _ := tracer.Extract(opentracing.TextMap, trace.PropertiesReaderWriter{PpMap: properties})
-	name := "receive pulsar msg"
-	opts := []opentracing.StartSpanOption{
-		ext.RPCServerOption(sc),
-		opentracing.Tags{
-			"ID":       msg.ID(),
-			"Type":     msg.Type(),
-			"HashKeys": msg.HashKeys(),
-			"Position": msg.Position(),
-		}}
-	return opentracing.StartSpan(name, opts...), true
+	ctx := trace.ExtractContextFromMsgProperties(context.Background(), properties)
+	name := "receive msg"
+	_, span := trace.Tracer().Start(ctx, name,
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer),
+		oteltrace.WithAttributes(msgAttributes(msg)...))
+	return span, true
 }
 
 // MsgSpanFromCtx extracts the span from context.
 // And it will attach some default tags to the span.
-func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...oteltrace.SpanStartOption) (oteltrace.Span, context.Context) {
 	if ctx == nil {
 		return trace.NoopSpan(), ctx
 	}
 	if !allowTrace(msg) {
 		return trace.NoopSpan(), ctx
 	}
-	operationName := "send pulsar msg"
-	opts = append(opts, opentracing.Tags{
-		"ID":       msg.ID(),
-		"Type":     msg.Type(),
-		"HashKeys": msg.HashKeys(),
-		"Position": msg.Position(),
-	})
+	operationName := "send msg"
+	opts = append(opts,
+		oteltrace.WithSpanKind(oteltrace.SpanKindProducer),
+		oteltrace.WithAttributes(msgAttributes(msg)...))
 
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := trace.Tracer().Start(ctx, operationName, opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	file, line := runtime.FuncForPC(pcs[0]).FileLine(pcs[0])
 
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
-	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
-
-	span.LogFields(log.String("filename", file), log.Int("line", line))
+	ctx, span := trace.Tracer().Start(ctx, operationName, opts...)
+	span.SetAttributes(attribute.String("filename", file), attribute.Int("line", line))
 
 	return span, ctx
 }
 
+func msgAttributes(msg TsMsg) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int64("ID", msg.ID()),
+		attribute.String("Type", msg.Type().String()),
+		attribute.String("HashKeys", fmt.Sprint(msg.HashKeys())),
+		attribute.String("Position", msg.Position().String()),
+	}
+}
+
 func allowTrace(in interface{}) bool {
 	if in == nil {
 		return false
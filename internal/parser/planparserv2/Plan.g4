@@ -6,10 +6,13 @@ expr:
 	| BooleanConstant										                # Boolean
 	| StringLiteral											                # String
 	| Identifier											                # Identifier
+	| JSONIdentifier										                # JSONIdentifier
 	| '(' expr ')'											                # Parens
 	| expr LIKE StringLiteral                                               # Like
 	| expr POW expr											                # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                # Unary
+	| ArrayContains '(' expr ',' expr ')'                                  # ArrayContains
+	| ArrayContainsAny '(' expr ',' '[' expr (',' expr)* ','? ']' ')'     # ArrayContainsAny
 //	| '(' typeName ')' expr									                # Cast
 	| expr op = (MUL | DIV | MOD) expr						                # MulDivMod
 	| expr op = (ADD | SUB) expr							                # AddSub
@@ -79,6 +82,9 @@ FloatingConstant:
 	DecimalFloatingConstant
 	| HexadecimalFloatingConstant;
 
+ArrayContains: 'array_contains' | 'ARRAY_CONTAINS';
+ArrayContainsAny: 'array_contains_any' | 'ARRAY_CONTAINS_ANY';
+
 Identifier: Nondigit (Nondigit | Digit)*;
 
 StringLiteral: EncodingPrefix? '"' SCharSequence? '"';
@@ -129,3 +135,5 @@ fragment EscapeSequence:
 Whitespace: [ \t]+ -> skip;
 
 Newline: ( '\r' '\n'? | '\n') -> skip;
+
+JSONIdentifier: Identifier ('[' StringLiteral ']')+;
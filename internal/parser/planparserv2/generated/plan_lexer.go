@@ -14,211 +14,243 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 39, 444,
-	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
-	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
-	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
-	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
-	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
-	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
-	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
-	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
-	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
-	60, 9, 60, 4, 61, 9, 61, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5,
-	3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 158, 10, 13, 3, 14, 3, 14, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20,
-	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3,
-	24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 190, 10, 25, 3, 26, 3, 26,
-	3, 26, 3, 26, 5, 26, 196, 10, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
-	28, 5, 28, 204, 10, 28, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30,
-	3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 7, 31, 219, 10, 31, 12, 31, 14,
-	31, 222, 11, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 42, 526, 8,
+	1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
+	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
+	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
+	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
+	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
+	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44, 9, 44,
+	4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9, 49, 4,
+	50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54, 4, 55,
+	9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4, 60, 9,
+	60, 4, 61, 9, 61, 4, 62, 9, 62, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5,
+	3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10,
+	3, 10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 160, 10, 13, 3, 14, 3, 14, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
+	20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24,
+	3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 192, 10, 25, 3, 26, 3,
+	26, 3, 26, 3, 26, 5, 26, 198, 10, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28,
+	3, 28, 5, 28, 206, 10, 28, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3,
+	30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 7, 31, 221, 10, 31, 12, 31,
+	14, 31, 224, 11, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
 	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	5, 32, 253, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 5, 33, 259, 10, 33, 3,
-	34, 3, 34, 5, 34, 263, 10, 34, 3, 35, 3, 35, 3, 35, 7, 35, 268, 10, 35,
-	12, 35, 14, 35, 271, 11, 35, 3, 36, 5, 36, 274, 10, 36, 3, 36, 3, 36, 5,
-	36, 278, 10, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 5, 37, 285, 10, 37,
-	3, 38, 6, 38, 288, 10, 38, 13, 38, 14, 38, 289, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 3, 39, 5, 39, 299, 10, 39, 3, 40, 3, 40, 3, 41, 3, 41,
-	3, 42, 3, 42, 3, 42, 6, 42, 308, 10, 42, 13, 42, 14, 42, 309, 3, 43, 3,
-	43, 7, 43, 314, 10, 43, 12, 43, 14, 43, 317, 11, 43, 3, 44, 3, 44, 7, 44,
-	321, 10, 44, 12, 44, 14, 44, 324, 11, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3,
-	46, 3, 46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 5,
-	50, 351, 10, 50, 3, 51, 3, 51, 5, 51, 355, 10, 51, 3, 51, 3, 51, 3, 51,
-	5, 51, 360, 10, 51, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 366, 10, 52, 3,
-	52, 3, 52, 3, 53, 5, 53, 371, 10, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
-	5, 53, 378, 10, 53, 3, 54, 3, 54, 5, 54, 382, 10, 54, 3, 54, 3, 54, 3,
-	55, 6, 55, 387, 10, 55, 13, 55, 14, 55, 388, 3, 56, 5, 56, 392, 10, 56,
-	3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 5, 56, 399, 10, 56, 3, 57, 6, 57, 402,
-	10, 57, 13, 57, 14, 57, 403, 3, 58, 3, 58, 5, 58, 408, 10, 58, 3, 58, 3,
-	58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 417, 10, 59, 3, 59, 5, 59,
-	420, 10, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 427, 10, 59, 3,
-	60, 6, 60, 430, 10, 60, 13, 60, 14, 60, 431, 3, 60, 3, 60, 3, 61, 3, 61,
-	5, 61, 438, 10, 61, 3, 61, 5, 61, 441, 10, 61, 3, 61, 3, 61, 2, 2, 62,
-	3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23,
-	13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41,
-	22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59,
-	31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 2, 75, 2, 77, 2,
-	79, 2, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99,
-	2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2, 111, 2, 113, 2, 115, 2, 117,
-	2, 119, 38, 121, 39, 3, 2, 17, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12,
+	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	32, 5, 32, 255, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 5, 33, 261, 10, 33, 3,
+	34, 3, 34, 5, 34, 265, 10, 34, 3, 35, 3, 35, 3, 35, 7, 35, 270, 10, 35,
+	12, 35, 14, 35, 273, 11, 35, 3, 36, 5, 36, 276, 10, 36, 3, 36, 3, 36, 5,
+	36, 280, 10, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 5, 37, 287, 10, 37, 3,
+	38, 6, 38, 290, 10, 38, 13, 38, 14, 38, 291, 3, 39, 3, 39, 3, 39, 3, 39,
+	3, 39, 3, 39, 3, 39, 5, 39, 301, 10, 39, 3, 40, 3, 40, 3, 41, 3, 41, 3,
+	42, 3, 42, 3, 42, 6, 42, 310, 10, 42, 13, 42, 14, 42, 311, 3, 43, 3, 43,
+	7, 43, 316, 10, 43, 12, 43, 14, 43, 319, 11, 43, 3, 44, 3, 44, 7, 44, 323,
+	10, 44, 12, 44, 14, 44, 326, 11, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3,
+	46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50,
+	3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 5, 50, 353,
+	10, 50, 3, 51, 3, 51, 5, 51, 357, 10, 51, 3, 51, 3, 51, 3, 51, 5, 51, 362,
+	10, 51, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 368, 10, 52, 3, 52, 3, 52, 3,
+	53, 5, 53, 373, 10, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 5, 53, 380, 10,
+	53, 3, 54, 3, 54, 5, 54, 384, 10, 54, 3, 54, 3, 54, 3, 55, 6, 55, 389, 10,
+	55, 13, 55, 14, 55, 390, 3, 56, 5, 56, 394, 10, 56, 3, 56, 3, 56, 3, 56,
+	3, 56, 3, 56, 5, 56, 401, 10, 56, 3, 57, 6, 57, 404, 10, 57, 13, 57, 14,
+	57, 405, 3, 58, 3, 58, 5, 58, 410, 10, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3,
+	59, 3, 59, 3, 59, 5, 59, 419, 10, 59, 3, 59, 5, 59, 422, 10, 59, 3, 59, 3,
+	59, 3, 59, 3, 59, 3, 59, 5, 59, 429, 10, 59, 3, 60, 6, 60, 432, 10, 60,
+	13, 60, 14, 60, 433, 3, 60, 3, 60, 3, 61, 3, 61, 5, 61, 440, 10, 61, 3,
+	61, 5, 61, 443, 10, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 6, 62,
+	451, 10, 62, 13, 62, 14, 62, 452, 4, 63, 9, 63, 3, 63, 3, 63, 3, 63, 3,
+	63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63,
+	3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3,
+	63, 3, 63, 3, 63, 3, 63, 5, 63, 485, 10, 63, 4, 64, 9, 64, 3, 64, 3, 64,
+	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
+	64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
+	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
+	64, 3, 64, 3, 64, 5, 64, 525, 10, 64, 2, 2, 65, 3, 3, 5, 4, 7, 5, 9, 6,
+	11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29,
+	16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47,
+	25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65,
+	34, 67, 35, 69, 36, 71, 37, 73, 2, 75, 2, 77, 2, 79, 2, 81, 2, 83, 2, 85,
+	2, 87, 2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99, 2, 101, 2, 103, 2, 105,
+	2, 107, 2, 109, 2, 111, 2, 113, 2, 115, 2, 117, 2, 119, 38, 121, 39, 123,
+	40, 454, 41, 486, 42, 3, 2, 17, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12,
 	12, 15, 15, 36, 36, 94, 94, 5, 2, 67, 92, 97, 97, 99, 124, 3, 2, 50, 59,
 	4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2, 90, 90, 122, 122, 3, 2, 51,
 	59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71, 103, 103,
 	4, 2, 45, 45, 47, 47, 4, 2, 82, 82, 114, 114, 12, 2, 36, 36, 41, 41, 65,
-	65, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120,
-	4, 2, 11, 11, 34, 34, 2, 467, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
-	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
-	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
-	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
-	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
-	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
-	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
-	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
-	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2,
-	2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2,
-	2, 2, 3, 123, 3, 2, 2, 2, 5, 125, 3, 2, 2, 2, 7, 127, 3, 2, 2, 2, 9, 129,
-	3, 2, 2, 2, 11, 131, 3, 2, 2, 2, 13, 133, 3, 2, 2, 2, 15, 135, 3, 2, 2,
-	2, 17, 138, 3, 2, 2, 2, 19, 140, 3, 2, 2, 2, 21, 143, 3, 2, 2, 2, 23, 146,
-	3, 2, 2, 2, 25, 157, 3, 2, 2, 2, 27, 159, 3, 2, 2, 2, 29, 161, 3, 2, 2,
-	2, 31, 163, 3, 2, 2, 2, 33, 165, 3, 2, 2, 2, 35, 167, 3, 2, 2, 2, 37, 169,
-	3, 2, 2, 2, 39, 172, 3, 2, 2, 2, 41, 175, 3, 2, 2, 2, 43, 178, 3, 2, 2,
-	2, 45, 180, 3, 2, 2, 2, 47, 182, 3, 2, 2, 2, 49, 189, 3, 2, 2, 2, 51, 195,
-	3, 2, 2, 2, 53, 197, 3, 2, 2, 2, 55, 203, 3, 2, 2, 2, 57, 205, 3, 2, 2,
-	2, 59, 208, 3, 2, 2, 2, 61, 215, 3, 2, 2, 2, 63, 252, 3, 2, 2, 2, 65, 258,
-	3, 2, 2, 2, 67, 262, 3, 2, 2, 2, 69, 264, 3, 2, 2, 2, 71, 273, 3, 2, 2,
-	2, 73, 284, 3, 2, 2, 2, 75, 287, 3, 2, 2, 2, 77, 298, 3, 2, 2, 2, 79, 300,
-	3, 2, 2, 2, 81, 302, 3, 2, 2, 2, 83, 304, 3, 2, 2, 2, 85, 311, 3, 2, 2,
-	2, 87, 318, 3, 2, 2, 2, 89, 325, 3, 2, 2, 2, 91, 329, 3, 2, 2, 2, 93, 331,
-	3, 2, 2, 2, 95, 333, 3, 2, 2, 2, 97, 335, 3, 2, 2, 2, 99, 350, 3, 2, 2,
-	2, 101, 359, 3, 2, 2, 2, 103, 361, 3, 2, 2, 2, 105, 377, 3, 2, 2, 2, 107,
-	379, 3, 2, 2, 2, 109, 386, 3, 2, 2, 2, 111, 398, 3, 2, 2, 2, 113, 401,
-	3, 2, 2, 2, 115, 405, 3, 2, 2, 2, 117, 426, 3, 2, 2, 2, 119, 429, 3, 2,
-	2, 2, 121, 440, 3, 2, 2, 2, 123, 124, 7, 42, 2, 2, 124, 4, 3, 2, 2, 2,
-	125, 126, 7, 43, 2, 2, 126, 6, 3, 2, 2, 2, 127, 128, 7, 93, 2, 2, 128,
-	8, 3, 2, 2, 2, 129, 130, 7, 46, 2, 2, 130, 10, 3, 2, 2, 2, 131, 132, 7,
-	95, 2, 2, 132, 12, 3, 2, 2, 2, 133, 134, 7, 62, 2, 2, 134, 14, 3, 2, 2,
-	2, 135, 136, 7, 62, 2, 2, 136, 137, 7, 63, 2, 2, 137, 16, 3, 2, 2, 2, 138,
-	139, 7, 64, 2, 2, 139, 18, 3, 2, 2, 2, 140, 141, 7, 64, 2, 2, 141, 142,
-	7, 63, 2, 2, 142, 20, 3, 2, 2, 2, 143, 144, 7, 63, 2, 2, 144, 145, 7, 63,
-	2, 2, 145, 22, 3, 2, 2, 2, 146, 147, 7, 35, 2, 2, 147, 148, 7, 63, 2, 2,
-	148, 24, 3, 2, 2, 2, 149, 150, 7, 110, 2, 2, 150, 151, 7, 107, 2, 2, 151,
-	152, 7, 109, 2, 2, 152, 158, 7, 103, 2, 2, 153, 154, 7, 78, 2, 2, 154,
-	155, 7, 75, 2, 2, 155, 156, 7, 77, 2, 2, 156, 158, 7, 71, 2, 2, 157, 149,
-	3, 2, 2, 2, 157, 153, 3, 2, 2, 2, 158, 26, 3, 2, 2, 2, 159, 160, 7, 45,
-	2, 2, 160, 28, 3, 2, 2, 2, 161, 162, 7, 47, 2, 2, 162, 30, 3, 2, 2, 2,
-	163, 164, 7, 44, 2, 2, 164, 32, 3, 2, 2, 2, 165, 166, 7, 49, 2, 2, 166,
-	34, 3, 2, 2, 2, 167, 168, 7, 39, 2, 2, 168, 36, 3, 2, 2, 2, 169, 170, 7,
-	44, 2, 2, 170, 171, 7, 44, 2, 2, 171, 38, 3, 2, 2, 2, 172, 173, 7, 62,
-	2, 2, 173, 174, 7, 62, 2, 2, 174, 40, 3, 2, 2, 2, 175, 176, 7, 64, 2, 2,
-	176, 177, 7, 64, 2, 2, 177, 42, 3, 2, 2, 2, 178, 179, 7, 40, 2, 2, 179,
-	44, 3, 2, 2, 2, 180, 181, 7, 126, 2, 2, 181, 46, 3, 2, 2, 2, 182, 183,
-	7, 96, 2, 2, 183, 48, 3, 2, 2, 2, 184, 185, 7, 40, 2, 2, 185, 190, 7, 40,
-	2, 2, 186, 187, 7, 99, 2, 2, 187, 188, 7, 112, 2, 2, 188, 190, 7, 102,
-	2, 2, 189, 184, 3, 2, 2, 2, 189, 186, 3, 2, 2, 2, 190, 50, 3, 2, 2, 2,
-	191, 192, 7, 126, 2, 2, 192, 196, 7, 126, 2, 2, 193, 194, 7, 113, 2, 2,
-	194, 196, 7, 116, 2, 2, 195, 191, 3, 2, 2, 2, 195, 193, 3, 2, 2, 2, 196,
-	52, 3, 2, 2, 2, 197, 198, 7, 128, 2, 2, 198, 54, 3, 2, 2, 2, 199, 204,
-	7, 35, 2, 2, 200, 201, 7, 112, 2, 2, 201, 202, 7, 113, 2, 2, 202, 204,
-	7, 118, 2, 2, 203, 199, 3, 2, 2, 2, 203, 200, 3, 2, 2, 2, 204, 56, 3, 2,
-	2, 2, 205, 206, 7, 107, 2, 2, 206, 207, 7, 112, 2, 2, 207, 58, 3, 2, 2,
-	2, 208, 209, 7, 112, 2, 2, 209, 210, 7, 113, 2, 2, 210, 211, 7, 118, 2,
-	2, 211, 212, 7, 34, 2, 2, 212, 213, 7, 107, 2, 2, 213, 214, 7, 112, 2,
-	2, 214, 60, 3, 2, 2, 2, 215, 220, 7, 93, 2, 2, 216, 219, 5, 119, 60, 2,
-	217, 219, 5, 121, 61, 2, 218, 216, 3, 2, 2, 2, 218, 217, 3, 2, 2, 2, 219,
-	222, 3, 2, 2, 2, 220, 218, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 223,
-	3, 2, 2, 2, 222, 220, 3, 2, 2, 2, 223, 224, 7, 95, 2, 2, 224, 62, 3, 2,
-	2, 2, 225, 226, 7, 118, 2, 2, 226, 227, 7, 116, 2, 2, 227, 228, 7, 119,
-	2, 2, 228, 253, 7, 103, 2, 2, 229, 230, 7, 86, 2, 2, 230, 231, 7, 116,
-	2, 2, 231, 232, 7, 119, 2, 2, 232, 253, 7, 103, 2, 2, 233, 234, 7, 86,
-	2, 2, 234, 235, 7, 84, 2, 2, 235, 236, 7, 87, 2, 2, 236, 253, 7, 71, 2,
-	2, 237, 238, 7, 104, 2, 2, 238, 239, 7, 99, 2, 2, 239, 240, 7, 110, 2,
-	2, 240, 241, 7, 117, 2, 2, 241, 253, 7, 103, 2, 2, 242, 243, 7, 72, 2,
-	2, 243, 244, 7, 99, 2, 2, 244, 245, 7, 110, 2, 2, 245, 246, 7, 117, 2,
-	2, 246, 253, 7, 103, 2, 2, 247, 248, 7, 72, 2, 2, 248, 249, 7, 67, 2, 2,
-	249, 250, 7, 78, 2, 2, 250, 251, 7, 85, 2, 2, 251, 253, 7, 71, 2, 2, 252,
-	225, 3, 2, 2, 2, 252, 229, 3, 2, 2, 2, 252, 233, 3, 2, 2, 2, 252, 237,
-	3, 2, 2, 2, 252, 242, 3, 2, 2, 2, 252, 247, 3, 2, 2, 2, 253, 64, 3, 2,
-	2, 2, 254, 259, 5, 85, 43, 2, 255, 259, 5, 87, 44, 2, 256, 259, 5, 89,
-	45, 2, 257, 259, 5, 83, 42, 2, 258, 254, 3, 2, 2, 2, 258, 255, 3, 2, 2,
-	2, 258, 256, 3, 2, 2, 2, 258, 257, 3, 2, 2, 2, 259, 66, 3, 2, 2, 2, 260,
-	263, 5, 101, 51, 2, 261, 263, 5, 103, 52, 2, 262, 260, 3, 2, 2, 2, 262,
-	261, 3, 2, 2, 2, 263, 68, 3, 2, 2, 2, 264, 269, 5, 79, 40, 2, 265, 268,
-	5, 79, 40, 2, 266, 268, 5, 81, 41, 2, 267, 265, 3, 2, 2, 2, 267, 266, 3,
-	2, 2, 2, 268, 271, 3, 2, 2, 2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2,
-	2, 270, 70, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2, 272, 274, 5, 73, 37, 2, 273,
-	272, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 275, 3, 2, 2, 2, 275, 277,
-	7, 36, 2, 2, 276, 278, 5, 75, 38, 2, 277, 276, 3, 2, 2, 2, 277, 278, 3,
-	2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 7, 36, 2, 2, 280, 72, 3, 2, 2,
-	2, 281, 282, 7, 119, 2, 2, 282, 285, 7, 58, 2, 2, 283, 285, 9, 2, 2, 2,
-	284, 281, 3, 2, 2, 2, 284, 283, 3, 2, 2, 2, 285, 74, 3, 2, 2, 2, 286, 288,
-	5, 77, 39, 2, 287, 286, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 287, 3,
-	2, 2, 2, 289, 290, 3, 2, 2, 2, 290, 76, 3, 2, 2, 2, 291, 299, 10, 3, 2,
-	2, 292, 299, 5, 117, 59, 2, 293, 294, 7, 94, 2, 2, 294, 299, 7, 12, 2,
-	2, 295, 296, 7, 94, 2, 2, 296, 297, 7, 15, 2, 2, 297, 299, 7, 12, 2, 2,
-	298, 291, 3, 2, 2, 2, 298, 292, 3, 2, 2, 2, 298, 293, 3, 2, 2, 2, 298,
-	295, 3, 2, 2, 2, 299, 78, 3, 2, 2, 2, 300, 301, 9, 4, 2, 2, 301, 80, 3,
-	2, 2, 2, 302, 303, 9, 5, 2, 2, 303, 82, 3, 2, 2, 2, 304, 305, 7, 50, 2,
-	2, 305, 307, 9, 6, 2, 2, 306, 308, 9, 7, 2, 2, 307, 306, 3, 2, 2, 2, 308,
-	309, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 84, 3,
-	2, 2, 2, 311, 315, 5, 91, 46, 2, 312, 314, 5, 81, 41, 2, 313, 312, 3, 2,
-	2, 2, 314, 317, 3, 2, 2, 2, 315, 313, 3, 2, 2, 2, 315, 316, 3, 2, 2, 2,
-	316, 86, 3, 2, 2, 2, 317, 315, 3, 2, 2, 2, 318, 322, 7, 50, 2, 2, 319,
-	321, 5, 93, 47, 2, 320, 319, 3, 2, 2, 2, 321, 324, 3, 2, 2, 2, 322, 320,
-	3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 88, 3, 2, 2, 2, 324, 322, 3, 2,
-	2, 2, 325, 326, 7, 50, 2, 2, 326, 327, 9, 8, 2, 2, 327, 328, 5, 113, 57,
-	2, 328, 90, 3, 2, 2, 2, 329, 330, 9, 9, 2, 2, 330, 92, 3, 2, 2, 2, 331,
-	332, 9, 10, 2, 2, 332, 94, 3, 2, 2, 2, 333, 334, 9, 11, 2, 2, 334, 96,
-	3, 2, 2, 2, 335, 336, 5, 95, 48, 2, 336, 337, 5, 95, 48, 2, 337, 338, 5,
-	95, 48, 2, 338, 339, 5, 95, 48, 2, 339, 98, 3, 2, 2, 2, 340, 341, 7, 94,
-	2, 2, 341, 342, 7, 119, 2, 2, 342, 343, 3, 2, 2, 2, 343, 351, 5, 97, 49,
-	2, 344, 345, 7, 94, 2, 2, 345, 346, 7, 87, 2, 2, 346, 347, 3, 2, 2, 2,
-	347, 348, 5, 97, 49, 2, 348, 349, 5, 97, 49, 2, 349, 351, 3, 2, 2, 2, 350,
-	340, 3, 2, 2, 2, 350, 344, 3, 2, 2, 2, 351, 100, 3, 2, 2, 2, 352, 354,
-	5, 105, 53, 2, 353, 355, 5, 107, 54, 2, 354, 353, 3, 2, 2, 2, 354, 355,
-	3, 2, 2, 2, 355, 360, 3, 2, 2, 2, 356, 357, 5, 109, 55, 2, 357, 358, 5,
-	107, 54, 2, 358, 360, 3, 2, 2, 2, 359, 352, 3, 2, 2, 2, 359, 356, 3, 2,
-	2, 2, 360, 102, 3, 2, 2, 2, 361, 362, 7, 50, 2, 2, 362, 365, 9, 8, 2, 2,
-	363, 366, 5, 111, 56, 2, 364, 366, 5, 113, 57, 2, 365, 363, 3, 2, 2, 2,
-	365, 364, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 368, 5, 115, 58, 2, 368,
-	104, 3, 2, 2, 2, 369, 371, 5, 109, 55, 2, 370, 369, 3, 2, 2, 2, 370, 371,
-	3, 2, 2, 2, 371, 372, 3, 2, 2, 2, 372, 373, 7, 48, 2, 2, 373, 378, 5, 109,
-	55, 2, 374, 375, 5, 109, 55, 2, 375, 376, 7, 48, 2, 2, 376, 378, 3, 2,
-	2, 2, 377, 370, 3, 2, 2, 2, 377, 374, 3, 2, 2, 2, 378, 106, 3, 2, 2, 2,
-	379, 381, 9, 12, 2, 2, 380, 382, 9, 13, 2, 2, 381, 380, 3, 2, 2, 2, 381,
-	382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 384, 5, 109, 55, 2, 384, 108,
-	3, 2, 2, 2, 385, 387, 5, 81, 41, 2, 386, 385, 3, 2, 2, 2, 387, 388, 3,
-	2, 2, 2, 388, 386, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389, 110, 3, 2, 2,
-	2, 390, 392, 5, 113, 57, 2, 391, 390, 3, 2, 2, 2, 391, 392, 3, 2, 2, 2,
-	392, 393, 3, 2, 2, 2, 393, 394, 7, 48, 2, 2, 394, 399, 5, 113, 57, 2, 395,
-	396, 5, 113, 57, 2, 396, 397, 7, 48, 2, 2, 397, 399, 3, 2, 2, 2, 398, 391,
-	3, 2, 2, 2, 398, 395, 3, 2, 2, 2, 399, 112, 3, 2, 2, 2, 400, 402, 5, 95,
-	48, 2, 401, 400, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 401, 3, 2, 2, 2,
-	403, 404, 3, 2, 2, 2, 404, 114, 3, 2, 2, 2, 405, 407, 9, 14, 2, 2, 406,
-	408, 9, 13, 2, 2, 407, 406, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 409,
-	3, 2, 2, 2, 409, 410, 5, 109, 55, 2, 410, 116, 3, 2, 2, 2, 411, 412, 7,
-	94, 2, 2, 412, 427, 9, 15, 2, 2, 413, 414, 7, 94, 2, 2, 414, 416, 5, 93,
-	47, 2, 415, 417, 5, 93, 47, 2, 416, 415, 3, 2, 2, 2, 416, 417, 3, 2, 2,
-	2, 417, 419, 3, 2, 2, 2, 418, 420, 5, 93, 47, 2, 419, 418, 3, 2, 2, 2,
-	419, 420, 3, 2, 2, 2, 420, 427, 3, 2, 2, 2, 421, 422, 7, 94, 2, 2, 422,
-	423, 7, 122, 2, 2, 423, 424, 3, 2, 2, 2, 424, 427, 5, 113, 57, 2, 425,
-	427, 5, 99, 50, 2, 426, 411, 3, 2, 2, 2, 426, 413, 3, 2, 2, 2, 426, 421,
-	3, 2, 2, 2, 426, 425, 3, 2, 2, 2, 427, 118, 3, 2, 2, 2, 428, 430, 9, 16,
-	2, 2, 429, 428, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431, 429, 3, 2, 2, 2,
-	431, 432, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 8, 60, 2, 2, 434,
-	120, 3, 2, 2, 2, 435, 437, 7, 15, 2, 2, 436, 438, 7, 12, 2, 2, 437, 436,
-	3, 2, 2, 2, 437, 438, 3, 2, 2, 2, 438, 441, 3, 2, 2, 2, 439, 441, 7, 12,
-	2, 2, 440, 435, 3, 2, 2, 2, 440, 439, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2,
-	442, 443, 8, 61, 2, 2, 443, 122, 3, 2, 2, 2, 40, 2, 157, 189, 195, 203,
-	218, 220, 252, 258, 262, 267, 269, 273, 277, 284, 289, 298, 309, 315, 322,
-	350, 354, 359, 365, 370, 377, 381, 388, 391, 398, 403, 407, 416, 419, 426,
-	431, 437, 440, 3, 8, 2, 2,
+	65, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120, 4,
+	2, 11, 11, 34, 34, 2, 552, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2,
+	2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2,
+	2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3,
+	2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31,
+	3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2,
+	39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2,
+	2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2,
+	2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2,
+	2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 454, 3,
+	2, 2, 2, 2, 486, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 3, 125, 3, 2, 2, 2, 5,
+	127, 3, 2, 2, 2, 7, 129, 3, 2, 2, 2, 9, 131, 3, 2, 2, 2, 11, 133, 3, 2, 2,
+	2, 13, 135, 3, 2, 2, 2, 15, 137, 3, 2, 2, 2, 17, 140, 3, 2, 2, 2, 19, 142,
+	3, 2, 2, 2, 21, 145, 3, 2, 2, 2, 23, 148, 3, 2, 2, 2, 25, 159, 3, 2, 2, 2,
+	27, 161, 3, 2, 2, 2, 29, 163, 3, 2, 2, 2, 31, 165, 3, 2, 2, 2, 33, 167, 3,
+	2, 2, 2, 35, 169, 3, 2, 2, 2, 37, 171, 3, 2, 2, 2, 39, 174, 3, 2, 2, 2,
+	41, 177, 3, 2, 2, 2, 43, 180, 3, 2, 2, 2, 45, 182, 3, 2, 2, 2, 47, 184, 3,
+	2, 2, 2, 49, 191, 3, 2, 2, 2, 51, 197, 3, 2, 2, 2, 53, 199, 3, 2, 2, 2,
+	55, 205, 3, 2, 2, 2, 57, 207, 3, 2, 2, 2, 59, 210, 3, 2, 2, 2, 61, 217, 3,
+	2, 2, 2, 63, 254, 3, 2, 2, 2, 65, 260, 3, 2, 2, 2, 67, 264, 3, 2, 2, 2,
+	69, 266, 3, 2, 2, 2, 71, 275, 3, 2, 2, 2, 73, 286, 3, 2, 2, 2, 75, 289, 3,
+	2, 2, 2, 77, 300, 3, 2, 2, 2, 79, 302, 3, 2, 2, 2, 81, 304, 3, 2, 2, 2,
+	83, 306, 3, 2, 2, 2, 85, 313, 3, 2, 2, 2, 87, 320, 3, 2, 2, 2, 89, 327, 3,
+	2, 2, 2, 91, 331, 3, 2, 2, 2, 93, 333, 3, 2, 2, 2, 95, 335, 3, 2, 2, 2,
+	97, 337, 3, 2, 2, 2, 99, 352, 3, 2, 2, 2, 101, 361, 3, 2, 2, 2, 103, 363,
+	3, 2, 2, 2, 105, 379, 3, 2, 2, 2, 107, 381, 3, 2, 2, 2, 109, 388, 3, 2, 2,
+	2, 111, 400, 3, 2, 2, 2, 113, 403, 3, 2, 2, 2, 115, 407, 3, 2, 2, 2, 117,
+	428, 3, 2, 2, 2, 119, 431, 3, 2, 2, 2, 121, 442, 3, 2, 2, 2, 123, 446, 3,
+	2, 2, 2, 125, 126, 7, 42, 2, 2, 126, 4, 3, 2, 2, 2, 127, 128, 7, 43, 2, 2,
+	128, 6, 3, 2, 2, 2, 129, 130, 7, 93, 2, 2, 130, 8, 3, 2, 2, 2, 131, 132,
+	7, 46, 2, 2, 132, 10, 3, 2, 2, 2, 133, 134, 7, 95, 2, 2, 134, 12, 3, 2, 2,
+	2, 135, 136, 7, 62, 2, 2, 136, 14, 3, 2, 2, 2, 137, 138, 7, 62, 2, 2, 138,
+	139, 7, 63, 2, 2, 139, 16, 3, 2, 2, 2, 140, 141, 7, 64, 2, 2, 141, 18, 3,
+	2, 2, 2, 142, 143, 7, 64, 2, 2, 143, 144, 7, 63, 2, 2, 144, 20, 3, 2, 2,
+	2, 145, 146, 7, 63, 2, 2, 146, 147, 7, 63, 2, 2, 147, 22, 3, 2, 2, 2, 148,
+	149, 7, 35, 2, 2, 149, 150, 7, 63, 2, 2, 150, 24, 3, 2, 2, 2, 151, 152, 7,
+	110, 2, 2, 152, 153, 7, 107, 2, 2, 153, 154, 7, 109, 2, 2, 154, 160, 7,
+	103, 2, 2, 155, 156, 7, 78, 2, 2, 156, 157, 7, 75, 2, 2, 157, 158, 7, 77,
+	2, 2, 158, 160, 7, 71, 2, 2, 159, 151, 3, 2, 2, 2, 159, 155, 3, 2, 2, 2,
+	160, 26, 3, 2, 2, 2, 161, 162, 7, 45, 2, 2, 162, 28, 3, 2, 2, 2, 163, 164,
+	7, 47, 2, 2, 164, 30, 3, 2, 2, 2, 165, 166, 7, 44, 2, 2, 166, 32, 3, 2, 2,
+	2, 167, 168, 7, 49, 2, 2, 168, 34, 3, 2, 2, 2, 169, 170, 7, 39, 2, 2, 170,
+	36, 3, 2, 2, 2, 171, 172, 7, 44, 2, 2, 172, 173, 7, 44, 2, 2, 173, 38, 3,
+	2, 2, 2, 174, 175, 7, 62, 2, 2, 175, 176, 7, 62, 2, 2, 176, 40, 3, 2, 2,
+	2, 177, 178, 7, 64, 2, 2, 178, 179, 7, 64, 2, 2, 179, 42, 3, 2, 2, 2, 180,
+	181, 7, 40, 2, 2, 181, 44, 3, 2, 2, 2, 182, 183, 7, 126, 2, 2, 183, 46, 3,
+	2, 2, 2, 184, 185, 7, 96, 2, 2, 185, 48, 3, 2, 2, 2, 186, 187, 7, 40, 2,
+	2, 187, 192, 7, 40, 2, 2, 188, 189, 7, 99, 2, 2, 189, 190, 7, 112, 2, 2,
+	190, 192, 7, 102, 2, 2, 191, 186, 3, 2, 2, 2, 191, 188, 3, 2, 2, 2, 192,
+	50, 3, 2, 2, 2, 193, 194, 7, 126, 2, 2, 194, 198, 7, 126, 2, 2, 195, 196,
+	7, 113, 2, 2, 196, 198, 7, 116, 2, 2, 197, 193, 3, 2, 2, 2, 197, 195, 3,
+	2, 2, 2, 198, 52, 3, 2, 2, 2, 199, 200, 7, 128, 2, 2, 200, 54, 3, 2, 2, 2,
+	201, 206, 7, 35, 2, 2, 202, 203, 7, 112, 2, 2, 203, 204, 7, 113, 2, 2,
+	204, 206, 7, 118, 2, 2, 205, 201, 3, 2, 2, 2, 205, 202, 3, 2, 2, 2, 206,
+	56, 3, 2, 2, 2, 207, 208, 7, 107, 2, 2, 208, 209, 7, 112, 2, 2, 209, 58,
+	3, 2, 2, 2, 210, 211, 7, 112, 2, 2, 211, 212, 7, 113, 2, 2, 212, 213, 7,
+	118, 2, 2, 213, 214, 7, 34, 2, 2, 214, 215, 7, 107, 2, 2, 215, 216, 7,
+	112, 2, 2, 216, 60, 3, 2, 2, 2, 217, 222, 7, 93, 2, 2, 218, 221, 5, 119,
+	60, 2, 219, 221, 5, 121, 61, 2, 220, 218, 3, 2, 2, 2, 220, 219, 3, 2, 2,
+	2, 221, 224, 3, 2, 2, 2, 222, 220, 3, 2, 2, 2, 222, 223, 3, 2, 2, 2, 223,
+	225, 3, 2, 2, 2, 224, 222, 3, 2, 2, 2, 225, 226, 7, 95, 2, 2, 226, 62, 3,
+	2, 2, 2, 227, 228, 7, 118, 2, 2, 228, 229, 7, 116, 2, 2, 229, 230, 7, 119,
+	2, 2, 230, 255, 7, 103, 2, 2, 231, 232, 7, 86, 2, 2, 232, 233, 7, 116, 2,
+	2, 233, 234, 7, 119, 2, 2, 234, 255, 7, 103, 2, 2, 235, 236, 7, 86, 2, 2,
+	236, 237, 7, 84, 2, 2, 237, 238, 7, 87, 2, 2, 238, 255, 7, 71, 2, 2, 239,
+	240, 7, 104, 2, 2, 240, 241, 7, 99, 2, 2, 241, 242, 7, 110, 2, 2, 242,
+	243, 7, 117, 2, 2, 243, 255, 7, 103, 2, 2, 244, 245, 7, 72, 2, 2, 245,
+	246, 7, 99, 2, 2, 246, 247, 7, 110, 2, 2, 247, 248, 7, 117, 2, 2, 248,
+	255, 7, 103, 2, 2, 249, 250, 7, 72, 2, 2, 250, 251, 7, 67, 2, 2, 251, 252,
+	7, 78, 2, 2, 252, 253, 7, 85, 2, 2, 253, 255, 7, 71, 2, 2, 254, 227, 3, 2,
+	2, 2, 254, 231, 3, 2, 2, 2, 254, 235, 3, 2, 2, 2, 254, 239, 3, 2, 2, 2,
+	254, 244, 3, 2, 2, 2, 254, 249, 3, 2, 2, 2, 255, 64, 3, 2, 2, 2, 256, 261,
+	5, 85, 43, 2, 257, 261, 5, 87, 44, 2, 258, 261, 5, 89, 45, 2, 259, 261, 5,
+	83, 42, 2, 260, 256, 3, 2, 2, 2, 260, 257, 3, 2, 2, 2, 260, 258, 3, 2, 2,
+	2, 260, 259, 3, 2, 2, 2, 261, 66, 3, 2, 2, 2, 262, 265, 5, 101, 51, 2,
+	263, 265, 5, 103, 52, 2, 264, 262, 3, 2, 2, 2, 264, 263, 3, 2, 2, 2, 265,
+	68, 3, 2, 2, 2, 266, 271, 5, 79, 40, 2, 267, 270, 5, 79, 40, 2, 268, 270,
+	5, 81, 41, 2, 269, 267, 3, 2, 2, 2, 269, 268, 3, 2, 2, 2, 270, 273, 3, 2,
+	2, 2, 271, 269, 3, 2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 70, 3, 2, 2, 2,
+	273, 271, 3, 2, 2, 2, 274, 276, 5, 73, 37, 2, 275, 274, 3, 2, 2, 2, 275,
+	276, 3, 2, 2, 2, 276, 277, 3, 2, 2, 2, 277, 279, 7, 36, 2, 2, 278, 280, 5,
+	75, 38, 2, 279, 278, 3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 281, 3, 2, 2,
+	2, 281, 282, 7, 36, 2, 2, 282, 72, 3, 2, 2, 2, 283, 284, 7, 119, 2, 2,
+	284, 287, 7, 58, 2, 2, 285, 287, 9, 2, 2, 2, 286, 283, 3, 2, 2, 2, 286,
+	285, 3, 2, 2, 2, 287, 74, 3, 2, 2, 2, 288, 290, 5, 77, 39, 2, 289, 288, 3,
+	2, 2, 2, 290, 291, 3, 2, 2, 2, 291, 289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2,
+	292, 76, 3, 2, 2, 2, 293, 301, 10, 3, 2, 2, 294, 301, 5, 117, 59, 2, 295,
+	296, 7, 94, 2, 2, 296, 301, 7, 12, 2, 2, 297, 298, 7, 94, 2, 2, 298, 299,
+	7, 15, 2, 2, 299, 301, 7, 12, 2, 2, 300, 293, 3, 2, 2, 2, 300, 294, 3, 2,
+	2, 2, 300, 295, 3, 2, 2, 2, 300, 297, 3, 2, 2, 2, 301, 78, 3, 2, 2, 2,
+	302, 303, 9, 4, 2, 2, 303, 80, 3, 2, 2, 2, 304, 305, 9, 5, 2, 2, 305, 82,
+	3, 2, 2, 2, 306, 307, 7, 50, 2, 2, 307, 309, 9, 6, 2, 2, 308, 310, 9, 7,
+	2, 2, 309, 308, 3, 2, 2, 2, 310, 311, 3, 2, 2, 2, 311, 309, 3, 2, 2, 2,
+	311, 312, 3, 2, 2, 2, 312, 84, 3, 2, 2, 2, 313, 317, 5, 91, 46, 2, 314,
+	316, 5, 81, 41, 2, 315, 314, 3, 2, 2, 2, 316, 319, 3, 2, 2, 2, 317, 315,
+	3, 2, 2, 2, 317, 318, 3, 2, 2, 2, 318, 86, 3, 2, 2, 2, 319, 317, 3, 2, 2,
+	2, 320, 324, 7, 50, 2, 2, 321, 323, 5, 93, 47, 2, 322, 321, 3, 2, 2, 2,
+	323, 326, 3, 2, 2, 2, 324, 322, 3, 2, 2, 2, 324, 325, 3, 2, 2, 2, 325, 88,
+	3, 2, 2, 2, 326, 324, 3, 2, 2, 2, 327, 328, 7, 50, 2, 2, 328, 329, 9, 8,
+	2, 2, 329, 330, 5, 113, 57, 2, 330, 90, 3, 2, 2, 2, 331, 332, 9, 9, 2, 2,
+	332, 92, 3, 2, 2, 2, 333, 334, 9, 10, 2, 2, 334, 94, 3, 2, 2, 2, 335, 336,
+	9, 11, 2, 2, 336, 96, 3, 2, 2, 2, 337, 338, 5, 95, 48, 2, 338, 339, 5, 95,
+	48, 2, 339, 340, 5, 95, 48, 2, 340, 341, 5, 95, 48, 2, 341, 98, 3, 2, 2,
+	2, 342, 343, 7, 94, 2, 2, 343, 344, 7, 119, 2, 2, 344, 345, 3, 2, 2, 2,
+	345, 353, 5, 97, 49, 2, 346, 347, 7, 94, 2, 2, 347, 348, 7, 87, 2, 2, 348,
+	349, 3, 2, 2, 2, 349, 350, 5, 97, 49, 2, 350, 351, 5, 97, 49, 2, 351, 353,
+	3, 2, 2, 2, 352, 342, 3, 2, 2, 2, 352, 346, 3, 2, 2, 2, 353, 100, 3, 2, 2,
+	2, 354, 356, 5, 105, 53, 2, 355, 357, 5, 107, 54, 2, 356, 355, 3, 2, 2, 2,
+	356, 357, 3, 2, 2, 2, 357, 362, 3, 2, 2, 2, 358, 359, 5, 109, 55, 2, 359,
+	360, 5, 107, 54, 2, 360, 362, 3, 2, 2, 2, 361, 354, 3, 2, 2, 2, 361, 358,
+	3, 2, 2, 2, 362, 102, 3, 2, 2, 2, 363, 364, 7, 50, 2, 2, 364, 367, 9, 8,
+	2, 2, 365, 368, 5, 111, 56, 2, 366, 368, 5, 113, 57, 2, 367, 365, 3, 2, 2,
+	2, 367, 366, 3, 2, 2, 2, 368, 369, 3, 2, 2, 2, 369, 370, 5, 115, 58, 2,
+	370, 104, 3, 2, 2, 2, 371, 373, 5, 109, 55, 2, 372, 371, 3, 2, 2, 2, 372,
+	373, 3, 2, 2, 2, 373, 374, 3, 2, 2, 2, 374, 375, 7, 48, 2, 2, 375, 380, 5,
+	109, 55, 2, 376, 377, 5, 109, 55, 2, 377, 378, 7, 48, 2, 2, 378, 380, 3,
+	2, 2, 2, 379, 372, 3, 2, 2, 2, 379, 376, 3, 2, 2, 2, 380, 106, 3, 2, 2, 2,
+	381, 383, 9, 12, 2, 2, 382, 384, 9, 13, 2, 2, 383, 382, 3, 2, 2, 2, 383,
+	384, 3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 386, 5, 109, 55, 2, 386, 108,
+	3, 2, 2, 2, 387, 389, 5, 81, 41, 2, 388, 387, 3, 2, 2, 2, 389, 390, 3, 2,
+	2, 2, 390, 388, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391, 110, 3, 2, 2, 2,
+	392, 394, 5, 113, 57, 2, 393, 392, 3, 2, 2, 2, 393, 394, 3, 2, 2, 2, 394,
+	395, 3, 2, 2, 2, 395, 396, 7, 48, 2, 2, 396, 401, 5, 113, 57, 2, 397, 398,
+	5, 113, 57, 2, 398, 399, 7, 48, 2, 2, 399, 401, 3, 2, 2, 2, 400, 393, 3,
+	2, 2, 2, 400, 397, 3, 2, 2, 2, 401, 112, 3, 2, 2, 2, 402, 404, 5, 95, 48,
+	2, 403, 402, 3, 2, 2, 2, 404, 405, 3, 2, 2, 2, 405, 403, 3, 2, 2, 2, 405,
+	406, 3, 2, 2, 2, 406, 114, 3, 2, 2, 2, 407, 409, 9, 14, 2, 2, 408, 410, 9,
+	13, 2, 2, 409, 408, 3, 2, 2, 2, 409, 410, 3, 2, 2, 2, 410, 411, 3, 2, 2,
+	2, 411, 412, 5, 109, 55, 2, 412, 116, 3, 2, 2, 2, 413, 414, 7, 94, 2, 2,
+	414, 429, 9, 15, 2, 2, 415, 416, 7, 94, 2, 2, 416, 418, 5, 93, 47, 2, 417,
+	419, 5, 93, 47, 2, 418, 417, 3, 2, 2, 2, 418, 419, 3, 2, 2, 2, 419, 421,
+	3, 2, 2, 2, 420, 422, 5, 93, 47, 2, 421, 420, 3, 2, 2, 2, 421, 422, 3, 2,
+	2, 2, 422, 429, 3, 2, 2, 2, 423, 424, 7, 94, 2, 2, 424, 425, 7, 122, 2, 2,
+	425, 426, 3, 2, 2, 2, 426, 429, 5, 113, 57, 2, 427, 429, 5, 99, 50, 2,
+	428, 413, 3, 2, 2, 2, 428, 415, 3, 2, 2, 2, 428, 423, 3, 2, 2, 2, 428,
+	427, 3, 2, 2, 2, 429, 118, 3, 2, 2, 2, 430, 432, 9, 16, 2, 2, 431, 430, 3,
+	2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 431, 3, 2, 2, 2, 433, 434, 3, 2, 2, 2,
+	434, 435, 3, 2, 2, 2, 435, 436, 8, 60, 2, 2, 436, 120, 3, 2, 2, 2, 437,
+	439, 7, 15, 2, 2, 438, 440, 7, 12, 2, 2, 439, 438, 3, 2, 2, 2, 439, 440,
+	3, 2, 2, 2, 440, 443, 3, 2, 2, 2, 441, 443, 7, 12, 2, 2, 442, 437, 3, 2,
+	2, 2, 442, 441, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 445, 8, 61, 2, 2,
+	445, 122, 3, 2, 2, 2, 446, 450, 5, 69, 35, 2, 447, 448, 7, 93, 2, 2, 448,
+	449, 5, 71, 36, 2, 449, 451, 7, 95, 2, 2, 450, 447, 3, 2, 2, 2, 451, 452,
+	3, 2, 2, 2, 452, 450, 3, 2, 2, 2, 452, 453, 3, 2, 2, 2, 453, 124, 3, 2, 2,
+	2, 454, 484, 3, 2, 2, 2, 456, 457, 7, 99, 2, 2, 457, 458, 7, 116, 2, 2,
+	458, 459, 7, 116, 2, 2, 459, 460, 7, 99, 2, 2, 460, 461, 7, 123, 2, 2,
+	461, 462, 7, 97, 2, 2, 462, 463, 7, 101, 2, 2, 463, 464, 7, 113, 2, 2,
+	464, 465, 7, 112, 2, 2, 465, 466, 7, 118, 2, 2, 466, 467, 7, 99, 2, 2,
+	467, 468, 7, 107, 2, 2, 468, 469, 7, 112, 2, 2, 469, 485, 7, 117, 2, 2,
+	470, 471, 7, 67, 2, 2, 471, 472, 7, 84, 2, 2, 472, 473, 7, 84, 2, 2, 473,
+	474, 7, 67, 2, 2, 474, 475, 7, 91, 2, 2, 475, 476, 7, 97, 2, 2, 476, 477,
+	7, 69, 2, 2, 477, 478, 7, 81, 2, 2, 478, 479, 7, 80, 2, 2, 479, 480, 7,
+	86, 2, 2, 480, 481, 7, 67, 2, 2, 481, 482, 7, 75, 2, 2, 482, 483, 7, 80,
+	2, 2, 483, 485, 7, 85, 2, 2, 484, 456, 3, 2, 2, 2, 484, 470, 3, 2, 2, 2,
+	485, 455, 3, 2, 2, 2, 486, 524, 3, 2, 2, 2, 488, 489, 7, 99, 2, 2, 489,
+	490, 7, 116, 2, 2, 490, 491, 7, 116, 2, 2, 491, 492, 7, 99, 2, 2, 492,
+	493, 7, 123, 2, 2, 493, 494, 7, 97, 2, 2, 494, 495, 7, 101, 2, 2, 495,
+	496, 7, 113, 2, 2, 496, 497, 7, 112, 2, 2, 497, 498, 7, 118, 2, 2, 498,
+	499, 7, 99, 2, 2, 499, 500, 7, 107, 2, 2, 500, 501, 7, 112, 2, 2, 501,
+	502, 7, 117, 2, 2, 502, 503, 7, 97, 2, 2, 503, 504, 7, 99, 2, 2, 504, 505,
+	7, 112, 2, 2, 505, 525, 7, 123, 2, 2, 506, 507, 7, 67, 2, 2, 507, 508, 7,
+	84, 2, 2, 508, 509, 7, 84, 2, 2, 509, 510, 7, 67, 2, 2, 510, 511, 7, 91,
+	2, 2, 511, 512, 7, 97, 2, 2, 512, 513, 7, 69, 2, 2, 513, 514, 7, 81, 2, 2,
+	514, 515, 7, 80, 2, 2, 515, 516, 7, 86, 2, 2, 516, 517, 7, 67, 2, 2, 517,
+	518, 7, 75, 2, 2, 518, 519, 7, 80, 2, 2, 519, 520, 7, 85, 2, 2, 520, 521,
+	7, 97, 2, 2, 521, 522, 7, 67, 2, 2, 522, 523, 7, 80, 2, 2, 523, 525, 7,
+	91, 2, 2, 524, 488, 3, 2, 2, 2, 524, 506, 3, 2, 2, 2, 525, 487, 3, 2, 2,
+	2, 43, 2, 159, 191, 197, 205, 220, 222, 254, 260, 264, 269, 271, 275, 279,
+	286, 291, 300, 311, 317, 324, 352, 356, 361, 367, 372, 379, 383, 390, 393,
+	400, 405, 409, 418, 421, 428, 433, 439, 442, 452, 484, 524, 3, 8, 2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -240,7 +272,7 @@ var lexerSymbolicNames = []string{
 	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
 	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
 	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"Newline", "JSONIdentifier", "ArrayContains", "ArrayContainsAny",
 }
 
 var lexerRuleNames = []string{
@@ -253,7 +285,7 @@ var lexerRuleNames = []string{
 	"HexQuad", "UniversalCharacterName", "DecimalFloatingConstant", "HexadecimalFloatingConstant",
 	"FractionalConstant", "ExponentPart", "DigitSequence", "HexadecimalFractionalConstant",
 	"HexadecimalDigitSequence", "BinaryExponentPart", "EscapeSequence", "Whitespace",
-	"Newline",
+	"Newline", "JSONIdentifier", "ArrayContains", "ArrayContainsAny",
 }
 
 type PlanLexer struct {
@@ -330,4 +362,7 @@ const (
 	PlanLexerStringLiteral    = 35
 	PlanLexerWhitespace       = 36
 	PlanLexerNewline          = 37
+	PlanLexerJSONIdentifier   = 38
+	PlanLexerArrayContains    = 39
+	PlanLexerArrayContainsAny = 40
 )
@@ -15,48 +15,59 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 39, 89, 4,
-	2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 5, 2, 17, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 71, 10, 2, 12,
-	2, 14, 2, 74, 11, 2, 3, 2, 5, 2, 77, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 7, 2, 84, 10, 2, 12, 2, 14, 2, 87, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 11,
-	4, 2, 15, 16, 28, 29, 3, 2, 17, 19, 3, 2, 15, 16, 3, 2, 21, 22, 3, 2, 8,
-	9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 30, 31, 2, 111, 2, 16,
-	3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 17, 7, 34, 2, 2, 6, 17, 7, 35, 2, 2, 7,
-	17, 7, 33, 2, 2, 8, 17, 7, 37, 2, 2, 9, 17, 7, 36, 2, 2, 10, 11, 7, 3,
-	2, 2, 11, 12, 5, 2, 2, 2, 12, 13, 7, 4, 2, 2, 13, 17, 3, 2, 2, 2, 14, 15,
-	9, 2, 2, 2, 15, 17, 5, 2, 2, 17, 16, 4, 3, 2, 2, 2, 16, 6, 3, 2, 2, 2,
-	16, 7, 3, 2, 2, 2, 16, 8, 3, 2, 2, 2, 16, 9, 3, 2, 2, 2, 16, 10, 3, 2,
-	2, 2, 16, 14, 3, 2, 2, 2, 17, 85, 3, 2, 2, 2, 18, 19, 12, 18, 2, 2, 19,
-	20, 7, 20, 2, 2, 20, 84, 5, 2, 2, 19, 21, 22, 12, 16, 2, 2, 22, 23, 9,
-	3, 2, 2, 23, 84, 5, 2, 2, 17, 24, 25, 12, 15, 2, 2, 25, 26, 9, 4, 2, 2,
-	26, 84, 5, 2, 2, 16, 27, 28, 12, 14, 2, 2, 28, 29, 9, 5, 2, 2, 29, 84,
-	5, 2, 2, 15, 30, 31, 12, 11, 2, 2, 31, 32, 9, 6, 2, 2, 32, 33, 7, 36, 2,
-	2, 33, 34, 9, 6, 2, 2, 34, 84, 5, 2, 2, 12, 35, 36, 12, 10, 2, 2, 36, 37,
-	9, 7, 2, 2, 37, 38, 7, 36, 2, 2, 38, 39, 9, 7, 2, 2, 39, 84, 5, 2, 2, 11,
-	40, 41, 12, 9, 2, 2, 41, 42, 9, 8, 2, 2, 42, 84, 5, 2, 2, 10, 43, 44, 12,
-	8, 2, 2, 44, 45, 9, 9, 2, 2, 45, 84, 5, 2, 2, 9, 46, 47, 12, 7, 2, 2, 47,
-	48, 7, 23, 2, 2, 48, 84, 5, 2, 2, 8, 49, 50, 12, 6, 2, 2, 50, 51, 7, 25,
-	2, 2, 51, 84, 5, 2, 2, 7, 52, 53, 12, 5, 2, 2, 53, 54, 7, 24, 2, 2, 54,
-	84, 5, 2, 2, 6, 55, 56, 12, 4, 2, 2, 56, 57, 7, 26, 2, 2, 57, 84, 5, 2,
-	2, 5, 58, 59, 12, 3, 2, 2, 59, 60, 7, 27, 2, 2, 60, 84, 5, 2, 2, 4, 61,
-	62, 12, 19, 2, 2, 62, 63, 7, 14, 2, 2, 63, 84, 7, 37, 2, 2, 64, 65, 12,
-	13, 2, 2, 65, 66, 9, 10, 2, 2, 66, 67, 7, 5, 2, 2, 67, 72, 5, 2, 2, 2,
-	68, 69, 7, 6, 2, 2, 69, 71, 5, 2, 2, 2, 70, 68, 3, 2, 2, 2, 71, 74, 3,
-	2, 2, 2, 72, 70, 3, 2, 2, 2, 72, 73, 3, 2, 2, 2, 73, 76, 3, 2, 2, 2, 74,
-	72, 3, 2, 2, 2, 75, 77, 7, 6, 2, 2, 76, 75, 3, 2, 2, 2, 76, 77, 3, 2, 2,
-	2, 77, 78, 3, 2, 2, 2, 78, 79, 7, 7, 2, 2, 79, 84, 3, 2, 2, 2, 80, 81,
-	12, 12, 2, 2, 81, 82, 9, 10, 2, 2, 82, 84, 7, 32, 2, 2, 83, 18, 3, 2, 2,
-	2, 83, 21, 3, 2, 2, 2, 83, 24, 3, 2, 2, 2, 83, 27, 3, 2, 2, 2, 83, 30,
-	3, 2, 2, 2, 83, 35, 3, 2, 2, 2, 83, 40, 3, 2, 2, 2, 83, 43, 3, 2, 2, 2,
-	83, 46, 3, 2, 2, 2, 83, 49, 3, 2, 2, 2, 83, 52, 3, 2, 2, 2, 83, 55, 3,
-	2, 2, 2, 83, 58, 3, 2, 2, 2, 83, 61, 3, 2, 2, 2, 83, 64, 3, 2, 2, 2, 83,
-	80, 3, 2, 2, 2, 84, 87, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 85, 86, 3, 2, 2,
-	2, 86, 3, 3, 2, 2, 2, 87, 85, 3, 2, 2, 2, 7, 16, 72, 76, 83, 85,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 42, 116, 4,
+	2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 5, 2, 18, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 72, 10, 2, 12, 2, 14, 2,
+	75, 11, 2, 3, 2, 5, 2, 78, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 85,
+	10, 2, 12, 2, 14, 2, 88, 11, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 106, 10, 2,
+	12, 2, 14, 2, 109, 11, 2, 3, 2, 5, 2, 112, 10, 2, 3, 2, 3, 2, 3, 2, 2, 3,
+	2, 3, 2, 2, 11, 4, 2, 15, 16, 28, 29, 3, 2, 17, 19, 3, 2, 15, 16, 3, 2,
+	21, 22, 3, 2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 30, 31,
+	2, 143, 2, 17, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 18, 7, 34, 2, 2, 6, 18, 7,
+	35, 2, 2, 7, 18, 7, 33, 2, 2, 8, 18, 7, 37, 2, 2, 9, 18, 7, 36, 2, 2, 10,
+	18, 7, 40, 2, 2, 11, 12, 7, 3, 2, 2, 12, 13, 5, 2, 2, 2, 13, 14, 7, 4, 2,
+	2, 14, 18, 3, 2, 2, 2, 15, 16, 9, 2, 2, 2, 16, 18, 5, 2, 2, 17, 17, 4, 3,
+	2, 2, 2, 17, 6, 3, 2, 2, 2, 17, 7, 3, 2, 2, 2, 17, 8, 3, 2, 2, 2, 17, 9,
+	3, 2, 2, 2, 17, 10, 3, 2, 2, 2, 17, 11, 3, 2, 2, 2, 17, 15, 3, 2, 2, 2,
+	17, 90, 3, 2, 2, 2, 17, 97, 3, 2, 2, 2, 18, 86, 3, 2, 2, 2, 19, 20, 12,
+	18, 2, 2, 20, 21, 7, 20, 2, 2, 21, 85, 5, 2, 2, 19, 22, 23, 12, 16, 2, 2,
+	23, 24, 9, 3, 2, 2, 24, 85, 5, 2, 2, 17, 25, 26, 12, 15, 2, 2, 26, 27, 9,
+	4, 2, 2, 27, 85, 5, 2, 2, 16, 28, 29, 12, 14, 2, 2, 29, 30, 9, 5, 2, 2,
+	30, 85, 5, 2, 2, 15, 31, 32, 12, 11, 2, 2, 32, 33, 9, 6, 2, 2, 33, 34, 7,
+	36, 2, 2, 34, 35, 9, 6, 2, 2, 35, 85, 5, 2, 2, 12, 36, 37, 12, 10, 2, 2,
+	37, 38, 9, 7, 2, 2, 38, 39, 7, 36, 2, 2, 39, 40, 9, 7, 2, 2, 40, 85, 5, 2,
+	2, 11, 41, 42, 12, 9, 2, 2, 42, 43, 9, 8, 2, 2, 43, 85, 5, 2, 2, 10, 44,
+	45, 12, 8, 2, 2, 45, 46, 9, 9, 2, 2, 46, 85, 5, 2, 2, 9, 47, 48, 12, 7, 2,
+	2, 48, 49, 7, 23, 2, 2, 49, 85, 5, 2, 2, 8, 50, 51, 12, 6, 2, 2, 51, 52,
+	7, 25, 2, 2, 52, 85, 5, 2, 2, 7, 53, 54, 12, 5, 2, 2, 54, 55, 7, 24, 2, 2,
+	55, 85, 5, 2, 2, 6, 56, 57, 12, 4, 2, 2, 57, 58, 7, 26, 2, 2, 58, 85, 5,
+	2, 2, 5, 59, 60, 12, 3, 2, 2, 60, 61, 7, 27, 2, 2, 61, 85, 5, 2, 2, 4, 62,
+	63, 12, 19, 2, 2, 63, 64, 7, 14, 2, 2, 64, 85, 7, 37, 2, 2, 65, 66, 12,
+	13, 2, 2, 66, 67, 9, 10, 2, 2, 67, 68, 7, 5, 2, 2, 68, 73, 5, 2, 2, 2, 69,
+	70, 7, 6, 2, 2, 70, 72, 5, 2, 2, 2, 71, 69, 3, 2, 2, 2, 72, 75, 3, 2, 2,
+	2, 73, 71, 3, 2, 2, 2, 73, 74, 3, 2, 2, 2, 74, 77, 3, 2, 2, 2, 75, 73, 3,
+	2, 2, 2, 76, 78, 7, 6, 2, 2, 77, 76, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78,
+	79, 3, 2, 2, 2, 79, 80, 7, 7, 2, 2, 80, 85, 3, 2, 2, 2, 81, 82, 12, 12, 2,
+	2, 82, 83, 9, 10, 2, 2, 83, 85, 7, 32, 2, 2, 84, 19, 3, 2, 2, 2, 84, 22,
+	3, 2, 2, 2, 84, 25, 3, 2, 2, 2, 84, 28, 3, 2, 2, 2, 84, 31, 3, 2, 2, 2,
+	84, 36, 3, 2, 2, 2, 84, 41, 3, 2, 2, 2, 84, 44, 3, 2, 2, 2, 84, 47, 3, 2,
+	2, 2, 84, 50, 3, 2, 2, 2, 84, 53, 3, 2, 2, 2, 84, 56, 3, 2, 2, 2, 84, 59,
+	3, 2, 2, 2, 84, 62, 3, 2, 2, 2, 84, 65, 3, 2, 2, 2, 84, 81, 3, 2, 2, 2,
+	85, 88, 3, 2, 2, 2, 86, 84, 3, 2, 2, 2, 86, 87, 3, 2, 2, 2, 87, 3, 3, 2,
+	2, 2, 88, 86, 3, 2, 2, 2, 90, 91, 7, 41, 2, 2, 91, 92, 7, 3, 2, 2, 92, 93,
+	5, 2, 2, 2, 93, 94, 7, 6, 2, 2, 94, 95, 5, 2, 2, 2, 95, 96, 7, 4, 2, 2,
+	96, 18, 3, 2, 2, 2, 97, 98, 7, 42, 2, 2, 98, 99, 7, 3, 2, 2, 99, 100, 5,
+	2, 2, 2, 100, 101, 7, 6, 2, 2, 101, 102, 7, 5, 2, 2, 102, 107, 5, 2, 2, 2,
+	103, 104, 7, 6, 2, 2, 104, 106, 5, 2, 2, 2, 105, 103, 3, 2, 2, 2, 106,
+	109, 3, 2, 2, 2, 107, 105, 3, 2, 2, 2, 107, 108, 3, 2, 2, 2, 108, 111, 3,
+	2, 2, 2, 109, 107, 3, 2, 2, 2, 110, 112, 7, 6, 2, 2, 111, 110, 3, 2, 2, 2,
+	111, 112, 3, 2, 2, 2, 112, 113, 3, 2, 2, 2, 113, 114, 7, 7, 2, 2, 114,
+	115, 7, 4, 2, 2, 115, 18, 3, 2, 2, 2, 9, 17, 73, 77, 84, 86, 107, 111,
 }
 var literalNames = []string{
 	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
@@ -68,7 +79,7 @@ var symbolicNames = []string{
 	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
 	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
 	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"Newline", "JSONIdentifier", "ArrayContains", "ArrayContainsAny",
 }
 
 var ruleNames = []string{
@@ -144,6 +155,9 @@ const (
 	PlanParserStringLiteral    = 35
 	PlanParserWhitespace       = 36
 	PlanParserNewline          = 37
+	PlanParserJSONIdentifier   = 38
+	PlanParserArrayContains    = 39
+	PlanParserArrayContainsAny = 40
 )
 
 // PlanParserRULE_expr is the PlanParser rule.
@@ -1028,6 +1042,148 @@ func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type JSONIdentifierContext struct {
+	*ExprContext
+}
+
+func NewJSONIdentifierContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JSONIdentifierContext {
+	var p = new(JSONIdentifierContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *JSONIdentifierContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JSONIdentifierContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
+func (s *JSONIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitJSONIdentifier(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ArrayContainsContext struct {
+	*ExprContext
+}
+
+func NewArrayContainsContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContainsContext {
+	var p = new(ArrayContainsContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ArrayContainsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArrayContainsContext) ArrayContains() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayContains, 0)
+}
+
+func (s *ArrayContainsContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArrayContainsContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ArrayContainsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitArrayContains(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ArrayContainsAnyContext struct {
+	*ExprContext
+}
+
+func NewArrayContainsAnyContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContainsAnyContext {
+	var p = new(ArrayContainsAnyContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ArrayContainsAnyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArrayContainsAnyContext) ArrayContainsAny() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayContainsAny, 0)
+}
+
+func (s *ArrayContainsAnyContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArrayContainsAnyContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ArrayContainsAnyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitArrayContainsAny(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type BitXorContext struct {
 	*ExprContext
 }
@@ -1478,7 +1634,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(14)
+	p.SetState(15)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -1528,20 +1684,29 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserIdentifier)
 		}
 
+	case PlanParserJSONIdentifier:
+		localctx = NewJSONIdentifierContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(8)
+			p.Match(PlanParserJSONIdentifier)
+		}
+
 	case PlanParserT__0:
 		localctx = NewParensContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(8)
+			p.SetState(9)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(9)
+			p.SetState(10)
 			p.expr(0)
 		}
 		{
-			p.SetState(10)
+			p.SetState(11)
 			p.Match(PlanParserT__1)
 		}
 
@@ -1550,7 +1715,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(12)
+			p.SetState(13)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -1568,15 +1733,112 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(13)
+			p.SetState(14)
 			p.expr(15)
 		}
 
+	case PlanParserArrayContains:
+		localctx = NewArrayContainsContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(88)
+			p.Match(PlanParserArrayContains)
+		}
+		{
+			p.SetState(89)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(90)
+			p.expr(0)
+		}
+		{
+			p.SetState(91)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(92)
+			p.expr(0)
+		}
+		{
+			p.SetState(93)
+			p.Match(PlanParserT__1)
+		}
+
+	case PlanParserArrayContainsAny:
+		localctx = NewArrayContainsAnyContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(95)
+			p.Match(PlanParserArrayContainsAny)
+		}
+		{
+			p.SetState(96)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(97)
+			p.expr(0)
+		}
+		{
+			p.SetState(98)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(99)
+			p.Match(PlanParserT__2)
+		}
+		{
+			p.SetState(100)
+			p.expr(0)
+		}
+		p.SetState(105)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(101)
+					p.Match(PlanParserT__3)
+				}
+				{
+					p.SetState(102)
+					p.expr(0)
+				}
+
+			}
+			p.SetState(107)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext())
+		}
+		p.SetState(109)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PlanParserT__3 {
+			{
+				p.SetState(108)
+				p.Match(PlanParserT__3)
+			}
+
+		}
+		{
+			p.SetState(111)
+			p.Match(PlanParserT__4)
+		}
+		{
+			p.SetState(112)
+			p.Match(PlanParserT__1)
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(83)
+	p.SetState(84)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
 
@@ -1586,36 +1848,36 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(81)
+			p.SetState(82)
 			p.GetErrorHandler().Sync(p)
 			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewPowerContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(16)
+				p.SetState(17)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
-					p.SetState(17)
+					p.SetState(18)
 					p.Match(PlanParserPOW)
 				}
 				{
-					p.SetState(18)
+					p.SetState(19)
 					p.expr(17)
 				}
 
 			case 2:
 				localctx = NewMulDivModContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(19)
+				p.SetState(20)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
 				}
 				{
-					p.SetState(20)
+					p.SetState(21)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1633,20 +1895,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(21)
+					p.SetState(22)
 					p.expr(15)
 				}
 
 			case 3:
 				localctx = NewAddSubContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(22)
+				p.SetState(23)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 				}
 				{
-					p.SetState(23)
+					p.SetState(24)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1664,20 +1926,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(24)
+					p.SetState(25)
 					p.expr(14)
 				}
 
 			case 4:
 				localctx = NewShiftContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(25)
+				p.SetState(26)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 				}
 				{
-					p.SetState(26)
+					p.SetState(27)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1695,20 +1957,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(27)
+					p.SetState(28)
 					p.expr(13)
 				}
 
 			case 5:
 				localctx = NewRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(28)
+				p.SetState(29)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(29)
+					p.SetState(30)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1726,11 +1988,11 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(30)
+					p.SetState(31)
 					p.Match(PlanParserIdentifier)
 				}
 				{
-					p.SetState(31)
+					p.SetState(32)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1748,20 +2010,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(32)
+					p.SetState(33)
 					p.expr(10)
 				}
 
 			case 6:
 				localctx = NewReverseRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(33)
+				p.SetState(34)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(34)
+					p.SetState(35)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1779,11 +2041,11 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(35)
+					p.SetState(36)
 					p.Match(PlanParserIdentifier)
 				}
 				{
-					p.SetState(36)
+					p.SetState(37)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1801,20 +2063,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(37)
+					p.SetState(38)
 					p.expr(9)
 				}
 
 			case 7:
 				localctx = NewRelationalContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(38)
+				p.SetState(39)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(39)
+					p.SetState(40)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1832,20 +2094,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(40)
+					p.SetState(41)
 					p.expr(8)
 				}
 
 			case 8:
 				localctx = NewEqualityContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(41)
+				p.SetState(42)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(42)
+					p.SetState(43)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1863,122 +2125,122 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(43)
+					p.SetState(44)
 					p.expr(7)
 				}
 
 			case 9:
 				localctx = NewBitAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(44)
+				p.SetState(45)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(45)
+					p.SetState(46)
 					p.Match(PlanParserBAND)
 				}
 				{
-					p.SetState(46)
+					p.SetState(47)
 					p.expr(6)
 				}
 
 			case 10:
 				localctx = NewBitXorContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(47)
+				p.SetState(48)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(48)
+					p.SetState(49)
 					p.Match(PlanParserBXOR)
 				}
 				{
-					p.SetState(49)
+					p.SetState(50)
 					p.expr(5)
 				}
 
 			case 11:
 				localctx = NewBitOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(50)
+				p.SetState(51)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(51)
+					p.SetState(52)
 					p.Match(PlanParserBOR)
 				}
 				{
-					p.SetState(52)
+					p.SetState(53)
 					p.expr(4)
 				}
 
 			case 12:
 				localctx = NewLogicalAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(53)
+				p.SetState(54)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(54)
+					p.SetState(55)
 					p.Match(PlanParserAND)
 				}
 				{
-					p.SetState(55)
+					p.SetState(56)
 					p.expr(3)
 				}
 
 			case 13:
 				localctx = NewLogicalOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(56)
+				p.SetState(57)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(57)
+					p.SetState(58)
 					p.Match(PlanParserOR)
 				}
 				{
-					p.SetState(58)
+					p.SetState(59)
 					p.expr(2)
 				}
 
 			case 14:
 				localctx = NewLikeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(59)
+				p.SetState(60)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
 				}
 				{
-					p.SetState(60)
+					p.SetState(61)
 					p.Match(PlanParserLIKE)
 				}
 				{
-					p.SetState(61)
+					p.SetState(62)
 					p.Match(PlanParserStringLiteral)
 				}
 
 			case 15:
 				localctx = NewTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(62)
+				p.SetState(63)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 				}
 				{
-					p.SetState(63)
+					p.SetState(64)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1997,59 +2259,59 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 
 				{
-					p.SetState(64)
+					p.SetState(65)
 					p.Match(PlanParserT__2)
 				}
 				{
-					p.SetState(65)
+					p.SetState(66)
 					p.expr(0)
 				}
-				p.SetState(70)
+				p.SetState(71)
 				p.GetErrorHandler().Sync(p)
 				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
 
 				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 					if _alt == 1 {
 						{
-							p.SetState(66)
+							p.SetState(67)
 							p.Match(PlanParserT__3)
 						}
 						{
-							p.SetState(67)
+							p.SetState(68)
 							p.expr(0)
 						}
 
 					}
-					p.SetState(72)
+					p.SetState(73)
 					p.GetErrorHandler().Sync(p)
 					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
 				}
-				p.SetState(74)
+				p.SetState(75)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == PlanParserT__3 {
 					{
-						p.SetState(73)
+						p.SetState(74)
 						p.Match(PlanParserT__3)
 					}
 
 				}
 				{
-					p.SetState(76)
+					p.SetState(77)
 					p.Match(PlanParserT__4)
 				}
 
 			case 16:
 				localctx = NewEmptyTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(78)
+				p.SetState(79)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(79)
+					p.SetState(80)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2067,14 +2329,14 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(80)
+					p.SetState(81)
 					p.Match(PlanParserEmptyTerm)
 				}
 
 			}
 
 		}
-		p.SetState(85)
+		p.SetState(86)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
 	}
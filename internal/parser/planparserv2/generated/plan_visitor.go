@@ -52,6 +52,15 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Identifier.
 	VisitIdentifier(ctx *IdentifierContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#JSONIdentifier.
+	VisitJSONIdentifier(ctx *JSONIdentifierContext) interface{}
+
+	// Visit a parse tree produced by PlanParser#ArrayContains.
+	VisitArrayContains(ctx *ArrayContainsContext) interface{}
+
+	// Visit a parse tree produced by PlanParser#ArrayContainsAny.
+	VisitArrayContainsAny(ctx *ArrayContainsAnyContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#BitXor.
 	VisitBitXor(ctx *BitXorContext) interface{}
 
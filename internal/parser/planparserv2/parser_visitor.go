@@ -27,7 +27,19 @@ func (v *ParserVisitor) VisitParens(ctx *parser.ParensContext) interface{} {
 func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, error) {
 	field, err := v.schema.GetFieldFromName(identifier)
 	if err != nil {
-		return nil, err
+		// an undeclared field of a dynamic schema refers to the key in the dynamic field.
+		dynamicField, dynamicErr := v.schema.GetDynamicField()
+		if dynamicErr != nil {
+			return nil, err
+		}
+		return v.translateDynamicIdentifier(dynamicField, identifier), nil
+	}
+	var elementType schemapb.DataType
+	if typeutil.IsArrayType(field.DataType) {
+		elementType, err = typeutil.GetElementType(field)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &ExprWithType{
 		expr: &planpb.Expr{
@@ -38,6 +50,7 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 						DataType:     field.DataType,
 						IsPrimaryKey: field.IsPrimaryKey,
 						IsAutoID:     field.AutoID,
+						ElementType:  elementType,
 					},
 				},
 			},
@@ -46,6 +59,23 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 	}, nil
 }
 
+func (v *ParserVisitor) translateDynamicIdentifier(dynamicField *schemapb.FieldSchema, identifier string) *ExprWithType {
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ColumnExpr{
+				ColumnExpr: &planpb.ColumnExpr{
+					Info: &planpb.ColumnInfo{
+						FieldId:    dynamicField.FieldID,
+						DataType:   dynamicField.DataType,
+						NestedPath: []string{identifier},
+					},
+				},
+			},
+		},
+		dataType: dynamicField.DataType,
+	}
+}
+
 // VisitIdentifier translates expr to column plan.
 func (v *ParserVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface{} {
 	identifier := ctx.Identifier().GetText()
@@ -56,6 +86,24 @@ func (v *ParserVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface
 	return expr
 }
 
+// VisitJSONIdentifier translates expr to column plan with the nested path inside the JSON field.
+func (v *ParserVisitor) VisitJSONIdentifier(ctx *parser.JSONIdentifierContext) interface{} {
+	fieldName, keys, err := parseJSONIdentifier(ctx.JSONIdentifier().GetText())
+	if err != nil {
+		return err
+	}
+	expr, err := v.translateIdentifier(fieldName)
+	if err != nil {
+		return err
+	}
+	if !typeutil.IsJSONType(expr.dataType) {
+		return fmt.Errorf("nested path can only be used on JSON field, but got: %s", fieldName)
+	}
+	columnInfo := toColumnInfo(expr)
+	columnInfo.NestedPath = append(columnInfo.NestedPath, keys...)
+	return expr
+}
+
 // VisitBoolean translates expr to GenericValue.
 func (v *ParserVisitor) VisitBoolean(ctx *parser.BooleanContext) interface{} {
 	literal := ctx.BooleanConstant().GetText()
@@ -395,7 +443,7 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return fmt.Errorf("the left operand of like is invalid")
 	}
 
-	if !typeutil.IsStringType(leftExpr.dataType) {
+	if !typeutil.IsStringType(leftExpr.dataType) && !typeutil.IsJSONType(leftExpr.dataType) {
 		return fmt.Errorf("like operation on non-string field is unsupported")
 	}
 
@@ -490,6 +538,64 @@ func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 	}
 }
 
+// VisitArrayContains translates expr to array contains plan.
+func (v *ParserVisitor) VisitArrayContains(ctx *parser.ArrayContainsContext) interface{} {
+	return v.translateArrayContains(planpb.ArrayContainsExpr_Contains, ctx.AllExpr())
+}
+
+// VisitArrayContainsAny translates expr to array contains any plan.
+func (v *ParserVisitor) VisitArrayContainsAny(ctx *parser.ArrayContainsAnyContext) interface{} {
+	return v.translateArrayContains(planpb.ArrayContainsExpr_ContainsAny, ctx.AllExpr())
+}
+
+func (v *ParserVisitor) translateArrayContains(op planpb.ArrayContainsExpr_ArrayOp, allExpr []parser.IExprContext) interface{} {
+	child := allExpr[0].Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	if childValue := getGenericValue(child); childValue != nil {
+		return fmt.Errorf("'array_contains' can only be used on non-const expression, but got: %s", allExpr[0].GetText())
+	}
+
+	childExpr := getExpr(child)
+	columnInfo := toColumnInfo(childExpr)
+	if columnInfo == nil || !typeutil.IsArrayType(childExpr.dataType) {
+		return fmt.Errorf("'array_contains' can only be used on array field, but got: %s", allExpr[0].GetText())
+	}
+
+	elements := make([]*planpb.GenericValue, 0, len(allExpr)-1)
+	for _, elementExpr := range allExpr[1:] {
+		element := elementExpr.Accept(v)
+		if getError(element) != nil {
+			return element
+		}
+		n := getGenericValue(element)
+		if n == nil {
+			return fmt.Errorf("element '%s' cannot be a non-const expression", elementExpr.GetText())
+		}
+		castedValue, err := castValue(columnInfo.GetElementType(), n)
+		if err != nil {
+			return fmt.Errorf("element '%s' cannot be casted to %s", elementExpr.GetText(), columnInfo.GetElementType().String())
+		}
+		elements = append(elements, castedValue)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_ArrayContainsExpr{
+			ArrayContainsExpr: &planpb.ArrayContainsExpr{
+				ColumnInfo: columnInfo,
+				Elements:   elements,
+				Op:         op,
+			},
+		},
+	}
+	return &ExprWithType{
+		expr:     expr,
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 // VisitEmptyTerm translates expr to term plan.
 func (v *ParserVisitor) VisitEmptyTerm(ctx *parser.EmptyTermContext) interface{} {
 	child := ctx.Expr().Accept(v)
@@ -4,6 +4,8 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +26,17 @@ func newTestSchema() *schemapb.CollectionSchema {
 		}
 		fields = append(fields, newField)
 	}
+	fields = append(fields, &schemapb.FieldSchema{
+		FieldID: int64(100 + typeutil.DataTypeJSON), Name: "JSONField", IsPrimaryKey: false, Description: "", DataType: typeutil.DataTypeJSON,
+	})
+	fields = append(fields, &schemapb.FieldSchema{
+		FieldID: int64(100 + typeutil.DataTypeArray), Name: "ArrayField", IsPrimaryKey: false, Description: "", DataType: typeutil.DataTypeArray,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()}},
+	})
+	fields = append(fields, &schemapb.FieldSchema{
+		FieldID: int64(200 + typeutil.DataTypeArray), Name: "StringArrayField", IsPrimaryKey: false, Description: "", DataType: typeutil.DataTypeArray,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: schemapb.DataType_VarChar.String()}},
+	})
 
 	return &schemapb.CollectionSchema{
 		Name:        "test",
@@ -236,6 +249,128 @@ func TestExpr_Identifier(t *testing.T) {
 	}
 }
 
+func TestExpr_JSON(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr := handleExpr(helper, `JSONField["a"]["b"]`)
+	columnInfo := toColumnInfo(getExpr(expr))
+	assert.NotNil(t, columnInfo)
+	assert.Equal(t, typeutil.DataTypeJSON, columnInfo.GetDataType())
+	assert.Equal(t, []string{"a", "b"}, columnInfo.GetNestedPath())
+
+	expr = handleExpr(helper, `JSONField["a]\"b"]`)
+	assert.Equal(t, []string{`a]"b`}, toColumnInfo(getExpr(expr)).GetNestedPath())
+
+	exprStrs := []string{
+		`JSONField["A"] > 3`,
+		`JSONField["A"] <= 3.5`,
+		`JSONField["A"] == "abc"`,
+		`JSONField["A"] != true`,
+		`10 < JSONField["A"]["B"]`,
+		`JSONField["A"] in [1, "abc", 2.5]`,
+		`JSONField["A"] not in []`,
+		`JSONField["A"] like "abc%"`,
+		`JSONField["A"] > 3 && Int64Field < 10`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`Int64Field["A"] > 3`,
+		`NotExistField["A"] > 3`,
+		`JSONField["A"] > Int64Field`,
+		`JSONField["A"] > JSONField["B"]`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_ArrayContains(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `array_contains(ArrayField, 1)`)
+	assert.NoError(t, err)
+	arrayContains := expr.GetArrayContainsExpr()
+	assert.NotNil(t, arrayContains)
+	assert.Equal(t, planpb.ArrayContainsExpr_Contains, arrayContains.GetOp())
+	assert.Equal(t, schemapb.DataType_Int64, arrayContains.GetColumnInfo().GetElementType())
+	assert.Equal(t, 1, len(arrayContains.GetElements()))
+
+	expr, err = ParseExpr(helper, `ARRAY_CONTAINS_ANY(StringArrayField, ["a", "b", "c",])`)
+	assert.NoError(t, err)
+	arrayContains = expr.GetArrayContainsExpr()
+	assert.NotNil(t, arrayContains)
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAny, arrayContains.GetOp())
+	assert.Equal(t, 3, len(arrayContains.GetElements()))
+	assert.Equal(t, "c", arrayContains.GetElements()[2].GetStringVal())
+
+	exprStrs := []string{
+		`array_contains(ArrayField, -1)`,
+		`array_contains_any(ArrayField, [1])`,
+		`array_contains_any(ArrayField, [1, 2, 3])`,
+		`array_contains(StringArrayField, "abc") && Int64Field > 10`,
+		`not array_contains(ArrayField, 1) || array_contains_any(StringArrayField, ["x", "y"])`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`array_contains(Int64Field, 1)`,
+		`array_contains(ArrayField, "abc")`,
+		`array_contains(ArrayField, Int64Field)`,
+		`array_contains(1, 1)`,
+		`array_contains_any(StringArrayField, [1, 2])`,
+		`array_contains_any(ArrayField, [])`,
+		`array_contains_any(ArrayField, 1)`,
+		`ArrayField > 1`,
+		`ArrayField in [1, 2]`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_DynamicField(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 300, Name: common.MetaFieldName, IsPrimaryKey: false, Description: "dynamic schema", DataType: typeutil.DataTypeJSON,
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr := handleExpr(helper, `A`)
+	columnInfo := toColumnInfo(getExpr(expr))
+	assert.NotNil(t, columnInfo)
+	assert.Equal(t, int64(300), columnInfo.GetFieldId())
+	assert.Equal(t, []string{"A"}, columnInfo.GetNestedPath())
+
+	expr = handleExpr(helper, `A["B"]`)
+	assert.Equal(t, []string{"A", "B"}, toColumnInfo(getExpr(expr)).GetNestedPath())
+
+	// declared fields are not affected
+	expr = handleExpr(helper, `Int64Field`)
+	assert.Equal(t, schemapb.DataType_Int64, toColumnInfo(getExpr(expr)).GetDataType())
+
+	exprStrs := []string{
+		`A > 3`,
+		`A["B"] == "abc"`,
+		`A in [1, 2, 3]`,
+		`A like "abc%"`,
+		`1 < A < 10`,
+		`A > 3 && Int64Field < 10`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_Constant(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -59,6 +59,8 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_ArrayContainsExpr:
+		js["expr"] = v.VisitArrayContainsExpr(realExpr.ArrayContainsExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -77,6 +79,19 @@ func (v *ShowExprVisitor) VisitTermExpr(expr *planpb.TermExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitArrayContainsExpr(expr *planpb.ArrayContainsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "array_contains"
+	js["op"] = expr.Op.String()
+	js["column_info"] = extractColumnInfo(expr.ColumnInfo)
+	elements := make([]interface{}, 0, len(expr.Elements))
+	for _, v := range expr.Elements {
+		elements = append(elements, extractGenericValue(v))
+	}
+	js["elements"] = elements
+	return js
+}
+
 func (v *ShowExprVisitor) VisitUnaryExpr(expr *planpb.UnaryExpr) interface{} {
 	js := make(map[string]interface{})
 	js["expr_type"] = expr.Op.String()
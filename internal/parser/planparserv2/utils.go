@@ -2,6 +2,8 @@ package planparserv2
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -155,6 +157,11 @@ func toColumnInfo(left *ExprWithType) *planpb.ColumnInfo {
 }
 
 func castValue(dataType schemapb.DataType, value *planpb.GenericValue) (*planpb.GenericValue, error) {
+	// the type of a value inside a JSON document is only known at execution time.
+	if typeutil.IsJSONType(dataType) {
+		return value, nil
+	}
+
 	if typeutil.IsStringType(dataType) && IsString(value) {
 		return value, nil
 	}
@@ -288,6 +295,10 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 		return nil, fmt.Errorf("only comparison between two fields is supported")
 	}
 
+	if typeutil.IsJSONType(left.dataType) || typeutil.IsJSONType(right.dataType) {
+		return nil, fmt.Errorf("comparison between JSON field and other fields is not supported")
+	}
+
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_CompareExpr{
 			CompareExpr: &planpb.CompareExpr{
@@ -307,6 +318,9 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 }
 
 func relationalCompatible(t1, t2 schemapb.DataType) bool {
+	if typeutil.IsJSONType(t1) || typeutil.IsJSONType(t2) {
+		return true
+	}
 	both := typeutil.IsStringType(t1) && typeutil.IsStringType(t2)
 	neither := !typeutil.IsStringType(t1) && !typeutil.IsStringType(t2)
 	return both || neither
@@ -330,3 +344,37 @@ func HandleCompare(op int, left, right *ExprWithType) (*planpb.Expr, error) {
 		return handleCompare(cmpOp, left, right)
 	}
 }
+
+// parseJSONIdentifier splits `field["key1"]["key2"]` into the field name and the nested keys.
+func parseJSONIdentifier(identifier string) (string, []string, error) {
+	start := strings.Index(identifier, "[")
+	if start <= 0 {
+		return "", nil, fmt.Errorf("invalid JSON identifier: %s", identifier)
+	}
+
+	fieldName := identifier[:start]
+	keys := make([]string, 0)
+	rest := identifier[start:]
+	for len(rest) > 0 {
+		if rest[0] != '[' {
+			return "", nil, fmt.Errorf("invalid JSON identifier: %s", identifier)
+		}
+		quoted, err := strconv.QuotedPrefix(rest[1:])
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid JSON identifier: %s", identifier)
+		}
+		rest = rest[1+len(quoted):]
+		if len(rest) == 0 || rest[0] != ']' {
+			return "", nil, fmt.Errorf("invalid JSON identifier: %s", identifier)
+		}
+		rest = rest[1:]
+
+		key, err := strconv.Unquote(quoted)
+		if err != nil {
+			return "", nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return fieldName, keys, nil
+}
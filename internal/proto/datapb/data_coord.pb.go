@@ -108,18 +108,22 @@ const (
 	CompactionType_UndefinedCompaction CompactionType = 0
 	CompactionType_MergeCompaction     CompactionType = 2
 	CompactionType_MixCompaction       CompactionType = 3
+	// ClusteringCompaction redistributes the rows of the segments into new segments by ranges of the clustering key
+	CompactionType_ClusteringCompaction CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"MergeCompaction":     2,
-	"MixCompaction":       3,
+	"UndefinedCompaction":  0,
+	"MergeCompaction":      2,
+	"MixCompaction":        3,
+	"ClusteringCompaction": 4,
 }
 
 func (x CompactionType) String() string {
@@ -1600,8 +1604,10 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk insert, and
 	// (2) the bulk insert task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
-	IsFake               bool     `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	IsImporting bool `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsFake      bool `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	// the segment is generated by clustering compaction, all its rows are in one range of the clustering key
+	IsClustered          bool     `protobuf:"varint,19,opt,name=is_clustered,json=isClustered,proto3" json:"is_clustered,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1758,6 +1764,13 @@ func (m *SegmentInfo) GetIsFake() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetIsClustered() bool {
+	if m != nil {
+		return m.IsClustered
+	}
+	return false
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -2967,14 +2980,16 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID        int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo   int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows     int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs     []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	// the target segments of a clustering compaction, compacted_to is the first one of them
+	CompactedToSegments  []*CompactionSegment `protobuf:"bytes,6,rep,name=compacted_to_segments,json=compactedToSegments,proto3" json:"compacted_to_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
@@ -3037,6 +3052,13 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetCompactedToSegments() []*CompactionSegment {
+	if m != nil {
+		return m.CompactedToSegments
+	}
+	return nil
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
@@ -3109,18 +3131,21 @@ func (m *CompactionSegmentBinlogs) GetInsertChannel() string {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	CollectionTtl        int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
-	TotalRows            int64                       `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID                  int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs          []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime               uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds        int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type                    CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel              uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel                 string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionTtl           int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
+	TotalRows               int64                       `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ClusteringKeyField      int64                       `protobuf:"varint,10,opt,name=clustering_key_field,json=clusteringKeyField,proto3" json:"clustering_key_field,omitempty"`
+	MaxSegmentRows          int64                       `protobuf:"varint,11,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
+	ClusteringKeyBoundaries *schemapb.ScalarField       `protobuf:"bytes,12,opt,name=clustering_key_boundaries,json=clusteringKeyBoundaries,proto3" json:"clustering_key_boundaries,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                    `json:"-"`
+	XXX_unrecognized        []byte                      `json:"-"`
+	XXX_sizecache           int32                       `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
@@ -3211,24 +3236,118 @@ func (m *CompactionPlan) GetTotalRows() int64 {
 	return 0
 }
 
-type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Channel              string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+func (m *CompactionPlan) GetClusteringKeyField() int64 {
+	if m != nil {
+		return m.ClusteringKeyField
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetClusteringKeyBoundaries() *schemapb.ScalarField {
+	if m != nil {
+		return m.ClusteringKeyBoundaries
+	}
+	return nil
+}
+
+type CompactionSegment struct {
+	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
+func (m *CompactionSegment) Reset()         { *m = CompactionSegment{} }
+func (m *CompactionSegment) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegment) ProtoMessage()    {}
+func (*CompactionSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{49}
+}
+
+func (m *CompactionSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegment.Unmarshal(m, b)
+}
+func (m *CompactionSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegment.Marshal(b, m, deterministic)
+}
+func (m *CompactionSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegment.Merge(m, src)
+}
+func (m *CompactionSegment) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegment.Size(m)
+}
+func (m *CompactionSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionSegment proto.InternalMessageInfo
+
+func (m *CompactionSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetDeltalogs() []*FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+type CompactionResult struct {
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Channel             string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the output segments of a clustering compaction
+	Segments             []*CompactionSegment `protobuf:"bytes,8,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -3298,6 +3417,13 @@ func (m *CompactionResult) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionResult) GetSegments() []*CompactionSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -3311,7 +3437,7 @@ func (m *CompactionStateResult) Reset()         { *m = CompactionStateResult{} }
 func (m *CompactionStateResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResult) ProtoMessage()    {}
 func (*CompactionStateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{50}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *CompactionStateResult) XXX_Unmarshal(b []byte) error {
@@ -3365,7 +3491,7 @@ func (m *CompactionStateResponse) Reset()         { *m = CompactionStateResponse
 func (m *CompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResponse) ProtoMessage()    {}
 func (*CompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{51}
+	return fileDescriptor_82cd95f524594f49, []int{52}
 }
 
 func (m *CompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3413,7 +3539,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{52}
+	return fileDescriptor_82cd95f524594f49, []int{53}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -3462,7 +3588,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{53}
+	return fileDescriptor_82cd95f524594f49, []int{54}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3522,7 +3648,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{54}
+	return fileDescriptor_82cd95f524594f49, []int{55}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3563,7 +3689,7 @@ func (m *SetSegmentStateRequest) Reset()         { *m = SetSegmentStateRequest{}
 func (m *SetSegmentStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateRequest) ProtoMessage()    {}
 func (*SetSegmentStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{55}
+	return fileDescriptor_82cd95f524594f49, []int{56}
 }
 
 func (m *SetSegmentStateRequest) XXX_Unmarshal(b []byte) error {
@@ -3616,7 +3742,7 @@ func (m *SetSegmentStateResponse) Reset()         { *m = SetSegmentStateResponse
 func (m *SetSegmentStateResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateResponse) ProtoMessage()    {}
 func (*SetSegmentStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{56}
+	return fileDescriptor_82cd95f524594f49, []int{57}
 }
 
 func (m *SetSegmentStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3657,7 +3783,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{57}
+	return fileDescriptor_82cd95f524594f49, []int{58}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3717,7 +3843,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{58}
+	return fileDescriptor_82cd95f524594f49, []int{59}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3805,7 +3931,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{59}
+	return fileDescriptor_82cd95f524594f49, []int{60}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3851,7 +3977,7 @@ func (m *ImportTask) Reset()         { *m = ImportTask{} }
 func (m *ImportTask) String() string { return proto.CompactTextString(m) }
 func (*ImportTask) ProtoMessage()    {}
 func (*ImportTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{60}
+	return fileDescriptor_82cd95f524594f49, []int{61}
 }
 
 func (m *ImportTask) XXX_Unmarshal(b []byte) error {
@@ -3943,7 +4069,7 @@ func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
 func (m *ImportTaskState) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskState) ProtoMessage()    {}
 func (*ImportTaskState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{61}
+	return fileDescriptor_82cd95f524594f49, []int{62}
 }
 
 func (m *ImportTaskState) XXX_Unmarshal(b []byte) error {
@@ -4024,7 +4150,7 @@ func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
 func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskInfo) ProtoMessage()    {}
 func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{62}
+	return fileDescriptor_82cd95f524594f49, []int{63}
 }
 
 func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
@@ -4163,7 +4289,7 @@ func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
 func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskResponse) ProtoMessage()    {}
 func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{63}
+	return fileDescriptor_82cd95f524594f49, []int{64}
 }
 
 func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -4211,7 +4337,7 @@ func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
 func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskRequest) ProtoMessage()    {}
 func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{64}
+	return fileDescriptor_82cd95f524594f49, []int{65}
 }
 
 func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -4253,6 +4379,205 @@ func (m *ImportTaskRequest) GetWorkingNodes() []int64 {
 	return nil
 }
 
+// ExportSegmentTask writes the entities of a flushed segment visible at a timestamp into files,
+// it's the piece of an export task that runs on a DataNode.
+type ExportSegmentTask struct {
+	TaskId               int64                      `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CollectionId         int64                      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Segment              *SegmentBinlogs            `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	Path                 string                     `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Format               string                     `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	Timestamp            uint64                     `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expr                 string                     `protobuf:"bytes,8,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ExportSegmentTask) Reset()         { *m = ExportSegmentTask{} }
+func (m *ExportSegmentTask) String() string { return proto.CompactTextString(m) }
+func (*ExportSegmentTask) ProtoMessage()    {}
+func (*ExportSegmentTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{66}
+}
+
+func (m *ExportSegmentTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSegmentTask.Unmarshal(m, b)
+}
+func (m *ExportSegmentTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSegmentTask.Marshal(b, m, deterministic)
+}
+func (m *ExportSegmentTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSegmentTask.Merge(m, src)
+}
+func (m *ExportSegmentTask) XXX_Size() int {
+	return xxx_messageInfo_ExportSegmentTask.Size(m)
+}
+func (m *ExportSegmentTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSegmentTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSegmentTask proto.InternalMessageInfo
+
+func (m *ExportSegmentTask) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportSegmentTask) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportSegmentTask) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *ExportSegmentTask) GetSegment() *SegmentBinlogs {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+func (m *ExportSegmentTask) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportSegmentTask) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportSegmentTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportSegmentTask) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type ExportSegmentResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportSegmentResponse) Reset()         { *m = ExportSegmentResponse{} }
+func (m *ExportSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportSegmentResponse) ProtoMessage()    {}
+func (*ExportSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{67}
+}
+
+func (m *ExportSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSegmentResponse.Unmarshal(m, b)
+}
+func (m *ExportSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSegmentResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSegmentResponse.Merge(m, src)
+}
+func (m *ExportSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportSegmentResponse.Size(m)
+}
+func (m *ExportSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSegmentResponse proto.InternalMessageInfo
+
+func (m *ExportSegmentResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportSegmentResponse) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+type ExportSegmentRequest struct {
+	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ExportTask           *ExportSegmentTask `protobuf:"bytes,2,opt,name=export_task,json=exportTask,proto3" json:"export_task,omitempty"`
+	WorkingNodes         []int64            `protobuf:"varint,3,rep,packed,name=working_nodes,json=workingNodes,proto3" json:"working_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportSegmentRequest) Reset()         { *m = ExportSegmentRequest{} }
+func (m *ExportSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportSegmentRequest) ProtoMessage()    {}
+func (*ExportSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{68}
+}
+
+func (m *ExportSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSegmentRequest.Unmarshal(m, b)
+}
+func (m *ExportSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSegmentRequest.Merge(m, src)
+}
+func (m *ExportSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportSegmentRequest.Size(m)
+}
+func (m *ExportSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSegmentRequest proto.InternalMessageInfo
+
+func (m *ExportSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportSegmentRequest) GetExportTask() *ExportSegmentTask {
+	if m != nil {
+		return m.ExportTask
+	}
+	return nil
+}
+
+func (m *ExportSegmentRequest) GetWorkingNodes() []int64 {
+	if m != nil {
+		return m.WorkingNodes
+	}
+	return nil
+}
+
 type UpdateSegmentStatisticsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Stats                []*SegmentStats   `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
@@ -4265,7 +4590,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{69}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4313,7 +4638,7 @@ func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelChe
 func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
 func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{70}
 }
 
 func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -4366,7 +4691,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4406,7 +4731,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4458,7 +4783,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4540,7 +4865,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4593,7 +4918,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4682,7 +5007,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4729,7 +5054,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4777,7 +5102,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{78}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4834,7 +5159,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{79}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4944,6 +5269,7 @@ func init() {
 	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
 	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
 	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionSegment)(nil), "milvus.proto.data.CompactionSegment")
 	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
 	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
 	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
@@ -4960,6 +5286,9 @@ func init() {
 	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
 	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
 	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*ExportSegmentTask)(nil), "milvus.proto.data.ExportSegmentTask")
+	proto.RegisterType((*ExportSegmentResponse)(nil), "milvus.proto.data.ExportSegmentResponse")
+	proto.RegisterType((*ExportSegmentRequest)(nil), "milvus.proto.data.ExportSegmentRequest")
 	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
 	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
 	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
@@ -4976,288 +5305,305 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x6c, 0x1c, 0x59,
-	0x5a, 0xa9, 0xfe, 0x73, 0xf7, 0xd7, 0xed, 0x76, 0xfb, 0x25, 0xe3, 0x74, 0x3a, 0xbf, 0x53, 0x99,
-	0xcc, 0x64, 0x32, 0x99, 0x64, 0x26, 0xc3, 0x68, 0xc3, 0x66, 0x67, 0x96, 0xd8, 0x4e, 0x32, 0x0d,
-	0xb6, 0xd7, 0x5b, 0x76, 0x26, 0xd2, 0x2e, 0x52, 0xab, 0xdc, 0xf5, 0xdc, 0xae, 0x75, 0x75, 0x55,
-	0xa7, 0xaa, 0xda, 0x8e, 0x97, 0xc3, 0x0e, 0x20, 0x81, 0x16, 0x21, 0x16, 0x21, 0x21, 0xe0, 0x80,
-	0x84, 0x38, 0x2d, 0x8b, 0x16, 0x21, 0xad, 0xb8, 0x70, 0xe1, 0x3a, 0x82, 0xc3, 0x08, 0x21, 0x71,
-	0xe4, 0x08, 0xdc, 0xb9, 0x72, 0x40, 0xef, 0xa7, 0x5e, 0xfd, 0xbd, 0xea, 0x2e, 0x77, 0x27, 0x13,
-	0x04, 0xb7, 0x7e, 0x5f, 0x7d, 0xef, 0x7b, 0x7f, 0xdf, 0xff, 0xfb, 0x5e, 0x43, 0xcb, 0xd0, 0x7d,
-	0xbd, 0xd7, 0x77, 0x1c, 0xd7, 0xb8, 0x33, 0x72, 0x1d, 0xdf, 0x41, 0xcb, 0x43, 0xd3, 0x3a, 0x1a,
-	0x7b, 0xac, 0x75, 0x87, 0x7c, 0xee, 0x34, 0xfa, 0xce, 0x70, 0xe8, 0xd8, 0x0c, 0xd4, 0x69, 0x9a,
-	0xb6, 0x8f, 0x5d, 0x5b, 0xb7, 0x78, 0xbb, 0x11, 0xed, 0xd0, 0x69, 0x78, 0xfd, 0x03, 0x3c, 0xd4,
-	0x59, 0x4b, 0x5d, 0x80, 0xf2, 0xa3, 0xe1, 0xc8, 0x3f, 0x51, 0xff, 0x54, 0x81, 0xc6, 0x63, 0x6b,
-	0xec, 0x1d, 0x68, 0xf8, 0xf9, 0x18, 0x7b, 0x3e, 0xfa, 0x00, 0x4a, 0x7b, 0xba, 0x87, 0xdb, 0xca,
-	0x35, 0xe5, 0x66, 0xfd, 0xde, 0xa5, 0x3b, 0xb1, 0x51, 0xf9, 0x78, 0x9b, 0xde, 0x60, 0x55, 0xf7,
-	0xb0, 0x46, 0x31, 0x11, 0x82, 0x92, 0xb1, 0xd7, 0x5d, 0x6f, 0x17, 0xae, 0x29, 0x37, 0x8b, 0x1a,
-	0xfd, 0x8d, 0xae, 0x00, 0x78, 0x78, 0x30, 0xc4, 0xb6, 0xdf, 0x5d, 0xf7, 0xda, 0xc5, 0x6b, 0xc5,
-	0x9b, 0x45, 0x2d, 0x02, 0x41, 0x2a, 0x34, 0xfa, 0x8e, 0x65, 0xe1, 0xbe, 0x6f, 0x3a, 0x76, 0x77,
-	0xbd, 0x5d, 0xa2, 0x7d, 0x63, 0x30, 0xf5, 0xdf, 0x15, 0x58, 0xe4, 0x53, 0xf3, 0x46, 0x8e, 0xed,
-	0x61, 0xf4, 0x11, 0x54, 0x3c, 0x5f, 0xf7, 0xc7, 0x1e, 0x9f, 0xdd, 0x45, 0xe9, 0xec, 0x76, 0x28,
-	0x8a, 0xc6, 0x51, 0xa5, 0xd3, 0x4b, 0x0e, 0x5f, 0x4c, 0x0f, 0x9f, 0x58, 0x42, 0x29, 0xb5, 0x84,
-	0x9b, 0xb0, 0xb4, 0x4f, 0x66, 0xb7, 0x13, 0x22, 0x95, 0x29, 0x52, 0x12, 0x4c, 0x28, 0xf9, 0xe6,
-	0x10, 0x7f, 0x67, 0x7f, 0x07, 0xeb, 0x56, 0xbb, 0x42, 0xc7, 0x8a, 0x40, 0xd4, 0x7f, 0x56, 0xa0,
-	0x25, 0xd0, 0x83, 0x73, 0x38, 0x07, 0xe5, 0xbe, 0x33, 0xb6, 0x7d, 0xba, 0xd4, 0x45, 0x8d, 0x35,
-	0xd0, 0x9b, 0xd0, 0xe8, 0x1f, 0xe8, 0xb6, 0x8d, 0xad, 0x9e, 0xad, 0x0f, 0x31, 0x5d, 0x54, 0x4d,
-	0xab, 0x73, 0xd8, 0x96, 0x3e, 0xc4, 0xb9, 0xd6, 0x76, 0x0d, 0xea, 0x23, 0xdd, 0xf5, 0xcd, 0xd8,
-	0xee, 0x47, 0x41, 0xa8, 0x03, 0x55, 0xd3, 0xeb, 0x0e, 0x47, 0x8e, 0xeb, 0xb7, 0xcb, 0xd7, 0x94,
-	0x9b, 0x55, 0x4d, 0xb4, 0xc9, 0x08, 0x26, 0xfd, 0xb5, 0xab, 0x7b, 0x87, 0xdd, 0x75, 0xbe, 0xa2,
-	0x18, 0x4c, 0xfd, 0x0b, 0x05, 0x56, 0x1e, 0x7a, 0x9e, 0x39, 0xb0, 0x53, 0x2b, 0x5b, 0x81, 0x8a,
-	0xed, 0x18, 0xb8, 0xbb, 0x4e, 0x97, 0x56, 0xd4, 0x78, 0x0b, 0x5d, 0x84, 0xda, 0x08, 0x63, 0xb7,
-	0xe7, 0x3a, 0x56, 0xb0, 0xb0, 0x2a, 0x01, 0x68, 0x8e, 0x85, 0xd1, 0x77, 0x61, 0xd9, 0x4b, 0x10,
-	0x62, 0x7c, 0x55, 0xbf, 0x77, 0xfd, 0x4e, 0x4a, 0x32, 0xee, 0x24, 0x07, 0xd5, 0xd2, 0xbd, 0xd5,
-	0x2f, 0x0a, 0x70, 0x56, 0xe0, 0xb1, 0xb9, 0x92, 0xdf, 0x64, 0xe7, 0x3d, 0x3c, 0x10, 0xd3, 0x63,
-	0x8d, 0x3c, 0x3b, 0x2f, 0x8e, 0xac, 0x18, 0x3d, 0xb2, 0x1c, 0xac, 0x9e, 0x3c, 0x8f, 0x72, 0xfa,
-	0x3c, 0xae, 0x42, 0x1d, 0xbf, 0x18, 0x99, 0x2e, 0xee, 0x11, 0xc6, 0xa1, 0x5b, 0x5e, 0xd2, 0x80,
-	0x81, 0x76, 0xcd, 0x61, 0x54, 0x36, 0x16, 0x72, 0xcb, 0x86, 0xfa, 0x97, 0x0a, 0x9c, 0x4f, 0x9d,
-	0x12, 0x17, 0x36, 0x0d, 0x5a, 0x74, 0xe5, 0xe1, 0xce, 0x10, 0xb1, 0x23, 0x1b, 0xfe, 0xf6, 0xa4,
-	0x0d, 0x0f, 0xd1, 0xb5, 0x54, 0xff, 0xc8, 0x24, 0x0b, 0xf9, 0x27, 0x79, 0x08, 0xe7, 0x9f, 0x60,
-	0x9f, 0x0f, 0x40, 0xbe, 0x61, 0x6f, 0x76, 0x65, 0x15, 0x97, 0xea, 0x42, 0x52, 0xaa, 0xd5, 0xbf,
-	0x2d, 0x08, 0x59, 0xa4, 0x43, 0x75, 0xed, 0x7d, 0x07, 0x5d, 0x82, 0x9a, 0x40, 0xe1, 0x5c, 0x11,
-	0x02, 0xd0, 0x37, 0xa0, 0x4c, 0x66, 0xca, 0x58, 0xa2, 0x79, 0xef, 0x4d, 0xf9, 0x9a, 0x22, 0x34,
-	0x35, 0x86, 0x8f, 0xba, 0xd0, 0xf4, 0x7c, 0xdd, 0xf5, 0x7b, 0x23, 0xc7, 0xa3, 0xe7, 0x4c, 0x19,
-	0xa7, 0x7e, 0x4f, 0x8d, 0x53, 0x10, 0x6a, 0x7d, 0xd3, 0x1b, 0x6c, 0x73, 0x4c, 0x6d, 0x91, 0xf6,
-	0x0c, 0x9a, 0xe8, 0x11, 0x34, 0xb0, 0x6d, 0x84, 0x84, 0x4a, 0xb9, 0x09, 0xd5, 0xb1, 0x6d, 0x08,
-	0x32, 0xe1, 0xf9, 0x94, 0xf3, 0x9f, 0xcf, 0xef, 0x2b, 0xd0, 0x4e, 0x1f, 0xd0, 0x3c, 0x2a, 0xfb,
-	0x01, 0xeb, 0x84, 0xd9, 0x01, 0x4d, 0x94, 0x70, 0x71, 0x48, 0x1a, 0xef, 0xa2, 0xfe, 0xb1, 0x02,
-	0x6f, 0x84, 0xd3, 0xa1, 0x9f, 0x5e, 0x15, 0xb7, 0xa0, 0x5b, 0xd0, 0x32, 0xed, 0xbe, 0x35, 0x36,
-	0xf0, 0x53, 0xfb, 0x33, 0xac, 0x5b, 0xfe, 0xc1, 0x09, 0x3d, 0xc3, 0xaa, 0x96, 0x82, 0xab, 0xff,
-	0x56, 0x80, 0x95, 0xe4, 0xbc, 0xe6, 0xd9, 0xa4, 0x5f, 0x82, 0xb2, 0x69, 0xef, 0x3b, 0xc1, 0x1e,
-	0x5d, 0x99, 0x20, 0x94, 0x64, 0x2c, 0x86, 0x8c, 0x1c, 0x40, 0x81, 0x1a, 0xeb, 0x1f, 0xe0, 0xfe,
-	0xe1, 0xc8, 0x31, 0xa9, 0xc2, 0x22, 0x24, 0x7e, 0x45, 0x42, 0x42, 0x3e, 0xe3, 0x3b, 0x6b, 0x8c,
-	0xc6, 0x9a, 0x20, 0xf1, 0xc8, 0xf6, 0xdd, 0x13, 0x6d, 0xb9, 0x9f, 0x84, 0x77, 0x0e, 0x60, 0x45,
-	0x8e, 0x8c, 0x5a, 0x50, 0x3c, 0xc4, 0x27, 0x74, 0xc9, 0x35, 0x8d, 0xfc, 0x44, 0xf7, 0xa1, 0x7c,
-	0xa4, 0x5b, 0x63, 0xcc, 0xb5, 0x43, 0x1e, 0xf6, 0x65, 0x1d, 0xbe, 0x59, 0xb8, 0xaf, 0xa8, 0x43,
-	0xb8, 0xf8, 0x04, 0xfb, 0x5d, 0xdb, 0xc3, 0xae, 0xbf, 0x6a, 0xda, 0x96, 0x33, 0xd8, 0xd6, 0xfd,
-	0x83, 0x39, 0x74, 0x45, 0x4c, 0xec, 0x0b, 0x09, 0xb1, 0x57, 0x7f, 0xaa, 0xc0, 0x25, 0xf9, 0x78,
-	0xfc, 0x54, 0x3b, 0x50, 0xdd, 0x37, 0xb1, 0x65, 0x10, 0xd6, 0x51, 0x28, 0xeb, 0x88, 0x36, 0xd1,
-	0x19, 0x23, 0x82, 0xcc, 0x0f, 0xef, 0xcd, 0x8c, 0x95, 0xee, 0xf8, 0xae, 0x69, 0x0f, 0x36, 0x4c,
-	0xcf, 0xd7, 0x18, 0x7e, 0x84, 0x55, 0x8a, 0xf9, 0x25, 0xf4, 0xf7, 0x14, 0xb8, 0xf2, 0x04, 0xfb,
-	0x6b, 0xc2, 0xe4, 0x90, 0xef, 0xa6, 0xe7, 0x9b, 0x7d, 0xef, 0xe5, 0xba, 0x7d, 0x39, 0x7c, 0x0f,
-	0xf5, 0x27, 0x0a, 0x5c, 0xcd, 0x9c, 0x0c, 0xdf, 0x3a, 0xae, 0x52, 0x03, 0x83, 0x23, 0x57, 0xa9,
-	0xbf, 0x86, 0x4f, 0x3e, 0x27, 0x87, 0xbf, 0xad, 0x9b, 0x2e, 0x53, 0xa9, 0x33, 0x1a, 0x98, 0x9f,
-	0x2b, 0x70, 0xf9, 0x09, 0xf6, 0xb7, 0x03, 0x73, 0xfb, 0x1a, 0x77, 0x87, 0xe0, 0x44, 0xcc, 0x7e,
-	0xe0, 0x77, 0xc6, 0x60, 0xea, 0x1f, 0xb0, 0xe3, 0x94, 0xce, 0xf7, 0xb5, 0x6c, 0xe0, 0x15, 0x2a,
-	0x09, 0x11, 0x3d, 0xc1, 0x25, 0x9e, 0x6f, 0x9f, 0xfa, 0xe7, 0x0a, 0x5c, 0x78, 0xd8, 0x7f, 0x3e,
-	0x36, 0x5d, 0xcc, 0x91, 0x36, 0x9c, 0xfe, 0xe1, 0xec, 0x9b, 0x1b, 0x7a, 0x90, 0x85, 0x98, 0x07,
-	0x39, 0x2d, 0xea, 0x58, 0x81, 0x8a, 0xcf, 0x5c, 0x56, 0xe6, 0x84, 0xf1, 0x16, 0x9d, 0x9f, 0x86,
-	0x2d, 0xac, 0x7b, 0xff, 0x3b, 0xe7, 0xf7, 0x93, 0x12, 0x34, 0x3e, 0xe7, 0xaa, 0x95, 0x3a, 0x24,
-	0x49, 0x4e, 0x52, 0xe4, 0x3e, 0x65, 0xc4, 0x39, 0x95, 0xf9, 0xab, 0x4f, 0x60, 0xd1, 0xc3, 0xf8,
-	0x70, 0x16, 0xf7, 0xa3, 0x41, 0x3a, 0x0a, 0xb7, 0x61, 0x03, 0x96, 0xc7, 0x36, 0x8d, 0x7a, 0xb0,
-	0xc1, 0x37, 0x90, 0x71, 0xee, 0x74, 0xb3, 0x94, 0xee, 0x88, 0x3e, 0xe3, 0x81, 0x55, 0x84, 0x56,
-	0x39, 0x17, 0xad, 0x64, 0x37, 0xd4, 0x85, 0x96, 0xe1, 0x3a, 0xa3, 0x11, 0x36, 0x7a, 0x5e, 0x40,
-	0xaa, 0x92, 0x8f, 0x14, 0xef, 0x27, 0x48, 0x7d, 0x00, 0x67, 0x93, 0x33, 0xed, 0x1a, 0xc4, 0xd7,
-	0x26, 0x67, 0x28, 0xfb, 0x84, 0x6e, 0xc3, 0x72, 0x1a, 0xbf, 0x4a, 0xf1, 0xd3, 0x1f, 0xd0, 0xfb,
-	0x80, 0x12, 0x53, 0x25, 0xe8, 0x35, 0x86, 0x1e, 0x9f, 0x4c, 0xd7, 0xf0, 0xd4, 0x1f, 0x2b, 0xb0,
-	0xf2, 0x4c, 0xf7, 0xfb, 0x07, 0xeb, 0x43, 0x2e, 0x6b, 0x73, 0xe8, 0xaa, 0x4f, 0xa0, 0x76, 0xc4,
-	0xf9, 0x22, 0x30, 0x48, 0x57, 0x25, 0xfb, 0x13, 0xe5, 0x40, 0x2d, 0xec, 0x41, 0x42, 0xbd, 0x73,
-	0x8f, 0x23, 0x21, 0xef, 0x6b, 0xd0, 0x9a, 0x53, 0x62, 0x75, 0xf5, 0x05, 0x00, 0x9f, 0xdc, 0xa6,
-	0x37, 0x98, 0x61, 0x5e, 0xf7, 0x61, 0x81, 0x53, 0xe3, 0x6a, 0x71, 0x1a, 0xff, 0x04, 0xe8, 0xea,
-	0xcf, 0x2a, 0x50, 0x8f, 0x7c, 0x40, 0x4d, 0x28, 0x08, 0x79, 0x2d, 0x48, 0x56, 0x57, 0x98, 0x1e,
-	0x1d, 0x16, 0xd3, 0xd1, 0xe1, 0x0d, 0x68, 0x9a, 0xd4, 0x0f, 0xe9, 0xf1, 0x53, 0xa1, 0x0a, 0xa4,
-	0xa6, 0x2d, 0x32, 0x28, 0x67, 0x11, 0x74, 0x05, 0xea, 0xf6, 0x78, 0xd8, 0x73, 0xf6, 0x7b, 0xae,
-	0x73, 0xec, 0xf1, 0x30, 0xb3, 0x66, 0x8f, 0x87, 0xdf, 0xd9, 0xd7, 0x9c, 0x63, 0x2f, 0x8c, 0x64,
-	0x2a, 0xa7, 0x8c, 0x64, 0xae, 0x40, 0x7d, 0xa8, 0xbf, 0x20, 0x54, 0x7b, 0xf6, 0x78, 0x48, 0x23,
-	0xd0, 0xa2, 0x56, 0x1b, 0xea, 0x2f, 0x34, 0xe7, 0x78, 0x6b, 0x3c, 0x44, 0x37, 0xa1, 0x65, 0xe9,
-	0x9e, 0xdf, 0x8b, 0x86, 0xb0, 0x55, 0x1a, 0xc2, 0x36, 0x09, 0xfc, 0x51, 0x18, 0xc6, 0xa6, 0x63,
-	0xa2, 0xda, 0x1c, 0x31, 0x91, 0x31, 0xb4, 0x42, 0x42, 0x90, 0x3f, 0x26, 0x32, 0x86, 0x96, 0x20,
-	0x73, 0x1f, 0x16, 0xf6, 0xa8, 0x77, 0xe7, 0xb5, 0xeb, 0x99, 0xba, 0xe3, 0x31, 0x71, 0xec, 0x98,
-	0x13, 0xa8, 0x05, 0xe8, 0xe8, 0x5b, 0x50, 0xa3, 0x46, 0x95, 0xf6, 0x6d, 0xe4, 0xea, 0x1b, 0x76,
-	0x20, 0xbd, 0x0d, 0x6c, 0xf9, 0x3a, 0xed, 0xbd, 0x98, 0xaf, 0xb7, 0xe8, 0x40, 0xf4, 0x55, 0xdf,
-	0xc5, 0xba, 0x8f, 0x8d, 0xd5, 0x93, 0x35, 0x67, 0x38, 0xd2, 0x29, 0x33, 0xb5, 0x9b, 0x34, 0x38,
-	0x91, 0x7d, 0x42, 0x6f, 0x43, 0xb3, 0x2f, 0x5a, 0x8f, 0x5d, 0x67, 0xd8, 0x5e, 0xa2, 0x72, 0x94,
-	0x80, 0xa2, 0xcb, 0x00, 0x81, 0xa6, 0xd2, 0xfd, 0x76, 0x8b, 0x9e, 0x62, 0x8d, 0x43, 0x1e, 0xd2,
-	0x0c, 0x95, 0xe9, 0xf5, 0x58, 0x2e, 0xc8, 0xb4, 0x07, 0xed, 0x65, 0x3a, 0x62, 0x3d, 0x48, 0x1e,
-	0x99, 0xf6, 0x00, 0x9d, 0x87, 0x05, 0xd3, 0xeb, 0xed, 0xeb, 0x87, 0xb8, 0x8d, 0xe8, 0xd7, 0x8a,
-	0xe9, 0x3d, 0xd6, 0x0f, 0xb1, 0xfa, 0x23, 0x38, 0x17, 0x72, 0x57, 0xe4, 0x24, 0xd3, 0x4c, 0xa1,
-	0xcc, 0xca, 0x14, 0x93, 0x7d, 0xfa, 0xaf, 0x4a, 0xb0, 0xb2, 0xa3, 0x1f, 0xe1, 0x57, 0x1f, 0x3e,
-	0xe4, 0x52, 0x6b, 0x1b, 0xb0, 0x4c, 0x23, 0x86, 0x7b, 0x91, 0xf9, 0x4c, 0xb0, 0xab, 0x51, 0x56,
-	0x48, 0x77, 0x44, 0xdf, 0x26, 0x0e, 0x01, 0xee, 0x1f, 0x6e, 0x93, 0x10, 0x2c, 0xb0, 0xa9, 0x97,
-	0x25, 0x74, 0xd6, 0x04, 0x96, 0x16, 0xed, 0x81, 0xb6, 0x61, 0x29, 0x7e, 0x0c, 0x81, 0x35, 0x7d,
-	0x67, 0x62, 0x7c, 0x1e, 0xee, 0xbe, 0xd6, 0x8c, 0x1d, 0x86, 0x87, 0xda, 0xb0, 0xc0, 0x4d, 0x21,
-	0xd5, 0x19, 0x55, 0x2d, 0x68, 0xa2, 0x6d, 0x38, 0xcb, 0x56, 0xb0, 0xc3, 0x05, 0x82, 0x2d, 0xbe,
-	0x9a, 0x6b, 0xf1, 0xb2, 0xae, 0x71, 0x79, 0xaa, 0x9d, 0x56, 0x9e, 0xda, 0xb0, 0xc0, 0x79, 0x9c,
-	0xea, 0x91, 0xaa, 0x16, 0x34, 0xc9, 0x31, 0x87, 0xdc, 0x5e, 0xa7, 0xdf, 0x42, 0x00, 0x09, 0xbd,
-	0x20, 0xdc, 0xcf, 0x29, 0x99, 0xa4, 0x4f, 0xa1, 0x2a, 0x38, 0x3c, 0x7f, 0x08, 0x2c, 0xfa, 0x24,
-	0xf5, 0x7b, 0x31, 0xa1, 0xdf, 0xd5, 0x7f, 0x52, 0xa0, 0xb1, 0x4e, 0x96, 0xb4, 0xe1, 0x0c, 0xa8,
-	0x35, 0xba, 0x01, 0x4d, 0x17, 0xf7, 0x1d, 0xd7, 0xe8, 0x61, 0xdb, 0x77, 0x4d, 0xcc, 0x12, 0x10,
-	0x25, 0x6d, 0x91, 0x41, 0x1f, 0x31, 0x20, 0x41, 0x23, 0x2a, 0xdb, 0xf3, 0xf5, 0xe1, 0xa8, 0xb7,
-	0x4f, 0x54, 0x43, 0x81, 0xa1, 0x09, 0x28, 0xd5, 0x0c, 0x6f, 0x42, 0x23, 0x44, 0xf3, 0x1d, 0x3a,
-	0x7e, 0x49, 0xab, 0x0b, 0xd8, 0xae, 0x83, 0xde, 0x82, 0x26, 0xdd, 0xd3, 0x9e, 0xe5, 0x0c, 0x7a,
-	0x24, 0xa2, 0xe5, 0x86, 0xaa, 0x61, 0xf0, 0x69, 0x91, 0xb3, 0x8a, 0x63, 0x79, 0xe6, 0x0f, 0x31,
-	0x37, 0x55, 0x02, 0x6b, 0xc7, 0xfc, 0x21, 0x56, 0xff, 0x51, 0x81, 0xc5, 0x75, 0xdd, 0xd7, 0xb7,
-	0x1c, 0x03, 0xef, 0xce, 0x68, 0xd8, 0x73, 0x64, 0x75, 0x2f, 0x41, 0x4d, 0xac, 0x80, 0x2f, 0x29,
-	0x04, 0xa0, 0xc7, 0xd0, 0x0c, 0x5c, 0xcb, 0x1e, 0x8b, 0xb8, 0x4a, 0x99, 0x0e, 0x54, 0xc4, 0x72,
-	0x7a, 0xda, 0x62, 0xd0, 0x8d, 0x36, 0xd5, 0xc7, 0xd0, 0x88, 0x7e, 0x26, 0xa3, 0xee, 0x24, 0x19,
-	0x45, 0x00, 0x08, 0x37, 0x6e, 0x8d, 0x87, 0xe4, 0x4c, 0xb9, 0x62, 0x09, 0x9a, 0xea, 0x6f, 0x2b,
-	0xb0, 0xc8, 0xcd, 0xfd, 0x8e, 0xb8, 0xff, 0xa0, 0x4b, 0x63, 0x79, 0x16, 0xfa, 0x1b, 0x7d, 0x33,
-	0x9e, 0xb2, 0x7c, 0x4b, 0xaa, 0x04, 0x28, 0x11, 0xea, 0x64, 0xc6, 0x6c, 0x7d, 0x9e, 0x18, 0xff,
-	0x0b, 0xc2, 0x68, 0xfc, 0x68, 0x28, 0xa3, 0xb5, 0x61, 0x41, 0x37, 0x0c, 0x17, 0x7b, 0x1e, 0x9f,
-	0x47, 0xd0, 0x24, 0x5f, 0x8e, 0xb0, 0xeb, 0x05, 0x2c, 0x5f, 0xd4, 0x82, 0x26, 0xfa, 0x16, 0x54,
-	0x85, 0x57, 0xca, 0x12, 0x54, 0xd7, 0xb2, 0xe7, 0xc9, 0x23, 0x52, 0xd1, 0x43, 0xfd, 0xbb, 0x02,
-	0x34, 0xf9, 0x86, 0xad, 0x72, 0x7b, 0x3c, 0x59, 0xf8, 0x56, 0xa1, 0xb1, 0x1f, 0xca, 0xfe, 0xa4,
-	0xb4, 0x5a, 0x54, 0x45, 0xc4, 0xfa, 0x4c, 0x13, 0xc0, 0xb8, 0x47, 0x50, 0x9a, 0xcb, 0x23, 0x28,
-	0x9f, 0x56, 0x83, 0xa5, 0x7d, 0xc4, 0x8a, 0xc4, 0x47, 0x54, 0x7f, 0x1d, 0xea, 0x11, 0x02, 0x54,
-	0x43, 0xb3, 0xa4, 0x15, 0xdf, 0xb1, 0xa0, 0x89, 0x3e, 0x0a, 0xfd, 0x22, 0xb6, 0x55, 0x17, 0x24,
-	0x73, 0x49, 0xb8, 0x44, 0xea, 0x3f, 0x28, 0x50, 0xe1, 0x94, 0xaf, 0x42, 0x9d, 0x2b, 0x1d, 0xea,
-	0x33, 0x32, 0xea, 0xc0, 0x41, 0xc4, 0x69, 0x7c, 0x79, 0x5a, 0xe7, 0x02, 0x54, 0x13, 0xfa, 0x66,
-	0x81, 0x9b, 0x85, 0xe0, 0x53, 0x44, 0xc9, 0x90, 0x4f, 0x44, 0xbf, 0xa0, 0x73, 0x50, 0xb6, 0x9c,
-	0x81, 0xb8, 0xdf, 0x62, 0x0d, 0xf5, 0x4b, 0x85, 0x5e, 0x47, 0x68, 0xb8, 0xef, 0x1c, 0x61, 0xf7,
-	0x64, 0xfe, 0x3c, 0xee, 0x83, 0x08, 0x9b, 0xe7, 0x0c, 0xbe, 0x44, 0x07, 0xf4, 0x20, 0x3c, 0x84,
-	0xa2, 0x2c, 0xd3, 0x13, 0xd5, 0x3b, 0x9c, 0x49, 0xc3, 0xc3, 0xf8, 0x43, 0x85, 0x66, 0xa4, 0xe3,
-	0x4b, 0x99, 0xd5, 0xdb, 0x79, 0x29, 0x81, 0x8c, 0xfa, 0x95, 0x02, 0x9d, 0x30, 0x95, 0xe4, 0xad,
-	0x9e, 0xcc, 0x7b, 0xdf, 0xf3, 0x72, 0xe2, 0xab, 0x5f, 0x16, 0x17, 0x12, 0x44, 0x68, 0x73, 0x45,
-	0x46, 0xc1, 0x75, 0x84, 0x4d, 0xb3, 0xd2, 0xe9, 0x05, 0xcd, 0xc3, 0x32, 0x1d, 0xa8, 0x8a, 0x7c,
-	0x06, 0xbb, 0x94, 0x10, 0x6d, 0x22, 0x61, 0x17, 0x9e, 0x60, 0xff, 0x71, 0x3c, 0x15, 0xf2, 0xba,
-	0x37, 0x30, 0x7a, 0x51, 0x72, 0xc0, 0x2f, 0x4a, 0x4a, 0x89, 0x8b, 0x12, 0x0e, 0x57, 0x87, 0x94,
-	0x05, 0x52, 0x0b, 0x78, 0x55, 0x1b, 0xf6, 0x3b, 0x0a, 0xb4, 0xf9, 0x28, 0x74, 0x4c, 0x12, 0x12,
-	0x59, 0xd8, 0xc7, 0xc6, 0xd7, 0x9d, 0x2a, 0xf8, 0x6f, 0x05, 0x5a, 0x51, 0xab, 0x4b, 0x0d, 0xe7,
-	0xc7, 0x50, 0xa6, 0x99, 0x16, 0x3e, 0x83, 0xa9, 0xaa, 0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed,
-	0x5d, 0xe1, 0x20, 0xf0, 0x66, 0x68, 0xfa, 0x8b, 0xa7, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0xc6, 0x84,
-	0x2e, 0x4b, 0x51, 0x86, 0x00, 0xf4, 0x09, 0x54, 0x58, 0x8d, 0x09, 0xbf, 0x3c, 0xbc, 0x11, 0x27,
-	0xcd, 0xeb, 0x4f, 0x22, 0x79, 0x7f, 0x0a, 0xd0, 0x78, 0x27, 0xf5, 0x57, 0x61, 0x25, 0x8c, 0x46,
-	0xd9, 0xb0, 0xb3, 0x32, 0xad, 0xfa, 0xaf, 0x0a, 0x9c, 0xdd, 0x39, 0xb1, 0xfb, 0x49, 0xf6, 0x5f,
-	0x81, 0xca, 0xc8, 0xd2, 0xc3, 0x8c, 0x29, 0x6f, 0x51, 0x37, 0x90, 0x8d, 0x8d, 0x0d, 0x62, 0x43,
-	0xd8, 0x9e, 0xd5, 0x05, 0x6c, 0xd7, 0x99, 0x6a, 0xda, 0x6f, 0x88, 0xf0, 0x19, 0x1b, 0xcc, 0x5a,
-	0xb1, 0x34, 0xd4, 0xa2, 0x80, 0x52, 0x6b, 0xf5, 0x09, 0x00, 0x35, 0xe8, 0xbd, 0xd3, 0x18, 0x71,
-	0xda, 0x63, 0x83, 0xa8, 0xec, 0x5f, 0x14, 0xa0, 0x1d, 0xd9, 0xa5, 0xaf, 0xdb, 0xbf, 0xc9, 0x88,
-	0xca, 0x8a, 0x2f, 0x29, 0x2a, 0x2b, 0xcd, 0xef, 0xd3, 0x94, 0x65, 0x3e, 0xcd, 0x6f, 0x16, 0xa1,
-	0x19, 0xee, 0xda, 0xb6, 0xa5, 0xdb, 0x99, 0x9c, 0xb0, 0x23, 0xfc, 0xf9, 0xf8, 0x3e, 0xbd, 0x27,
-	0x93, 0x93, 0x8c, 0x83, 0xd0, 0x12, 0x24, 0xd0, 0x65, 0x7a, 0xe8, 0xae, 0xcf, 0x12, 0x5f, 0x3c,
-	0x86, 0x60, 0x02, 0x69, 0x0e, 0x31, 0xba, 0x0d, 0x88, 0x4b, 0x51, 0xcf, 0xb4, 0x7b, 0x1e, 0xee,
-	0x3b, 0xb6, 0xc1, 0xe4, 0xab, 0xac, 0xb5, 0xf8, 0x97, 0xae, 0xbd, 0xc3, 0xe0, 0xe8, 0x63, 0x28,
-	0xf9, 0x27, 0x23, 0xe6, 0xad, 0x34, 0xa5, 0xf6, 0x3e, 0x9c, 0xd7, 0xee, 0xc9, 0x08, 0x6b, 0x14,
-	0x3d, 0x28, 0x42, 0xf2, 0x5d, 0xfd, 0x88, 0xbb, 0x7e, 0x25, 0x2d, 0x02, 0x21, 0x1a, 0x23, 0xd8,
-	0xc3, 0x05, 0xe6, 0x22, 0xf1, 0x26, 0xe3, 0xec, 0x40, 0x68, 0x7b, 0xbe, 0x6f, 0xd1, 0xd4, 0x1d,
-	0xe5, 0xec, 0x00, 0xba, 0xeb, 0x5b, 0x64, 0x91, 0xbe, 0xe3, 0xeb, 0x16, 0x93, 0x8f, 0x1a, 0xd7,
-	0x0e, 0x04, 0x42, 0x03, 0x93, 0x7f, 0x29, 0x40, 0x2b, 0x9c, 0x98, 0x86, 0xbd, 0xb1, 0x95, 0x2d,
-	0x8f, 0x93, 0x53, 0x27, 0xd3, 0x44, 0xf1, 0xdb, 0x50, 0xe7, 0x5c, 0x71, 0x0a, 0xae, 0x02, 0xd6,
-	0x65, 0x63, 0x02, 0x9b, 0x97, 0x5f, 0x12, 0x9b, 0x57, 0x66, 0x48, 0x3e, 0xc8, 0xcf, 0x46, 0xfd,
-	0xa9, 0x02, 0x6f, 0xa4, 0xb4, 0xe6, 0xc4, 0xad, 0x9d, 0x1c, 0xfa, 0x71, 0x6d, 0x9a, 0x24, 0xc9,
-	0xf5, 0xff, 0x03, 0xa8, 0xb8, 0x94, 0x3a, 0xbf, 0x29, 0xba, 0x3e, 0x91, 0xf9, 0xd8, 0x44, 0x34,
-	0xde, 0x45, 0xfd, 0x23, 0x05, 0xce, 0xa7, 0xa7, 0x3a, 0x87, 0x51, 0x5f, 0x85, 0x05, 0x46, 0x3a,
-	0x90, 0xd1, 0x9b, 0x93, 0x65, 0x34, 0xdc, 0x1c, 0x2d, 0xe8, 0xa8, 0xee, 0xc0, 0x4a, 0x60, 0xfb,
-	0xc3, 0xad, 0xdf, 0xc4, 0xbe, 0x3e, 0x21, 0xf0, 0xb9, 0x0a, 0x75, 0xe6, 0x41, 0xb3, 0x80, 0x82,
-	0xa5, 0x0c, 0x60, 0x4f, 0x64, 0xda, 0xd4, 0xff, 0x54, 0xe0, 0x1c, 0x35, 0x9e, 0xc9, 0xab, 0x99,
-	0x3c, 0xd7, 0x76, 0xaa, 0xc8, 0x48, 0x6c, 0xe9, 0x43, 0x5e, 0x01, 0x53, 0xd3, 0x62, 0x30, 0xd4,
-	0x4d, 0x27, 0xe2, 0xa4, 0x01, 0x72, 0x78, 0xcf, 0x4b, 0x82, 0x71, 0x7a, 0xcd, 0x9b, 0xcc, 0xc0,
-	0x85, 0x46, 0xbb, 0x34, 0x8b, 0xd1, 0xde, 0x80, 0x37, 0x12, 0x2b, 0x9d, 0xe3, 0x44, 0xd5, 0xbf,
-	0x52, 0xc8, 0x71, 0xc4, 0x2a, 0x89, 0x66, 0x77, 0x5c, 0x2f, 0x8b, 0x3b, 0xa1, 0x9e, 0x69, 0x24,
-	0x95, 0x88, 0x81, 0x3e, 0x85, 0x9a, 0x8d, 0x8f, 0x7b, 0x51, 0x5f, 0x28, 0x87, 0x57, 0x5f, 0xb5,
-	0xf1, 0x31, 0xfd, 0xa5, 0x6e, 0xc1, 0xf9, 0xd4, 0x54, 0xe7, 0x59, 0xfb, 0xdf, 0x2b, 0x70, 0x61,
-	0xdd, 0x75, 0x46, 0x9f, 0x9b, 0xae, 0x3f, 0xd6, 0xad, 0xf8, 0x0d, 0xfa, 0xab, 0xc9, 0x6c, 0x7d,
-	0x16, 0xf1, 0x8a, 0x19, 0xff, 0xdc, 0x96, 0x48, 0x50, 0x7a, 0x52, 0x7c, 0xd1, 0x11, 0x1f, 0xfa,
-	0x3f, 0x8a, 0xb2, 0xc9, 0x73, 0xbc, 0x29, 0x7e, 0x49, 0x9e, 0x00, 0x43, 0x9a, 0x08, 0x2f, 0xce,
-	0x9a, 0x08, 0xcf, 0x50, 0xef, 0xa5, 0x97, 0xa4, 0xde, 0x4f, 0x9d, 0x99, 0xf9, 0x0c, 0xe2, 0x97,
-	0x14, 0xd4, 0x3a, 0xcf, 0x74, 0xbb, 0xb1, 0x0a, 0x10, 0x26, 0xec, 0x79, 0x21, 0x68, 0x1e, 0x32,
-	0x91, 0x5e, 0xe4, 0xb4, 0x84, 0x29, 0xe5, 0x96, 0x3e, 0x92, 0x42, 0xfe, 0x2e, 0x74, 0x64, 0x5c,
-	0x3a, 0x0f, 0xe7, 0xff, 0xa2, 0x00, 0xd0, 0x15, 0xb5, 0xc3, 0xb3, 0xd9, 0x82, 0xeb, 0x10, 0xf1,
-	0x46, 0x42, 0x79, 0x8f, 0x72, 0x91, 0x41, 0x44, 0x42, 0xc4, 0xa4, 0x04, 0x27, 0x15, 0xa7, 0x1a,
-	0x94, 0x4e, 0x44, 0x6a, 0x18, 0x53, 0x24, 0xd5, 0xef, 0x45, 0xa8, 0xb9, 0xce, 0x71, 0x8f, 0x88,
-	0x99, 0x11, 0x14, 0x47, 0xbb, 0xce, 0x31, 0x11, 0x3e, 0x03, 0x9d, 0x87, 0x05, 0x5f, 0xf7, 0x0e,
-	0x09, 0xfd, 0x4a, 0xa4, 0x88, 0xc3, 0x40, 0xe7, 0xa0, 0xbc, 0x6f, 0x5a, 0x98, 0xd5, 0x0c, 0xd4,
-	0x34, 0xd6, 0x40, 0xdf, 0x08, 0xaa, 0xf8, 0xaa, 0xb9, 0x0b, 0x75, 0x28, 0xbe, 0xfa, 0xa5, 0x02,
-	0x4b, 0xe1, 0xae, 0x51, 0x05, 0x44, 0x74, 0x1a, 0xd5, 0x67, 0x6b, 0x8e, 0xc1, 0x54, 0x45, 0x33,
-	0xc3, 0x22, 0xb0, 0x8e, 0x4c, 0x6b, 0x85, 0x5d, 0x26, 0x85, 0xc9, 0x64, 0x5d, 0x64, 0xd1, 0xa6,
-	0x11, 0x14, 0xae, 0x54, 0x5c, 0xe7, 0xb8, 0x6b, 0x88, 0xdd, 0x60, 0x95, 0xcf, 0x2c, 0x28, 0x24,
-	0xbb, 0xb1, 0x46, 0x8b, 0x9f, 0xaf, 0xc3, 0x22, 0x76, 0x5d, 0xc7, 0xed, 0x0d, 0xb1, 0xe7, 0xe9,
-	0x03, 0xcc, 0xfd, 0xf3, 0x06, 0x05, 0x6e, 0x32, 0x98, 0xfa, 0x27, 0x25, 0x68, 0x86, 0x4b, 0x09,
-	0xae, 0xc9, 0x4d, 0x23, 0xb8, 0x26, 0x37, 0xc9, 0xd1, 0x81, 0xcb, 0x54, 0xa1, 0x38, 0xdc, 0xd5,
-	0x42, 0x5b, 0xd1, 0x6a, 0x1c, 0xda, 0x35, 0x88, 0x59, 0x26, 0x42, 0x66, 0x3b, 0x06, 0x0e, 0x0f,
-	0x17, 0x02, 0x10, 0x3f, 0xdb, 0x18, 0x8f, 0x94, 0x72, 0xf0, 0x48, 0x39, 0x07, 0x8f, 0x54, 0x24,
-	0x3c, 0xb2, 0x02, 0x95, 0xbd, 0x71, 0xff, 0x10, 0xfb, 0xdc, 0x63, 0xe3, 0xad, 0x38, 0xef, 0x54,
-	0x13, 0xbc, 0x23, 0x58, 0xa4, 0x16, 0x65, 0x91, 0x8b, 0x50, 0x63, 0xf7, 0xb5, 0x3d, 0xdf, 0xa3,
-	0x97, 0x4f, 0x45, 0xad, 0xca, 0x00, 0xbb, 0x1e, 0xba, 0x1f, 0xb8, 0x73, 0x75, 0x99, 0xb0, 0x53,
-	0xad, 0x93, 0xe0, 0x92, 0xc0, 0x99, 0x7b, 0x07, 0x96, 0x22, 0xdb, 0x41, 0x6d, 0x44, 0x83, 0x4e,
-	0x35, 0xe2, 0xed, 0x53, 0x33, 0x71, 0x03, 0x9a, 0xe1, 0x96, 0x50, 0xbc, 0x45, 0x16, 0x64, 0x09,
-	0x28, 0x45, 0x13, 0x9c, 0xdc, 0x3c, 0x1d, 0x27, 0xa3, 0x0b, 0x50, 0xe5, 0xd1, 0x91, 0xd7, 0x5e,
-	0x8a, 0x25, 0x2b, 0xd4, 0x1f, 0x00, 0x0a, 0x67, 0x3f, 0x9f, 0xb7, 0x98, 0x60, 0x8f, 0x42, 0x92,
-	0x3d, 0xd4, 0x9f, 0x29, 0xb0, 0x1c, 0x1d, 0x6c, 0x56, 0xc3, 0xfb, 0x29, 0xd4, 0xd9, 0xf5, 0x5f,
-	0x8f, 0x08, 0x3e, 0x4f, 0x02, 0x5d, 0x9e, 0x78, 0x2e, 0x1a, 0x84, 0x6f, 0x27, 0x08, 0x7b, 0x1d,
-	0x3b, 0xee, 0xa1, 0x69, 0x0f, 0x7a, 0x64, 0x66, 0x81, 0xb8, 0x35, 0x38, 0x70, 0x8b, 0xc0, 0xd4,
-	0x1f, 0x2b, 0x70, 0xe5, 0xe9, 0xc8, 0xd0, 0x7d, 0x1c, 0xf1, 0x40, 0xe6, 0xad, 0x59, 0xfc, 0x38,
-	0x28, 0x1a, 0x2c, 0xe4, 0xbb, 0xc2, 0x62, 0xd8, 0xea, 0xdf, 0x88, 0xb9, 0xa4, 0x0a, 0x7d, 0x67,
-	0x9f, 0x4b, 0x07, 0xaa, 0x47, 0x9c, 0x5c, 0xf0, 0x16, 0x24, 0x68, 0xc7, 0xae, 0x49, 0x8b, 0xa7,
-	0xbf, 0x26, 0x55, 0x37, 0xe1, 0x82, 0x86, 0x3d, 0x6c, 0x1b, 0xb1, 0xd5, 0xcc, 0x9c, 0x6c, 0x1a,
-	0x41, 0x47, 0x46, 0x6e, 0x1e, 0x66, 0x65, 0xbe, 0x6b, 0xcf, 0x25, 0x64, 0x7d, 0xae, 0x8a, 0x89,
-	0xcb, 0x44, 0xc7, 0xf1, 0xd5, 0xbf, 0x2e, 0xc0, 0xf9, 0x87, 0x86, 0xc1, 0xb5, 0x38, 0xf7, 0xc6,
-	0x5e, 0x95, 0xa3, 0x9c, 0x74, 0x24, 0x8b, 0x69, 0x47, 0xf2, 0x65, 0x69, 0x56, 0x6e, 0x63, 0xec,
-	0xf1, 0x30, 0xb0, 0x9d, 0x2e, 0xab, 0x1f, 0x7a, 0xc0, 0xef, 0xcd, 0x48, 0x40, 0x4f, 0xed, 0xe7,
-	0x74, 0xff, 0xaa, 0x1a, 0x24, 0xcd, 0xd4, 0x11, 0xb4, 0xd3, 0x9b, 0x35, 0xa7, 0x2a, 0x09, 0x76,
-	0x64, 0xe4, 0xb0, 0x04, 0x6b, 0x83, 0xb8, 0x50, 0x14, 0xb4, 0xed, 0x78, 0xea, 0x7f, 0x15, 0xa0,
-	0xbd, 0xa3, 0x1f, 0xe1, 0xff, 0x3f, 0x07, 0xf4, 0x3d, 0x38, 0xe7, 0xe9, 0x47, 0xb8, 0x17, 0x09,
-	0x8c, 0x7b, 0x2e, 0x7e, 0xce, 0x5d, 0xd0, 0x77, 0x65, 0x9a, 0x44, 0x5a, 0x66, 0xa3, 0x2d, 0x7b,
-	0x31, 0xb8, 0x86, 0x9f, 0xa3, 0xb7, 0x61, 0x29, 0x5a, 0xc7, 0x45, 0xa6, 0x56, 0xa5, 0x5b, 0xbe,
-	0x18, 0x29, 0xd3, 0xea, 0x1a, 0xea, 0x73, 0xb8, 0xf4, 0xd4, 0xf6, 0xb0, 0xdf, 0x0d, 0x4b, 0x8d,
-	0xe6, 0x0c, 0x21, 0xaf, 0x42, 0x3d, 0xdc, 0xf8, 0xd4, 0xfb, 0x0f, 0xc3, 0x53, 0x1d, 0xe8, 0x6c,
-	0xea, 0xee, 0x61, 0x90, 0x66, 0x5e, 0x67, 0x25, 0x21, 0xaf, 0x70, 0xc0, 0x7d, 0x51, 0x21, 0xa5,
-	0xe1, 0x7d, 0xec, 0x62, 0xbb, 0x8f, 0x37, 0x9c, 0xfe, 0x61, 0xa4, 0x72, 0x58, 0x89, 0x56, 0x0e,
-	0xcf, 0x5a, 0x89, 0xac, 0xfe, 0xbc, 0x00, 0x2b, 0x0f, 0x2d, 0x1f, 0xbb, 0x61, 0xe4, 0x7f, 0x9a,
-	0x24, 0x46, 0x98, 0x55, 0x28, 0xcc, 0x90, 0x55, 0x48, 0x15, 0xc1, 0x17, 0xd3, 0x45, 0xf0, 0xb2,
-	0x1c, 0x48, 0x69, 0xc6, 0x1c, 0xc8, 0x43, 0x80, 0x91, 0xeb, 0x8c, 0xb0, 0xeb, 0x9b, 0x38, 0x08,
-	0xdf, 0x72, 0xb8, 0x2f, 0x91, 0x4e, 0xb7, 0x3e, 0x15, 0x55, 0x9e, 0xbb, 0x27, 0x23, 0x8c, 0x16,
-	0xa0, 0xb8, 0x85, 0x8f, 0x5b, 0x67, 0x10, 0x40, 0x65, 0xcb, 0x71, 0x87, 0xba, 0xd5, 0x52, 0x50,
-	0x1d, 0x16, 0xf8, 0xa5, 0x56, 0xab, 0x80, 0x16, 0xa1, 0xb6, 0x16, 0x5c, 0x0c, 0xb4, 0x8a, 0xb7,
-	0xfe, 0x4c, 0x81, 0xe5, 0xd4, 0xb5, 0x0b, 0x6a, 0x02, 0x3c, 0xb5, 0xfb, 0xfc, 0x3e, 0xaa, 0x75,
-	0x06, 0x35, 0xa0, 0x1a, 0xdc, 0x4e, 0x31, 0x7a, 0xbb, 0x0e, 0xc5, 0x6e, 0x15, 0x50, 0x0b, 0x1a,
-	0xac, 0xe3, 0xb8, 0xdf, 0xc7, 0x9e, 0xd7, 0x2a, 0x0a, 0xc8, 0x63, 0xdd, 0xb4, 0xc6, 0x2e, 0x6e,
-	0x95, 0xc8, 0x98, 0xbb, 0x0e, 0xaf, 0x73, 0x6f, 0x95, 0x11, 0x82, 0x66, 0x50, 0xf4, 0xce, 0x3b,
-	0x55, 0x22, 0xb0, 0xa0, 0xdb, 0xc2, 0xad, 0x67, 0xd1, 0xe4, 0x39, 0x5d, 0xde, 0x79, 0x38, 0xfb,
-	0xd4, 0x36, 0xf0, 0xbe, 0x69, 0x63, 0x23, 0xfc, 0xd4, 0x3a, 0x83, 0xce, 0xc2, 0xd2, 0x26, 0x76,
-	0x07, 0x38, 0x02, 0x2c, 0xa0, 0x65, 0x58, 0xdc, 0x34, 0x5f, 0x44, 0x40, 0x45, 0xb5, 0x54, 0x55,
-	0x5a, 0xca, 0xbd, 0xdf, 0xbd, 0x0c, 0x35, 0x72, 0x28, 0x6b, 0x8e, 0xe3, 0x1a, 0xc8, 0x02, 0x44,
-	0x9f, 0x85, 0x0c, 0x47, 0x8e, 0x2d, 0xde, 0x91, 0xa1, 0x3b, 0xf1, 0x73, 0xe0, 0x8d, 0x34, 0x22,
-	0xe7, 0xce, 0xce, 0x5b, 0x52, 0xfc, 0x04, 0xb2, 0x7a, 0x06, 0x0d, 0xe9, 0x68, 0xbb, 0xe6, 0x10,
-	0xef, 0x9a, 0xfd, 0xc3, 0xc0, 0xb3, 0xf8, 0x20, 0xc3, 0x8f, 0x48, 0xa3, 0x06, 0xe3, 0x5d, 0x97,
-	0x8e, 0xc7, 0xde, 0xed, 0x04, 0x56, 0x46, 0x3d, 0x83, 0x9e, 0xc3, 0xb9, 0x27, 0x38, 0xe2, 0xa4,
-	0x05, 0x03, 0xde, 0xcb, 0x1e, 0x30, 0x85, 0x7c, 0xca, 0x21, 0x37, 0xa0, 0x4c, 0xd9, 0x0d, 0xc9,
-	0xfc, 0xb8, 0xe8, 0x93, 0xef, 0xce, 0xb5, 0x6c, 0x04, 0x41, 0xed, 0x07, 0xb0, 0x94, 0x78, 0x28,
-	0x8a, 0x64, 0x5a, 0x5d, 0xfe, 0xe4, 0xb7, 0x73, 0x2b, 0x0f, 0xaa, 0x18, 0x6b, 0x00, 0xcd, 0xf8,
-	0x73, 0x12, 0x74, 0x33, 0xc7, 0xcb, 0x34, 0x36, 0xd2, 0xbb, 0xb9, 0xdf, 0xb0, 0x51, 0x26, 0x68,
-	0x25, 0x1f, 0x2e, 0xa2, 0x5b, 0x13, 0x09, 0xc4, 0x99, 0xed, 0xbd, 0x5c, 0xb8, 0x62, 0xb8, 0x13,
-	0xca, 0x04, 0xa9, 0x07, 0x63, 0x49, 0x1e, 0x0f, 0xc8, 0x64, 0xbd, 0x64, 0xeb, 0xdc, 0xcd, 0x8d,
-	0x2f, 0x86, 0xfe, 0x2d, 0x56, 0xb5, 0x22, 0x7b, 0x74, 0x85, 0x3e, 0x94, 0x93, 0x9b, 0xf0, 0x5a,
-	0xac, 0x73, 0xef, 0x34, 0x5d, 0xc4, 0x24, 0x7e, 0x44, 0xcb, 0x4d, 0x24, 0xcf, 0x96, 0x92, 0x72,
-	0x17, 0xd0, 0xcb, 0x7e, 0x91, 0xd5, 0xf9, 0xf0, 0x14, 0x3d, 0xc4, 0x04, 0x9c, 0xe4, 0xcb, 0xd0,
-	0x40, 0x0c, 0xef, 0x4e, 0xe5, 0x9a, 0xd9, 0x64, 0xf0, 0xfb, 0xb0, 0x94, 0xf0, 0x73, 0x50, 0x7e,
-	0x5f, 0xa8, 0x33, 0xc9, 0x19, 0x65, 0x22, 0x99, 0xa8, 0xde, 0x41, 0x19, 0xdc, 0x2f, 0xa9, 0xf0,
-	0xe9, 0xdc, 0xca, 0x83, 0x2a, 0x16, 0xe2, 0x51, 0x75, 0x99, 0xa8, 0xc9, 0x40, 0xb7, 0xe5, 0x34,
-	0xe4, 0xb5, 0x27, 0x9d, 0xf7, 0x73, 0x62, 0x8b, 0x41, 0x8f, 0xe0, 0xac, 0xa4, 0x74, 0x06, 0xbd,
-	0x3f, 0xf1, 0xb0, 0x92, 0x35, 0x43, 0x9d, 0x3b, 0x79, 0xd1, 0xc5, 0xb8, 0xbf, 0x01, 0x68, 0xe7,
-	0xc0, 0x39, 0x5e, 0x73, 0xec, 0x7d, 0x73, 0x30, 0x76, 0x75, 0xe6, 0x25, 0x64, 0xd9, 0x86, 0x34,
-	0x6a, 0x06, 0x8f, 0x4e, 0xec, 0x21, 0x06, 0xef, 0x01, 0x3c, 0xc1, 0xfe, 0x26, 0xf6, 0x5d, 0x22,
-	0x18, 0x6f, 0x67, 0x99, 0x3f, 0x8e, 0x10, 0x0c, 0xf5, 0xce, 0x54, 0xbc, 0x88, 0x29, 0x6a, 0x6d,
-	0xea, 0xf6, 0x58, 0xb7, 0x22, 0xb5, 0xff, 0xb7, 0xa5, 0xdd, 0x93, 0x68, 0x19, 0x07, 0x99, 0x89,
-	0x2d, 0x86, 0x3c, 0x16, 0xa6, 0x3d, 0x72, 0x15, 0x37, 0xd9, 0xb4, 0xa7, 0xcb, 0x40, 0x92, 0x6a,
-	0x6f, 0x02, 0xbe, 0x18, 0xf8, 0x0b, 0x85, 0x56, 0x5f, 0x25, 0x10, 0x9e, 0x99, 0xfe, 0xc1, 0xb6,
-	0xa5, 0xdb, 0x5e, 0x9e, 0x29, 0x50, 0xc4, 0x53, 0x4c, 0x81, 0xe3, 0x8b, 0x29, 0x18, 0xb0, 0x18,
-	0xbb, 0x21, 0x43, 0xb2, 0x62, 0x79, 0xd9, 0x6d, 0x61, 0xe7, 0xe6, 0x74, 0x44, 0x31, 0xca, 0x01,
-	0x2c, 0x06, 0xa2, 0xc4, 0x36, 0xf7, 0xdd, 0xac, 0x99, 0x86, 0x38, 0x19, 0x9a, 0x40, 0x8e, 0x1a,
-	0xd5, 0x04, 0xe9, 0x0b, 0x00, 0x94, 0xef, 0xe2, 0x68, 0x92, 0x26, 0xc8, 0xbe, 0x55, 0x60, 0xaa,
-	0x2e, 0x71, 0xd9, 0x26, 0xd7, 0xa3, 0xd2, 0xbb, 0x43, 0xa9, 0xaa, 0xcb, 0xb8, 0xbb, 0x53, 0xcf,
-	0xa0, 0x67, 0x50, 0xe1, 0xff, 0x73, 0xf2, 0xd6, 0xe4, 0xa4, 0x1d, 0xa7, 0x7e, 0x63, 0x0a, 0x96,
-	0x20, 0x7c, 0x08, 0xe7, 0x33, 0x52, 0x76, 0x52, 0x13, 0x3c, 0x39, 0xbd, 0x37, 0xcd, 0x38, 0x88,
-	0xc1, 0x52, 0x39, 0xb9, 0x09, 0x83, 0x65, 0xe5, 0xef, 0xa6, 0x0d, 0xa6, 0x03, 0x4a, 0x3f, 0xef,
-	0x95, 0xf2, 0x44, 0xe6, 0x2b, 0xe0, 0x1c, 0x43, 0xa4, 0x5f, 0xe8, 0x4a, 0x87, 0xc8, 0x7c, 0xc8,
-	0x3b, 0x6d, 0x88, 0x1e, 0x2c, 0xa7, 0x92, 0x36, 0xe8, 0xbd, 0x0c, 0x73, 0x2d, 0x4b, 0xed, 0x4c,
-	0x1b, 0x60, 0x00, 0x6f, 0x48, 0x13, 0x14, 0x52, 0xf7, 0x63, 0x52, 0x2a, 0x63, 0xda, 0x40, 0x7d,
-	0x38, 0x2b, 0x49, 0x4b, 0x48, 0x0d, 0x67, 0x76, 0xfa, 0x62, 0xda, 0x20, 0xfb, 0xd0, 0x59, 0x75,
-	0x1d, 0xdd, 0xe8, 0xeb, 0x9e, 0x4f, 0x53, 0x05, 0x24, 0x16, 0x0c, 0xfc, 0x3f, 0x79, 0x70, 0x20,
-	0x4d, 0x28, 0x4c, 0x1b, 0x67, 0x0f, 0xea, 0x94, 0x21, 0xd9, 0xff, 0x68, 0x20, 0xb9, 0xa5, 0x8b,
-	0x60, 0x64, 0xa8, 0x4f, 0x19, 0x62, 0x20, 0x9a, 0xf7, 0xbe, 0xac, 0x41, 0x35, 0x78, 0xaf, 0xf0,
-	0x35, 0x07, 0xa2, 0xaf, 0x21, 0x32, 0xfc, 0x3e, 0x2c, 0x25, 0xde, 0x0e, 0x4b, 0x8f, 0x4b, 0xfe,
-	0xbe, 0x78, 0xda, 0x71, 0x3d, 0xe3, 0x7f, 0xda, 0x25, 0x9c, 0xc4, 0x77, 0xb2, 0xa2, 0xcb, 0xa4,
-	0x7f, 0x38, 0x85, 0xf0, 0xff, 0x6d, 0xaf, 0x6c, 0x0b, 0x20, 0xe2, 0x8f, 0x4d, 0xae, 0xea, 0x23,
-	0x2e, 0xc6, 0xb4, 0xdd, 0x1a, 0x4a, 0x5d, 0xae, 0x77, 0xf3, 0x54, 0x48, 0x65, 0x1b, 0xcd, 0x6c,
-	0x47, 0xeb, 0x29, 0x34, 0xa2, 0xf5, 0xb6, 0x48, 0xfa, 0x17, 0x51, 0xe9, 0x82, 0xdc, 0x69, 0xab,
-	0xd8, 0x3c, 0xa5, 0x2d, 0x9e, 0x42, 0xce, 0x23, 0x46, 0x24, 0x79, 0x53, 0x93, 0x61, 0x44, 0x32,
-	0xee, 0x87, 0xa4, 0xbe, 0x4b, 0xf6, 0xf5, 0x0f, 0x4b, 0x32, 0x24, 0xaf, 0x1f, 0xa4, 0x49, 0x86,
-	0x8c, 0x0b, 0x1d, 0x69, 0x92, 0x21, 0xeb, 0x3e, 0x43, 0x3d, 0xb3, 0xfa, 0xd1, 0xf7, 0x3e, 0x1c,
-	0x98, 0xfe, 0xc1, 0x78, 0x8f, 0xac, 0xfe, 0x2e, 0xeb, 0xfa, 0xbe, 0xe9, 0xf0, 0x5f, 0x77, 0x03,
-	0x76, 0xbf, 0x4b, 0xa9, 0xdd, 0x25, 0xd4, 0x46, 0x7b, 0x7b, 0x15, 0xda, 0xfa, 0xe8, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x50, 0x04, 0x47, 0xc9, 0x76, 0x50, 0x00, 0x00,
+	// 4753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0xb8, 0x9b, 0xa4, 0x28, 0xf2, 0x91, 0xa2, 0xa8, 0x92, 0x2c, 0xd3, 0xf4, 0x77, 0xcf, 0x78,
+	0x46, 0xe3, 0xb1, 0x65, 0x8f, 0xe6, 0x37, 0xf8, 0x4d, 0x76, 0x76, 0x66, 0xd7, 0x92, 0x6c, 0x0f,
+	0xb3, 0x96, 0xd7, 0xdb, 0x92, 0x67, 0x82, 0xdd, 0x05, 0x88, 0x16, 0xbb, 0x44, 0xf5, 0xaa, 0xd9,
+	0x4d, 0x77, 0x37, 0x25, 0x6b, 0x73, 0xd8, 0x41, 0x02, 0x04, 0xd8, 0x20, 0xc8, 0x06, 0x01, 0x16,
+	0x49, 0x0e, 0x01, 0x92, 0x3d, 0x6d, 0x12, 0x6c, 0x10, 0x60, 0x93, 0x4b, 0x72, 0xc8, 0x35, 0x48,
+	0x10, 0x2c, 0xf2, 0x0f, 0xe4, 0x98, 0xe4, 0x92, 0x53, 0xae, 0x39, 0x04, 0xf5, 0xd1, 0xd5, 0x5f,
+	0xd5, 0x64, 0x8b, 0xb4, 0xc7, 0x8b, 0xe4, 0xc6, 0xaa, 0x7e, 0xaf, 0x5e, 0x7d, 0xbc, 0xef, 0x57,
+	0x45, 0x68, 0x1a, 0xba, 0xaf, 0x77, 0x7b, 0x8e, 0xe3, 0x1a, 0xeb, 0x43, 0xd7, 0xf1, 0x1d, 0xb4,
+	0x34, 0x30, 0xad, 0xe3, 0x91, 0xc7, 0x5a, 0xeb, 0xe4, 0x73, 0xbb, 0xde, 0x73, 0x06, 0x03, 0xc7,
+	0x66, 0x5d, 0xed, 0x86, 0x69, 0xfb, 0xd8, 0xb5, 0x75, 0x8b, 0xb7, 0xeb, 0x51, 0x84, 0x76, 0xdd,
+	0xeb, 0x1d, 0xe2, 0x81, 0xce, 0x5a, 0xea, 0x3c, 0xcc, 0x3d, 0x18, 0x0c, 0xfd, 0x53, 0xf5, 0x0f,
+	0x15, 0xa8, 0x3f, 0xb4, 0x46, 0xde, 0xa1, 0x86, 0x9f, 0x8f, 0xb0, 0xe7, 0xa3, 0x7b, 0x50, 0xda,
+	0xd7, 0x3d, 0xdc, 0x52, 0xae, 0x2b, 0x6b, 0xb5, 0x8d, 0xcb, 0xeb, 0x31, 0xaa, 0x9c, 0xde, 0x8e,
+	0xd7, 0xdf, 0xd4, 0x3d, 0xac, 0x51, 0x48, 0x84, 0xa0, 0x64, 0xec, 0x77, 0xb6, 0x5b, 0x85, 0xeb,
+	0xca, 0x5a, 0x51, 0xa3, 0xbf, 0xd1, 0x55, 0x00, 0x0f, 0xf7, 0x07, 0xd8, 0xf6, 0x3b, 0xdb, 0x5e,
+	0xab, 0x78, 0xbd, 0xb8, 0x56, 0xd4, 0x22, 0x3d, 0x48, 0x85, 0x7a, 0xcf, 0xb1, 0x2c, 0xdc, 0xf3,
+	0x4d, 0xc7, 0xee, 0x6c, 0xb7, 0x4a, 0x14, 0x37, 0xd6, 0xa7, 0xfe, 0x9b, 0x02, 0x0b, 0x7c, 0x6a,
+	0xde, 0xd0, 0xb1, 0x3d, 0x8c, 0xde, 0x87, 0xb2, 0xe7, 0xeb, 0xfe, 0xc8, 0xe3, 0xb3, 0xbb, 0x24,
+	0x9d, 0xdd, 0x2e, 0x05, 0xd1, 0x38, 0xa8, 0x74, 0x7a, 0x49, 0xf2, 0xc5, 0x34, 0xf9, 0xc4, 0x12,
+	0x4a, 0xa9, 0x25, 0xac, 0xc1, 0xe2, 0x01, 0x99, 0xdd, 0x6e, 0x08, 0x34, 0x47, 0x81, 0x92, 0xdd,
+	0x64, 0x24, 0xdf, 0x1c, 0xe0, 0x6f, 0x1e, 0xec, 0x62, 0xdd, 0x6a, 0x95, 0x29, 0xad, 0x48, 0x8f,
+	0xfa, 0x2f, 0x0a, 0x34, 0x05, 0x78, 0x70, 0x0e, 0x2b, 0x30, 0xd7, 0x73, 0x46, 0xb6, 0x4f, 0x97,
+	0xba, 0xa0, 0xb1, 0x06, 0xba, 0x01, 0xf5, 0xde, 0xa1, 0x6e, 0xdb, 0xd8, 0xea, 0xda, 0xfa, 0x00,
+	0xd3, 0x45, 0x55, 0xb5, 0x1a, 0xef, 0x7b, 0xa2, 0x0f, 0x70, 0xae, 0xb5, 0x5d, 0x87, 0xda, 0x50,
+	0x77, 0x7d, 0x33, 0xb6, 0xfb, 0xd1, 0x2e, 0xd4, 0x86, 0x8a, 0xe9, 0x75, 0x06, 0x43, 0xc7, 0xf5,
+	0x5b, 0x73, 0xd7, 0x95, 0xb5, 0x8a, 0x26, 0xda, 0x84, 0x82, 0x49, 0x7f, 0xed, 0xe9, 0xde, 0x51,
+	0x67, 0x9b, 0xaf, 0x28, 0xd6, 0xa7, 0xfe, 0x89, 0x02, 0xab, 0xf7, 0x3d, 0xcf, 0xec, 0xdb, 0xa9,
+	0x95, 0xad, 0x42, 0xd9, 0x76, 0x0c, 0xdc, 0xd9, 0xa6, 0x4b, 0x2b, 0x6a, 0xbc, 0x85, 0x2e, 0x41,
+	0x75, 0x88, 0xb1, 0xdb, 0x75, 0x1d, 0x2b, 0x58, 0x58, 0x85, 0x74, 0x68, 0x8e, 0x85, 0xd1, 0xb7,
+	0x60, 0xc9, 0x4b, 0x0c, 0xc4, 0xf8, 0xaa, 0xb6, 0xf1, 0xc6, 0x7a, 0x4a, 0x32, 0xd6, 0x93, 0x44,
+	0xb5, 0x34, 0xb6, 0xfa, 0x45, 0x01, 0x96, 0x05, 0x1c, 0x9b, 0x2b, 0xf9, 0x4d, 0x76, 0xde, 0xc3,
+	0x7d, 0x31, 0x3d, 0xd6, 0xc8, 0xb3, 0xf3, 0xe2, 0xc8, 0x8a, 0xd1, 0x23, 0xcb, 0xc1, 0xea, 0xc9,
+	0xf3, 0x98, 0x4b, 0x9f, 0xc7, 0x35, 0xa8, 0xe1, 0x17, 0x43, 0xd3, 0xc5, 0x5d, 0xc2, 0x38, 0x74,
+	0xcb, 0x4b, 0x1a, 0xb0, 0xae, 0x3d, 0x73, 0x10, 0x95, 0x8d, 0xf9, 0xdc, 0xb2, 0xa1, 0xfe, 0x44,
+	0x81, 0x0b, 0xa9, 0x53, 0xe2, 0xc2, 0xa6, 0x41, 0x93, 0xae, 0x3c, 0xdc, 0x19, 0x22, 0x76, 0x64,
+	0xc3, 0xdf, 0x1a, 0xb7, 0xe1, 0x21, 0xb8, 0x96, 0xc2, 0x8f, 0x4c, 0xb2, 0x90, 0x7f, 0x92, 0x47,
+	0x70, 0xe1, 0x11, 0xf6, 0x39, 0x01, 0xf2, 0x0d, 0x7b, 0xd3, 0x2b, 0xab, 0xb8, 0x54, 0x17, 0x92,
+	0x52, 0xad, 0xfe, 0x55, 0x01, 0x9a, 0x51, 0x52, 0x1d, 0xfb, 0xc0, 0x41, 0x97, 0xa1, 0x2a, 0x40,
+	0x38, 0x57, 0x84, 0x1d, 0xe8, 0xff, 0xc3, 0x1c, 0x99, 0x29, 0x63, 0x89, 0xc6, 0xc6, 0x0d, 0xf9,
+	0x9a, 0x22, 0x63, 0x6a, 0x0c, 0x1e, 0x75, 0xa0, 0xe1, 0xf9, 0xba, 0xeb, 0x77, 0x87, 0x8e, 0x47,
+	0xcf, 0x99, 0x32, 0x4e, 0x6d, 0x43, 0x8d, 0x8f, 0x20, 0xd4, 0xfa, 0x8e, 0xd7, 0x7f, 0xca, 0x21,
+	0xb5, 0x05, 0x8a, 0x19, 0x34, 0xd1, 0x03, 0xa8, 0x63, 0xdb, 0x08, 0x07, 0x2a, 0xe5, 0x1e, 0xa8,
+	0x86, 0x6d, 0x43, 0x0c, 0x13, 0x9e, 0xcf, 0x5c, 0xfe, 0xf3, 0xf9, 0x1d, 0x05, 0x5a, 0xe9, 0x03,
+	0x9a, 0x45, 0x65, 0x7f, 0xc4, 0x90, 0x30, 0x3b, 0xa0, 0xb1, 0x12, 0x2e, 0x0e, 0x49, 0xe3, 0x28,
+	0xea, 0x8f, 0x15, 0x38, 0x1f, 0x4e, 0x87, 0x7e, 0x7a, 0x55, 0xdc, 0x82, 0x6e, 0x41, 0xd3, 0xb4,
+	0x7b, 0xd6, 0xc8, 0xc0, 0xcf, 0xec, 0x4f, 0xb1, 0x6e, 0xf9, 0x87, 0xa7, 0xf4, 0x0c, 0x2b, 0x5a,
+	0xaa, 0x5f, 0xfd, 0xd7, 0x02, 0xac, 0x26, 0xe7, 0x35, 0xcb, 0x26, 0xfd, 0x3f, 0x98, 0x33, 0xed,
+	0x03, 0x27, 0xd8, 0xa3, 0xab, 0x63, 0x84, 0x92, 0xd0, 0x62, 0xc0, 0xc8, 0x01, 0x14, 0xa8, 0xb1,
+	0xde, 0x21, 0xee, 0x1d, 0x0d, 0x1d, 0x93, 0x2a, 0x2c, 0x32, 0xc4, 0xd7, 0x25, 0x43, 0xc8, 0x67,
+	0xbc, 0xbe, 0xc5, 0xc6, 0xd8, 0x12, 0x43, 0x3c, 0xb0, 0x7d, 0xf7, 0x54, 0x5b, 0xea, 0x25, 0xfb,
+	0xdb, 0x87, 0xb0, 0x2a, 0x07, 0x46, 0x4d, 0x28, 0x1e, 0xe1, 0x53, 0xba, 0xe4, 0xaa, 0x46, 0x7e,
+	0xa2, 0x0f, 0x61, 0xee, 0x58, 0xb7, 0x46, 0xb8, 0x55, 0xc8, 0xcd, 0xbe, 0x0c, 0xe1, 0x2b, 0x85,
+	0x0f, 0x15, 0x75, 0x00, 0x97, 0x1e, 0x61, 0xbf, 0x63, 0x7b, 0xd8, 0xf5, 0x37, 0x4d, 0xdb, 0x72,
+	0xfa, 0x4f, 0x75, 0xff, 0x70, 0x06, 0x5d, 0x11, 0x13, 0xfb, 0x42, 0x42, 0xec, 0xd5, 0x9f, 0x2a,
+	0x70, 0x59, 0x4e, 0x8f, 0x9f, 0x6a, 0x1b, 0x2a, 0x07, 0x26, 0xb6, 0x8c, 0xce, 0x36, 0x53, 0x9c,
+	0x45, 0x4d, 0xb4, 0x89, 0xce, 0x18, 0x12, 0x60, 0x7e, 0x78, 0x37, 0x32, 0x56, 0xba, 0xeb, 0xbb,
+	0xa6, 0xdd, 0x7f, 0x6c, 0x7a, 0xbe, 0xc6, 0xe0, 0x23, 0xac, 0x52, 0xcc, 0x2f, 0xa1, 0xbf, 0xad,
+	0xc0, 0xd5, 0x47, 0xd8, 0xdf, 0x12, 0x26, 0x87, 0x7c, 0x37, 0x3d, 0xdf, 0xec, 0x79, 0x2f, 0xd7,
+	0xed, 0xcb, 0xe1, 0x7b, 0xa8, 0x3f, 0x52, 0xe0, 0x5a, 0xe6, 0x64, 0xf8, 0xd6, 0x71, 0x95, 0x1a,
+	0x18, 0x1c, 0xb9, 0x4a, 0xfd, 0x06, 0x3e, 0xfd, 0x8c, 0x1c, 0xfe, 0x53, 0xdd, 0x74, 0x99, 0x4a,
+	0x9d, 0xd2, 0xc0, 0xfc, 0x4c, 0x81, 0x2b, 0x8f, 0xb0, 0xff, 0x34, 0x30, 0xb7, 0xaf, 0x71, 0x77,
+	0x08, 0x4c, 0xc4, 0xec, 0x07, 0x7e, 0x67, 0xac, 0x4f, 0xfd, 0x5d, 0x76, 0x9c, 0xd2, 0xf9, 0xbe,
+	0x96, 0x0d, 0xbc, 0x0a, 0x97, 0xe3, 0x7a, 0x82, 0x4b, 0x3c, 0xdf, 0x3e, 0xf5, 0x8f, 0x15, 0xb8,
+	0x78, 0xbf, 0xf7, 0x7c, 0x64, 0xba, 0x98, 0x03, 0x3d, 0x76, 0x7a, 0x47, 0xd3, 0x6f, 0x6e, 0xe8,
+	0x41, 0x16, 0x62, 0x1e, 0xe4, 0xa4, 0xa8, 0x63, 0x15, 0xca, 0x3e, 0x73, 0x59, 0x99, 0x13, 0xc6,
+	0x5b, 0x74, 0x7e, 0x1a, 0xb6, 0xb0, 0xee, 0xfd, 0x72, 0xce, 0xef, 0x47, 0x25, 0xa8, 0x7f, 0xc6,
+	0x55, 0x2b, 0x75, 0x48, 0x92, 0x9c, 0xa4, 0xc8, 0x7d, 0xca, 0x88, 0x73, 0x2a, 0xf3, 0x57, 0x1f,
+	0xc1, 0x82, 0x87, 0xf1, 0xd1, 0x34, 0xee, 0x47, 0x9d, 0x20, 0x06, 0x2d, 0xf4, 0x18, 0x96, 0x46,
+	0x36, 0x8d, 0x7a, 0xb0, 0xc1, 0x37, 0x90, 0x71, 0xee, 0x64, 0xb3, 0x94, 0x46, 0x44, 0x9f, 0xc2,
+	0x62, 0xa2, 0xab, 0x35, 0x97, 0x6b, 0xac, 0x24, 0x1a, 0xea, 0x40, 0xd3, 0x70, 0x9d, 0xe1, 0x10,
+	0x1b, 0x5d, 0x2f, 0x18, 0xaa, 0x9c, 0x6f, 0x28, 0x8e, 0x27, 0x86, 0xba, 0x07, 0xcb, 0xc9, 0x99,
+	0x76, 0x0c, 0xe2, 0x6b, 0x93, 0x33, 0x94, 0x7d, 0x42, 0xb7, 0x61, 0x29, 0x0d, 0x5f, 0xa1, 0xf0,
+	0xe9, 0x0f, 0xe8, 0x0e, 0xa0, 0xc4, 0x54, 0x09, 0x78, 0x95, 0x81, 0xc7, 0x27, 0xd3, 0x31, 0x3c,
+	0xf5, 0x87, 0x0a, 0xac, 0x7e, 0xae, 0xfb, 0xbd, 0xc3, 0xed, 0x01, 0x97, 0xb5, 0x19, 0x74, 0xd5,
+	0xc7, 0x50, 0x3d, 0xe6, 0x7c, 0x11, 0x18, 0xa4, 0x6b, 0x92, 0xfd, 0x89, 0x72, 0xa0, 0x16, 0x62,
+	0x90, 0x50, 0x6f, 0xe5, 0x61, 0x24, 0xe4, 0x7d, 0x0d, 0x5a, 0x73, 0x42, 0xac, 0xae, 0xbe, 0x00,
+	0xe0, 0x93, 0xdb, 0xf1, 0xfa, 0x53, 0xcc, 0xeb, 0x43, 0x98, 0xe7, 0xa3, 0x71, 0xb5, 0x38, 0x89,
+	0x7f, 0x02, 0x70, 0xf5, 0x9f, 0xcb, 0x50, 0x8b, 0x7c, 0x40, 0x0d, 0x28, 0x08, 0x79, 0x2d, 0x48,
+	0x56, 0x57, 0x98, 0x1c, 0x1d, 0x16, 0xd3, 0xd1, 0xe1, 0x4d, 0x68, 0x98, 0xd4, 0x0f, 0xe9, 0xf2,
+	0x53, 0xa1, 0x0a, 0xa4, 0xaa, 0x2d, 0xb0, 0x5e, 0xce, 0x22, 0xe8, 0x2a, 0xd4, 0xec, 0xd1, 0xa0,
+	0xeb, 0x1c, 0x74, 0x5d, 0xe7, 0xc4, 0xe3, 0x61, 0x66, 0xd5, 0x1e, 0x0d, 0xbe, 0x79, 0xa0, 0x39,
+	0x27, 0x5e, 0x18, 0xc9, 0x94, 0xcf, 0x18, 0xc9, 0x5c, 0x85, 0xda, 0x40, 0x7f, 0x41, 0x46, 0xed,
+	0xda, 0xa3, 0x01, 0x8d, 0x40, 0x8b, 0x5a, 0x75, 0xa0, 0xbf, 0xd0, 0x9c, 0x93, 0x27, 0xa3, 0x01,
+	0x5a, 0x83, 0xa6, 0xa5, 0x7b, 0x7e, 0x37, 0x1a, 0xc2, 0x56, 0x68, 0x08, 0xdb, 0x20, 0xfd, 0x0f,
+	0xc2, 0x30, 0x36, 0x1d, 0x13, 0x55, 0x67, 0x88, 0x89, 0x8c, 0x81, 0x15, 0x0e, 0x04, 0xf9, 0x63,
+	0x22, 0x63, 0x60, 0x89, 0x61, 0x3e, 0x84, 0xf9, 0x7d, 0xea, 0xdd, 0x79, 0xad, 0x5a, 0xa6, 0xee,
+	0x78, 0x48, 0x1c, 0x3b, 0xe6, 0x04, 0x6a, 0x01, 0x38, 0xfa, 0x2a, 0x54, 0xa9, 0x51, 0xa5, 0xb8,
+	0xf5, 0x5c, 0xb8, 0x21, 0x02, 0xc1, 0x36, 0xb0, 0xe5, 0xeb, 0x14, 0x7b, 0x21, 0x1f, 0xb6, 0x40,
+	0x20, 0xfa, 0xaa, 0xe7, 0x62, 0xdd, 0xc7, 0xc6, 0xe6, 0xe9, 0x96, 0x33, 0x18, 0xea, 0x94, 0x99,
+	0x5a, 0x0d, 0x1a, 0x9c, 0xc8, 0x3e, 0xa1, 0xb7, 0xa0, 0xd1, 0x13, 0xad, 0x87, 0xae, 0x33, 0x68,
+	0x2d, 0x52, 0x39, 0x4a, 0xf4, 0xa2, 0x2b, 0x00, 0x81, 0xa6, 0xd2, 0xfd, 0x56, 0x93, 0x9e, 0x62,
+	0x95, 0xf7, 0xdc, 0xa7, 0x19, 0x2a, 0xd3, 0xeb, 0xb2, 0x5c, 0x90, 0x69, 0xf7, 0x5b, 0x4b, 0x94,
+	0x62, 0x2d, 0x48, 0x1e, 0x99, 0x76, 0x1f, 0x5d, 0x80, 0x79, 0xd3, 0xeb, 0x1e, 0xe8, 0x47, 0xb8,
+	0x85, 0xe8, 0xd7, 0xb2, 0xe9, 0x3d, 0xd4, 0x8f, 0x30, 0xc7, 0xed, 0x59, 0x23, 0xcf, 0xc7, 0x2e,
+	0x36, 0x5a, 0xcb, 0x01, 0xee, 0x56, 0xd0, 0xa5, 0xfe, 0x00, 0x56, 0x42, 0x06, 0x8c, 0x1c, 0x76,
+	0x9a, 0x6f, 0x94, 0x69, 0xf9, 0x66, 0xbc, 0xdb, 0xff, 0x8b, 0x12, 0xac, 0xee, 0xea, 0xc7, 0xf8,
+	0xd5, 0x47, 0x18, 0xb9, 0x34, 0xdf, 0x63, 0x58, 0xa2, 0x41, 0xc5, 0x46, 0x64, 0x3e, 0xad, 0x52,
+	0x2e, 0x6e, 0x49, 0x23, 0xa2, 0xaf, 0x11, 0x9f, 0x01, 0xf7, 0x8e, 0x9e, 0x3a, 0x66, 0x68, 0x76,
+	0xaf, 0x48, 0xc6, 0xd9, 0x12, 0x50, 0x5a, 0x14, 0x03, 0x3d, 0x85, 0xc5, 0xf8, 0x31, 0x04, 0x06,
+	0xf7, 0xed, 0xb1, 0x21, 0x7c, 0xb8, 0xfb, 0x5a, 0x23, 0x76, 0x18, 0x1e, 0x6a, 0xc1, 0x3c, 0xb7,
+	0x96, 0x54, 0xad, 0x54, 0xb4, 0xa0, 0x89, 0x9e, 0xc2, 0x32, 0x5b, 0xc1, 0x2e, 0x97, 0x19, 0xb6,
+	0xf8, 0x4a, 0xae, 0xc5, 0xcb, 0x50, 0xe3, 0x22, 0x57, 0x3d, 0xab, 0xc8, 0xb5, 0x60, 0x9e, 0x8b,
+	0x01, 0x55, 0x35, 0x15, 0x2d, 0x68, 0x92, 0x63, 0x0e, 0x05, 0xa2, 0x46, 0xbf, 0x85, 0x1d, 0x24,
+	0x3a, 0x83, 0x70, 0x3f, 0x27, 0x24, 0x9b, 0x3e, 0x81, 0x8a, 0xe0, 0xf0, 0xfc, 0x51, 0xb2, 0xc0,
+	0x49, 0x9a, 0x80, 0x62, 0xc2, 0x04, 0xa8, 0xff, 0xa4, 0x40, 0x7d, 0x9b, 0x2c, 0xe9, 0xb1, 0xd3,
+	0xa7, 0x06, 0xeb, 0x26, 0x34, 0x5c, 0xdc, 0x73, 0x5c, 0xa3, 0x8b, 0x6d, 0xdf, 0x35, 0x31, 0xcb,
+	0x51, 0x94, 0xb4, 0x05, 0xd6, 0xfb, 0x80, 0x75, 0x12, 0x30, 0xa2, 0xd5, 0x3d, 0x5f, 0x1f, 0x0c,
+	0xbb, 0x07, 0x44, 0x7b, 0x14, 0x18, 0x98, 0xe8, 0xa5, 0xca, 0xe3, 0x06, 0xd4, 0x43, 0x30, 0xdf,
+	0xa1, 0xf4, 0x4b, 0x5a, 0x4d, 0xf4, 0xed, 0x39, 0xe8, 0x4d, 0x68, 0xd0, 0x3d, 0xed, 0x5a, 0x4e,
+	0xbf, 0x4b, 0x82, 0x5e, 0x6e, 0xcb, 0xea, 0x06, 0x9f, 0x16, 0x39, 0xab, 0x38, 0x94, 0x67, 0x7e,
+	0x1f, 0x73, 0x6b, 0x26, 0xa0, 0x76, 0xcd, 0xef, 0x63, 0xf5, 0x1f, 0x15, 0x58, 0xd8, 0xd6, 0x7d,
+	0xfd, 0x89, 0x63, 0xe0, 0xbd, 0x29, 0x6d, 0x7f, 0x8e, 0xc4, 0xef, 0x65, 0xa8, 0x8a, 0x15, 0xf0,
+	0x25, 0x85, 0x1d, 0xe8, 0x21, 0x34, 0x02, 0xef, 0xb3, 0xcb, 0x82, 0xb2, 0x52, 0xa6, 0x8f, 0x15,
+	0x31, 0xae, 0x9e, 0xb6, 0x10, 0xa0, 0xd1, 0xa6, 0xfa, 0x10, 0xea, 0xd1, 0xcf, 0x84, 0xea, 0x6e,
+	0x92, 0x51, 0x44, 0x07, 0xe1, 0xc6, 0x27, 0xa3, 0x01, 0x39, 0x53, 0xae, 0x58, 0x82, 0xa6, 0xfa,
+	0x9b, 0x0a, 0x2c, 0x70, 0x8f, 0x60, 0x57, 0x94, 0x48, 0xe8, 0xd2, 0x58, 0x2a, 0x86, 0xfe, 0x46,
+	0x5f, 0x89, 0x67, 0x35, 0xdf, 0x94, 0x2a, 0x01, 0x3a, 0x08, 0xf5, 0x43, 0x63, 0xee, 0x40, 0x9e,
+	0x34, 0xc0, 0x17, 0x84, 0xd1, 0xf8, 0xd1, 0x50, 0x46, 0x6b, 0xc1, 0xbc, 0x6e, 0x18, 0x2e, 0xf6,
+	0x3c, 0x3e, 0x8f, 0xa0, 0x49, 0xbe, 0x1c, 0x63, 0xd7, 0x0b, 0x58, 0xbe, 0xa8, 0x05, 0x4d, 0xf4,
+	0x55, 0xa8, 0x08, 0xc7, 0x95, 0xe5, 0xb0, 0xae, 0x67, 0xcf, 0x93, 0x07, 0xad, 0x02, 0x43, 0xfd,
+	0x9b, 0x02, 0x34, 0xf8, 0x86, 0x6d, 0x72, 0x93, 0x3d, 0x5e, 0xf8, 0x36, 0xa1, 0x7e, 0x10, 0xca,
+	0xfe, 0xb8, 0xcc, 0x5b, 0x54, 0x45, 0xc4, 0x70, 0x26, 0x09, 0x60, 0xdc, 0x69, 0x28, 0xcd, 0xe4,
+	0x34, 0xcc, 0x9d, 0x55, 0x83, 0xa5, 0xdd, 0xc8, 0xb2, 0xc4, 0x8d, 0x54, 0xbf, 0x0b, 0xb5, 0xc8,
+	0x00, 0x54, 0x43, 0xb3, 0xbc, 0x16, 0xdf, 0xb1, 0xa0, 0x89, 0xde, 0x0f, 0x5d, 0x27, 0xb6, 0x55,
+	0x17, 0x25, 0x73, 0x49, 0x78, 0x4d, 0xea, 0xdf, 0x2b, 0x50, 0xe6, 0x23, 0x93, 0xa2, 0x07, 0xd3,
+	0x2f, 0xd4, 0xad, 0x64, 0xa3, 0x03, 0xef, 0x22, 0x7e, 0xe5, 0xcb, 0xd3, 0x3a, 0x17, 0xa1, 0x92,
+	0xd0, 0x37, 0xf3, 0xdc, 0x2c, 0x04, 0x9f, 0x22, 0x4a, 0x66, 0xde, 0x62, 0xfa, 0x85, 0x54, 0x7c,
+	0x2c, 0xa7, 0x2f, 0x4a, 0x60, 0xac, 0xa1, 0xfe, 0x83, 0x42, 0x2b, 0x16, 0x1a, 0xee, 0x39, 0xc7,
+	0xd8, 0x3d, 0x9d, 0x3d, 0xd5, 0xfb, 0x51, 0x84, 0xcd, 0x73, 0xc6, 0x67, 0x02, 0x01, 0x7d, 0x14,
+	0x1e, 0x42, 0x51, 0x96, 0x0c, 0x8a, 0xea, 0x1d, 0xce, 0xa4, 0xe1, 0x61, 0xfc, 0x9e, 0x02, 0xab,
+	0xa9, 0xa5, 0x4c, 0xeb, 0xed, 0xbc, 0x94, 0x58, 0x47, 0xfd, 0x85, 0x02, 0xed, 0x30, 0xdb, 0xe4,
+	0x6d, 0x9e, 0xce, 0x5a, 0x12, 0x7a, 0x39, 0x21, 0xd8, 0xaf, 0x88, 0x9a, 0x05, 0x11, 0xda, 0x5c,
+	0xc1, 0x13, 0x47, 0x50, 0x6d, 0x9a, 0xb8, 0x4e, 0x2f, 0x68, 0x16, 0x96, 0x69, 0x43, 0x45, 0xa4,
+	0x3c, 0x58, 0xdd, 0x42, 0xb4, 0x89, 0x84, 0x5d, 0x7c, 0x84, 0xfd, 0x87, 0xf1, 0x6c, 0xc9, 0xeb,
+	0xde, 0xc0, 0x68, 0x2d, 0xe5, 0x90, 0xd7, 0x52, 0x4a, 0x89, 0x5a, 0x0a, 0xef, 0x57, 0x07, 0xd0,
+	0x96, 0x2d, 0xe0, 0x55, 0x6d, 0xd8, 0x6f, 0x29, 0xd0, 0xe2, 0x54, 0x28, 0x4d, 0x12, 0x35, 0x59,
+	0xd8, 0xc7, 0xc6, 0x97, 0x9d, 0x4d, 0xf8, 0x6f, 0x05, 0x9a, 0x51, 0xab, 0x4b, 0xbe, 0xa2, 0x0f,
+	0x60, 0x8e, 0x26, 0x63, 0xf8, 0x0c, 0x26, 0xaa, 0x06, 0x06, 0x4d, 0xd4, 0x36, 0x75, 0xb5, 0xf7,
+	0x84, 0x83, 0xc0, 0x9b, 0xa1, 0xe9, 0x2f, 0x9e, 0xdd, 0xf4, 0x73, 0x57, 0xc8, 0x19, 0x91, 0x71,
+	0x59, 0x16, 0x33, 0xec, 0x40, 0x1f, 0x43, 0x99, 0x5d, 0x43, 0xe1, 0xf5, 0xc5, 0x9b, 0xf1, 0xa1,
+	0xd9, 0xb7, 0xf5, 0x48, 0x69, 0x80, 0x76, 0x68, 0x1c, 0x49, 0xfd, 0x55, 0x58, 0x0d, 0x03, 0x56,
+	0x46, 0x76, 0x5a, 0xa6, 0x25, 0x85, 0xde, 0xe5, 0xdd, 0x53, 0xbb, 0x97, 0x64, 0xff, 0x55, 0x28,
+	0x0f, 0x2d, 0x3d, 0x4c, 0xaa, 0xf2, 0x16, 0x75, 0x03, 0x19, 0x6d, 0x6c, 0x10, 0x1b, 0xc2, 0xf6,
+	0xac, 0x26, 0xfa, 0xf6, 0x9c, 0x89, 0xa6, 0xfd, 0xa6, 0x88, 0xb0, 0xb1, 0xc1, 0xac, 0x15, 0xcb,
+	0x54, 0x2d, 0x88, 0x5e, 0x6a, 0xad, 0x3e, 0x06, 0xa0, 0x06, 0xbd, 0x7b, 0x16, 0x23, 0x4e, 0x31,
+	0x1e, 0x13, 0x23, 0xfe, 0x6b, 0x70, 0x3e, 0x3a, 0xd1, 0x64, 0xe6, 0x53, 0x7a, 0x9a, 0xe1, 0xa6,
+	0x32, 0x60, 0x6d, 0x39, 0xb2, 0xae, 0xdd, 0x40, 0x0c, 0x7e, 0x5e, 0x80, 0x56, 0x0a, 0xf4, 0xcb,
+	0xf3, 0x9c, 0x32, 0xe2, 0xbd, 0xe2, 0x4b, 0x8a, 0xf7, 0x4a, 0xb3, 0x7b, 0x4b, 0x73, 0x32, 0x6f,
+	0xe9, 0xef, 0x4a, 0xd0, 0x08, 0x77, 0xed, 0xa9, 0xa5, 0xdb, 0x99, 0x3c, 0xb6, 0x2b, 0x22, 0x85,
+	0xf8, 0x3e, 0xbd, 0x9b, 0xe7, 0xcc, 0x02, 0xdb, 0x9d, 0x18, 0x82, 0xe4, 0x6b, 0x58, 0x48, 0x4e,
+	0xb3, 0x6e, 0x3c, 0x3a, 0x61, 0xa2, 0x4e, 0x12, 0x6e, 0xb7, 0x01, 0x71, 0xf9, 0xec, 0x9a, 0x76,
+	0xd7, 0xc3, 0x3d, 0xc7, 0x36, 0x98, 0xe4, 0xce, 0x69, 0x4d, 0xfe, 0xa5, 0x63, 0xef, 0xb2, 0x7e,
+	0xf4, 0x01, 0x94, 0xfc, 0xd3, 0x21, 0xf3, 0x83, 0x1a, 0x1b, 0x37, 0xc6, 0xce, 0x6b, 0xef, 0x74,
+	0x88, 0x35, 0x0a, 0x1e, 0xdc, 0x80, 0xf2, 0x5d, 0xfd, 0x98, 0x3b, 0x95, 0x25, 0x2d, 0xd2, 0x43,
+	0x74, 0x51, 0xb0, 0x87, 0xf3, 0xcc, 0xf9, 0xe2, 0x4d, 0x26, 0x33, 0x81, 0x3a, 0xe8, 0xfa, 0xbe,
+	0x45, 0xf3, 0x86, 0x54, 0x66, 0x82, 0xde, 0x3d, 0xdf, 0x22, 0x8b, 0xf4, 0x1d, 0x5f, 0xb7, 0x98,
+	0xe4, 0x55, 0xb9, 0xde, 0x21, 0x3d, 0x54, 0xf2, 0xee, 0xc1, 0x0a, 0xcf, 0x2a, 0x99, 0x76, 0xbf,
+	0x7b, 0x84, 0x4f, 0xbb, 0x94, 0x1d, 0x68, 0x9c, 0x5e, 0xd4, 0x50, 0xf8, 0xed, 0x1b, 0xf8, 0x94,
+	0x9e, 0x36, 0xc9, 0x58, 0x92, 0x8c, 0x26, 0xdf, 0x4b, 0x36, 0x6c, 0x8d, 0x42, 0x37, 0x06, 0xfa,
+	0x8b, 0x40, 0x4c, 0xc8, 0xd8, 0xdf, 0x85, 0x8b, 0x89, 0xb1, 0xf7, 0x9d, 0x91, 0x6d, 0xe8, 0x34,
+	0x56, 0xae, 0x5f, 0x57, 0xd2, 0x41, 0x09, 0x57, 0x73, 0xbb, 0x3d, 0xdd, 0xd2, 0x5d, 0x4a, 0x4e,
+	0xbb, 0x10, 0x9b, 0xc2, 0xa6, 0x18, 0x40, 0xfd, 0x49, 0x01, 0x96, 0x52, 0x47, 0x3d, 0x41, 0xd8,
+	0x12, 0x7a, 0xa8, 0x90, 0xd4, 0x43, 0x5f, 0x83, 0x1a, 0x67, 0xdc, 0x88, 0x57, 0x38, 0x89, 0xf1,
+	0x81, 0xa1, 0x3c, 0x1e, 0x23, 0x89, 0xa5, 0x97, 0x24, 0x89, 0x67, 0x8d, 0x5b, 0xd4, 0x1f, 0x17,
+	0xa1, 0x19, 0x6e, 0x92, 0x86, 0xbd, 0x91, 0x95, 0xad, 0xc8, 0xc7, 0xe7, 0xdc, 0x26, 0xe9, 0xf0,
+	0xc4, 0xde, 0x95, 0x5e, 0xd6, 0xde, 0xcd, 0xbd, 0xa4, 0xbd, 0x2b, 0x4f, 0x91, 0xb5, 0xca, 0x10,
+	0xbd, 0xaf, 0x47, 0x3c, 0xa2, 0xca, 0x19, 0x6c, 0x47, 0xe8, 0x37, 0xfd, 0x54, 0x81, 0xf3, 0x29,
+	0x83, 0x3d, 0xf6, 0x70, 0xc6, 0x67, 0x1d, 0xb8, 0x21, 0x4f, 0x0e, 0xc9, 0x50, 0xc8, 0xad, 0x21,
+	0x97, 0x8e, 0xce, 0xeb, 0x98, 0x6f, 0x8c, 0x9d, 0x2d, 0x9b, 0x88, 0xc6, 0x51, 0xd4, 0xdf, 0x57,
+	0xe0, 0x42, 0x7a, 0xaa, 0x33, 0xf8, 0x93, 0x9b, 0x30, 0xcf, 0x86, 0x0e, 0x94, 0xf8, 0xda, 0xf8,
+	0xcd, 0x0b, 0x37, 0x47, 0x0b, 0x10, 0xd5, 0x5d, 0x58, 0x0d, 0xdc, 0xce, 0xf0, 0xf0, 0x76, 0xb0,
+	0xaf, 0x8f, 0x89, 0xb9, 0xaf, 0x41, 0x8d, 0x05, 0x6f, 0x2c, 0x96, 0x65, 0xd9, 0x2a, 0xd8, 0x17,
+	0x49, 0x5e, 0xf5, 0x3f, 0x14, 0x58, 0xa1, 0x7e, 0x5b, 0xb2, 0x70, 0x98, 0xa7, 0xa8, 0xac, 0x42,
+	0x3d, 0x92, 0xf8, 0x62, 0x4b, 0xab, 0x6a, 0xb1, 0x3e, 0xd4, 0x49, 0xe7, 0x80, 0xa5, 0xb9, 0x99,
+	0xf0, 0x16, 0x02, 0xc9, 0x03, 0xd1, 0x4b, 0x08, 0xc9, 0xe4, 0x6f, 0xe8, 0x2f, 0x96, 0xa6, 0xf1,
+	0x17, 0x1f, 0xc3, 0xf9, 0xc4, 0x4a, 0x67, 0x38, 0x51, 0xf5, 0xcf, 0x14, 0x72, 0x1c, 0xb1, 0x7b,
+	0x6e, 0xd3, 0xc7, 0x4c, 0x57, 0x44, 0xc5, 0xb2, 0x6b, 0x1a, 0x49, 0x35, 0x64, 0xa0, 0x4f, 0xa0,
+	0x6a, 0xe3, 0x93, 0x6e, 0xd4, 0x0d, 0xcf, 0x11, 0x50, 0x56, 0x6c, 0x7c, 0x42, 0x7f, 0xa9, 0x4f,
+	0xe0, 0x42, 0x6a, 0xaa, 0xb3, 0xac, 0xfd, 0x6f, 0x15, 0xb8, 0xb8, 0xed, 0x3a, 0xc3, 0xcf, 0x4c,
+	0xd7, 0x1f, 0xe9, 0x56, 0xfc, 0x7e, 0xc7, 0xab, 0x49, 0xaa, 0x7e, 0x1a, 0x51, 0x3f, 0x8c, 0x7f,
+	0x6e, 0x4b, 0x24, 0x28, 0x3d, 0xa9, 0xb4, 0x1a, 0xfa, 0xf7, 0x22, 0x5c, 0xcc, 0x84, 0x9b, 0x60,
+	0x4b, 0xf3, 0xc4, 0xb6, 0xd2, 0x1a, 0x4c, 0x71, 0xda, 0x1a, 0xcc, 0x2f, 0x99, 0x71, 0x45, 0x9f,
+	0x42, 0xbc, 0x3e, 0xd6, 0x2a, 0xe7, 0x2e, 0x3b, 0xc4, 0x11, 0xd1, 0x26, 0x40, 0x58, 0x2b, 0x6a,
+	0xcd, 0xe7, 0x1e, 0x26, 0x82, 0x45, 0x4e, 0x4b, 0x18, 0x63, 0xee, 0x0a, 0x86, 0x1d, 0xea, 0xb7,
+	0xa0, 0x2d, 0xe3, 0xd2, 0x59, 0x38, 0xff, 0xe7, 0x05, 0x80, 0x8e, 0xb8, 0xd9, 0x3e, 0x9d, 0x2d,
+	0x78, 0x03, 0x22, 0xee, 0x6a, 0x28, 0xef, 0x51, 0x2e, 0x32, 0x88, 0x48, 0x88, 0x74, 0x08, 0x81,
+	0x49, 0xa5, 0x48, 0x0c, 0x3a, 0x4e, 0x44, 0x6a, 0x18, 0x53, 0x24, 0xd5, 0xef, 0x25, 0xa8, 0x92,
+	0x3a, 0x3c, 0x11, 0x33, 0x23, 0xb8, 0xba, 0xef, 0x3a, 0x27, 0x44, 0xf8, 0x0c, 0x52, 0x7a, 0x25,
+	0x77, 0x8a, 0xc8, 0xf8, 0xe5, 0xc8, 0x15, 0x23, 0x83, 0x64, 0x32, 0x0f, 0x4c, 0x0b, 0xb3, 0x1b,
+	0x2d, 0x55, 0x8d, 0x35, 0xc8, 0x85, 0x00, 0x76, 0xc7, 0xb4, 0x92, 0xfb, 0x1a, 0x19, 0x85, 0x27,
+	0x29, 0xd0, 0xc5, 0x70, 0xd7, 0xa8, 0x02, 0x22, 0x3a, 0x8d, 0xea, 0xb3, 0x2d, 0xc7, 0x60, 0xaa,
+	0xa2, 0x91, 0x61, 0x11, 0x18, 0x22, 0xd3, 0x5a, 0x21, 0xca, 0xb8, 0x0c, 0x0d, 0x59, 0x17, 0x59,
+	0xb4, 0x69, 0x04, 0xd7, 0xaa, 0xca, 0xae, 0x73, 0xd2, 0x31, 0xc4, 0x6e, 0xb0, 0x7b, 0xf9, 0x2c,
+	0x1f, 0x41, 0x76, 0x63, 0x8b, 0xb4, 0xc9, 0x7e, 0x62, 0xd7, 0x75, 0xdc, 0xee, 0x00, 0x7b, 0x9e,
+	0xde, 0xc7, 0x3c, 0x80, 0xab, 0xd3, 0xce, 0x1d, 0xd6, 0xa7, 0xfe, 0x41, 0x09, 0x1a, 0xe1, 0x52,
+	0x82, 0x4b, 0x1c, 0xa6, 0x11, 0x5c, 0xe2, 0x30, 0xc9, 0xd1, 0x81, 0xcb, 0x54, 0xa1, 0x38, 0xdc,
+	0xcd, 0x42, 0x4b, 0xd1, 0xaa, 0xbc, 0xb7, 0x63, 0x10, 0xb3, 0x4c, 0x84, 0xcc, 0x76, 0x0c, 0x1c,
+	0x1e, 0x2e, 0x04, 0x5d, 0xfc, 0x6c, 0x63, 0x3c, 0x52, 0xca, 0xc1, 0x23, 0x73, 0x39, 0x78, 0xa4,
+	0x2c, 0xe1, 0x91, 0x55, 0x28, 0xef, 0x8f, 0x7a, 0x47, 0xd8, 0xe7, 0x3e, 0x1f, 0x6f, 0xc5, 0x79,
+	0xa7, 0x92, 0xe0, 0x1d, 0xc1, 0x22, 0xd5, 0x28, 0x8b, 0x5c, 0x82, 0x2a, 0xbb, 0x4d, 0xd0, 0xf5,
+	0x3d, 0x1e, 0x4f, 0x55, 0x58, 0xc7, 0x9e, 0x47, 0x2e, 0xf4, 0x32, 0x13, 0x56, 0x93, 0x09, 0x3b,
+	0xd5, 0x3a, 0x09, 0x2e, 0x09, 0x9c, 0xb9, 0xb7, 0x61, 0x31, 0xb2, 0x1d, 0xd4, 0x46, 0xd4, 0xe9,
+	0x54, 0x23, 0xe1, 0x20, 0x35, 0x13, 0x37, 0xa1, 0x11, 0x6e, 0x09, 0x85, 0x5b, 0x60, 0x51, 0xb8,
+	0xe8, 0xa5, 0x60, 0x82, 0x93, 0x1b, 0x67, 0xe3, 0x64, 0x92, 0xfd, 0xe7, 0xe1, 0xb3, 0xd7, 0x5a,
+	0x8c, 0xe5, 0xc9, 0xd4, 0xef, 0x01, 0x0a, 0x67, 0x3f, 0x9b, 0xb7, 0x98, 0x60, 0x8f, 0x42, 0x92,
+	0x3d, 0xd4, 0x3f, 0x57, 0x60, 0x29, 0x4a, 0x6c, 0x5a, 0xc3, 0xfb, 0x09, 0xd4, 0x58, 0xe5, 0xb9,
+	0x4b, 0x04, 0x9f, 0xe7, 0x1f, 0xaf, 0x8c, 0x3d, 0x17, 0x0d, 0xc2, 0x97, 0x3d, 0x84, 0xbd, 0x4e,
+	0x1c, 0xf7, 0x88, 0x84, 0xba, 0x64, 0x66, 0x81, 0xb8, 0xd5, 0x79, 0x27, 0xa9, 0xe6, 0x79, 0xea,
+	0x5f, 0x14, 0x60, 0xe9, 0xc1, 0x0b, 0x2a, 0xc4, 0x4c, 0x40, 0x29, 0x6a, 0x44, 0xf7, 0x28, 0x31,
+	0xdd, 0x93, 0x4b, 0x3d, 0x86, 0xae, 0x60, 0x71, 0x0a, 0x57, 0x90, 0x54, 0x41, 0x82, 0x9c, 0x2b,
+	0x73, 0x25, 0xf3, 0x54, 0x41, 0x38, 0x06, 0xa9, 0x8f, 0x52, 0x67, 0x9a, 0xa9, 0x07, 0xfa, 0x9b,
+	0x88, 0xd0, 0x81, 0xe3, 0x0e, 0x74, 0x9f, 0xd7, 0xc8, 0x78, 0x2b, 0x5e, 0x0b, 0x9e, 0x4f, 0xd6,
+	0x82, 0x11, 0x94, 0xf0, 0x8b, 0xa1, 0x4b, 0x65, 0xab, 0xaa, 0xd1, 0xdf, 0xea, 0x00, 0xce, 0xc7,
+	0x36, 0xeb, 0x15, 0x73, 0xd2, 0x5f, 0x2b, 0xb0, 0x92, 0xa0, 0x37, 0x2d, 0x33, 0x3d, 0xa0, 0x8f,
+	0x92, 0x12, 0xcc, 0x24, 0x0b, 0x12, 0x53, 0xcc, 0x40, 0x9f, 0x2e, 0x9d, 0x89, 0xa7, 0x7e, 0xa8,
+	0xc0, 0xd5, 0x67, 0x43, 0x43, 0xf7, 0x71, 0xc4, 0xab, 0x9d, 0xf5, 0x96, 0xf6, 0x07, 0xc1, 0x35,
+	0xe9, 0x42, 0xbe, 0x8a, 0x3c, 0x83, 0x56, 0xff, 0x52, 0xcc, 0x25, 0xf5, 0xb4, 0x61, 0xfa, 0xb9,
+	0xb4, 0xa1, 0x72, 0xcc, 0x87, 0x0b, 0x5e, 0xbf, 0x05, 0xed, 0xd8, 0xad, 0x8f, 0xe2, 0xd9, 0x6f,
+	0x7d, 0xa8, 0x3b, 0xe4, 0x7e, 0xb3, 0x87, 0x6d, 0x23, 0xb6, 0x9a, 0xa9, 0x73, 0xe7, 0x43, 0x68,
+	0xcb, 0x86, 0x9b, 0x85, 0x6d, 0x59, 0x3c, 0xd4, 0x75, 0xb1, 0xc7, 0xca, 0x22, 0x45, 0xee, 0x86,
+	0x53, 0x3a, 0x3e, 0xd1, 0x28, 0x17, 0xee, 0x1b, 0x06, 0xf7, 0x0c, 0x66, 0xe5, 0xdb, 0x09, 0xc1,
+	0x57, 0x32, 0x38, 0x29, 0xa6, 0x83, 0x93, 0x97, 0x65, 0xad, 0xb9, 0xdf, 0x42, 0xaa, 0xdb, 0xdc,
+	0x1f, 0x73, 0xd9, 0x8d, 0xc9, 0x8f, 0xf8, 0x35, 0x00, 0x92, 0x66, 0x6a, 0xcd, 0xe7, 0xf2, 0xd9,
+	0x2b, 0x41, 0x0d, 0x40, 0x1d, 0x42, 0x2b, 0xbd, 0x59, 0x33, 0x2a, 0x95, 0x60, 0x47, 0x86, 0x0e,
+	0xcb, 0x28, 0xd6, 0x35, 0xe0, 0x5d, 0x4f, 0x1d, 0x4f, 0xfd, 0xaf, 0x02, 0xb4, 0xc8, 0xad, 0xb8,
+	0xff, 0x3b, 0x07, 0xf4, 0x6d, 0x58, 0xf1, 0xf4, 0x63, 0xdc, 0x8d, 0x24, 0x5b, 0xba, 0x2e, 0x7e,
+	0xce, 0xc3, 0x9a, 0x77, 0x64, 0x9a, 0x44, 0x7a, 0x6b, 0x50, 0x5b, 0xf2, 0x62, 0xfd, 0x1a, 0x7e,
+	0x8e, 0xde, 0x82, 0xc5, 0xe8, 0xcd, 0x55, 0x32, 0xb5, 0x0a, 0xdd, 0xf2, 0x85, 0xc8, 0xc5, 0xd4,
+	0x8e, 0xa1, 0x3e, 0x87, 0xcb, 0xcf, 0x6c, 0x0f, 0xfb, 0x9d, 0xf0, 0x72, 0xe5, 0x8c, 0x69, 0x89,
+	0x6b, 0x50, 0x0b, 0x37, 0x3e, 0xf5, 0xe2, 0xcd, 0xf0, 0x54, 0x07, 0xda, 0x3b, 0xba, 0x7b, 0xc4,
+	0x4f, 0xd8, 0xdb, 0x66, 0x37, 0xdc, 0x5e, 0x21, 0xc1, 0x03, 0x71, 0xe1, 0x53, 0xc3, 0x07, 0xd8,
+	0xc5, 0x76, 0x0f, 0x93, 0xc7, 0x19, 0x91, 0xb7, 0x12, 0x51, 0x67, 0x62, 0x7b, 0xda, 0xb7, 0x17,
+	0xea, 0xcf, 0x0a, 0xb0, 0x7a, 0xdf, 0xf2, 0xb1, 0x1b, 0xba, 0x10, 0x67, 0x49, 0x8c, 0x85, 0xee,
+	0x49, 0x61, 0x1a, 0xf7, 0x24, 0xf9, 0xec, 0xa7, 0x98, 0x7e, 0xf6, 0x23, 0xcb, 0xab, 0x95, 0xa6,
+	0xcc, 0xab, 0xdd, 0x07, 0x18, 0xba, 0xce, 0x10, 0xbb, 0xbe, 0x89, 0x83, 0x94, 0x40, 0x0e, 0x97,
+	0x38, 0x82, 0x74, 0xeb, 0x13, 0x71, 0xaf, 0x9d, 0xd4, 0x79, 0xd0, 0x3c, 0x14, 0x9f, 0xe0, 0x93,
+	0xe6, 0x39, 0x04, 0x50, 0x7e, 0x42, 0x3c, 0x21, 0xab, 0xa9, 0xa0, 0x1a, 0xcc, 0xf3, 0x1a, 0x7d,
+	0xb3, 0x80, 0x16, 0xa0, 0xba, 0x15, 0x14, 0x15, 0x9b, 0xc5, 0x5b, 0x7f, 0xa4, 0xc0, 0x52, 0xaa,
+	0x8a, 0x8c, 0x1a, 0x00, 0xcf, 0xec, 0x1e, 0x2f, 0xaf, 0x37, 0xcf, 0xa1, 0x3a, 0x54, 0x82, 0x62,
+	0x3b, 0x1b, 0x6f, 0xcf, 0xa1, 0xd0, 0xcd, 0x02, 0x6a, 0x42, 0x9d, 0x21, 0x8e, 0x7a, 0x3d, 0xec,
+	0x79, 0xcd, 0xa2, 0xe8, 0x79, 0xa8, 0x9b, 0xd6, 0xc8, 0xc5, 0xcd, 0x12, 0xa1, 0xb9, 0xe7, 0xf0,
+	0x97, 0x3d, 0xcd, 0x39, 0x84, 0xa0, 0xc1, 0x1b, 0x01, 0x52, 0x39, 0xd2, 0x17, 0xa0, 0xcd, 0xdf,
+	0x7a, 0x1e, 0xad, 0xd8, 0xd1, 0xe5, 0x5d, 0x80, 0xe5, 0x67, 0xb6, 0x81, 0x0f, 0x4c, 0x1b, 0x1b,
+	0xe1, 0xa7, 0xe6, 0x39, 0xb4, 0x0c, 0x8b, 0x3b, 0xd8, 0xed, 0xe3, 0x48, 0x67, 0x01, 0x2d, 0xc1,
+	0xc2, 0x8e, 0xf9, 0x22, 0xd2, 0x55, 0x44, 0x2d, 0x58, 0xd9, 0x12, 0x25, 0x9e, 0xc8, 0x97, 0x92,
+	0x5a, 0xaa, 0x28, 0x4d, 0x65, 0xe3, 0x3f, 0xaf, 0x40, 0x95, 0x1c, 0xd7, 0x96, 0xe3, 0xb8, 0x06,
+	0xb2, 0x00, 0xd1, 0x27, 0x72, 0x83, 0xa1, 0x63, 0x8b, 0x37, 0xb5, 0x68, 0x3d, 0x7e, 0x42, 0xbc,
+	0x91, 0x06, 0xe4, 0x7c, 0xdb, 0x7e, 0x53, 0x0a, 0x9f, 0x00, 0x56, 0xcf, 0xa1, 0x01, 0xa5, 0x46,
+	0xaa, 0x81, 0x7b, 0x66, 0xef, 0x28, 0xf0, 0x39, 0xee, 0x65, 0x78, 0x18, 0x69, 0xd0, 0x80, 0xde,
+	0x1b, 0x52, 0x7a, 0xec, 0x0d, 0x63, 0x60, 0x7f, 0xd4, 0x73, 0xe8, 0x39, 0xac, 0x3c, 0xc2, 0x11,
+	0xf7, 0x2d, 0x20, 0xb8, 0x91, 0x4d, 0x30, 0x05, 0x7c, 0x46, 0x92, 0x8f, 0x61, 0x8e, 0x32, 0x22,
+	0x92, 0x79, 0x78, 0xd1, 0xbf, 0xbf, 0x68, 0x5f, 0xcf, 0x06, 0x10, 0xa3, 0x7d, 0x0f, 0x16, 0x13,
+	0x8f, 0xe6, 0x91, 0x4c, 0xdf, 0xcb, 0xff, 0xfe, 0xa0, 0x7d, 0x2b, 0x0f, 0xa8, 0xa0, 0xd5, 0x87,
+	0x46, 0xfc, 0x69, 0x1d, 0x5a, 0xcb, 0xf1, 0x4a, 0x97, 0x51, 0x7a, 0x27, 0xf7, 0x7b, 0x5e, 0xca,
+	0x04, 0xcd, 0xe4, 0x23, 0x6e, 0x74, 0x6b, 0xec, 0x00, 0x71, 0x66, 0x7b, 0x37, 0x17, 0xac, 0x20,
+	0x77, 0x0a, 0x2b, 0xb2, 0xc7, 0xb3, 0x68, 0x5d, 0x3e, 0x4c, 0xd6, 0xab, 0xde, 0xf6, 0xdd, 0xdc,
+	0xf0, 0x82, 0xf4, 0x6f, 0xb0, 0xeb, 0x79, 0xb2, 0x07, 0xa8, 0xe8, 0x3d, 0xf9, 0x70, 0x63, 0x5e,
+	0xce, 0xb6, 0x37, 0xce, 0x82, 0x22, 0x26, 0xf1, 0x03, 0x58, 0x95, 0x3f, 0xe1, 0x44, 0xf7, 0xe4,
+	0xe3, 0x65, 0xbf, 0x4e, 0x6d, 0xbf, 0x77, 0x06, 0x0c, 0x31, 0x01, 0x27, 0xf9, 0x4a, 0x3e, 0x10,
+	0xc3, 0xbb, 0x13, 0xb9, 0x66, 0x3a, 0x19, 0xfc, 0x0e, 0x2c, 0x26, 0x3c, 0x20, 0x94, 0xdf, 0x4b,
+	0x6a, 0x8f, 0x73, 0x53, 0x99, 0x48, 0x26, 0xae, 0x29, 0xa2, 0x0c, 0xee, 0x97, 0x5c, 0x65, 0x6c,
+	0xdf, 0xca, 0x03, 0x2a, 0x16, 0xe2, 0x51, 0x75, 0x99, 0xb8, 0x7c, 0x86, 0x6e, 0xcb, 0xc7, 0x90,
+	0x5f, 0xb2, 0x6b, 0xdf, 0xc9, 0x09, 0x2d, 0x88, 0x1e, 0xc3, 0xb2, 0xe4, 0x8e, 0x20, 0xba, 0x33,
+	0xf6, 0xb0, 0x92, 0x97, 0x23, 0xdb, 0xeb, 0x79, 0xc1, 0x05, 0xdd, 0x5f, 0x07, 0xb4, 0x7b, 0x48,
+	0xf2, 0xa5, 0xf6, 0x81, 0xd9, 0x1f, 0xb9, 0x3a, 0xf3, 0x1f, 0xb2, 0x6c, 0x43, 0x1a, 0x34, 0x83,
+	0x47, 0xc7, 0x62, 0x08, 0xe2, 0x5d, 0x80, 0x47, 0xd8, 0xdf, 0xc1, 0xbe, 0x4b, 0x04, 0xe3, 0xad,
+	0x2c, 0xf3, 0xc7, 0x01, 0x02, 0x52, 0x6f, 0x4f, 0x84, 0x8b, 0x98, 0xa2, 0xe6, 0x8e, 0x6e, 0x93,
+	0x52, 0x41, 0xf8, 0x0e, 0xea, 0xb6, 0x14, 0x3d, 0x09, 0x96, 0x71, 0x90, 0x99, 0xd0, 0x82, 0xe4,
+	0x89, 0x30, 0xed, 0x91, 0xc2, 0xef, 0x78, 0xd3, 0x9e, 0xbe, 0xef, 0xd6, 0xbe, 0x9b, 0x1b, 0x5e,
+	0x10, 0xfe, 0x42, 0x81, 0x4b, 0x69, 0x80, 0xcf, 0x4d, 0xff, 0x90, 0xdc, 0x49, 0xf2, 0xf2, 0x4c,
+	0x81, 0x02, 0x9e, 0x61, 0x0a, 0x1c, 0x5e, 0x4c, 0xc1, 0x80, 0x85, 0x58, 0x3d, 0x16, 0xc9, 0x5e,
+	0x05, 0xc9, 0x6a, 0xd3, 0xed, 0xb5, 0xc9, 0x80, 0x82, 0xca, 0x21, 0x2c, 0x04, 0xa2, 0xc4, 0x36,
+	0xf7, 0x9d, 0xac, 0x99, 0x86, 0x30, 0x19, 0x9a, 0x40, 0x0e, 0x1a, 0xd5, 0x04, 0xe9, 0x72, 0x13,
+	0xca, 0x57, 0xa6, 0x1c, 0xa7, 0x09, 0xb2, 0x6b, 0x58, 0x4c, 0xd5, 0x25, 0x4a, 0xbb, 0x72, 0x3d,
+	0x2a, 0xad, 0x54, 0xb7, 0x6f, 0xe5, 0x01, 0x15, 0xb4, 0x3e, 0x87, 0x32, 0xff, 0xcf, 0xa7, 0x37,
+	0xc7, 0xa7, 0x88, 0xf9, 0xe8, 0x37, 0x27, 0x40, 0x45, 0x39, 0x21, 0x96, 0x13, 0x94, 0x72, 0x82,
+	0x2c, 0x4b, 0xd9, 0x5e, 0x9b, 0x0c, 0x28, 0xa8, 0x1c, 0xc1, 0x85, 0x8c, 0x94, 0xa1, 0xd4, 0xd0,
+	0x8f, 0x4f, 0x2f, 0x4e, 0x32, 0x41, 0x82, 0x58, 0x2a, 0x27, 0x38, 0x86, 0x58, 0x56, 0xfe, 0x70,
+	0x12, 0x31, 0x1d, 0x50, 0xfa, 0x0f, 0x15, 0xa4, 0x9c, 0x97, 0xf9, 0xbf, 0x0b, 0x39, 0x48, 0xa4,
+	0xff, 0x13, 0x41, 0x4a, 0x22, 0xf3, 0xaf, 0x13, 0x26, 0x91, 0xe8, 0xc2, 0x52, 0x2a, 0x69, 0x84,
+	0xde, 0xcd, 0x70, 0x0a, 0x64, 0xa9, 0xa5, 0x49, 0x04, 0xfa, 0x70, 0x5e, 0x9a, 0x20, 0x91, 0x3a,
+	0x39, 0xe3, 0x52, 0x29, 0x93, 0x08, 0xf5, 0x60, 0x59, 0x92, 0x16, 0x91, 0x9a, 0xe7, 0xec, 0xf4,
+	0xc9, 0x24, 0x22, 0x07, 0xd0, 0xde, 0x74, 0x1d, 0xdd, 0xe8, 0xe9, 0x9e, 0x4f, 0x53, 0x15, 0xd8,
+	0x08, 0xbd, 0x4c, 0x79, 0x08, 0x22, 0x4d, 0x68, 0x4c, 0xa2, 0xb3, 0x0f, 0x35, 0xca, 0x90, 0xec,
+	0x9f, 0x8b, 0x90, 0xdc, 0x9e, 0x46, 0x20, 0x32, 0x44, 0x53, 0x06, 0x18, 0x88, 0xe6, 0xc6, 0x9f,
+	0x02, 0x54, 0x82, 0xe7, 0x5f, 0x5f, 0x72, 0xb8, 0xfb, 0x1a, 0xe2, 0xcf, 0xef, 0xc0, 0x62, 0xe2,
+	0xdf, 0x1a, 0xa4, 0xc7, 0x25, 0xff, 0x47, 0x87, 0x49, 0xc7, 0xf5, 0x39, 0xff, 0x9b, 0x44, 0xe1,
+	0x8a, 0xbe, 0x9d, 0x15, 0xc3, 0x26, 0xbd, 0xd0, 0x09, 0x03, 0xff, 0xef, 0xf6, 0xfd, 0x9e, 0x00,
+	0x44, 0xbc, 0xbe, 0xf1, 0x57, 0x99, 0x89, 0x23, 0x33, 0x69, 0xb7, 0x06, 0x52, 0xc7, 0xee, 0x9d,
+	0x3c, 0xb7, 0xfe, 0xb2, 0x4d, 0x73, 0xb6, 0x3b, 0xf7, 0x0c, 0xea, 0xd1, 0xe7, 0x0b, 0x48, 0xfa,
+	0xa7, 0x7c, 0xe9, 0xf7, 0x0d, 0x93, 0x56, 0xb1, 0x73, 0x46, 0x8b, 0x3f, 0x99, 0x37, 0xa7, 0xb4,
+	0xf3, 0x13, 0x06, 0xf6, 0x00, 0xa5, 0x4b, 0x50, 0x19, 0xd6, 0x29, 0xa3, 0xf0, 0xd5, 0xbe, 0x93,
+	0x13, 0x3a, 0x9a, 0x23, 0x49, 0xd6, 0x55, 0xa4, 0x39, 0x92, 0x8c, 0x4a, 0x55, 0xfb, 0xdd, 0x5c,
+	0xb0, 0x01, 0xb9, 0xcd, 0xf7, 0xbf, 0xfd, 0x5e, 0xdf, 0xf4, 0x0f, 0x47, 0xfb, 0x64, 0xf5, 0x77,
+	0x19, 0xea, 0x1d, 0xd3, 0xe1, 0xbf, 0xee, 0x06, 0x72, 0x74, 0x97, 0x8e, 0x76, 0x97, 0x8c, 0x36,
+	0xdc, 0xdf, 0x2f, 0xd3, 0xd6, 0xfb, 0xff, 0x33, 0x00, 0x92, 0xf5, 0xf8, 0x46, 0x41, 0x56, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5299,6 +5645,7 @@ type DataCoordClient interface {
 	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	ExportSegment(ctx context.Context, in *ExportSegmentRequest, opts ...grpc.CallOption) (*ExportSegmentResponse, error)
 	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -5543,6 +5890,15 @@ func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opt
 	return out, nil
 }
 
+func (c *dataCoordClient) ExportSegment(ctx context.Context, in *ExportSegmentRequest, opts ...grpc.CallOption) (*ExportSegmentResponse, error) {
+	out := new(ExportSegmentResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ExportSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
@@ -5653,6 +6009,7 @@ type DataCoordServer interface {
 	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
+	ExportSegment(context.Context, *ExportSegmentRequest) (*ExportSegmentResponse, error)
 	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
 	AcquireSegmentLock(context.Context, *AcquireSegmentLockRequest) (*commonpb.Status, error)
@@ -5743,6 +6100,9 @@ func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *S
 func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataCoordServer) ExportSegment(ctx context.Context, req *ExportSegmentRequest) (*ExportSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSegment not implemented")
+}
 func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
 }
@@ -6225,6 +6585,24 @@ func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ExportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ExportSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ExportSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ExportSegment(ctx, req.(*ExportSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSegmentStatisticsRequest)
 	if err := dec(in); err != nil {
@@ -6491,6 +6869,10 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataCoord_Import_Handler,
 		},
+		{
+			MethodName: "ExportSegment",
+			Handler:    _DataCoord_ExportSegment_Handler,
+		},
 		{
 			MethodName: "UpdateSegmentStatistics",
 			Handler:    _DataCoord_UpdateSegmentStatistics_Handler,
@@ -6548,6 +6930,7 @@ type DataNodeClient interface {
 	SyncSegments(ctx context.Context, in *SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ExportSegment(ctx context.Context, in *ExportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
 }
@@ -6650,6 +7033,15 @@ func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts
 	return out, nil
 }
 
+func (c *dataNodeClient) ExportSegment(ctx context.Context, in *ExportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/ExportSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error) {
 	out := new(ResendSegmentStatsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/ResendSegmentStats", in, out, opts...)
@@ -6682,6 +7074,7 @@ type DataNodeServer interface {
 	SyncSegments(context.Context, *SyncSegmentsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
+	ExportSegment(context.Context, *ExportSegmentRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
 }
@@ -6720,6 +7113,9 @@ func (*UnimplementedDataNodeServer) SyncSegments(ctx context.Context, req *SyncS
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataNodeServer) ExportSegment(ctx context.Context, req *ExportSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSegment not implemented")
+}
 func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendSegmentStats not implemented")
 }
@@ -6911,6 +7307,24 @@ func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_ExportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ExportSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/ExportSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ExportSegment(ctx, req.(*ExportSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_ResendSegmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendSegmentStatsRequest)
 	if err := dec(in); err != nil {
@@ -6991,6 +7405,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
 		},
+		{
+			MethodName: "ExportSegment",
+			Handler:    _DataNode_ExportSegment_Handler,
+		},
 		{
 			MethodName: "ResendSegmentStats",
 			Handler:    _DataNode_ResendSegmentStats_Handler,
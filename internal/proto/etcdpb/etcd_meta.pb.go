@@ -115,6 +115,37 @@ func (AliasState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_975d306d62b73e88, []int{2}
 }
 
+type DatabaseState int32
+
+const (
+	DatabaseState_DatabaseCreated  DatabaseState = 0
+	DatabaseState_DatabaseCreating DatabaseState = 1
+	DatabaseState_DatabaseDropping DatabaseState = 2
+	DatabaseState_DatabaseDropped  DatabaseState = 3
+)
+
+var DatabaseState_name = map[int32]string{
+	0: "DatabaseCreated",
+	1: "DatabaseCreating",
+	2: "DatabaseDropping",
+	3: "DatabaseDropped",
+}
+
+var DatabaseState_value = map[string]int32{
+	"DatabaseCreated":  0,
+	"DatabaseCreating": 1,
+	"DatabaseDropping": 2,
+	"DatabaseDropped":  3,
+}
+
+func (x DatabaseState) String() string {
+	return proto.EnumName(DatabaseState_name, int32(x))
+}
+
+func (DatabaseState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{3}
+}
+
 type IndexInfo struct {
 	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
@@ -252,6 +283,7 @@ type CollectionInfo struct {
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	State                      CollectionState           `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.etcd.CollectionState" json:"state,omitempty"`
 	Properties                 []*commonpb.KeyValuePair  `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
+	DbId                       int64                     `protobuf:"varint,15,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
 	XXX_unrecognized           []byte                    `json:"-"`
 	XXX_sizecache              int32                     `json:"-"`
@@ -380,6 +412,13 @@ func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
 type PartitionInfo struct {
 	PartitionID               int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string         `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
@@ -456,6 +495,7 @@ type AliasInfo struct {
 	CollectionId         int64      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
 	CreatedTime          uint64     `protobuf:"varint,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	State                AliasState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.etcd.AliasState" json:"state,omitempty"`
+	DbId                 int64      `protobuf:"varint,5,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -514,6 +554,84 @@ func (m *AliasInfo) GetState() AliasState {
 	return AliasState_AliasCreated
 }
 
+func (m *AliasInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+type DatabaseInfo struct {
+	TenantId             string        `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	State                DatabaseState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.etcd.DatabaseState" json:"state,omitempty"`
+	CreatedTime          uint64        `protobuf:"varint,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetTenantId() string {
+	if m != nil {
+		return m.TenantId
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetState() DatabaseState {
+	if m != nil {
+		return m.State
+	}
+	return DatabaseState_DatabaseCreated
+}
+
+func (m *DatabaseInfo) GetCreatedTime() uint64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -532,7 +650,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{6}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -626,7 +744,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -706,7 +824,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{7}
+	return fileDescriptor_975d306d62b73e88, []int{8}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -766,11 +884,13 @@ func init() {
 	proto.RegisterEnum("milvus.proto.etcd.CollectionState", CollectionState_name, CollectionState_value)
 	proto.RegisterEnum("milvus.proto.etcd.PartitionState", PartitionState_name, PartitionState_value)
 	proto.RegisterEnum("milvus.proto.etcd.AliasState", AliasState_name, AliasState_value)
+	proto.RegisterEnum("milvus.proto.etcd.DatabaseState", DatabaseState_name, DatabaseState_value)
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
 	proto.RegisterType((*PartitionInfo)(nil), "milvus.proto.etcd.PartitionInfo")
 	proto.RegisterType((*AliasInfo)(nil), "milvus.proto.etcd.AliasInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
@@ -779,69 +899,76 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x8e, 0xdc, 0x44,
-	0x14, 0x8d, 0xdb, 0xfd, 0xf2, 0xed, 0xc7, 0x74, 0x17, 0xc9, 0xc8, 0x19, 0x12, 0x70, 0x1a, 0x02,
-	0x56, 0xa4, 0xcc, 0x88, 0x19, 0x5e, 0x1b, 0x10, 0x61, 0xac, 0x48, 0x2d, 0x20, 0x6a, 0x79, 0x46,
-	0x59, 0xb0, 0xb1, 0xaa, 0xed, 0x9a, 0xee, 0x42, 0x7e, 0xc9, 0x55, 0x3d, 0x30, 0x7f, 0xc0, 0x9f,
-	0xf0, 0x09, 0x7c, 0x01, 0x5f, 0xc3, 0x9a, 0x15, 0x1b, 0x54, 0x55, 0x7e, 0x77, 0x0f, 0x62, 0xc5,
-	0xce, 0xf7, 0x54, 0xdd, 0x5b, 0xf7, 0xdc, 0xc7, 0x31, 0x1c, 0x11, 0xee, 0x07, 0x5e, 0x44, 0x38,
-	0x3e, 0x4d, 0xb3, 0x84, 0x27, 0x68, 0x1e, 0xd1, 0xf0, 0x76, 0xc7, 0x94, 0x75, 0x2a, 0x4e, 0x4f,
-	0xc6, 0x7e, 0x12, 0x45, 0x49, 0xac, 0xa0, 0x93, 0x31, 0xf3, 0xb7, 0x24, 0xca, 0xaf, 0x2f, 0xfe,
-	0xd0, 0xc0, 0x58, 0xc6, 0x01, 0xf9, 0x65, 0x19, 0xdf, 0x24, 0xe8, 0x29, 0x00, 0x15, 0x86, 0x17,
-	0xe3, 0x88, 0x98, 0x9a, 0xa5, 0xd9, 0x86, 0x6b, 0x48, 0xe4, 0x0d, 0x8e, 0x08, 0x32, 0x61, 0x20,
-	0x8d, 0xa5, 0x63, 0x76, 0x2c, 0xcd, 0xd6, 0xdd, 0xc2, 0x44, 0x0e, 0x8c, 0x95, 0x63, 0x8a, 0x33,
-	0x1c, 0x31, 0x53, 0xb7, 0x74, 0x7b, 0x74, 0xfe, 0xec, 0xb4, 0x91, 0x4c, 0x9e, 0xc6, 0x77, 0xe4,
-	0xee, 0x2d, 0x0e, 0x77, 0x64, 0x85, 0x69, 0xe6, 0x8e, 0xa4, 0xdb, 0x4a, 0x7a, 0x89, 0xf8, 0x01,
-	0x09, 0x09, 0x27, 0x81, 0xd9, 0xb5, 0x34, 0x7b, 0xe8, 0x16, 0x26, 0x7a, 0x1f, 0x46, 0x7e, 0x46,
-	0x30, 0x27, 0x1e, 0xa7, 0x11, 0x31, 0x7b, 0x96, 0x66, 0x77, 0x5d, 0x50, 0xd0, 0x35, 0x8d, 0xc8,
-	0xc2, 0x81, 0xe9, 0x6b, 0x4a, 0xc2, 0xa0, 0xe2, 0x62, 0xc2, 0xe0, 0x86, 0x86, 0x24, 0x58, 0x3a,
-	0x92, 0x88, 0xee, 0x16, 0xe6, 0xfd, 0x34, 0x16, 0x7f, 0xf7, 0x60, 0x7a, 0x99, 0x84, 0x21, 0xf1,
-	0x39, 0x4d, 0x62, 0x19, 0x66, 0x0a, 0x9d, 0x32, 0x42, 0x67, 0xe9, 0xa0, 0xaf, 0xa0, 0xaf, 0x0a,
-	0x28, 0x7d, 0x47, 0xe7, 0xcf, 0x9b, 0x1c, 0xf3, 0xe2, 0x56, 0x41, 0xae, 0x24, 0xe0, 0xe6, 0x4e,
-	0x6d, 0x22, 0x7a, 0x9b, 0x08, 0x5a, 0xc0, 0x38, 0xc5, 0x19, 0xa7, 0x32, 0x01, 0x87, 0x99, 0x5d,
-	0x4b, 0xb7, 0x75, 0xb7, 0x81, 0xa1, 0x8f, 0x60, 0x5a, 0xda, 0xa2, 0x31, 0xcc, 0xec, 0x59, 0xba,
-	0x6d, 0xb8, 0x2d, 0x14, 0xbd, 0x86, 0xc9, 0x8d, 0x28, 0x8a, 0x27, 0xf9, 0x11, 0x66, 0xf6, 0x0f,
-	0xb5, 0x45, 0xcc, 0xc8, 0x69, 0xb3, 0x78, 0xee, 0xf8, 0xa6, 0xb4, 0x09, 0x43, 0xe7, 0xf0, 0xe8,
-	0x96, 0x66, 0x7c, 0x87, 0x43, 0xcf, 0xdf, 0xe2, 0x38, 0x26, 0xa1, 0x1c, 0x10, 0x66, 0x0e, 0xe4,
-	0xb3, 0xef, 0xe4, 0x87, 0x97, 0xea, 0x4c, 0xbd, 0xfd, 0x29, 0x1c, 0xa7, 0xdb, 0x3b, 0x46, 0xfd,
-	0x3d, 0xa7, 0xa1, 0x74, 0x7a, 0x58, 0x9c, 0x36, 0xbc, 0xbe, 0x81, 0x27, 0x25, 0x07, 0x4f, 0x55,
-	0x25, 0x90, 0x95, 0x62, 0x1c, 0x47, 0x29, 0x33, 0x0d, 0x4b, 0xb7, 0xbb, 0xee, 0x49, 0x79, 0xe7,
-	0x52, 0x5d, 0xb9, 0x2e, 0x6f, 0x88, 0x11, 0x66, 0x5b, 0x9c, 0x05, 0xcc, 0x8b, 0x77, 0x91, 0x09,
-	0x96, 0x66, 0xf7, 0x5c, 0x43, 0x21, 0x6f, 0x76, 0x11, 0x5a, 0xc2, 0x11, 0xe3, 0x38, 0xe3, 0x5e,
-	0x9a, 0x30, 0x19, 0x81, 0x99, 0x23, 0x59, 0x14, 0xeb, 0xbe, 0x59, 0x75, 0x30, 0xc7, 0x72, 0x54,
-	0xa7, 0xd2, 0x71, 0x55, 0xf8, 0x21, 0x17, 0xe6, 0x7e, 0x12, 0x33, 0xca, 0x38, 0x89, 0xfd, 0x3b,
-	0x2f, 0x24, 0xb7, 0x24, 0x34, 0xc7, 0x96, 0x66, 0x4f, 0xdb, 0x43, 0x91, 0x07, 0xbb, 0xac, 0x6e,
-	0x7f, 0x2f, 0x2e, 0xbb, 0x33, 0xbf, 0x85, 0xa0, 0x2f, 0xa1, 0xc7, 0x38, 0xe6, 0xc4, 0x9c, 0xc8,
-	0x38, 0x8b, 0x03, 0x9d, 0xaa, 0x8d, 0x96, 0xb8, 0xe9, 0x2a, 0x07, 0xf4, 0x0a, 0x20, 0xcd, 0x92,
-	0x94, 0x64, 0x9c, 0x12, 0x66, 0x4e, 0xff, 0xeb, 0xfe, 0xd5, 0x9c, 0x16, 0x7f, 0x69, 0x30, 0x59,
-	0x95, 0x73, 0x26, 0x86, 0xdf, 0x82, 0x51, 0x6d, 0xf0, 0xf2, 0x2d, 0xa8, 0x43, 0xe8, 0x43, 0x98,
-	0x34, 0x86, 0x4e, 0x6e, 0x85, 0xe1, 0x36, 0x41, 0xf4, 0x35, 0xbc, 0xfb, 0x2f, 0x6d, 0xcd, 0xb7,
-	0xe0, 0xf1, 0xbd, 0x5d, 0x45, 0x1f, 0xc0, 0xc4, 0x2f, 0x69, 0x7b, 0x54, 0xc9, 0x83, 0xee, 0x8e,
-	0x2b, 0x70, 0x19, 0xa0, 0x2f, 0x8a, 0xda, 0xf5, 0x64, 0xed, 0x0e, 0x4d, 0x79, 0xc9, 0xae, 0x5e,
-	0xba, 0xc5, 0x6f, 0x1a, 0x18, 0xaf, 0x42, 0x8a, 0x59, 0xa1, 0x81, 0x58, 0x18, 0x0d, 0x0d, 0x94,
-	0x88, 0xa4, 0xb2, 0x97, 0x4a, 0xe7, 0x40, 0x2a, 0xcf, 0x60, 0x5c, 0x67, 0x99, 0x13, 0xcc, 0x37,
-	0x5f, 0xf2, 0x42, 0x17, 0x45, 0xb6, 0x5d, 0x99, 0xed, 0xd3, 0x03, 0xd9, 0xca, 0x9c, 0x1a, 0x99,
-	0xfe, 0xda, 0x81, 0xd9, 0x15, 0xd9, 0x44, 0x24, 0xe6, 0x95, 0xd0, 0x2d, 0xa0, 0xfe, 0x78, 0xd1,
-	0xa5, 0x06, 0xd6, 0x6e, 0x64, 0x67, 0xbf, 0x91, 0x4f, 0xc0, 0x60, 0x79, 0x64, 0x47, 0xe6, 0xab,
-	0xbb, 0x15, 0xa0, 0xc4, 0x54, 0x28, 0x82, 0x93, 0x97, 0xbe, 0x30, 0xeb, 0x62, 0xda, 0x6b, 0xfe,
-	0x13, 0x4c, 0x18, 0xac, 0x77, 0x54, 0xfa, 0xf4, 0xd5, 0x49, 0x6e, 0x8a, 0xf2, 0x90, 0x18, 0xaf,
-	0x43, 0xa2, 0x84, 0xc9, 0x1c, 0x48, 0xb1, 0x1f, 0x29, 0x4c, 0x12, 0x6b, 0xeb, 0xe4, 0x70, 0x4f,
-	0xf0, 0xff, 0xd4, 0xea, 0x52, 0xfd, 0x03, 0xe1, 0xf8, 0x7f, 0x97, 0xea, 0xf7, 0x00, 0xca, 0x0a,
-	0x15, 0x42, 0x5d, 0x43, 0xd0, 0xf3, 0x9a, 0x4c, 0x7b, 0x1c, 0x6f, 0x0a, 0x99, 0xae, 0x96, 0xe3,
-	0x1a, 0x6f, 0xd8, 0x9e, 0xe2, 0xf7, 0xf7, 0x15, 0x7f, 0xf1, 0xbb, 0x60, 0x9b, 0x91, 0x80, 0xc4,
-	0x9c, 0xe2, 0x50, 0xb6, 0xfd, 0x04, 0x86, 0x3b, 0x46, 0xb2, 0xda, 0x94, 0x96, 0x36, 0x7a, 0x09,
-	0x88, 0xc4, 0x7e, 0x76, 0x97, 0x8a, 0x09, 0x4c, 0x31, 0x63, 0x3f, 0x27, 0x59, 0x90, 0xaf, 0xe6,
-	0xbc, 0x3c, 0x59, 0xe5, 0x07, 0xe8, 0x18, 0xfa, 0x9c, 0xc4, 0x38, 0xe6, 0x92, 0xa4, 0xe1, 0xe6,
-	0x16, 0x7a, 0x0c, 0x43, 0xca, 0x3c, 0xb6, 0x4b, 0x49, 0x56, 0xfc, 0x90, 0x29, 0xbb, 0x12, 0x26,
-	0xfa, 0x18, 0x8e, 0xd8, 0x16, 0x9f, 0x7f, 0xf6, 0x79, 0x15, 0xbe, 0x27, 0x7d, 0xa7, 0x0a, 0x2e,
-	0x62, 0xbf, 0x48, 0xe0, 0xa8, 0xa5, 0x58, 0xe8, 0x11, 0xcc, 0x2b, 0x28, 0xdf, 0xf5, 0xd9, 0x03,
-	0x74, 0x0c, 0xa8, 0x05, 0xd3, 0x78, 0x33, 0xd3, 0x9a, 0xb8, 0x93, 0x25, 0x69, 0x2a, 0xf0, 0x4e,
-	0x33, 0x8c, 0xc4, 0x49, 0x30, 0xd3, 0x5f, 0xfc, 0x04, 0xd3, 0xe6, 0x9a, 0xa3, 0x87, 0x30, 0x5b,
-	0xb5, 0xa4, 0x65, 0xf6, 0x40, 0xb8, 0x37, 0x51, 0xf5, 0x5a, 0x1d, 0xae, 0x3d, 0x56, 0x8f, 0x51,
-	0xbd, 0xf5, 0x16, 0xa0, 0x5a, 0x52, 0x34, 0x83, 0xb1, 0xb4, 0xaa, 0x37, 0xe6, 0x30, 0xa9, 0x10,
-	0x15, 0xbf, 0x80, 0x6a, 0xb1, 0x0b, 0xbf, 0x32, 0xee, 0xb7, 0x17, 0x3f, 0x7e, 0xb2, 0xa1, 0x7c,
-	0xbb, 0x5b, 0x0b, 0xcd, 0x3e, 0x53, 0x53, 0xfb, 0x92, 0x26, 0xf9, 0xd7, 0x19, 0x8d, 0xb9, 0x68,
-	0x74, 0x78, 0x26, 0x07, 0xf9, 0x4c, 0x88, 0x45, 0xba, 0x5e, 0xf7, 0xa5, 0x75, 0xf1, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf9, 0x76, 0x1c, 0x4f, 0x13, 0x0a, 0x00, 0x00,
+	// 1121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xde, 0xf1, 0xd8, 0x8e, 0x5d, 0xfe, 0x4d, 0x6f, 0x36, 0x9a, 0xcd, 0xee, 0xc2, 0xac, 0x21,
+	0x60, 0xad, 0xb4, 0x89, 0x48, 0x60, 0xe1, 0x02, 0x62, 0x89, 0xb5, 0x92, 0x05, 0xac, 0xac, 0x49,
+	0xb4, 0x07, 0x2e, 0xa3, 0xf6, 0x4c, 0x25, 0x6e, 0x34, 0x7f, 0x9a, 0x6e, 0x07, 0xf2, 0x06, 0x1c,
+	0x79, 0x0e, 0x5e, 0x80, 0x0b, 0x57, 0x9e, 0x86, 0x33, 0x77, 0xd4, 0xdd, 0xf3, 0x6b, 0x3b, 0x88,
+	0x13, 0x37, 0xd7, 0x37, 0x5d, 0xd5, 0xf5, 0x55, 0x7d, 0x5d, 0x65, 0x18, 0xa1, 0xf0, 0x7c, 0x37,
+	0x44, 0x41, 0x4f, 0x92, 0x34, 0x16, 0x31, 0xd9, 0x0f, 0x59, 0x70, 0xbb, 0xe6, 0xda, 0x3a, 0x91,
+	0x5f, 0x8f, 0xfa, 0x5e, 0x1c, 0x86, 0x71, 0xa4, 0xa1, 0xa3, 0x3e, 0xf7, 0x56, 0x18, 0x66, 0xc7,
+	0x27, 0x7f, 0x1a, 0xd0, 0x9d, 0x47, 0x3e, 0xfe, 0x3c, 0x8f, 0xae, 0x63, 0xf2, 0x0c, 0x80, 0x49,
+	0xc3, 0x8d, 0x68, 0x88, 0x96, 0x61, 0x1b, 0xd3, 0xae, 0xd3, 0x55, 0xc8, 0x5b, 0x1a, 0x22, 0xb1,
+	0x60, 0x4f, 0x19, 0xf3, 0x99, 0xd5, 0xb0, 0x8d, 0xa9, 0xe9, 0xe4, 0x26, 0x99, 0x41, 0x5f, 0x3b,
+	0x26, 0x34, 0xa5, 0x21, 0xb7, 0x4c, 0xdb, 0x9c, 0xf6, 0xce, 0x9e, 0x9f, 0xd4, 0x92, 0xc9, 0xd2,
+	0xf8, 0x16, 0xef, 0xde, 0xd1, 0x60, 0x8d, 0x0b, 0xca, 0x52, 0xa7, 0xa7, 0xdc, 0x16, 0xca, 0x4b,
+	0xc6, 0xf7, 0x31, 0x40, 0x81, 0xbe, 0xd5, 0xb4, 0x8d, 0x69, 0xc7, 0xc9, 0x4d, 0xf2, 0x3e, 0xf4,
+	0xbc, 0x14, 0xa9, 0x40, 0x57, 0xb0, 0x10, 0xad, 0x96, 0x6d, 0x4c, 0x9b, 0x0e, 0x68, 0xe8, 0x8a,
+	0x85, 0x38, 0x99, 0xc1, 0xf0, 0x0d, 0xc3, 0xc0, 0x2f, 0xb9, 0x58, 0xb0, 0x77, 0xcd, 0x02, 0xf4,
+	0xe7, 0x33, 0x45, 0xc4, 0x74, 0x72, 0xf3, 0x7e, 0x1a, 0x93, 0x5f, 0xdb, 0x30, 0xbc, 0x88, 0x83,
+	0x00, 0x3d, 0xc1, 0xe2, 0x48, 0x85, 0x19, 0x42, 0xa3, 0x88, 0xd0, 0x98, 0xcf, 0xc8, 0x97, 0xd0,
+	0xd6, 0x05, 0x54, 0xbe, 0xbd, 0xb3, 0xe3, 0x3a, 0xc7, 0xac, 0xb8, 0x65, 0x90, 0x4b, 0x05, 0x38,
+	0x99, 0xd3, 0x26, 0x11, 0x73, 0x93, 0x08, 0x99, 0x40, 0x3f, 0xa1, 0xa9, 0x60, 0x2a, 0x81, 0x19,
+	0xb7, 0x9a, 0xb6, 0x39, 0x35, 0x9d, 0x1a, 0x46, 0x3e, 0x82, 0x61, 0x61, 0xcb, 0xc6, 0x70, 0xab,
+	0x65, 0x9b, 0xd3, 0xae, 0xb3, 0x81, 0x92, 0x37, 0x30, 0xb8, 0x96, 0x45, 0x71, 0x15, 0x3f, 0xe4,
+	0x56, 0x7b, 0x57, 0x5b, 0xa4, 0x46, 0x4e, 0xea, 0xc5, 0x73, 0xfa, 0xd7, 0x85, 0x8d, 0x9c, 0x9c,
+	0xc1, 0xa3, 0x5b, 0x96, 0x8a, 0x35, 0x0d, 0x5c, 0x6f, 0x45, 0xa3, 0x08, 0x03, 0x25, 0x10, 0x6e,
+	0xed, 0xa9, 0x6b, 0x1f, 0x66, 0x1f, 0x2f, 0xf4, 0x37, 0x7d, 0xf7, 0xa7, 0x70, 0x98, 0xac, 0xee,
+	0x38, 0xf3, 0xb6, 0x9c, 0x3a, 0xca, 0xe9, 0x20, 0xff, 0x5a, 0xf3, 0xfa, 0x1a, 0x9e, 0x16, 0x1c,
+	0x5c, 0x5d, 0x15, 0x5f, 0x55, 0x8a, 0x0b, 0x1a, 0x26, 0xdc, 0xea, 0xda, 0xe6, 0xb4, 0xe9, 0x1c,
+	0x15, 0x67, 0x2e, 0xf4, 0x91, 0xab, 0xe2, 0x84, 0x94, 0x30, 0x5f, 0xd1, 0xd4, 0xe7, 0x6e, 0xb4,
+	0x0e, 0x2d, 0xb0, 0x8d, 0x69, 0xcb, 0xe9, 0x6a, 0xe4, 0xed, 0x3a, 0x24, 0x73, 0x18, 0x71, 0x41,
+	0x53, 0xe1, 0x26, 0x31, 0x57, 0x11, 0xb8, 0xd5, 0x53, 0x45, 0xb1, 0xef, 0xd3, 0xea, 0x8c, 0x0a,
+	0xaa, 0xa4, 0x3a, 0x54, 0x8e, 0x8b, 0xdc, 0x8f, 0x38, 0xb0, 0xef, 0xc5, 0x11, 0x67, 0x5c, 0x60,
+	0xe4, 0xdd, 0xb9, 0x01, 0xde, 0x62, 0x60, 0xf5, 0x6d, 0x63, 0x3a, 0x3c, 0x3b, 0xde, 0x19, 0xec,
+	0xa2, 0x3c, 0xfd, 0x9d, 0x3c, 0xec, 0x8c, 0xbd, 0x0d, 0x84, 0x7c, 0x01, 0x2d, 0x2e, 0xa8, 0x40,
+	0x6b, 0xa0, 0xe2, 0x4c, 0x76, 0x74, 0xaa, 0x22, 0x2d, 0x79, 0xd2, 0xd1, 0x0e, 0xe4, 0x35, 0x40,
+	0x92, 0xc6, 0x09, 0xa6, 0x82, 0x21, 0xb7, 0x86, 0xff, 0xf5, 0xfd, 0x55, 0x9c, 0xc8, 0x43, 0x68,
+	0xf9, 0x4b, 0x97, 0xf9, 0xd6, 0x48, 0xa9, 0xbd, 0xe9, 0x2f, 0xe7, 0xfe, 0xe4, 0x6f, 0x03, 0x06,
+	0x8b, 0x42, 0x7c, 0xf2, 0x45, 0xd8, 0xd0, 0xab, 0xa8, 0x31, 0x7b, 0x1a, 0x55, 0x88, 0x7c, 0x08,
+	0x83, 0x9a, 0x12, 0xd5, 0x53, 0xe9, 0x3a, 0x75, 0x90, 0x7c, 0x05, 0x4f, 0xfe, 0xa5, 0xd7, 0xd9,
+	0xd3, 0x78, 0x7c, 0x6f, 0xab, 0xc9, 0x07, 0x30, 0xf0, 0x8a, 0x5a, 0xb8, 0x4c, 0xcf, 0x0c, 0xd3,
+	0xe9, 0x97, 0xe0, 0xdc, 0x27, 0x9f, 0xe7, 0x05, 0x6d, 0xa9, 0x82, 0xee, 0x92, 0x7e, 0xc1, 0xae,
+	0x5a, 0xcf, 0xc9, 0x1f, 0x06, 0x74, 0x5f, 0x07, 0x8c, 0xf2, 0x7c, 0x30, 0x52, 0x69, 0xd4, 0x06,
+	0xa3, 0x42, 0x14, 0x95, 0xad, 0x54, 0x1a, 0x3b, 0x52, 0x79, 0x0e, 0xfd, 0x2a, 0xcb, 0x8c, 0x60,
+	0xcf, 0x2b, 0x79, 0x91, 0xf3, 0x3c, 0xdb, 0xa6, 0xca, 0xf6, 0xd9, 0x8e, 0x6c, 0x55, 0x4e, 0xb5,
+	0xce, 0x17, 0x6d, 0x6b, 0x55, 0xda, 0xf6, 0x9b, 0x01, 0x7d, 0xa9, 0xdc, 0x25, 0xe5, 0xa8, 0x18,
+	0x3c, 0x81, 0xae, 0xc0, 0x88, 0x46, 0x42, 0x9e, 0xd4, 0x04, 0x3a, 0x1a, 0x98, 0xfb, 0x84, 0x40,
+	0x33, 0x2a, 0xfb, 0xa4, 0x7e, 0xcb, 0xc1, 0xc7, 0x7c, 0x95, 0xa4, 0xe9, 0x34, 0x98, 0x4f, 0x5e,
+	0xd5, 0x73, 0xb3, 0x77, 0xe4, 0x96, 0x5f, 0x58, 0x4b, 0x6f, 0x93, 0x76, 0x6b, 0x8b, 0xf6, 0xe4,
+	0x97, 0x06, 0x8c, 0x2f, 0xf1, 0x26, 0xc4, 0x48, 0x94, 0xf3, 0x7b, 0x02, 0xd5, 0xf2, 0xe5, 0x3a,
+	0xab, 0x61, 0x9b, 0x52, 0x6c, 0x6c, 0x4b, 0xf1, 0x29, 0x74, 0x79, 0x16, 0x79, 0x96, 0x91, 0x29,
+	0x01, 0xbd, 0x23, 0xe4, 0xa0, 0x9b, 0x65, 0xe2, 0xc9, 0xcd, 0xea, 0x8e, 0x68, 0xd5, 0x57, 0x9d,
+	0x05, 0x7b, 0xcb, 0x35, 0x53, 0x3e, 0x6d, 0xfd, 0x25, 0x33, 0x25, 0x53, 0x8c, 0xe8, 0x32, 0x40,
+	0x3d, 0x6f, 0xad, 0x3d, 0xb5, 0xc3, 0x7a, 0x1a, 0x53, 0xc4, 0x36, 0xc7, 0x7f, 0x67, 0x6b, 0x8f,
+	0xfd, 0x65, 0x54, 0x37, 0xd0, 0xf7, 0x28, 0xe8, 0xff, 0xbe, 0x81, 0xde, 0x03, 0x28, 0x2a, 0x94,
+	0xef, 0x9f, 0x0a, 0x42, 0x8e, 0x2b, 0xdb, 0xc7, 0x15, 0xf4, 0x26, 0xdf, 0x3e, 0xe5, 0xf3, 0xbe,
+	0xa2, 0x37, 0x7c, 0x6b, 0x91, 0xb5, 0xb7, 0x17, 0xd9, 0xe4, 0x77, 0xc9, 0x36, 0x45, 0x1f, 0x23,
+	0xc1, 0x68, 0xa0, 0xda, 0x7e, 0x04, 0x9d, 0x35, 0xc7, 0xb4, 0xf2, 0xce, 0x0a, 0x9b, 0xbc, 0x04,
+	0x82, 0x91, 0x97, 0xde, 0x25, 0x52, 0x4c, 0x09, 0xe5, 0xfc, 0xa7, 0x38, 0xf5, 0x33, 0xd1, 0xee,
+	0x17, 0x5f, 0x16, 0xd9, 0x07, 0x72, 0x08, 0x6d, 0xad, 0x70, 0x45, 0xb2, 0xeb, 0x64, 0x16, 0x79,
+	0x0c, 0x1d, 0xc6, 0x5d, 0xbe, 0x4e, 0x30, 0xcd, 0xff, 0x67, 0x30, 0x7e, 0x29, 0x4d, 0xf2, 0x31,
+	0x8c, 0xf8, 0x8a, 0x9e, 0x7d, 0xf6, 0xaa, 0x0c, 0xdf, 0x52, 0xbe, 0x43, 0x0d, 0xe7, 0xb1, 0x5f,
+	0xc4, 0x30, 0xda, 0x18, 0xc4, 0xe4, 0x11, 0xec, 0x97, 0x50, 0x36, 0xad, 0xc6, 0x0f, 0xc8, 0x21,
+	0x90, 0x0d, 0x98, 0x45, 0x37, 0x63, 0xa3, 0x8e, 0xcf, 0xd2, 0x38, 0x49, 0x24, 0xde, 0xa8, 0x87,
+	0x51, 0x38, 0xfa, 0x63, 0xf3, 0xc5, 0x8f, 0x30, 0xac, 0x0f, 0x2a, 0x72, 0x00, 0xe3, 0xc5, 0xc6,
+	0x70, 0x1c, 0x3f, 0x90, 0xee, 0x75, 0x54, 0xdf, 0x56, 0x85, 0x2b, 0x97, 0x55, 0x63, 0x94, 0x77,
+	0xbd, 0x03, 0x28, 0xc7, 0x0c, 0x19, 0x43, 0x5f, 0x59, 0xe5, 0x1d, 0xfb, 0x30, 0x28, 0x11, 0x1d,
+	0x3f, 0x87, 0x2a, 0xb1, 0x73, 0xbf, 0x32, 0x2e, 0xc2, 0xa0, 0x36, 0x22, 0xc8, 0x43, 0x18, 0xe5,
+	0x40, 0x19, 0xfd, 0x00, 0xc6, 0x35, 0x50, 0x5f, 0x50, 0x41, 0x2b, 0x77, 0x54, 0x02, 0x14, 0xd7,
+	0x7c, 0x73, 0xfe, 0xc3, 0x27, 0x37, 0x4c, 0xac, 0xd6, 0x4b, 0xb9, 0xf1, 0x4e, 0xf5, 0xe3, 0x78,
+	0xc9, 0xe2, 0xec, 0xd7, 0x29, 0x8b, 0x84, 0xd4, 0x53, 0x70, 0xaa, 0xde, 0xcb, 0xa9, 0x9c, 0x5c,
+	0xc9, 0x72, 0xd9, 0x56, 0xd6, 0xf9, 0x3f, 0x03, 0x00, 0x3e, 0x18, 0xc5, 0xe0, 0x51, 0x0b, 0x00,
+	0x00,
 }
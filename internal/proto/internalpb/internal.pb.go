@@ -1311,19 +1311,24 @@ type SearchRequest struct {
 	PartitionIDs []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl          string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Nq                   int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
-	Topk                 int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Nq                 int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk               int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType         string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
+	// results are grouped by the field if group_by_field_id is set, each group keeps at most group_size hits
+	GroupByFieldId int64 `protobuf:"varint,17,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupSize      int64 `protobuf:"varint,18,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	// rows inserted before it are expired by collection ttl, 0 means ttl is disabled
+	CollectionTtlTimestamps uint64   `protobuf:"varint,19,opt,name=collection_ttl_timestamps,json=collectionTtlTimestamps,proto3" json:"collection_ttl_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1463,6 +1468,27 @@ func (m *SearchRequest) GetMetricType() string {
 	return ""
 }
 
+func (m *SearchRequest) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetCollectionTtlTimestamps() uint64 {
+	if m != nil {
+		return m.CollectionTtlTimestamps
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1592,20 +1618,22 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 }
 
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Limit                int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReqID              int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Limit              int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// rows inserted before it are expired by collection ttl, 0 means ttl is disabled
+	CollectionTtlTimestamps uint64   `protobuf:"varint,12,opt,name=collection_ttl_timestamps,json=collectionTtlTimestamps,proto3" json:"collection_ttl_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
@@ -1710,6 +1738,13 @@ func (m *RetrieveRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetCollectionTtlTimestamps() uint64 {
+	if m != nil {
+		return m.CollectionTtlTimestamps
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -2655,143 +2690,147 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x0a, 0x3d, 0x71, 0xb2, 0x8c, 0x93, 0x6c, 0x1c, 0xf6,
-	0xcb, 0x4d, 0xba, 0x49, 0xea, 0xdd, 0x4d, 0x0a, 0xb4, 0xe8, 0x22, 0xb6, 0xb2, 0x81, 0x11, 0x3b,
-	0x75, 0xe8, 0x20, 0x40, 0x7b, 0x21, 0x46, 0xe2, 0x58, 0x9a, 0x86, 0xe4, 0x30, 0x33, 0x43, 0x3b,
-	0xca, 0xa9, 0x87, 0x9e, 0xba, 0x68, 0x6f, 0xbd, 0x14, 0x68, 0xcf, 0x45, 0x81, 0x02, 0xbd, 0xed,
-	0xb1, 0x40, 0x4f, 0x3d, 0xf5, 0xd4, 0xbf, 0xa6, 0xe8, 0xa1, 0x98, 0x19, 0x92, 0xfa, 0xb0, 0xe2,
-	0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0x79, 0xef, 0xcd, 0xd7, 0xef, 0xfd, 0xde, 0xe3, 0x7b,
-	0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x2b, 0xe5, 0x4c, 0x32, 0x74, 0x21, 0xa6, 0xd1,
-	0x41, 0x26, 0xcc, 0xe8, 0x56, 0xa1, 0x5c, 0x69, 0xf7, 0x59, 0x1c, 0xb3, 0xc4, 0x88, 0x57, 0xda,
-	0xa2, 0x3f, 0x24, 0x31, 0x36, 0x23, 0xef, 0x32, 0x5c, 0x7a, 0x48, 0xe4, 0x53, 0x1a, 0x93, 0xa7,
-	0xb4, 0xff, 0x7c, 0x73, 0x88, 0x93, 0x84, 0x44, 0x3e, 0x79, 0x91, 0x11, 0x21, 0xbd, 0xab, 0x70,
-	0xf9, 0x21, 0x91, 0x7b, 0x12, 0x4b, 0x2a, 0x24, 0xed, 0x8b, 0x19, 0xf5, 0x05, 0x38, 0xff, 0x90,
-	0xc8, 0x6e, 0x38, 0x23, 0x7e, 0x06, 0x8d, 0xc7, 0x2c, 0x24, 0x5b, 0xc9, 0x3e, 0x43, 0x77, 0xa1,
-	0x8e, 0xc3, 0x90, 0x13, 0x21, 0x5c, 0x6b, 0xd5, 0x5a, 0x6b, 0xad, 0x5f, 0xb9, 0x35, 0x75, 0xc6,
-	0xfc, 0x64, 0xf7, 0x8d, 0x8d, 0x5f, 0x18, 0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad,
-	0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x02, 0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0x2e,
-	0xc2, 0x42, 0xa2, 0x76, 0xe9, 0xea, 0x85, 0x6d, 0x3f, 0x1f, 0xa1, 0x2e, 0xb4, 0x85, 0xc4, 0x5c,
-	0x06, 0xa9, 0xb6, 0x73, 0x2b, 0xab, 0xf6, 0x5a, 0x6b, 0xfd, 0xfa, 0xdc, 0x6d, 0x1f, 0x91, 0xd1,
-	0x33, 0x1c, 0x65, 0x64, 0x17, 0x53, 0xee, 0xb7, 0xf4, 0x34, 0xb3, 0xba, 0xf7, 0x73, 0x80, 0x3d,
-	0xc9, 0x69, 0x32, 0xd8, 0xa6, 0x42, 0xaa, 0xbd, 0x0e, 0x94, 0x9d, 0xba, 0x84, 0xbd, 0xd6, 0xf4,
-	0xf3, 0x11, 0xfa, 0x08, 0x16, 0x84, 0xc4, 0x32, 0x13, 0xfa, 0x9c, 0xad, 0xf5, 0xcb, 0x73, 0x77,
-	0xd9, 0xd3, 0x26, 0x7e, 0x6e, 0xea, 0x7d, 0x0a, 0xad, 0x02, 0xee, 0x1d, 0x31, 0x40, 0x77, 0xa0,
-	0xda, 0xc3, 0x82, 0x1c, 0x0b, 0xcf, 0x8e, 0x18, 0x6c, 0x60, 0x41, 0x7c, 0x6d, 0xe9, 0xfd, 0xb5,
-	0x02, 0xcb, 0x53, 0x6e, 0xc9, 0x81, 0x3f, 0xfd, 0x52, 0x0a, 0xe6, 0xb0, 0xb7, 0xd5, 0xd5, 0xc7,
-	0xb7, 0x7d, 0xfd, 0x8c, 0x3c, 0x68, 0xf7, 0x59, 0x14, 0x91, 0xbe, 0xa4, 0x2c, 0xd9, 0xea, 0xba,
-	0xb6, 0xd6, 0x4d, 0xc9, 0x94, 0x4d, 0x8a, 0xb9, 0xa4, 0x66, 0x28, 0xdc, 0xea, 0xaa, 0xad, 0x6c,
-	0x26, 0x65, 0xe8, 0xfb, 0xe0, 0x48, 0x8e, 0x0f, 0x48, 0x14, 0x48, 0x1a, 0x13, 0x21, 0x71, 0x9c,
-	0xba, 0xb5, 0x55, 0x6b, 0xad, 0xea, 0x9f, 0x33, 0xf2, 0xa7, 0x85, 0x18, 0xdd, 0x86, 0xf3, 0x83,
-	0x0c, 0x73, 0x9c, 0x48, 0x42, 0x26, 0xac, 0x17, 0xb4, 0x35, 0x2a, 0x55, 0xe3, 0x09, 0x37, 0x61,
-	0x49, 0x99, 0xb1, 0x4c, 0x4e, 0x98, 0xd7, 0xb5, 0xb9, 0x93, 0x2b, 0x4a, 0x63, 0xef, 0x0b, 0x0b,
-	0x2e, 0xcc, 0xe0, 0x25, 0x52, 0x96, 0x08, 0x72, 0x06, 0xc0, 0xce, 0xe2, 0x71, 0x74, 0x0f, 0x6a,
-	0xea, 0x49, 0xb8, 0xf6, 0x49, 0xb9, 0x68, 0xec, 0xbd, 0xdf, 0xd8, 0xf0, 0xfe, 0x26, 0x27, 0x58,
-	0x92, 0xcd, 0x12, 0xfd, 0xb3, 0x3b, 0xfb, 0x7d, 0xa8, 0x87, 0xbd, 0x20, 0xc1, 0x71, 0x11, 0x56,
-	0x0b, 0x61, 0xef, 0x31, 0x8e, 0x09, 0xfa, 0x2e, 0x74, 0xc6, 0xde, 0x55, 0x12, 0xed, 0xf3, 0xa6,
-	0x3f, 0x23, 0x45, 0xdf, 0x86, 0xc5, 0xd2, 0xc3, 0xda, 0xac, 0xaa, 0xcd, 0xa6, 0x85, 0x25, 0xa7,
-	0x6a, 0xc7, 0x70, 0x6a, 0x61, 0x0e, 0xa7, 0x56, 0xa1, 0x35, 0xc1, 0x1f, 0xed, 0x4d, 0xdb, 0x9f,
-	0x14, 0xa9, 0x30, 0x34, 0xb9, 0xcb, 0x6d, 0xac, 0x5a, 0x6b, 0x6d, 0x3f, 0x1f, 0xa1, 0x3b, 0x70,
-	0xfe, 0x80, 0x72, 0x99, 0xe1, 0x28, 0xcf, 0x44, 0xea, 0x1c, 0xc2, 0x6d, 0xea, 0x58, 0x9d, 0xa7,
-	0x42, 0xeb, 0xb0, 0x9c, 0x0e, 0x47, 0x82, 0xf6, 0x67, 0xa6, 0x80, 0x9e, 0x32, 0x57, 0xe7, 0xfd,
-	0xc3, 0x82, 0x0b, 0x5d, 0xce, 0xd2, 0x77, 0xc2, 0x15, 0x05, 0xc8, 0xd5, 0x63, 0x40, 0xae, 0x1d,
-	0x05, 0xd9, 0xfb, 0x6d, 0x05, 0x2e, 0x1a, 0x46, 0xed, 0x16, 0xc0, 0x7e, 0x09, 0xb7, 0xf8, 0x1e,
-	0x9c, 0x1b, 0xef, 0x6a, 0x0c, 0xe6, 0x5f, 0xe3, 0x3b, 0xd0, 0x29, 0x1d, 0x6c, 0xec, 0xbe, 0x5a,
-	0x4a, 0x79, 0x9f, 0x57, 0x60, 0x59, 0x39, 0xf5, 0x1b, 0x34, 0x14, 0x1a, 0x7f, 0xb2, 0x00, 0x19,
-	0x76, 0xdc, 0x8f, 0x28, 0x16, 0x5f, 0x27, 0x16, 0xcb, 0x50, 0xc3, 0xea, 0x0c, 0x39, 0x04, 0x66,
-	0xe0, 0x09, 0x70, 0x94, 0xb7, 0xbe, 0xac, 0xd3, 0x95, 0x9b, 0xda, 0x93, 0x9b, 0xfe, 0xd1, 0x82,
-	0xa5, 0xfb, 0x91, 0x24, 0xfc, 0x1d, 0x05, 0xe5, 0xef, 0x95, 0xc2, 0x6b, 0x5b, 0x49, 0x48, 0x5e,
-	0x7e, 0x9d, 0x07, 0xbc, 0x0a, 0xb0, 0x4f, 0x49, 0x14, 0x4e, 0xb2, 0xb7, 0xa9, 0x25, 0x6f, 0xc5,
-	0x5c, 0x17, 0xea, 0x7a, 0x91, 0x92, 0xb5, 0xc5, 0x50, 0x55, 0x7b, 0xe4, 0xa5, 0xe4, 0xb8, 0xa8,
-	0xf6, 0x1a, 0x27, 0xae, 0xf6, 0xf4, 0xb4, 0xbc, 0xda, 0xfb, 0x57, 0x15, 0x16, 0xb7, 0x12, 0x41,
-	0xb8, 0x3c, 0x3b, 0x78, 0x57, 0xa0, 0x29, 0x86, 0x98, 0xeb, 0x8b, 0xe6, 0xf0, 0x8d, 0x05, 0x93,
-	0xd0, 0xda, 0x6f, 0x82, 0xb6, 0x7a, 0xc2, 0xe4, 0x50, 0x3b, 0x2e, 0x39, 0x2c, 0x1c, 0x03, 0x71,
-	0xfd, 0xcd, 0xc9, 0xa1, 0x71, 0xf4, 0xed, 0xab, 0x2e, 0x48, 0x06, 0x31, 0x49, 0xe4, 0x56, 0xd7,
-	0x6d, 0x6a, 0xfd, 0x58, 0x80, 0x3e, 0x00, 0x28, 0x2b, 0x31, 0xf3, 0x1e, 0xad, 0xfa, 0x13, 0x12,
-	0xf5, 0xee, 0xe6, 0xec, 0x50, 0xd5, 0x8a, 0x2d, 0x5d, 0x2b, 0xe6, 0x23, 0xf4, 0x31, 0x34, 0x38,
-	0x3b, 0x0c, 0x42, 0x2c, 0xb1, 0xdb, 0xd6, 0xce, 0xbb, 0x34, 0x17, 0xec, 0x8d, 0x88, 0xf5, 0xfc,
-	0x3a, 0x67, 0x87, 0x5d, 0x2c, 0x31, 0xfa, 0x14, 0x5a, 0x9a, 0x01, 0xc2, 0x4c, 0x5c, 0xd4, 0x13,
-	0x3f, 0x98, 0x9e, 0x98, 0xb7, 0x39, 0x9f, 0x29, 0x3b, 0x35, 0xc9, 0x37, 0xd4, 0x14, 0x7a, 0x81,
-	0x4b, 0xd0, 0x48, 0xb2, 0x38, 0xe0, 0xec, 0x50, 0xb8, 0x1d, 0x5d, 0x37, 0xd6, 0x93, 0x2c, 0xf6,
-	0xd9, 0xa1, 0x40, 0x1b, 0x50, 0x3f, 0x20, 0x5c, 0x50, 0x96, 0xb8, 0xe7, 0x56, 0xad, 0xb5, 0xce,
-	0xfa, 0xda, 0xad, 0xb9, 0x6d, 0xd5, 0x2d, 0xc3, 0x18, 0xb5, 0xdc, 0x33, 0x63, 0xef, 0x17, 0x13,
-	0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e, 0x9d, 0x50, 0xcb, 0x50, 0xe3, 0xe4,
-	0x45, 0x59, 0x9c, 0x9b, 0x41, 0xe9, 0x5f, 0xfb, 0x18, 0xff, 0x56, 0x4f, 0x50, 0xb1, 0xd7, 0xe6,
-	0x54, 0xec, 0x0e, 0xd8, 0xa1, 0x88, 0x34, 0x75, 0x9a, 0xbe, 0x7a, 0x54, 0x75, 0x76, 0x1a, 0xe1,
-	0x3e, 0x19, 0xb2, 0x28, 0x24, 0x3c, 0x18, 0x70, 0x96, 0x99, 0x3a, 0xbb, 0xed, 0x3b, 0x13, 0x8a,
-	0x87, 0x4a, 0x8e, 0xee, 0x41, 0x23, 0x14, 0x51, 0x20, 0x47, 0x29, 0xd1, 0xfc, 0xe9, 0xbc, 0xe6,
-	0x9a, 0x5d, 0x11, 0x3d, 0x1d, 0xa5, 0xc4, 0xaf, 0x87, 0xe6, 0x01, 0xdd, 0x81, 0x65, 0x41, 0x38,
-	0xc5, 0x11, 0x7d, 0x45, 0xc2, 0x80, 0xbc, 0x4c, 0x79, 0x90, 0x46, 0x38, 0xd1, 0x24, 0x6b, 0xfb,
-	0x68, 0xac, 0x7b, 0xf0, 0x32, 0xe5, 0xbb, 0x11, 0x4e, 0xd0, 0x1a, 0x38, 0x2c, 0x93, 0x69, 0x26,
-	0x83, 0x9c, 0x06, 0x34, 0xd4, 0x9c, 0xb3, 0xfd, 0x8e, 0x91, 0x6b, 0xaf, 0x8b, 0xad, 0x70, 0x6e,
-	0x17, 0xd2, 0x3a, 0x55, 0x17, 0xd2, 0x3e, 0x5d, 0x17, 0xb2, 0x38, 0xbf, 0x0b, 0x41, 0x1d, 0xa8,
-	0x24, 0x2f, 0x34, 0xd7, 0x6c, 0xbf, 0x92, 0xbc, 0x50, 0x8e, 0x94, 0x2c, 0x7d, 0xae, 0x39, 0x66,
-	0xfb, 0xfa, 0x59, 0x05, 0x51, 0x4c, 0x24, 0xa7, 0x7d, 0x05, 0x8b, 0xeb, 0x68, 0x3f, 0x4c, 0x48,
-	0xbc, 0xff, 0xda, 0x63, 0x5a, 0x89, 0x2c, 0x92, 0xe2, 0xab, 0xea, 0x60, 0x4a, 0x2e, 0xda, 0x93,
-	0x5c, 0xbc, 0x06, 0x2d, 0x73, 0x38, 0xe3, 0xf3, 0xea, 0xec, 0x79, 0x95, 0x81, 0x8a, 0xb2, 0x17,
-	0x19, 0xe1, 0x94, 0x88, 0x3c, 0xed, 0x43, 0x92, 0xc5, 0x4f, 0x8c, 0x04, 0x9d, 0x87, 0x9a, 0x64,
-	0x69, 0xf0, 0xbc, 0x48, 0x57, 0x92, 0xa5, 0x8f, 0xd0, 0x4f, 0x60, 0x45, 0x10, 0x1c, 0x91, 0x30,
-	0x28, 0xd3, 0x8b, 0x08, 0x84, 0xbe, 0x36, 0x09, 0xdd, 0xba, 0x76, 0xb3, 0x6b, 0x2c, 0xf6, 0x4a,
-	0x83, 0xbd, 0x5c, 0xaf, 0xbc, 0xd8, 0x37, 0x65, 0xfb, 0xd4, 0xb4, 0x86, 0xae, 0xec, 0xd1, 0x58,
-	0x55, 0x4e, 0xf8, 0x11, 0xb8, 0x83, 0x88, 0xf5, 0x70, 0x14, 0x1c, 0xd9, 0x55, 0xb7, 0x10, 0xb6,
-	0x7f, 0xd1, 0xe8, 0xf7, 0x66, 0xb6, 0x54, 0xd7, 0x13, 0x11, 0xed, 0x93, 0x30, 0xe8, 0x45, 0xac,
-	0xe7, 0x82, 0xa6, 0x2b, 0x18, 0x91, 0xca, 0x57, 0x8a, 0xa6, 0xb9, 0x81, 0x82, 0xa1, 0xcf, 0xb2,
-	0x44, 0x6a, 0xf2, 0xd9, 0x7e, 0xc7, 0xc8, 0x1f, 0x67, 0xf1, 0xa6, 0x92, 0xa2, 0x6f, 0xc1, 0x62,
-	0x6e, 0xc9, 0xf6, 0xf7, 0x05, 0x91, 0x9a, 0x75, 0xb6, 0xdf, 0x36, 0xc2, 0x9f, 0x69, 0x99, 0xf7,
-	0x37, 0x1b, 0xce, 0xf9, 0x0a, 0x5d, 0x72, 0x40, 0xfe, 0x9f, 0xf2, 0xca, 0xeb, 0xe2, 0x7b, 0xe1,
-	0x54, 0xf1, 0x5d, 0x3f, 0x71, 0x7c, 0x37, 0x4e, 0x15, 0xdf, 0xcd, 0xd3, 0xc5, 0x37, 0xbc, 0x26,
-	0xbe, 0x97, 0xa1, 0x16, 0xd1, 0x98, 0x16, 0x0e, 0x36, 0x03, 0xef, 0xcf, 0x53, 0x2e, 0x7b, 0x07,
-	0x62, 0xf6, 0x06, 0xd8, 0x34, 0x34, 0x05, 0x64, 0x6b, 0xdd, 0x9d, 0xfb, 0xc6, 0xdc, 0xea, 0x0a,
-	0x5f, 0x19, 0xcd, 0xbe, 0x65, 0x6b, 0xa7, 0x7e, 0xcb, 0xfe, 0x14, 0x2e, 0x1f, 0x8d, 0x64, 0x9e,
-	0xc3, 0x11, 0xba, 0x0b, 0xda, 0xa3, 0x97, 0x66, 0x43, 0xb9, 0xc0, 0x2b, 0x44, 0x3f, 0x84, 0xe5,
-	0x89, 0x58, 0x1e, 0x4f, 0xac, 0x9b, 0xce, 0x7e, 0xac, 0x1b, 0x4f, 0x39, 0x2e, 0x9a, 0x1b, 0xc7,
-	0x45, 0xb3, 0xf7, 0x4f, 0x1b, 0x16, 0xbb, 0x24, 0x22, 0x92, 0x7c, 0x53, 0x04, 0xbe, 0xb6, 0x08,
-	0xfc, 0x01, 0x20, 0x9a, 0xc8, 0xbb, 0x1f, 0x07, 0x29, 0xa7, 0x31, 0xe6, 0xa3, 0xe0, 0x39, 0x19,
-	0x15, 0x69, 0xd2, 0xd1, 0x9a, 0x5d, 0xa3, 0x78, 0x44, 0x46, 0xe2, 0x8d, 0x45, 0xe1, 0x64, 0x15,
-	0x66, 0xc2, 0xa6, 0xac, 0xc2, 0x7e, 0x0c, 0xed, 0xa9, 0x2d, 0xda, 0x6f, 0x20, 0x6c, 0x2b, 0x1d,
-	0xef, 0xeb, 0xfd, 0xc7, 0x82, 0xe6, 0x36, 0xc3, 0xa1, 0xee, 0x87, 0xce, 0xe8, 0xc6, 0xb2, 0xd4,
-	0xad, 0xcc, 0x96, 0xba, 0x57, 0x60, 0xdc, 0xd2, 0xe4, 0x8e, 0x9c, 0xe8, 0x71, 0x26, 0x7a, 0x95,
-	0xea, 0x74, 0xaf, 0x72, 0x0d, 0x5a, 0x54, 0x1d, 0x28, 0x48, 0xb1, 0x1c, 0x9a, 0x4c, 0xd9, 0xf4,
-	0x41, 0x8b, 0x76, 0x95, 0x44, 0x35, 0x33, 0x85, 0x81, 0x6e, 0x66, 0x16, 0x4e, 0xdc, 0xcc, 0xe4,
-	0x8b, 0xe8, 0x66, 0xe6, 0xd7, 0x16, 0x80, 0xbe, 0xb8, 0xca, 0x07, 0x47, 0x17, 0xb5, 0xce, 0xb2,
-	0xa8, 0x4a, 0xe1, 0xda, 0x53, 0x24, 0xc2, 0x72, 0x1c, 0x54, 0x22, 0x07, 0x07, 0x29, 0xaf, 0x19,
-	0x55, 0x1e, 0x50, 0xc2, 0xfb, 0x9d, 0x05, 0xa0, 0xb3, 0x82, 0x39, 0xc6, 0x2c, 0xfd, 0xac, 0xe3,
-	0xdb, 0xbc, 0xca, 0x34, 0x74, 0x1b, 0x05, 0x74, 0xc7, 0x7c, 0x47, 0x9d, 0xa8, 0xcb, 0x8b, 0xcb,
-	0xe7, 0xe8, 0xea, 0x67, 0xef, 0xf7, 0x16, 0xb4, 0xf3, 0xd3, 0x99, 0x23, 0x4d, 0x79, 0xd9, 0x9a,
-	0xf5, 0xb2, 0x2e, 0x6e, 0x62, 0xc6, 0x47, 0x81, 0xa0, 0xaf, 0x48, 0x7e, 0x20, 0x30, 0xa2, 0x3d,
-	0xfa, 0x8a, 0x4c, 0x91, 0xd7, 0x9e, 0x26, 0xef, 0x4d, 0x58, 0xe2, 0xa4, 0x4f, 0x12, 0x19, 0x8d,
-	0x82, 0x98, 0x85, 0x74, 0x9f, 0x92, 0x50, 0xb3, 0xa1, 0xe1, 0x3b, 0x85, 0x62, 0x27, 0x97, 0x7b,
-	0xbf, 0xb2, 0xa0, 0xb5, 0x23, 0x06, 0xbb, 0x4c, 0xe8, 0x20, 0x43, 0xd7, 0xa1, 0x9d, 0x27, 0x36,
-	0x13, 0xe1, 0x96, 0x66, 0x58, 0xab, 0x3f, 0xfe, 0x16, 0xa9, 0x52, 0x7b, 0x2c, 0x06, 0x39, 0x4c,
-	0x6d, 0xdf, 0x0c, 0xd0, 0x0a, 0x34, 0x62, 0x31, 0xd0, 0xb5, 0x78, 0x4e, 0xcb, 0x72, 0xac, 0xee,
-	0x3a, 0x7e, 0x85, 0x55, 0xf5, 0x2b, 0x6c, 0x2c, 0xf0, 0xbe, 0xb0, 0x00, 0xe5, 0xdf, 0x3a, 0xdf,
-	0xea, 0xd7, 0x84, 0xf6, 0xf2, 0xe4, 0xf7, 0xd4, 0x8a, 0xe6, 0xf8, 0x94, 0x6c, 0x26, 0x29, 0xd8,
-	0x47, 0x92, 0xc2, 0x4d, 0x58, 0x0a, 0xc9, 0x3e, 0xce, 0xa2, 0xc9, 0xb7, 0xae, 0x39, 0xb2, 0x93,
-	0x2b, 0xa6, 0xbe, 0xed, 0x77, 0x36, 0x39, 0x09, 0x49, 0x22, 0x29, 0x8e, 0xf4, 0x2f, 0xa7, 0x15,
-	0x68, 0x64, 0x42, 0x31, 0xa1, 0xc4, 0xae, 0x1c, 0xa3, 0x0f, 0x01, 0x91, 0xa4, 0xcf, 0x47, 0xa9,
-	0x22, 0x71, 0x8a, 0x85, 0x38, 0x64, 0x3c, 0xcc, 0x13, 0xf5, 0x52, 0xa9, 0xd9, 0xcd, 0x15, 0xaa,
-	0x69, 0x95, 0x24, 0xc1, 0x89, 0x2c, 0xf2, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25,
-	0x3c, 0x77, 0x6b, 0x9d, 0x8a, 0x3d, 0x35, 0x54, 0xa9, 0x5c, 0x0c, 0xf1, 0xfa, 0x27, 0x77, 0xc7,
-	0xcb, 0x9b, 0x14, 0xdd, 0x31, 0xe2, 0x62, 0x6d, 0xef, 0x01, 0x2c, 0x6d, 0x53, 0x21, 0x77, 0x59,
-	0x44, 0xfb, 0xa3, 0x33, 0xbf, 0x71, 0xbc, 0xcf, 0x2d, 0x40, 0x93, 0xeb, 0xe4, 0x7f, 0x36, 0xc6,
-	0x15, 0x83, 0x75, 0xf2, 0x8a, 0xe1, 0x3a, 0xb4, 0x53, 0xbd, 0x4c, 0x40, 0x93, 0x7d, 0x56, 0x78,
-	0xaf, 0x65, 0x64, 0x0a, 0x5b, 0x81, 0xae, 0x02, 0x28, 0x30, 0x03, 0xce, 0x22, 0x62, 0x9c, 0xd7,
-	0xf4, 0x9b, 0x4a, 0xe2, 0x2b, 0x81, 0x37, 0x80, 0x4b, 0x7b, 0x43, 0x76, 0xb8, 0xc9, 0x92, 0x7d,
-	0x3a, 0xc8, 0x38, 0x56, 0x84, 0x7e, 0x8b, 0x2f, 0x66, 0x2e, 0xd4, 0x53, 0x2c, 0x55, 0x58, 0xe7,
-	0x3e, 0x2a, 0x86, 0xde, 0x1f, 0x2c, 0x58, 0x99, 0xb7, 0xd3, 0xdb, 0x5c, 0xff, 0x21, 0x2c, 0xf6,
-	0xcd, 0x72, 0x66, 0xb5, 0x93, 0xff, 0x3a, 0x9c, 0x9e, 0xe7, 0x3d, 0x80, 0xaa, 0x8f, 0x25, 0x41,
-	0xb7, 0xa1, 0xc2, 0xa5, 0x3e, 0x41, 0x67, 0xfd, 0xda, 0x6b, 0x92, 0x95, 0x32, 0xd4, 0xdd, 0x70,
-	0x85, 0x4b, 0xd4, 0x06, 0x8b, 0xeb, 0x9b, 0x5a, 0xbe, 0xc5, 0x6f, 0xac, 0xc3, 0xd2, 0x91, 0x4f,
-	0x0c, 0xa8, 0x0d, 0x0d, 0x9f, 0x1d, 0x2a, 0x8c, 0x42, 0xe7, 0x3d, 0x74, 0x0e, 0x5a, 0x9b, 0x2c,
-	0xca, 0xe2, 0xc4, 0x08, 0xac, 0x1b, 0x7f, 0xb1, 0xa0, 0x51, 0x2c, 0x89, 0x96, 0x60, 0xb1, 0xdb,
-	0xdd, 0x1e, 0xff, 0xaf, 0x70, 0xde, 0x43, 0x0e, 0xb4, 0xbb, 0xdd, 0xed, 0xf2, 0x6b, 0xb7, 0x63,
-	0xa9, 0x05, 0xbb, 0xdd, 0x6d, 0x9d, 0x33, 0x9d, 0x4a, 0x3e, 0xfa, 0x2c, 0xca, 0xc4, 0xd0, 0xb1,
-	0xcb, 0x05, 0xe2, 0x14, 0x9b, 0x05, 0xaa, 0x68, 0x11, 0x9a, 0xdd, 0x9d, 0x6d, 0x73, 0x2e, 0xa7,
-	0x96, 0x0f, 0x4d, 0xd9, 0xe4, 0x2c, 0xa8, 0xf3, 0x74, 0x77, 0xb6, 0x37, 0xb2, 0xe8, 0xb9, 0x7a,
-	0xfd, 0x3a, 0x75, 0xad, 0x7f, 0xb2, 0x6d, 0x7a, 0x2d, 0xa7, 0xa1, 0x97, 0x7f, 0xb2, 0xad, 0xba,
-	0xbf, 0x91, 0xd3, 0xdc, 0xb8, 0xf7, 0x8b, 0x4f, 0x06, 0x54, 0x0e, 0xb3, 0x9e, 0x02, 0xf5, 0xb6,
-	0xc1, 0xe7, 0x43, 0xca, 0xf2, 0xa7, 0xdb, 0x05, 0x46, 0xb7, 0x35, 0x64, 0xe5, 0x30, 0xed, 0xf5,
-	0x16, 0xb4, 0xe4, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe9, 0xa6, 0xf9, 0x00, 0x1f,
-	0x00, 0x00,
+	// 2257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x8a, 0x3c, 0x71, 0x12, 0xda, 0xc9, 0x6e, 0x1c, 0x7e,
+	0xbf, 0x6d, 0xbd, 0x49, 0x37, 0x49, 0xbd, 0xbb, 0x49, 0xd1, 0x16, 0x5d, 0xc4, 0x56, 0x36, 0x30,
+	0x62, 0xa7, 0x0e, 0x1d, 0x04, 0x68, 0x2f, 0xc4, 0x48, 0x1c, 0x4b, 0x6c, 0x48, 0x0e, 0x33, 0x33,
+	0xb4, 0xa3, 0x9c, 0x7a, 0xe8, 0xa9, 0x8b, 0xf6, 0xd6, 0x4b, 0x81, 0xf6, 0x5c, 0x14, 0xe8, 0xb9,
+	0xc7, 0x02, 0x3d, 0xf5, 0xd4, 0x3f, 0xa0, 0x7f, 0x43, 0xff, 0x82, 0xa2, 0x87, 0x62, 0x66, 0xf8,
+	0x4b, 0xb2, 0xac, 0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0xf7, 0x63, 0xde, 0xcc, 0x7c, 0xde,
+	0x0f, 0xbe, 0x47, 0x42, 0xc7, 0x8f, 0x04, 0x61, 0x11, 0x0e, 0x6e, 0xc7, 0x8c, 0x0a, 0x8a, 0x2e,
+	0x85, 0x7e, 0x70, 0x98, 0x70, 0x4d, 0xdd, 0xce, 0x84, 0xab, 0xed, 0x01, 0x0d, 0x43, 0x1a, 0x69,
+	0xf6, 0x6a, 0x9b, 0x0f, 0x46, 0x24, 0xc4, 0x9a, 0xb2, 0xaf, 0xc2, 0xca, 0x23, 0x22, 0x9e, 0xf9,
+	0x21, 0x79, 0xe6, 0x0f, 0x5e, 0x6c, 0x8d, 0x70, 0x14, 0x91, 0xc0, 0x21, 0x2f, 0x13, 0xc2, 0x85,
+	0xfd, 0x3e, 0x5c, 0x7d, 0x44, 0xc4, 0xbe, 0xc0, 0xc2, 0xe7, 0xc2, 0x1f, 0xf0, 0x29, 0xf1, 0x25,
+	0xb8, 0xf8, 0x88, 0x88, 0x9e, 0x37, 0xc5, 0x7e, 0x0e, 0x8d, 0x27, 0xd4, 0x23, 0xdb, 0xd1, 0x01,
+	0x45, 0xf7, 0xa0, 0x8e, 0x3d, 0x8f, 0x11, 0xce, 0x2d, 0x63, 0xcd, 0x58, 0x6f, 0x6d, 0x5c, 0xbb,
+	0x3d, 0x71, 0xc6, 0xf4, 0x64, 0x0f, 0xb4, 0x8e, 0x93, 0x29, 0x23, 0x04, 0x55, 0x46, 0x03, 0x62,
+	0x55, 0xd6, 0x8c, 0xf5, 0xa6, 0xa3, 0x9e, 0xed, 0x9f, 0x03, 0x6c, 0x47, 0xbe, 0xd8, 0xc3, 0x0c,
+	0x87, 0x1c, 0x5d, 0x86, 0x85, 0x48, 0xee, 0xd2, 0x53, 0x86, 0x4d, 0x27, 0xa5, 0x50, 0x0f, 0xda,
+	0x5c, 0x60, 0x26, 0xdc, 0x58, 0xe9, 0x59, 0x95, 0x35, 0x73, 0xbd, 0xb5, 0x71, 0x63, 0xe6, 0xb6,
+	0x8f, 0xc9, 0xf8, 0x39, 0x0e, 0x12, 0xb2, 0x87, 0x7d, 0xe6, 0xb4, 0xd4, 0x32, 0x6d, 0xdd, 0xfe,
+	0x29, 0xc0, 0xbe, 0x60, 0x7e, 0x34, 0xdc, 0xf1, 0xb9, 0x90, 0x7b, 0x1d, 0x4a, 0x3d, 0x79, 0x09,
+	0x73, 0xbd, 0xe9, 0xa4, 0x14, 0xfa, 0x18, 0x16, 0xb8, 0xc0, 0x22, 0xe1, 0xea, 0x9c, 0xad, 0x8d,
+	0xab, 0x33, 0x77, 0xd9, 0x57, 0x2a, 0x4e, 0xaa, 0x6a, 0x7f, 0x06, 0xad, 0x0c, 0xee, 0x5d, 0x3e,
+	0x44, 0x77, 0xa1, 0xda, 0xc7, 0x9c, 0xcc, 0x85, 0x67, 0x97, 0x0f, 0x37, 0x31, 0x27, 0x8e, 0xd2,
+	0xb4, 0xff, 0x5c, 0x81, 0xe5, 0x09, 0xb7, 0xa4, 0xc0, 0x9f, 0xdd, 0x94, 0x84, 0xd9, 0xeb, 0x6f,
+	0xf7, 0xd4, 0xf1, 0x4d, 0x47, 0x3d, 0x23, 0x1b, 0xda, 0x03, 0x1a, 0x04, 0x64, 0x20, 0x7c, 0x1a,
+	0x6d, 0xf7, 0x2c, 0x53, 0xc9, 0x26, 0x78, 0x52, 0x27, 0xc6, 0x4c, 0xf8, 0x9a, 0xe4, 0x56, 0x75,
+	0xcd, 0x94, 0x3a, 0x65, 0x1e, 0xfa, 0x10, 0xba, 0x82, 0xe1, 0x43, 0x12, 0xb8, 0xc2, 0x0f, 0x09,
+	0x17, 0x38, 0x8c, 0xad, 0xda, 0x9a, 0xb1, 0x5e, 0x75, 0x2e, 0x68, 0xfe, 0xb3, 0x8c, 0x8d, 0xee,
+	0xc0, 0xc5, 0x61, 0x82, 0x19, 0x8e, 0x04, 0x21, 0x25, 0xed, 0x05, 0xa5, 0x8d, 0x72, 0x51, 0xb1,
+	0xe0, 0x16, 0x2c, 0x49, 0x35, 0x9a, 0x88, 0x92, 0x7a, 0x5d, 0xa9, 0x77, 0x53, 0x41, 0xae, 0x6c,
+	0xff, 0xc5, 0x80, 0x4b, 0x53, 0x78, 0xf1, 0x98, 0x46, 0x9c, 0x9c, 0x03, 0xb0, 0xf3, 0x78, 0x1c,
+	0xdd, 0x87, 0x9a, 0x7c, 0xe2, 0x96, 0x79, 0xda, 0x58, 0xd4, 0xfa, 0xf6, 0xaf, 0x4c, 0xb8, 0xb2,
+	0xc5, 0x08, 0x16, 0x64, 0x2b, 0x47, 0xff, 0xfc, 0xce, 0xbe, 0x02, 0x75, 0xaf, 0xef, 0x46, 0x38,
+	0xcc, 0xd2, 0x6a, 0xc1, 0xeb, 0x3f, 0xc1, 0x21, 0x41, 0xdf, 0x86, 0x4e, 0xe1, 0x5d, 0xc9, 0x51,
+	0x3e, 0x6f, 0x3a, 0x53, 0x5c, 0xf4, 0xff, 0xb0, 0x98, 0x7b, 0x58, 0xa9, 0x55, 0x95, 0xda, 0x24,
+	0x33, 0x8f, 0xa9, 0xda, 0x9c, 0x98, 0x5a, 0x98, 0x11, 0x53, 0x6b, 0xd0, 0x2a, 0xc5, 0x8f, 0xf2,
+	0xa6, 0xe9, 0x94, 0x59, 0x32, 0x0d, 0x75, 0xed, 0xb2, 0x1a, 0x6b, 0xc6, 0x7a, 0xdb, 0x49, 0x29,
+	0x74, 0x17, 0x2e, 0x1e, 0xfa, 0x4c, 0x24, 0x38, 0x48, 0x2b, 0x91, 0x3c, 0x07, 0xb7, 0x9a, 0x2a,
+	0x57, 0x67, 0x89, 0xd0, 0x06, 0x2c, 0xc7, 0xa3, 0x31, 0xf7, 0x07, 0x53, 0x4b, 0x40, 0x2d, 0x99,
+	0x29, 0xb3, 0xff, 0x66, 0xc0, 0xa5, 0x1e, 0xa3, 0xf1, 0x3b, 0xe1, 0x8a, 0x0c, 0xe4, 0xea, 0x1c,
+	0x90, 0x6b, 0xc7, 0x41, 0xb6, 0x7f, 0x5d, 0x81, 0xcb, 0x3a, 0xa2, 0xf6, 0x32, 0x60, 0xbf, 0x84,
+	0x5b, 0x7c, 0x07, 0x2e, 0x14, 0xbb, 0xba, 0xd1, 0xc9, 0xd7, 0xf8, 0x16, 0x74, 0x72, 0x07, 0x6b,
+	0xbd, 0xaf, 0x36, 0xa4, 0xec, 0x2f, 0x2a, 0xb0, 0x2c, 0x9d, 0xfa, 0x0d, 0x1a, 0x12, 0x8d, 0x3f,
+	0x18, 0x80, 0x74, 0x74, 0x3c, 0x08, 0x7c, 0xcc, 0xbf, 0x4e, 0x2c, 0x96, 0xa1, 0x86, 0xe5, 0x19,
+	0x52, 0x08, 0x34, 0x61, 0x73, 0xe8, 0x4a, 0x6f, 0x7d, 0x59, 0xa7, 0xcb, 0x37, 0x35, 0xcb, 0x9b,
+	0xfe, 0xde, 0x80, 0xa5, 0x07, 0x81, 0x20, 0xec, 0x1d, 0x05, 0xe5, 0xaf, 0x95, 0xcc, 0x6b, 0xdb,
+	0x91, 0x47, 0x5e, 0x7d, 0x9d, 0x07, 0x7c, 0x1f, 0xe0, 0xc0, 0x27, 0x81, 0x57, 0x8e, 0xde, 0xa6,
+	0xe2, 0xbc, 0x55, 0xe4, 0x5a, 0x50, 0x57, 0x46, 0xf2, 0xa8, 0xcd, 0x48, 0xd9, 0xed, 0x91, 0x57,
+	0x82, 0xe1, 0xac, 0xdb, 0x6b, 0x9c, 0xba, 0xdb, 0x53, 0xcb, 0xd2, 0x6e, 0xef, 0x1f, 0x55, 0x58,
+	0xdc, 0x8e, 0x38, 0x61, 0xe2, 0xfc, 0xe0, 0x5d, 0x83, 0x26, 0x1f, 0x61, 0xe6, 0x3d, 0x29, 0xe0,
+	0x2b, 0x18, 0x65, 0x68, 0xcd, 0x37, 0x41, 0x5b, 0x3d, 0x65, 0x71, 0xa8, 0xcd, 0x2b, 0x0e, 0x0b,
+	0x73, 0x20, 0xae, 0xbf, 0xb9, 0x38, 0x34, 0x8e, 0xbf, 0x7d, 0xe5, 0x05, 0xc9, 0x30, 0x24, 0x91,
+	0xd8, 0xee, 0x59, 0x4d, 0x25, 0x2f, 0x18, 0xe8, 0x03, 0x80, 0xbc, 0x13, 0xd3, 0xef, 0xd1, 0xaa,
+	0x53, 0xe2, 0xc8, 0x77, 0x37, 0xa3, 0x47, 0xb2, 0x57, 0x6c, 0xa9, 0x5e, 0x31, 0xa5, 0xd0, 0x27,
+	0xd0, 0x60, 0xf4, 0xc8, 0xf5, 0xb0, 0xc0, 0x56, 0x5b, 0x39, 0x6f, 0x65, 0x26, 0xd8, 0x9b, 0x01,
+	0xed, 0x3b, 0x75, 0x46, 0x8f, 0x7a, 0x58, 0x60, 0xf4, 0x19, 0xb4, 0x54, 0x04, 0x70, 0xbd, 0x70,
+	0x51, 0x2d, 0xfc, 0x60, 0x72, 0x61, 0x3a, 0xe6, 0x7c, 0x2e, 0xf5, 0xe4, 0x22, 0x47, 0x87, 0x26,
+	0x57, 0x06, 0x56, 0xa0, 0x11, 0x25, 0xa1, 0xcb, 0xe8, 0x11, 0xb7, 0x3a, 0xaa, 0x6f, 0xac, 0x47,
+	0x49, 0xe8, 0xd0, 0x23, 0x8e, 0x36, 0xa1, 0x7e, 0x48, 0x18, 0xf7, 0x69, 0x64, 0x5d, 0x58, 0x33,
+	0xd6, 0x3b, 0x1b, 0xeb, 0xb7, 0x67, 0x8e, 0x55, 0xb7, 0x75, 0xc4, 0x48, 0x73, 0xcf, 0xb5, 0xbe,
+	0x93, 0x2d, 0xb4, 0xff, 0x59, 0x83, 0xc5, 0x7d, 0x82, 0xd9, 0x60, 0x74, 0xfe, 0x80, 0x5a, 0x86,
+	0x1a, 0x23, 0x2f, 0xf3, 0xe6, 0x5c, 0x13, 0xb9, 0x7f, 0xcd, 0x39, 0xfe, 0xad, 0x9e, 0xa2, 0x63,
+	0xaf, 0xcd, 0xe8, 0xd8, 0xbb, 0x60, 0x7a, 0x3c, 0x50, 0xa1, 0xd3, 0x74, 0xe4, 0xa3, 0xec, 0xb3,
+	0xe3, 0x00, 0x0f, 0xc8, 0x88, 0x06, 0x1e, 0x61, 0xee, 0x90, 0xd1, 0x44, 0xf7, 0xd9, 0x6d, 0xa7,
+	0x5b, 0x12, 0x3c, 0x92, 0x7c, 0x74, 0x1f, 0x1a, 0x1e, 0x0f, 0x5c, 0x31, 0x8e, 0x89, 0x8a, 0x9f,
+	0xce, 0x09, 0xd7, 0xec, 0xf1, 0xe0, 0xd9, 0x38, 0x26, 0x4e, 0xdd, 0xd3, 0x0f, 0xe8, 0x2e, 0x2c,
+	0x73, 0xc2, 0x7c, 0x1c, 0xf8, 0xaf, 0x89, 0xe7, 0x92, 0x57, 0x31, 0x73, 0xe3, 0x00, 0x47, 0x2a,
+	0xc8, 0xda, 0x0e, 0x2a, 0x64, 0x0f, 0x5f, 0xc5, 0x6c, 0x2f, 0xc0, 0x11, 0x5a, 0x87, 0x2e, 0x4d,
+	0x44, 0x9c, 0x08, 0x37, 0x0d, 0x03, 0xdf, 0x53, 0x31, 0x67, 0x3a, 0x1d, 0xcd, 0x57, 0x5e, 0xe7,
+	0xdb, 0xde, 0xcc, 0x29, 0xa4, 0x75, 0xa6, 0x29, 0xa4, 0x7d, 0xb6, 0x29, 0x64, 0x71, 0xf6, 0x14,
+	0x82, 0x3a, 0x50, 0x89, 0x5e, 0xaa, 0x58, 0x33, 0x9d, 0x4a, 0xf4, 0x52, 0x3a, 0x52, 0xd0, 0xf8,
+	0x85, 0x8a, 0x31, 0xd3, 0x51, 0xcf, 0x32, 0x89, 0x42, 0x22, 0x98, 0x3f, 0x90, 0xb0, 0x58, 0x5d,
+	0xe5, 0x87, 0x12, 0x07, 0x7d, 0x08, 0x4b, 0xca, 0x05, 0x6e, 0x7f, 0xac, 0x2f, 0x2e, 0xef, 0xbd,
+	0xa4, 0x0c, 0x74, 0x94, 0x60, 0x73, 0xac, 0x2e, 0xbe, 0xed, 0xc9, 0x4a, 0xac, 0x55, 0xb9, 0xff,
+	0x9a, 0x58, 0x48, 0xa7, 0xab, 0xe2, 0xec, 0xfb, 0xaf, 0x09, 0xfa, 0x01, 0xac, 0x94, 0xca, 0x8e,
+	0x10, 0x25, 0x78, 0xb8, 0x75, 0x51, 0x5d, 0xe1, 0x4a, 0xa1, 0xf0, 0x4c, 0x14, 0x30, 0x71, 0xfb,
+	0x3f, 0x66, 0x11, 0xdc, 0x3c, 0x09, 0x04, 0xff, 0xaa, 0xe6, 0xa8, 0x3c, 0x23, 0xcc, 0x72, 0x46,
+	0x5c, 0x87, 0x96, 0x86, 0x48, 0x47, 0x5e, 0xf5, 0x18, 0x6a, 0xd7, 0xa1, 0x25, 0x73, 0xfd, 0x65,
+	0x42, 0x98, 0x4f, 0x78, 0xfa, 0xf2, 0x81, 0x28, 0x09, 0x9f, 0x6a, 0x0e, 0xba, 0x08, 0x35, 0x41,
+	0x63, 0xf7, 0x45, 0x56, 0x34, 0x05, 0x8d, 0x1f, 0xa3, 0x1f, 0xc1, 0x2a, 0x27, 0x38, 0x20, 0x9e,
+	0x9b, 0x17, 0x39, 0xee, 0x72, 0x75, 0x6d, 0xe2, 0x59, 0x75, 0x15, 0x6c, 0x96, 0xd6, 0xd8, 0xcf,
+	0x15, 0xf6, 0x53, 0xb9, 0x8c, 0xa5, 0x81, 0x1e, 0x1e, 0x26, 0x96, 0x35, 0xd4, 0x7c, 0x81, 0x0a,
+	0x51, 0xbe, 0xe0, 0xfb, 0x60, 0x0d, 0x03, 0xda, 0xc7, 0x81, 0x7b, 0x6c, 0x57, 0x35, 0xc8, 0x98,
+	0xce, 0x65, 0x2d, 0xdf, 0x9f, 0xda, 0x52, 0x5e, 0x8f, 0x07, 0xfe, 0x80, 0x78, 0x6e, 0x3f, 0xa0,
+	0x7d, 0x0b, 0x54, 0xd2, 0x80, 0x66, 0xc9, 0xaa, 0x29, 0x93, 0x25, 0x55, 0x90, 0x30, 0x0c, 0x68,
+	0x12, 0x09, 0x95, 0x02, 0xa6, 0xd3, 0xd1, 0xfc, 0x27, 0x49, 0xb8, 0x25, 0xb9, 0xe8, 0xff, 0x60,
+	0x31, 0xd5, 0xa4, 0x07, 0x07, 0x9c, 0x08, 0x15, 0xfb, 0xa6, 0xd3, 0xd6, 0xcc, 0x9f, 0x28, 0x9e,
+	0xfd, 0x2f, 0x13, 0x2e, 0x38, 0x12, 0x5d, 0x72, 0x48, 0xfe, 0x97, 0xaa, 0xdb, 0x49, 0x55, 0x66,
+	0xe1, 0x4c, 0x55, 0xa6, 0x7e, 0xea, 0x2a, 0xd3, 0x38, 0x53, 0x95, 0x69, 0x9e, 0xad, 0xca, 0xc0,
+	0x09, 0x55, 0x66, 0x19, 0x6a, 0x81, 0x1f, 0xfa, 0x99, 0x83, 0x35, 0x31, 0x3f, 0xdb, 0xdb, 0xf3,
+	0xb3, 0xfd, 0x8f, 0x13, 0xee, 0x7e, 0x07, 0xf2, 0xfd, 0x26, 0x98, 0xbe, 0xa7, 0x5b, 0xe0, 0xd6,
+	0x86, 0x35, 0xf3, 0x9d, 0xbf, 0xdd, 0xe3, 0x8e, 0x54, 0x9a, 0xee, 0x13, 0x6a, 0x67, 0xee, 0x13,
+	0x7e, 0x0c, 0x57, 0x8f, 0x57, 0x01, 0x96, 0xc2, 0xe1, 0x59, 0x0b, 0x2a, 0x1a, 0x56, 0xa6, 0xcb,
+	0x40, 0x86, 0x97, 0x87, 0xbe, 0x07, 0xcb, 0xa5, 0x3a, 0x50, 0x2c, 0xac, 0xeb, 0x6f, 0x13, 0x85,
+	0xac, 0x58, 0x32, 0xaf, 0x12, 0x34, 0xe6, 0x55, 0x02, 0xfb, 0xef, 0x26, 0x2c, 0xf6, 0x48, 0x40,
+	0x04, 0xf9, 0xa6, 0x8d, 0x3d, 0xb1, 0x8d, 0xfd, 0x2e, 0x20, 0x3f, 0x12, 0xf7, 0x3e, 0x71, 0x63,
+	0xe6, 0x87, 0x98, 0x8d, 0xdd, 0x17, 0x64, 0x9c, 0x95, 0xd8, 0xae, 0x92, 0xec, 0x69, 0xc1, 0x63,
+	0x32, 0xe6, 0x6f, 0x6c, 0x6b, 0xcb, 0x7d, 0xa4, 0x4e, 0xb9, 0xbc, 0x8f, 0xfc, 0x21, 0xb4, 0x27,
+	0xb6, 0x68, 0xbf, 0x21, 0x60, 0x5b, 0x71, 0xb1, 0xaf, 0xfd, 0x6f, 0x03, 0x9a, 0x3b, 0x14, 0x7b,
+	0x6a, 0xa2, 0x3b, 0xa7, 0x1b, 0xf3, 0x66, 0xbd, 0x32, 0xdd, 0xac, 0x5f, 0x83, 0x62, 0x28, 0x4b,
+	0x1d, 0x59, 0x30, 0xca, 0xd3, 0x56, 0x75, 0x72, 0xda, 0xba, 0x0e, 0x2d, 0x5f, 0x1e, 0xc8, 0x8d,
+	0xb1, 0x18, 0xe9, 0x2a, 0xdb, 0x74, 0x40, 0xb1, 0xf6, 0x24, 0x47, 0x8e, 0x63, 0x99, 0x82, 0x1a,
+	0xc7, 0x16, 0x4e, 0x3d, 0x8e, 0xa5, 0x46, 0xd4, 0x38, 0xf6, 0x4b, 0x43, 0x7e, 0xe9, 0xf7, 0xc8,
+	0x2b, 0x59, 0x0f, 0x8e, 0x1b, 0x35, 0xce, 0x63, 0x54, 0x96, 0x7f, 0xe5, 0x29, 0x12, 0x60, 0x51,
+	0x24, 0x15, 0x4f, 0xc1, 0x41, 0xd2, 0x6b, 0x5a, 0x94, 0x26, 0x14, 0xb7, 0x7f, 0x63, 0x00, 0xa8,
+	0xaa, 0xa0, 0x8f, 0x31, 0x1d, 0x7e, 0xc6, 0xfc, 0x41, 0xb5, 0x32, 0x09, 0xdd, 0x66, 0x06, 0xdd,
+	0x9c, 0x2f, 0xc1, 0xa5, 0xc9, 0x22, 0xbb, 0x7c, 0x8a, 0xae, 0x7a, 0xb6, 0x7f, 0x6b, 0x40, 0x3b,
+	0x3d, 0x9d, 0x3e, 0xd2, 0x84, 0x97, 0x8d, 0x69, 0x2f, 0xab, 0xc6, 0x28, 0xa4, 0x6c, 0xac, 0x7b,
+	0x40, 0x7d, 0x20, 0xd0, 0x2c, 0xd5, 0x04, 0x96, 0x83, 0xd7, 0x9c, 0x0c, 0xde, 0x5b, 0xb0, 0xc4,
+	0xc8, 0x80, 0x44, 0x22, 0x18, 0xbb, 0x21, 0xf5, 0xfc, 0x03, 0x9f, 0x78, 0x2a, 0x1a, 0x1a, 0x4e,
+	0x37, 0x13, 0xec, 0xa6, 0x7c, 0xfb, 0x17, 0x06, 0xb4, 0x76, 0xf9, 0x70, 0x8f, 0x72, 0x95, 0x64,
+	0xe8, 0x06, 0xb4, 0xd3, 0xc2, 0xa6, 0x33, 0xdc, 0x50, 0x11, 0xd6, 0x1a, 0x14, 0x5f, 0x53, 0x65,
+	0x69, 0x0f, 0xf9, 0x30, 0x85, 0xa9, 0xed, 0x68, 0x02, 0xad, 0x42, 0x23, 0xe4, 0x43, 0x35, 0x4d,
+	0xa4, 0x61, 0x99, 0xd3, 0xf2, 0xae, 0xc5, 0xeb, 0xaf, 0xaa, 0xde, 0x59, 0x4d, 0x51, 0xfe, 0xc6,
+	0x8f, 0xd2, 0xaf, 0xb5, 0x6f, 0xf5, 0x73, 0x45, 0x79, 0xb9, 0xfc, 0x45, 0xb8, 0xa2, 0x62, 0x7c,
+	0x82, 0x37, 0x55, 0x14, 0xcc, 0x63, 0x45, 0xe1, 0x16, 0x2c, 0x79, 0xe4, 0x00, 0x27, 0x81, 0x70,
+	0xa7, 0x8f, 0xdc, 0x4d, 0x05, 0x13, 0x7f, 0x27, 0x3a, 0x5b, 0x8c, 0x78, 0x24, 0x12, 0x3e, 0x0e,
+	0xd4, 0x4f, 0xb3, 0x55, 0x68, 0x24, 0x9c, 0xb0, 0x12, 0x76, 0x39, 0x8d, 0x3e, 0x02, 0x44, 0xa2,
+	0x01, 0x1b, 0xc7, 0x32, 0x88, 0x63, 0xcc, 0xf9, 0x11, 0x65, 0x5e, 0x5a, 0xa8, 0x97, 0x72, 0xc9,
+	0x5e, 0x2a, 0x90, 0x63, 0xb7, 0x20, 0x11, 0x8e, 0x44, 0x56, 0xaf, 0x35, 0x25, 0x5d, 0xef, 0x73,
+	0x97, 0x27, 0x31, 0x61, 0xa9, 0x5b, 0xeb, 0x3e, 0xdf, 0x97, 0xa4, 0x2c, 0xe5, 0x7c, 0x84, 0x37,
+	0x3e, 0xbd, 0x57, 0x98, 0xd7, 0x25, 0xba, 0xa3, 0xd9, 0x99, 0x6d, 0xfb, 0x21, 0x2c, 0xc9, 0xbf,
+	0x63, 0x7b, 0x34, 0xf0, 0x07, 0xe3, 0x73, 0xbf, 0x71, 0xec, 0x2f, 0x0c, 0x40, 0x65, 0x3b, 0xe9,
+	0xbf, 0x99, 0xa2, 0x63, 0x30, 0x4e, 0xdf, 0x31, 0xdc, 0x80, 0x76, 0xac, 0xcc, 0xb8, 0x7e, 0x74,
+	0x40, 0x33, 0xef, 0xb5, 0x34, 0x4f, 0x62, 0xcb, 0xe5, 0x60, 0x24, 0xc1, 0x74, 0x19, 0x0d, 0x88,
+	0x76, 0x5e, 0xd3, 0x69, 0x4a, 0x8e, 0x23, 0x19, 0xf6, 0x10, 0x56, 0xf6, 0x47, 0xf4, 0x68, 0x8b,
+	0x46, 0x07, 0xfe, 0x30, 0x61, 0x58, 0x06, 0xf4, 0x5b, 0x7c, 0xf3, 0xb3, 0xa0, 0x1e, 0x63, 0x21,
+	0xd3, 0x3a, 0xf5, 0x51, 0x46, 0xda, 0xbf, 0x33, 0x60, 0x75, 0xd6, 0x4e, 0x6f, 0x73, 0xfd, 0x47,
+	0xb0, 0x38, 0xd0, 0xe6, 0xb4, 0xb5, 0xd3, 0xff, 0xfc, 0x9c, 0x5c, 0x67, 0x3f, 0x84, 0xaa, 0x83,
+	0x05, 0x41, 0x77, 0xa0, 0xc2, 0x84, 0x3a, 0x41, 0x67, 0xe3, 0xfa, 0x09, 0xc5, 0x4a, 0x2a, 0xaa,
+	0x79, 0xbe, 0xc2, 0x04, 0x6a, 0x83, 0xc1, 0xd4, 0x4d, 0x0d, 0xc7, 0x60, 0x37, 0x37, 0x60, 0xe9,
+	0xd8, 0x47, 0x12, 0xd4, 0x86, 0x86, 0x43, 0x8f, 0x24, 0x46, 0x5e, 0xf7, 0x3d, 0x74, 0x01, 0x5a,
+	0x5b, 0x34, 0x48, 0xc2, 0x48, 0x33, 0x8c, 0x9b, 0x7f, 0x32, 0xa0, 0x91, 0x99, 0x44, 0x4b, 0xb0,
+	0xd8, 0xeb, 0xed, 0x14, 0x7f, 0x5c, 0xba, 0xef, 0xa1, 0x2e, 0xb4, 0x7b, 0xbd, 0x9d, 0xfc, 0x7b,
+	0x7d, 0xd7, 0x90, 0x06, 0x7b, 0xbd, 0x1d, 0x55, 0x33, 0xbb, 0x95, 0x94, 0xfa, 0x3c, 0x48, 0xf8,
+	0xa8, 0x6b, 0xe6, 0x06, 0xc2, 0x18, 0x6b, 0x03, 0x55, 0xb4, 0x08, 0xcd, 0xde, 0xee, 0x8e, 0x3e,
+	0x57, 0xb7, 0x96, 0x92, 0xba, 0x6d, 0xea, 0x2e, 0xc8, 0xf3, 0xf4, 0x76, 0x77, 0x36, 0x93, 0xe0,
+	0x85, 0x7c, 0xfd, 0x76, 0xeb, 0x4a, 0xfe, 0x74, 0x47, 0xcf, 0x69, 0xdd, 0x86, 0x32, 0xff, 0x74,
+	0x47, 0x4e, 0x8e, 0xe3, 0x6e, 0x73, 0xf3, 0xfe, 0xcf, 0x3e, 0x1d, 0xfa, 0x62, 0x94, 0xf4, 0x25,
+	0xa8, 0x77, 0x34, 0x3e, 0x1f, 0xf9, 0x34, 0x7d, 0xba, 0x93, 0x61, 0x74, 0x47, 0x41, 0x96, 0x93,
+	0x71, 0xbf, 0xbf, 0xa0, 0x38, 0x1f, 0xff, 0x77, 0x00, 0xe2, 0x2e, 0x38, 0xc1, 0xc2, 0x1f, 0x00,
+	0x00,
 }
@@ -169,6 +169,31 @@ func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
+type ArrayContainsExpr_ArrayOp int32
+
+const (
+	ArrayContainsExpr_Contains    ArrayContainsExpr_ArrayOp = 0
+	ArrayContainsExpr_ContainsAny ArrayContainsExpr_ArrayOp = 1
+)
+
+var ArrayContainsExpr_ArrayOp_name = map[int32]string{
+	0: "Contains",
+	1: "ContainsAny",
+}
+
+var ArrayContainsExpr_ArrayOp_value = map[string]int32{
+	"Contains":    0,
+	"ContainsAny": 1,
+}
+
+func (x ArrayContainsExpr_ArrayOp) String() string {
+	return proto.EnumName(ArrayContainsExpr_ArrayOp_name, int32(x))
+}
+
+func (ArrayContainsExpr_ArrayOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{14, 0}
+}
+
 type GenericValue struct {
 	// Types that are valid to be assigned to Val:
 	//	*GenericValue_BoolVal
@@ -347,6 +372,8 @@ type ColumnInfo struct {
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
 	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
 	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	NestedPath           []string          `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	ElementType          schemapb.DataType `protobuf:"varint,6,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -405,6 +432,20 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
+func (m *ColumnInfo) GetElementType() schemapb.DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return schemapb.DataType_None
+}
+
 type ColumnExpr struct {
 	Info                 *ColumnInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -994,6 +1035,61 @@ func (m *BinaryArithOpEvalRangeExpr) GetValue() *GenericValue {
 	return nil
 }
 
+type ArrayContainsExpr struct {
+	ColumnInfo           *ColumnInfo               `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Elements             []*GenericValue           `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Op                   ArrayContainsExpr_ArrayOp `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.ArrayContainsExpr_ArrayOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArrayContainsExpr) Reset()         { *m = ArrayContainsExpr{} }
+func (m *ArrayContainsExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayContainsExpr) ProtoMessage()    {}
+func (*ArrayContainsExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
+}
+
+func (m *ArrayContainsExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayContainsExpr.Unmarshal(m, b)
+}
+func (m *ArrayContainsExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayContainsExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayContainsExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayContainsExpr.Merge(m, src)
+}
+func (m *ArrayContainsExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayContainsExpr.Size(m)
+}
+func (m *ArrayContainsExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayContainsExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayContainsExpr proto.InternalMessageInfo
+
+func (m *ArrayContainsExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetElements() []*GenericValue {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetOp() ArrayContainsExpr_ArrayOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayContainsExpr_Contains
+}
+
 type Expr struct {
 	// Types that are valid to be assigned to Expr:
 	//	*Expr_TermExpr
@@ -1006,6 +1102,7 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_ArrayContainsExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1113,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1178,10 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_ArrayContainsExpr struct {
+	ArrayContainsExpr *ArrayContainsExpr `protobuf:"bytes,11,opt,name=array_contains_expr,json=arrayContainsExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1202,8 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_ArrayContainsExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1281,13 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetArrayContainsExpr() *ArrayContainsExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayContainsExpr); ok {
+		return x.ArrayContainsExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1301,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_ArrayContainsExpr)(nil),
 	}
 }
 
@@ -1209,7 +1320,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1271,6 +1382,7 @@ type PlanNode struct {
 	//	*PlanNode_Predicates
 	Node                 isPlanNode_Node `protobuf_oneof:"node"`
 	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	Limit                int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1280,7 +1392,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1345,6 +1457,13 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1358,6 +1477,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayContainsExpr_ArrayOp", ArrayContainsExpr_ArrayOp_name, ArrayContainsExpr_ArrayOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
@@ -1372,6 +1492,7 @@ func init() {
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
 	proto.RegisterType((*BinaryArithExpr)(nil), "milvus.proto.plan.BinaryArithExpr")
 	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
+	proto.RegisterType((*ArrayContainsExpr)(nil), "milvus.proto.plan.ArrayContainsExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
 	proto.RegisterType((*VectorANNS)(nil), "milvus.proto.plan.VectorANNS")
 	proto.RegisterType((*PlanNode)(nil), "milvus.proto.plan.PlanNode")
@@ -1380,93 +1501,102 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x73, 0xdb, 0xb6,
+	0x12, 0x17, 0x45, 0xfd, 0x21, 0x57, 0xb2, 0x4c, 0xe3, 0xbd, 0x99, 0xe7, 0x24, 0x2f, 0xb1, 0x1f,
+	0x5f, 0xe6, 0x3d, 0x37, 0x6d, 0xec, 0x49, 0x93, 0x26, 0x93, 0xa4, 0x7f, 0x22, 0xdb, 0xa9, 0xa5,
+	0x69, 0x62, 0xbb, 0x8c, 0xe3, 0x43, 0x2f, 0x1c, 0x88, 0x84, 0x2d, 0x4c, 0x28, 0x90, 0x01, 0x41,
+	0x25, 0x3a, 0xf7, 0xd4, 0xde, 0xfa, 0x01, 0x7a, 0xee, 0xbd, 0xc7, 0x5e, 0xfa, 0x05, 0x3a, 0xd3,
+	0x4e, 0x4f, 0xbd, 0xf7, 0x4b, 0xf4, 0xd8, 0x01, 0x40, 0xfd, 0xf3, 0xc8, 0xb6, 0x3c, 0xf5, 0x4c,
+	0x6f, 0xbb, 0x8b, 0xdd, 0xc5, 0xee, 0x0f, 0xbb, 0x0b, 0x00, 0x20, 0x89, 0x30, 0x5b, 0x4f, 0x78,
+	0x2c, 0x62, 0xb4, 0xd4, 0xa3, 0x51, 0x3f, 0x4b, 0x35, 0xb7, 0x2e, 0x17, 0xae, 0xd6, 0xd3, 0xa0,
+	0x4b, 0x7a, 0x58, 0x8b, 0xdc, 0x6f, 0x0c, 0xa8, 0xef, 0x10, 0x46, 0x38, 0x0d, 0x0e, 0x71, 0x94,
+	0x11, 0x74, 0x0d, 0xac, 0x4e, 0x1c, 0x47, 0x7e, 0x1f, 0x47, 0xcb, 0xc6, 0xaa, 0xb1, 0x66, 0xb5,
+	0x0a, 0x5e, 0x55, 0x4a, 0x0e, 0x71, 0x84, 0xae, 0x83, 0x4d, 0x99, 0xb8, 0x7f, 0x4f, 0xad, 0x16,
+	0x57, 0x8d, 0x35, 0xb3, 0x55, 0xf0, 0x2c, 0x25, 0xca, 0x97, 0x8f, 0xa2, 0x18, 0x0b, 0xb5, 0x6c,
+	0xae, 0x1a, 0x6b, 0x86, 0x5c, 0x56, 0x22, 0xb9, 0xbc, 0x02, 0x90, 0x0a, 0x4e, 0xd9, 0xb1, 0x5a,
+	0x2f, 0xad, 0x1a, 0x6b, 0x76, 0xab, 0xe0, 0xd9, 0x5a, 0x76, 0x88, 0xa3, 0xcd, 0x32, 0x98, 0x7d,
+	0x1c, 0xb9, 0x5f, 0x1b, 0x60, 0x7f, 0x9e, 0x11, 0x3e, 0x68, 0xb3, 0xa3, 0x18, 0x21, 0x28, 0x89,
+	0x38, 0x79, 0xa5, 0x82, 0x31, 0x3d, 0x45, 0xa3, 0x15, 0xa8, 0xf5, 0x88, 0xe0, 0x34, 0xf0, 0xc5,
+	0x20, 0x21, 0x6a, 0x2b, 0xdb, 0x03, 0x2d, 0x3a, 0x18, 0x24, 0x04, 0xfd, 0x17, 0x16, 0x52, 0x82,
+	0x79, 0xd0, 0xf5, 0x13, 0xcc, 0x71, 0x2f, 0xd5, 0xbb, 0x79, 0x75, 0x2d, 0xdc, 0x57, 0x32, 0xa9,
+	0xc4, 0xe3, 0x8c, 0x85, 0x7e, 0x48, 0x02, 0xda, 0xc3, 0xd1, 0x72, 0x59, 0x6d, 0x51, 0x57, 0xc2,
+	0x6d, 0x2d, 0x73, 0xbf, 0x2a, 0x02, 0x6c, 0xc5, 0x51, 0xd6, 0x63, 0x2a, 0x9a, 0x2b, 0x60, 0x1d,
+	0x51, 0x12, 0x85, 0x3e, 0x0d, 0xf3, 0x88, 0xaa, 0x8a, 0x6f, 0x87, 0xe8, 0x11, 0xd8, 0x21, 0x16,
+	0x58, 0x87, 0x24, 0xc1, 0x69, 0xbc, 0x7f, 0x7d, 0x7d, 0x0a, 0xff, 0x1c, 0xf9, 0x6d, 0x2c, 0xb0,
+	0x8c, 0xd2, 0xb3, 0xc2, 0x9c, 0x42, 0x37, 0xa1, 0x41, 0x53, 0x3f, 0xe1, 0xb4, 0x87, 0xf9, 0xc0,
+	0x7f, 0x45, 0x06, 0x2a, 0x27, 0xcb, 0xab, 0xd3, 0x74, 0x5f, 0x0b, 0x3f, 0x23, 0x03, 0x74, 0x0d,
+	0x6c, 0x9a, 0xfa, 0x38, 0x13, 0x71, 0x7b, 0x5b, 0x65, 0x64, 0x79, 0x16, 0x4d, 0x9b, 0x8a, 0x97,
+	0x98, 0x30, 0x92, 0x0a, 0x12, 0xfa, 0x09, 0x16, 0xdd, 0xe5, 0xf2, 0xaa, 0x29, 0x31, 0xd1, 0xa2,
+	0x7d, 0x2c, 0xba, 0xe8, 0x09, 0xd4, 0x49, 0x44, 0x7a, 0x84, 0x09, 0x1d, 0x62, 0x65, 0x9e, 0x10,
+	0x6b, 0xb9, 0x89, 0x64, 0xdc, 0x4f, 0x86, 0x50, 0x3c, 0x7d, 0x9b, 0x70, 0x74, 0x07, 0x4a, 0x94,
+	0x1d, 0xc5, 0x0a, 0x86, 0xda, 0x49, 0x3f, 0xaa, 0x06, 0xc7, 0xb8, 0x79, 0x4a, 0xd5, 0xdd, 0x04,
+	0x5b, 0x55, 0x99, 0xb2, 0xff, 0x00, 0xca, 0x7d, 0xc9, 0xe4, 0x0e, 0x56, 0x66, 0x38, 0x98, 0xac,
+	0x4c, 0x4f, 0x6b, 0xbb, 0xdf, 0x1b, 0xd0, 0x78, 0xc9, 0x30, 0x1f, 0x78, 0x98, 0x1d, 0x6b, 0x4f,
+	0x1f, 0x43, 0x2d, 0x50, 0x5b, 0xf9, 0xf3, 0x07, 0x04, 0xc1, 0xf8, 0x50, 0xdf, 0x81, 0x62, 0x9c,
+	0xe4, 0x47, 0x76, 0x65, 0x86, 0xd9, 0x5e, 0xa2, 0xb0, 0x28, 0xc6, 0xc9, 0x38, 0x68, 0xf3, 0x42,
+	0x41, 0x7f, 0x57, 0x84, 0xc5, 0x4d, 0x7a, 0xb9, 0x51, 0xff, 0x1f, 0x16, 0xa3, 0xf8, 0x0d, 0xe1,
+	0x3e, 0x65, 0x41, 0x94, 0xa5, 0xb4, 0xaf, 0xab, 0xce, 0xf2, 0x1a, 0x4a, 0xdc, 0x1e, 0x4a, 0xa5,
+	0x62, 0x96, 0x24, 0x53, 0x8a, 0xba, 0xba, 0x1a, 0x4a, 0x3c, 0x56, 0x7c, 0x02, 0x35, 0xed, 0x51,
+	0xa7, 0x58, 0x9a, 0x2f, 0x45, 0x50, 0x36, 0x8a, 0x96, 0x1e, 0xf4, 0x56, 0xda, 0x43, 0x79, 0x4e,
+	0x0f, 0xca, 0x46, 0xd1, 0xee, 0x4f, 0x06, 0xd4, 0xb6, 0xe2, 0x5e, 0x82, 0xb9, 0x46, 0x69, 0x07,
+	0x9c, 0x88, 0x1c, 0x09, 0xff, 0xc2, 0x50, 0x35, 0xa4, 0xd9, 0x98, 0x47, 0x6d, 0x58, 0xe2, 0xf4,
+	0xb8, 0x3b, 0xed, 0xa9, 0x38, 0x8f, 0xa7, 0x45, 0x65, 0xb7, 0x75, 0xb2, 0x5e, 0xcc, 0x39, 0xea,
+	0xc5, 0xfd, 0xd2, 0x00, 0xeb, 0x80, 0xf0, 0xde, 0xa5, 0x9c, 0xf8, 0x03, 0xa8, 0x28, 0x5c, 0xd3,
+	0xe5, 0xe2, 0xaa, 0x39, 0x0f, 0xb0, 0xb9, 0xba, 0x9c, 0xf2, 0xb6, 0xea, 0x19, 0x15, 0xc6, 0x3d,
+	0x15, 0xbe, 0xa1, 0xc2, 0xbf, 0x39, 0xc3, 0xc5, 0x48, 0x53, 0x53, 0x7b, 0x89, 0xaa, 0xfc, 0xdb,
+	0x50, 0x0e, 0xba, 0x34, 0x0a, 0x73, 0xcc, 0xfe, 0x35, 0xc3, 0x50, 0xda, 0x78, 0x5a, 0xcb, 0x5d,
+	0x81, 0x6a, 0x6e, 0x8d, 0x6a, 0x50, 0x6d, 0xb3, 0x3e, 0x8e, 0x68, 0xe8, 0x14, 0x50, 0x15, 0xcc,
+	0xdd, 0x58, 0x38, 0x86, 0xfb, 0x9b, 0x01, 0xa0, 0x5b, 0x42, 0x05, 0x75, 0x7f, 0x22, 0xa8, 0xff,
+	0xcd, 0xf0, 0x3d, 0x56, 0xcd, 0xc9, 0x3c, 0xac, 0x77, 0xa1, 0x24, 0x0f, 0xfa, 0xbc, 0xa8, 0x94,
+	0x92, 0xcc, 0x41, 0x9d, 0xe5, 0xb2, 0x79, 0xb6, 0xb6, 0xd6, 0x72, 0xef, 0x83, 0xb5, 0x49, 0x67,
+	0x25, 0xd1, 0x00, 0x78, 0x16, 0x1f, 0xd3, 0x00, 0x47, 0x4d, 0x16, 0x3a, 0x06, 0x5a, 0x00, 0x3b,
+	0xe7, 0xf7, 0xb8, 0x53, 0x74, 0x7f, 0x31, 0x60, 0x41, 0x1b, 0x36, 0x39, 0x15, 0xdd, 0xbd, 0xe4,
+	0x2f, 0x9f, 0xfc, 0x43, 0xb0, 0xb0, 0x74, 0xe5, 0x8f, 0xe6, 0xd4, 0x8d, 0x19, 0xc6, 0xf9, 0x6e,
+	0xaa, 0xf8, 0xaa, 0x38, 0xdf, 0x7a, 0x1b, 0x16, 0x74, 0xdd, 0xc7, 0x09, 0xe1, 0x98, 0x85, 0xf3,
+	0x4e, 0xae, 0xba, 0xb2, 0xda, 0xd3, 0x46, 0xee, 0xb7, 0xc6, 0x70, 0x80, 0xa9, 0x4d, 0xd4, 0x91,
+	0x0d, 0xa1, 0x37, 0x2e, 0x04, 0x7d, 0x71, 0x1e, 0xe8, 0xd1, 0xfa, 0x44, 0x8b, 0x9d, 0x97, 0xaa,
+	0xec, 0xb3, 0x1f, 0x8b, 0x70, 0x75, 0x0a, 0xf2, 0xa7, 0x7d, 0x1c, 0x5d, 0xde, 0xac, 0xfd, 0xbb,
+	0xf1, 0xcf, 0x47, 0x4e, 0xe9, 0x42, 0x57, 0x54, 0xf9, 0x42, 0x57, 0xd4, 0x1f, 0x06, 0x2c, 0x35,
+	0x39, 0xc7, 0x83, 0xad, 0x98, 0x09, 0x4c, 0x59, 0x7a, 0x29, 0xc0, 0x3d, 0x06, 0x2b, 0x7f, 0x41,
+	0xcc, 0x3d, 0xb4, 0x46, 0x06, 0xe8, 0xc3, 0x89, 0x22, 0x78, 0x6f, 0x26, 0xde, 0x27, 0xc2, 0xd5,
+	0x12, 0x3d, 0x19, 0xdc, 0x35, 0xa8, 0xe6, 0x2c, 0xaa, 0x83, 0x35, 0x54, 0x73, 0x0a, 0x68, 0x11,
+	0x6a, 0x43, 0xae, 0xc9, 0x06, 0x8e, 0xe1, 0xfe, 0x5a, 0x81, 0x92, 0xca, 0xf6, 0x11, 0xd8, 0x82,
+	0xf0, 0x9e, 0x4f, 0xde, 0x26, 0x3c, 0xcf, 0xf5, 0xda, 0x8c, 0x7d, 0x87, 0x03, 0x5d, 0xbe, 0x6e,
+	0x45, 0x4e, 0xa3, 0x8f, 0x00, 0x32, 0x59, 0x7f, 0xda, 0x58, 0x57, 0xf9, 0xbf, 0xcf, 0x9a, 0xae,
+	0xf2, 0xed, 0x9b, 0x0d, 0x19, 0x79, 0x73, 0x76, 0xe8, 0xd8, 0xde, 0x3c, 0x15, 0xe8, 0xf1, 0x20,
+	0x6c, 0x15, 0x3c, 0xe8, 0x8c, 0x38, 0xb4, 0x05, 0xf5, 0x40, 0x5f, 0x9c, 0xda, 0x85, 0xbe, 0xbe,
+	0x6f, 0xcc, 0x3c, 0xab, 0xd1, 0xfd, 0xda, 0x2a, 0x78, 0xb5, 0x60, 0xcc, 0xa2, 0xe7, 0xe0, 0xe8,
+	0x2c, 0xb8, 0xec, 0x1d, 0xed, 0x48, 0xd7, 0xd1, 0x7f, 0x4e, 0xcb, 0x65, 0xd4, 0x65, 0xad, 0x82,
+	0xd7, 0xc8, 0xa6, 0x24, 0x68, 0x1f, 0x96, 0x3a, 0xf4, 0xa4, 0xbf, 0x8a, 0xf2, 0xe7, 0x9e, 0x9a,
+	0xdb, 0xa4, 0xc3, 0xc5, 0xce, 0xb4, 0x08, 0x09, 0x58, 0xc9, 0x3d, 0x0e, 0x1b, 0xd2, 0x27, 0x7d,
+	0x1c, 0x4d, 0xfa, 0xaf, 0x2a, 0xff, 0xb7, 0x4f, 0xf5, 0x3f, 0x6b, 0x42, 0xb4, 0x0a, 0xde, 0xd5,
+	0xce, 0xa9, 0xab, 0x13, 0x79, 0xe8, 0x5d, 0xd5, 0x3e, 0xd6, 0x39, 0x79, 0x8c, 0x26, 0xe5, 0x38,
+	0x8f, 0x91, 0x48, 0x96, 0x8b, 0xea, 0x3b, 0xed, 0xca, 0x3e, 0xb5, 0x5c, 0x46, 0xef, 0x65, 0x59,
+	0x2e, 0xfd, 0x21, 0x23, 0xcb, 0x25, 0xef, 0x4b, 0x65, 0x0f, 0xe7, 0xf4, 0xe5, 0xb0, 0x5c, 0x82,
+	0x11, 0x87, 0x0e, 0xe1, 0x1f, 0x58, 0xb6, 0x87, 0x1f, 0xe4, 0xbd, 0xa0, 0x3d, 0xd5, 0x94, 0xa7,
+	0x9b, 0xf3, 0x74, 0x5b, 0xab, 0xe0, 0x2d, 0xe1, 0x93, 0xc2, 0xcd, 0x0a, 0x94, 0xa4, 0x23, 0xf7,
+	0x77, 0x03, 0xe0, 0x90, 0x04, 0x22, 0xe6, 0xcd, 0xdd, 0xdd, 0x17, 0xf9, 0xdf, 0x45, 0xa3, 0xb0,
+	0x6c, 0x0c, 0xff, 0x2e, 0x1a, 0xa8, 0xa9, 0x5f, 0x55, 0x71, 0xfa, 0x57, 0xf5, 0x00, 0x20, 0xe1,
+	0x24, 0xa4, 0x01, 0x16, 0x24, 0x3d, 0xef, 0xde, 0x9e, 0x50, 0x45, 0x8f, 0x01, 0x5e, 0xcb, 0x4f,
+	0xa4, 0x1e, 0x5c, 0xa5, 0x53, 0x01, 0x1e, 0xfd, 0x34, 0x3d, 0xfb, 0xf5, 0x90, 0x94, 0x4f, 0xe6,
+	0x24, 0xc2, 0x01, 0xe9, 0xc6, 0x51, 0x48, 0xb8, 0x2f, 0xf0, 0xb1, 0xea, 0x02, 0xdb, 0x6b, 0x4c,
+	0x88, 0x0f, 0xf0, 0xb1, 0xfb, 0xb3, 0x01, 0xd6, 0x7e, 0x84, 0xd9, 0x6e, 0x1c, 0xaa, 0xd7, 0x6f,
+	0x5f, 0x65, 0xec, 0x63, 0xc6, 0xd2, 0x33, 0x86, 0xe5, 0x18, 0x17, 0x79, 0x28, 0xda, 0xa6, 0xc9,
+	0x58, 0x8a, 0x1e, 0x4e, 0x65, 0x7b, 0xf6, 0x55, 0x29, 0x4d, 0x27, 0xf2, 0x5d, 0x03, 0x27, 0xce,
+	0x44, 0x92, 0x09, 0x7f, 0x08, 0xa5, 0x84, 0xcb, 0x5c, 0x33, 0xbd, 0x86, 0x96, 0x7f, 0xaa, 0x11,
+	0x4d, 0xd1, 0x3f, 0xa1, 0x1c, 0xd1, 0x1e, 0x15, 0x0a, 0x14, 0xd3, 0xd3, 0x8c, 0x3c, 0x37, 0x16,
+	0x87, 0xe4, 0xd6, 0x0f, 0x06, 0x54, 0xf4, 0x6d, 0x32, 0xfd, 0xe6, 0x59, 0x84, 0xda, 0x0e, 0x27,
+	0x58, 0x10, 0x7e, 0xd0, 0xc5, 0xcc, 0x31, 0x90, 0x03, 0xf5, 0x5c, 0xf0, 0xf4, 0x75, 0x86, 0x23,
+	0xa7, 0x28, 0xc7, 0xec, 0x33, 0x92, 0xa6, 0x6a, 0xdd, 0x54, 0x8f, 0x22, 0x92, 0xa6, 0x7a, 0xb1,
+	0x84, 0x6c, 0x28, 0x6b, 0xb2, 0x2c, 0xf5, 0x76, 0x63, 0xa1, 0xb9, 0x8a, 0x74, 0xbc, 0xcf, 0xc9,
+	0x11, 0x7d, 0xfb, 0x1c, 0x8b, 0xa0, 0xeb, 0x54, 0xa5, 0xe3, 0xfd, 0x38, 0x15, 0x23, 0x89, 0x25,
+	0x6d, 0x35, 0x69, 0x4b, 0x52, 0xb5, 0xa5, 0x03, 0xa8, 0x02, 0xc5, 0x36, 0x73, 0x6a, 0x52, 0xb4,
+	0x1b, 0x8b, 0x36, 0x73, 0xea, 0xb7, 0x76, 0xa0, 0x36, 0x71, 0x09, 0xcb, 0x04, 0x5e, 0xb2, 0x57,
+	0x2c, 0x7e, 0xc3, 0xf4, 0xcb, 0xb3, 0x19, 0xca, 0xd7, 0x5a, 0x15, 0xcc, 0x17, 0x59, 0xc7, 0x29,
+	0x4a, 0xe2, 0x79, 0x16, 0x39, 0xa6, 0x24, 0xb6, 0x69, 0xdf, 0x29, 0x29, 0x49, 0x1c, 0x3a, 0xe5,
+	0xcd, 0xbb, 0x5f, 0xdc, 0x39, 0xa6, 0xa2, 0x9b, 0x75, 0xd6, 0x83, 0xb8, 0xb7, 0xa1, 0x0f, 0xe0,
+	0x36, 0x8d, 0x73, 0x6a, 0x83, 0x32, 0x41, 0x38, 0xc3, 0xd1, 0x86, 0x3a, 0x93, 0x0d, 0x79, 0x26,
+	0x49, 0xa7, 0x53, 0x51, 0xdc, 0xdd, 0x3f, 0x07, 0x00, 0xe1, 0xa5, 0x00, 0xe4, 0x82, 0x11, 0x00,
+	0x00,
 }
@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +28,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_ExportFailed    ExportState = 1
+	ExportState_ExportStarted   ExportState = 2
+	ExportState_ExportCompleted ExportState = 3
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "ExportFailed",
+	2: "ExportStarted",
+	3: "ExportCompleted",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"ExportFailed":    1,
+	"ExportStarted":   2,
+	"ExportCompleted": 3,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{0}
+}
+
 type InvalidateCollMetaCacheRequest struct {
 	// MsgType:
 	//  DropCollection    ->  {meta cache, dml channels}
@@ -205,147 +237,981 @@ type RefreshPolicyInfoCacheRequest struct {
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *RefreshPolicyInfoCacheRequest) Reset()         { *m = RefreshPolicyInfoCacheRequest{} }
-func (m *RefreshPolicyInfoCacheRequest) String() string { return proto.CompactTextString(m) }
-func (*RefreshPolicyInfoCacheRequest) ProtoMessage()    {}
-func (*RefreshPolicyInfoCacheRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_700b50b08ed8dbaf, []int{3}
+func (m *RefreshPolicyInfoCacheRequest) Reset()         { *m = RefreshPolicyInfoCacheRequest{} }
+func (m *RefreshPolicyInfoCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshPolicyInfoCacheRequest) ProtoMessage()    {}
+func (*RefreshPolicyInfoCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{3}
+}
+
+func (m *RefreshPolicyInfoCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Unmarshal(m, b)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.Merge(m, src)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Size(m)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshPolicyInfoCacheRequest proto.InternalMessageInfo
+
+func (m *RefreshPolicyInfoCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshPolicyInfoCacheRequest) GetOpType() int32 {
+	if m != nil {
+		return m.OpType
+	}
+	return 0
+}
+
+func (m *RefreshPolicyInfoCacheRequest) GetOpKey() string {
+	if m != nil {
+		return m.OpKey
+	}
+	return ""
+}
+
+type CollectionRate struct {
+	Collection           int64              `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Rates                []*internalpb.Rate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CollectionRate) Reset()         { *m = CollectionRate{} }
+func (m *CollectionRate) String() string { return proto.CompactTextString(m) }
+func (*CollectionRate) ProtoMessage()    {}
+func (*CollectionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{4}
+}
+
+func (m *CollectionRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionRate.Unmarshal(m, b)
+}
+func (m *CollectionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionRate.Marshal(b, m, deterministic)
+}
+func (m *CollectionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRate.Merge(m, src)
+}
+func (m *CollectionRate) XXX_Size() int {
+	return xxx_messageInfo_CollectionRate.Size(m)
+}
+func (m *CollectionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionRate proto.InternalMessageInfo
+
+func (m *CollectionRate) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *CollectionRate) GetRates() []*internalpb.Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type PartitionRate struct {
+	Collection           int64              `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Partition            int64              `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Rates                []*internalpb.Rate `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PartitionRate) Reset()         { *m = PartitionRate{} }
+func (m *PartitionRate) String() string { return proto.CompactTextString(m) }
+func (*PartitionRate) ProtoMessage()    {}
+func (*PartitionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
+}
+
+func (m *PartitionRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionRate.Unmarshal(m, b)
+}
+func (m *PartitionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionRate.Marshal(b, m, deterministic)
+}
+func (m *PartitionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionRate.Merge(m, src)
+}
+func (m *PartitionRate) XXX_Size() int {
+	return xxx_messageInfo_PartitionRate.Size(m)
+}
+func (m *PartitionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionRate proto.InternalMessageInfo
+
+func (m *PartitionRate) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *PartitionRate) GetPartition() int64 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionRate) GetRates() []*internalpb.Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type SetRatesRequest struct {
+	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Rates                []*internalpb.Rate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	RatesPerCollection   []*CollectionRate  `protobuf:"bytes,3,rep,name=rates_per_collection,json=ratesPerCollection,proto3" json:"rates_per_collection,omitempty"`
+	RatesPerPartition    []*PartitionRate   `protobuf:"bytes,4,rep,name=rates_per_partition,json=ratesPerPartition,proto3" json:"rates_per_partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetRatesRequest) Reset()         { *m = SetRatesRequest{} }
+func (m *SetRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRatesRequest) ProtoMessage()    {}
+func (*SetRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+
+func (m *SetRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRatesRequest.Unmarshal(m, b)
+}
+func (m *SetRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRatesRequest.Merge(m, src)
+}
+func (m *SetRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRatesRequest.Size(m)
+}
+func (m *SetRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRatesRequest proto.InternalMessageInfo
+
+func (m *SetRatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetRatesRequest) GetRates() []*internalpb.Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *SetRatesRequest) GetRatesPerCollection() []*CollectionRate {
+	if m != nil {
+		return m.RatesPerCollection
+	}
+	return nil
+}
+
+func (m *SetRatesRequest) GetRatesPerPartition() []*PartitionRate {
+	if m != nil {
+		return m.RatesPerPartition
+	}
+	return nil
+}
+
+// UpsertRequest deletes the entities with the given primary keys and inserts
+// the new ones in the same request, both sharing one timestamp.
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
+}
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
+}
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
+}
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
+
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
+
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	CreatedTimestamp     []uint64         `protobuf:"varint,3,rep,packed,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbNames() []string {
+	if m != nil {
+		return m.DbNames
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetCreatedTimestamp() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return nil
+}
+
+// RenameCollectionRequest changes the name of a collection inside a database,
+// the collection id and its data are kept untouched.
+type RenameCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	OldName              string            `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string            `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{12}
+}
+
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
+}
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
+}
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
+}
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
+
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RenameCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+// ExportRequest writes the entities of a collection which are visible at a timestamp
+// into files on the object storage, one file(or one directory for numpy) per segment.
+// The files can be bulk inserted into a collection with the same schema.
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Path                 string            `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Format               string            `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	Timestamp            uint64            `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expr                 string            `protobuf:"bytes,8,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{13}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{14}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{15}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                ExportState      `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.proxy.ExportState" json:"state,omitempty"`
+	TaskId               int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CollectionName       string           `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RowCount             int64            `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Files                []string         `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	ExportedSegments     int64            `protobuf:"varint,8,opt,name=exported_segments,json=exportedSegments,proto3" json:"exported_segments,omitempty"`
+	TotalSegments        int64            `protobuf:"varint,9,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	CreateTs             int64            `protobuf:"varint,10,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	Reason               string           `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{16}
 }
 
-func (m *RefreshPolicyInfoCacheRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Unmarshal(m, b)
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
 }
-func (m *RefreshPolicyInfoCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Marshal(b, m, deterministic)
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
 }
-func (m *RefreshPolicyInfoCacheRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshPolicyInfoCacheRequest.Merge(m, src)
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
 }
-func (m *RefreshPolicyInfoCacheRequest) XXX_Size() int {
-	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Size(m)
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
 }
-func (m *RefreshPolicyInfoCacheRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshPolicyInfoCacheRequest.DiscardUnknown(m)
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshPolicyInfoCacheRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
 
-func (m *RefreshPolicyInfoCacheRequest) GetBase() *commonpb.MsgBase {
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *RefreshPolicyInfoCacheRequest) GetOpType() int32 {
+func (m *GetExportStateResponse) GetState() ExportState {
 	if m != nil {
-		return m.OpType
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *GetExportStateResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
 	}
 	return 0
 }
 
-func (m *RefreshPolicyInfoCacheRequest) GetOpKey() string {
+func (m *GetExportStateResponse) GetCollectionName() string {
 	if m != nil {
-		return m.OpKey
+		return m.CollectionName
 	}
 	return ""
 }
 
-type SetRatesRequest struct {
-	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Rates                []*internalpb.Rate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *GetExportStateResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *SetRatesRequest) Reset()         { *m = SetRatesRequest{} }
-func (m *SetRatesRequest) String() string { return proto.CompactTextString(m) }
-func (*SetRatesRequest) ProtoMessage()    {}
-func (*SetRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_700b50b08ed8dbaf, []int{4}
+func (m *GetExportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
 }
 
-func (m *SetRatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetRatesRequest.Unmarshal(m, b)
-}
-func (m *SetRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetRatesRequest.Marshal(b, m, deterministic)
-}
-func (m *SetRatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRatesRequest.Merge(m, src)
-}
-func (m *SetRatesRequest) XXX_Size() int {
-	return xxx_messageInfo_SetRatesRequest.Size(m)
+func (m *GetExportStateResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
 }
-func (m *SetRatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRatesRequest.DiscardUnknown(m)
+
+func (m *GetExportStateResponse) GetExportedSegments() int64 {
+	if m != nil {
+		return m.ExportedSegments
+	}
+	return 0
 }
 
-var xxx_messageInfo_SetRatesRequest proto.InternalMessageInfo
+func (m *GetExportStateResponse) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
 
-func (m *SetRatesRequest) GetBase() *commonpb.MsgBase {
+func (m *GetExportStateResponse) GetCreateTs() int64 {
 	if m != nil {
-		return m.Base
+		return m.CreateTs
 	}
-	return nil
+	return 0
 }
 
-func (m *SetRatesRequest) GetRates() []*internalpb.Rate {
+func (m *GetExportStateResponse) GetReason() string {
 	if m != nil {
-		return m.Rates
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.proxy.ExportState", ExportState_name, ExportState_value)
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
 	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
+	proto.RegisterType((*CollectionRate)(nil), "milvus.proto.proxy.CollectionRate")
+	proto.RegisterType((*PartitionRate)(nil), "milvus.proto.proxy.PartitionRate")
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.proxy.UpsertRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.proxy.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.proxy.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.proxy.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.proxy.ListDatabasesResponse")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.proxy.RenameCollectionRequest")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.proxy.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.proxy.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.proxy.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.proxy.GetExportStateResponse")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x6e, 0xda, 0x30,
-	0x18, 0x6d, 0xda, 0x42, 0xbb, 0x0f, 0x54, 0x24, 0xab, 0x63, 0x2c, 0x5d, 0x27, 0x94, 0x4e, 0x2b,
-	0xaa, 0x34, 0x58, 0xd9, 0x9e, 0xa0, 0x54, 0x42, 0x68, 0xa2, 0xaa, 0xc2, 0x76, 0xb3, 0x9b, 0xc9,
-	0x49, 0xbe, 0x82, 0x51, 0x62, 0xa7, 0xb1, 0x61, 0xe3, 0x6a, 0xd2, 0xde, 0x68, 0x77, 0x7b, 0xbc,
-	0x29, 0x3f, 0x04, 0xc2, 0x42, 0xa3, 0xad, 0xda, 0x1d, 0xc7, 0x3e, 0xf6, 0x39, 0x27, 0xfe, 0x0e,
-	0x50, 0xf1, 0x03, 0xf1, 0x6d, 0xd1, 0xf6, 0x03, 0xa1, 0x04, 0x21, 0x1e, 0x73, 0xe7, 0x33, 0x19,
-	0xa3, 0x76, 0xb4, 0xa3, 0x57, 0x6d, 0xe1, 0x79, 0x82, 0xc7, 0x6b, 0xfa, 0x11, 0xe3, 0x0a, 0x03,
-	0x4e, 0xdd, 0x04, 0x57, 0xd7, 0x4f, 0x18, 0xbf, 0x34, 0x78, 0x39, 0xe0, 0x73, 0xea, 0x32, 0x87,
-	0x2a, 0xec, 0x09, 0xd7, 0x1d, 0xa2, 0xa2, 0x3d, 0x6a, 0x4f, 0xd0, 0xc4, 0xfb, 0x19, 0x4a, 0x45,
-	0xde, 0xc2, 0xbe, 0x45, 0x25, 0x36, 0xb4, 0xa6, 0xd6, 0xaa, 0x74, 0x5f, 0xb4, 0x33, 0x8a, 0x89,
-	0xd4, 0x50, 0x8e, 0xaf, 0xa8, 0x44, 0x33, 0x62, 0x92, 0x67, 0x70, 0xe0, 0x58, 0x5f, 0x38, 0xf5,
-	0xb0, 0xb1, 0xdb, 0xd4, 0x5a, 0x4f, 0xcc, 0xb2, 0x63, 0xdd, 0x50, 0x0f, 0xc9, 0x39, 0xd4, 0x6c,
-	0xe1, 0xba, 0x68, 0x2b, 0x26, 0x78, 0x4c, 0xd8, 0x8b, 0x08, 0x47, 0xab, 0xe5, 0x88, 0x68, 0x40,
-	0x75, 0xb5, 0x32, 0xb8, 0x6e, 0xec, 0x37, 0xb5, 0xd6, 0x9e, 0x99, 0x59, 0x33, 0xa6, 0xa0, 0xaf,
-	0x39, 0x0f, 0xd0, 0x79, 0xa4, 0x6b, 0x1d, 0x0e, 0x67, 0x32, 0xfc, 0x52, 0xa9, 0xed, 0x14, 0x1b,
-	0x3f, 0x34, 0xa8, 0x7f, 0xf2, 0xff, 0xbf, 0x50, 0xb8, 0xe7, 0x53, 0x29, 0xbf, 0x8a, 0xc0, 0x49,
-	0x3e, 0x4d, 0x8a, 0x8d, 0xef, 0x70, 0x6a, 0xe2, 0x5d, 0x80, 0x72, 0x72, 0x2b, 0x5c, 0x66, 0x2f,
-	0x06, 0xfc, 0x4e, 0x3c, 0xd2, 0x4a, 0x1d, 0xca, 0xc2, 0xff, 0xb8, 0xf0, 0x63, 0x23, 0x25, 0x33,
-	0x41, 0xe4, 0x18, 0x4a, 0xc2, 0xff, 0x80, 0x8b, 0xc4, 0x43, 0x0c, 0x8c, 0x39, 0xd4, 0x46, 0xa8,
-	0x4c, 0xaa, 0x50, 0xfe, 0xbb, 0xe4, 0x25, 0x94, 0x82, 0xf0, 0x86, 0xc6, 0x6e, 0x73, 0xaf, 0x55,
-	0xe9, 0x9e, 0x64, 0x8f, 0xa4, 0xc3, 0x1a, 0xaa, 0x98, 0x31, 0xb3, 0xfb, 0xf3, 0x00, 0x4a, 0xb7,
-	0xe1, 0x68, 0x13, 0x17, 0x48, 0x1f, 0x55, 0x4f, 0x78, 0xbe, 0xe0, 0xc8, 0xd5, 0x48, 0x85, 0xfb,
-	0xa4, 0x9d, 0xbd, 0x23, 0x01, 0x7f, 0x12, 0x13, 0xd3, 0xfa, 0xab, 0x5c, 0xfe, 0x06, 0xd9, 0xd8,
-	0x21, 0xf7, 0x70, 0xdc, 0xc7, 0x08, 0x32, 0xa9, 0x98, 0x2d, 0x7b, 0x13, 0xca, 0x39, 0xba, 0xa4,
-	0xbb, 0xc5, 0x73, 0x1e, 0x79, 0xa9, 0x79, 0x96, 0xab, 0x39, 0x52, 0x01, 0xe3, 0x63, 0x13, 0xa5,
-	0x2f, 0xb8, 0x44, 0x63, 0x87, 0x04, 0x70, 0x9a, 0xad, 0x63, 0x3c, 0xee, 0x69, 0x29, 0x37, 0xb5,
-	0xe3, 0xff, 0x82, 0x87, 0x1b, 0xac, 0x9f, 0xe4, 0x3e, 0x4b, 0x68, 0x75, 0x16, 0xc6, 0xa4, 0x50,
-	0xed, 0xa3, 0xba, 0x76, 0x96, 0xf1, 0x2e, 0xb6, 0xc7, 0x4b, 0x49, 0x7f, 0x19, 0x6b, 0x0a, 0xcf,
-	0xb3, 0x5d, 0x45, 0xae, 0x18, 0x75, 0xe3, 0x48, 0xed, 0x82, 0x48, 0x1b, 0x8d, 0x2b, 0x8a, 0x63,
-	0xc1, 0xd3, 0x55, 0x55, 0xd7, 0x75, 0x2e, 0xf2, 0x74, 0xf2, 0x5b, 0x5d, 0xa4, 0x31, 0x85, 0x7a,
-	0x7e, 0x15, 0xc9, 0x65, 0x9e, 0xc8, 0x83, 0xb5, 0x2d, 0xd2, 0x72, 0xa0, 0xd6, 0x47, 0x15, 0xcd,
-	0xff, 0x10, 0x55, 0xc0, 0x6c, 0x49, 0x5e, 0x6f, 0x1b, 0xf8, 0x84, 0xb0, 0xbc, 0xf9, 0xbc, 0x90,
-	0x97, 0xbe, 0xd0, 0x0d, 0x1c, 0x2e, 0xbb, 0x4d, 0xce, 0xf2, 0x32, 0x6c, 0x34, 0xbf, 0xc0, 0xf5,
-	0xd5, 0xfb, 0xcf, 0xdd, 0x31, 0x53, 0x93, 0x99, 0x15, 0xee, 0x74, 0x62, 0xea, 0x1b, 0x26, 0x92,
-	0x5f, 0x9d, 0xe5, 0x50, 0x75, 0xa2, 0xd3, 0x9d, 0x48, 0xc2, 0xb7, 0xac, 0x72, 0x04, 0xdf, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xbe, 0x61, 0x31, 0xe4, 0x06, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x77, 0xd3, 0x46,
+	0x10, 0x8f, 0x23, 0xff, 0x9d, 0xc4, 0x8e, 0x59, 0x92, 0x20, 0x1c, 0xa0, 0x41, 0xb4, 0x8d, 0x09,
+	0xaf, 0x4e, 0x31, 0xed, 0x85, 0x03, 0x7d, 0x0f, 0x07, 0xf2, 0xf2, 0x68, 0x78, 0x41, 0x49, 0x7a,
+	0xe0, 0x80, 0xdf, 0xda, 0xda, 0xc4, 0x02, 0x59, 0x2b, 0xb4, 0x6b, 0x9c, 0x9c, 0xda, 0xd7, 0xaf,
+	0xd0, 0xde, 0x4b, 0xef, 0xbd, 0xf4, 0xd6, 0xcf, 0xc0, 0xa7, 0xea, 0xdb, 0x5d, 0xc9, 0x96, 0xcc,
+	0x26, 0x0e, 0x04, 0x1e, 0x37, 0xcd, 0xec, 0x6f, 0xe7, 0x37, 0xb3, 0x33, 0xb3, 0x9a, 0x85, 0xb9,
+	0x20, 0xa4, 0xc7, 0x27, 0x8d, 0x20, 0xa4, 0x9c, 0x22, 0xd4, 0x77, 0xbd, 0x37, 0x03, 0xa6, 0xa4,
+	0x86, 0x5c, 0xa9, 0xcd, 0x77, 0x69, 0xbf, 0x4f, 0x7d, 0xa5, 0xab, 0x55, 0x5c, 0x9f, 0x93, 0xd0,
+	0xc7, 0x5e, 0x24, 0xcf, 0x27, 0x77, 0xd4, 0xe6, 0x59, 0xb7, 0x47, 0xfa, 0x58, 0x49, 0xd6, 0x7f,
+	0x19, 0xb8, 0xb1, 0xed, 0xbf, 0xc1, 0x9e, 0xeb, 0x60, 0x4e, 0x5a, 0xd4, 0xf3, 0x76, 0x08, 0xc7,
+	0x2d, 0xdc, 0xed, 0x11, 0x9b, 0xbc, 0x1e, 0x10, 0xc6, 0xd1, 0xf7, 0x90, 0xed, 0x60, 0x46, 0xcc,
+	0xcc, 0x6a, 0xa6, 0x3e, 0xd7, 0xbc, 0xd6, 0x48, 0xf1, 0x47, 0xc4, 0x3b, 0xec, 0xe8, 0x21, 0x66,
+	0xc4, 0x96, 0x48, 0x74, 0x05, 0x0a, 0x4e, 0xa7, 0xed, 0xe3, 0x3e, 0x31, 0x67, 0x57, 0x33, 0xf5,
+	0x92, 0x9d, 0x77, 0x3a, 0x4f, 0x71, 0x9f, 0xa0, 0x35, 0x58, 0xe8, 0x52, 0xcf, 0x23, 0x5d, 0xee,
+	0x52, 0x5f, 0x01, 0x0c, 0x09, 0xa8, 0x8c, 0xd5, 0x12, 0x68, 0xc1, 0xfc, 0x58, 0xb3, 0xbd, 0x69,
+	0x66, 0x57, 0x33, 0x75, 0xc3, 0x4e, 0xe9, 0xac, 0x97, 0x50, 0x4b, 0x78, 0x1e, 0x12, 0xe7, 0x82,
+	0x5e, 0xd7, 0xa0, 0x38, 0x60, 0x24, 0x4c, 0xb8, 0x3d, 0x92, 0xad, 0xdf, 0x33, 0xb0, 0x7c, 0x10,
+	0x7c, 0x7e, 0x22, 0xb1, 0x16, 0x60, 0xc6, 0x86, 0x34, 0x74, 0xa2, 0xa3, 0x19, 0xc9, 0xd6, 0xaf,
+	0x70, 0xdd, 0x26, 0x87, 0x21, 0x61, 0xbd, 0x5d, 0xea, 0xb9, 0xdd, 0x93, 0x6d, 0xff, 0x90, 0x5e,
+	0xd0, 0x95, 0x65, 0xc8, 0xd3, 0x60, 0xff, 0x24, 0x50, 0x8e, 0xe4, 0xec, 0x48, 0x42, 0x8b, 0x90,
+	0xa3, 0xc1, 0x13, 0x72, 0x12, 0xf9, 0xa0, 0x04, 0xab, 0x0b, 0x95, 0xd6, 0x28, 0x03, 0x36, 0xe6,
+	0x04, 0xdd, 0x00, 0x18, 0xe7, 0x44, 0xf2, 0x1a, 0x76, 0x42, 0x83, 0xee, 0x42, 0x2e, 0xc4, 0x9c,
+	0x30, 0x73, 0x76, 0xd5, 0xa8, 0xcf, 0x35, 0x57, 0xd2, 0x2e, 0x8d, 0xea, 0x54, 0xd8, 0xb2, 0x15,
+	0xd2, 0xfa, 0x2d, 0x03, 0xe5, 0x5d, 0x1c, 0x72, 0xf7, 0xdc, 0x24, 0xd7, 0xa0, 0x14, 0xc4, 0x1b,
+	0x64, 0x1c, 0x86, 0x3d, 0x56, 0x8c, 0x5d, 0x30, 0xce, 0xed, 0xc2, 0x5f, 0xb3, 0xb0, 0xb0, 0x47,
+	0xb8, 0x50, 0xb1, 0x8f, 0x3f, 0xdb, 0x0f, 0x8f, 0x1d, 0xed, 0xc3, 0xa2, 0xfc, 0x68, 0x07, 0x24,
+	0x6c, 0x27, 0x62, 0x56, 0xae, 0x5b, 0x8d, 0xf7, 0x5b, 0xbf, 0x91, 0x4e, 0x88, 0x8d, 0xe4, 0xfe,
+	0x5d, 0x12, 0x8e, 0xf5, 0xe8, 0x19, 0x5c, 0x1e, 0x5b, 0x1d, 0x9f, 0x54, 0x56, 0x1a, 0xbd, 0xa9,
+	0x33, 0x9a, 0x3a, 0x7f, 0xfb, 0x52, 0x6c, 0x73, 0xa4, 0xb6, 0xfe, 0x99, 0x85, 0xf2, 0x41, 0xc0,
+	0x48, 0xc8, 0xbf, 0xe4, 0x2d, 0xf1, 0x0d, 0x54, 0x46, 0xe1, 0x28, 0x5c, 0x56, 0xe2, 0xca, 0x23,
+	0xad, 0x84, 0xfd, 0x04, 0x73, 0x87, 0x2e, 0xf1, 0x1c, 0xd6, 0x76, 0x30, 0xc7, 0x66, 0x4e, 0xc6,
+	0x7d, 0x23, 0xed, 0x61, 0x74, 0x29, 0x3e, 0x16, 0xb8, 0x4d, 0xcc, 0xb1, 0x0d, 0x6a, 0x8b, 0xf8,
+	0x46, 0x2b, 0x50, 0xea, 0x61, 0xd6, 0x6b, 0xbf, 0x22, 0x27, 0xcc, 0xcc, 0xaf, 0x1a, 0xf5, 0xb2,
+	0x5d, 0x14, 0x8a, 0x27, 0xe4, 0x84, 0xa1, 0xab, 0x50, 0xf4, 0x07, 0xfd, 0x76, 0x48, 0x87, 0xcc,
+	0x2c, 0xac, 0x66, 0xea, 0x65, 0xbb, 0xe0, 0x0f, 0xfa, 0x36, 0x1d, 0xb2, 0xfb, 0x85, 0x77, 0x0f,
+	0xb2, 0xd5, 0xa2, 0x69, 0x58, 0x2e, 0x2c, 0xb5, 0x42, 0x82, 0x39, 0x11, 0xe6, 0x44, 0xf0, 0x9f,
+	0xfe, 0xd4, 0xee, 0xe7, 0xde, 0x3d, 0x98, 0x2d, 0x66, 0xac, 0x23, 0xb8, 0xbc, 0x19, 0xd2, 0xe0,
+	0xf3, 0x13, 0x3d, 0x83, 0xc5, 0x9f, 0x5d, 0xc6, 0x63, 0xa2, 0x8f, 0x6f, 0x14, 0x79, 0x4c, 0xc5,
+	0x4c, 0x35, 0x6b, 0xfd, 0x99, 0x81, 0xa5, 0x09, 0x9b, 0x2c, 0xa0, 0x3e, 0x23, 0xe8, 0x1e, 0xe4,
+	0x19, 0xc7, 0x7c, 0xc0, 0x22, 0xb3, 0x2b, 0x5a, 0xb3, 0x7b, 0x12, 0x62, 0x47, 0x50, 0x91, 0x99,
+	0x28, 0x02, 0xd5, 0x83, 0x25, 0xbb, 0xa0, 0x42, 0x60, 0xe8, 0x0e, 0x5c, 0xea, 0xca, 0x84, 0x38,
+	0x6d, 0xee, 0xf6, 0x09, 0xe3, 0xb8, 0x1f, 0xc8, 0x2e, 0xcb, 0xda, 0xd5, 0x68, 0x61, 0x3f, 0xd6,
+	0x5b, 0x7f, 0x67, 0xe0, 0x8a, 0x4d, 0x84, 0x9d, 0x44, 0xb3, 0x7d, 0xfa, 0xb2, 0xbf, 0x0a, 0x45,
+	0xea, 0x39, 0xc9, 0x7a, 0x2f, 0x50, 0xcf, 0x89, 0x97, 0x7c, 0x32, 0x4c, 0x96, 0x78, 0xc1, 0x27,
+	0xc3, 0x64, 0x36, 0xfe, 0x98, 0x85, 0xf2, 0xa3, 0xe3, 0x80, 0x7e, 0xd9, 0x86, 0x5c, 0x83, 0x85,
+	0x74, 0x43, 0x32, 0x79, 0xcb, 0x94, 0xec, 0x4a, 0xaa, 0x23, 0x19, 0x42, 0x90, 0x0d, 0x30, 0xef,
+	0x99, 0x39, 0x69, 0x46, 0x7e, 0x8b, 0x7f, 0xd1, 0x21, 0x0d, 0xfb, 0x98, 0x9b, 0x79, 0xc5, 0xae,
+	0x24, 0x71, 0xbd, 0x8f, 0x73, 0x24, 0x3a, 0x2c, 0x6b, 0x8f, 0x15, 0xc2, 0x12, 0x39, 0x0e, 0x42,
+	0xb3, 0xa8, 0x2c, 0x89, 0x6f, 0xd5, 0x77, 0x55, 0xd3, 0xb0, 0x5e, 0x40, 0x25, 0x3e, 0x94, 0x8b,
+	0x14, 0xd2, 0x15, 0x28, 0x70, 0xcc, 0x5e, 0xb5, 0x5d, 0x27, 0xfa, 0xbd, 0xe4, 0x85, 0xb8, 0xed,
+	0x58, 0x1d, 0x58, 0xda, 0x22, 0x5c, 0x51, 0x88, 0x3d, 0x17, 0x6b, 0x37, 0x3d, 0xc7, 0x5b, 0x03,
+	0x96, 0x27, 0x49, 0x2e, 0x12, 0xcc, 0x8f, 0x90, 0x13, 0x5f, 0x2a, 0xc7, 0x95, 0xe6, 0x57, 0xba,
+	0xfb, 0x3f, 0x49, 0xa6, 0xd0, 0x49, 0xff, 0x8c, 0xa4, 0x7f, 0xba, 0xe2, 0xc8, 0x6a, 0x8b, 0x23,
+	0x95, 0xc7, 0xdc, 0x64, 0x1e, 0x57, 0xa0, 0x14, 0xd2, 0x61, 0xbb, 0x4b, 0x07, 0xbe, 0x2a, 0x00,
+	0xc3, 0x2e, 0x86, 0x74, 0xd8, 0x12, 0xb2, 0x18, 0x47, 0x0e, 0x5d, 0x8f, 0x88, 0x0b, 0x56, 0x54,
+	0x93, 0x12, 0x44, 0x13, 0x13, 0xe9, 0x28, 0x71, 0xda, 0x8c, 0x1c, 0xf5, 0x89, 0xcf, 0x99, 0xac,
+	0x03, 0xc3, 0xae, 0xc6, 0x0b, 0x7b, 0x91, 0x5e, 0xfc, 0x2b, 0x38, 0xe5, 0xd8, 0x1b, 0x23, 0x4b,
+	0x12, 0x59, 0x96, 0xda, 0x11, 0x6c, 0x05, 0x4a, 0xaa, 0xff, 0xdb, 0x9c, 0x99, 0xa0, 0xdc, 0x50,
+	0x8a, 0x7d, 0x26, 0x2a, 0x34, 0x24, 0x98, 0x51, 0xdf, 0x9c, 0x53, 0x15, 0xaa, 0xa4, 0xf5, 0xe7,
+	0x30, 0x97, 0x38, 0x31, 0x74, 0x29, 0x6e, 0xc5, 0x5d, 0xe2, 0x3b, 0xae, 0x7f, 0x54, 0x9d, 0x41,
+	0x55, 0x98, 0x57, 0xaa, 0xc7, 0xd8, 0xf5, 0x88, 0x53, 0xcd, 0x8c, 0x41, 0x7b, 0x1c, 0x0b, 0x47,
+	0xab, 0xb3, 0xe8, 0x32, 0x2c, 0x28, 0x55, 0x8b, 0xf6, 0x03, 0x8f, 0x08, 0xa5, 0xd1, 0xfc, 0xb7,
+	0x00, 0xb9, 0x5d, 0x91, 0x14, 0xe4, 0x01, 0xda, 0x22, 0x72, 0x8d, 0xfa, 0xc4, 0x57, 0x5c, 0x0c,
+	0x35, 0xd2, 0xf9, 0x8b, 0x84, 0xf7, 0x81, 0x51, 0x65, 0xd6, 0xbe, 0xd6, 0xe2, 0x27, 0xc0, 0xd6,
+	0x0c, 0x7a, 0x0d, 0x8b, 0x5b, 0x44, 0x8a, 0x2e, 0xe3, 0x6e, 0x97, 0xb5, 0x7a, 0xd8, 0xf7, 0x89,
+	0x87, 0x9a, 0xa7, 0x8c, 0x31, 0x3a, 0x70, 0xcc, 0x79, 0x4b, 0xcb, 0xb9, 0xc7, 0x43, 0xd7, 0x3f,
+	0x8a, 0x8b, 0xd9, 0x9a, 0x41, 0x21, 0x5c, 0x4f, 0x3f, 0x45, 0x54, 0xf1, 0x8c, 0x1e, 0x24, 0xa8,
+	0xa9, 0xab, 0xd5, 0xb3, 0x5f, 0x2f, 0xb5, 0xb3, 0x7a, 0xc2, 0x9a, 0x41, 0x18, 0xe6, 0xb7, 0x08,
+	0xdf, 0x74, 0xe2, 0xf0, 0xd6, 0x4f, 0x0f, 0x6f, 0x04, 0xfa, 0xc0, 0xb0, 0x5e, 0xc2, 0xd5, 0xf4,
+	0x3b, 0x85, 0xf8, 0xdc, 0xc5, 0x9e, 0x0a, 0xa9, 0x31, 0x25, 0xa4, 0x89, 0xd7, 0xc6, 0xb4, 0x70,
+	0x3a, 0xb0, 0x74, 0x10, 0xe8, 0x78, 0xd6, 0x75, 0x3c, 0x07, 0xc1, 0xc7, 0x70, 0xbc, 0x84, 0x65,
+	0xfd, 0x33, 0x04, 0xdd, 0xd5, 0x91, 0x9c, 0xf9, 0x64, 0x99, 0xc6, 0xe5, 0xc0, 0xc2, 0x16, 0xe1,
+	0xb2, 0xfe, 0x77, 0x08, 0x0f, 0xdd, 0x2e, 0x43, 0xdf, 0x9e, 0x56, 0xf0, 0x11, 0x20, 0xb6, 0xbc,
+	0x36, 0x15, 0x37, 0xca, 0xd0, 0x53, 0x28, 0xc6, 0xe3, 0x3e, 0xba, 0xa5, 0x8b, 0x61, 0xe2, 0x31,
+	0x30, 0xc5, 0xeb, 0xe6, 0xdb, 0x1c, 0x54, 0x77, 0x24, 0xe0, 0xd1, 0x31, 0xdf, 0x23, 0xe1, 0x1b,
+	0xb7, 0x4b, 0x90, 0x0d, 0x79, 0x35, 0x31, 0xa3, 0x9b, 0xfa, 0x5c, 0x24, 0xa6, 0xe9, 0x53, 0x4a,
+	0x6b, 0x67, 0xc0, 0xb1, 0x1a, 0x3e, 0xd8, 0xc0, 0xe3, 0xd6, 0x0c, 0x7a, 0x0e, 0x95, 0xf4, 0x5c,
+	0x89, 0x6e, 0x6b, 0xdf, 0x08, 0xba, 0xd9, 0x73, 0xda, 0xd1, 0xff, 0x02, 0xf3, 0xc9, 0x41, 0x12,
+	0xad, 0xe9, 0x2c, 0x6b, 0x46, 0xcd, 0x69, 0x76, 0x0f, 0xa1, 0x9c, 0x9a, 0xf1, 0x50, 0x5d, 0x67,
+	0x58, 0x37, 0x5a, 0xd6, 0x6e, 0x9f, 0x03, 0x39, 0x4a, 0xea, 0x0b, 0xa8, 0x4e, 0x0e, 0x6d, 0xe8,
+	0x8e, 0xbe, 0x40, 0xb5, 0xa3, 0xdd, 0xb4, 0x38, 0x9e, 0x41, 0x5e, 0xdd, 0xd6, 0xfa, 0x7c, 0xa6,
+	0x86, 0xb1, 0x9a, 0x75, 0x16, 0x64, 0xe4, 0xb2, 0x0b, 0x95, 0xf4, 0x9f, 0x5e, 0x9f, 0x4e, 0xed,
+	0xc8, 0x51, 0x5b, 0x3f, 0x0f, 0x34, 0xa6, 0x7a, 0xf8, 0xc3, 0xf3, 0xe6, 0x91, 0xcb, 0x7b, 0x83,
+	0x8e, 0x88, 0x6b, 0x43, 0xed, 0xfc, 0xce, 0xa5, 0xd1, 0xd7, 0x46, 0x7c, 0xef, 0x6d, 0x48, 0x63,
+	0x1b, 0xd2, 0x58, 0xd0, 0xe9, 0xe4, 0xa5, 0x78, 0xef, 0xff, 0x01, 0x00, 0x1a, 0x2f, 0x4e, 0xd8,
+	0x91, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -715,3 +1581,291 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
+
+// MilvusExtServiceClient is the client API for MilvusExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusExtServiceClient interface {
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*milvuspb.MutationResult, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+}
+
+type milvusExtServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusExtServiceClient(cc *grpc.ClientConn) MilvusExtServiceClient {
+	return &milvusExtServiceClient{cc}
+}
+
+func (c *milvusExtServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*milvuspb.MutationResult, error) {
+	out := new(milvuspb.MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusExtServiceServer is the server API for MilvusExtService service.
+type MilvusExtServiceServer interface {
+	Upsert(context.Context, *UpsertRequest) (*milvuspb.MutationResult, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+}
+
+// UnimplementedMilvusExtServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMilvusExtServiceServer struct {
+}
+
+func (*UnimplementedMilvusExtServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+
+func RegisterMilvusExtServiceServer(s *grpc.Server, srv MilvusExtServiceServer) {
+	s.RegisterService(&_MilvusExtService_serviceDesc, srv)
+}
+
+func _MilvusExtService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MilvusExtService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.MilvusExtService",
+	HandlerType: (*MilvusExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusExtService_Upsert_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusExtService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusExtService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusExtService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _MilvusExtService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _MilvusExtService_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _MilvusExtService_GetExportState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
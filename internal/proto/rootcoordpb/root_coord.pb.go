@@ -328,6 +328,238 @@ func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
 	return nil
 }
 
+// ExportTaskInfo is the persisted state of an export task
+type ExportTaskInfo struct {
+	Id                   int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId         int64               `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CollectionName       string              `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionIds         []int64             `protobuf:"varint,4,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	Path                 string              `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Format               string              `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	Timestamp            uint64              `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	State                proxypb.ExportState `protobuf:"varint,8,opt,name=state,proto3,enum=milvus.proto.proxy.ExportState" json:"state,omitempty"`
+	Segments             []int64             `protobuf:"varint,9,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	ExportedSegments     []int64             `protobuf:"varint,10,rep,packed,name=exported_segments,json=exportedSegments,proto3" json:"exported_segments,omitempty"`
+	Files                []string            `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64               `protobuf:"varint,12,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	CreateTs             int64               `protobuf:"varint,13,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	Reason               string              `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	Expr                 string              `protobuf:"bytes,15,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetState() proxypb.ExportState {
+	if m != nil {
+		return m.State
+	}
+	return proxypb.ExportState_ExportPending
+}
+
+func (m *ExportTaskInfo) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetExportedSegments() []int64 {
+	if m != nil {
+		return m.ExportedSegments
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+// ExportSegmentResult is reported by the DataNode which exports a segment of an export task
+type ExportSegmentResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	SegmentId            int64            `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	DatanodeId           int64            `protobuf:"varint,4,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	Files                []string         `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64            `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportSegmentResult) Reset()         { *m = ExportSegmentResult{} }
+func (m *ExportSegmentResult) String() string { return proto.CompactTextString(m) }
+func (*ExportSegmentResult) ProtoMessage()    {}
+func (*ExportSegmentResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{6}
+}
+
+func (m *ExportSegmentResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSegmentResult.Unmarshal(m, b)
+}
+func (m *ExportSegmentResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSegmentResult.Marshal(b, m, deterministic)
+}
+func (m *ExportSegmentResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSegmentResult.Merge(m, src)
+}
+func (m *ExportSegmentResult) XXX_Size() int {
+	return xxx_messageInfo_ExportSegmentResult.Size(m)
+}
+func (m *ExportSegmentResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSegmentResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSegmentResult proto.InternalMessageInfo
+
+func (m *ExportSegmentResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportSegmentResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportSegmentResult) GetSegmentId() int64 {
+	if m != nil {
+		return m.SegmentId
+	}
+	return 0
+}
+
+func (m *ExportSegmentResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ExportSegmentResult) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportSegmentResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
 type DescribeSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -341,7 +573,7 @@ func (m *DescribeSegmentsRequest) Reset()         { *m = DescribeSegmentsRequest
 func (m *DescribeSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsRequest) ProtoMessage()    {}
 func (*DescribeSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{5}
+	return fileDescriptor_4513485a144f6b06, []int{7}
 }
 
 func (m *DescribeSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -396,7 +628,7 @@ func (m *SegmentBaseInfo) Reset()         { *m = SegmentBaseInfo{} }
 func (m *SegmentBaseInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentBaseInfo) ProtoMessage()    {}
 func (*SegmentBaseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{6}
+	return fileDescriptor_4513485a144f6b06, []int{8}
 }
 
 func (m *SegmentBaseInfo) XXX_Unmarshal(b []byte) error {
@@ -451,7 +683,7 @@ func (m *SegmentInfos) Reset()         { *m = SegmentInfos{} }
 func (m *SegmentInfos) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfos) ProtoMessage()    {}
 func (*SegmentInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{7}
+	return fileDescriptor_4513485a144f6b06, []int{9}
 }
 
 func (m *SegmentInfos) XXX_Unmarshal(b []byte) error {
@@ -506,7 +738,7 @@ func (m *DescribeSegmentsResponse) Reset()         { *m = DescribeSegmentsRespon
 func (m *DescribeSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsResponse) ProtoMessage()    {}
 func (*DescribeSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{8}
+	return fileDescriptor_4513485a144f6b06, []int{10}
 }
 
 func (m *DescribeSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -562,7 +794,7 @@ func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
 func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialRequest) ProtoMessage()    {}
 func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{9}
+	return fileDescriptor_4513485a144f6b06, []int{11}
 }
 
 func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -613,7 +845,7 @@ func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
 func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialResponse) ProtoMessage()    {}
 func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{10}
+	return fileDescriptor_4513485a144f6b06, []int{12}
 }
 
 func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
@@ -661,6 +893,8 @@ func init() {
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
 	proto.RegisterType((*ImportResult)(nil), "milvus.proto.rootcoord.ImportResult")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.rootcoord.ExportTaskInfo")
+	proto.RegisterType((*ExportSegmentResult)(nil), "milvus.proto.rootcoord.ExportSegmentResult")
 	proto.RegisterType((*DescribeSegmentsRequest)(nil), "milvus.proto.rootcoord.DescribeSegmentsRequest")
 	proto.RegisterType((*SegmentBaseInfo)(nil), "milvus.proto.rootcoord.SegmentBaseInfo")
 	proto.RegisterType((*SegmentInfos)(nil), "milvus.proto.rootcoord.SegmentInfos")
@@ -674,103 +908,124 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6b, 0x93, 0xda, 0x36,
-	0x17, 0x0e, 0x90, 0xbd, 0x70, 0x60, 0x61, 0xa3, 0xc9, 0x85, 0x97, 0xe4, 0x7d, 0x43, 0x48, 0xde,
-	0x86, 0xdc, 0xd8, 0x74, 0x33, 0x93, 0xa6, 0xf9, 0x96, 0x85, 0xcc, 0x86, 0x69, 0x77, 0xb2, 0x35,
-	0x49, 0x27, 0xbd, 0xec, 0x50, 0x61, 0x2b, 0xe0, 0x59, 0x63, 0x11, 0x49, 0xec, 0x65, 0xfa, 0xa9,
-	0x33, 0xfd, 0xde, 0xff, 0xd4, 0xfe, 0x94, 0xfe, 0x90, 0x76, 0x64, 0xd9, 0xc2, 0x36, 0x36, 0xeb,
-	0x4d, 0xf2, 0x0d, 0x49, 0x8f, 0x9f, 0xe7, 0xe8, 0x1c, 0x9d, 0x73, 0x24, 0x60, 0x93, 0x51, 0x2a,
-	0x06, 0x26, 0xa5, 0xcc, 0x6a, 0x4f, 0x19, 0x15, 0x14, 0x5d, 0x9d, 0xd8, 0xce, 0xd1, 0x8c, 0xab,
-	0x51, 0x5b, 0x2e, 0x7b, 0xab, 0xf5, 0xb2, 0x49, 0x27, 0x13, 0xea, 0xaa, 0xf9, 0x7a, 0x39, 0x8c,
-	0xaa, 0x57, 0x6c, 0x57, 0x10, 0xe6, 0x62, 0xc7, 0x1f, 0x97, 0xa6, 0x8c, 0x9e, 0x9c, 0xfa, 0x83,
-	0x2a, 0x11, 0xa6, 0x35, 0x98, 0x10, 0x81, 0xd5, 0x44, 0x73, 0x00, 0x57, 0x5e, 0x38, 0x0e, 0x35,
-	0xdf, 0xd8, 0x13, 0xc2, 0x05, 0x9e, 0x4c, 0x0d, 0xf2, 0x61, 0x46, 0xb8, 0x40, 0x8f, 0xe1, 0xe2,
-	0x10, 0x73, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x5f, 0x7e, 0x8f,
-	0x8f, 0x76, 0x30, 0x27, 0x86, 0x87, 0x44, 0x97, 0x61, 0xc5, 0xa4, 0x33, 0x57, 0xd4, 0x0a, 0x8d,
-	0x5c, 0x6b, 0xc3, 0x50, 0x83, 0xe6, 0x6f, 0x39, 0xb8, 0x1a, 0x57, 0xe0, 0x53, 0xea, 0x72, 0x82,
-	0x9e, 0xc0, 0x2a, 0x17, 0x58, 0xcc, 0xb8, 0x2f, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x41, 0x0c, 0x1f,
-	0x8a, 0x6e, 0x40, 0x51, 0x04, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0x68, 0xcc, 0x27, 0x52, 0x6c,
-	0x78, 0x07, 0x15, 0xcf, 0x84, 0x5e, 0xf7, 0x33, 0xec, 0x2e, 0x1f, 0x66, 0x76, 0xa0, 0xaa, 0x99,
-	0x3f, 0x65, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x1e, 0x75, 0xc1, 0xc8, 0xf7, 0xba, 0x29, 0xfb, 0xf8,
-	0x33, 0x0f, 0xe5, 0xde, 0x64, 0x4a, 0x99, 0x30, 0x08, 0x9f, 0x39, 0xe2, 0xe3, 0xb4, 0xae, 0xc1,
-	0x9a, 0xc0, 0xfc, 0x70, 0x60, 0x5b, 0xbe, 0xe0, 0xaa, 0x1c, 0xf6, 0x2c, 0x74, 0x13, 0x4a, 0x16,
-	0x16, 0xd8, 0xa5, 0x16, 0x91, 0x8b, 0x05, 0x6f, 0x11, 0x82, 0xa9, 0x9e, 0x85, 0x9e, 0xc2, 0x8a,
-	0xe4, 0x20, 0xb5, 0x8b, 0x8d, 0x5c, 0xab, 0xb2, 0xdd, 0x48, 0x54, 0x53, 0x06, 0x4a, 0x4d, 0x62,
-	0x28, 0x38, 0xaa, 0xc3, 0x3a, 0x27, 0xa3, 0x09, 0x71, 0x05, 0xaf, 0xad, 0x34, 0x0a, 0xad, 0x82,
-	0xa1, 0xc7, 0xe8, 0x3f, 0xb0, 0x8e, 0x67, 0x82, 0x0e, 0x6c, 0x8b, 0xd7, 0x56, 0xbd, 0xb5, 0x35,
-	0x39, 0xee, 0x59, 0x1c, 0x5d, 0x87, 0x22, 0xa3, 0xc7, 0x03, 0xe5, 0x88, 0x35, 0xcf, 0x9a, 0x75,
-	0x46, 0x8f, 0x3b, 0x72, 0x8c, 0xbe, 0x82, 0x15, 0xdb, 0x7d, 0x4f, 0x79, 0x6d, 0xbd, 0x51, 0x68,
-	0x95, 0xb6, 0x6f, 0x25, 0xda, 0xf2, 0x0d, 0x39, 0xfd, 0x1e, 0x3b, 0x33, 0xb2, 0x8f, 0x6d, 0x66,
-	0x28, 0x7c, 0xf3, 0x8f, 0x1c, 0x5c, 0xeb, 0x12, 0x6e, 0x32, 0x7b, 0x48, 0xfa, 0xbe, 0x15, 0x1f,
-	0x7f, 0x2c, 0x9a, 0x50, 0x36, 0xa9, 0xe3, 0x10, 0x53, 0xd8, 0xd4, 0xd5, 0x21, 0x8c, 0xcc, 0xa1,
-	0xff, 0x01, 0xf8, 0xdb, 0xed, 0x75, 0x79, 0xad, 0xe0, 0x6d, 0x32, 0x34, 0xd3, 0x9c, 0x41, 0xd5,
-	0x37, 0x44, 0x12, 0xf7, 0xdc, 0xf7, 0x74, 0x81, 0x36, 0x97, 0x40, 0xdb, 0x80, 0xd2, 0x14, 0x33,
-	0x61, 0x47, 0x94, 0xc3, 0x53, 0x32, 0x57, 0xb4, 0x8c, 0x1f, 0xce, 0xf9, 0x44, 0xf3, 0xef, 0x3c,
-	0x94, 0x7d, 0x5d, 0xa9, 0xc9, 0x51, 0x17, 0x8a, 0x72, 0x4f, 0x03, 0xe9, 0x27, 0xdf, 0x05, 0x77,
-	0xdb, 0xc9, 0x15, 0xa8, 0x1d, 0x33, 0xd8, 0x58, 0x1f, 0x06, 0xa6, 0x77, 0xa1, 0x64, 0xbb, 0x16,
-	0x39, 0x19, 0xa8, 0xf0, 0xe4, 0xbd, 0xf0, 0xdc, 0x8e, 0xf2, 0xc8, 0x2a, 0xd4, 0xd6, 0xda, 0x16,
-	0x39, 0xf1, 0x38, 0xc0, 0x0e, 0x7e, 0x72, 0x44, 0xe0, 0x12, 0x39, 0x11, 0x0c, 0x0f, 0xc2, 0x5c,
-	0x05, 0x8f, 0xeb, 0xeb, 0x33, 0x6c, 0xf2, 0x08, 0xda, 0x2f, 0xe5, 0xd7, 0x9a, 0x9b, 0xbf, 0x74,
-	0x05, 0x3b, 0x35, 0xaa, 0x24, 0x3a, 0x5b, 0xff, 0x05, 0x2e, 0x27, 0x01, 0xd1, 0x26, 0x14, 0x0e,
-	0xc9, 0xa9, 0xef, 0x76, 0xf9, 0x13, 0x6d, 0xc3, 0xca, 0x91, 0x3c, 0x4a, 0x9e, 0x9f, 0x17, 0xce,
-	0x86, 0xb7, 0xa1, 0xf9, 0x4e, 0x14, 0xf4, 0x79, 0xfe, 0x59, 0xae, 0xf9, 0x57, 0x1e, 0x6a, 0x8b,
-	0xc7, 0xed, 0x53, 0x6a, 0x45, 0x96, 0x23, 0x37, 0x82, 0x0d, 0x3f, 0xd0, 0x11, 0xd7, 0xed, 0xa4,
-	0xb9, 0x2e, 0xcd, 0xc2, 0x88, 0x4f, 0x95, 0x0f, 0xcb, 0x3c, 0x34, 0x55, 0x27, 0x70, 0x69, 0x01,
-	0x92, 0xe0, 0xbd, 0xe7, 0x51, 0xef, 0xdd, 0xc9, 0x12, 0xc2, 0xb0, 0x17, 0x2d, 0xb8, 0xbc, 0x4b,
-	0x44, 0x87, 0x11, 0x8b, 0xb8, 0xc2, 0xc6, 0xce, 0xc7, 0x27, 0x6c, 0x1d, 0xd6, 0x67, 0x5c, 0xf6,
-	0xc7, 0x89, 0x32, 0xa6, 0x68, 0xe8, 0x71, 0xf3, 0xf7, 0x1c, 0x5c, 0x89, 0xc9, 0x7c, 0x4a, 0xa0,
-	0x96, 0x48, 0xc9, 0xb5, 0x29, 0xe6, 0xfc, 0x98, 0x32, 0x55, 0x68, 0x8b, 0x86, 0x1e, 0x6f, 0xff,
-	0x73, 0x13, 0x8a, 0x06, 0xa5, 0xa2, 0x23, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x99, 0x52,
-	0x97, 0xb8, 0xaa, 0xb0, 0x72, 0xd4, 0x8e, 0x1a, 0xe0, 0x0f, 0x16, 0x81, 0xbe, 0xa3, 0xea, 0x77,
-	0x12, 0xf1, 0x31, 0x70, 0xf3, 0x02, 0x9a, 0x78, 0x6a, 0xb2, 0x57, 0xbf, 0xb1, 0xcd, 0xc3, 0xce,
-	0x18, 0xbb, 0x2e, 0x71, 0xd0, 0xe3, 0xe8, 0xd7, 0xfa, 0x86, 0xb1, 0x08, 0x0d, 0xf4, 0x6e, 0x27,
-	0xea, 0xf5, 0x05, 0xb3, 0xdd, 0x51, 0xe0, 0xd5, 0xe6, 0x05, 0xf4, 0xc1, 0x8b, 0xab, 0x54, 0xb7,
-	0xb9, 0xb0, 0x4d, 0x1e, 0x08, 0x6e, 0xa7, 0x0b, 0x2e, 0x80, 0xcf, 0x29, 0x39, 0x80, 0xcd, 0x0e,
-	0x23, 0x58, 0x90, 0x8e, 0x4e, 0x18, 0xf4, 0x30, 0xd9, 0x3b, 0x31, 0x58, 0x20, 0xb4, 0x2c, 0xf8,
-	0xcd, 0x0b, 0xe8, 0x27, 0xa8, 0x74, 0x19, 0x9d, 0x86, 0xe8, 0xef, 0x27, 0xd2, 0x47, 0x41, 0x19,
-	0xc9, 0x07, 0xb0, 0xf1, 0x0a, 0xf3, 0x10, 0xf7, 0xbd, 0x44, 0xee, 0x08, 0x26, 0xa0, 0xbe, 0x95,
-	0x08, 0xdd, 0xa1, 0xd4, 0x09, 0xb9, 0xe7, 0x18, 0x50, 0x50, 0x0c, 0x42, 0x2a, 0xc9, 0xc7, 0x6d,
-	0x11, 0x18, 0x48, 0x6d, 0x65, 0xc6, 0x6b, 0xe1, 0xb7, 0x50, 0x52, 0x0e, 0x7f, 0xe1, 0xd8, 0x98,
-	0xa3, 0xbb, 0x4b, 0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x3b, 0x28, 0x4a, 0x47, 0x2b, 0xd2, 0xff,
-	0xa7, 0x06, 0xe2, 0x3c, 0x94, 0x7d, 0x80, 0x17, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x91, 0xc8, 0x39,
-	0x07, 0x64, 0x24, 0x75, 0xa1, 0xda, 0x1f, 0xcb, 0xcb, 0x4d, 0xe0, 0x1a, 0x8e, 0x1e, 0x24, 0x1f,
-	0xe8, 0x28, 0x2a, 0xa0, 0x7f, 0x98, 0x0d, 0xac, 0xdd, 0x7d, 0x20, 0x6f, 0xae, 0x82, 0xb0, 0x50,
-	0x90, 0x1f, 0xa4, 0xef, 0xe4, 0xdc, 0xe7, 0xf4, 0x00, 0xaa, 0x2a, 0x56, 0xfb, 0xc1, 0x7d, 0x24,
-	0x85, 0x3e, 0x86, 0xca, 0x48, 0xff, 0x03, 0x6c, 0xc8, 0xa8, 0xcd, 0xc9, 0xef, 0xa5, 0x46, 0xf6,
-	0xbc, 0xd4, 0x07, 0x50, 0x7e, 0x85, 0xf9, 0x9c, 0xb9, 0x95, 0x96, 0x60, 0x0b, 0xc4, 0x99, 0xf2,
-	0xeb, 0x10, 0x2a, 0x32, 0x28, 0xfa, 0x63, 0x9e, 0x52, 0x1d, 0xa2, 0xa0, 0x40, 0xe2, 0x41, 0x26,
-	0xac, 0x16, 0x23, 0x50, 0x96, 0x6b, 0x41, 0x57, 0x4f, 0xd9, 0x4b, 0x18, 0x12, 0x08, 0xdd, 0xcb,
-	0x80, 0x0c, 0x55, 0xf1, 0x4a, 0xf4, 0x89, 0x87, 0x1e, 0xa5, 0x35, 0xf8, 0xc4, 0xc7, 0x66, 0xbd,
-	0x9d, 0x15, 0xae, 0x25, 0x7f, 0x86, 0x35, 0xff, 0xe1, 0x15, 0x4f, 0xc0, 0xd8, 0xc7, 0xfa, 0xcd,
-	0x57, 0xbf, 0x7b, 0x26, 0x4e, 0xb3, 0x63, 0xb8, 0xf2, 0x76, 0x6a, 0xc9, 0xe2, 0xaf, 0x5a, 0x4c,
-	0xd0, 0xe4, 0xe2, 0xc7, 0x4c, 0xf7, 0xa5, 0x18, 0x6e, 0x8f, 0x8f, 0xce, 0x3a, 0x66, 0x0c, 0xfe,
-	0xdb, 0x73, 0x8f, 0xb0, 0x63, 0x5b, 0x91, 0x1e, 0xb3, 0x47, 0x04, 0xee, 0x60, 0x73, 0x4c, 0xe2,
-	0x2d, 0x50, 0xbd, 0xe2, 0xa3, 0x9f, 0x68, 0x70, 0xc6, 0xa3, 0xfd, 0x2b, 0x20, 0x55, 0x10, 0xdc,
-	0xf7, 0xf6, 0x68, 0xc6, 0xb0, 0x3a, 0x7f, 0x69, 0xcd, 0x7d, 0x11, 0x1a, 0xc8, 0x7c, 0x79, 0x8e,
-	0x2f, 0x42, 0x7d, 0x17, 0x76, 0x89, 0xd8, 0x23, 0x82, 0xd9, 0x66, 0x5a, 0xd5, 0x9c, 0x03, 0x52,
-	0x82, 0x96, 0x80, 0xd3, 0x02, 0x7d, 0x58, 0x55, 0x6f, 0x4f, 0xd4, 0x4c, 0xfc, 0x28, 0x78, 0x39,
-	0x2f, 0xbb, 0x2d, 0xe8, 0xd7, 0x75, 0x28, 0x5d, 0x77, 0x89, 0x08, 0xbd, 0x69, 0x53, 0xd2, 0x35,
-	0x0a, 0x5a, 0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa1, 0xfa, 0xad, 0xcd, 0xfd, 0xc5, 0x37, 0x98,
-	0x1f, 0xa6, 0xf5, 0x80, 0x18, 0x6a, 0x79, 0x0f, 0x58, 0x00, 0x87, 0x3c, 0x56, 0x36, 0x88, 0x5c,
-	0xf0, 0xfd, 0x96, 0x7a, 0x2d, 0x0f, 0xff, 0xe9, 0x70, 0xd6, 0x21, 0x7b, 0xa7, 0xef, 0x57, 0xfa,
-	0x1a, 0x1d, 0xef, 0xbb, 0xf3, 0xb4, 0xd1, 0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x67, 0xe5, 0xe7,
-	0x66, 0x1e, 0xc0, 0x66, 0x97, 0x38, 0x24, 0xc2, 0xfc, 0x30, 0xe5, 0x0a, 0x13, 0x85, 0x65, 0xcc,
-	0xbc, 0x31, 0x6c, 0xc8, 0x30, 0xc8, 0xef, 0xde, 0x72, 0xc2, 0x78, 0x4a, 0xbf, 0x8a, 0x60, 0x02,
-	0xea, 0xfb, 0x59, 0xa0, 0xa1, 0x33, 0xb4, 0x11, 0x79, 0xc2, 0xc4, 0xf7, 0x31, 0x0f, 0x6a, 0xd2,
-	0x83, 0xaa, 0xfe, 0x28, 0x23, 0x3a, 0x74, 0x86, 0x40, 0x85, 0xdb, 0xa0, 0x0e, 0x49, 0x49, 0xeb,
-	0x39, 0x20, 0xa3, 0xbb, 0x5e, 0xc3, 0xba, 0x6c, 0xdd, 0x1e, 0xe5, 0x9d, 0xd4, 0xce, 0x7e, 0x0e,
-	0xc2, 0x03, 0xa8, 0xbe, 0x9e, 0x12, 0x86, 0x05, 0x91, 0xfe, 0xf2, 0x78, 0x93, 0x33, 0x2b, 0x86,
-	0xca, 0x7c, 0x2b, 0x87, 0x3e, 0x91, 0x15, 0x7c, 0x89, 0x13, 0xe6, 0x80, 0xe5, 0xb5, 0x2d, 0x8c,
-	0x0b, 0x17, 0x4f, 0x35, 0x2f, 0x0d, 0x5b, 0x2a, 0xe0, 0x59, 0x9e, 0x41, 0x40, 0xe1, 0xc2, 0xaf,
-	0x22, 0x7f, 0xeb, 0xfb, 0xcc, 0x3e, 0xb2, 0x1d, 0x32, 0x22, 0x29, 0x19, 0x10, 0x87, 0x65, 0x74,
-	0xd1, 0x10, 0x4a, 0x4a, 0x78, 0x97, 0x61, 0x57, 0xa0, 0x65, 0xa6, 0x79, 0x88, 0x80, 0xb6, 0x75,
-	0x36, 0x50, 0x6f, 0xc2, 0x04, 0x90, 0x69, 0xb1, 0x4f, 0x1d, 0xdb, 0x3c, 0x8d, 0x5f, 0x76, 0x74,
-	0x69, 0x98, 0x43, 0x52, 0x2e, 0x3b, 0x89, 0x48, 0x2d, 0x32, 0x84, 0x52, 0x67, 0x4c, 0xcc, 0xc3,
-	0x57, 0x04, 0x3b, 0x62, 0x9c, 0xf6, 0x4e, 0x99, 0x23, 0x96, 0x6f, 0x24, 0x02, 0x0c, 0x34, 0x76,
-	0x9e, 0xfd, 0xf8, 0x74, 0x64, 0x8b, 0xf1, 0x6c, 0x28, 0xdd, 0xb8, 0xa5, 0xa0, 0x8f, 0x6c, 0xea,
-	0xff, 0xda, 0x0a, 0x0c, 0xdc, 0xf2, 0xa8, 0xb6, 0x74, 0x92, 0x4e, 0x87, 0xc3, 0x55, 0x6f, 0xea,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x82, 0xd4, 0x6e, 0x4c, 0x18, 0x00, 0x00,
+	// 1867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x72, 0x1b, 0xb7,
+	0x19, 0x35, 0x49, 0x89, 0x12, 0x3f, 0x52, 0xa4, 0x82, 0xda, 0xce, 0x96, 0x4e, 0x1a, 0x9a, 0xb6,
+	0x23, 0x2a, 0xb6, 0xa9, 0x54, 0x99, 0xa6, 0x69, 0xee, 0x6c, 0xd1, 0x63, 0x73, 0x5a, 0x37, 0xee,
+	0xca, 0xce, 0xa4, 0x69, 0x5d, 0x16, 0xda, 0x85, 0xc4, 0x1d, 0x2d, 0x17, 0xcc, 0x02, 0xd4, 0xcf,
+	0xf4, 0xaa, 0x33, 0xbd, 0xef, 0x55, 0x5f, 0xa8, 0x7d, 0x87, 0xce, 0xf4, 0xba, 0x2f, 0xd2, 0x01,
+	0xb0, 0x8b, 0xfd, 0xe1, 0x82, 0x5a, 0xd9, 0xe9, 0x1d, 0x01, 0x1c, 0x9c, 0x03, 0x9c, 0x0f, 0x1f,
+	0x80, 0x05, 0x61, 0x3b, 0xa4, 0x94, 0x4f, 0x1c, 0x4a, 0x43, 0x77, 0x38, 0x0f, 0x29, 0xa7, 0xe8,
+	0xf6, 0xcc, 0xf3, 0xcf, 0x16, 0x4c, 0x95, 0x86, 0xa2, 0x59, 0xb6, 0x76, 0x5b, 0x0e, 0x9d, 0xcd,
+	0x68, 0xa0, 0xea, 0xbb, 0xad, 0x34, 0xaa, 0xdb, 0xf6, 0x02, 0x4e, 0xc2, 0x00, 0xfb, 0x51, 0xb9,
+	0x39, 0x0f, 0xe9, 0xc5, 0x65, 0x54, 0xe8, 0x10, 0xee, 0xb8, 0x93, 0x19, 0xe1, 0x58, 0x55, 0xf4,
+	0x27, 0x70, 0xeb, 0x89, 0xef, 0x53, 0xe7, 0xb5, 0x37, 0x23, 0x8c, 0xe3, 0xd9, 0xdc, 0x26, 0x3f,
+	0x2c, 0x08, 0xe3, 0xe8, 0x73, 0x58, 0x3b, 0xc2, 0x8c, 0x58, 0x95, 0x5e, 0x65, 0xd0, 0xdc, 0xff,
+	0x68, 0x98, 0x19, 0x49, 0x24, 0xff, 0x92, 0x9d, 0x3c, 0xc5, 0x8c, 0xd8, 0x12, 0x89, 0x6e, 0xc2,
+	0xba, 0x43, 0x17, 0x01, 0xb7, 0x6a, 0xbd, 0xca, 0x60, 0xcb, 0x56, 0x85, 0xfe, 0x5f, 0x2b, 0x70,
+	0x3b, 0xaf, 0xc0, 0xe6, 0x34, 0x60, 0x04, 0x7d, 0x01, 0x75, 0xc6, 0x31, 0x5f, 0xb0, 0x48, 0xe4,
+	0x4e, 0xa1, 0xc8, 0xa1, 0x84, 0xd8, 0x11, 0x14, 0x7d, 0x04, 0x0d, 0x1e, 0x33, 0x59, 0xd5, 0x5e,
+	0x65, 0xb0, 0x66, 0x27, 0x15, 0x86, 0x31, 0x7c, 0x07, 0x6d, 0x39, 0x84, 0xf1, 0xe8, 0x47, 0x98,
+	0x5d, 0x35, 0xcd, 0xec, 0x43, 0x47, 0x33, 0xbf, 0xcf, 0xac, 0xda, 0x50, 0x1d, 0x8f, 0x24, 0x75,
+	0xcd, 0xae, 0x8e, 0x47, 0x86, 0x79, 0xfc, 0xb3, 0x0a, 0xad, 0xf1, 0x6c, 0x4e, 0x43, 0x6e, 0x13,
+	0xb6, 0xf0, 0xf9, 0xbb, 0x69, 0x7d, 0x08, 0x1b, 0x1c, 0xb3, 0xd3, 0x89, 0xe7, 0x46, 0x82, 0x75,
+	0x51, 0x1c, 0xbb, 0xe8, 0x13, 0x68, 0xba, 0x98, 0xe3, 0x80, 0xba, 0x44, 0x34, 0xd6, 0x64, 0x23,
+	0xc4, 0x55, 0x63, 0x17, 0x7d, 0x09, 0xeb, 0x82, 0x83, 0x58, 0x6b, 0xbd, 0xca, 0xa0, 0xbd, 0xdf,
+	0x2b, 0x54, 0x53, 0x03, 0x14, 0x9a, 0xc4, 0x56, 0x70, 0xd4, 0x85, 0x4d, 0x46, 0x4e, 0x66, 0x24,
+	0xe0, 0xcc, 0x5a, 0xef, 0xd5, 0x06, 0x35, 0x5b, 0x97, 0xd1, 0x4f, 0x61, 0x13, 0x2f, 0x38, 0x9d,
+	0x78, 0x2e, 0xb3, 0xea, 0xb2, 0x6d, 0x43, 0x94, 0xc7, 0x2e, 0x43, 0x77, 0xa0, 0x11, 0xd2, 0xf3,
+	0x89, 0x32, 0x62, 0x43, 0x8e, 0x66, 0x33, 0xa4, 0xe7, 0x07, 0xa2, 0x8c, 0x7e, 0x09, 0xeb, 0x5e,
+	0x70, 0x4c, 0x99, 0xb5, 0xd9, 0xab, 0x0d, 0x9a, 0xfb, 0x77, 0x0b, 0xc7, 0xf2, 0x6b, 0x72, 0xf9,
+	0x2d, 0xf6, 0x17, 0xe4, 0x15, 0xf6, 0x42, 0x5b, 0xe1, 0xfb, 0xff, 0xa9, 0x41, 0xfb, 0xd9, 0x85,
+	0x18, 0xe3, 0x6b, 0x31, 0xed, 0xe0, 0x98, 0x0a, 0xf7, 0x3d, 0x57, 0x5a, 0x58, 0xb3, 0xab, 0x9e,
+	0x8b, 0xee, 0xc1, 0x96, 0x43, 0x7d, 0x9f, 0x38, 0xdc, 0xa3, 0x41, 0xe2, 0x53, 0x2b, 0xa9, 0x1c,
+	0xbb, 0x68, 0x07, 0x3a, 0x29, 0x50, 0x80, 0x67, 0x44, 0x3a, 0xd6, 0xb0, 0xdb, 0x49, 0xf5, 0x6f,
+	0xf1, 0x8c, 0x08, 0xb6, 0x39, 0x0e, 0xb9, 0x17, 0x91, 0x31, 0x6b, 0x4d, 0x4e, 0xb3, 0xa5, 0x2b,
+	0xc5, 0x5c, 0x11, 0xac, 0xcd, 0x31, 0x9f, 0x5a, 0xeb, 0x92, 0x42, 0xfe, 0x46, 0xb7, 0xa1, 0x7e,
+	0x4c, 0xc3, 0x19, 0xe6, 0x56, 0x5d, 0xd6, 0x46, 0xa5, 0x6c, 0x0a, 0x6c, 0xe4, 0x53, 0xe0, 0x17,
+	0x71, 0x90, 0x36, 0x65, 0x90, 0x3e, 0xc9, 0x1a, 0xa3, 0x36, 0x83, 0x67, 0x17, 0x2b, 0x63, 0xd4,
+	0xc8, 0xc5, 0xe8, 0x21, 0x7c, 0x40, 0x64, 0x0f, 0xe2, 0x4e, 0x34, 0x08, 0x24, 0x68, 0x3b, 0x6e,
+	0x38, 0x8c, 0xc1, 0x37, 0x61, 0xfd, 0xd8, 0xf3, 0x09, 0xb3, 0x9a, 0xbd, 0xda, 0xa0, 0x61, 0xab,
+	0x42, 0x36, 0x96, 0xad, 0x5c, 0x2c, 0xef, 0x40, 0xc3, 0x09, 0x09, 0xe6, 0x64, 0xc2, 0x99, 0xb5,
+	0xa5, 0x1a, 0x55, 0xc5, 0x6b, 0x26, 0x5c, 0x08, 0x09, 0x66, 0x34, 0xb0, 0xda, 0xca, 0x05, 0x55,
+	0x12, 0x8e, 0x91, 0x8b, 0x79, 0x68, 0x75, 0x94, 0x63, 0xe2, 0x77, 0xff, 0xdf, 0x15, 0xf8, 0x49,
+	0x34, 0x37, 0x35, 0x9c, 0xff, 0x4b, 0x9e, 0x7c, 0x0c, 0x10, 0xb9, 0x90, 0xa4, 0x49, 0x23, 0xaa,
+	0x59, 0x4e, 0xa3, 0xb5, 0xa5, 0x34, 0xd2, 0x0e, 0xad, 0x1b, 0x1d, 0xaa, 0x67, 0x1d, 0xea, 0xff,
+	0xbd, 0x02, 0x1f, 0x8e, 0x08, 0x73, 0x42, 0xef, 0x88, 0xc4, 0x4e, 0xbf, 0xfb, 0x5e, 0xd6, 0x87,
+	0xf4, 0x52, 0x1e, 0x15, 0x2c, 0xef, 0x11, 0xfa, 0x99, 0x9e, 0xe4, 0x78, 0xc4, 0xac, 0x9a, 0x0c,
+	0x76, 0xaa, 0xa6, 0xbf, 0x80, 0x4e, 0x34, 0x10, 0x41, 0x2c, 0xd3, 0x28, 0x4f, 0x5b, 0x29, 0xa0,
+	0xed, 0x41, 0x33, 0x59, 0xf7, 0xb1, 0x72, 0xba, 0x4a, 0xac, 0x6e, 0x2d, 0x93, 0x37, 0x77, 0xd4,
+	0xff, 0x6f, 0x15, 0x5a, 0x91, 0xae, 0xd0, 0x64, 0x68, 0x04, 0x0d, 0x31, 0xa7, 0x89, 0x48, 0xee,
+	0xc8, 0x82, 0x9d, 0x61, 0xf1, 0xb1, 0x39, 0xcc, 0x0d, 0xd8, 0xde, 0x3c, 0x8a, 0x87, 0x3e, 0x82,
+	0xa6, 0x17, 0xb8, 0xe4, 0x62, 0xa2, 0xf6, 0x94, 0xaa, 0xdc, 0x53, 0xee, 0x65, 0x79, 0xc4, 0xd1,
+	0x39, 0xd4, 0xda, 0x2e, 0xb9, 0x90, 0x1c, 0xe0, 0xc5, 0x3f, 0x19, 0x22, 0x22, 0x4f, 0x78, 0x88,
+	0x27, 0x69, 0xae, 0x9a, 0xe4, 0xfa, 0xd5, 0x15, 0x63, 0x92, 0x04, 0xc3, 0x67, 0xa2, 0xb7, 0xe6,
+	0x66, 0xcf, 0x02, 0x1e, 0x5e, 0xda, 0x1d, 0x92, 0xad, 0xed, 0xfe, 0x19, 0x6e, 0x16, 0x01, 0xd1,
+	0x36, 0xd4, 0x4e, 0xc9, 0x65, 0x64, 0xbb, 0xf8, 0x89, 0xf6, 0x61, 0xfd, 0x4c, 0xec, 0x7f, 0x56,
+	0xb5, 0x68, 0x6d, 0xc8, 0x09, 0x25, 0x33, 0x51, 0xd0, 0xaf, 0xab, 0x5f, 0x55, 0xfa, 0xff, 0xaa,
+	0x82, 0xb5, 0xbc, 0xdc, 0xde, 0xe7, 0x80, 0x2b, 0xb3, 0xe4, 0x4e, 0x60, 0x4b, 0xe7, 0x55, 0xca,
+	0xba, 0xa7, 0x26, 0xeb, 0x4c, 0x23, 0xcc, 0x78, 0xaa, 0x3c, 0x6c, 0xb1, 0x54, 0x55, 0x97, 0xc0,
+	0x07, 0x4b, 0x90, 0x02, 0xf7, 0xbe, 0xce, 0xba, 0x77, 0xbf, 0x4c, 0x08, 0xd3, 0x2e, 0xba, 0x70,
+	0xf3, 0x39, 0xe1, 0x07, 0x21, 0x71, 0x49, 0xc0, 0x3d, 0xec, 0xbf, 0x7b, 0xc2, 0x76, 0x61, 0x73,
+	0xc1, 0xc4, 0xa5, 0x6e, 0xa6, 0x06, 0xd3, 0xb0, 0x75, 0xb9, 0xff, 0xb7, 0x0a, 0xdc, 0xca, 0xc9,
+	0xbc, 0x4f, 0xa0, 0x56, 0x48, 0x89, 0xb6, 0x39, 0x66, 0xec, 0x9c, 0x86, 0x6e, 0x74, 0xd6, 0xe9,
+	0xf2, 0xfe, 0x3f, 0x1e, 0x40, 0xc3, 0xa6, 0x94, 0x1f, 0x08, 0x4b, 0x90, 0x0f, 0x48, 0x8c, 0x89,
+	0xce, 0xe6, 0x34, 0x20, 0x81, 0x3a, 0x69, 0x18, 0x1a, 0x66, 0x07, 0x10, 0x15, 0x96, 0x81, 0x91,
+	0x51, 0xdd, 0xfb, 0x85, 0xf8, 0x1c, 0xb8, 0x7f, 0x03, 0xcd, 0xa4, 0x9a, 0xb8, 0x60, 0xbe, 0xf6,
+	0x9c, 0xd3, 0x83, 0x29, 0x0e, 0x02, 0xe2, 0xa3, 0xcf, 0xb3, 0xbd, 0xf5, 0xb5, 0x78, 0x19, 0x1a,
+	0xeb, 0xdd, 0x2b, 0xd4, 0x3b, 0xe4, 0xa1, 0x17, 0x9c, 0xc4, 0xae, 0xf6, 0x6f, 0xa0, 0x1f, 0x64,
+	0x5c, 0x85, 0xba, 0xc7, 0xb8, 0xe7, 0xb0, 0x58, 0x70, 0xdf, 0x2c, 0xb8, 0x04, 0xbe, 0xa6, 0xe4,
+	0x04, 0xb6, 0x0f, 0xe4, 0x81, 0x78, 0xa0, 0x13, 0x06, 0x3d, 0x2a, 0x76, 0x27, 0x07, 0x8b, 0x85,
+	0x56, 0x05, 0xbf, 0x7f, 0x03, 0xfd, 0x01, 0xda, 0xa3, 0x90, 0xce, 0x53, 0xf4, 0x9f, 0x15, 0xd2,
+	0x67, 0x41, 0x25, 0xc9, 0x27, 0xb0, 0xf5, 0x02, 0xb3, 0x14, 0xf7, 0x6e, 0x21, 0x77, 0x06, 0x13,
+	0x53, 0xdf, 0x2d, 0x84, 0x3e, 0xa5, 0xd4, 0x4f, 0xd9, 0x73, 0x0e, 0x28, 0xde, 0x0c, 0x52, 0x2a,
+	0xc5, 0xcb, 0x6d, 0x19, 0x18, 0x4b, 0xed, 0x95, 0xc6, 0x6b, 0xe1, 0x37, 0xd0, 0x54, 0x86, 0x3f,
+	0xf1, 0x3d, 0xcc, 0xd0, 0xce, 0x8a, 0x90, 0x48, 0x44, 0x49, 0xc3, 0x7e, 0x07, 0x0d, 0x61, 0xb4,
+	0x22, 0x7d, 0x60, 0x0c, 0xc4, 0x75, 0x28, 0x0f, 0x01, 0x9e, 0xf8, 0x9c, 0x84, 0x8a, 0xf3, 0xd3,
+	0x42, 0xce, 0x04, 0x50, 0x92, 0x34, 0x80, 0xce, 0xe1, 0x94, 0x9e, 0x27, 0xd6, 0x30, 0xf4, 0xb0,
+	0x78, 0x41, 0x67, 0x51, 0x31, 0xfd, 0xa3, 0x72, 0x60, 0x6d, 0xf7, 0x5b, 0xf1, 0xb9, 0xc5, 0x49,
+	0x98, 0xb4, 0x1a, 0xf4, 0x72, 0xa8, 0x92, 0xd3, 0x79, 0x0b, 0x1d, 0x15, 0xab, 0x57, 0xf1, 0x7d,
+	0xc4, 0x40, 0x9f, 0x43, 0x95, 0xa4, 0xff, 0x3d, 0x6c, 0x89, 0xa8, 0x25, 0xe4, 0xbb, 0xc6, 0xc8,
+	0x5e, 0x97, 0xfa, 0x2d, 0xb4, 0x5e, 0x60, 0x96, 0x30, 0x0f, 0x4c, 0x09, 0xb6, 0x44, 0x5c, 0x2a,
+	0xbf, 0x4e, 0xa1, 0x2d, 0x82, 0xa2, 0x3b, 0x33, 0xc3, 0xee, 0x90, 0x05, 0xc5, 0x12, 0x0f, 0x4b,
+	0x61, 0xb5, 0x18, 0x81, 0x96, 0x68, 0xd3, 0x1f, 0x14, 0x03, 0x63, 0xf7, 0xdc, 0x4d, 0xb8, 0xbb,
+	0x5b, 0x02, 0x99, 0xda, 0xc5, 0xdb, 0xd9, 0x77, 0x09, 0xf4, 0xd8, 0x74, 0xc0, 0x17, 0xbe, 0x90,
+	0x74, 0x87, 0x65, 0xe1, 0x5a, 0xf2, 0x8f, 0xb0, 0x11, 0xbd, 0x16, 0xa0, 0x4f, 0x57, 0x76, 0xd6,
+	0x0f, 0x15, 0xdd, 0x9d, 0x2b, 0x71, 0x9a, 0x1d, 0xc3, 0xad, 0x37, 0x73, 0x57, 0x6c, 0xfe, 0xea,
+	0x88, 0x89, 0x0f, 0x39, 0xb4, 0x6b, 0x38, 0x97, 0x72, 0xb8, 0x97, 0xec, 0xe4, 0xaa, 0x65, 0x16,
+	0xc2, 0xc7, 0xe3, 0xe0, 0x0c, 0xfb, 0x9e, 0x9b, 0x39, 0x63, 0x5e, 0x12, 0x8e, 0x0f, 0xb0, 0x33,
+	0x25, 0x68, 0xbf, 0xe8, 0x6b, 0x33, 0xdb, 0x45, 0x83, 0x4b, 0x2e, 0xed, 0xbf, 0x00, 0x52, 0x1b,
+	0x42, 0x70, 0xec, 0x9d, 0x2c, 0x42, 0xac, 0xd6, 0x9f, 0xe9, 0x70, 0x5f, 0x86, 0xc6, 0x32, 0x3f,
+	0xbf, 0x46, 0x8f, 0xd4, 0xb9, 0x0b, 0xcf, 0x09, 0x7f, 0x49, 0x78, 0xe8, 0x39, 0xa6, 0x5d, 0x33,
+	0x01, 0x18, 0x82, 0x56, 0x80, 0xd3, 0x02, 0x87, 0x50, 0x57, 0x0f, 0x26, 0xa8, 0x5f, 0xd8, 0x29,
+	0x7e, 0xee, 0x59, 0x75, 0x5b, 0x88, 0x31, 0xe9, 0x74, 0x7d, 0x4e, 0x78, 0xea, 0x21, 0xc6, 0x90,
+	0xae, 0x59, 0xd0, 0xea, 0x74, 0xcd, 0x63, 0xb5, 0x58, 0x00, 0x9d, 0xdf, 0x78, 0x2c, 0x6a, 0x14,
+	0x4f, 0x2a, 0xa6, 0x33, 0x20, 0x87, 0x5a, 0x7d, 0x06, 0x2c, 0x81, 0x53, 0x8e, 0xb5, 0x6c, 0x22,
+	0x1a, 0x22, 0xdf, 0x8c, 0xd7, 0xf2, 0xf4, 0x4b, 0xd9, 0x55, 0x8b, 0xec, 0x3b, 0x7d, 0xbf, 0xd2,
+	0xd7, 0x68, 0xf4, 0xc0, 0xb0, 0x60, 0x12, 0x88, 0xb8, 0xf1, 0x97, 0x60, 0x8e, 0xb2, 0xf2, 0xc7,
+	0x66, 0x9e, 0xc0, 0xf6, 0x88, 0xf8, 0x24, 0xc3, 0xfc, 0xc8, 0x70, 0x85, 0xc9, 0xc2, 0x4a, 0x66,
+	0xde, 0x14, 0xb6, 0x44, 0x18, 0x44, 0xbf, 0x37, 0x8c, 0x84, 0xcc, 0x70, 0x5e, 0x65, 0x30, 0x31,
+	0xf5, 0x67, 0x65, 0xa0, 0xa9, 0x35, 0xb4, 0x95, 0xf9, 0x84, 0x41, 0x8f, 0x4c, 0x41, 0x2d, 0xfa,
+	0xa0, 0xea, 0x3e, 0x2e, 0x89, 0x4e, 0xad, 0x21, 0x50, 0xe1, 0xb6, 0xa9, 0x4f, 0x0c, 0x69, 0x9d,
+	0x00, 0x4a, 0xda, 0xf5, 0x0d, 0x6c, 0x8a, 0xa3, 0x5b, 0x52, 0xde, 0x37, 0x9e, 0xec, 0xd7, 0x20,
+	0x7c, 0x0b, 0x9d, 0x6f, 0xe6, 0x24, 0xc4, 0x9c, 0x08, 0xbf, 0x24, 0x6f, 0x71, 0x66, 0xe5, 0x50,
+	0xa5, 0x6f, 0xe5, 0x70, 0x48, 0xc4, 0x0e, 0xbe, 0xc2, 0x84, 0x04, 0xb0, 0x7a, 0x6f, 0x4b, 0xe3,
+	0xd2, 0x9b, 0xa7, 0xaa, 0x17, 0x03, 0x5b, 0x29, 0x20, 0x47, 0x5e, 0x42, 0x40, 0xe1, 0xd2, 0x5f,
+	0x45, 0xd1, 0xd4, 0x5f, 0x85, 0xde, 0x99, 0xe7, 0x93, 0x13, 0x62, 0xc8, 0x80, 0x3c, 0xac, 0xa4,
+	0x45, 0x47, 0xd0, 0x54, 0xc2, 0xcf, 0x43, 0x1c, 0x70, 0xb4, 0x6a, 0x68, 0x12, 0x11, 0xd3, 0x0e,
+	0xae, 0x06, 0xea, 0x49, 0x38, 0x00, 0x22, 0x2d, 0x5e, 0x51, 0xdf, 0x73, 0x2e, 0xd1, 0xc0, 0xb0,
+	0x35, 0x24, 0x10, 0xc3, 0x65, 0xa7, 0x10, 0xa9, 0x45, 0x8e, 0xa0, 0x79, 0x30, 0x25, 0xce, 0xe9,
+	0x0b, 0x82, 0x7d, 0x3e, 0x35, 0x7d, 0xa7, 0x24, 0x88, 0xd5, 0x13, 0xc9, 0x00, 0xb5, 0xc6, 0xf7,
+	0xd0, 0x56, 0x39, 0x33, 0xc2, 0x1c, 0xcb, 0x67, 0x8b, 0xdd, 0xa2, 0xdb, 0x40, 0x16, 0x53, 0x32,
+	0x10, 0xdf, 0x42, 0x4b, 0x24, 0x8f, 0x66, 0xde, 0x29, 0x62, 0x4e, 0x23, 0x4a, 0xf2, 0x1e, 0xab,
+	0x2d, 0x2e, 0xee, 0xb5, 0x74, 0xd9, 0x54, 0xc4, 0x19, 0x88, 0xc1, 0xff, 0x42, 0xa4, 0xf6, 0xe6,
+	0x4f, 0xb0, 0x6d, 0x13, 0xf1, 0x86, 0x62, 0xfe, 0x72, 0x51, 0x04, 0x79, 0x54, 0xe9, 0x0f, 0xc6,
+	0xba, 0x7a, 0xf7, 0x46, 0x77, 0xcd, 0xef, 0xfd, 0x31, 0x57, 0x7f, 0x15, 0x44, 0x0f, 0xd9, 0x93,
+	0x97, 0x88, 0xd4, 0x3f, 0x05, 0xc5, 0xe1, 0xcc, 0x62, 0x0c, 0xdb, 0x7f, 0x31, 0x34, 0xb5, 0x72,
+	0xa2, 0x23, 0x3d, 0x9a, 0xc3, 0x43, 0xd3, 0x7e, 0x5e, 0xf0, 0xb6, 0x7f, 0x85, 0x33, 0x4f, 0xbf,
+	0xfa, 0xfe, 0xcb, 0x13, 0x8f, 0x4f, 0x17, 0x47, 0xa2, 0x65, 0x4f, 0x41, 0x1f, 0x7b, 0x34, 0xfa,
+	0xb5, 0x17, 0xa7, 0xcd, 0x9e, 0xec, 0xbd, 0xa7, 0xa5, 0xe6, 0x47, 0x47, 0x75, 0x59, 0xf5, 0xc5,
+	0xff, 0x06, 0x00, 0xdd, 0x84, 0x6e, 0x75, 0x97, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -878,6 +1133,13 @@ type RootCoordClient interface {
 	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CreateDatabase(ctx context.Context, in *proxypb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *proxypb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *proxypb.ListDatabasesRequest, opts ...grpc.CallOption) (*proxypb.ListDatabasesResponse, error)
+	RenameCollection(ctx context.Context, in *proxypb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *proxypb.ExportRequest, opts ...grpc.CallOption) (*proxypb.ExportResponse, error)
+	GetExportState(ctx context.Context, in *proxypb.GetExportStateRequest, opts ...grpc.CallOption) (*proxypb.GetExportStateResponse, error)
+	ReportExport(ctx context.Context, in *ExportSegmentResult, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type rootCoordClient struct {
@@ -1257,6 +1519,69 @@ func (c *rootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHea
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *proxypb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *proxypb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *proxypb.ListDatabasesRequest, opts ...grpc.CallOption) (*proxypb.ListDatabasesResponse, error) {
+	out := new(proxypb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *proxypb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) Export(ctx context.Context, in *proxypb.ExportRequest, opts ...grpc.CallOption) (*proxypb.ExportResponse, error) {
+	out := new(proxypb.ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetExportState(ctx context.Context, in *proxypb.GetExportStateRequest, opts ...grpc.CallOption) (*proxypb.GetExportStateResponse, error) {
+	out := new(proxypb.GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ReportExport(ctx context.Context, in *ExportSegmentResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReportExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -1352,6 +1677,13 @@ type RootCoordServer interface {
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CreateDatabase(context.Context, *proxypb.CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *proxypb.DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error)
+	RenameCollection(context.Context, *proxypb.RenameCollectionRequest) (*commonpb.Status, error)
+	Export(context.Context, *proxypb.ExportRequest) (*proxypb.ExportResponse, error)
+	GetExportState(context.Context, *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error)
+	ReportExport(context.Context, *ExportSegmentResult) (*commonpb.Status, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1481,6 +1813,27 @@ func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *intern
 func (*UnimplementedRootCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedRootCoordServer) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedRootCoordServer) ReportExport(ctx context.Context, req *ExportSegmentResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExport not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -2224,6 +2577,132 @@ func _RootCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*proxypb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*proxypb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*proxypb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*proxypb.RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).Export(ctx, req.(*proxypb.ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetExportState(ctx, req.(*proxypb.GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ReportExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSegmentResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ReportExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReportExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ReportExport(ctx, req.(*ExportSegmentResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2392,6 +2871,34 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _RootCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _RootCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _RootCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "ReportExport",
+			Handler:    _RootCoord_ReportExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
@@ -105,7 +105,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}
 	ctx = logutil.WithModule(ctx, moduleName)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-InvalidateCollectionMetaCache")
-	defer sp.Finish()
+	defer sp.End()
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -120,7 +120,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	var aliasName []string
 	if globalMetaCache != nil {
 		if collectionName != "" {
-			globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+			globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
 		}
 		if request.CollectionID != UniqueID(0) {
 			aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
@@ -143,6 +143,210 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+// CreateDatabase create a database, the collections can be created in the database later.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateDatabase")
+	defer sp.End()
+	method := "CreateDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	cdt := &createDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(cdt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()))
+
+	if err := cdt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", cdt.BeginTs()),
+			zap.Uint64("EndTs", cdt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return cdt.result, nil
+}
+
+// DropDatabase drop a database, the database should have no collections.
+func (node *Proxy) DropDatabase(ctx context.Context, request *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropDatabase")
+	defer sp.End()
+	method := "DropDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	ddt := &dropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()))
+
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", ddt.BeginTs()),
+			zap.Uint64("EndTs", ddt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ddt.result, nil
+}
+
+// ListDatabases list all the databases, the default database is always included.
+func (node *Proxy) ListDatabases(ctx context.Context, request *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListDatabases")
+	defer sp.End()
+	method := "ListDatabases"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	ldt := &listDatabaseTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(ldt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &proxypb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	if err := ldt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", ldt.BeginTs()),
+			zap.Uint64("EndTs", ldt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &proxypb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Int("num of db", len(ldt.result.GetDbNames())),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ldt.result, nil
+}
+
 // CreateCollection create a collection by the schema.
 // TODO(dragondriver): add more detailed ut for ConsistencyLevel, should we support multiple consistency level in Proxy?
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
@@ -151,7 +355,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "CreateCollection"
 	tr := timerecord.NewTimeRecorder(method)
 
@@ -226,7 +430,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "DropCollection"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
@@ -293,7 +497,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HasCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "HasCollection"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -364,7 +568,7 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-LoadCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "LoadCollection"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -430,7 +634,7 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ReleaseCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "ReleaseCollection"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -503,7 +707,7 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DescribeCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "DescribeCollection"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -578,7 +782,7 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetStatistics")
-	defer sp.Finish()
+	defer sp.End()
 	method := "GetStatistics"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -664,7 +868,7 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetCollectionStatistics")
-	defer sp.Finish()
+	defer sp.End()
 	method := "GetCollectionStatistics"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -741,7 +945,7 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 		}, nil
 	}
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ShowCollections")
-	defer sp.Finish()
+	defer sp.End()
 	method := "ShowCollections"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
@@ -812,7 +1016,7 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterCollection")
-	defer sp.Finish()
+	defer sp.End()
 	method := "AlterCollection"
 	tr := timerecord.NewTimeRecorder(method)
 
@@ -882,7 +1086,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreatePartition")
-	defer sp.Finish()
+	defer sp.End()
 	method := "CreatePartition"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
@@ -953,7 +1157,7 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropPartition")
-	defer sp.Finish()
+	defer sp.End()
 	method := "DropPartition"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
@@ -1027,7 +1231,7 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HasPartition")
-	defer sp.Finish()
+	defer sp.End()
 	method := "HasPartition"
 	tr := timerecord.NewTimeRecorder(method)
 	//TODO: use collectionID instead of collectionName
@@ -1109,7 +1313,7 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-LoadPartitions")
-	defer sp.Finish()
+	defer sp.End()
 	method := "LoadPartitions"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -1183,7 +1387,7 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ReleasePartitions")
-	defer sp.Finish()
+	defer sp.End()
 
 	rpt := &releasePartitionsTask{
 		ctx:                      ctx,
@@ -1260,7 +1464,7 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetPartitionStatistics")
-	defer sp.Finish()
+	defer sp.End()
 	method := "GetPartitionStatistics"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -1340,7 +1544,7 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ShowPartitions")
-	defer sp.Finish()
+	defer sp.End()
 
 	spt := &showPartitionsTask{
 		ctx:                   ctx,
@@ -1430,7 +1634,7 @@ func (node *Proxy) GetLoadingProgress(ctx context.Context, request *milvuspb.Get
 	method := "GetLoadingProgress"
 	tr := timerecord.NewTimeRecorder(method)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetLoadingProgress")
-	defer sp.Finish()
+	defer sp.End()
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 	log := log.Ctx(ctx)
 
@@ -1454,7 +1658,7 @@ func (node *Proxy) GetLoadingProgress(ctx context.Context, request *milvuspb.Get
 	if err := validateCollectionName(request.CollectionName); err != nil {
 		return getErrResponse(err), nil
 	}
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.CollectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, request.CollectionName)
 	if err != nil {
 		return getErrResponse(err), nil
 	}
@@ -1485,7 +1689,7 @@ func (node *Proxy) GetLoadingProgress(ctx context.Context, request *milvuspb.Get
 		}
 	} else {
 		if progress, err = getPartitionProgress(ctx, node.queryCoord, request.GetBase(),
-			request.GetPartitionNames(), request.GetCollectionName(), collectionID); err != nil {
+			request.GetPartitionNames(), util.DefaultDBName, request.GetCollectionName(), collectionID); err != nil {
 			return getErrResponse(err), nil
 		}
 	}
@@ -1510,7 +1714,7 @@ func (node *Proxy) GetLoadState(ctx context.Context, request *milvuspb.GetLoadSt
 	method := "GetLoadState"
 	tr := timerecord.NewTimeRecorder(method)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetLoadState")
-	defer sp.Finish()
+	defer sp.End()
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 	log := log.Ctx(ctx)
 
@@ -1555,7 +1759,7 @@ func (node *Proxy) GetLoadState(ctx context.Context, request *milvuspb.GetLoadSt
 		metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	}()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.CollectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, request.CollectionName)
 	if err != nil {
 		successResponse.State = commonpb.LoadState_LoadStateNotExist
 		return successResponse, nil
@@ -1582,7 +1786,7 @@ func (node *Proxy) GetLoadState(ctx context.Context, request *milvuspb.GetLoadSt
 		}
 	} else {
 		if progress, err = getPartitionProgress(ctx, node.queryCoord, request.GetBase(),
-			request.GetPartitionNames(), request.GetCollectionName(), collectionID); err != nil {
+			request.GetPartitionNames(), util.DefaultDBName, request.GetCollectionName(), collectionID); err != nil {
 			successResponse.State = commonpb.LoadState_LoadStateNotLoad
 			return successResponse, nil
 		}
@@ -1602,7 +1806,7 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	cit := &createIndexTask{
 		ctx:        ctx,
@@ -1682,7 +1886,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DescribeIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	dit := &describeIndexTask{
 		ctx:                  ctx,
@@ -1767,7 +1971,7 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	dit := &dropIndexTask{
 		ctx:              ctx,
@@ -1847,7 +2051,7 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetIndexBuildProgress")
-	defer sp.Finish()
+	defer sp.End()
 
 	gibpt := &getIndexBuildProgressTask{
 		ctx:                          ctx,
@@ -1930,7 +2134,7 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
-	defer sp.Finish()
+	defer sp.End()
 
 	dipt := &getIndexStateTask{
 		ctx:                  ctx,
@@ -2006,7 +2210,7 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 // Insert insert records into collection.
 func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
-	defer sp.Finish()
+	defer sp.End()
 	log := log.Ctx(ctx)
 	log.Debug("Start processing insert request in Proxy")
 	defer log.Debug("Finish processing insert request in Proxy")
@@ -2020,6 +2224,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	tr := timerecord.NewTimeRecorder(method)
 	receiveSize := proto.Size(request)
 	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(receiveSize))
+	addCollectionRate(ctx, internalpb.RateType_DMLInsert, request.GetDbName(), request.GetCollectionName(), float64(receiveSize))
+	addPartitionRate(ctx, internalpb.RateType_DMLInsert, request, float64(receiveSize))
 	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel).Add(float64(receiveSize))
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
@@ -2037,6 +2243,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					commonpbutil.WithMsgID(0),
 					commonpbutil.WithSourceID(paramtable.GetNodeID()),
 				),
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				FieldsData:     request.FieldsData,
@@ -2131,13 +2338,15 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 // Delete delete records from collection, then these records cannot be searched.
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Delete")
-	defer sp.Finish()
+	defer sp.End()
 	log := log.Ctx(ctx)
 	log.Debug("Start processing delete request in Proxy")
 	defer log.Debug("Finish processing delete request in Proxy")
 
 	receiveSize := proto.Size(request)
 	rateCol.Add(internalpb.RateType_DMLDelete.String(), float64(receiveSize))
+	addCollectionRate(ctx, internalpb.RateType_DMLDelete, request.GetDbName(), request.GetCollectionName(), float64(receiveSize))
+	addPartitionRate(ctx, internalpb.RateType_DMLDelete, request, float64(receiveSize))
 	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.DeleteLabel).Add(float64(receiveSize))
 
 	if !node.checkHealthy() {
@@ -2222,12 +2431,146 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return dt.result, nil
 }
 
+// Upsert deletes the entities with the primary keys in the request and inserts the new entities,
+// the delete and the insert share one timestamp, so readers never observe the intermediate state.
+func (node *Proxy) Upsert(ctx context.Context, request *proxypb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.End()
+	log := log.Ctx(ctx)
+	log.Debug("Start processing upsert request in Proxy")
+	defer log.Debug("Finish processing upsert request in Proxy")
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "Upsert"
+	tr := timerecord.NewTimeRecorder(method)
+	receiveSize := proto.Size(request)
+	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(receiveSize))
+	addCollectionRate(ctx, internalpb.RateType_DMLInsert, request.GetDbName(), request.GetCollectionName(), float64(receiveSize))
+	addPartitionRate(ctx, internalpb.RateType_DMLInsert, request, float64(receiveSize))
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Add(float64(receiveSize))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	ut := &upsertTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		req:       request,
+		insertMsg: &msgstream.InsertMsg{
+			BaseMsg: msgstream.BaseMsg{
+				HashValues: request.HashKeys,
+			},
+			InsertRequest: internalpb.InsertRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Insert),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				NumRows:        uint64(request.NumRows),
+				Version:        internalpb.InsertDataVersion_ColumnBased,
+			},
+		},
+		deleteMsg: &msgstream.DeleteMsg{
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+			},
+		},
+		idAllocator:   node.rowIDAllocator,
+		segIDAssigner: node.segAssigner,
+		chMgr:         node.chMgr,
+		chTicker:      node.chTicker,
+	}
+
+	if len(ut.insertMsg.PartitionName) <= 0 {
+		ut.insertMsg.PartitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+	}
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("len(FieldsData)", len(request.FieldsData)),
+		zap.Int("len(HashKeys)", len(request.HashKeys)),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Warn("Failed to enqueue upsert task: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Uint64("BeginTS", ut.BeginTs()),
+		zap.Uint64("EndTS", ut.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Warn("Failed to execute upsert task in task scheduler: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		ut.result.ErrIndex = errIndex
+	}
+
+	// UpsertCnt always equals to the number of entities in the request
+	ut.result.UpsertCnt = int64(request.NumRows)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ut.result, nil
+}
+
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	receiveSize := proto.Size(request)
 	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Add(float64(receiveSize))
 
 	rateCol.Add(internalpb.RateType_DQLSearch.String(), float64(request.GetNq()))
+	addCollectionRate(ctx, internalpb.RateType_DQLSearch, request.GetDbName(), request.GetCollectionName(), float64(request.GetNq()))
 
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
@@ -2240,7 +2583,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		metrics.TotalLabel).Inc()
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
-	defer sp.Finish()
+	defer sp.End()
 
 	qt := &searchTask{
 		ctx:       ctx,
@@ -2349,7 +2692,7 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Flush")
-	defer sp.Finish()
+	defer sp.End()
 
 	ft := &flushTask{
 		ctx:          ctx,
@@ -2415,6 +2758,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Add(float64(receiveSize))
 
 	rateCol.Add(internalpb.RateType_DQLQuery.String(), 1)
+	addCollectionRate(ctx, internalpb.RateType_DQLQuery, request.GetDbName(), request.GetCollectionName(), 1)
 
 	if !node.checkHealthy() {
 		return &milvuspb.QueryResults{
@@ -2423,7 +2767,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Query")
-	defer sp.Finish()
+	defer sp.End()
 	tr := timerecord.NewTimeRecorder("Query")
 
 	qt := &queryTask{
@@ -2498,6 +2842,10 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.QueryLabel).Observe(float64(span.Milliseconds()))
 
+	if qt.queryParams.iterator {
+		sendQueryCursor(ctx, qt.nextCursor)
+	}
+
 	log.Debug(rpcDone(method))
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -2525,7 +2873,7 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateAlias")
-	defer sp.Finish()
+	defer sp.End()
 
 	cat := &CreateAliasTask{
 		ctx:                ctx,
@@ -2595,7 +2943,7 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropAlias")
-	defer sp.Finish()
+	defer sp.End()
 
 	dat := &DropAliasTask{
 		ctx:              ctx,
@@ -2664,7 +3012,7 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterAlias")
-	defer sp.Finish()
+	defer sp.End()
 
 	aat := &AlterAliasTask{
 		ctx:               ctx,
@@ -2727,6 +3075,76 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
+// RenameCollection renames a collection, the collection id and the aliases of the collection are kept.
+func (node *Proxy) RenameCollection(ctx context.Context, request *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RenameCollection")
+	defer sp.End()
+
+	rct := &renameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	method := "RenameCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(rct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()))
+
+	if err := rct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", rct.BeginTs()),
+			zap.Uint64("EndTs", rct.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return rct.result, nil
+}
+
 // CalcDistance calculates the distances between vectors.
 func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	if !node.checkHealthy() {
@@ -2736,7 +3154,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
@@ -2825,7 +3243,7 @@ func (node *Proxy) GetDdChannel(ctx context.Context, request *internalpb.GetDdCh
 // GetPersistentSegmentInfo get the information of sealed segment.
 func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetPersistentSegmentInfo")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -2849,7 +3267,7 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		metrics.TotalLabel).Inc()
 
 	// list segments
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
 	if err != nil {
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		resp.Status.Reason = fmt.Errorf("getCollectionID failed, err:%w", err).Error()
@@ -2915,7 +3333,7 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 // GetQuerySegmentInfo gets segment information from QueryCoord.
 func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetQuerySegmentInfo")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -2939,7 +3357,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.CollectionName)
 	if err != nil {
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		resp.Status.Reason = err.Error()
@@ -3002,7 +3420,7 @@ func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milv
 	drt, err := parseDummyRequestType(req.RequestType)
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Dummy")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3048,7 +3466,7 @@ func (node *Proxy) RegisterLink(ctx context.Context, req *milvuspb.RegisterLinkR
 	code := node.stateCode.Load().(commonpb.StateCode)
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RegisterLink")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
@@ -3079,7 +3497,7 @@ func (node *Proxy) RegisterLink(ctx context.Context, req *milvuspb.RegisterLinkR
 // TODO(dragondriver): cache the Metrics and set a retention to the cache
 func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetMetrics")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3166,7 +3584,7 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 // because it only obtains the metrics of Proxy, not including the topological metrics of Query cluster and Data cluster.
 func (node *Proxy) GetProxyMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetProxyMetrics")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.Int64("node_id", paramtable.GetNodeID()),
@@ -3237,7 +3655,7 @@ func (node *Proxy) GetProxyMetrics(ctx context.Context, req *milvuspb.GetMetrics
 // LoadBalance would do a load balancing operation between query nodes
 func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-LoadBalance")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3253,7 +3671,7 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, req.GetCollectionName())
 	if err != nil {
 		log.Warn("failed to get collection id",
 			zap.String("collection name", req.GetCollectionName()),
@@ -3296,7 +3714,7 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 // GetReplicas gets replica info
 func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetReplicas")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3331,7 +3749,7 @@ func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReq
 // GetCompactionState gets the compaction state of multiple segments
 func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetCompactionState")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.Int64("compactionID", req.GetCompactionID()))
@@ -3353,7 +3771,7 @@ func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetComp
 // ManualCompaction invokes compaction on specified collection
 func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ManualCompaction")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()))
@@ -3375,7 +3793,7 @@ func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCom
 // GetCompactionStateWithPlans returns the compactions states with the given plan ID
 func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetCompactionStateWithPlans")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.Int64("compactionID", req.GetCompactionID()))
@@ -3397,7 +3815,7 @@ func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvusp
 // GetFlushState gets the flush state of multiple segments
 func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetFlushState")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3444,7 +3862,7 @@ func unhealthyStatus() *commonpb.Status {
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Import")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3496,7 +3914,7 @@ func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*mi
 // GetImportState checks import task state from RootCoord.
 func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetImportState")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3533,7 +3951,7 @@ func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportSt
 // ListImportTasks get id array of all import tasks from rootcoord
 func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListImportTasks")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3565,12 +3983,102 @@ func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImport
 	return resp, err
 }
 
+// Export writes the entities of a collection visible at a timestamp to files on MinIO/S3 storage.
+// The export is executed asynchronously by RootCoord, use GetExportState to check the progress.
+func (node *Proxy) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Export")
+	defer sp.End()
+
+	log := log.Ctx(ctx)
+
+	log.Info("received export request",
+		zap.String("database", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()),
+		zap.String("path", req.GetPath()),
+		zap.String("format", req.GetFormat()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	resp := &proxypb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		log.Error("failed to execute export request", zap.Error(err))
+		resp.Status.ErrorCode = commonpb.ErrorCode_IllegalCollectionName
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	method := "Export"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	respFromRC, err := node.rootCoord.Export(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute export request", zap.Error(err))
+		resp.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return respFromRC, nil
+}
+
+// GetExportState checks export task state from RootCoord.
+func (node *Proxy) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetExportState")
+	defer sp.End()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received get export state request",
+		zap.Int64("taskID", req.GetTaskId()))
+	resp := &proxypb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	method := "GetExportState"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	respFromRC, err := node.rootCoord.GetExportState(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute get export state", zap.Error(err))
+		resp.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("successfully received get export state response",
+		zap.Int64("taskID", req.GetTaskId()),
+		zap.String("state", respFromRC.GetState().String()))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return respFromRC, nil
+}
+
 // InvalidateCredentialCache invalidate the credential cache of specified username.
 func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-InvalidateCredentialCache")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
@@ -3598,7 +4106,7 @@ func (node *Proxy) UpdateCredentialCache(ctx context.Context, request *proxypb.U
 	ctx = logutil.WithModule(ctx, moduleName)
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-UpdateCredentialCache")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
@@ -3626,7 +4134,7 @@ func (node *Proxy) UpdateCredentialCache(ctx context.Context, request *proxypb.U
 
 func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateCredential")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("username", req.Username))
@@ -3690,7 +4198,7 @@ func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCre
 
 func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-UpdateCredential")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("username", req.Username))
@@ -3763,7 +4271,7 @@ func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCre
 
 func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DeleteCredential")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("username", req.Username))
@@ -3794,7 +4302,7 @@ func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCre
 
 func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListCredUsers")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole))
@@ -3827,7 +4335,7 @@ func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUser
 
 func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateRole")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3862,7 +4370,7 @@ func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleReque
 
 func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropRole")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3899,7 +4407,7 @@ func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest)
 
 func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-OperateUserRole")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3935,7 +4443,7 @@ func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUse
 
 func (node *Proxy) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-SelectRole")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -3971,7 +4479,7 @@ func (node *Proxy) SelectRole(ctx context.Context, req *milvuspb.SelectRoleReque
 
 func (node *Proxy) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-SelectUser")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -4040,7 +4548,7 @@ func (node *Proxy) validPrivilegeParams(req *milvuspb.OperatePrivilegeRequest) e
 
 func (node *Proxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-OperatePrivilege")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -4103,7 +4611,7 @@ func (node *Proxy) validGrantParams(req *milvuspb.SelectGrantRequest) error {
 
 func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-SelectGrant")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -4138,7 +4646,7 @@ func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantReq
 
 func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RefreshPolicyInfoCache")
-	defer sp.Finish()
+	defer sp.End()
 
 	log := log.Ctx(ctx)
 
@@ -4179,8 +4687,7 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 		return resp, nil
 	}
 
-	err := node.multiRateLimiter.globalRateLimiter.setRates(request.GetRates())
-	// TODO: set multiple rate limiter rates
+	err := node.multiRateLimiter.SetRates(request)
 	if err != nil {
 		resp.Reason = err.Error()
 		return resp, nil
@@ -4207,7 +4714,7 @@ func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealt
 		defer mu.Unlock()
 
 		sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RefreshPolicyInfoCache")
-		defer sp.Finish()
+		defer sp.End()
 
 		log := log.Ctx(ctx).With(zap.String("role", role))
 
@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
 	"github.com/stretchr/testify/assert"
@@ -239,24 +240,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, rootCoord.GetAccessCount(), 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection1")
 	assert.Equal(t, rootCoord.GetAccessCount(), 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection2")
 	assert.Equal(t, rootCoord.GetAccessCount(), 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection2")
 	assert.Equal(t, rootCoord.GetAccessCount(), 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -265,11 +266,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection1")
 	assert.Equal(t, rootCoord.GetAccessCount(), 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection1")
 	assert.Equal(t, rootCoord.GetAccessCount(), 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -288,13 +289,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	rootCoord.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	rootCoord.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -318,10 +319,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -334,16 +335,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -362,7 +363,7 @@ func TestMetaCache_ConcurrentTest1(t *testing.T) {
 		defer wg.Done()
 		for i := 0; i < cnt; i++ {
 			//GetCollectionSchema will never fail
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, util.DefaultDBName, "collection1")
 			assert.Nil(t, err)
 			assert.Equal(t, schema, &schemapb.CollectionSchema{
 				AutoID: true,
@@ -376,7 +377,7 @@ func TestMetaCache_ConcurrentTest1(t *testing.T) {
 		defer wg.Done()
 		for i := 0; i < cnt; i++ {
 			//GetPartitions may fail
-			globalMetaCache.GetPartitions(ctx, "collection1")
+			globalMetaCache.GetPartitions(ctx, util.DefaultDBName, "collection1")
 			time.Sleep(10 * time.Millisecond)
 		}
 	}
@@ -385,7 +386,7 @@ func TestMetaCache_ConcurrentTest1(t *testing.T) {
 		defer wg.Done()
 		for i := 0; i < cnt; i++ {
 			//periodically invalid collection cache
-			globalMetaCache.RemoveCollection(ctx, "collection1")
+			globalMetaCache.RemoveCollection(ctx, util.DefaultDBName, "collection1")
 			time.Sleep(10 * time.Millisecond)
 		}
 	}
@@ -410,24 +411,24 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, util.DefaultDBName, "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, util.DefaultDBName, "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
@@ -450,21 +451,21 @@ func TestMetaCache_GetShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("No collection in meta cache", func(t *testing.T) {
-		shards, err := globalMetaCache.GetShards(ctx, true, "non-exists")
+		shards, err := globalMetaCache.GetShards(ctx, true, util.DefaultDBName, "non-exists")
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info invalid shardLeaders", func(t *testing.T) {
 		qc.validShardLeaders = false
-		shards, err := globalMetaCache.GetShards(ctx, false, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, false, util.DefaultDBName, collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info", func(t *testing.T) {
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, util.DefaultDBName, collectionName)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
 		assert.Equal(t, 1, len(shards))
@@ -472,7 +473,7 @@ func TestMetaCache_GetShards(t *testing.T) {
 
 		// get from cache
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, util.DefaultDBName, collectionName)
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
@@ -498,26 +499,26 @@ func TestMetaCache_ClearShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("Clear with no collection info", func(t *testing.T) {
-		globalMetaCache.ClearShards("collection_not_exist")
+		globalMetaCache.ClearShards(util.DefaultDBName, "collection_not_exist")
 	})
 
 	t.Run("Clear valid collection empty cache", func(t *testing.T) {
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards(util.DefaultDBName, collectionName)
 	})
 
 	t.Run("Clear valid collection valid cache", func(t *testing.T) {
 
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, util.DefaultDBName, collectionName)
 		require.NoError(t, err)
 		require.NotEmpty(t, shards)
 		require.Equal(t, 1, len(shards))
 		require.Equal(t, 3, len(shards["channel-1"]))
 
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards(util.DefaultDBName, collectionName)
 
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, util.DefaultDBName, collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
@@ -614,7 +615,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	assert.Nil(t, err)
 
 	t.Run("test IsCollectionLoaded", func(t *testing.T) {
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// no collectionInfo of collection1, should access RootCoord
@@ -622,7 +623,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		// not loaded, should access QueryCoord
 		assert.Equal(t, queryCoord.GetAccessCount(), 1)
 
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// shouldn't access QueryCoord or RootCoord again
@@ -630,7 +631,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		assert.Equal(t, queryCoord.GetAccessCount(), 1)
 
 		// test collection2 not fully loaded
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection2")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection2")
 		assert.NoError(t, err)
 		assert.False(t, info.isLoaded)
 		// no collectionInfo of collection2, should access RootCoord
@@ -640,8 +641,8 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	})
 
 	t.Run("test RemoveCollectionLoadCache", func(t *testing.T) {
-		globalMetaCache.RemoveCollection(ctx, "collection1")
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		globalMetaCache.RemoveCollection(ctx, util.DefaultDBName, "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// should access QueryCoord
@@ -657,21 +658,21 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, shardMgr)
 	assert.Nil(t, err)
 
-	info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err := globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// no collectionInfo of collection1, should access RootCoord
 	assert.Equal(t, rootCoord.GetAccessCount(), 1)
 
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
 	assert.Equal(t, rootCoord.GetAccessCount(), 1)
 
-	globalMetaCache.RemoveCollection(ctx, "collection1")
+	globalMetaCache.RemoveCollection(ctx, util.DefaultDBName, "collection1")
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
@@ -679,9 +680,46 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 
 	globalMetaCache.RemoveCollectionsByID(ctx, UniqueID(1))
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, util.DefaultDBName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
 	assert.Equal(t, rootCoord.GetAccessCount(), 3)
 }
+
+func TestMetaCache_Database(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	shardMgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, queryCoord, shardMgr)
+	assert.Nil(t, err)
+
+	// the empty database name refers to the default database
+	_, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 1)
+	_, err = globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 1)
+
+	// the collection with the same name in another database is cached separately
+	_, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 2)
+
+	globalMetaCache.RemoveCollection(ctx, "db1", "collection1")
+	_, err = globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 2)
+	_, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 3)
+
+	// remove by id works for all the databases
+	names := globalMetaCache.RemoveCollectionsByID(ctx, UniqueID(1))
+	assert.ElementsMatch(t, []string{"collection1", "collection1"}, names)
+	_, err = globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, "collection1")
+	assert.NoError(t, err)
+	assert.Equal(t, rootCoord.GetAccessCount(), 4)
+}
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"strconv"
 	"sync"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -56,12 +57,80 @@ func getQuotaMetrics() (*metricsinfo.ProxyQuotaMetrics, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	collectionRms := make(map[int64][]metricsinfo.RateMetric)
+	partitionRms := make(map[int64]map[int64][]metricsinfo.RateMetric)
+	for _, label := range rateCol.Labels() {
+		rt, subLabel, ok := ratelimitutil.SplitSubLabel(label)
+		if !ok {
+			continue
+		}
+		collectionLabel, partitionLabel, isPartition := ratelimitutil.SplitSubLabel(subLabel)
+		collectionID, err := strconv.ParseInt(collectionLabel, 10, 64)
+		if err != nil {
+			continue
+		}
+		var partitionID int64
+		if isPartition {
+			partitionID, err = strconv.ParseInt(partitionLabel, 10, 64)
+			if err != nil {
+				continue
+			}
+		}
+		rate, err := rateCol.Rate(label, ratelimitutil.DefaultAvgDuration)
+		if err != nil {
+			return nil, err
+		}
+		rm := metricsinfo.RateMetric{
+			Label: rt,
+			Rate:  rate,
+		}
+		if !isPartition {
+			collectionRms[collectionID] = append(collectionRms[collectionID], rm)
+			continue
+		}
+		if _, ok := partitionRms[collectionID]; !ok {
+			partitionRms[collectionID] = make(map[int64][]metricsinfo.RateMetric)
+		}
+		partitionRms[collectionID][partitionID] = append(partitionRms[collectionID][partitionID], rm)
+	}
 	return &metricsinfo.ProxyQuotaMetrics{
-		Hms: metricsinfo.HardwareMetrics{},
-		Rms: rms,
+		Hms:           metricsinfo.HardwareMetrics{},
+		Rms:           rms,
+		CollectionRms: collectionRms,
+		PartitionRms:  partitionRms,
 	}, nil
 }
 
+// addCollectionRate records the value of rate type for the collection, the rate is reported to
+// QuotaCenter to calculate the rates of the collection.
+func addCollectionRate(ctx context.Context, rt internalpb.RateType, database, collectionName string, value float64) {
+	if globalMetaCache == nil || collectionName == "" {
+		return
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return
+	}
+	label := ratelimitutil.FormatSubLabel(rt.String(), strconv.FormatInt(collectionID, 10))
+	rateCol.Register(label)
+	rateCol.Add(label, value)
+}
+
+// addPartitionRate records the value of rate type for the partition the dml request writes into,
+// the rate is reported to QuotaCenter to calculate the rates of the partition.
+func addPartitionRate(ctx context.Context, rt internalpb.RateType, req interface{}, value float64) {
+	collectionID := getCollectionIDOfRequest(ctx, req)
+	partitionID := getPartitionIDOfRequest(ctx, req)
+	if collectionID == 0 || partitionID == 0 {
+		return
+	}
+	label := ratelimitutil.FormatSubLabel(rt.String(),
+		ratelimitutil.FormatSubLabel(strconv.FormatInt(collectionID, 10), strconv.FormatInt(partitionID, 10)))
+	rateCol.Register(label)
+	rateCol.Add(label, value)
+}
+
 // getProxyMetrics get metrics of Proxy, not including the topological metrics of Query cluster and Data cluster.
 func getProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest, node *Proxy) (*milvuspb.GetMetricsResponse, error) {
 	totalMem := hardware.GetMemoryCount()
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
@@ -288,3 +289,40 @@ func TestProxy_metrics(t *testing.T) {
 	dc.getMetricsFunc = nil
 	ic.getMetricsFunc = nil
 }
+
+func TestProxy_getQuotaMetrics(t *testing.T) {
+	node := &Proxy{}
+	err := node.initRateCollector()
+	assert.NoError(t, err)
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
+		return 100, nil
+	})
+	mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		return 1000, nil
+	})
+	globalMetaCache = mockCache
+
+	addCollectionRate(context.Background(), internalpb.RateType_DMLInsert, "", "coll", 1024)
+	addCollectionRate(context.Background(), internalpb.RateType_DQLSearch, "", "coll", 10)
+	addPartitionRate(context.Background(), internalpb.RateType_DMLInsert, &milvuspb.InsertRequest{CollectionName: "coll"}, 1024)
+	addPartitionRate(context.Background(), internalpb.RateType_DMLDelete, &milvuspb.DeleteRequest{CollectionName: "coll"}, 1024)
+	quotaMetrics, err := getQuotaMetrics()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(quotaMetrics.Rms))
+	assert.Equal(t, 1, len(quotaMetrics.CollectionRms))
+	labels := make([]string, 0)
+	for _, rm := range quotaMetrics.CollectionRms[100] {
+		labels = append(labels, rm.Label)
+	}
+	assert.ElementsMatch(t, []string{internalpb.RateType_DMLInsert.String(), internalpb.RateType_DQLSearch.String()}, labels)
+
+	// delete request without partition name doesn't write into a single partition
+	assert.Equal(t, 1, len(quotaMetrics.PartitionRms))
+	assert.Equal(t, 1, len(quotaMetrics.PartitionRms[100]))
+	assert.Equal(t, 1, len(quotaMetrics.PartitionRms[100][1000]))
+	assert.Equal(t, internalpb.RateType_DMLInsert.String(), quotaMetrics.PartitionRms[100][1000][0].Label)
+}
@@ -7,11 +7,12 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-type getCollectionIDFunc func(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-type getCollectionInfoFunc func(ctx context.Context, collectionName string) (*collectionInfo, error)
+type getCollectionIDFunc func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error)
+type getCollectionSchemaFunc func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error)
+type getCollectionInfoFunc func(ctx context.Context, database, collectionName string) (*collectionInfo, error)
 type getUserRoleFunc func(username string) []string
-type getPartitionIDFunc func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+type getPartitionIDFunc func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error)
+type getPartitionsFunc func(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
 
 type mockCache struct {
 	Cache
@@ -20,39 +21,47 @@ type mockCache struct {
 	getInfoFunc        getCollectionInfoFunc
 	getUserRoleFunc    getUserRoleFunc
 	getPartitionIDFunc getPartitionIDFunc
+	getPartitionsFunc  getPartitionsFunc
 }
 
-func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *mockCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 	if m.getIDFunc != nil {
-		return m.getIDFunc(ctx, collectionName)
+		return m.getIDFunc(ctx, database, collectionName)
 	}
 	return 0, nil
 }
 
-func (m *mockCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *mockCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 	if m.getSchemaFunc != nil {
-		return m.getSchemaFunc(ctx, collectionName)
+		return m.getSchemaFunc(ctx, database, collectionName)
 	}
 	return nil, nil
 }
 
-func (m *mockCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *mockCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 	if m.getInfoFunc != nil {
-		return m.getInfoFunc(ctx, collectionName)
+		return m.getInfoFunc(ctx, database, collectionName)
 	}
 	return nil, nil
 }
 
-func (m *mockCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *mockCache) RemoveCollection(ctx context.Context, database, collectionName string) {
 }
 
-func (m *mockCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+func (m *mockCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
 	if m.getPartitionIDFunc != nil {
-		return m.getPartitionIDFunc(ctx, collectionName, partitionName)
+		return m.getPartitionIDFunc(ctx, database, collectionName, partitionName)
 	}
 	return 0, nil
 }
 
+func (m *mockCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	if m.getPartitionsFunc != nil {
+		return m.getPartitionsFunc(ctx, database, collectionName)
+	}
+	return nil, nil
+}
+
 func (m *mockCache) GetUserRole(username string) []string {
 	if m.getUserRoleFunc != nil {
 		return m.getUserRoleFunc(username)
@@ -76,6 +85,10 @@ func (m *mockCache) setGetPartitionIDFunc(f getPartitionIDFunc) {
 	m.getPartitionIDFunc = f
 }
 
+func (m *mockCache) setGetPartitionsFunc(f getPartitionsFunc) {
+	m.getPartitionsFunc = f
+}
+
 func newMockCache() *mockCache {
 	return &mockCache{}
 }
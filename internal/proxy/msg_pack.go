@@ -32,7 +32,10 @@ import (
 	"go.uber.org/zap"
 )
 
-func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result *milvuspb.MutationResult, channelNames []string, idAllocator *allocator.IDAllocator, segIDAssigner *segIDAssigner) (*msgstream.MsgPack, error) {
+// assignSegmentID assigns segments for the insert data and repacks them into insert messages by segment.
+// rowPartitionIDs holds the partition of every row if the rows are hashed into partitions by the partition key,
+// all the rows belong to insertMsg.PartitionID if it's nil.
+func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, rowPartitionIDs []UniqueID, result *milvuspb.MutationResult, channelNames []string, idAllocator *allocator.IDAllocator, segIDAssigner *segIDAssigner) (*msgstream.MsgPack, error) {
 	threshold := Params.PulsarCfg.MaxMessageSize.GetAsInt()
 	log.Debug("assign segmentid", zap.Int("threshold", threshold))
 
@@ -47,16 +50,20 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 	}
 	insertMsg.HashValues = typeutil.HashPK2Channels(result.IDs, channelNames)
 	// groupedHashKeys represents the dmChannel index
-	channel2RowOffsets := make(map[string][]int)  //   channelName to count
-	channelMaxTSMap := make(map[string]Timestamp) //  channelName to max Timestamp
+	channel2RowOffsets := make(map[string]map[UniqueID][]int) //   channelName to partitionID to row offsets
+	channelMaxTSMap := make(map[string]Timestamp)             //  channelName to max Timestamp
 
 	// assert len(it.hashValues) < maxInt
 	for offset, channelID := range insertMsg.HashValues {
 		channelName := channelNames[channelID]
+		partitionID := insertMsg.PartitionID
+		if rowPartitionIDs != nil {
+			partitionID = rowPartitionIDs[offset]
+		}
 		if _, ok := channel2RowOffsets[channelName]; !ok {
-			channel2RowOffsets[channelName] = []int{}
+			channel2RowOffsets[channelName] = make(map[UniqueID][]int)
 		}
-		channel2RowOffsets[channelName] = append(channel2RowOffsets[channelName], offset)
+		channel2RowOffsets[channelName][partitionID] = append(channel2RowOffsets[channelName][partitionID], offset)
 
 		if _, ok := channelMaxTSMap[channelName]; !ok {
 			channelMaxTSMap[channelName] = typeutil.ZeroTimestamp
@@ -90,7 +97,7 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 	}
 
 	// create empty insert message
-	createInsertMsg := func(segmentID UniqueID, channelName string, msgID int64) *msgstream.InsertMsg {
+	createInsertMsg := func(segmentID UniqueID, partitionID UniqueID, channelName string, msgID int64) *msgstream.InsertMsg {
 		insertReq := internalpb.InsertRequest{
 			Base: commonpbutil.NewMsgBase(
 				commonpbutil.WithMsgType(commonpb.MsgType_Insert),
@@ -99,7 +106,7 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 				commonpbutil.WithSourceID(insertMsg.Base.SourceID),
 			),
 			CollectionID:   insertMsg.CollectionID,
-			PartitionID:    insertMsg.PartitionID,
+			PartitionID:    partitionID,
 			CollectionName: insertMsg.CollectionName,
 			PartitionName:  insertMsg.PartitionName,
 			SegmentID:      segmentID,
@@ -119,14 +126,14 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 	}
 
 	// repack the row data corresponding to the offset to insertMsg
-	getInsertMsgsBySegmentID := func(segmentID UniqueID, rowOffsets []int, channelName string, maxMessageSize int) ([]msgstream.TsMsg, error) {
+	getInsertMsgsBySegmentID := func(segmentID UniqueID, partitionID UniqueID, rowOffsets []int, channelName string, maxMessageSize int) ([]msgstream.TsMsg, error) {
 		repackedMsgs := make([]msgstream.TsMsg, 0)
 		requestSize := 0
 		msgID, err := getMsgID()
 		if err != nil {
 			return nil, err
 		}
-		msg := createInsertMsg(segmentID, channelName, msgID)
+		msg := createInsertMsg(segmentID, partitionID, channelName, msgID)
 		for _, offset := range rowOffsets {
 			curRowMessageSize, err := typeutil.EstimateEntitySize(insertMsg.GetFieldsData(), offset)
 			if err != nil {
@@ -140,7 +147,7 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 				if err != nil {
 					return nil, err
 				}
-				msg = createInsertMsg(segmentID, channelName, msgID)
+				msg = createInsertMsg(segmentID, partitionID, channelName, msgID)
 				requestSize = 0
 			}
 
@@ -157,26 +164,29 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 	}
 
 	// get allocated segmentID info for every dmChannel and repack insertMsgs for every segmentID
-	for channelName, rowOffsets := range channel2RowOffsets {
-		assignedSegmentInfos, err := segIDAssigner.GetSegmentID(insertMsg.CollectionID, insertMsg.PartitionID, channelName, uint32(len(rowOffsets)), channelMaxTSMap[channelName])
-		if err != nil {
-			log.Error("allocate segmentID for insert data failed", zap.Int64("collectionID", insertMsg.CollectionID), zap.String("channel name", channelName),
-				zap.Int("allocate count", len(rowOffsets)),
-				zap.Error(err))
-			return nil, err
-		}
-
-		startPos := 0
-		for segmentID, count := range assignedSegmentInfos {
-			subRowOffsets := rowOffsets[startPos : startPos+int(count)]
-			insertMsgs, err := getInsertMsgsBySegmentID(segmentID, subRowOffsets, channelName, threshold)
+	for channelName, partition2RowOffsets := range channel2RowOffsets {
+		for partitionID, rowOffsets := range partition2RowOffsets {
+			assignedSegmentInfos, err := segIDAssigner.GetSegmentID(insertMsg.CollectionID, partitionID, channelName, uint32(len(rowOffsets)), channelMaxTSMap[channelName])
 			if err != nil {
-				log.Error("repack insert data to insert msgs failed", zap.Int64("collectionID", insertMsg.CollectionID),
+				log.Error("allocate segmentID for insert data failed", zap.Int64("collectionID", insertMsg.CollectionID),
+					zap.Int64("partitionID", partitionID), zap.String("channel name", channelName),
+					zap.Int("allocate count", len(rowOffsets)),
 					zap.Error(err))
 				return nil, err
 			}
-			msgPack.Msgs = append(msgPack.Msgs, insertMsgs...)
-			startPos += int(count)
+
+			startPos := 0
+			for segmentID, count := range assignedSegmentInfos {
+				subRowOffsets := rowOffsets[startPos : startPos+int(count)]
+				insertMsgs, err := getInsertMsgsBySegmentID(segmentID, partitionID, subRowOffsets, channelName, threshold)
+				if err != nil {
+					log.Error("repack insert data to insert msgs failed", zap.Int64("collectionID", insertMsg.CollectionID),
+						zap.Error(err))
+					return nil, err
+				}
+				msgPack.Msgs = append(msgPack.Msgs, insertMsgs...)
+				startPos += int(count)
+			}
 		}
 	}
 
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,32 +26,135 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 )
 
 // MultiRateLimiter includes multilevel rate limiters, such as global rateLimiter,
-// collection level rateLimiter and so on. It also implements Limiter interface.
+// collection level rateLimiter, partition level rateLimiter and so on. It also implements Limiter interface.
 type MultiRateLimiter struct {
 	globalRateLimiter *rateLimiter
-	// TODO: add collection level rateLimiter
+
+	collectionMu       sync.RWMutex
+	collectionLimiters map[int64]*rateLimiter
+
+	partitionMu       sync.RWMutex
+	partitionLimiters map[int64]*rateLimiter
 }
 
 // NewMultiRateLimiter returns a new MultiRateLimiter.
 func NewMultiRateLimiter() *MultiRateLimiter {
-	m := &MultiRateLimiter{}
+	m := &MultiRateLimiter{
+		collectionLimiters: make(map[int64]*rateLimiter),
+		partitionLimiters:  make(map[int64]*rateLimiter),
+	}
 	m.globalRateLimiter = newRateLimiter()
 	return m
 }
 
 // Limit returns true, the request will be rejected.
 // Otherwise, the request will pass. Limit also returns limit of limiter.
-func (m *MultiRateLimiter) Limit(rt internalpb.RateType, n int) (bool, float64) {
+// The request is checked by the rateLimiter of the collection first if collectionID is not 0,
+// then by the rateLimiter of the partition if partitionID is not 0, and by the global rateLimiter
+// at last. Tokens taken by the passed rateLimiters are given back if a later rateLimiter rejects
+// the request, so a rejected request never uses up any quota.
+func (m *MultiRateLimiter) Limit(collectionID int64, partitionID int64, rt internalpb.RateType, n int) (bool, float64) {
 	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		return false, 1 // no limit
 	}
-	// TODO: call other rate limiters
-	return m.globalRateLimiter.limit(rt, n)
+
+	limiters := make([]*rateLimiter, 0, 3)
+	if collectionID != 0 {
+		m.collectionMu.RLock()
+		if limiter, ok := m.collectionLimiters[collectionID]; ok {
+			limiters = append(limiters, limiter)
+		}
+		m.collectionMu.RUnlock()
+	}
+	if partitionID != 0 {
+		m.partitionMu.RLock()
+		if limiter, ok := m.partitionLimiters[partitionID]; ok {
+			limiters = append(limiters, limiter)
+		}
+		m.partitionMu.RUnlock()
+	}
+	limiters = append(limiters, m.globalRateLimiter)
+
+	var limit bool
+	var rate float64
+	for i, limiter := range limiters {
+		l, r := limiter.limit(rt, n)
+		if l || r == 0 {
+			for _, passed := range limiters[:i] {
+				passed.cancel(rt, n)
+			}
+			return l, r
+		}
+		if i == 0 {
+			limit, rate = l, r
+		}
+	}
+	return limit, rate
+}
+
+// SetRates sets the rates of the global rateLimiter, the collection level rateLimiters and the
+// partition level rateLimiters, rateLimiters of collections and partitions which are absent from
+// the request are removed.
+func (m *MultiRateLimiter) SetRates(request *proxypb.SetRatesRequest) error {
+	err := m.globalRateLimiter.setRates(request.GetRates())
+	if err != nil {
+		return err
+	}
+
+	collectionLimiters := make(map[int64]*rateLimiter, len(request.GetRatesPerCollection()))
+	m.collectionMu.RLock()
+	for _, collectionRate := range request.GetRatesPerCollection() {
+		collectionID := collectionRate.GetCollection()
+		limiter, ok := m.collectionLimiters[collectionID]
+		if !ok {
+			limiter = newUnlimitedRateLimiter()
+		}
+		collectionLimiters[collectionID] = limiter
+	}
+	m.collectionMu.RUnlock()
+
+	for _, collectionRate := range request.GetRatesPerCollection() {
+		err = collectionLimiters[collectionRate.GetCollection()].setLimits(collectionRate.GetRates())
+		if err != nil {
+			return err
+		}
+	}
+
+	partitionLimiters := make(map[int64]*rateLimiter, len(request.GetRatesPerPartition()))
+	m.partitionMu.RLock()
+	for _, partitionRate := range request.GetRatesPerPartition() {
+		partitionID := partitionRate.GetPartition()
+		limiter, ok := m.partitionLimiters[partitionID]
+		if !ok {
+			limiter = newUnlimitedRateLimiter()
+		}
+		partitionLimiters[partitionID] = limiter
+	}
+	m.partitionMu.RUnlock()
+
+	for _, partitionRate := range request.GetRatesPerPartition() {
+		err = partitionLimiters[partitionRate.GetPartition()].setLimits(partitionRate.GetRates())
+		if err != nil {
+			return err
+		}
+	}
+	log.Debug("RateLimiter setRates for collections and partitions",
+		zap.Any("collectionRates", request.GetRatesPerCollection()),
+		zap.Any("partitionRates", request.GetRatesPerPartition()))
+
+	m.collectionMu.Lock()
+	m.collectionLimiters = collectionLimiters
+	m.collectionMu.Unlock()
+	m.partitionMu.Lock()
+	m.partitionLimiters = partitionLimiters
+	m.partitionMu.Unlock()
+	return nil
 }
 
 // rateLimiter implements Limiter.
@@ -67,12 +171,29 @@ func newRateLimiter() *rateLimiter {
 	return rl
 }
 
+// newUnlimitedRateLimiter returns a new RateLimiter for a collection or a partition, all of its limiters
+// are unlimited until the rates are set by QuotaCenter.
+func newUnlimitedRateLimiter() *rateLimiter {
+	rl := &rateLimiter{
+		limiters: make(map[internalpb.RateType]*ratelimitutil.Limiter),
+	}
+	for rt := range internalpb.RateType_name {
+		rl.limiters[internalpb.RateType(rt)] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+	}
+	return rl
+}
+
 // limit returns true, the request will be rejected.
 // Otherwise, the request will pass.
 func (rl *rateLimiter) limit(rt internalpb.RateType, n int) (bool, float64) {
 	return !rl.limiters[rt].AllowN(time.Now(), n), float64(rl.limiters[rt].Limit())
 }
 
+// cancel gives back the tokens taken by a passed request.
+func (rl *rateLimiter) cancel(rt internalpb.RateType, n int) {
+	rl.limiters[rt].Cancel(n)
+}
+
 // setRates sets new rates for the limiters.
 func (rl *rateLimiter) setRates(rates []*internalpb.Rate) error {
 	for _, r := range rates {
@@ -87,6 +208,18 @@ func (rl *rateLimiter) setRates(rates []*internalpb.Rate) error {
 	return nil
 }
 
+// setLimits sets new rates for the limiters without reporting the rate metrics.
+func (rl *rateLimiter) setLimits(rates []*internalpb.Rate) error {
+	for _, r := range rates {
+		limiter, ok := rl.limiters[r.GetRt()]
+		if !ok {
+			return fmt.Errorf("unregister rateLimiter for rateType %s", r.GetRt().String())
+		}
+		limiter.SetLimit(ratelimitutil.Limit(r.GetR()))
+	}
+	return nil
+}
+
 // printRates logs the rate info.
 func (rl *rateLimiter) printRates(rates []*internalpb.Rate) {
 	//fmt.Printf("RateLimiter set rates:\n---------------------------------\n")
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 	"github.com/stretchr/testify/assert"
@@ -36,22 +37,202 @@ func TestMultiRateLimiter(t *testing.T) {
 			multiLimiter.globalRateLimiter.limiters[internalpb.RateType(rt)] = ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1)
 		}
 		for _, rt := range internalpb.RateType_value {
-			ok, _ := multiLimiter.Limit(internalpb.RateType(rt), 1)
+			ok, _ := multiLimiter.Limit(0, 0, internalpb.RateType(rt), 1)
 			assert.False(t, ok)
-			ok, _ = multiLimiter.Limit(internalpb.RateType(rt), math.MaxInt)
+			ok, _ = multiLimiter.Limit(0, 0, internalpb.RateType(rt), math.MaxInt)
 			assert.False(t, ok)
-			ok, _ = multiLimiter.Limit(internalpb.RateType(rt), math.MaxInt)
+			ok, _ = multiLimiter.Limit(0, 0, internalpb.RateType(rt), math.MaxInt)
 			assert.True(t, ok)
 		}
 		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
 	})
 
+	t.Run("test collection rateLimiter", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		multiLimiter := NewMultiRateLimiter()
+		for _, rt := range internalpb.RateType_value {
+			multiLimiter.globalRateLimiter.limiters[internalpb.RateType(rt)] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+		}
+
+		// collection without rateLimiter is not limited
+		ok, _ := multiLimiter.Limit(100, 0, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.False(t, ok)
+
+		err := multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerCollection: []*proxypb.CollectionRate{
+				{
+					Collection: 100,
+					Rates: []*internalpb.Rate{
+						{Rt: internalpb.RateType_DMLInsert, R: 0},
+						{Rt: internalpb.RateType_DQLSearch, R: 1000},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		ok, r := multiLimiter.Limit(100, 0, internalpb.RateType_DMLInsert, 1)
+		assert.True(t, ok)
+		assert.Equal(t, float64(0), r)
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DQLSearch, 1)
+		assert.False(t, ok)
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DQLQuery, math.MaxInt)
+		assert.False(t, ok)
+		ok, _ = multiLimiter.Limit(200, 0, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+
+		// rateLimiter of collection absent from the request is removed
+		err = multiLimiter.SetRates(&proxypb.SetRatesRequest{})
+		assert.NoError(t, err)
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+
+		// illegal rate type
+		err = multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerCollection: []*proxypb.CollectionRate{
+				{Collection: 100, Rates: []*internalpb.Rate{{Rt: internalpb.RateType(-1), R: 0}}},
+			},
+		})
+		assert.Error(t, err)
+		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+	})
+
+	t.Run("rejected request takes no quota", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DMLInsert] = ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1)
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DQLSearch] = ratelimitutil.NewLimiter(0, 0)
+		err := multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerCollection: []*proxypb.CollectionRate{
+				{
+					Collection: 100,
+					Rates: []*internalpb.Rate{
+						{Rt: internalpb.RateType_DMLInsert, R: 0},
+						{Rt: internalpb.RateType_DQLSearch, R: 1000},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		// rejected by the collection rateLimiter, the global tokens are not taken
+		ok, _ := multiLimiter.Limit(100, 0, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.True(t, ok)
+		ok, _ = multiLimiter.Limit(0, 0, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+
+		// rejected by the global rateLimiter, the collection tokens are given back
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DQLSearch, 1000000)
+		assert.True(t, ok)
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DQLSearch] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DQLSearch, 1)
+		assert.False(t, ok)
+		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+	})
+
+	t.Run("test partition rateLimiter", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		multiLimiter := NewMultiRateLimiter()
+		for _, rt := range internalpb.RateType_value {
+			multiLimiter.globalRateLimiter.limiters[internalpb.RateType(rt)] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+		}
+
+		// partition without rateLimiter is not limited
+		ok, _ := multiLimiter.Limit(100, 1000, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.False(t, ok)
+
+		err := multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerPartition: []*proxypb.PartitionRate{
+				{
+					Collection: 100,
+					Partition:  1000,
+					Rates: []*internalpb.Rate{
+						{Rt: internalpb.RateType_DMLInsert, R: 0},
+						{Rt: internalpb.RateType_DMLDelete, R: 1000},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		ok, r := multiLimiter.Limit(100, 1000, internalpb.RateType_DMLInsert, 1)
+		assert.True(t, ok)
+		assert.Equal(t, float64(0), r)
+		ok, _ = multiLimiter.Limit(100, 1000, internalpb.RateType_DMLDelete, 1)
+		assert.False(t, ok)
+		ok, _ = multiLimiter.Limit(100, 1001, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+		ok, _ = multiLimiter.Limit(100, 0, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+
+		// rateLimiter of partition absent from the request is removed
+		err = multiLimiter.SetRates(&proxypb.SetRatesRequest{})
+		assert.NoError(t, err)
+		ok, _ = multiLimiter.Limit(100, 1000, internalpb.RateType_DMLInsert, 1)
+		assert.False(t, ok)
+
+		// illegal rate type
+		err = multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerPartition: []*proxypb.PartitionRate{
+				{Collection: 100, Partition: 1000, Rates: []*internalpb.Rate{{Rt: internalpb.RateType(-1), R: 0}}},
+			},
+		})
+		assert.Error(t, err)
+		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+	})
+
+	t.Run("partition rateLimiter is checked after the collection one", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DMLInsert] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DMLDelete] = ratelimitutil.NewLimiter(0, 0)
+		err := multiLimiter.SetRates(&proxypb.SetRatesRequest{
+			RatesPerCollection: []*proxypb.CollectionRate{
+				{
+					Collection: 100,
+					Rates: []*internalpb.Rate{
+						{Rt: internalpb.RateType_DMLInsert, R: 1000},
+						{Rt: internalpb.RateType_DMLDelete, R: 1000},
+					},
+				},
+			},
+			RatesPerPartition: []*proxypb.PartitionRate{
+				{
+					Collection: 100,
+					Partition:  1000,
+					Rates: []*internalpb.Rate{
+						{Rt: internalpb.RateType_DMLInsert, R: 0},
+						{Rt: internalpb.RateType_DMLDelete, R: 1000},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		// rejected by the partition rateLimiter, the collection tokens are given back
+		ok, r := multiLimiter.Limit(100, 1000, internalpb.RateType_DMLInsert, 1000)
+		assert.True(t, ok)
+		assert.Equal(t, float64(0), r)
+		ok, _ = multiLimiter.Limit(100, 1001, internalpb.RateType_DMLInsert, 1000)
+		assert.False(t, ok)
+
+		// rejected by the global rateLimiter, the collection and partition tokens are given back
+		ok, _ = multiLimiter.Limit(100, 1000, internalpb.RateType_DMLDelete, 1000)
+		assert.True(t, ok)
+		multiLimiter.globalRateLimiter.limiters[internalpb.RateType_DMLDelete] = ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+		ok, _ = multiLimiter.Limit(100, 1000, internalpb.RateType_DMLDelete, 1000)
+		assert.False(t, ok)
+		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+	})
+
 	t.Run("not enable quotaAndLimit", func(t *testing.T) {
 		multiLimiter := NewMultiRateLimiter()
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
 		for _, rt := range internalpb.RateType_value {
-			ok, r := multiLimiter.Limit(internalpb.RateType(rt), 1)
+			ok, r := multiLimiter.Limit(0, 0, internalpb.RateType(rt), 1)
 			assert.False(t, ok)
 			assert.NotEqual(t, float64(0), r)
 		}
@@ -65,7 +246,7 @@ func TestMultiRateLimiter(t *testing.T) {
 			multiLimiter := NewMultiRateLimiter()
 			bak := Params.QuotaConfig.QuotaAndLimitsEnabled
 			paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
-			ok, r := multiLimiter.Limit(internalpb.RateType_DMLInsert, 1*1024*1024)
+			ok, r := multiLimiter.Limit(0, 0, internalpb.RateType_DMLInsert, 1*1024*1024)
 			assert.False(t, ok)
 			assert.NotEqual(t, float64(0), r)
 			Params.QuotaConfig.QuotaAndLimitsEnabled = bak
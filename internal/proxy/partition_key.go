@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// isPartitionKeyMode returns true if the entities of the collection are hashed into hidden partitions by the partition key
+func isPartitionKeyMode(ctx context.Context, dbName string, collectionName string) (bool, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil {
+		return false, err
+	}
+	return typeutil.GetPartitionKeyField(schema) != nil, nil
+}
+
+var errPartitionNamesWithPartitionKey = errors.New("not support manually specifying the partition names if partition key mode is used")
+
+// validatePartitionKeyModePartitionName checks that no partition is specified for a partition key collection,
+// the default partition name is regarded as not specified since it's filled if the request doesn't specify one.
+func validatePartitionKeyModePartitionName(partitionName string) error {
+	if partitionName != "" && partitionName != Params.CommonCfg.DefaultPartitionName.GetValue() {
+		return errPartitionNamesWithPartitionKey
+	}
+	return nil
+}
+
+// getDefaultPartitionNames returns the hidden partition names of a partition key collection in the order of their
+// indexes, and the mapping from the partition names to the partition IDs.
+func getDefaultPartitionNames(ctx context.Context, dbName string, collectionName string) ([]string, map[string]UniqueID, error) {
+	partitions, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	partitionNames := make([]string, len(partitions))
+	for i := range partitionNames {
+		partitionNames[i] = fmt.Sprintf("%s_%d", Params.CommonCfg.DefaultPartitionName.GetValue(), i)
+		if _, ok := partitions[partitionNames[i]]; !ok {
+			return nil, nil, fmt.Errorf("partition %s of partition key collection %s not found", partitionNames[i], collectionName)
+		}
+	}
+	return partitionNames, partitions, nil
+}
+
+// getPartitionKeyFieldData returns the field data of the partition key field
+func getPartitionKeyFieldData(fieldsData []*schemapb.FieldData, partitionKeyField *schemapb.FieldSchema) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == partitionKeyField.GetFieldID() {
+			return fieldData, nil
+		}
+	}
+	return nil, fmt.Errorf("partition key field %s is not provided", partitionKeyField.GetName())
+}
+
+// assignPartitionKeys hashes the partition keys to the hidden partitions, returns the partition ID of every key.
+func assignPartitionKeys(ctx context.Context, dbName string, collectionName string, keys *schemapb.FieldData) ([]UniqueID, error) {
+	partitionNames, partitions, err := getDefaultPartitionNames(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	hashValues, err := typeutil.HashKey2Partitions(keys, partitionNames)
+	if err != nil {
+		return nil, err
+	}
+	partitionIDs := make([]UniqueID, len(hashValues))
+	for i, idx := range hashValues {
+		partitionIDs[i] = partitions[partitionNames[idx]]
+	}
+	return partitionIDs, nil
+}
+
+// getPartitionKeyValues returns the values of the partition key field pinned by the filter expression.
+// The second return value is false if the expression doesn't pin the partition key to a finite set of values.
+func getPartitionKeyValues(expr *planpb.Expr, fieldID int64) ([]*planpb.GenericValue, bool) {
+	isPartitionKey := func(info *planpb.ColumnInfo) bool {
+		return info.GetFieldId() == fieldID && len(info.GetNestedPath()) == 0
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		if isPartitionKey(e.TermExpr.GetColumnInfo()) {
+			return e.TermExpr.GetValues(), true
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		if isPartitionKey(e.UnaryRangeExpr.GetColumnInfo()) && e.UnaryRangeExpr.GetOp() == planpb.OpType_Equal {
+			return []*planpb.GenericValue{e.UnaryRangeExpr.GetValue()}, true
+		}
+	case *planpb.Expr_BinaryExpr:
+		leftValues, leftOk := getPartitionKeyValues(e.BinaryExpr.GetLeft(), fieldID)
+		rightValues, rightOk := getPartitionKeyValues(e.BinaryExpr.GetRight(), fieldID)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			// either side pinning the partition key is enough, the partitions of both sides are kept for simplicity
+			switch {
+			case leftOk && rightOk:
+				return append(append([]*planpb.GenericValue{}, leftValues...), rightValues...), true
+			case leftOk:
+				return leftValues, true
+			case rightOk:
+				return rightValues, true
+			}
+		case planpb.BinaryExpr_LogicalOr:
+			if leftOk && rightOk {
+				return append(append([]*planpb.GenericValue{}, leftValues...), rightValues...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// getPartitionIDsByExpr returns the hidden partitions which may contain the entities matching the filter expression,
+// nil is returned if the expression doesn't pin the partition key and all the partitions should be searched.
+func getPartitionIDsByExpr(ctx context.Context, dbName string, collectionName string, partitionKeyField *schemapb.FieldSchema, expr *planpb.Expr) ([]UniqueID, error) {
+	values, ok := getPartitionKeyValues(expr, partitionKeyField.GetFieldID())
+	if !ok {
+		return nil, nil
+	}
+
+	keys := &schemapb.FieldData{
+		Type:    partitionKeyField.GetDataType(),
+		FieldId: partitionKeyField.GetFieldID(),
+	}
+	switch partitionKeyField.GetDataType() {
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(values))
+		for _, value := range values {
+			data = append(data, value.GetInt64Val())
+		}
+		keys.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+			},
+		}
+	case schemapb.DataType_VarChar:
+		data := make([]string, 0, len(values))
+		for _, value := range values {
+			data = append(data, value.GetStringVal())
+		}
+		keys.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported partition key type: %s", partitionKeyField.GetDataType().String())
+	}
+
+	partitionIDs, err := assignPartitionKeys(ctx, dbName, collectionName, keys)
+	if err != nil {
+		return nil, err
+	}
+	return typeutil.NewUniqueSet(partitionIDs...).Collect(), nil
+}
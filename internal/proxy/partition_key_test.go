@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newPartitionKeyTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test_partition_key",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:  101,
+				Name:     "tenant",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "max_length", Value: "64"},
+					{Key: common.PartitionKeyKey, Value: "true"},
+				},
+			},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func newPartitionKeyTestCache(numPartitions int) *mockCache {
+	cache := newMockCache()
+	cache.setGetPartitionsFunc(func(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+		partitions := make(map[string]typeutil.UniqueID)
+		for i := 0; i < numPartitions; i++ {
+			partitions[fmt.Sprintf("%s_%d", Params.CommonCfg.DefaultPartitionName.GetValue(), i)] = int64(1000 + i)
+		}
+		return partitions, nil
+	})
+	cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+		return newPartitionKeyTestSchema(), nil
+	})
+	return cache
+}
+
+func TestGetPartitionKeyValues(t *testing.T) {
+	schema := newPartitionKeyTestSchema()
+	tests := []struct {
+		expr      string
+		pinned    bool
+		numValues int
+	}{
+		{`tenant == "a"`, true, 1},
+		{`tenant in ["a", "b", "c"]`, true, 3},
+		{`tenant == "a" && age > 10`, true, 1},
+		{`age > 10 && tenant in ["a", "b"]`, true, 2},
+		{`tenant == "a" || tenant == "b"`, true, 2},
+		{`tenant == "a" || age > 10`, false, 0},
+		{`tenant > "a"`, false, 0},
+		{`not (tenant == "a")`, false, 0},
+		{`age in [1, 2]`, false, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			plan, err := planparserv2.CreateRetrievePlan(schema, test.expr)
+			assert.NoError(t, err)
+			values, ok := getPartitionKeyValues(plan.GetPredicates(), 101)
+			assert.Equal(t, test.pinned, ok)
+			assert.Equal(t, test.numValues, len(values))
+		})
+	}
+}
+
+func TestAssignPartitionKeys(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	keys := &schemapb.FieldData{
+		Type:    schemapb.DataType_VarChar,
+		FieldId: 101,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: []string{"a", "b", "a", "c"}},
+				},
+			},
+		},
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		globalMetaCache = newPartitionKeyTestCache(4)
+		partitionIDs, err := assignPartitionKeys(ctx, "", "test_partition_key", keys)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(partitionIDs))
+		assert.Equal(t, partitionIDs[0], partitionIDs[2])
+		for _, partitionID := range partitionIDs {
+			assert.GreaterOrEqual(t, partitionID, int64(1000))
+			assert.Less(t, partitionID, int64(1004))
+		}
+	})
+
+	t.Run("partition missing", func(t *testing.T) {
+		mockCache := newMockCache()
+		mockCache.setGetPartitionsFunc(func(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+			return map[string]typeutil.UniqueID{"p0": 1, "p1": 2}, nil
+		})
+		globalMetaCache = mockCache
+		_, err := assignPartitionKeys(ctx, "", "test_partition_key", keys)
+		assert.Error(t, err)
+	})
+
+	t.Run("no partition key", func(t *testing.T) {
+		_, err := getPartitionKeyFieldData([]*schemapb.FieldData{{FieldId: 100}}, newPartitionKeyTestSchema().GetFields()[1])
+		assert.Error(t, err)
+		fieldData, err := getPartitionKeyFieldData([]*schemapb.FieldData{{FieldId: 100}, keys}, newPartitionKeyTestSchema().GetFields()[1])
+		assert.NoError(t, err)
+		assert.Equal(t, keys, fieldData)
+	})
+}
+
+func TestGetPartitionIDsByExpr(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	globalMetaCache = newPartitionKeyTestCache(16)
+
+	schema := newPartitionKeyTestSchema()
+	partitionKeyField := typeutil.GetPartitionKeyField(schema)
+
+	plan, err := planparserv2.CreateRetrievePlan(schema, `tenant in ["a", "a", "b"] && age > 10`)
+	assert.NoError(t, err)
+	partitionIDs, err := getPartitionIDsByExpr(ctx, "", schema.GetName(), partitionKeyField, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(partitionIDs), 2)
+	assert.NotEmpty(t, partitionIDs)
+
+	// the entities of the same key are inserted into the pruned partition
+	keys := &schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+			},
+		},
+	}
+	insertPartitionIDs, err := assignPartitionKeys(ctx, "", schema.GetName(), keys)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, typeutil.NewUniqueSet(insertPartitionIDs...).Collect(), partitionIDs)
+
+	plan, err = planparserv2.CreateRetrievePlan(schema, `age > 10`)
+	assert.NoError(t, err)
+	partitionIDs, err = getPartitionIDsByExpr(ctx, "", schema.GetName(), partitionKeyField, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+}
+
+func TestPartitionKeyModeValidation(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	globalMetaCache = newPartitionKeyTestCache(4)
+	partitionKeyMode, err := isPartitionKeyMode(ctx, "", "test_partition_key")
+	assert.NoError(t, err)
+	assert.True(t, partitionKeyMode)
+
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{}, nil
+	})
+	globalMetaCache = mockCache
+	partitionKeyMode, err = isPartitionKeyMode(ctx, "", "test")
+	assert.NoError(t, err)
+	assert.False(t, partitionKeyMode)
+
+	assert.NoError(t, validatePartitionKeyModePartitionName(""))
+	assert.NoError(t, validatePartitionKeyModePartitionName(Params.CommonCfg.DefaultPartitionName.GetValue()))
+	assert.Error(t, validatePartitionKeyModePartitionName("p1"))
+}
+
+func TestPartitionKeyModeHiddenPartitions(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	globalMetaCache = newPartitionKeyTestCache(4)
+
+	t.Run("show partitions", func(t *testing.T) {
+		spt := &showPartitionsTask{
+			ShowPartitionsRequest: &milvuspb.ShowPartitionsRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "test_partition_key",
+				Type:           milvuspb.ShowType_All,
+			},
+			ctx: ctx,
+		}
+		assert.NoError(t, spt.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, spt.result.GetStatus().GetErrorCode())
+		assert.Empty(t, spt.result.GetPartitionNames())
+		assert.Empty(t, spt.result.GetPartitionIDs())
+
+		spt.ShowPartitionsRequest.Type = milvuspb.ShowType_InMemory
+		spt.PartitionNames = []string{Params.CommonCfg.DefaultPartitionName.GetValue() + "_0"}
+		assert.Error(t, spt.Execute(ctx))
+	})
+
+	t.Run("load partitions", func(t *testing.T) {
+		lpt := &loadPartitionsTask{
+			LoadPartitionsRequest: &milvuspb.LoadPartitionsRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "test_partition_key",
+				PartitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue() + "_0"},
+			},
+			ctx: ctx,
+		}
+		assert.Error(t, lpt.PreExecute(ctx))
+	})
+
+	t.Run("release partitions", func(t *testing.T) {
+		rpt := &releasePartitionsTask{
+			ReleasePartitionsRequest: &milvuspb.ReleasePartitionsRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "test_partition_key",
+				PartitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue() + "_0"},
+			},
+			ctx: ctx,
+		}
+		assert.Error(t, rpt.PreExecute(ctx))
+	})
+}
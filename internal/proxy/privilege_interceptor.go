@@ -94,9 +94,11 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	objectNameIndexs := privilegeExt.ObjectNameIndexs
 	objectNames := funcutil.GetObjectNames(req, objectNameIndexs)
 	objectPrivilege := privilegeExt.ObjectPrivilege.String()
+	dbName := getDbNameFromRequest(req)
 	policyInfo := strings.Join(globalMetaCache.GetPrivilegeInfo(ctx), ",")
 
 	log.Debug("current request info", zap.String("username", username), zap.Strings("role_names", roleNames),
+		zap.String("db", dbName),
 		zap.String("object_type", objectType), zap.String("object_privilege", objectPrivilege),
 		zap.Int32("object_index", objectNameIndex), zap.String("object_name", objectName),
 		zap.Int32("object_indexs", objectNameIndexs), zap.Strings("object_names", objectNames),
@@ -114,12 +116,17 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	}
 	for _, roleName := range roleNames {
 		permitFunc := func(resName string) (bool, error) {
-			object := funcutil.PolicyForResource(objectType, resName)
-			isPermit, err := e.Enforce(roleName, object, objectPrivilege)
-			if err != nil {
-				return false, err
+			for _, name := range getResourceNames(objectType, dbName, resName) {
+				object := funcutil.PolicyForResource(objectType, name)
+				isPermit, err := e.Enforce(roleName, object, objectPrivilege)
+				if err != nil {
+					return false, err
+				}
+				if isPermit {
+					return true, nil
+				}
 			}
-			return isPermit, nil
+			return false, nil
 		}
 
 		if objectNameIndex != 0 {
@@ -165,3 +172,26 @@ func isCurUserObject(objectType string, curUser string, object string) bool {
 	}
 	return curUser == object
 }
+
+// getDbNameFromRequest returns the database name carried by the request, empty if the request has no database.
+func getDbNameFromRequest(req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		return r.GetDbName()
+	}
+	return ""
+}
+
+// getResourceNames returns the names used to check the privilege of the resource.
+// A collection is granted with the name in "database.collection" format, the collection in the default
+// database is also granted by its plain name, which is the format used before databases were introduced.
+func getResourceNames(objectType string, dbName string, resName string) []string {
+	if objectType != commonpb.ObjectType_Collection.String() {
+		return []string{resName}
+	}
+	dbName = funcutil.DBNameOrDefault(dbName)
+	resNames := []string{funcutil.CombineObjectName(dbName, resName)}
+	if dbName == util.DefaultDBName {
+		resNames = append(resNames, resName)
+	}
+	return resNames
+}
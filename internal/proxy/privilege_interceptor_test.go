@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
@@ -53,8 +54,9 @@ func TestPrivilegeInterceptor(t *testing.T) {
 					ErrorCode: commonpb.ErrorCode_Success,
 				},
 				PolicyInfos: []string{
-					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeLoad.String()),
-					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeFlush.String()),
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombineObjectName("db_test", "col1"), commonpb.ObjectPrivilege_PrivilegeLoad.String()),
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombineObjectName("db_test", "*"), commonpb.ObjectPrivilege_PrivilegeFlush.String()),
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col2", commonpb.ObjectPrivilege_PrivilegeLoad.String()),
 					funcutil.PolicyForPrivilege("role2", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeAll.String()),
 				},
 				UserRoles: []string{
@@ -106,6 +108,33 @@ func TestPrivilegeInterceptor(t *testing.T) {
 		})
 		assert.Nil(t, err)
 
+		// the privilege granted in one database doesn't work for the other databases
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{
+			CollectionName: "col1",
+		})
+		assert.NotNil(t, err)
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.FlushRequest{
+			DbName:          "db_test2",
+			CollectionNames: []string{"col1"},
+		})
+		assert.NotNil(t, err)
+
+		// the privilege granted with plain collection name works for the default database
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{
+			CollectionName: "col2",
+		})
+		assert.Nil(t, err)
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{
+			DbName:         util.DefaultDBName,
+			CollectionName: "col2",
+		})
+		assert.Nil(t, err)
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{
+			DbName:         "db_test",
+			CollectionName: "col2",
+		})
+		assert.NotNil(t, err)
+
 		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &milvuspb.LoadCollectionRequest{
 			DbName:         "db_test",
 			CollectionName: "col1",
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// queryCursor is the position of a query iterator, the next page continues from the entities whose primary keys
+// are greater than the last primary key of the previous page, and all the pages are read at the pinned timestamp
+// so that they are a consistent snapshot of the collection.
+type queryCursor struct {
+	CollectionID UniqueID  `json:"collection_id"`
+	IntPK        *int64    `json:"int_pk,omitempty"`
+	StrPK        *string   `json:"str_pk,omitempty"`
+	Timestamp    Timestamp `json:"ts"`
+}
+
+func encodeQueryCursor(cursor *queryCursor) (string, error) {
+	bs, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+func decodeQueryCursor(token string) (*queryCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid, %w", IteratorCursorKey, token, err)
+	}
+	cursor := &queryCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid, %w", IteratorCursorKey, token, err)
+	}
+	if cursor.Timestamp == 0 || (cursor.IntPK == nil) == (cursor.StrPK == nil) {
+		return nil, fmt.Errorf("%s [%s] is invalid", IteratorCursorKey, token)
+	}
+	return cursor, nil
+}
+
+// parseQueryIteratorParams fills the iterator options of the query params, the iterator requires a positive limit
+// as the page size and doesn't support offset since the pages continue from the cursor.
+func parseQueryIteratorParams(queryParamsPair []*commonpb.KeyValuePair, queryParams *queryParams) error {
+	iteratorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorKey, queryParamsPair)
+	if err != nil {
+		return nil
+	}
+	iterator, err := strconv.ParseBool(iteratorStr)
+	if err != nil {
+		return fmt.Errorf("%s [%s] is invalid", IteratorKey, iteratorStr)
+	}
+	if !iterator {
+		return nil
+	}
+	if queryParams.limit <= 0 {
+		return fmt.Errorf("%s is required to be positive for query iterator", LimitKey)
+	}
+	if queryParams.offset != 0 {
+		return fmt.Errorf("%s is not supported by query iterator", OffsetKey)
+	}
+	queryParams.iterator = true
+
+	token, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorCursorKey, queryParamsPair)
+	// the first page has no cursor
+	if err != nil || token == "" {
+		return nil
+	}
+	queryParams.cursor, err = decodeQueryCursor(token)
+	return err
+}
+
+// appendCursorExpr restricts the retrieve plan to the entities whose primary keys are greater than the cursor
+func appendCursorExpr(plan *planpb.PlanNode, pkField *schemapb.FieldSchema, cursor *queryCursor) error {
+	var value *planpb.GenericValue
+	switch {
+	case pkField.GetDataType() == schemapb.DataType_Int64 && cursor.IntPK != nil:
+		value = &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: *cursor.IntPK}}
+	case pkField.GetDataType() == schemapb.DataType_VarChar && cursor.StrPK != nil:
+		value = &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: *cursor.StrPK}}
+	default:
+		return fmt.Errorf("%s doesn't match the primary key type %s", IteratorCursorKey, pkField.GetDataType().String())
+	}
+
+	cursorExpr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.GetFieldID(),
+					DataType:     pkField.GetDataType(),
+					IsPrimaryKey: true,
+					IsAutoID:     pkField.GetAutoID(),
+				},
+				Op:    planpb.OpType_GreaterThan,
+				Value: value,
+			},
+		},
+	}
+	plan.Node = &planpb.PlanNode_Predicates{
+		Predicates: &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  plan.GetPredicates(),
+					Right: cursorExpr,
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// getNextQueryCursor returns the cursor of the next page, empty string is returned if the iterator is exhausted,
+// which means the page is not full.
+func getNextQueryCursor(fieldsData []*schemapb.FieldData, pkField *schemapb.FieldSchema, collectionID UniqueID, ts Timestamp, limit int64) (string, error) {
+	cursor := &queryCursor{
+		CollectionID: collectionID,
+		Timestamp:    ts,
+	}
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != pkField.GetFieldID() {
+			continue
+		}
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			pks := fieldData.GetScalars().GetLongData().GetData()
+			if int64(len(pks)) < limit {
+				return "", nil
+			}
+			cursor.IntPK = &pks[len(pks)-1]
+		case schemapb.DataType_VarChar:
+			pks := fieldData.GetScalars().GetStringData().GetData()
+			if int64(len(pks)) < limit {
+				return "", nil
+			}
+			cursor.StrPK = &pks[len(pks)-1]
+		default:
+			return "", fmt.Errorf("unsupported primary key type: %s", pkField.GetDataType().String())
+		}
+		return encodeQueryCursor(cursor)
+	}
+	return "", fmt.Errorf("primary key field %s is not in the query results", pkField.GetName())
+}
+
+// sendQueryCursor returns the cursor of the next page to the client in the response header since the query results
+// have no field to carry it, the http handler copies it into the response body. An empty cursor means the iterator
+// is exhausted.
+func sendQueryCursor(ctx context.Context, token string) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(IteratorCursorHeader, token)); err != nil {
+		log.Ctx(ctx).Warn("failed to send the cursor of query iterator", zap.Error(err))
+	}
+}
@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func TestQueryCursor(t *testing.T) {
+	pk := int64(100)
+	token, err := encodeQueryCursor(&queryCursor{CollectionID: 1, IntPK: &pk, Timestamp: 1000})
+	assert.NoError(t, err)
+	cursor, err := decodeQueryCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), cursor.CollectionID)
+	assert.Equal(t, pk, *cursor.IntPK)
+	assert.Nil(t, cursor.StrPK)
+	assert.Equal(t, Timestamp(1000), cursor.Timestamp)
+
+	_, err = decodeQueryCursor("!!!")
+	assert.Error(t, err)
+	// no primary key
+	token, err = encodeQueryCursor(&queryCursor{CollectionID: 1, Timestamp: 1000})
+	assert.NoError(t, err)
+	_, err = decodeQueryCursor(token)
+	assert.Error(t, err)
+	// no timestamp
+	token, err = encodeQueryCursor(&queryCursor{CollectionID: 1, IntPK: &pk})
+	assert.NoError(t, err)
+	_, err = decodeQueryCursor(token)
+	assert.Error(t, err)
+}
+
+func TestParseQueryIteratorParams(t *testing.T) {
+	pk := "a"
+	token, err := encodeQueryCursor(&queryCursor{CollectionID: 1, StrPK: &pk, Timestamp: 1000})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		description string
+		params      []*commonpb.KeyValuePair
+		iterator    bool
+		hasCursor   bool
+		isValid     bool
+	}{
+		{"not iterator", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}}, false, false, true},
+		{"iterator disabled", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: IteratorKey, Value: "false"}}, false, false, true},
+		{"first page", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: IteratorKey, Value: "true"}}, true, false, true},
+		{"next page", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: IteratorKey, Value: "true"}, {Key: IteratorCursorKey, Value: token}}, true, true, true},
+		{"invalid iterator", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: IteratorKey, Value: "yes"}}, false, false, false},
+		{"no limit", []*commonpb.KeyValuePair{{Key: IteratorKey, Value: "true"}}, false, false, false},
+		{"with offset", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "5"}, {Key: IteratorKey, Value: "true"}}, false, false, false},
+		{"invalid cursor", []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: IteratorKey, Value: "true"}, {Key: IteratorCursorKey, Value: "abc"}}, true, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			queryParams, err := parseQueryParams(test.params)
+			assert.NoError(t, err)
+			err = parseQueryIteratorParams(test.params, queryParams)
+			if !test.isValid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.iterator, queryParams.iterator)
+			assert.Equal(t, test.hasCursor, queryParams.cursor != nil)
+		})
+	}
+}
+
+func TestAppendCursorExpr(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, "age > 10")
+	assert.NoError(t, err)
+	predicates := plan.GetPredicates()
+
+	pk := int64(5)
+	err = appendCursorExpr(plan, schema.GetFields()[0], &queryCursor{IntPK: &pk, Timestamp: 1000})
+	assert.NoError(t, err)
+	binaryExpr := plan.GetPredicates().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	assert.Equal(t, predicates, binaryExpr.GetLeft())
+	cursorExpr := binaryExpr.GetRight().GetUnaryRangeExpr()
+	assert.Equal(t, int64(100), cursorExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.OpType_GreaterThan, cursorExpr.GetOp())
+	assert.Equal(t, pk, cursorExpr.GetValue().GetInt64Val())
+
+	// the cursor of varchar primary key doesn't match
+	strPK := "a"
+	err = appendCursorExpr(plan, schema.GetFields()[0], &queryCursor{StrPK: &strPK, Timestamp: 1000})
+	assert.Error(t, err)
+}
+
+func TestGetNextQueryCursor(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar}
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+				},
+			},
+		},
+		{
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b", "c"}}},
+				},
+			},
+		},
+	}
+
+	token, err := getNextQueryCursor(fieldsData, pkField, 1, 1000, 3)
+	assert.NoError(t, err)
+	cursor, err := decodeQueryCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "c", *cursor.StrPK)
+	assert.Equal(t, Timestamp(1000), cursor.Timestamp)
+
+	// the page is not full, the iterator is exhausted
+	token, err = getNextQueryCursor(fieldsData, pkField, 1, 1000, 4)
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+
+	_, err = getNextQueryCursor(fieldsData[:1], pkField, 1, 1000, 3)
+	assert.Error(t, err)
+}
+
+func TestQueryIteratorDuplicatedPKs(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	// the primary keys 2, 3 and 4 have versions in both segments
+	segments := [][]int64{{0, 1, 2, 3, 4, 5, 6, 7}, {2, 3, 4, 8, 9}}
+	limit := int64(3)
+	// retrieve returns at most limit distinct primary keys greater than the cursor from every segment
+	retrieve := func(cursor int64) []*internalpb.RetrieveResults {
+		results := make([]*internalpb.RetrieveResults, 0, len(segments))
+		for _, segment := range segments {
+			pks := make([]int64, 0, limit)
+			for _, pk := range segment {
+				if pk > cursor && int64(len(pks)) < limit {
+					pks = append(pks, pk)
+				}
+			}
+			results = append(results, &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:    schemapb.DataType_Int64,
+						FieldId: pkField.GetFieldID(),
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+							},
+						},
+					},
+				},
+			})
+		}
+		return results
+	}
+
+	var retrieved []int64
+	cursor := int64(-1)
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 10)
+		result, err := reduceRetrieveResults(context.Background(), retrieve(cursor), &queryParams{limit: limit})
+		require.NoError(t, err)
+		pks := result.GetFieldsData()[0].GetScalars().GetLongData().GetData()
+		retrieved = append(retrieved, pks...)
+		token, err := getNextQueryCursor(result.GetFieldsData(), pkField, 1, 1000, limit)
+		require.NoError(t, err)
+		if token == "" {
+			break
+		}
+		// the duplicated primary keys don't make a page look like the last one
+		assert.Equal(t, int(limit), len(pks))
+		next, err := decodeQueryCursor(token)
+		require.NoError(t, err)
+		cursor = *next.IntPK
+	}
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, retrieved)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -35,7 +36,9 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		rt, n, err := getRequestInfo(req)
 		if err == nil {
-			limit, rate := limiter.Limit(rt, n)
+			collectionID := getCollectionIDOfRequest(ctx, req)
+			partitionID := getPartitionIDOfRequest(ctx, req)
+			limit, rate := limiter.Limit(collectionID, partitionID, rt, n)
 			if rate == 0 {
 				res, err1 := getFailedResponse(req, commonpb.ErrorCode_ForceDeny, fmt.Sprintf("force to deny %s.", info.FullMethod))
 				if err1 == nil {
@@ -60,6 +63,8 @@ func getRequestInfo(req interface{}) (internalpb.RateType, int, error) {
 		return internalpb.RateType_DMLInsert, proto.Size(r), nil
 	case *milvuspb.DeleteRequest:
 		return internalpb.RateType_DMLDelete, proto.Size(r), nil
+	case *proxypb.UpsertRequest:
+		return internalpb.RateType_DMLInsert, proto.Size(r), nil
 	case *milvuspb.ImportRequest:
 		return internalpb.RateType_DMLBulkLoad, proto.Size(r), nil
 	case *milvuspb.SearchRequest:
@@ -89,6 +94,68 @@ func getRequestInfo(req interface{}) (internalpb.RateType, int, error) {
 	}
 }
 
+// getCollectionIDOfRequest returns the id of the collection the request targets,
+// 0 is returned if the request doesn't target a collection or the collection is unknown.
+func getCollectionIDOfRequest(ctx context.Context, req interface{}) int64 {
+	var database, collectionName string
+	switch r := req.(type) {
+	case *milvuspb.ImportRequest:
+		collectionName = r.GetCollectionName() // import requests carry no database name
+	case interface {
+		GetDbName() string
+		GetCollectionName() string
+	}:
+		database, collectionName = r.GetDbName(), r.GetCollectionName()
+	}
+	if collectionName == "" || globalMetaCache == nil {
+		return 0
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return 0
+	}
+	return collectionID
+}
+
+// getPartitionIDOfRequest returns the id of the partition the dml request writes into, 0 is returned if
+// the request doesn't write into a single partition or the partition is unknown. Insert, upsert and import
+// requests without partition name write into the default partition, delete requests without partition
+// name delete from all partitions. Entities of partition key collections are hashed into partitions,
+// so requests of these collections never write into a single partition.
+func getPartitionIDOfRequest(ctx context.Context, req interface{}) int64 {
+	var database, collectionName, partitionName string
+	switch r := req.(type) {
+	case *milvuspb.InsertRequest:
+		database, collectionName, partitionName = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+	case *proxypb.UpsertRequest:
+		database, collectionName, partitionName = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+	case *milvuspb.ImportRequest:
+		collectionName, partitionName = r.GetCollectionName(), r.GetPartitionName()
+	case *milvuspb.DeleteRequest:
+		if r.GetPartitionName() == "" {
+			return 0
+		}
+		database, collectionName, partitionName = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+	default:
+		return 0
+	}
+	if collectionName == "" || globalMetaCache == nil {
+		return 0
+	}
+	partitionKeyMode, err := isPartitionKeyMode(ctx, database, collectionName)
+	if err != nil || partitionKeyMode {
+		return 0
+	}
+	if partitionName == "" {
+		partitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, database, collectionName, partitionName)
+	if err != nil {
+		return 0
+	}
+	return partitionID
+}
+
 // failedStatus returns failed status.
 func failedStatus(code commonpb.ErrorCode, reason string) *commonpb.Status {
 	return &commonpb.Status{
@@ -114,7 +181,7 @@ func failedBoolResponse(code commonpb.ErrorCode, reason string) *milvuspb.BoolRe
 // getFailedResponse returns failed response.
 func getFailedResponse(req interface{}, code commonpb.ErrorCode, reason string) (interface{}, error) {
 	switch req.(type) {
-	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest:
+	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest, *proxypb.UpsertRequest:
 		return failedMutationResult(code, reason), nil
 	case *milvuspb.ImportRequest:
 		return &milvuspb.ImportResponse{
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -26,7 +27,10 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type limiterMock struct {
@@ -34,7 +38,7 @@ type limiterMock struct {
 	rate  float64
 }
 
-func (l *limiterMock) Limit(_ internalpb.RateType, _ int) (bool, float64) {
+func (l *limiterMock) Limit(_ int64, _ int64, _ internalpb.RateType, _ int) (bool, float64) {
 	return l.limit, l.rate
 }
 
@@ -91,6 +95,68 @@ func TestRateLimitInterceptor(t *testing.T) {
 		assert.Equal(t, internalpb.RateType_DDLCompaction, rt)
 	})
 
+	t.Run("test getCollectionIDOfRequest", func(t *testing.T) {
+		cache := globalMetaCache
+		defer func() { globalMetaCache = cache }()
+
+		globalMetaCache = nil
+		assert.Equal(t, int64(0), getCollectionIDOfRequest(context.Background(), &milvuspb.InsertRequest{CollectionName: "coll"}))
+
+		mockCache := newMockCache()
+		mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
+			if collectionName != "coll" {
+				return 0, errors.New("collection not found")
+			}
+			return 100, nil
+		})
+		globalMetaCache = mockCache
+		assert.Equal(t, int64(100), getCollectionIDOfRequest(context.Background(), &milvuspb.InsertRequest{CollectionName: "coll"}))
+		assert.Equal(t, int64(100), getCollectionIDOfRequest(context.Background(), &milvuspb.SearchRequest{CollectionName: "coll"}))
+		assert.Equal(t, int64(100), getCollectionIDOfRequest(context.Background(), &milvuspb.ImportRequest{CollectionName: "coll"}))
+		assert.Equal(t, int64(0), getCollectionIDOfRequest(context.Background(), &milvuspb.QueryRequest{CollectionName: "other"}))
+		assert.Equal(t, int64(0), getCollectionIDOfRequest(context.Background(), &milvuspb.InsertRequest{}))
+		assert.Equal(t, int64(0), getCollectionIDOfRequest(context.Background(), &milvuspb.ManualCompactionRequest{}))
+	})
+
+	t.Run("test getPartitionIDOfRequest", func(t *testing.T) {
+		cache := globalMetaCache
+		defer func() { globalMetaCache = cache }()
+
+		globalMetaCache = nil
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(context.Background(), &milvuspb.InsertRequest{CollectionName: "coll"}))
+
+		mockCache := newMockCache()
+		mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+			switch partitionName {
+			case Params.CommonCfg.DefaultPartitionName.GetValue():
+				return 1000, nil
+			case "p1":
+				return 1001, nil
+			}
+			return 0, errors.New("partition not found")
+		})
+		globalMetaCache = mockCache
+		ctx := context.Background()
+		assert.Equal(t, int64(1000), getPartitionIDOfRequest(ctx, &milvuspb.InsertRequest{CollectionName: "coll"}))
+		assert.Equal(t, int64(1001), getPartitionIDOfRequest(ctx, &milvuspb.InsertRequest{CollectionName: "coll", PartitionName: "p1"}))
+		assert.Equal(t, int64(1000), getPartitionIDOfRequest(ctx, &proxypb.UpsertRequest{CollectionName: "coll"}))
+		assert.Equal(t, int64(1001), getPartitionIDOfRequest(ctx, &milvuspb.ImportRequest{CollectionName: "coll", PartitionName: "p1"}))
+		assert.Equal(t, int64(1001), getPartitionIDOfRequest(ctx, &milvuspb.DeleteRequest{CollectionName: "coll", PartitionName: "p1"}))
+		// delete from all partitions
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(ctx, &milvuspb.DeleteRequest{CollectionName: "coll"}))
+		// unknown partition
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(ctx, &milvuspb.InsertRequest{CollectionName: "coll", PartitionName: "p2"}))
+		// not a dml request
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(ctx, &milvuspb.SearchRequest{CollectionName: "coll", PartitionNames: []string{"p1"}}))
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(ctx, &milvuspb.InsertRequest{}))
+
+		// entities of partition key collections are hashed into partitions
+		mockCache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+			return newPartitionKeyTestSchema(), nil
+		})
+		assert.Equal(t, int64(0), getPartitionIDOfRequest(ctx, &milvuspb.InsertRequest{CollectionName: "coll"}))
+	})
+
 	t.Run("test getFailedResponse", func(t *testing.T) {
 		testGetFailedResponse := func(req interface{}) {
 			_, err := getFailedResponse(req, commonpb.ErrorCode_UnexpectedError, "mock")
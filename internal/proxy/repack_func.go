@@ -17,9 +17,15 @@
 package proxy
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // insertRepackFunc deprecated, use defaultInsertRepackFunc instead.
@@ -83,3 +89,52 @@ func defaultInsertRepackFunc(
 	}
 	return pack, nil
 }
+
+// repackDeleteMsgByHash splits the delete message into one delete message per dml channel,
+// the primary keys are hashed to channels the same way as the insert path does, so that a
+// delete and an insert of the same primary key always land on the same channel.
+func repackDeleteMsgByHash(
+	ctx context.Context,
+	deleteMsg *msgstream.DeleteMsg,
+	channelNames []string,
+) []msgstream.TsMsg {
+
+	deleteMsg.HashValues = typeutil.HashPK2Channels(deleteMsg.PrimaryKeys, channelNames)
+
+	result := make(map[uint32]*msgstream.DeleteMsg)
+	for index, key := range deleteMsg.HashValues {
+		curMsg, ok := result[key]
+		if !ok {
+			sliceRequest := internalpb.DeleteRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+					commonpbutil.WithMsgID(deleteMsg.Base.MsgID),
+					commonpbutil.WithTimeStamp(deleteMsg.Timestamps[index]),
+					commonpbutil.WithSourceID(deleteMsg.Base.SourceID),
+				),
+				CollectionID:   deleteMsg.CollectionID,
+				PartitionID:    deleteMsg.PartitionID,
+				CollectionName: deleteMsg.CollectionName,
+				PartitionName:  deleteMsg.PartitionName,
+				PrimaryKeys:    &schemapb.IDs{},
+			}
+			curMsg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx: ctx,
+				},
+				DeleteRequest: sliceRequest,
+			}
+			result[key] = curMsg
+		}
+		curMsg.HashValues = append(curMsg.HashValues, deleteMsg.HashValues[index])
+		curMsg.Timestamps = append(curMsg.Timestamps, deleteMsg.Timestamps[index])
+		typeutil.AppendIDs(curMsg.PrimaryKeys, deleteMsg.PrimaryKeys, index)
+		curMsg.NumRows++
+	}
+
+	msgs := make([]msgstream.TsMsg, 0, len(result))
+	for _, msg := range result {
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
@@ -17,10 +17,15 @@
 package proxy
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -194,3 +199,49 @@ func Test_defaultInsertRepackFunc(t *testing.T) {
 		assert.Equal(t, histogram[key], len(ret7[key].Msgs))
 	}
 }
+
+func Test_repackDeleteMsgByHash(t *testing.T) {
+	channelNames := []string{"by-dev-dml_0", "by-dev-dml_1"}
+	numRows := 10
+	pks := make([]int64, 0, numRows)
+	tss := make([]uint64, 0, numRows)
+	for i := 0; i < numRows; i++ {
+		pks = append(pks, int64(i))
+		tss = append(tss, 100)
+	}
+	deleteMsg := &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Delete,
+				MsgID:    1,
+				SourceID: 2,
+			},
+			CollectionID: 3,
+			PrimaryKeys: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+			Timestamps: tss,
+			NumRows:    int64(numRows),
+		},
+	}
+
+	msgs := repackDeleteMsgByHash(context.TODO(), deleteMsg, channelNames)
+	assert.LessOrEqual(t, len(msgs), len(channelNames))
+
+	total := int64(0)
+	for _, msg := range msgs {
+		dMsg := msg.(*msgstream.DeleteMsg)
+		assert.Equal(t, int64(3), dMsg.CollectionID)
+		assert.Equal(t, int64(1), dMsg.Base.MsgID)
+		assert.Equal(t, uint64(100), dMsg.Base.Timestamp)
+		assert.Equal(t, int(dMsg.NumRows), len(dMsg.Timestamps))
+		assert.Equal(t, int(dMsg.NumRows), len(dMsg.GetPrimaryKeys().GetIntId().GetData()))
+		// all the primary keys in one message are hashed to the same channel
+		hashValues := typeutil.HashPK2Channels(dMsg.PrimaryKeys, channelNames)
+		for _, h := range hashValues {
+			assert.Equal(t, dMsg.HashValues[0], h)
+		}
+		total += dMsg.NumRows
+	}
+	assert.Equal(t, int64(numRows), total)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
@@ -49,8 +50,17 @@ const (
 	RoundDecimalKey = "round_decimal"
 	OffsetKey       = "offset"
 	LimitKey        = "limit"
+	// RangeLimitKey is the max number of hits per query of a range search, topk doesn't apply to range searches
+	RangeLimitKey   = "range_limit"
+	GroupByFieldKey = common.GroupByFieldKey
+	GroupSizeKey    = common.GroupSizeKey
+
+	IteratorKey          = "iterator"
+	IteratorCursorKey    = "iterator_cursor"
+	IteratorCursorHeader = common.QueryIteratorCursorHeader
 
 	InsertTaskName             = "InsertTask"
+	UpsertTaskName             = "UpsertTask"
 	CreateCollectionTaskName   = "CreateCollectionTask"
 	DropCollectionTaskName     = "DropCollectionTask"
 	HasCollectionTaskName      = "HasCollectionTask"
@@ -70,6 +80,7 @@ const (
 	DropAliasTaskName          = "DropAliasTask"
 	AlterAliasTaskName         = "AlterAliasTask"
 	AlterCollectionTaskName    = "AlterCollectionTask"
+	RenameCollectionTaskName   = "RenameCollectionTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -212,12 +223,46 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		// an array field must declare a supported element type
+		if typeutil.IsArrayType(field.DataType) {
+			if err = typeutil.ValidateArrayField(field); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
 		return err
 	}
 
+	// validate partition key definition
+	if err := typeutil.ValidatePartitionKeyField(cct.schema); err != nil {
+		return err
+	}
+
+	// validate clustering key definition
+	if err := typeutil.ValidateClusteringKeyField(cct.schema); err != nil {
+		return err
+	}
+
+	// validate the fields with min/max or bloom filter stats
+	if err := typeutil.ValidateFieldStats(cct.schema); err != nil {
+		return err
+	}
+
+	// undeclared fields of a dynamic schema are kept in a hidden JSON field
+	enableDynamicField, err := isDynamicFieldEnabled(cct.GetProperties())
+	if err != nil {
+		return err
+	}
+	if enableDynamicField {
+		cct.schema.Fields = append(cct.schema.Fields, &schemapb.FieldSchema{
+			Name:        common.MetaFieldName,
+			Description: "dynamic schema",
+			DataType:    typeutil.DataTypeJSON,
+		})
+	}
+
 	cct.CreateCollectionRequest.Schema, err = proto.Marshal(cct.schema)
 	if err != nil {
 		return err
@@ -582,7 +627,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -631,11 +676,14 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		for offset, id := range resp.CollectionIDs {
 			collectionName, ok := IDs2Names[id]
 			if !ok {
-				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
+				// QueryCoord returns the loaded collections of all the databases,
+				// skip the ones which don't belong to the requested database.
+				log.Debug("Skip the collection not in the database.", zap.Int64("collectionID", id),
+					zap.String("database", sct.GetDbName()),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
-				return errors.New("failed to show collections")
+				continue
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -721,6 +769,77 @@ func (act *alterCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// renameCollectionTask is the task to rename a collection, the collection id and aliases are kept
+type renameCollectionTask struct {
+	Condition
+	*proxypb.RenameCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rct *renameCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
+}
+
+func (rct *renameCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
+}
+
+func (rct *renameCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
+}
+
+func (rct *renameCollectionTask) Name() string {
+	return RenameCollectionTaskName
+}
+
+func (rct *renameCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *renameCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *renameCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *renameCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
+}
+
+func (rct *renameCollectionTask) OnEnqueue() error {
+	rct.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (rct *renameCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(rct.GetOldName()); err != nil {
+		return err
+	}
+	if err := validateCollectionName(rct.GetNewName()); err != nil {
+		return err
+	}
+	if rct.GetOldName() == rct.GetNewName() {
+		return fmt.Errorf("new collection name is the same as the old one: %s", rct.GetNewName())
+	}
+	return nil
+}
+
+func (rct *renameCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	rct.result, err = rct.rootCoord.RenameCollection(ctx, rct.RenameCollectionRequest)
+	return err
+}
+
+func (rct *renameCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
@@ -780,6 +899,14 @@ func (cpt *createPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	partitionKeyMode, err := isPartitionKeyMode(ctx, cpt.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		return errors.New("disable create partition if partition key mode is used")
+	}
+
 	return nil
 }
 
@@ -858,11 +985,19 @@ func (dpt *dropPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, dpt.GetCollectionName())
+	partitionKeyMode, err := isPartitionKeyMode(ctx, dpt.GetDbName(), collName)
 	if err != nil {
 		return err
 	}
-	partID, err := globalMetaCache.GetPartitionID(ctx, dpt.GetCollectionName(), dpt.GetPartitionName())
+	if partitionKeyMode {
+		return errors.New("disable drop partition if partition key mode is used")
+	}
+
+	collID, err := globalMetaCache.GetCollectionID(ctx, dpt.GetDbName(), dpt.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	partID, err := globalMetaCache.GetPartitionID(ctx, dpt.GetDbName(), dpt.GetCollectionName(), dpt.GetPartitionName())
 	if err != nil {
 		if err.Error() == ErrPartitionNotExist(dpt.GetPartitionName()).Error() {
 			return nil
@@ -1044,6 +1179,28 @@ func (spt *showPartitionsTask) PreExecute(ctx context.Context) error {
 }
 
 func (spt *showPartitionsTask) Execute(ctx context.Context) error {
+	partitionKeyMode, err := isPartitionKeyMode(ctx, spt.GetDbName(), spt.CollectionName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		// the partitions of a partition key collection are hidden from users
+		if spt.GetType() == milvuspb.ShowType_InMemory && len(spt.PartitionNames) > 0 {
+			return errPartitionNamesWithPartitionKey
+		}
+		spt.result = &milvuspb.ShowPartitionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			PartitionNames:       []string{},
+			PartitionIDs:         []int64{},
+			CreatedTimestamps:    []uint64{},
+			CreatedUtcTimestamps: []uint64{},
+			InMemoryPercentages:  []int64{},
+		}
+		return nil
+	}
+
 	respFromRootCoord, err := spt.rootCoord.ShowPartitions(ctx, spt.ShowPartitionsRequest)
 	if err != nil {
 		return err
@@ -1059,7 +1216,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1072,7 +1229,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1118,7 +1275,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1197,7 +1354,7 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 	flushColl2Segments := make(map[string]*schemapb.LongArray)
 	coll2SealTimes := make(map[string]int64)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.GetDbName(), collName)
 		if err != nil {
 			return err
 		}
@@ -1306,7 +1463,7 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
@@ -1318,7 +1475,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	}
 
 	lct.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1370,7 +1527,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 }
 
 func (lct *loadCollectionTask) PostExecute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 	log.Ctx(ctx).Debug("loadCollectionTask PostExecute",
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("collectionID", collID))
@@ -1442,7 +1599,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.GetDbName(), rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1458,13 +1615,13 @@ func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
 
 	rct.result, err = rct.queryCoord.ReleaseCollection(ctx, request)
 
-	globalMetaCache.RemoveCollection(ctx, rct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, rct.GetDbName(), rct.CollectionName)
 
 	return err
 }
 
 func (rct *releaseCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rct.CollectionName)
+	globalMetaCache.ClearShards(rct.GetDbName(), rct.CollectionName)
 	return nil
 }
 
@@ -1526,17 +1683,25 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	partitionKeyMode, err := isPartitionKeyMode(ctx, lpt.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		return errors.New("disable load partitions if partition key mode is used")
+	}
+
 	return nil
 }
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	lpt.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1568,7 +1733,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 		return errors.New(errMsg)
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.GetDbName(), lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -1651,18 +1816,26 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	partitionKeyMode, err := isPartitionKeyMode(ctx, rpt.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		return errors.New("disable release partitions if partition key mode is used")
+	}
+
 	return nil
 }
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.GetDbName(), rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	rpt.collectionID = collID
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.GetDbName(), rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -1682,7 +1855,7 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 }
 
 func (rpt *releasePartitionsTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rpt.CollectionName)
+	globalMetaCache.ClearShards(rpt.GetDbName(), rpt.CollectionName)
 	return nil
 }
 
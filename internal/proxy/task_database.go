@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	CreateDatabaseTaskName = "CreateDatabaseTask"
+	DropDatabaseTaskName   = "DropDatabaseTask"
+	ListDatabaseTaskName   = "ListDatabaseTask"
+)
+
+// createDatabaseTask is the task to create a database
+type createDatabaseTask struct {
+	Condition
+	*proxypb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (cdt *createDatabaseTask) TraceCtx() context.Context {
+	return cdt.ctx
+}
+
+func (cdt *createDatabaseTask) ID() UniqueID {
+	return cdt.Base.MsgID
+}
+
+func (cdt *createDatabaseTask) SetID(uid UniqueID) {
+	cdt.Base.MsgID = uid
+}
+
+func (cdt *createDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (cdt *createDatabaseTask) Type() commonpb.MsgType {
+	return cdt.Base.MsgType
+}
+
+func (cdt *createDatabaseTask) BeginTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *createDatabaseTask) EndTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *createDatabaseTask) SetTs(ts Timestamp) {
+	cdt.Base.Timestamp = ts
+}
+
+func (cdt *createDatabaseTask) OnEnqueue() error {
+	cdt.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (cdt *createDatabaseTask) PreExecute(ctx context.Context) error {
+	cdt.Base.SourceID = paramtable.GetNodeID()
+	return validateDatabaseName(cdt.GetDbName())
+}
+
+func (cdt *createDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	cdt.result, err = cdt.rootCoord.CreateDatabase(ctx, cdt.CreateDatabaseRequest)
+	if err != nil {
+		return err
+	}
+	if cdt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cdt.result.Reason)
+	}
+	return nil
+}
+
+func (cdt *createDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// dropDatabaseTask is the task to drop a database, only the empty database can be dropped
+type dropDatabaseTask struct {
+	Condition
+	*proxypb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (ddt *dropDatabaseTask) TraceCtx() context.Context {
+	return ddt.ctx
+}
+
+func (ddt *dropDatabaseTask) ID() UniqueID {
+	return ddt.Base.MsgID
+}
+
+func (ddt *dropDatabaseTask) SetID(uid UniqueID) {
+	ddt.Base.MsgID = uid
+}
+
+func (ddt *dropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (ddt *dropDatabaseTask) Type() commonpb.MsgType {
+	return ddt.Base.MsgType
+}
+
+func (ddt *dropDatabaseTask) BeginTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *dropDatabaseTask) EndTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *dropDatabaseTask) SetTs(ts Timestamp) {
+	ddt.Base.Timestamp = ts
+}
+
+func (ddt *dropDatabaseTask) OnEnqueue() error {
+	ddt.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (ddt *dropDatabaseTask) PreExecute(ctx context.Context) error {
+	ddt.Base.SourceID = paramtable.GetNodeID()
+	if err := validateDatabaseName(ddt.GetDbName()); err != nil {
+		return err
+	}
+	if ddt.GetDbName() == util.DefaultDBName {
+		return fmt.Errorf("can not drop default database")
+	}
+	return nil
+}
+
+func (ddt *dropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ddt.result, err = ddt.rootCoord.DropDatabase(ctx, ddt.DropDatabaseRequest)
+	if err != nil {
+		return err
+	}
+	if ddt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ddt.result.Reason)
+	}
+	return nil
+}
+
+func (ddt *dropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// listDatabaseTask is the task to list all the databases
+type listDatabaseTask struct {
+	Condition
+	*proxypb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *proxypb.ListDatabasesResponse
+}
+
+func (ldt *listDatabaseTask) TraceCtx() context.Context {
+	return ldt.ctx
+}
+
+func (ldt *listDatabaseTask) ID() UniqueID {
+	return ldt.Base.MsgID
+}
+
+func (ldt *listDatabaseTask) SetID(uid UniqueID) {
+	ldt.Base.MsgID = uid
+}
+
+func (ldt *listDatabaseTask) Name() string {
+	return ListDatabaseTaskName
+}
+
+func (ldt *listDatabaseTask) Type() commonpb.MsgType {
+	return ldt.Base.MsgType
+}
+
+func (ldt *listDatabaseTask) BeginTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *listDatabaseTask) EndTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *listDatabaseTask) SetTs(ts Timestamp) {
+	ldt.Base.Timestamp = ts
+}
+
+func (ldt *listDatabaseTask) OnEnqueue() error {
+	ldt.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (ldt *listDatabaseTask) PreExecute(ctx context.Context) error {
+	ldt.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (ldt *listDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ldt.result, err = ldt.rootCoord.ListDatabases(ctx, ldt.ListDatabasesRequest)
+	if err != nil {
+		return err
+	}
+	if ldt.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(ldt.result.GetStatus().GetReason())
+	}
+	return nil
+}
+
+func (ldt *listDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
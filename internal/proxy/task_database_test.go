@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
+)
+
+func TestCreateDatabaseTask(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	task := &createDatabaseTask{
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: &proxypb.CreateDatabaseRequest{DbName: "db1"},
+		ctx:                   ctx,
+		rootCoord:             rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	assert.Equal(t, CreateDatabaseTaskName, task.Name())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	t.Run("invalid name", func(t *testing.T) {
+		task.DbName = "1db"
+		assert.Error(t, task.PreExecute(ctx))
+		task.DbName = "db.1"
+		assert.Error(t, task.PreExecute(ctx))
+	})
+
+	t.Run("rootcoord fail", func(t *testing.T) {
+		mockRC := mocks.NewRootCoord(t)
+		mockRC.EXPECT().CreateDatabase(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		task.rootCoord = mockRC
+		assert.Error(t, task.Execute(ctx))
+
+		mockRC = mocks.NewRootCoord(t)
+		mockRC.EXPECT().CreateDatabase(mock.Anything, mock.Anything).Return(&commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "database already exist",
+		}, nil)
+		task.rootCoord = mockRC
+		assert.Error(t, task.Execute(ctx))
+	})
+}
+
+func TestDropDatabaseTask(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	task := &dropDatabaseTask{
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: &proxypb.DropDatabaseRequest{DbName: "db1"},
+		ctx:                 ctx,
+		rootCoord:           rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	assert.Equal(t, DropDatabaseTaskName, task.Name())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	t.Run("default database", func(t *testing.T) {
+		task.DbName = util.DefaultDBName
+		assert.Error(t, task.PreExecute(ctx))
+	})
+
+	t.Run("rootcoord fail", func(t *testing.T) {
+		mockRC := mocks.NewRootCoord(t)
+		mockRC.EXPECT().DropDatabase(mock.Anything, mock.Anything).Return(&commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "database is not empty",
+		}, nil)
+		task.rootCoord = mockRC
+		assert.Error(t, task.Execute(ctx))
+	})
+}
+
+func TestListDatabaseTask(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	task := &listDatabaseTask{
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: &proxypb.ListDatabasesRequest{},
+		ctx:                  ctx,
+		rootCoord:            rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	assert.Equal(t, ListDatabaseTaskName, task.Name())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, []string{util.DefaultDBName}, task.result.GetDbNames())
+
+	t.Run("rootcoord fail", func(t *testing.T) {
+		mockRC := mocks.NewRootCoord(t)
+		mockRC.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		task.rootCoord = mockRC
+		assert.Error(t, task.Execute(ctx))
+	})
+}
@@ -121,7 +121,7 @@ func TestDropIndexTask_PreExecute(t *testing.T) {
 	qc.updateState(commonpb.StateCode_Healthy)
 
 	mockCache := newMockCache()
-	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+	mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 		return collectionID, nil
 	})
 	globalMetaCache = mockCache
@@ -153,7 +153,7 @@ func TestDropIndexTask_PreExecute(t *testing.T) {
 
 	t.Run("get collectionID error", func(t *testing.T) {
 		mockCache := newMockCache()
-		mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 0, errors.New("error")
 		})
 		globalMetaCache = mockCache
@@ -161,7 +161,7 @@ func TestDropIndexTask_PreExecute(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+	mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 		return collectionID, nil
 	})
 	globalMetaCache = mockCache
@@ -222,10 +222,10 @@ func TestCreateIndexTask_PreExecute(t *testing.T) {
 	ctx := context.Background()
 
 	mockCache := newMockCache()
-	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+	mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 		return collectionID, nil
 	})
-	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+	mockCache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 		return newTestSchema(), nil
 	})
 	globalMetaCache = mockCache
@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -89,7 +90,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.insertMsg.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.insertMsg.GetDbName(), it.insertMsg.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func (it *insertTask) OnEnqueue() error {
 
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 
 	it.result = &milvuspb.MutationResult{
 		Status: &commonpb.Status{
@@ -126,13 +127,20 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		log.Error("get collection schema from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
 		return err
 	}
 	it.schema = collSchema
 
+	if typeutil.GetPartitionKeyField(collSchema) != nil {
+		if err := validatePartitionKeyModePartitionName(partitionTag); err != nil {
+			log.Error("partition name is specified for partition key collection", zap.String("partition name", partitionTag), zap.Error(err))
+			return err
+		}
+	}
+
 	rowNums := uint32(it.insertMsg.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
@@ -160,6 +168,12 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.result.SuccIndex = sliceIndex
 
+	// fold the undeclared fields into the dynamic field if dynamic schema is enabled
+	if err := fillDynamicFieldData(it.schema, it.insertMsg); err != nil {
+		log.Error("fill dynamic field data failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
@@ -193,25 +207,36 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 
 func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
-	defer sp.Finish()
+	defer sp.End()
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute insert %d", it.ID()))
 	defer tr.Elapse("insert execute done")
 
 	collectionName := it.insertMsg.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
 	it.insertMsg.CollectionID = collID
 	var partitionID UniqueID
-	if len(it.insertMsg.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.insertMsg.PartitionName)
+	// rowPartitionIDs is the partition of every row if the rows are hashed into partitions by the partition key
+	var rowPartitionIDs []UniqueID
+	if partitionKeyField := typeutil.GetPartitionKeyField(it.schema); partitionKeyField != nil {
+		keys, err := getPartitionKeyFieldData(it.insertMsg.GetFieldsData(), partitionKeyField)
+		if err != nil {
+			return err
+		}
+		rowPartitionIDs, err = assignPartitionKeys(ctx, it.insertMsg.GetDbName(), collectionName, keys)
+		if err != nil {
+			return err
+		}
+	} else if len(it.insertMsg.PartitionName) > 0 {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.insertMsg.GetDbName(), collectionName, it.insertMsg.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName.GetValue())
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.insertMsg.GetDbName(), collectionName, Params.CommonCfg.DefaultPartitionName.GetValue())
 		if err != nil {
 			return err
 		}
@@ -245,7 +270,7 @@ func (it *insertTask) Execute(ctx context.Context) error {
 
 	// assign segmentID for insert data and repack data by segmentID
 	var msgPack *msgstream.MsgPack
-	msgPack, err = assignSegmentID(it.TraceCtx(), it.insertMsg, it.result, channelNames, it.idAllocator, it.segIDAssigner)
+	msgPack, err = assignSegmentID(it.TraceCtx(), it.insertMsg, rowPartitionIDs, it.result, channelNames, it.idAllocator, it.segIDAssigner)
 	if err != nil {
 		log.Error("assign segmentID and repack insert data failed",
 			zap.Int64("collectionID", collID),
@@ -50,6 +50,8 @@ type queryTask struct {
 	collectionName string
 	queryParams    *queryParams
 	schema         *schemapb.CollectionSchema
+	dynamicFields  []string
+	nextCursor     string
 
 	resultBuf       chan *internalpb.RetrieveResults
 	toReduceResults []*internalpb.RetrieveResults
@@ -61,6 +63,9 @@ type queryTask struct {
 type queryParams struct {
 	limit  int64
 	offset int64
+
+	iterator bool
+	cursor   *queryCursor
 }
 
 // translateOutputFields translates output fields name to output fields id.
@@ -189,7 +194,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		zap.Any("collectionName", collectionName),
 		zap.Any("requestType", "query"))
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
 		log.Ctx(ctx).Warn("Failed to get collection id.",
 			zap.Any("collectionName", collectionName),
@@ -214,7 +219,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	log.Ctx(ctx).Debug("Validate partition names.",
 		zap.Any("requestType", "query"))
 
-	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		log.Ctx(ctx).Warn("failed to get partitions in collection.", zap.String("collectionName", collectionName),
 			zap.Error(err),
@@ -229,10 +234,16 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := parseQueryIteratorParams(t.request.GetQueryParams(), queryParams); err != nil {
+		return err
+	}
+	if queryParams.cursor != nil && queryParams.cursor.CollectionID != collID {
+		return fmt.Errorf("%s doesn't belong to collection %s", IteratorCursorKey, collectionName)
+	}
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.RetrieveRequest.GetPartitionIDs())
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.RetrieveRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when query, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
@@ -240,7 +251,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when query", collectionName, t.request.GetPartitionNames())
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 	t.schema = schema
 
 	if t.ids != nil {
@@ -261,6 +272,28 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// only query the partitions which the filter expression pins the partition key to
+	if partitionKeyField := typeutil.GetPartitionKeyField(schema); partitionKeyField != nil {
+		if len(t.request.GetPartitionNames()) > 0 {
+			return errPartitionNamesWithPartitionKey
+		}
+		t.RetrieveRequest.PartitionIDs, err = getPartitionIDsByExpr(ctx, t.request.GetDbName(), collectionName, partitionKeyField, plan.GetPredicates())
+		if err != nil {
+			return err
+		}
+	}
+	// the next page of the iterator continues from the last primary key of the previous one
+	if queryParams.cursor != nil {
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return err
+		}
+		if err := appendCursorExpr(plan, pkField, queryParams.cursor); err != nil {
+			return err
+		}
+	}
+	t.dynamicFields = getDynamicOutputFields(t.request.OutputFields, schema)
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
 		return err
@@ -276,6 +309,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	plan.OutputFieldIds = outputFieldIDs
+	// each segment returns at most limit entities with the smallest primary keys in the order of primary keys
+	if t.RetrieveRequest.Limit > 0 {
+		plan.Limit = t.RetrieveRequest.Limit
+	}
 	log.Ctx(ctx).Debug("translate output fields to field ids",
 		zap.Any("OutputFieldsID", t.OutputFieldsId),
 		zap.Any("requestType", "query"))
@@ -299,6 +336,26 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	t.GuaranteeTimestamp = parseGuaranteeTs(guaranteeTs, t.BeginTs())
 
+	// all the pages of the iterator read the snapshot at the timestamp pinned by the first page
+	if queryParams.iterator {
+		if queryParams.cursor != nil {
+			t.TravelTimestamp = queryParams.cursor.Timestamp
+			if err := validateTravelTimestamp(t.TravelTimestamp, t.BeginTs()); err != nil {
+				return err
+			}
+		}
+		t.GuaranteeTimestamp = t.TravelTimestamp
+	}
+
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	t.CollectionTtlTimestamps, err = getCollectionTTLTimestamp(collInfo.properties, t.GuaranteeTimestamp, t.BeginTs())
+	if err != nil {
+		return fmt.Errorf("invalid collection ttl of collection %s, err = %w", collectionName, err)
+	}
+
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
 		t.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
@@ -319,7 +376,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	log := log.Ctx(ctx)
 
 	executeQuery := func(withCache bool) error {
-		shards, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
@@ -391,7 +448,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -416,6 +473,19 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			}
 		}
 	}
+	if err := filterDynamicFieldData(schema, t.result.FieldsData, t.dynamicFields); err != nil {
+		return err
+	}
+	if t.queryParams.iterator {
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return err
+		}
+		t.nextCursor, err = getNextQueryCursor(t.result.FieldsData, pkField, t.CollectionID, t.GetTravelTimestamp(), t.queryParams.limit)
+		if err != nil {
+			return err
+		}
+	}
 	log.Ctx(ctx).Debug("Query PostExecute done",
 		zap.String("requestType", "query"))
 	return nil
@@ -498,18 +568,22 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
-		if queryParams.offset > 0 {
-			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
-				if sel == -1 {
-					return ret, nil
-				}
-				cursors[sel]++
+		// the duplicated primary keys are regarded as one entity by the offset and the limit
+		for skipped := int64(0); skipped < queryParams.offset; {
+			sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+			if sel == -1 {
+				return ret, nil
+			}
+			pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
+			if _, ok := idSet[pk]; !ok {
+				idSet[pk] = struct{}{}
+				skipped++
 			}
+			cursors[sel]++
 		}
 	}
 
-	for j := 0; j < loopEnd; j++ {
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -519,6 +593,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
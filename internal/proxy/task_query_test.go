@@ -20,8 +20,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -94,7 +96,7 @@ func TestQueryTask_all(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -148,6 +150,10 @@ func TestQueryTask_all(t *testing.T) {
 	assert.NoError(t, task.PreExecute(ctx))
 	// after preExecute
 	assert.Greater(t, task.TimeoutTimestamp, typeutil.ZeroTimestamp)
+	// segcore retrieves all the matched entities without limit
+	plan := &planpb.PlanNode{}
+	assert.NoError(t, proto.Unmarshal(task.RetrieveRequest.GetSerializedExprPlan(), plan))
+	assert.Equal(t, int64(0), plan.GetLimit())
 
 	task.ctx = ctx
 	task.queryShardPolicy = errPolicy
@@ -449,6 +455,38 @@ func TestTaskQuery_functions(t *testing.T) {
 			assert.InDeltaSlice(t, FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 		})
 
+		t.Run("test duplicated pks not counted by limit", func(t *testing.T) {
+			result1 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{0, 1},
+						},
+					},
+				},
+				FieldsData: fieldDataArray1,
+			}
+			result2 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{0, 1},
+						},
+					},
+				},
+				FieldsData: fieldDataArray2,
+			}
+
+			result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result1, result2}, &queryParams{limit: 2})
+			assert.NoError(t, err)
+			assert.Equal(t, Int64Array, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+
+			// the duplicated pk skipped by offset is not returned either
+			result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result1, result2}, &queryParams{limit: 2, offset: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, Int64Array[1:], result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+		})
+
 		t.Run("test nil results", func(t *testing.T) {
 			ret, err := reduceRetrieveResults(context.Background(), nil, nil)
 			assert.NoError(t, err)
@@ -23,13 +23,13 @@ import (
 	"fmt"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
 )
 
 type taskQueue interface {
@@ -427,21 +427,21 @@ func (sched *taskScheduler) getTaskByReqID(reqID UniqueID) task {
 
 func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-			"ID":   t.ID(),
-		})
-	defer span.Finish()
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name()),
+			attribute.Int64("ID", t.ID()),
+		))
+	defer span.End()
 	log := log.Ctx(ctx)
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
+	span.AddEvent("scheduler process AddActiveTask")
 	q.AddActiveTask(t)
 
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
+		span.AddEvent("scheduler process PopActiveTask")
 		q.PopActiveTask(t.ID())
 	}()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	span.AddEvent("scheduler process PreExecute")
 
 	err := t.PreExecute(ctx)
 
@@ -454,7 +454,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
+	span.AddEvent("scheduler process Execute")
 	err = t.Execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
@@ -462,7 +462,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
+	span.AddEvent("scheduler process PostExecute")
 	err = t.PostExecute(ctx)
 
 	if err != nil {
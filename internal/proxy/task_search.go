@@ -52,6 +52,9 @@ type searchTask struct {
 	tr             *timerecord.TimeRecorder
 	collectionName string
 	schema         *schemapb.CollectionSchema
+	dynamicFields  []string
+	// groupByFieldAppended is true if the group by field is not an output field requested by user
+	groupByFieldAppended bool
 
 	offset          int64
 	resultBuf       chan *internalpb.SearchResults
@@ -61,14 +64,14 @@ type searchTask struct {
 	shardMgr          *shardClientMgr
 }
 
-func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
+func getPartitionIDs(ctx context.Context, dbName string, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
 	for _, tag := range partitionNames {
 		if err := validatePartitionTag(tag, false); err != nil {
 			return nil, err
 		}
 	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -183,11 +186,6 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 		}
 	}
 
-	queryTopK := topK + offset
-	if err := validateLimit(queryTopK); err != nil {
-		return nil, 0, fmt.Errorf("%s+%s [%d] is invalid, %w", OffsetKey, TopKKey, queryTopK, err)
-	}
-
 	metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, searchParamsPair)
 	if err != nil {
 		return nil, 0, errors.New(common.MetricTypeKey + " not found in search_params")
@@ -210,6 +208,23 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	if err != nil {
 		return nil, 0, err
 	}
+	// radius and range_filter are carried to segcore within search params, segcore keeps searching until the range
+	// is exhausted and returns the hits in the range up to the limit, which is range_limit instead of topk
+	rangeParams, err := distance.ParseRangeSearchParams(searchParamStr, metricType)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rangeParams != nil {
+		topK, err = parseRangeLimit(searchParamsPair, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	queryTopK := topK + offset
+	if err := validateLimit(queryTopK); err != nil {
+		return nil, 0, fmt.Errorf("%s+%s [%d] is invalid, %w", OffsetKey, TopKKey, queryTopK, err)
+	}
 	return &planpb.QueryInfo{
 		Topk:         queryTopK,
 		MetricType:   metricType,
@@ -218,6 +233,56 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// parseRangeLimit returns the max number of hits per query of a range search, all the hits the topk limit allows
+// after the offset by default. It's only a bound, segcore searches as many hits as there are in the range.
+func parseRangeLimit(searchParamsPair []*commonpb.KeyValuePair, offset int64) (int64, error) {
+	rangeLimitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeLimitKey, searchParamsPair)
+	if err != nil {
+		return Params.CommonCfg.TopKLimit.GetAsInt64() - offset, nil
+	}
+	rangeLimit, err := strconv.ParseInt(rangeLimitStr, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s [%s] is invalid", RangeLimitKey, rangeLimitStr)
+	}
+	if err := validateLimit(rangeLimit); err != nil {
+		return 0, fmt.Errorf("%s [%d] is invalid, %w", RangeLimitKey, rangeLimit, err)
+	}
+	return rangeLimit, nil
+}
+
+// parseGroupByInfo returns the id of the field to group search results by and the max number of hits in a group,
+// zero field id is returned if search results are not grouped.
+func parseGroupByInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (int64, int64, error) {
+	groupByFieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil || groupByFieldName == "" {
+		return 0, 0, nil
+	}
+
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if field.GetName() == groupByFieldName {
+			groupByField = field
+			break
+		}
+	}
+	if groupByField == nil {
+		return 0, 0, fmt.Errorf("group by field %s not exist", groupByFieldName)
+	}
+	if !typeutil.IsGroupByFieldType(groupByField.GetDataType()) {
+		return 0, 0, fmt.Errorf("group by field %s of type %s is not supported", groupByFieldName, groupByField.GetDataType().String())
+	}
+
+	groupSize := int64(1)
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return 0, 0, fmt.Errorf("%s [%s] is invalid, should be a positive integer", GroupSizeKey, groupSizeStr)
+		}
+	}
+	return groupByField.GetFieldID(), groupSize, nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -259,7 +324,7 @@ func getNq(req *milvuspb.SearchRequest) (int64, error) {
 
 func (t *searchTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-Search-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 
 	if t.searchShardPolicy == nil {
 		t.searchShardPolicy = mergeRoundRobinPolicy
@@ -270,23 +335,28 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 	collectionName := t.request.CollectionName
 	t.collectionName = collectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
 
 	t.SearchRequest.DbID = 0 // todo
 	t.SearchRequest.CollectionID = collID
-	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
+
+	partitionKeyField := typeutil.GetPartitionKeyField(t.schema)
+	if partitionKeyField != nil && len(t.request.GetPartitionNames()) > 0 {
+		return errPartitionNamesWithPartitionKey
+	}
 
 	// translate partition name to partition ids. Use regex-pattern to match partition name.
-	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		return err
 	}
 
 	// check if collection/partitions are loaded into query node
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.SearchRequest.GetPartitionIDs())
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.SearchRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when search, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
@@ -294,6 +364,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when search", collectionName, t.request.GetPartitionNames())
 	}
 
+	t.dynamicFields = getDynamicOutputFields(t.request.OutputFields, t.schema)
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		return err
@@ -313,6 +384,20 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 		t.offset = offset
 
+		groupByFieldID, groupSize, err := parseGroupByInfo(t.request.GetSearchParams(), t.schema)
+		if err != nil {
+			return err
+		}
+		if groupByFieldID > 0 {
+			// topk bounds the hits of all the groups, query nodes search more hits if the hits fall into less groups
+			queryInfo.Topk *= groupSize
+			if err := validateLimit(queryInfo.GetTopk()); err != nil {
+				return fmt.Errorf("(%s+%s)*%s [%d] is invalid, %w", OffsetKey, TopKKey, GroupSizeKey, queryInfo.GetTopk(), err)
+			}
+			t.SearchRequest.GroupByFieldId = groupByFieldID
+			t.SearchRequest.GroupSize = groupSize
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err),
@@ -324,10 +409,23 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
+		// only search the partitions which the filter expression pins the partition key to
+		if partitionKeyField != nil {
+			t.SearchRequest.PartitionIDs, err = getPartitionIDsByExpr(ctx, t.request.GetDbName(), collectionName, partitionKeyField, plan.GetVectorAnns().GetPredicates())
+			if err != nil {
+				return err
+			}
+		}
+
 		outputFieldIDs, err := getOutputFieldIDs(t.schema, t.request.GetOutputFields())
 		if err != nil {
 			return err
 		}
+		if groupByFieldID > 0 && !funcutil.SliceContain(outputFieldIDs, groupByFieldID) {
+			// group by values are required while reducing, they are removed from the final result
+			outputFieldIDs = append(outputFieldIDs, groupByFieldID)
+			t.groupByFieldAppended = true
+		}
 
 		t.SearchRequest.OutputFieldsId = outputFieldIDs
 		plan.OutputFieldIds = outputFieldIDs
@@ -359,6 +457,15 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	guaranteeTs = parseGuaranteeTs(guaranteeTs, t.BeginTs())
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	t.SearchRequest.CollectionTtlTimestamps, err = getCollectionTTLTimestamp(collInfo.properties, guaranteeTs, t.BeginTs())
+	if err != nil {
+		return fmt.Errorf("invalid collection ttl of collection %s, err = %w", collectionName, err)
+	}
+
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
 		t.SearchRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
 	}
@@ -386,14 +493,14 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 func (t *searchTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-Search-Execute")
-	defer sp.Finish()
+	defer sp.End()
 	log := log.Ctx(ctx)
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
 
 	executeSearch := func(withCache bool) error {
-		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
@@ -422,7 +529,7 @@ func (t *searchTask) Execute(ctx context.Context) error {
 
 func (t *searchTask) PostExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-Search-PostExecute")
-	defer sp.Finish()
+	defer sp.End()
 
 	tr := timerecord.NewTimeRecorder("searchTask PostExecute")
 	defer func() {
@@ -464,15 +571,26 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	if groupByFieldID := t.SearchRequest.GetGroupByFieldId(); groupByFieldID > 0 {
+		t.result, err = reduceSearchResultDataWithGroupBy(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType,
+			t.offset, groupByFieldID, t.SearchRequest.GetGroupSize())
+	} else {
+		t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	}
 	if err != nil {
 		return err
 	}
+	if t.groupByFieldAppended {
+		t.removeGroupByFieldData()
+	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
+	if err := filterDynamicFieldData(t.schema, t.result.GetResults().GetFieldsData(), t.dynamicFields); err != nil {
+		return err
+	}
 
 	log.Ctx(ctx).Debug("Search post execute done")
 	return nil
@@ -525,6 +643,15 @@ func (t *searchTask) fillInEmptyResult(numQueries int64) {
 	}
 }
 
+// removeGroupByFieldData removes the group by field data which is not requested by user
+func (t *searchTask) removeGroupByFieldData() {
+	// the group by field is appended after the output fields
+	fieldsData := t.result.GetResults().GetFieldsData()
+	if len(fieldsData) > len(t.request.GetOutputFields()) {
+		t.result.Results.FieldsData = fieldsData[:len(t.request.GetOutputFields())]
+	}
+}
+
 func (t *searchTask) fillInFieldInfo() {
 	if len(t.request.OutputFields) != 0 && len(t.result.Results.FieldsData) != 0 {
 		for i, name := range t.request.OutputFields {
@@ -557,8 +684,8 @@ func (t *searchTask) collectSearchResults(ctx context.Context) error {
 }
 
 // checkIfLoaded check if collection was loaded into QueryNode
-func checkIfLoaded(ctx context.Context, qc types.QueryCoord, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
-	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+func checkIfLoaded(ctx context.Context, qc types.QueryCoord, dbName string, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
+	info, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
 	if err != nil {
 		return false, fmt.Errorf("GetCollectionInfo failed, collection = %s, err = %s", collectionName, err)
 	}
@@ -664,20 +791,9 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
-func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
-	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
-	defer func() {
-		tr.CtxElapse(ctx, "done")
-	}()
-
-	limit := topk - offset
-	log.Ctx(ctx).Debug("reduceSearchResultData",
-		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
-		zap.Int64("nq", nq),
-		zap.Int64("offset", offset),
-		zap.Int64("limit", limit),
-		zap.String("metricType", metricType))
-
+// initReducedSearchResults creates the reduce result and checks the sub search results,
+// it also returns the start offset of each query of nq queries for each sub search result.
+func initReducedSearchResults(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, pkType schemapb.DataType) (*milvuspb.SearchResults, [][]int64, error) {
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -706,7 +822,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			},
 		}
 	default:
-		return nil, errors.New("unsupported pk type")
+		return nil, nil, errors.New("unsupported pk type")
 	}
 
 	for i, sData := range subSearchResultData {
@@ -717,7 +833,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			zap.Any("length of FieldsData", len(sData.FieldsData)))
 		if err := checkSearchResultData(sData, nq, topk); err != nil {
 			log.Ctx(ctx).Warn("invalid search results", zap.Error(err))
-			return ret, err
+			return ret, nil, err
 		}
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
@@ -733,6 +849,37 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
 		}
 	}
+	return ret, subSearchNqOffset, nil
+}
+
+// restoreDistances turns the scores back to distances for metrics which are not positively related
+func restoreDistances(ret *milvuspb.SearchResults, metricType string) {
+	if !distance.PositivelyRelated(metricType) {
+		for k := range ret.Results.Scores {
+			ret.Results.Scores[k] *= -1
+		}
+	}
+}
+
+func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	limit := topk - offset
+	log.Ctx(ctx).Debug("reduceSearchResultData",
+		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
+		zap.Int64("nq", nq),
+		zap.Int64("offset", offset),
+		zap.Int64("limit", limit),
+		zap.String("metricType", metricType))
+
+	ret, subSearchNqOffset, err := initReducedSearchResults(ctx, subSearchResultData, nq, topk, pkType)
+	if err != nil {
+		return ret, err
+	}
+	subSearchNum := len(subSearchResultData)
 
 	var (
 		skipDupCnt int64
@@ -801,12 +948,88 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 	}
 
 	ret.Results.TopK = realTopK // realTopK is the topK of the nq-th query
-	if !distance.PositivelyRelated(metricType) {
-		for k := range ret.Results.Scores {
-			ret.Results.Scores[k] *= -1
+	restoreDistances(ret, metricType)
+	// printSearchResultData(ret.Results, "proxy reduce result")
+	return ret, nil
+}
+
+// reduceSearchResultDataWithGroupBy merges the search results grouped by a scalar field,
+// topk is the number of groups multiplied by groupSize, and the hits of the first offset groups are skipped.
+func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, groupByFieldID int64, groupSize int64) (*milvuspb.SearchResults, error) {
+	tr := timerecord.NewTimeRecorder("reduceSearchResultDataWithGroupBy")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	groupTopK := topk / groupSize
+	limit := (groupTopK - offset) * groupSize
+	log.Ctx(ctx).Debug("reduceSearchResultDataWithGroupBy",
+		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
+		zap.Int64("nq", nq),
+		zap.Int64("offset", offset),
+		zap.Int64("groupTopK", groupTopK),
+		zap.Int64("groupByFieldID", groupByFieldID),
+		zap.Int64("groupSize", groupSize),
+		zap.String("metricType", metricType))
+
+	ret, subSearchNqOffset, err := initReducedSearchResults(ctx, subSearchResultData, nq, topk, pkType)
+	if err != nil {
+		return ret, err
+	}
+	subSearchNum := len(subSearchResultData)
+
+	var (
+		skipDupCnt int64
+		maxTopK    int64
+	)
+
+	for i := int64(0); i < nq; i++ {
+		var (
+			cursors = make([]int64, subSearchNum)
+
+			j       int64
+			idSet   = make(map[interface{}]struct{})
+			groupBy = typeutil.NewSearchGroupBy(groupTopK, groupSize)
+		)
+
+		for j < limit {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+
+			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+			// remove duplicates
+			if _, ok := idSet[id]; ok {
+				skipDupCnt++
+				continue
+			}
+			idSet[id] = struct{}{}
+
+			// skip the hits of full groups, groups out of top groups and the first offset groups
+			groupValue := typeutil.GetGroupByValue(subSearchResultData[subSearchIdx].FieldsData, groupByFieldID, resultDataIdx)
+			if rank, ok := groupBy.Add(groupValue); !ok || rank < offset {
+				continue
+			}
+
+			typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, id)
+			ret.Results.Scores = append(ret.Results.Scores, subSearchResultData[subSearchIdx].Scores[resultDataIdx])
+			j++
 		}
+		if j > maxTopK {
+			maxTopK = j
+		}
+		ret.Results.Topks = append(ret.Results.Topks, j)
 	}
-	// printSearchResultData(ret.Results, "proxy reduce result")
+
+	if skipDupCnt > 0 {
+		log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
+	}
+
+	ret.Results.TopK = maxTopK
+	restoreDistances(ret, metricType)
 	return ret, nil
 }
 
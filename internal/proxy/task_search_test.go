@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
@@ -229,7 +230,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("test checkIfLoaded error", func(t *testing.T) {
 		collName := "test_checkIfLoaded_error" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		_, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		_, err := globalMetaCache.GetCollectionID(context.TODO(), util.DefaultDBName, collName)
 		require.NoError(t, err)
 		task := getSearchTask(t, collName)
 		task.collectionName = collName
@@ -255,7 +256,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("search with timeout", func(t *testing.T) {
 		collName := "search_with_timeout" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), util.DefaultDBName, collName)
 		require.NoError(t, err)
 		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -1560,33 +1561,162 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 	})
 }
 
+func TestTaskSearch_reduceSearchResultDataWithGroupBy(t *testing.T) {
+	const groupByFieldID = 101
+	genGroupByResultData := func(topk int64, ids []int64, scores []float32, groups []string) *schemapb.SearchResultData {
+		r := getSearchResultData(1, topk)
+		r.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		r.Scores = scores
+		r.Topks = []int64{int64(len(ids))}
+		r.FieldsData = []*schemapb.FieldData{{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: groupByFieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: groups},
+					},
+				},
+			},
+		}}
+		return r
+	}
+
+	tests := []struct {
+		description string
+		topk        int64
+		offset      int64
+		groupSize   int64
+
+		outData   []int64
+		outScore  []float32
+		outGroups []string
+	}{
+		{"2 groups, group size 2", 4, 0, 2,
+			[]int64{1, 5, 2, 7}, []float32{10, 9.5, 9, 7.5}, []string{"a", "c", "a", "c"}},
+		{"3 groups, group size 1", 3, 0, 1,
+			[]int64{1, 5, 6}, []float32{10, 9.5, 8.5}, []string{"a", "c", "b"}},
+		{"offset 1 group", 4, 1, 2,
+			[]int64{5, 7}, []float32{9.5, 7.5}, []string{"c", "c"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			results := []*schemapb.SearchResultData{
+				genGroupByResultData(test.topk, []int64{1, 2, 3, 4}, []float32{10, 9, 8, 7}, []string{"a", "a", "a", "b"}),
+				genGroupByResultData(test.topk, []int64{5, 6, 7, 8}, []float32{9.5, 8.5, 7.5, 6.5}, []string{"c", "b", "c", "c"}),
+			}
+			reduced, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, 1, test.topk, distance.IP,
+				schemapb.DataType_Int64, test.offset, groupByFieldID, test.groupSize)
+			assert.NoError(t, err)
+			assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
+			assert.Equal(t, test.outScore, reduced.GetResults().GetScores())
+			assert.Equal(t, test.outGroups, reduced.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+			assert.Equal(t, []int64{int64(len(test.outData))}, reduced.GetResults().GetTopks())
+		})
+	}
+
+	t.Run("unsupported pk type", func(t *testing.T) {
+		results := []*schemapb.SearchResultData{
+			genGroupByResultData(2, []int64{1, 2}, []float32{10, 9}, []string{"a", "b"}),
+		}
+		_, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, 1, 2, distance.IP,
+			schemapb.DataType_Float, 0, groupByFieldID, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestTaskSearch_parseGroupByInfo(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	t.Run("no group by", func(t *testing.T) {
+		fieldID, groupSize, err := parseGroupByInfo(getValidSearchParams(), schema)
+		assert.NoError(t, err)
+		assert.Zero(t, fieldID)
+		assert.Zero(t, groupSize)
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		fieldID, groupSize, err := parseGroupByInfo([]*commonpb.KeyValuePair{
+			{Key: GroupByFieldKey, Value: "doc_id"},
+		}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), fieldID)
+		assert.Equal(t, int64(1), groupSize)
+
+		fieldID, groupSize, err = parseGroupByInfo([]*commonpb.KeyValuePair{
+			{Key: GroupByFieldKey, Value: "pk"},
+			{Key: GroupSizeKey, Value: "3"},
+		}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), fieldID)
+		assert.Equal(t, int64(3), groupSize)
+	})
+
+	t.Run("invalid group by", func(t *testing.T) {
+		invalidParams := [][]*commonpb.KeyValuePair{
+			{{Key: GroupByFieldKey, Value: "not_exist"}},
+			{{Key: GroupByFieldKey, Value: "score"}},
+			{{Key: GroupByFieldKey, Value: "vec"}},
+			{{Key: GroupByFieldKey, Value: "doc_id"}, {Key: GroupSizeKey, Value: "0"}},
+			{{Key: GroupByFieldKey, Value: "doc_id"}, {Key: GroupSizeKey, Value: "invalid"}},
+		}
+		for _, params := range invalidParams {
+			_, _, err := parseGroupByInfo(params, schema)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestSearchTask_removeGroupByFieldData(t *testing.T) {
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{GroupByFieldId: 101},
+		request:       &milvuspb.SearchRequest{OutputFields: []string{"int64"}},
+		result: &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				FieldsData: []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}},
+			},
+		},
+	}
+	task.removeGroupByFieldData()
+	assert.Equal(t, 1, len(task.result.GetResults().GetFieldsData()))
+	assert.Equal(t, int64(100), task.result.GetResults().GetFieldsData()[0].GetFieldId())
+}
+
 func Test_checkIfLoaded(t *testing.T) {
 	t.Run("failed to get collection info", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return nil, errors.New("mock")
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		_, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{})
 		assert.Error(t, err)
 	})
 
 	t.Run("collection loaded", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: true}, nil
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
 
 	t.Run("show partitions failed", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1594,13 +1724,13 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
 	t.Run("show partitions but didn't success", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1608,13 +1738,13 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
 	t.Run("partitions loaded", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1622,14 +1752,14 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 100}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
 
 	t.Run("partitions loaded, some patitions not fully loaded", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1637,14 +1767,14 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 50}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
 
 	t.Run("no specified partitions, show partitions failed", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1652,13 +1782,13 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
 	t.Run("no specified partitions, show partitions but didn't succeed", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1666,13 +1796,13 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
 	t.Run("not fully loaded", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1680,14 +1810,14 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{1, 2}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
 
 	t.Run("not loaded", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		cache.setGetInfoFunc(func(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 			return &collectionInfo{isLoaded: false}, nil
 		})
 		globalMetaCache = cache
@@ -1695,7 +1825,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, util.DefaultDBName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1764,7 +1894,7 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -1863,6 +1993,32 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 		assert.Equal(t, targetOffset, offset)
 	})
 
+	t.Run("parseSearchInfo range search", func(t *testing.T) {
+		sp := []*commonpb.KeyValuePair{
+			{Key: AnnsFieldKey, Value: testFloatVecField},
+			{Key: TopKKey, Value: "10"},
+			{Key: common.MetricTypeKey, Value: distance.L2},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10, "radius": 2.0, "range_filter": 1.0}`},
+		}
+
+		info, _, err := parseSearchInfo(sp)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"nprobe": 10, "radius": 2.0, "range_filter": 1.0}`, info.GetSearchParams())
+		// topk doesn't bound the hits of a range search
+		assert.Equal(t, Params.CommonCfg.TopKLimit.GetAsInt64(), info.GetTopk())
+
+		info, offset, err := parseSearchInfo(append(sp,
+			&commonpb.KeyValuePair{Key: RangeLimitKey, Value: "100"},
+			&commonpb.KeyValuePair{Key: OffsetKey, Value: "5"}))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), offset)
+		assert.Equal(t, int64(105), info.GetTopk())
+
+		info, _, err = parseSearchInfo(append(sp, &commonpb.KeyValuePair{Key: OffsetKey, Value: "5"}))
+		assert.NoError(t, err)
+		assert.Equal(t, Params.CommonCfg.TopKLimit.GetAsInt64(), info.GetTopk())
+	})
+
 	t.Run("parseSearchInfo error", func(t *testing.T) {
 		spNoTopk := []*commonpb.KeyValuePair{{
 			Key:   AnnsFieldKey,
@@ -1924,6 +2080,25 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 			Value: "16386",
 		})
 
+		spInvalidRangeFilter := []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: common.MetricTypeKey, Value: distance.L2},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10, "radius": 1.0, "range_filter": 2.0}`},
+		}
+
+		spInvalidRangeLimit := []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: RangeLimitKey, Value: "0"},
+			{Key: common.MetricTypeKey, Value: distance.L2},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10, "radius": 2.0}`},
+		}
+
+		spRangeFilterWithoutRadius := []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: common.MetricTypeKey, Value: distance.IP},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10, "range_filter": 2.0}`},
+		}
+
 		tests := []struct {
 			description   string
 			invalidParams []*commonpb.KeyValuePair
@@ -1939,6 +2114,9 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 			{"Invalid_offset_not_int", spInvalidOffsetNoInt},
 			{"Invalid_offset_negative", spInvalidOffsetNegative},
 			{"Invalid_offset_too_large", spInvalidOffsetTooLarge},
+			{"Invalid_range_filter", spInvalidRangeFilter},
+			{"Range_filter_without_radius", spRangeFilterWithoutRadius},
+			{"Invalid_range_limit", spInvalidRangeLimit},
 		}
 
 		for _, test := range tests {
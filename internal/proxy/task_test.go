@@ -27,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -751,6 +752,19 @@ func TestCreateCollectionTask(t *testing.T) {
 		} else {
 			assert.Error(t, err)
 		}
+
+		// dynamic schema
+		task.CreateCollectionRequest.Schema = marshaledSchema
+		task.CreateCollectionRequest.Properties = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldKey, Value: "dummy"}}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+
+		task.CreateCollectionRequest.Schema = marshaledSchema
+		task.CreateCollectionRequest.Properties = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldKey, Value: "true"}}
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, typeutil.GetDynamicField(task.schema))
+		task.CreateCollectionRequest.Properties = nil
 	})
 }
 
@@ -818,7 +832,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -937,7 +951,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -999,7 +1013,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1099,7 +1113,7 @@ func TestDropPartitionTask(t *testing.T) {
 	qc := NewQueryCoordMock(withValidShardLeaders(), SetQueryCoordShowPartitionsFunc(showPartitionsMock))
 	qc.updateState(commonpb.StateCode_Healthy)
 	mockCache := newMockCache()
-	mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
 		return 1, nil
 	})
 	globalMetaCache = mockCache
@@ -1142,10 +1156,10 @@ func TestDropPartitionTask(t *testing.T) {
 
 	t.Run("get collectionID error", func(t *testing.T) {
 		mockCache := newMockCache()
-		mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
 			return 1, nil
 		})
-		mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 0, errors.New("error")
 		})
 		globalMetaCache = mockCache
@@ -1158,10 +1172,10 @@ func TestDropPartitionTask(t *testing.T) {
 		task.PartitionName = "partition2"
 
 		mockCache := newMockCache()
-		mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
 			return 0, ErrPartitionNotExist(task.PartitionName)
 		})
-		mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 1, nil
 		})
 		globalMetaCache = mockCache
@@ -1173,10 +1187,10 @@ func TestDropPartitionTask(t *testing.T) {
 		task.PartitionName = "partition3"
 
 		mockCache := newMockCache()
-		mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetPartitionIDFunc(func(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
 			return 0, errors.New("error")
 		})
-		mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		mockCache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 1, nil
 		})
 		globalMetaCache = mockCache
@@ -1235,7 +1249,13 @@ func TestShowPartitionsTask(t *testing.T) {
 	rc := NewRootCoordMock()
 	rc.Start()
 	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
 	ctx := context.Background()
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rc, qc, mgr)
+	assert.NoError(t, err)
 	prefix := "TestShowPartitionsTask"
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
@@ -1265,7 +1285,7 @@ func TestShowPartitionsTask(t *testing.T) {
 	assert.Equal(t, Timestamp(100), task.BeginTs())
 	assert.Equal(t, Timestamp(100), task.EndTs())
 	assert.Equal(t, paramtable.GetNodeID(), task.GetBase().GetSourceID())
-	err := task.Execute(ctx)
+	err = task.Execute(ctx)
 	assert.NotNil(t, err)
 
 	task.CollectionName = "#0xc0de"
@@ -1357,7 +1377,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1609,7 +1629,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1911,6 +1931,68 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestRenameCollectionTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestRenameCollectionTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+	newName := prefix + funcutil.GenRandomStr()
+
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	task := &renameCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		RenameCollectionRequest: &proxypb.RenameCollectionRequest{
+			OldName: collectionName,
+			NewName: newName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	assert.Equal(t, RenameCollectionTaskName, task.Name())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+
+	resp, err := rc.HasCollection(ctx, &milvuspb.HasCollectionRequest{CollectionName: newName})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetValue())
+
+	t.Run("invalid name", func(t *testing.T) {
+		task.OldName = "#0xc0de"
+		assert.Error(t, task.PreExecute(ctx))
+		task.OldName = newName
+		task.NewName = "#0xc0de"
+		assert.Error(t, task.PreExecute(ctx))
+		task.NewName = newName
+		assert.Error(t, task.PreExecute(ctx))
+	})
+}
+
 func Test_createIndexTask_getIndexedField(t *testing.T) {
 	collectionName := "test"
 	fieldName := "test"
@@ -1924,7 +2006,7 @@ func Test_createIndexTask_getIndexedField(t *testing.T) {
 
 	t.Run("normal", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 			return &schemapb.CollectionSchema{
 				Fields: []*schemapb.FieldSchema{
 					{
@@ -1952,7 +2034,7 @@ func Test_createIndexTask_getIndexedField(t *testing.T) {
 
 	t.Run("schema not found", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 			return nil, errors.New("mock")
 		})
 		globalMetaCache = cache
@@ -1962,7 +2044,7 @@ func Test_createIndexTask_getIndexedField(t *testing.T) {
 
 	t.Run("invalid schema", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 			return &schemapb.CollectionSchema{
 				Fields: []*schemapb.FieldSchema{
 					{
@@ -1981,7 +2063,7 @@ func Test_createIndexTask_getIndexedField(t *testing.T) {
 
 	t.Run("field not found", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 			return &schemapb.CollectionSchema{
 				Fields: []*schemapb.FieldSchema{
 					{
@@ -2133,10 +2215,10 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 
 	t.Run("normal", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		cache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 100, nil
 		})
-		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 			return &schemapb.CollectionSchema{
 				Fields: []*schemapb.FieldSchema{
 					{
@@ -2176,7 +2258,7 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 
 	t.Run("collection not found", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		cache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 0, errors.New("mock")
 		})
 		globalMetaCache = cache
@@ -2185,7 +2267,7 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 
 	t.Run("index name length exceed 255", func(t *testing.T) {
 		cache := newMockCache()
-		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		cache.setGetIDFunc(func(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 			return 100, nil
 		})
 		globalMetaCache = cache
@@ -0,0 +1,348 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// upsertTask deletes the entities with the primary keys carried by the request and inserts
+// the new entities. The delete and the insert messages share one timestamp and are produced
+// in one message pack, so the old and the new version of an entity are never visible at the
+// same time, and there is no timestamp at which the entity is missing.
+type upsertTask struct {
+	Condition
+	ctx context.Context
+
+	req       *proxypb.UpsertRequest
+	insertMsg *BaseInsertTask
+	deleteMsg *BaseDeleteTask
+
+	result        *milvuspb.MutationResult
+	idAllocator   *allocator.IDAllocator
+	segIDAssigner *segIDAssigner
+	chMgr         channelsMgr
+	chTicker      channelsTimeTicker
+	schema        *schemapb.CollectionSchema
+}
+
+// TraceCtx returns upsertTask context
+func (ut *upsertTask) TraceCtx() context.Context {
+	return ut.ctx
+}
+
+func (ut *upsertTask) ID() UniqueID {
+	return ut.insertMsg.Base.MsgID
+}
+
+func (ut *upsertTask) SetID(uid UniqueID) {
+	ut.insertMsg.Base.MsgID = uid
+	ut.deleteMsg.Base.MsgID = uid
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return ut.insertMsg.Base.MsgType
+}
+
+func (ut *upsertTask) BeginTs() Timestamp {
+	return ut.insertMsg.BeginTimestamp
+}
+
+// SetTs sets the same timestamp to both the delete and the insert part of the upsert.
+func (ut *upsertTask) SetTs(ts Timestamp) {
+	ut.insertMsg.BeginTimestamp = ts
+	ut.insertMsg.EndTimestamp = ts
+	ut.deleteMsg.Base.Timestamp = ts
+}
+
+func (ut *upsertTask) EndTs() Timestamp {
+	return ut.insertMsg.EndTimestamp
+}
+
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	ret := make(map[pChan]pChanStatistics)
+
+	channels, err := ut.getChannels()
+	if err != nil {
+		return ret, err
+	}
+
+	beginTs := ut.BeginTs()
+	endTs := ut.EndTs()
+
+	for _, channel := range channels {
+		ret[channel] = pChanStatistics{
+			minTs: beginTs,
+			maxTs: endTs,
+		}
+	}
+	return ret, nil
+}
+
+func (ut *upsertTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(ut.ctx, ut.req.GetDbName(), ut.req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	return ut.chMgr.getChannels(collID)
+}
+
+func (ut *upsertTask) OnEnqueue() error {
+	return nil
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.End()
+
+	ut.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: ut.EndTs(),
+	}
+
+	collectionName := ut.req.GetCollectionName()
+	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
+	if err := validateCollectionName(collectionName); err != nil {
+		log.Error("valid collection name failed", zap.Error(err))
+		return err
+	}
+
+	partitionTag := ut.insertMsg.PartitionName
+	if err := validatePartitionTag(partitionTag, true); err != nil {
+		log.Error("valid partition name failed", zap.String("partition name", partitionTag), zap.Error(err))
+		return err
+	}
+
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.req.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema from global meta cache failed", zap.Error(err))
+		return err
+	}
+	if typeutil.GetPartitionKeyField(collSchema) != nil {
+		if err := validatePartitionKeyModePartitionName(partitionTag); err != nil {
+			log.Error("partition name is specified for partition key collection", zap.String("partition name", partitionTag), zap.Error(err))
+			return err
+		}
+	}
+	ut.schema = collSchema
+
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(collSchema)
+	if err != nil {
+		log.Error("get primary field schema failed", zap.Error(err))
+		return err
+	}
+	// the primary keys of the entities to replace are provided by the user,
+	// upsert makes no sense if the primary keys are generated by the proxy.
+	if primaryFieldSchema.GetAutoID() {
+		err = fmt.Errorf("upsert is not supported when auto id enabled, primary field: %s", primaryFieldSchema.GetName())
+		log.Error("upsert on auto id collection", zap.Error(err))
+		return err
+	}
+
+	rowNums := uint32(ut.insertMsg.NRows())
+	// set upsertTask.rowIDs
+	var rowIDBegin UniqueID
+	var rowIDEnd UniqueID
+	tr := timerecord.NewTimeRecorder("applyPK")
+	rowIDBegin, rowIDEnd, _ = ut.idAllocator.Alloc(rowNums)
+	metrics.ProxyApplyPrimaryKeyLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	ut.insertMsg.RowIDs = make([]UniqueID, rowNums)
+	for i := rowIDBegin; i < rowIDEnd; i++ {
+		offset := i - rowIDBegin
+		ut.insertMsg.RowIDs[offset] = i
+	}
+	// set upsertTask.timeStamps, both the inserted and the deleted entities use the task timestamp
+	ut.insertMsg.Timestamps = make([]uint64, rowNums)
+	for index := range ut.insertMsg.Timestamps {
+		ut.insertMsg.Timestamps[index] = ut.insertMsg.BeginTimestamp
+	}
+
+	// set result.SuccIndex
+	sliceIndex := make([]uint32, rowNums)
+	for i := uint32(0); i < rowNums; i++ {
+		sliceIndex[i] = i
+	}
+	ut.result.SuccIndex = sliceIndex
+
+	// fold the undeclared fields into the dynamic field if dynamic schema is enabled
+	if err := fillDynamicFieldData(ut.schema, ut.insertMsg); err != nil {
+		log.Error("fill dynamic field data failed", zap.Error(err))
+		return err
+	}
+
+	ut.result.IDs, err = checkPrimaryFieldData(ut.schema, ut.insertMsg)
+	if err != nil {
+		log.Error("check primary field data failed", zap.Error(err))
+		return err
+	}
+
+	// set field ID to insert field data
+	err = fillFieldIDBySchema(ut.insertMsg.GetFieldsData(), collSchema)
+	if err != nil {
+		log.Error("set fieldID to fieldData failed", zap.Error(err))
+		return err
+	}
+
+	// check that all field's number rows are equal
+	if err = ut.insertMsg.CheckAligned(); err != nil {
+		log.Error("field data is not aligned", zap.Error(err))
+		return err
+	}
+
+	// the old entities are deleted from all the partitions, so that the primary key
+	// stays unique in the collection even if the entity moves to another partition.
+	ut.deleteMsg.CollectionName = collectionName
+	ut.deleteMsg.PartitionID = common.InvalidPartitionID
+	ut.deleteMsg.PrimaryKeys = ut.result.IDs
+	ut.deleteMsg.NumRows = int64(rowNums)
+	ut.deleteMsg.Timestamps = make([]uint64, rowNums)
+	for index := range ut.deleteMsg.Timestamps {
+		ut.deleteMsg.Timestamps[index] = ut.BeginTs()
+	}
+
+	log.Debug("Proxy Upsert PreExecute done")
+
+	return nil
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.End()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute upsert %d", ut.ID()))
+	defer tr.Elapse("upsert execute done")
+
+	collectionName := ut.req.GetCollectionName()
+	collID, err := globalMetaCache.GetCollectionID(ctx, ut.req.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	ut.insertMsg.CollectionID = collID
+	ut.deleteMsg.CollectionID = collID
+	var partitionID UniqueID
+	// rowPartitionIDs is the partition of every row if the rows are hashed into partitions by the partition key
+	var rowPartitionIDs []UniqueID
+	if partitionKeyField := typeutil.GetPartitionKeyField(ut.schema); partitionKeyField != nil {
+		keys, err := getPartitionKeyFieldData(ut.insertMsg.GetFieldsData(), partitionKeyField)
+		if err != nil {
+			return err
+		}
+		rowPartitionIDs, err = assignPartitionKeys(ctx, ut.req.GetDbName(), collectionName, keys)
+		if err != nil {
+			return err
+		}
+	} else {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, ut.req.GetDbName(), collectionName, ut.insertMsg.PartitionName)
+		if err != nil {
+			return err
+		}
+	}
+	ut.insertMsg.PartitionID = partitionID
+	tr.Record("get collection id & partition id from cache")
+
+	stream, err := ut.chMgr.getOrCreateDmlStream(collID)
+	if err != nil {
+		return err
+	}
+	tr.Record("get used message stream")
+
+	channelNames, err := ut.chMgr.getVChannels(collID)
+	if err != nil {
+		log.Ctx(ctx).Error("get vChannels failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	log.Ctx(ctx).Debug("send upsert request to virtual channels",
+		zap.String("collection", collectionName),
+		zap.String("partition", ut.insertMsg.GetPartitionName()),
+		zap.Int64("collection_id", collID),
+		zap.Int64("partition_id", partitionID),
+		zap.Strings("virtual_channels", channelNames),
+		zap.Int64("task_id", ut.ID()))
+
+	// assign segmentID for insert data and repack data by segmentID
+	insertPack, err := assignSegmentID(ut.TraceCtx(), ut.insertMsg, rowPartitionIDs, ut.result, channelNames, ut.idAllocator, ut.segIDAssigner)
+	if err != nil {
+		log.Error("assign segmentID and repack insert data failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	tr.Record("assign segment id")
+
+	// the primary keys are hashed to the same channels as the insert data,
+	// delete messages go first so that they are consumed before the new entities.
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+	}
+	msgPack.Msgs = append(msgPack.Msgs, repackDeleteMsgByHash(ctx, ut.deleteMsg, channelNames)...)
+	msgPack.Msgs = append(msgPack.Msgs, insertPack.Msgs...)
+	tr.Record("pack messages")
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	sendMsgDur := tr.Record("send upsert request to dml channel")
+	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
+
+	log.Debug("Proxy Upsert Execute done",
+		zap.String("collectionName", collectionName))
+
+	return nil
+}
+
+func (ut *upsertTask) PostExecute(ctx context.Context) error {
+	return nil
+}
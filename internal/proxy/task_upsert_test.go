@@ -0,0 +1,261 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newTestUpsertTask(ctx context.Context, collectionName string) *upsertTask {
+	return &upsertTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		req: &proxypb.UpsertRequest{
+			CollectionName: collectionName,
+			NumRows:        1,
+		},
+		insertMsg: &msgstream.InsertMsg{
+			InsertRequest: internalpb.InsertRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+				CollectionName: collectionName,
+				PartitionName:  "_default",
+				NumRows:        1,
+			},
+		},
+		deleteMsg: &msgstream.DeleteMsg{
+			DeleteRequest: internalpb.DeleteRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+				CollectionName: collectionName,
+			},
+		},
+	}
+}
+
+func TestUpsertTask_SetTs(t *testing.T) {
+	ut := newTestUpsertTask(context.Background(), "test_upsert")
+
+	ut.SetID(100)
+	assert.Equal(t, UniqueID(100), ut.ID())
+	assert.Equal(t, UniqueID(100), ut.deleteMsg.Base.MsgID)
+
+	ut.SetTs(1000)
+	assert.Equal(t, Timestamp(1000), ut.BeginTs())
+	assert.Equal(t, Timestamp(1000), ut.EndTs())
+	// the delete part shares the timestamp with the insert part
+	assert.Equal(t, Timestamp(1000), ut.deleteMsg.Base.Timestamp)
+	assert.Equal(t, UpsertTaskName, ut.Name())
+	assert.Equal(t, commonpb.MsgType_Insert, ut.Type())
+}
+
+func TestUpsertTask_PreExecute_AutoID(t *testing.T) {
+	cache := newMockCache()
+	cache.setGetSchemaFunc(func(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:      100,
+					Name:         "pk",
+					IsPrimaryKey: true,
+					AutoID:       true,
+					DataType:     schemapb.DataType_Int64,
+				},
+			},
+		}, nil
+	})
+	oldCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() { globalMetaCache = oldCache }()
+
+	ut := newTestUpsertTask(context.Background(), "test_upsert")
+	err := ut.PreExecute(context.Background())
+	assert.Error(t, err)
+}
+
+func TestUpsertTask_Execute(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+
+	ctx := context.Background()
+	err := InitMetaCache(ctx, rc, qc, newShardClientMgr())
+	assert.NoError(t, err)
+
+	prefix := "TestUpsertTask_Execute"
+	dbName := ""
+	collectionName := prefix + funcutil.GenRandomStr()
+	fieldName2Types := map[string]schemapb.DataType{
+		testInt64Field:    schemapb.DataType_Int64,
+		testFloatField:    schemapb.DataType_Float,
+		testFloatVecField: schemapb.DataType_FloatVector,
+	}
+	nb := 10
+
+	schema := constructCollectionSchemaByDataType(collectionName, fieldName2Types, testInt64Field, false)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      2,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, createColT.OnEnqueue())
+	assert.NoError(t, createColT.PreExecute(ctx))
+	assert.NoError(t, createColT.Execute(ctx))
+	assert.NoError(t, createColT.PostExecute(ctx))
+	// the mock does not create the default partition along with the collection
+	_, err = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  "_default",
+	})
+	assert.NoError(t, err)
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+
+	chMgr := newChannelsMgrImpl(getDmlChannelsFunc(ctx, rc), nil, newSimpleMockMsgStreamFactory())
+	defer chMgr.removeAllDMLStream()
+	stream, err := chMgr.getOrCreateDmlStream(collectionID)
+	assert.NoError(t, err)
+
+	idAllocator, err := allocator.NewIDAllocator(ctx, rc, paramtable.GetNodeID())
+	assert.NoError(t, err)
+	_ = idAllocator.Start()
+	defer idAllocator.Close()
+
+	segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
+	assert.NoError(t, err)
+	_ = segAllocator.Start()
+	defer segAllocator.Close()
+
+	t.Run("produce delete and insert", func(t *testing.T) {
+		ut := newTestUpsertTask(ctx, collectionName)
+		ut.req.NumRows = uint32(nb)
+		ut.insertMsg.NumRows = uint64(nb)
+		ut.insertMsg.Version = internalpb.InsertDataVersion_ColumnBased
+		for fieldName, dataType := range fieldName2Types {
+			ut.insertMsg.FieldsData = append(ut.insertMsg.FieldsData, generateFieldData(dataType, fieldName, nb))
+		}
+		ut.idAllocator = idAllocator
+		ut.segIDAssigner = segAllocator
+		ut.chMgr = chMgr
+
+		ts := Timestamp(1000)
+		ut.SetID(UniqueID(ts))
+		ut.SetTs(ts)
+		assert.NoError(t, ut.OnEnqueue())
+		assert.NoError(t, ut.PreExecute(ctx))
+		assert.NoError(t, ut.Execute(ctx))
+		assert.NoError(t, ut.PostExecute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, ut.result.GetStatus().GetErrorCode())
+
+		msgPack := <-stream.(*simpleMockMsgStream).msgChan
+		assert.Equal(t, ts, msgPack.BeginTs)
+		assert.Equal(t, ts, msgPack.EndTs)
+
+		type pkMsg struct {
+			index   int
+			channel uint32
+			ts      Timestamp
+		}
+		deletes := make(map[int64]pkMsg)
+		inserts := make(map[int64]pkMsg)
+		for index, msg := range msgPack.Msgs {
+			switch msg.Type() {
+			case commonpb.MsgType_Delete:
+				deleteMsg := msg.(*msgstream.DeleteMsg)
+				assert.Equal(t, collectionID, deleteMsg.CollectionID)
+				assert.Equal(t, common.InvalidPartitionID, deleteMsg.PartitionID)
+				for i, pk := range deleteMsg.GetPrimaryKeys().GetIntId().GetData() {
+					deletes[pk] = pkMsg{index: index, channel: deleteMsg.HashValues[i], ts: deleteMsg.Timestamps[i]}
+				}
+			case commonpb.MsgType_Insert:
+				insertMsg := msg.(*msgstream.InsertMsg)
+				assert.Equal(t, collectionID, insertMsg.CollectionID)
+				for _, fieldData := range insertMsg.GetFieldsData() {
+					if fieldData.GetFieldName() != testInt64Field {
+						continue
+					}
+					for i, pk := range fieldData.GetScalars().GetLongData().GetData() {
+						inserts[pk] = pkMsg{index: index, channel: insertMsg.HashValues[i], ts: insertMsg.Timestamps[i]}
+					}
+				}
+			default:
+				t.Errorf("unexpected msg type %s", msg.Type())
+			}
+		}
+
+		assert.Equal(t, len(ut.result.GetIDs().GetIntId().GetData()), len(inserts))
+		assert.Equal(t, len(inserts), len(deletes))
+		for pk, insert := range inserts {
+			del, ok := deletes[pk]
+			assert.True(t, ok)
+			// the delete of a pk is produced to the same channel ahead of its insert,
+			// and never has a larger timestamp than the insert, so the new entity is not deleted.
+			assert.Equal(t, insert.channel, del.channel)
+			assert.Less(t, del.index, insert.index)
+			assert.LessOrEqual(t, del.ts, insert.ts)
+			assert.Equal(t, ts, insert.ts)
+		}
+	})
+
+	t.Run("get vchannels failed", func(t *testing.T) {
+		ut := newTestUpsertTask(ctx, collectionName)
+		ut.insertMsg.FieldsData = append(ut.insertMsg.FieldsData, generateFieldData(schemapb.DataType_Int64, testInt64Field, 1))
+		ut.insertMsg.FieldsData = append(ut.insertMsg.FieldsData, generateFieldData(schemapb.DataType_Float, testFloatField, 1))
+		ut.insertMsg.FieldsData = append(ut.insertMsg.FieldsData, generateFieldData(schemapb.DataType_FloatVector, testFloatVecField, 1))
+		ut.idAllocator = idAllocator
+		ut.segIDAssigner = segAllocator
+		mockMgr := newMockChannelsMgr()
+		mockMgr.channelsMgr = chMgr
+		mockMgr.getVChannelsFuncType = func(collectionID UniqueID) ([]vChan, error) {
+			return nil, errors.New("mock")
+		}
+		ut.chMgr = mockMgr
+
+		ut.SetTs(Timestamp(1000))
+		assert.NoError(t, ut.PreExecute(ctx))
+		assert.Error(t, ut.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, ut.result.GetStatus().GetErrorCode())
+	})
+}
@@ -17,9 +17,12 @@
 package proxy
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +39,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
@@ -125,6 +129,37 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+// validateDatabaseName checks the database name, it uses the same format as collection name,
+// so "." is never a part of database name and can be used to combine database name and collection name.
+func validateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if len(dbName) > Params.ProxyCfg.MaxNameLength.GetAsInt() {
+		msg := invalidMsg + "The length of a database name must be less than " + Params.ProxyCfg.MaxNameLength.GetValue() + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func validatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -616,6 +651,38 @@ func parseGuaranteeTs(ts, tMax typeutil.Timestamp) typeutil.Timestamp {
 	return ts
 }
 
+// getCollectionTTL returns ttl if collection's ttl is specified, or return global ttl
+func getCollectionTTL(properties map[string]string) (time.Duration, error) {
+	v, ok := properties[common.CollectionTTLConfigKey]
+	if ok {
+		ttl, err := strconv.Atoi(v)
+		if err != nil {
+			return -1, err
+		}
+		return time.Duration(ttl) * time.Second, nil
+	}
+
+	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
+}
+
+// getCollectionTTLTimestamp returns the timestamp before which entities are regarded as expired at the guarantee
+// timestamp, tMax is used instead if the guarantee timestamp is not a physical one (eventually consistency).
+// 0 means the collection never expires its entities.
+func getCollectionTTLTimestamp(properties map[string]string, guaranteeTs, tMax typeutil.Timestamp) (typeutil.Timestamp, error) {
+	ttl, err := getCollectionTTL(properties)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 {
+		return 0, nil
+	}
+	ts := guaranteeTs
+	if ts <= boundedTS {
+		ts = tMax
+	}
+	return tsoutil.AddPhysicalDurationOnTs(ts, -ttl), nil
+}
+
 func validateName(entity string, nameType string) error {
 	entity = strings.TrimSpace(entity)
 
@@ -658,10 +725,21 @@ func IsDefaultRole(roleName string) bool {
 	return false
 }
 
+// ValidateObjectName checks the object name of the grant, the collection in a database
+// is granted with the name in "database.collection" format, and "database.*" refers to all of them.
 func ValidateObjectName(entity string) error {
 	if util.IsAnyWord(entity) {
 		return nil
 	}
+	if dbName, objectName, ok := strings.Cut(entity, util.DBObjectSeparator); ok {
+		if err := validateDatabaseName(dbName); err != nil {
+			return err
+		}
+		if util.IsAnyWord(objectName) {
+			return nil
+		}
+		return validateName(objectName, "role name")
+	}
 	return validateName(entity, "role name")
 }
 
@@ -781,6 +859,7 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		}
 	}
 
+	dynamicField := typeutil.GetDynamicField(schema)
 	for _, outputFieldName := range outputFields {
 		outputFieldName = strings.TrimSpace(outputFieldName)
 		if outputFieldName == "*" {
@@ -791,6 +870,9 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 			for fieldName := range vectorFieldNameMap {
 				resultFieldNameMap[fieldName] = true
 			}
+		} else if dynamicField != nil && !scalarFieldNameMap[outputFieldName] && !vectorFieldNameMap[outputFieldName] {
+			// undeclared fields are read from the dynamic field
+			resultFieldNameMap[dynamicField.GetName()] = true
 		} else {
 			resultFieldNameMap[outputFieldName] = true
 		}
@@ -893,6 +975,234 @@ func checkLengthOfFieldsData(schema *schemapb.CollectionSchema, insertMsg *msgst
 	return nil
 }
 
+// isDynamicFieldEnabled returns whether the dynamic schema is enabled by the collection properties
+func isDynamicFieldEnabled(properties []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range properties {
+		if kv.GetKey() == common.EnableDynamicFieldKey {
+			enabled, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, fmt.Errorf("invalid value for %s: %s", common.EnableDynamicFieldKey, kv.GetValue())
+			}
+			return enabled, nil
+		}
+	}
+	return false, nil
+}
+
+// getScalarFieldDataRows returns the values of a scalar column row by row
+func getScalarFieldDataRows(fieldData *schemapb.FieldData) ([]interface{}, error) {
+	rows := make([]interface{}, 0)
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		for _, v := range data.BoolData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_IntData:
+		for _, v := range data.IntData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_LongData:
+		for _, v := range data.LongData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_FloatData:
+		for _, v := range data.FloatData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_DoubleData:
+		for _, v := range data.DoubleData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_StringData:
+		for _, v := range data.StringData.GetData() {
+			rows = append(rows, v)
+		}
+	case *schemapb.ScalarField_BytesData:
+		for _, v := range data.BytesData.GetData() {
+			if !json.Valid(v) {
+				return nil, fmt.Errorf("field %s contains invalid JSON document", fieldData.GetFieldName())
+			}
+			rows = append(rows, json.RawMessage(v))
+		}
+	default:
+		return nil, fmt.Errorf("undeclared field %s must be a scalar field", fieldData.GetFieldName())
+	}
+	return rows, nil
+}
+
+// decodeDynamicFieldRow decodes a document of the dynamic field, numbers keep their precision.
+// The document must be a single JSON object, segcore looks up the undeclared fields as its keys.
+func decodeDynamicFieldRow(doc []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+	var row map[string]interface{}
+	if err := decoder.Decode(&row); err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, errors.New("document is not a JSON object")
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the JSON object")
+	}
+	return row, nil
+}
+
+// fillDynamicFieldData folds the columns not declared in a dynamic schema into the dynamic field,
+// one JSON object per row.
+func fillDynamicFieldData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
+	dynamicField := typeutil.GetDynamicField(schema)
+	if dynamicField == nil {
+		return nil
+	}
+	rowNum := int(insertMsg.NRows())
+	if rowNum <= 0 {
+		return errNumRowsLessThanOrEqualToZero(uint32(rowNum))
+	}
+
+	declaredFields := make(map[string]bool)
+	for _, field := range schema.GetFields() {
+		declaredFields[field.GetName()] = true
+	}
+
+	rows := make([]map[string]interface{}, rowNum)
+	for i := range rows {
+		rows[i] = make(map[string]interface{})
+	}
+	columns := make([]*schemapb.FieldData, 0, len(insertMsg.GetFieldsData())+1)
+	for _, fieldData := range insertMsg.GetFieldsData() {
+		fieldName := fieldData.GetFieldName()
+		if fieldName == dynamicField.GetName() {
+			// the dynamic field is provided directly, each row must be a JSON object
+			docs := fieldData.GetScalars().GetBytesData().GetData()
+			if len(docs) != rowNum {
+				return fmt.Errorf("the number of rows of field %s is %d, expected: %d", fieldName, len(docs), rowNum)
+			}
+			for i, doc := range docs {
+				row, err := decodeDynamicFieldRow(doc)
+				if err != nil {
+					return fmt.Errorf("field %s must be a JSON object, error: %w", fieldName, err)
+				}
+				for key, value := range row {
+					if _, ok := rows[i][key]; ok {
+						return fmt.Errorf("field %s is set more than once", key)
+					}
+					rows[i][key] = value
+				}
+			}
+			continue
+		}
+		if declaredFields[fieldName] {
+			columns = append(columns, fieldData)
+			continue
+		}
+
+		values, err := getScalarFieldDataRows(fieldData)
+		if err != nil {
+			return err
+		}
+		if len(values) != rowNum {
+			return fmt.Errorf("the number of rows of field %s is %d, expected: %d", fieldName, len(values), rowNum)
+		}
+		for i, value := range values {
+			if _, ok := rows[i][fieldName]; ok {
+				return fmt.Errorf("field %s is set more than once", fieldName)
+			}
+			rows[i][fieldName] = value
+		}
+	}
+
+	docs := make([][]byte, 0, rowNum)
+	for _, row := range rows {
+		for key := range row {
+			if declaredFields[key] {
+				return fmt.Errorf("declared field %s cannot be set in field %s", key, dynamicField.GetName())
+			}
+		}
+		doc, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	columns = append(columns, &schemapb.FieldData{
+		FieldName: dynamicField.GetName(),
+		FieldId:   dynamicField.GetFieldID(),
+		Type:      dynamicField.GetDataType(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BytesData{
+					BytesData: &schemapb.BytesArray{
+						Data: docs,
+					},
+				},
+			},
+		},
+	})
+	insertMsg.FieldsData = columns
+	return nil
+}
+
+// getDynamicOutputFields returns the requested output fields which are not declared in a dynamic schema,
+// returns nil if the whole dynamic field is requested.
+func getDynamicOutputFields(outputFields []string, schema *schemapb.CollectionSchema) []string {
+	dynamicField := typeutil.GetDynamicField(schema)
+	if dynamicField == nil {
+		return nil
+	}
+	declaredFields := make(map[string]bool)
+	for _, field := range schema.GetFields() {
+		declaredFields[field.GetName()] = true
+	}
+
+	dynamicFields := make([]string, 0)
+	for _, outputFieldName := range outputFields {
+		outputFieldName = strings.TrimSpace(outputFieldName)
+		if outputFieldName == "*" || outputFieldName == dynamicField.GetName() {
+			return nil
+		}
+		if outputFieldName != "%" && !declaredFields[outputFieldName] {
+			dynamicFields = append(dynamicFields, outputFieldName)
+		}
+	}
+	return dynamicFields
+}
+
+// filterDynamicFieldData keeps only the requested keys in each row of the dynamic field
+func filterDynamicFieldData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, dynamicFields []string) error {
+	if len(dynamicFields) == 0 {
+		return nil
+	}
+	dynamicField := typeutil.GetDynamicField(schema)
+	if dynamicField == nil {
+		return nil
+	}
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != dynamicField.GetFieldID() {
+			continue
+		}
+		docs := fieldData.GetScalars().GetBytesData().GetData()
+		for i, doc := range docs {
+			row, err := decodeDynamicFieldRow(doc)
+			if err != nil {
+				return err
+			}
+			filtered := make(map[string]interface{})
+			for _, key := range dynamicFields {
+				if value, ok := row[key]; ok {
+					filtered[key] = value
+				}
+			}
+			filteredDoc, err := json.Marshal(filtered)
+			if err != nil {
+				return err
+			}
+			docs[i] = filteredDoc
+		}
+	}
+	return nil
+}
+
 func checkPrimaryFieldData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) (*schemapb.IDs, error) {
 	rowNums := uint32(insertMsg.NRows())
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
@@ -973,11 +1283,11 @@ func getCollectionProgress(ctx context.Context, queryCoord types.QueryCoord,
 }
 
 func getPartitionProgress(ctx context.Context, queryCoord types.QueryCoord,
-	msgBase *commonpb.MsgBase, partitionNames []string, collectionName string, collectionID int64) (int64, error) {
+	msgBase *commonpb.MsgBase, partitionNames []string, dbName string, collectionName string, collectionID int64) (int64, error) {
 	IDs2Names := make(map[int64]string)
 	partitionIDs := make([]int64, 0)
 	for _, partitionName := range partitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, dbName, collectionName, partitionName)
 		if err != nil {
 			return 0, err
 		}
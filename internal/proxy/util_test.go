@@ -30,6 +30,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
@@ -67,6 +69,31 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, validateDatabaseName("abc"))
+	assert.Nil(t, validateDatabaseName("_123abc"))
+	assert.Nil(t, validateDatabaseName("abc123_"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"abc.def",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateDatabaseName(name))
+	}
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, validatePartitionTag("abc", true))
 	assert.Nil(t, validatePartitionTag("123abc", true))
@@ -676,6 +703,11 @@ func TestValidateName(t *testing.T) {
 	assert.NotNil(t, ValidateObjectName(" "))
 	assert.NotNil(t, ValidateObjectName(string(longName)))
 	assert.Nil(t, ValidateObjectName("*"))
+	assert.Nil(t, ValidateObjectName("db1.col1"))
+	assert.Nil(t, ValidateObjectName("db1.*"))
+	assert.NotNil(t, ValidateObjectName("1db.col1"))
+	assert.NotNil(t, ValidateObjectName("db1."))
+	assert.NotNil(t, ValidateObjectName("db1.col1.col2"))
 }
 
 func TestIsDefaultRole(t *testing.T) {
@@ -808,6 +840,41 @@ func TestValidateTravelTimestamp(t *testing.T) {
 	}
 }
 
+func TestGetCollectionTTLTimestamp(t *testing.T) {
+	ts := tsoutil.GetCurrentTime()
+
+	t.Run("collection ttl", func(t *testing.T) {
+		ttlTs, err := getCollectionTTLTimestamp(map[string]string{common.CollectionTTLConfigKey: "10"}, ts, ts+1)
+		assert.NoError(t, err)
+		assert.Equal(t, tsoutil.AddPhysicalDurationOnTs(ts, -10*time.Second), ttlTs)
+	})
+
+	t.Run("eventually consistency", func(t *testing.T) {
+		ttlTs, err := getCollectionTTLTimestamp(map[string]string{common.CollectionTTLConfigKey: "10"}, 1, ts)
+		assert.NoError(t, err)
+		assert.Equal(t, tsoutil.AddPhysicalDurationOnTs(ts, -10*time.Second), ttlTs)
+	})
+
+	t.Run("no ttl", func(t *testing.T) {
+		ttlTs, err := getCollectionTTLTimestamp(map[string]string{common.CollectionTTLConfigKey: "0"}, ts, ts)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), ttlTs)
+	})
+
+	t.Run("global ttl", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "20")
+		defer paramtable.Get().Reset(Params.CommonCfg.EntityExpirationTTL.Key)
+		ttlTs, err := getCollectionTTLTimestamp(nil, ts, ts)
+		assert.NoError(t, err)
+		assert.Equal(t, tsoutil.AddPhysicalDurationOnTs(ts, -20*time.Second), ttlTs)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := getCollectionTTLTimestamp(map[string]string{common.CollectionTTLConfigKey: "abc"}, ts, ts)
+		assert.Error(t, err)
+	})
+}
+
 func Test_isCollectionIsLoaded(t *testing.T) {
 	ctx := context.Background()
 	t.Run("normal", func(t *testing.T) {
@@ -1226,3 +1293,155 @@ func Test_InsertTaskCheckPrimaryFieldData(t *testing.T) {
 	_, err = checkPrimaryFieldData(case4.schema, case4.insertMsg)
 	assert.NotEqual(t, nil, err)
 }
+
+func Test_isDynamicFieldEnabled(t *testing.T) {
+	enabled, err := isDynamicFieldEnabled(nil)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = isDynamicFieldEnabled([]*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = isDynamicFieldEnabled([]*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldKey, Value: "dummy"}})
+	assert.Error(t, err)
+}
+
+func Test_fillDynamicFieldData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "Test_fillDynamicFieldData",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: common.MetaFieldName, DataType: typeutil.DataTypeJSON},
+		},
+	}
+	newInsertMsg := func(fieldsData ...*schemapb.FieldData) *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{
+			InsertRequest: internalpb.InsertRequest{
+				NumRows:    2,
+				FieldsData: fieldsData,
+				Version:    internalpb.InsertDataVersion_ColumnBased,
+			},
+		}
+	}
+	pkData := newScalarFieldData(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}, "pk", 2)
+
+	t.Run("not dynamic schema", func(t *testing.T) {
+		insertMsg := newInsertMsg(pkData)
+		err := fillDynamicFieldData(&schemapb.CollectionSchema{}, insertMsg)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(insertMsg.GetFieldsData()))
+	})
+
+	t.Run("undeclared fields", func(t *testing.T) {
+		color := &schemapb.FieldData{
+			FieldName: "color",
+			Type:      schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"red", "blue"}}},
+				},
+			},
+		}
+		meta := &schemapb.FieldData{
+			FieldName: common.MetaFieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: [][]byte{[]byte(`{"size": 1}`), []byte(`{}`)}}},
+				},
+			},
+		}
+		insertMsg := newInsertMsg(pkData, color, meta)
+		err := fillDynamicFieldData(schema, insertMsg)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(insertMsg.GetFieldsData()))
+		dynamicData := insertMsg.GetFieldsData()[1]
+		assert.Equal(t, common.MetaFieldName, dynamicData.GetFieldName())
+		assert.Equal(t, int64(101), dynamicData.GetFieldId())
+		assert.Equal(t, typeutil.DataTypeJSON, dynamicData.GetType())
+		assert.Equal(t, [][]byte{[]byte(`{"color":"red","size":1}`), []byte(`{"color":"blue"}`)},
+			dynamicData.GetScalars().GetBytesData().GetData())
+	})
+
+	t.Run("no undeclared fields", func(t *testing.T) {
+		insertMsg := newInsertMsg(pkData)
+		err := fillDynamicFieldData(schema, insertMsg)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{}`), []byte(`{}`)},
+			insertMsg.GetFieldsData()[1].GetScalars().GetBytesData().GetData())
+	})
+
+	t.Run("invalid undeclared fields", func(t *testing.T) {
+		vector := newFloatVectorFieldData("vec", 2, 4)
+		err := fillDynamicFieldData(schema, newInsertMsg(pkData, vector))
+		assert.Error(t, err)
+
+		meta := &schemapb.FieldData{
+			FieldName: common.MetaFieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: [][]byte{[]byte(`{"pk": 1}`), []byte(`{}`)}}},
+				},
+			},
+		}
+		err = fillDynamicFieldData(schema, newInsertMsg(pkData, meta))
+		assert.Error(t, err)
+
+		// every document must be a single JSON object
+		for _, doc := range []string{`1`, `null`, `[]`, `{} {}`, `{"a": 1`} {
+			meta.GetScalars().GetBytesData().Data = [][]byte{[]byte(doc), []byte(`{}`)}
+			err = fillDynamicFieldData(schema, newInsertMsg(pkData, meta))
+			assert.Error(t, err, doc)
+		}
+
+		// an undeclared field can't be set both by a column and inside the dynamic field
+		color := &schemapb.FieldData{
+			FieldName: "color",
+			Type:      schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"red", "blue"}}},
+				},
+			},
+		}
+		meta.GetScalars().GetBytesData().Data = [][]byte{[]byte(`{"color": "green"}`), []byte(`{}`)}
+		err = fillDynamicFieldData(schema, newInsertMsg(pkData, meta, color))
+		assert.Error(t, err)
+		err = fillDynamicFieldData(schema, newInsertMsg(pkData, color, meta))
+		assert.Error(t, err)
+	})
+}
+
+func Test_dynamicOutputFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "Test_dynamicOutputFields",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: common.MetaFieldName, DataType: typeutil.DataTypeJSON},
+		},
+	}
+
+	assert.Nil(t, getDynamicOutputFields([]string{"pk", "color"}, &schemapb.CollectionSchema{}))
+	assert.Nil(t, getDynamicOutputFields([]string{"*", "color"}, schema))
+	assert.Nil(t, getDynamicOutputFields([]string{common.MetaFieldName, "color"}, schema))
+	assert.Equal(t, []string{"color"}, getDynamicOutputFields([]string{"pk", "color"}, schema))
+
+	outputFields, err := translateOutputFields([]string{"pk", "color"}, schema, false)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"pk", common.MetaFieldName}, outputFields)
+
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldName: common.MetaFieldName,
+			FieldId:   101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: [][]byte{[]byte(`{"color":"red","size":1}`), []byte(`{"size":2}`)}}},
+				},
+			},
+		},
+	}
+	err = filterDynamicFieldData(schema, fieldsData, []string{"color"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"color":"red"}`), []byte(`{}`)}, fieldsData[0].GetScalars().GetBytesData().GetData())
+}
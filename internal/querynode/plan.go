@@ -86,6 +86,11 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// setCollectionTTL masks the rows inserted before collectionTTL, 0 means collection ttl is disabled
+func (plan *SearchPlan) setCollectionTTL(collectionTTL Timestamp) {
+	C.SetSearchPlanCollectionTTL(plan.cSearchPlan, C.uint64_t(collectionTTL))
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -119,6 +124,7 @@ func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		plan.delete()
 		return nil, errors.New("empty search request")
 	}
+	plan.setCollectionTTL(req.GetReq().GetCollectionTtlTimestamps())
 
 	var blobPtr = unsafe.Pointer(&placeholderGrp[0])
 	blobSize := C.int64_t(len(placeholderGrp))
@@ -204,6 +210,11 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp,
 	return newPlan, nil
 }
 
+// setCollectionTTL masks the rows inserted before collectionTTL, 0 means collection ttl is disabled
+func (plan *RetrievePlan) setCollectionTTL(collectionTTL Timestamp) {
+	C.SetRetrievePlanCollectionTTL(plan.cRetrievePlan, C.uint64_t(collectionTTL))
+}
+
 func (plan *RetrievePlan) delete() {
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
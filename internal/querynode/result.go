@@ -77,7 +77,7 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 	return ret, nil
 }
 
-func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupByFieldID int64, groupSize int64) (*internalpb.SearchResults, error) {
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
 		log.Ctx(ctx).Warn("decode search results errors", zap.Error(err))
@@ -86,7 +86,7 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	log.Ctx(ctx).Debug("reduceSearchResultData",
 		zap.Int("numbers", len(searchResultData)), zap.Int64("targetNq", nq), zap.Int64("targetTopk", topk))
 
-	reducedResultData, err := reduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := reduceSearchResultData(ctx, searchResultData, nq, topk, groupByFieldID, groupSize)
 	if err != nil {
 		log.Ctx(ctx).Warn("reduce search results error", zap.Error(err))
 		return nil, err
@@ -108,7 +108,9 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// reduceSearchResultData merges the search results by score, if groupByFieldID is set,
+// results are grouped by the field and topk is the number of groups multiplied by groupSize.
+func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupByFieldID int64, groupSize int64) (*schemapb.SearchResultData, error) {
 	if len(searchResultData) == 0 {
 		return &schemapb.SearchResultData{
 			NumQueries: nq,
@@ -136,11 +138,19 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
+	if groupByFieldID > 0 && groupSize <= 0 {
+		groupSize = 1
+	}
+
 	var skipDupCnt int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
 		var idSet = make(map[interface{}]struct{})
+		var groupBy *typeutil.SearchGroupBy
+		if groupByFieldID > 0 {
+			groupBy = typeutil.NewSearchGroupBy(topk/groupSize, groupSize)
+		}
 		var j int64
 		for j = 0; j < topk; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -153,17 +163,26 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			score := searchResultData[sel].Scores[idx]
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
-				typeutil.AppendPKs(ret.Ids, id)
-				ret.Scores = append(ret.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
+			if _, ok := idSet[id]; ok {
 				// skip entity with same id
 				skipDupCnt++
+				offsets[sel]++
+				continue
 			}
+			idSet[id] = struct{}{}
 			offsets[sel]++
+
+			// skip entity whose group is full or out of the top groups
+			if groupBy != nil {
+				if _, ok := groupBy.Add(typeutil.GetGroupByValue(searchResultData[sel].FieldsData, groupByFieldID, idx)); !ok {
+					continue
+				}
+			}
+
+			typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
+			typeutil.AppendPKs(ret.Ids, id)
+			ret.Scores = append(ret.Scores, score)
+			j++
 		}
 
 		// if realTopK != -1 && realTopK != j {
@@ -214,6 +233,46 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffset
 	return sel
 }
 
+// groupSearchResultBlob groups the results of a marshaled SearchResultData searched with searchTopK hits per query,
+// nil is returned if there is no result left. It also returns whether some query may get more groups by searching
+// more hits, see groupsUnfilled.
+func groupSearchResultBlob(ctx context.Context, blob []byte, nq int64, topk int64, searchTopK int64, groupByFieldID int64, groupSize int64) ([]byte, bool, error) {
+	var searchResultData schemapb.SearchResultData
+	if err := proto.Unmarshal(blob, &searchResultData); err != nil {
+		return nil, false, err
+	}
+	if groupSize <= 0 {
+		groupSize = 1
+	}
+	unfilled := groupsUnfilled(&searchResultData, searchTopK, topk/groupSize, groupByFieldID)
+	grouped, err := reduceSearchResultData(ctx, []*schemapb.SearchResultData{&searchResultData}, nq, topk, groupByFieldID, groupSize)
+	if err != nil {
+		return nil, false, err
+	}
+	if typeutil.GetSizeOfIDs(grouped.GetIds()) == 0 {
+		return nil, unfilled, nil
+	}
+	ret, err := proto.Marshal(grouped)
+	return ret, unfilled, err
+}
+
+// groupsUnfilled checks whether some query gets less than groupTopK groups while all the searchTopK hits searched
+// are returned, the hits of the query may fall into a few groups and searching more hits may fill more groups.
+func groupsUnfilled(searchResultData *schemapb.SearchResultData, searchTopK int64, groupTopK int64, groupByFieldID int64) bool {
+	var offset int64
+	for _, topk := range searchResultData.GetTopks() {
+		groups := make(map[interface{}]struct{})
+		for idx := offset; idx < offset+topk; idx++ {
+			groups[typeutil.GetGroupByValue(searchResultData.GetFieldsData(), groupByFieldID, idx)] = struct{}{}
+		}
+		offset += topk
+		if topk >= searchTopK && int64(len(groups)) < groupTopK {
+			return true
+		}
+	}
+	return false
+}
+
 func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
 	results := make([]*schemapb.SearchResultData, 0)
 	for _, partialSearchResult := range searchResults {
@@ -279,6 +338,7 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		return ret, nil
 	}
 
+	// segcore returns the limited entities of each segment in the order of primary keys
 	if limit != typeutil.Unlimited {
 		loopEnd = int(limit)
 	}
@@ -286,7 +346,8 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// the duplicated primary keys are not counted by the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -298,6 +359,7 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idTsMap[pk] = ts
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -345,6 +407,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 		return ret, nil
 	}
 
+	// segcore returns the limited entities of each segment in the order of primary keys
 	if limit != typeutil.Unlimited {
 		loopEnd = int(limit)
 	}
@@ -352,7 +415,8 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// the duplicated primary keys are not counted by the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -363,6 +427,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
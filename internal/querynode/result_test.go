@@ -21,6 +21,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -81,6 +82,36 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 		assert.InDeltaSlice(t, FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 	})
 
+	t.Run("test duplicated pks not counted by limit", func(t *testing.T) {
+		result1 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray1,
+		}
+		result2 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray2,
+		}
+
+		result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 1}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, Int64Array, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+	})
+
 	t.Run("test nil results", func(t *testing.T) {
 		ret, err := mergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited)
 		assert.NoError(t, err)
@@ -393,7 +424,7 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Ids.GetIntId().Data)
 		assert.Equal(t, scores, res.Scores)
@@ -410,10 +441,143 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	t.Run("group by", func(t *testing.T) {
+		const groupByFieldID = 101
+		genGroupByData := func(ids []int64, scores []float32, groups []int64) *schemapb.SearchResultData {
+			data := genSearchResultData(nq, topk, ids, scores, []int64{int64(len(ids))})
+			data.FieldsData = []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: groupByFieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{Data: groups},
+						},
+					},
+				},
+			}}
+			return data
+		}
+		data1 := genGroupByData([]int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{10, 10, 10, 20})
+		data2 := genGroupByData([]int64{5, 6, 7, 8}, []float32{-1.5, -2.5, -3.5, -4.5}, []int64{30, 20, 10, 40})
+
+		// 2 groups with at most 2 hits in each group
+		res, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk, groupByFieldID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 5, 2}, res.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{-1.0, -1.5, -2.0}, res.GetScores())
+		assert.Equal(t, []int64{10, 30, 10}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{3}, res.GetTopks())
+
+		// group a single result data
+		blob, err := proto.Marshal(data1)
+		assert.NoError(t, err)
+		grouped, unfilled, err := groupSearchResultBlob(context.TODO(), blob, nq, topk, topk, groupByFieldID, 1)
+		assert.NoError(t, err)
+		groupedData := &schemapb.SearchResultData{}
+		assert.NoError(t, proto.Unmarshal(grouped, groupedData))
+		assert.Equal(t, []int64{1, 4}, groupedData.GetIds().GetIntId().GetData())
+		// 4 groups are requested while the 4 hits searched fall into 2 groups
+		assert.True(t, unfilled)
+	})
+}
+
+func TestResult_groupSearchResultBlob_skewed(t *testing.T) {
+	const (
+		groupByFieldID = 101
+		groupTopK      = 3
+		groupSize      = 1
+		topk           = groupTopK * groupSize
+	)
+	// genSkewedData returns the hits of a query in descending order of score, the first hot hits are in the same group
+	// and the others are in distinct groups, n hits are returned at most as if they were searched with topk n.
+	genSkewedData := func(hot int64, total int64, n int64) []byte {
+		if n > total {
+			n = total
+		}
+		ids := make([]int64, 0, n)
+		scores := make([]float32, 0, n)
+		groups := make([]int64, 0, n)
+		for i := int64(0); i < n; i++ {
+			ids = append(ids, i)
+			scores = append(scores, -float32(i))
+			if i < hot {
+				groups = append(groups, 0)
+			} else {
+				groups = append(groups, i)
+			}
+		}
+		data := genSearchResultData(1, n, ids, scores, []int64{n})
+		data.FieldsData = []*schemapb.FieldData{{
+			Type:    schemapb.DataType_Int64,
+			FieldId: groupByFieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{Data: groups},
+					},
+				},
+			},
+		}}
+		blob, err := proto.Marshal(data)
+		assert.NoError(t, err)
+		return blob
+	}
+
+	t.Run("search until the groups are filled", func(t *testing.T) {
+		// the 10 best hits are in the same group, topk*groupSize hits only fill a single group
+		var rounds int
+		searchTopK := int64(topk)
+		for {
+			rounds++
+			grouped, unfilled, err := groupSearchResultBlob(context.TODO(), genSkewedData(10, 100, searchTopK), 1, topk, searchTopK, groupByFieldID, groupSize)
+			assert.NoError(t, err)
+			if unfilled {
+				searchTopK *= 2
+				continue
+			}
+			groupedData := &schemapb.SearchResultData{}
+			assert.NoError(t, proto.Unmarshal(grouped, groupedData))
+			assert.Equal(t, []int64{0, 10, 11}, groupedData.GetIds().GetIntId().GetData())
+			assert.Equal(t, []int64{topk}, groupedData.GetTopks())
+			break
+		}
+		// 3, 6 and 12 hits are searched
+		assert.Equal(t, 3, rounds)
+		assert.Equal(t, int64(12), searchTopK)
+	})
+
+	t.Run("hits exhausted", func(t *testing.T) {
+		// only 5 hits in 2 groups exist, no more group can be found by searching more hits
+		grouped, unfilled, err := groupSearchResultBlob(context.TODO(), genSkewedData(4, 5, 6), 1, topk, 6, groupByFieldID, groupSize)
+		assert.NoError(t, err)
+		assert.False(t, unfilled)
+		groupedData := &schemapb.SearchResultData{}
+		assert.NoError(t, proto.Unmarshal(grouped, groupedData))
+		assert.Equal(t, []int64{0, 4}, groupedData.GetIds().GetIntId().GetData())
+	})
+
+	t.Run("multiple queries", func(t *testing.T) {
+		// the first query gets 3 groups while the second one gets a single group
+		data := genSearchResultData(2, topk, []int64{1, 2, 3, 4, 5, 6}, []float32{-1, -2, -3, -1, -2, -3}, []int64{3, 3})
+		data.FieldsData = []*schemapb.FieldData{{
+			Type:    schemapb.DataType_Int64,
+			FieldId: groupByFieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{Data: []int64{10, 20, 30, 10, 10, 10}},
+					},
+				},
+			},
+		}}
+		assert.True(t, groupsUnfilled(data, topk, groupTopK, groupByFieldID))
+		assert.False(t, groupsUnfilled(data, topk+1, groupTopK, groupByFieldID))
+	})
 }
 
 func TestResult_selectSearchResultData_int(t *testing.T) {
@@ -101,6 +101,8 @@ type Segment struct {
 	// only used by sealed segments
 	currentStat  *storage.PkStatistics
 	historyStats []*storage.PkStatistics
+	// stats of the scalar fields, one for each stats log, used to skip the segment which can't match the filter
+	fieldStats map[UniqueID][]*storage.FieldStats
 }
 
 // ID returns the identity number.
@@ -239,6 +241,7 @@ func deleteSegment(segment *Segment) {
 
 	segment.currentStat = nil
 	segment.historyStats = nil
+	segment.fieldStats = nil
 
 	log.Info("delete segment from memory",
 		zap.Int64("collectionID", segment.collectionID),
@@ -616,6 +619,25 @@ func (s *Segment) InitCurrentStat() {
 	}
 }
 
+// setFieldStats adds @stats to the field stats of the segment
+func (s *Segment) setFieldStats(stats []*storage.FieldStats) {
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	if s.fieldStats == nil {
+		s.fieldStats = make(map[UniqueID][]*storage.FieldStats)
+	}
+	for _, stat := range stats {
+		s.fieldStats[stat.FieldID] = append(s.fieldStats[stat.FieldID], stat)
+	}
+}
+
+// getFieldStats returns the stats of the field, nil if no stats
+func (s *Segment) getFieldStats(fieldID UniqueID) []*storage.FieldStats {
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	return s.fieldStats[fieldID]
+}
+
 // check if PK exists is current
 func (s *Segment) isPKExist(pk primaryKey) bool {
 	s.statLock.Lock()
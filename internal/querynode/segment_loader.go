@@ -292,6 +292,12 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		}
 	}
 
+	log.Info("loading field stats...", zap.Int64("segmentID", segmentID))
+	err = loader.loadSegmentFieldStats(ctx, segment, loader.filterFieldStatsBinlogs(loadInfo.Statslogs, pkFieldID))
+	if err != nil {
+		return err
+	}
+
 	log.Info("loading delta...", zap.Int64("segmentID", segmentID))
 	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 	return err
@@ -309,6 +315,19 @@ func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBi
 	return result
 }
 
+// filterFieldStatsBinlogs returns the stats logs of the scalar fields except the primary key
+func (loader *segmentLoader) filterFieldStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
+	result := make([]string, 0)
+	for _, fieldBinlog := range fieldBinlogs {
+		if fieldBinlog.FieldID != pkFieldID {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				result = append(result, binlog.GetLogPath())
+			}
+		}
+	}
+	return result
+}
+
 func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segment *Segment, fieldBinlogs []*datapb.FieldBinlog) error {
 	if len(fieldBinlogs) <= 0 {
 		return nil
@@ -675,6 +694,30 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	return nil
 }
 
+// loadSegmentFieldStats loads the min/max stats of the scalar fields, which are used to prune the segment for filtering
+func (loader *segmentLoader) loadSegmentFieldStats(ctx context.Context, segment *Segment, binlogPaths []string) error {
+	if len(binlogPaths) == 0 {
+		return nil
+	}
+
+	values, err := loader.cm.MultiRead(ctx, binlogPaths)
+	if err != nil {
+		return err
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for i := 0; i < len(values); i++ {
+		blobs = append(blobs, &storage.Blob{Value: values[i]})
+	}
+
+	stats, err := storage.DeserializeFieldStats(blobs)
+	if err != nil {
+		log.Warn("failed to deserialize field stats", zap.Error(err))
+		return err
+	}
+	segment.setFieldStats(stats)
+	return nil
+}
+
 func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
 	dCodec := storage.DeleteCodec{}
 	var blobs []*storage.Blob
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// pruneSegmentsByFieldStats filters out the sealed segments whose field stats show that no entity can match the expression,
+// the segments without stats are always kept.
+func pruneSegmentsByFieldStats(replica ReplicaInterface, segmentIDs []UniqueID, expr *planpb.Expr) []UniqueID {
+	if expr == nil || len(segmentIDs) == 0 {
+		return segmentIDs
+	}
+
+	result := make([]UniqueID, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment, err := replica.getSegmentByID(segmentID, segmentTypeSealed)
+		if err != nil {
+			// let the search report the error
+			result = append(result, segmentID)
+			continue
+		}
+		if exprMayMatch(expr, segment.getFieldStats) {
+			result = append(result, segmentID)
+		}
+	}
+	return result
+}
+
+// exprMayMatch returns false only if the stats prove that no entity can match the expression
+func exprMayMatch(expr *planpb.Expr, getStats func(fieldID int64) []*storage.FieldStats) bool {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return exprMayMatch(e.BinaryExpr.GetLeft(), getStats) && exprMayMatch(e.BinaryExpr.GetRight(), getStats)
+		case planpb.BinaryExpr_LogicalOr:
+			return exprMayMatch(e.BinaryExpr.GetLeft(), getStats) || exprMayMatch(e.BinaryExpr.GetRight(), getStats)
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		return columnMayMatch(e.UnaryRangeExpr.GetColumnInfo(), getStats, func(stats *storage.FieldStats) bool {
+			return unaryRangeMayMatch(e.UnaryRangeExpr, stats)
+		})
+	case *planpb.Expr_BinaryRangeExpr:
+		return columnMayMatch(e.BinaryRangeExpr.GetColumnInfo(), getStats, func(stats *storage.FieldStats) bool {
+			return binaryRangeMayMatch(e.BinaryRangeExpr, stats)
+		})
+	case *planpb.Expr_TermExpr:
+		return columnMayMatch(e.TermExpr.GetColumnInfo(), getStats, func(stats *storage.FieldStats) bool {
+			for _, v := range e.TermExpr.GetValues() {
+				values, ok := genericValueToKeys(v, stats)
+				if !ok {
+					return true
+				}
+				for _, value := range values {
+					if stats.MayContain(value) {
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+	return true
+}
+
+// columnMayMatch returns true if any stats log of the column may match, the stats of a column are recorded
+// per stats log, so the bloom filters of them can't be merged.
+func columnMayMatch(info *planpb.ColumnInfo, getStats func(fieldID int64) []*storage.FieldStats, mayMatch func(stats *storage.FieldStats) bool) bool {
+	if info == nil || len(info.GetNestedPath()) > 0 {
+		return true
+	}
+	statsList := getStats(info.GetFieldId())
+	if len(statsList) == 0 {
+		return true
+	}
+	for _, stats := range statsList {
+		// the stats of an empty stats log has no min/max, there is no entity in it
+		if stats.Min == nil || stats.Max == nil {
+			continue
+		}
+		if mayMatch(stats) {
+			return true
+		}
+	}
+	return false
+}
+
+func unaryRangeMayMatch(expr *planpb.UnaryRangeExpr, stats *storage.FieldStats) bool {
+	values, ok := genericValueToKeys(expr.GetValue(), stats)
+	if !ok {
+		return true
+	}
+	for _, value := range values {
+		var mayMatch bool
+		switch expr.GetOp() {
+		case planpb.OpType_Equal:
+			mayMatch = stats.MayContain(value)
+		case planpb.OpType_GreaterThan:
+			mayMatch = stats.Max.GT(value)
+		case planpb.OpType_GreaterEqual:
+			mayMatch = stats.Max.GE(value)
+		case planpb.OpType_LessThan:
+			mayMatch = stats.Min.LT(value)
+		case planpb.OpType_LessEqual:
+			mayMatch = stats.Min.LE(value)
+		default:
+			mayMatch = true
+		}
+		if mayMatch {
+			return true
+		}
+	}
+	return false
+}
+
+func binaryRangeMayMatch(expr *planpb.BinaryRangeExpr, stats *storage.FieldStats) bool {
+	lowers, lowerOk := genericValueToKeys(expr.GetLowerValue(), stats)
+	uppers, upperOk := genericValueToKeys(expr.GetUpperValue(), stats)
+	if !lowerOk || !upperOk {
+		return true
+	}
+	for _, lower := range lowers {
+		for _, upper := range uppers {
+			if expr.GetLowerInclusive() && stats.Max.LT(lower) || !expr.GetLowerInclusive() && stats.Max.LE(lower) {
+				continue
+			}
+			if expr.GetUpperInclusive() && stats.Min.GT(upper) || !expr.GetUpperInclusive() && stats.Min.GE(upper) {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// genericValueToKeys converts the value of the expression to the same key type of the stats. The value of a float
+// field may be compared in either float or double precision, so both of them are returned and the expression may
+// match if any of them matches.
+func genericValueToKeys(value *planpb.GenericValue, stats *storage.FieldStats) ([]storage.PrimaryKey, bool) {
+	switch stats.Min.(type) {
+	case *storage.Int64PrimaryKey:
+		if v, ok := value.GetVal().(*planpb.GenericValue_Int64Val); ok {
+			return []storage.PrimaryKey{storage.NewInt64PrimaryKey(v.Int64Val)}, true
+		}
+	case *storage.DoubleFieldValue:
+		var f float64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_FloatVal:
+			f = v.FloatVal
+		case *planpb.GenericValue_Int64Val:
+			f = float64(v.Int64Val)
+		default:
+			return nil, false
+		}
+		keys := []storage.PrimaryKey{storage.NewDoubleFieldValue(f)}
+		if schemapb.DataType(stats.Type) == schemapb.DataType_Float && float64(float32(f)) != f {
+			keys = append(keys, storage.NewDoubleFieldValue(float64(float32(f))))
+		}
+		return keys, true
+	case *storage.VarCharPrimaryKey:
+		if v, ok := value.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return []storage.PrimaryKey{storage.NewVarCharPrimaryKey(v.StringVal)}, true
+		}
+	}
+	return nil, false
+}
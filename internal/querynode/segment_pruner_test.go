@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newFieldStats(t *testing.T, fieldID int64, dataType schemapb.DataType, data storage.FieldData, withBloomFilter bool) *storage.FieldStats {
+	sw := &storage.StatsWriter{}
+	require.NoError(t, sw.GenerateFieldStats(fieldID, dataType, data, withBloomFilter))
+	stats, err := storage.DeserializeFieldStats([]*storage.Blob{{Value: sw.GetBuffer()}})
+	require.NoError(t, err)
+	return stats[0]
+}
+
+func TestExprMayMatch(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 104, Name: "tag", DataType: schemapb.DataType_Int32},
+			{FieldID: 105, Name: "weight", DataType: schemapb.DataType_Double},
+		},
+	}
+	stats := map[int64][]*storage.FieldStats{
+		// two stats logs of age, [10, 12] and [18, 20], and an empty one
+		101: {
+			newFieldStats(t, 101, schemapb.DataType_Int64, &storage.Int64FieldData{Data: []int64{10, 12}}, false),
+			newFieldStats(t, 101, schemapb.DataType_Int64, &storage.Int64FieldData{Data: []int64{20, 18}}, false),
+			newFieldStats(t, 101, schemapb.DataType_Int64, &storage.Int64FieldData{}, false),
+		},
+		102: {newFieldStats(t, 102, schemapb.DataType_VarChar, &storage.StringFieldData{Data: []string{"b", "d"}}, false)},
+		103: {newFieldStats(t, 103, schemapb.DataType_Float, &storage.FloatFieldData{Data: []float32{0.1, 0.5}}, false)},
+		104: {newFieldStats(t, 104, schemapb.DataType_Int32, &storage.Int32FieldData{Data: []int32{1, 100}}, true)},
+		105: {newFieldStats(t, 105, schemapb.DataType_Double, &storage.DoubleFieldData{Data: []float64{-1.5, 1.5}}, false)},
+	}
+	getStats := func(fieldID int64) []*storage.FieldStats {
+		return stats[fieldID]
+	}
+
+	tests := []struct {
+		expr     string
+		mayMatch bool
+	}{
+		{"age == 11", true},
+		{"age == 15", false},
+		{"age == 21", false},
+		{"age > 20", false},
+		{"age >= 20", true},
+		{"age < 10", false},
+		{"age <= 10", true},
+		{"age != 15", true},
+		{"5 < age < 10", false},
+		{"5 < age <= 10", true},
+		{"12 < age < 18", false},
+		{"12 <= age < 18", true},
+		{"20 < age < 30", false},
+		{"20 <= age < 30", true},
+		{"age in [1, 2, 15, 30]", false},
+		{"age in [1, 19]", true},
+		{`name == "a"`, false},
+		{`name in ["c", "e"]`, true},
+		{`name > "d"`, false},
+		{"score >= 0.5", true},
+		{"score > 0.5", false},
+		{"score <= 0.1", true},
+		{"score < 0.1", false},
+		{"score == 0.1", true},
+		{"score == 0.6", false},
+		{"weight > 1.5", false},
+		{"weight >= 1", true},
+		{"weight < -1.5", false},
+		{"tag == 1", true},
+		{"tag in [1, 100]", true},
+		{"tag == 200", false},
+		{"age > 20 && score > 0.1", false},
+		{"age > 20 || score > 0.1", true},
+		{`age > 20 || name == "a"`, false},
+		{"not (age == 15)", true},
+		{"pk > 100", true},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			plan, err := planparserv2.CreateRetrievePlan(schema, test.expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.mayMatch, exprMayMatch(plan.GetPredicates(), getStats))
+		})
+	}
+
+	// the bloom filter skips the values inside the range which don't exist
+	bfStats := stats[104][0]
+	assert.NotNil(t, bfStats.BF)
+	absent := int64(0)
+	for v := int64(2); v < 100; v++ {
+		if !bfStats.MayContain(storage.NewInt64PrimaryKey(v)) {
+			absent = v
+			break
+		}
+	}
+	require.NotZero(t, absent)
+	plan, err := planparserv2.CreateRetrievePlan(schema, fmt.Sprintf("tag == %d", absent))
+	require.NoError(t, err)
+	assert.False(t, exprMayMatch(plan.GetPredicates(), getStats))
+}
+
+func TestSegment_FieldStats(t *testing.T) {
+	segment := &Segment{}
+	assert.Nil(t, segment.getFieldStats(101))
+
+	segment.setFieldStats([]*storage.FieldStats{
+		{FieldID: 101, Min: storage.NewInt64PrimaryKey(10), Max: storage.NewInt64PrimaryKey(20)},
+		{FieldID: 102, Min: storage.NewVarCharPrimaryKey("a"), Max: storage.NewVarCharPrimaryKey("b")},
+	})
+	segment.setFieldStats([]*storage.FieldStats{
+		{FieldID: 101, Min: storage.NewInt64PrimaryKey(5), Max: storage.NewInt64PrimaryKey(15)},
+	})
+	assert.Equal(t, 2, len(segment.getFieldStats(101)))
+	assert.Equal(t, 1, len(segment.getFieldStats(102)))
+}
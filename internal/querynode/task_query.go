@@ -22,12 +22,15 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 )
@@ -77,6 +80,7 @@ func (q *queryTask) queryOnStreaming() error {
 		return err
 	}
 	defer plan.delete()
+	plan.setCollectionTTL(q.iReq.GetCollectionTtlTimestamps())
 
 	sResults, _, _, sErr := retrieveStreaming(ctx, q.QS.metaReplica, plan, q.CollectionID, q.iReq.GetPartitionIDs(), q.QS.channel, q.QS.vectorChunkManager)
 	if sErr != nil {
@@ -122,9 +126,20 @@ func (q *queryTask) queryOnHistorical() error {
 		return err
 	}
 	defer plan.delete()
-	retrieveResults, _, _, err := retrieveHistorical(ctx, q.QS.metaReplica, plan, q.CollectionID, nil, q.req.SegmentIDs, q.QS.vectorChunkManager)
-	if err != nil {
-		return err
+	plan.setCollectionTTL(q.iReq.GetCollectionTtlTimestamps())
+
+	// skip the segments whose field stats can't match the filter
+	segmentIDs := q.req.GetSegmentIDs()
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(q.iReq.GetSerializedExprPlan(), planNode); err == nil {
+		segmentIDs = pruneSegmentsByFieldStats(q.QS.metaReplica, segmentIDs, planNode.GetPredicates())
+	}
+	var retrieveResults []*segcorepb.RetrieveResults
+	if len(q.req.GetSegmentIDs()) == 0 || len(segmentIDs) > 0 {
+		retrieveResults, _, _, err = retrieveHistorical(ctx, q.QS.metaReplica, plan, q.CollectionID, nil, segmentIDs, q.QS.vectorChunkManager)
+		if err != nil {
+			return err
+		}
 	}
 
 	mergedResult, err := mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, retrieveResults, q.req.GetReq().GetLimit(), q.iReq.GetOutputFieldsId(), coll.Schema())
@@ -109,20 +109,15 @@ func (s *searchTask) searchOnStreaming() error {
 		return fmt.Errorf("retrieve failed, collection has been released, collectionID = %d", s.CollectionID)
 	}
 
-	searchReq, err2 := newSearchRequest(s.QS.collection, s.req, s.PlaceholderGroup)
-	if err2 != nil {
-		return err2
-	}
-	defer searchReq.delete()
-
-	partResults, _, _, sErr := searchStreaming(ctx, s.QS.metaReplica, searchReq, s.CollectionID, s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
-	if sErr != nil {
-		log.Ctx(ctx).Warn("failed to search streaming data",
-			zap.Int64("collectionID", s.CollectionID), zap.Error(sErr))
-		return sErr
-	}
-	defer deleteSearchResults(partResults)
-	return s.reduceResults(ctx, searchReq, partResults)
+	return s.searchAndReduce(ctx, func(searchReq *searchRequest) ([]*SearchResult, error) {
+		partResults, _, _, sErr := searchStreaming(ctx, s.QS.metaReplica, searchReq, s.CollectionID, s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
+		if sErr != nil {
+			log.Ctx(ctx).Warn("failed to search streaming data",
+				zap.Int64("collectionID", s.CollectionID), zap.Error(sErr))
+			return nil, sErr
+		}
+		return partResults, nil
+	})
 }
 
 func (s *searchTask) searchOnHistorical() error {
@@ -144,19 +139,70 @@ func (s *searchTask) searchOnHistorical() error {
 		return fmt.Errorf("retrieve failed, collection has been released, collectionID = %d", s.CollectionID)
 	}
 
-	segmentIDs := s.req.GetSegmentIDs()
-	searchReq, err2 := newSearchRequest(s.QS.collection, s.req, s.PlaceholderGroup)
-	if err2 != nil {
-		return err2
+	// skip the segments whose field stats can't match the filter
+	segmentIDs := pruneSegmentsByFieldStats(s.QS.metaReplica, s.req.GetSegmentIDs(), s.plan.GetVectorAnns().GetPredicates())
+	if len(s.req.GetSegmentIDs()) > 0 && len(segmentIDs) == 0 {
+		_, err := s.reduceResults(ctx, nil, nil, s.TopK)
+		return err
+	}
+	return s.searchAndReduce(ctx, func(searchReq *searchRequest) ([]*SearchResult, error) {
+		partResults, _, _, err := searchHistorical(ctx, s.QS.metaReplica, searchReq, s.CollectionID, nil, segmentIDs)
+		return partResults, err
+	})
+}
+
+// searchAndReduce searches by the search function and reduces the results. The hits of a group by search may fall
+// into less groups than requested, so it's repeated with a doubled topk until the groups of every query are filled,
+// the hits are exhausted or topk reaches the limit.
+func (s *searchTask) searchAndReduce(ctx context.Context, search func(*searchRequest) ([]*SearchResult, error)) error {
+	req := s.req
+	searchTopK := s.TopK
+	for {
+		more, err := s.searchAndReduceOnce(ctx, req, searchTopK, search)
+		if err != nil || !more {
+			return err
+		}
+		searchTopK *= 2
+		if topKLimit := Params.CommonCfg.TopKLimit.GetAsInt64(); searchTopK > topKLimit {
+			searchTopK = topKLimit
+		}
+		log.Ctx(ctx).Debug("search more hits to fill the groups",
+			zap.Int64("collectionID", s.CollectionID), zap.Int64("topk", searchTopK))
+		req, err = s.searchRequestWithTopK(searchTopK)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *searchTask) searchAndReduceOnce(ctx context.Context, req *querypb.SearchRequest, searchTopK int64,
+	search func(*searchRequest) ([]*SearchResult, error)) (bool, error) {
+	searchReq, err := newSearchRequest(s.QS.collection, req, s.PlaceholderGroup)
+	if err != nil {
+		return false, err
 	}
 	defer searchReq.delete()
 
-	partResults, _, _, err := searchHistorical(ctx, s.QS.metaReplica, searchReq, s.CollectionID, nil, segmentIDs)
+	results, err := search(searchReq)
 	if err != nil {
-		return err
+		return false, err
+	}
+	defer deleteSearchResults(results)
+	return s.reduceResults(ctx, searchReq, results, searchTopK)
+}
+
+// searchRequestWithTopK returns a copy of the search request, which searches topK hits per query.
+func (s *searchTask) searchRequestWithTopK(topK int64) (*querypb.SearchRequest, error) {
+	plan := proto.Clone(s.plan).(*planpb.PlanNode)
+	plan.GetVectorAnns().GetQueryInfo().Topk = topK
+	expr, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
 	}
-	defer deleteSearchResults(partResults)
-	return s.reduceResults(ctx, searchReq, partResults)
+	req := proto.Clone(s.req).(*querypb.SearchRequest)
+	req.Req.SerializedExprPlan = expr
+	req.Req.Topk = topK
+	return req, nil
 }
 
 func (s *searchTask) Execute(ctx context.Context) error {
@@ -216,21 +262,31 @@ func (s *searchTask) CPUUsage() int32 {
 	return s.cpu
 }
 
-// reduceResults reduce search results
-func (s *searchTask) reduceResults(ctx context.Context, searchReq *searchRequest, results []*SearchResult) error {
+// reduceResults reduce search results searched with searchTopK hits per query,
+// true is returned without any result set if a group by search needs to search more hits to fill the groups.
+func (s *searchTask) reduceResults(ctx context.Context, searchReq *searchRequest, results []*SearchResult, searchTopK int64) (bool, error) {
 	isEmpty := len(results) == 0
 	cnt := 1 + len(s.otherTasks)
 	var t *searchTask
 	s.tr.RecordSpan()
 	if !isEmpty {
-		sInfo := parseSliceInfo(s.OrigNQs, s.OrigTopKs, s.NQ)
+		topKs := s.OrigTopKs
+		if searchTopK != s.TopK {
+			topKs = make([]int64, len(s.OrigTopKs))
+			for i := range topKs {
+				topKs[i] = searchTopK
+			}
+		}
+		sInfo := parseSliceInfo(s.OrigNQs, topKs, s.NQ)
 		numSegment := int64(len(results))
 		blobs, err := reduceSearchResultsAndFillData(searchReq.plan, results, numSegment, sInfo.sliceNQs, sInfo.sliceTopKs)
 		if err != nil {
 			log.Ctx(ctx).Warn("marshal for historical results error",
 				zap.Error(err))
-			return err
+			return false, err
 		}
+		// only the plan of an expr search can be searched again with another topk
+		canSearchMore := s.iReq.GetDslType() == commonpb.DslType_BoolExprV1 && s.plan != nil && searchTopK < Params.CommonCfg.TopKLimit.GetAsInt64()
 
 		defer func() {
 			deleteSearchResultDataBlobs(blobs)
@@ -242,10 +298,22 @@ func (s *searchTask) reduceResults(ctx context.Context, searchReq *searchRequest
 			if err != nil {
 				log.Ctx(ctx).Warn("getSearchResultDataBlob for historical results error",
 					zap.Error(err))
-				return err
+				return false, err
 			}
 			bs := make([]byte, len(blob))
 			copy(bs, blob)
+			if groupByFieldID := s.iReq.GetGroupByFieldId(); groupByFieldID > 0 && bs != nil {
+				var unfilled bool
+				bs, unfilled, err = groupSearchResultBlob(ctx, bs, s.OrigNQs[i], s.OrigTopKs[i], searchTopK, groupByFieldID, s.iReq.GetGroupSize())
+				if err != nil {
+					log.Ctx(ctx).Warn("group search results error",
+						zap.Error(err))
+					return false, err
+				}
+				if unfilled && canSearchMore {
+					return true, nil
+				}
+			}
 			if i == 0 {
 				t = s
 			} else {
@@ -281,7 +349,7 @@ func (s *searchTask) reduceResults(ctx context.Context, searchReq *searchRequest
 
 		s.reduceDur = s.tr.RecordSpan()
 	}
-	return nil
+	return false, nil
 }
 
 func (s *searchTask) CanMergeWith(t readTask) bool {
@@ -344,6 +412,16 @@ func (s *searchTask) CanMergeWith(t readTask) bool {
 		return false
 	}
 
+	// the merged task filters the expired entities with one ttl timestamp,
+	// a different one would hide or expose entities to one of the requests
+	if s.iReq.GetCollectionTtlTimestamps() != s2.iReq.GetCollectionTtlTimestamps() {
+		return false
+	}
+
+	if s.iReq.GetGroupByFieldId() != s2.iReq.GetGroupByFieldId() || s.iReq.GetGroupSize() != s2.iReq.GetGroupSize() {
+		return false
+	}
+
 	if !planparserv2.CheckPlanNodeIdentical(s.plan, s2.plan) {
 		return false
 	}
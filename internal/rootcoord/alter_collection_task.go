@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
 
@@ -21,6 +23,18 @@ func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 		return fmt.Errorf("alter collection failed, collection name does not exists")
 	}
 
+	for _, kv := range a.Req.GetProperties() {
+		switch kv.GetKey() {
+		case common.CollectionInsertRateMaxKey, common.CollectionDeleteRateMaxKey, common.CollectionBulkLoadRateMaxKey,
+			common.CollectionSearchRateMaxKey, common.CollectionQueryRateMaxKey, common.CollectionDiskQuotaKey,
+			common.PartitionInsertRateMaxKey, common.PartitionDeleteRateMaxKey, common.PartitionBulkLoadRateMaxKey,
+			common.PartitionDiskQuotaKey:
+			if _, err := strconv.ParseFloat(kv.GetValue(), 64); err != nil {
+				return fmt.Errorf("alter collection failed, invalid value %s of property %s", kv.GetValue(), kv.GetKey())
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -30,7 +44,7 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 		return errors.New("only support alter collection properties, but collection properties is empty")
 	}
 
-	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetCollectionName(), a.ts)
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetDbName(), a.Req.GetCollectionName(), a.ts)
 	if err != nil {
 		log.Warn("get collection failed during changing collection state",
 			zap.String("collectionName", a.Req.GetCollectionName()), zap.Uint64("ts", a.ts))
@@ -51,6 +65,7 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 
 	redoTask.AddSyncStep(&expireCacheStep{
 		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
 		collectionNames: []string{oldColl.Name},
 		collectionID:    oldColl.CollectionID,
 		ts:              ts,
@@ -32,6 +32,34 @@ func Test_alterCollectionTask_Prepare(t *testing.T) {
 		err := task.Prepare(context.Background())
 		assert.NoError(t, err)
 	})
+
+	t.Run("invalid quota property", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionInsertRateMaxKey, Value: "abc"},
+				},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.Properties[0].Value = "10.5"
+		err = task.Prepare(context.Background())
+		assert.NoError(t, err)
+
+		task.Req.Properties = []*commonpb.KeyValuePair{
+			{Key: common.PartitionDiskQuotaKey, Value: "abc"},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.Properties[0].Value = "1024"
+		err = task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
 }
 
 func Test_alterCollectionTask_Execute(t *testing.T) {
@@ -64,7 +92,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("alter step failed", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -87,7 +115,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("broadcast step failed", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -115,7 +143,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("alter successfully", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
 
 	ms "github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -35,11 +38,15 @@ type collectionChannels struct {
 
 type createCollectionTask struct {
 	baseTask
-	Req      *milvuspb.CreateCollectionRequest
-	schema   *schemapb.CollectionSchema
-	collID   UniqueID
-	partID   UniqueID
-	channels collectionChannels
+	Req     *milvuspb.CreateCollectionRequest
+	schema  *schemapb.CollectionSchema
+	dbID    int64
+	collID  UniqueID
+	partIDs []UniqueID
+	// partitionNames are the names of the partitions created along with the collection, it's the default partition
+	// or the hidden partitions of a collection with partition key.
+	partitionNames []string
+	channels       collectionChannels
 }
 
 func (t *createCollectionTask) validate() error {
@@ -74,7 +81,13 @@ func (t *createCollectionTask) validateSchema(schema *schemapb.CollectionSchema)
 	if hasSystemFields(schema, []string{RowIDFieldName, TimeStampFieldName}) {
 		return fmt.Errorf("schema contains system field: %s, %s", RowIDFieldName, TimeStampFieldName)
 	}
-	return nil
+	if err := typeutil.ValidatePartitionKeyField(schema); err != nil {
+		return err
+	}
+	if err := typeutil.ValidateClusteringKeyField(schema); err != nil {
+		return err
+	}
+	return typeutil.ValidateFieldStats(schema)
 }
 
 func (t *createCollectionTask) assignFieldID(schema *schemapb.CollectionSchema) {
@@ -126,10 +139,48 @@ func (t *createCollectionTask) assignCollectionID() error {
 	return err
 }
 
-func (t *createCollectionTask) assignPartitionID() error {
-	var err error
-	t.partID, err = t.core.idAllocator.AllocOne()
-	return err
+// getNumPartitionsWithKey returns the number of hidden partitions of a collection with partition key.
+func (t *createCollectionTask) getNumPartitionsWithKey() (int, error) {
+	numPartitions := Params.RootCoordCfg.DefaultPartitionsWithKey.GetAsInt()
+	for _, kv := range t.Req.GetProperties() {
+		if kv.GetKey() == common.PartitionKeyNumPartitionsKey {
+			num, err := strconv.Atoi(kv.GetValue())
+			if err != nil {
+				return 0, fmt.Errorf("invalid value for %s: %s", common.PartitionKeyNumPartitionsKey, kv.GetValue())
+			}
+			numPartitions = num
+		}
+	}
+	maxPartitionNum := Params.RootCoordCfg.MaxPartitionNum.GetAsInt()
+	if numPartitions <= 0 || numPartitions > maxPartitionNum {
+		return 0, fmt.Errorf("the number of partitions (%d) should be in range [1, %d]", numPartitions, maxPartitionNum)
+	}
+	return numPartitions, nil
+}
+
+func (t *createCollectionTask) assignPartitionIDs() error {
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	t.partitionNames = []string{defaultPartitionName}
+	if typeutil.GetPartitionKeyField(t.schema) != nil {
+		numPartitions, err := t.getNumPartitionsWithKey()
+		if err != nil {
+			return err
+		}
+		t.partitionNames = make([]string, numPartitions)
+		for i := 0; i < numPartitions; i++ {
+			t.partitionNames[i] = fmt.Sprintf("%s_%d", defaultPartitionName, i)
+		}
+	}
+
+	start, end, err := t.core.idAllocator.Alloc(uint32(len(t.partitionNames)))
+	if err != nil {
+		return err
+	}
+	t.partIDs = make([]UniqueID, 0, len(t.partitionNames))
+	for id := start; id < end; id++ {
+		t.partIDs = append(t.partIDs, id)
+	}
+	return nil
 }
 
 func (t *createCollectionTask) assignChannels() error {
@@ -151,6 +202,15 @@ func (t *createCollectionTask) assignChannels() error {
 	return nil
 }
 
+func (t *createCollectionTask) assignDatabaseID(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.dbID = db.ID
+	return nil
+}
+
 func (t *createCollectionTask) Prepare(ctx context.Context) error {
 	if err := t.validate(); err != nil {
 		return err
@@ -160,13 +220,17 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 		return err
 	}
 
+	if err := t.assignDatabaseID(ctx); err != nil {
+		return err
+	}
+
 	t.assignShardsNum()
 
 	if err := t.assignCollectionID(); err != nil {
 		return err
 	}
 
-	if err := t.assignPartitionID(); err != nil {
+	if err := t.assignPartitionIDs(); err != nil {
 		return err
 	}
 
@@ -176,7 +240,7 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 func (t *createCollectionTask) genCreateCollectionMsg(ctx context.Context) *ms.MsgPack {
 	ts := t.GetTs()
 	collectionID := t.collID
-	partitionID := t.partID
+	partitionID := t.partIDs[0]
 	// error won't happen here.
 	marshaledSchema, _ := proto.Marshal(t.schema)
 	pChannels := t.channels.physicalChannels
@@ -215,7 +279,6 @@ func (t *createCollectionTask) addChannelsAndGetStartPositions(ctx context.Conte
 
 func (t *createCollectionTask) Execute(ctx context.Context) error {
 	collID := t.collID
-	partID := t.partID
 	ts := t.GetTs()
 
 	vchanNames := t.channels.virtualChannels
@@ -230,6 +293,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 
 	collInfo := model.Collection{
 		CollectionID:         collID,
+		DBID:                 t.dbID,
 		Name:                 t.schema.Name,
 		Description:          t.schema.Description,
 		AutoID:               t.schema.AutoID,
@@ -241,25 +305,29 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 		StartPositions:       toKeyDataPairs(startPositions),
 		CreateTime:           ts,
 		State:                pb.CollectionState_CollectionCreating,
-		Partitions: []*model.Partition{
-			{
-				PartitionID:               partID,
-				PartitionName:             Params.CommonCfg.DefaultPartitionName.GetValue(),
-				PartitionCreatedTimestamp: ts,
-				CollectionID:              collID,
-				State:                     pb.PartitionState_PartitionCreated,
-			},
-		},
-		Properties: t.Req.Properties,
+		Partitions:           make([]*model.Partition, 0, len(t.partIDs)),
+		Properties:           t.Req.Properties,
+	}
+	for i, partID := range t.partIDs {
+		collInfo.Partitions = append(collInfo.Partitions, &model.Partition{
+			PartitionID:               partID,
+			PartitionName:             t.partitionNames[i],
+			PartitionCreatedTimestamp: ts,
+			CollectionID:              collID,
+			State:                     pb.PartitionState_PartitionCreated,
+		})
 	}
 
 	// We cannot check the idempotency inside meta table when adding collection, since we'll execute duplicate steps
 	// if add collection successfully due to idempotency check. Some steps may be risky to be duplicate executed if they
 	// are not promised idempotent.
 	clone := collInfo.Clone()
-	clone.Partitions = []*model.Partition{{PartitionName: Params.CommonCfg.DefaultPartitionName.GetValue()}}
+	clone.Partitions = make([]*model.Partition, 0, len(t.partitionNames))
+	for _, partitionName := range t.partitionNames {
+		clone.Partitions = append(clone.Partitions, &model.Partition{PartitionName: partitionName})
+	}
 	// need double check in meta table if we can't promise the sequence execution.
-	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
 	if err == nil {
 		equal := existedCollInfo.Equal(*clone)
 		if !equal {
@@ -273,6 +341,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 	undoTask := newBaseUndoTask(t.core.stepExecutor)
 	undoTask.AddStep(&expireCacheStep{
 		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
 		collectionNames: []string{t.Req.GetCollectionName()},
 		collectionID:    InvalidCollectionID,
 		ts:              ts,
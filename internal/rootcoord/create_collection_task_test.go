@@ -14,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -214,7 +215,11 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		marshaledSchema, err := proto.Marshal(schema)
 		assert.NoError(t, err)
 
-		core := newTestCore(withInvalidIDAllocator())
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return model.NewDefaultDatabase(), nil
+		}
+		core := newTestCore(withInvalidIDAllocator(), withMeta(meta))
 
 		task := createCollectionTask{
 			baseTask: baseTask{core: core},
@@ -236,7 +241,11 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 
 		ticker := newRocksMqTtSynchronizer()
 
-		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker))
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return &model.Database{ID: 1, Name: dbName}, nil
+		}
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
 
 		schema := &schemapb.CollectionSchema{
 			Name:        collectionName,
@@ -263,6 +272,109 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		task.Req.ShardsNum = 1
 		err = task.Prepare(context.Background())
 		assert.NoError(t, err)
+		assert.Equal(t, int64(1), task.dbID)
+	})
+
+	t.Run("database not exist", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		field1 := funcutil.GenRandomStr()
+		schema := &schemapb.CollectionSchema{
+			Name:   collectionName,
+			Fields: []*schemapb.FieldSchema{{Name: field1}},
+		}
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return nil, errors.New("error mock GetDatabaseByName")
+		}
+		core := newTestCore(withValidIDAllocator(), withMeta(meta))
+
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         "not_exist",
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+			},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func Test_createCollectionTask_assignPartitionIDs(t *testing.T) {
+	partitionKeyField := &schemapb.FieldSchema{
+		Name:       "tenant",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}},
+	}
+
+	t.Run("default partition", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &milvuspb.CreateCollectionRequest{},
+			schema:   &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{Name: "field1"}}},
+		}
+		err := task.assignPartitionIDs()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(task.partIDs))
+		assert.Equal(t, []string{Params.CommonCfg.DefaultPartitionName.GetValue()}, task.partitionNames)
+	})
+
+	t.Run("partition key", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.CreateCollectionRequest{
+				Properties: []*commonpb.KeyValuePair{{Key: common.PartitionKeyNumPartitionsKey, Value: "16"}},
+			},
+			schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{partitionKeyField}},
+		}
+		err := task.assignPartitionIDs()
+		assert.NoError(t, err)
+		assert.Equal(t, 16, len(task.partIDs))
+		assert.Equal(t, 16, len(task.partitionNames))
+		assert.Equal(t, Params.CommonCfg.DefaultPartitionName.GetValue()+"_15", task.partitionNames[15])
+	})
+
+	t.Run("default number of partitions", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &milvuspb.CreateCollectionRequest{},
+			schema:   &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{partitionKeyField}},
+		}
+		err := task.assignPartitionIDs()
+		assert.NoError(t, err)
+		assert.Equal(t, Params.RootCoordCfg.DefaultPartitionsWithKey.GetAsInt(), len(task.partIDs))
+	})
+
+	t.Run("invalid number of partitions", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		for _, num := range []string{"abc", "0", "100000"} {
+			task := createCollectionTask{
+				baseTask: baseTask{core: core},
+				Req: &milvuspb.CreateCollectionRequest{
+					Properties: []*commonpb.KeyValuePair{{Key: common.PartitionKeyNumPartitionsKey, Value: num}},
+				},
+				schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{partitionKeyField}},
+			}
+			assert.Error(t, task.assignPartitionIDs())
+		}
+	})
+
+	t.Run("failed to alloc", func(t *testing.T) {
+		core := newTestCore(withInvalidIDAllocator())
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &milvuspb.CreateCollectionRequest{},
+			schema:   &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{Name: "field1"}}},
+		}
+		assert.Error(t, task.assignPartitionIDs())
 	})
 }
 
@@ -276,7 +388,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		coll := &model.Collection{Name: collectionName}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -304,6 +416,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		field1 := funcutil.GenRandomStr()
 		collID := UniqueID(1)
+		partID := UniqueID(2)
 		schema := &schemapb.CollectionSchema{Name: collectionName, Fields: []*schemapb.FieldSchema{{Name: field1}}}
 		channels := collectionChannels{
 			virtualChannels:  []string{funcutil.GenRandomStr(), funcutil.GenRandomStr()},
@@ -321,7 +434,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -333,9 +446,11 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
 				CollectionName: collectionName,
 			},
-			collID:   collID,
-			schema:   schema,
-			channels: channels,
+			collID:         collID,
+			schema:         schema,
+			channels:       channels,
+			partIDs:        []UniqueID{partID},
+			partitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue()},
 		}
 
 		err := task.Execute(context.Background())
@@ -353,6 +468,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				physicalChannels: pchans,
 				virtualChannels:  []string{funcutil.GenRandomStr(), funcutil.GenRandomStr()},
 			},
+			partIDs: []UniqueID{1},
 		}
 		err := task.Execute(context.Background())
 		assert.Error(t, err)
@@ -369,7 +485,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
@@ -420,8 +536,10 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				Schema:         marshaledSchema,
 				ShardsNum:      int32(shardNum),
 			},
-			channels: collectionChannels{physicalChannels: pchans},
-			schema:   schema,
+			channels:       collectionChannels{physicalChannels: pchans},
+			schema:         schema,
+			partIDs:        []UniqueID{1},
+			partitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue()},
 		}
 
 		err = task.Execute(context.Background())
@@ -439,7 +557,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
@@ -503,8 +621,10 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				Schema:         marshaledSchema,
 				ShardsNum:      int32(shardNum),
 			},
-			channels: collectionChannels{physicalChannels: pchans},
-			schema:   schema,
+			channels:       collectionChannels{physicalChannels: pchans},
+			schema:         schema,
+			partIDs:        []UniqueID{1},
+			partitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue()},
 		}
 
 		err = task.Execute(context.Background())
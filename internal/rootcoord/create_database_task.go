@@ -0,0 +1,38 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+type createDatabaseTask struct {
+	baseTask
+	Req  *proxypb.CreateDatabaseRequest
+	dbID UniqueID
+}
+
+func (t *createDatabaseTask) Prepare(ctx context.Context) error {
+	if t.Req.GetDbName() == "" {
+		return errors.New("database name should not be empty")
+	}
+	dbID, err := t.core.idAllocator.AllocOne()
+	if err != nil {
+		return err
+	}
+	t.dbID = dbID
+	return nil
+}
+
+func (t *createDatabaseTask) Execute(ctx context.Context) error {
+	// create database is atomic enough.
+	return t.core.meta.CreateDatabase(ctx, &model.Database{
+		ID:          t.dbID,
+		Name:        t.Req.GetDbName(),
+		State:       pb.DatabaseState_DatabaseCreated,
+		CreatedTime: t.GetTs(),
+	}, t.GetTs())
+}
@@ -0,0 +1,74 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func Test_createDatabaseTask_Prepare(t *testing.T) {
+	t.Run("empty database name", func(t *testing.T) {
+		task := &createDatabaseTask{
+			Req: &proxypb.CreateDatabaseRequest{},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to assign id", func(t *testing.T) {
+		core := newTestCore(withInvalidIDAllocator())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.CreateDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.CreateDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_createDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to create database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.CreateDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		dbName := funcutil.GenRandomStr()
+		meta := newMockMetaTable()
+		meta.CreateDatabaseFunc = func(ctx context.Context, db *model.Database, ts Timestamp) error {
+			assert.Equal(t, dbName, db.Name)
+			assert.Equal(t, UniqueID(100), db.ID)
+			assert.Equal(t, pb.DatabaseState_DatabaseCreated, db.State)
+			return nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.CreateDatabaseRequest{DbName: dbName},
+			dbID:     100,
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
@@ -37,7 +37,7 @@ func Test_createPartitionTask_Prepare(t *testing.T) {
 		meta := newMockMetaTable()
 		collectionName := funcutil.GenRandomStr()
 		coll := &model.Collection{Name: collectionName}
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll.Clone(), nil
 		}
 		core := newTestCore(withMeta(meta))
@@ -0,0 +1,28 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+type dropDatabaseTask struct {
+	baseTask
+	Req *proxypb.DropDatabaseRequest
+}
+
+func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
+	if funcutil.DBNameOrDefault(t.Req.GetDbName()) == util.DefaultDBName {
+		return errors.New("can not drop default database")
+	}
+	return nil
+}
+
+func (t *dropDatabaseTask) Execute(ctx context.Context) error {
+	// meta table refuses to drop the database if there is any collection in it,
+	// so there is no cache of the proxies to be expired.
+	return t.core.meta.DropDatabase(ctx, t.Req.GetDbName(), t.GetTs())
+}
@@ -0,0 +1,60 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func Test_dropDatabaseTask_Prepare(t *testing.T) {
+	t.Run("drop default database", func(t *testing.T) {
+		task := &dropDatabaseTask{
+			Req: &proxypb.DropDatabaseRequest{DbName: util.DefaultDBName},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.DbName = ""
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &dropDatabaseTask{
+			Req: &proxypb.DropDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_dropDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to drop database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &dropDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.DropDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.DropDatabaseFunc = func(ctx context.Context, dbName string, ts Timestamp) error {
+			return nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &dropDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.DropDatabaseRequest{DbName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type FlushCollectionFunc func(ctx context.Context, collID UniqueID) ([]UniqueID, error)
+type GetFlushStateFunc func(ctx context.Context, segIDs []UniqueID) (bool, error)
+type GetSegmentBinlogsFunc func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error)
+type SegRefLockFunc func(ctx context.Context, taskID int64, segIDs []UniqueID) error
+type GetCollectionSchemaFunc func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error)
+type ExportSegmentFunc func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error)
+type RemoveFilesFunc func(ctx context.Context, files []string) error
+
+type ExportFactory interface {
+	NewIDAllocator() IDAllocator
+	NewFlushCollectionFunc() FlushCollectionFunc
+	NewGetFlushStateFunc() GetFlushStateFunc
+	NewGetSegmentBinlogsFunc() GetSegmentBinlogsFunc
+	NewAddSegRefLockFunc() SegRefLockFunc
+	NewReleaseSegRefLockFunc() SegRefLockFunc
+	NewGetCollectionSchemaFunc() GetCollectionSchemaFunc
+	NewExportSegmentFunc() ExportSegmentFunc
+	NewListDataNodesFunc() ListDataNodesFunc
+	NewRemoveFilesFunc() RemoveFilesFunc
+}
+
+type ExportFactoryImpl struct {
+	c *Core
+}
+
+func (f ExportFactoryImpl) NewIDAllocator() IDAllocator {
+	return IDAllocatorWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewFlushCollectionFunc() FlushCollectionFunc {
+	return FlushCollectionWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewGetFlushStateFunc() GetFlushStateFunc {
+	return GetFlushStateWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewGetSegmentBinlogsFunc() GetSegmentBinlogsFunc {
+	return GetSegmentBinlogsWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewAddSegRefLockFunc() SegRefLockFunc {
+	return func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+		return f.c.broker.AddSegRefLock(ctx, taskID, segIDs)
+	}
+}
+
+func (f ExportFactoryImpl) NewReleaseSegRefLockFunc() SegRefLockFunc {
+	return func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+		return f.c.broker.ReleaseSegRefLock(ctx, taskID, segIDs)
+	}
+}
+
+func (f ExportFactoryImpl) NewGetCollectionSchemaFunc() GetCollectionSchemaFunc {
+	return GetCollectionSchemaWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewExportSegmentFunc() ExportSegmentFunc {
+	return ExportSegmentWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewListDataNodesFunc() ListDataNodesFunc {
+	return ListDataNodesWithCore(f.c)
+}
+
+func (f ExportFactoryImpl) NewRemoveFilesFunc() RemoveFilesFunc {
+	return RemoveFilesWithCore(f.c)
+}
+
+func NewExportFactory(c *Core) ExportFactory {
+	return &ExportFactoryImpl{c: c}
+}
+
+// FlushCollectionWithCore seals the growing segments of the collection, returns the sealed segments which are
+// not flushed yet.
+func FlushCollectionWithCore(c *Core) FlushCollectionFunc {
+	return func(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+		resp, err := c.dataCoord.Flush(ctx, &datapb.FlushRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Flush),
+				commonpbutil.WithSourceID(c.session.ServerID),
+			),
+			CollectionID: collID,
+		})
+		if err != nil {
+			log.Error("Core failed to flush collection", zap.Int64("collection ID", collID), zap.Error(err))
+			return nil, err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.GetStatus().GetReason())
+		}
+		return resp.GetSegmentIDs(), nil
+	}
+}
+
+func GetFlushStateWithCore(c *Core) GetFlushStateFunc {
+	return func(ctx context.Context, segIDs []UniqueID) (bool, error) {
+		resp, err := c.dataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: segIDs})
+		if err != nil {
+			return false, err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return false, errors.New(resp.GetStatus().GetReason())
+		}
+		return resp.GetFlushed(), nil
+	}
+}
+
+// GetSegmentBinlogsWithCore returns the binlogs of the flushed segments of a partition.
+func GetSegmentBinlogsWithCore(c *Core) GetSegmentBinlogsFunc {
+	return func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error) {
+		resp, err := c.dataCoord.GetRecoveryInfo(ctx, &datapb.GetRecoveryInfoRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_GetRecoveryInfo),
+				commonpbutil.WithSourceID(c.session.ServerID),
+			),
+			CollectionID: collID,
+			PartitionID:  partitionID,
+		})
+		if err != nil {
+			log.Error("Core failed to get recovery info", zap.Int64("collection ID", collID),
+				zap.Int64("partition ID", partitionID), zap.Error(err))
+			return nil, err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.GetStatus().GetReason())
+		}
+		return resp.GetBinlogs(), nil
+	}
+}
+
+func GetCollectionSchemaWithCore(c *Core) GetCollectionSchemaFunc {
+	return func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error) {
+		colInfo, err := c.meta.GetCollectionByID(ctx, collID, typeutil.MaxTimestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get collection %d: %w", collID, err)
+		}
+		return &schemapb.CollectionSchema{
+			Name:        colInfo.Name,
+			Description: colInfo.Description,
+			AutoID:      colInfo.AutoID,
+			Fields:      model.MarshalFieldModels(colInfo.Fields),
+		}, nil
+	}
+}
+
+// ExportSegmentWithCore sends an export segment task to an idle DataNode by DataCoord.
+func ExportSegmentWithCore(c *Core) ExportSegmentFunc {
+	return func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+		return c.dataCoord.ExportSegment(ctx, req)
+	}
+}
+
+// RemoveFilesWithCore removes the exported files from MinIO/S3 storage.
+func RemoveFilesWithCore(c *Core) RemoveFilesFunc {
+	return func(ctx context.Context, files []string) error {
+		cm, err := c.factory.NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			log.Error("Core failed to create chunk manager", zap.Error(err))
+			return err
+		}
+		return cm.MultiRemove(ctx, files)
+	}
+}
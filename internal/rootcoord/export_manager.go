@@ -0,0 +1,750 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// checkFlushStateInterval is the default interval to check if the sealed segments of an export task are flushed,
+// default 1000 milliseconds (1 second).
+var checkFlushStateInterval = 1000
+
+// waitFlushTimeout is the default timeout of waiting for the sealed segments of an export task to be flushed,
+// default 10*60*1000 milliseconds (10 minutes).
+var waitFlushTimeout = 10 * 60 * 1000
+
+// dispatchSegmentInterval is the default interval to retry dispatching the segments of an export task when all
+// DataNodes are busy, default 1000 milliseconds (1 second).
+var dispatchSegmentInterval = 1000
+
+// exportSegmentTimeout is the default timeout of exporting one segment, including the time waiting for an idle
+// DataNode, default 60*60*1000 milliseconds (1 hour).
+var exportSegmentTimeout = 60 * 60 * 1000
+
+// lockSegmentsRetry is the number of attempts to lock a stable set of segments, the segments may be compacted
+// between listing and locking them.
+var lockSegmentsRetry = 3
+
+// exportManager runs the export tasks, each task writes the entities of a collection at a snapshot timestamp
+// into files on the object storage, one file per segment. The segments are exported by DataNodes, which report
+// the written files back by ReportExport. The task infos are persisted in Etcd, the unfinished tasks are marked
+// failed when rootcoord restarts.
+// The segments are read by their binlogs, the deletes after the snapshot are ignored. Compactions only remove the
+// rows deleted before the retention duration, so the snapshot must be within the retention duration until the
+// segments are locked, then the segments and their binlogs are kept as they are till the end of the task.
+type exportManager struct {
+	ctx       context.Context
+	taskStore kv.TxnKV // Persistent task info storage.
+
+	tasks     map[int64]*rootcoordpb.ExportTaskInfo // tasks in memory, including the finished ones
+	tasksLock sync.RWMutex
+	queue     chan int64 // ids of the pending tasks
+
+	// results receive the segment results reported by DataNodes, keyed by the id of the running task
+	results     map[int64]*exportResults
+	resultsLock sync.Mutex
+
+	startOnce sync.Once
+
+	idAllocator           func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)
+	callFlushCollection   func(ctx context.Context, collID UniqueID) ([]UniqueID, error)
+	callGetFlushState     func(ctx context.Context, segIDs []UniqueID) (bool, error)
+	callGetSegmentBinlogs func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error)
+	callAddSegRefLock     func(ctx context.Context, taskID int64, segIDs []UniqueID) error
+	callReleaseSegRefLock func(ctx context.Context, taskID int64, segIDs []UniqueID) error
+	getCollectionSchema   func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error)
+	callExportSegment     func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error)
+	callListDataNodes     func(ctx context.Context) ([]UniqueID, error)
+	callRemoveFiles       func(ctx context.Context, files []string) error
+}
+
+// exportResults receives the segment results of a running export task.
+type exportResults struct {
+	ch chan *rootcoordpb.ExportSegmentResult
+	// reported are the segments whose results are accepted, each segment is reported once
+	reported map[UniqueID]struct{}
+	// rejected are the DataNodes the segments are taken from when they are considered offline, keyed by segment id,
+	// their late results are rejected so that they remove the written files by themselves
+	rejected map[UniqueID][]int64
+}
+
+// newExportManager helper function to create a exportManager
+func newExportManager(ctx context.Context, client kv.TxnKV,
+	idAlloc func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error),
+	flushCollection func(ctx context.Context, collID UniqueID) ([]UniqueID, error),
+	getFlushState func(ctx context.Context, segIDs []UniqueID) (bool, error),
+	getSegmentBinlogs func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error),
+	addSegRefLock func(ctx context.Context, taskID int64, segIDs []UniqueID) error,
+	releaseSegRefLock func(ctx context.Context, taskID int64, segIDs []UniqueID) error,
+	getCollectionSchema func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error),
+	exportSegment func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error),
+	listDataNodes func(ctx context.Context) ([]UniqueID, error),
+	removeFiles func(ctx context.Context, files []string) error) *exportManager {
+	return &exportManager{
+		ctx:                   ctx,
+		taskStore:             client,
+		tasks:                 make(map[int64]*rootcoordpb.ExportTaskInfo),
+		queue:                 make(chan int64, MaxPendingCount),
+		results:               make(map[int64]*exportResults),
+		idAllocator:           idAlloc,
+		callFlushCollection:   flushCollection,
+		callGetFlushState:     getFlushState,
+		callGetSegmentBinlogs: getSegmentBinlogs,
+		callAddSegRefLock:     addSegRefLock,
+		callReleaseSegRefLock: releaseSegRefLock,
+		getCollectionSchema:   getCollectionSchema,
+		callExportSegment:     exportSegment,
+		callListDataNodes:     listDataNodes,
+		callRemoveFiles:       removeFiles,
+	}
+}
+
+func (m *exportManager) init() {
+	m.startOnce.Do(func() {
+		if err := m.loadFromTaskStore(); err != nil {
+			log.Error("exportManager init failed, read tasks from Etcd failed, about to panic")
+			panic(err)
+		}
+	})
+}
+
+// loadFromTaskStore loads the tasks from Etcd, the unfinished tasks are marked failed since the progress
+// in memory is lost.
+func (m *exportManager) loadFromTaskStore() error {
+	_, values, err := m.taskStore.LoadWithPrefix(Params.RootCoordCfg.ExportTaskSubPath.GetValue())
+	if err != nil {
+		log.Error("export manager failed to load from Etcd", zap.Error(err))
+		return err
+	}
+
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+	for _, value := range values {
+		task := &rootcoordpb.ExportTaskInfo{}
+		if err := proto.Unmarshal([]byte(value), task); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", value), zap.Error(err))
+			continue
+		}
+		if !exportTaskFinished(task) {
+			task.State = proxypb.ExportState_ExportFailed
+			task.Reason = "task marked failed as service restarted"
+			if err := m.persistTaskInfo(task); err != nil {
+				log.Error("failed to mark an unfinished export task as failed",
+					zap.Int64("task ID", task.GetId()),
+					zap.Error(err))
+			}
+		}
+		m.tasks[task.GetId()] = task
+	}
+	return nil
+}
+
+// exportJob creates a pending export task from the given task info, the task is executed in background.
+func (m *exportManager) exportJob(info *rootcoordpb.ExportTaskInfo) (int64, error) {
+	if len(m.queue) >= cap(m.queue) {
+		return 0, fmt.Errorf("export task queue max size is %d, currently there are %d pending tasks", cap(m.queue), len(m.queue))
+	}
+
+	taskID, _, err := m.idAllocator(1)
+	if err != nil {
+		return 0, err
+	}
+	task := proto.Clone(info).(*rootcoordpb.ExportTaskInfo)
+	task.Id = taskID
+	task.State = proxypb.ExportState_ExportPending
+	task.CreateTs = time.Now().Unix()
+	if err := m.saveTask(task); err != nil {
+		return 0, err
+	}
+
+	select {
+	case m.queue <- taskID:
+	default:
+		task.State = proxypb.ExportState_ExportFailed
+		task.Reason = "export task queue is full"
+		if err := m.saveTask(task); err != nil {
+			log.Warn("failed to mark the export task as failed", zap.Int64("task ID", taskID), zap.Error(err))
+		}
+		return 0, errors.New(task.Reason)
+	}
+	log.Info("export task created", zap.Int64("task ID", taskID), zap.Int64("collection ID", task.GetCollectionId()),
+		zap.String("path", task.GetPath()), zap.String("format", task.GetFormat()), zap.Uint64("timestamp", task.GetTimestamp()))
+	return taskID, nil
+}
+
+// workLoop executes the pending tasks one by one, there are RootCoordCfg.ExportTaskParallelism work loops.
+func (m *exportManager) workLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("export manager context done, exit workLoop")
+			return
+		case taskID := <-m.queue:
+			m.runTask(taskID)
+		}
+	}
+}
+
+// cleanupLoop removes the finished tasks which are past retention from memory and Etcd.
+func (m *exportManager) cleanupLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(cleanUpLoopInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("export manager context done, exit cleanupLoop")
+			return
+		case <-ticker.C:
+			m.expireOldTasks()
+		}
+	}
+}
+
+func (m *exportManager) expireOldTasks() {
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+	for id, task := range m.tasks {
+		if !exportTaskFinished(task) || !exportTaskPastRetention(task) {
+			continue
+		}
+		if err := m.taskStore.Remove(BuildExportTaskKey(id)); err != nil {
+			log.Warn("failed to remove export task from Etcd", zap.Int64("task ID", id), zap.Error(err))
+			continue
+		}
+		delete(m.tasks, id)
+		log.Info("export task expired", zap.Int64("task ID", id))
+	}
+}
+
+func (m *exportManager) runTask(taskID int64) {
+	task := m.getTask(taskID)
+	if task == nil {
+		log.Warn("export task not found", zap.Int64("task ID", taskID))
+		return
+	}
+
+	task.State = proxypb.ExportState_ExportStarted
+	err := m.saveTask(task)
+	if err == nil {
+		err = m.export(task)
+	}
+	if err != nil {
+		log.Warn("export task failed", zap.Int64("task ID", taskID), zap.Error(err))
+		task.State = proxypb.ExportState_ExportFailed
+		task.Reason = err.Error()
+		m.removeFiles(task)
+	} else {
+		log.Info("export task completed", zap.Int64("task ID", taskID),
+			zap.Int64("row count", task.GetRowCount()), zap.Int("file count", len(task.GetFiles())))
+		task.State = proxypb.ExportState_ExportCompleted
+	}
+	if err := m.saveTask(task); err != nil {
+		log.Error("failed to save the export task state", zap.Int64("task ID", taskID), zap.Error(err))
+	}
+}
+
+// removeFiles removes the files written by the exported segments of a failed task, the files of the segments
+// still running on DataNodes are removed by the DataNodes as the results can't be reported to the failed task.
+func (m *exportManager) removeFiles(task *rootcoordpb.ExportTaskInfo) {
+	if len(task.GetFiles()) == 0 {
+		return
+	}
+	if err := m.callRemoveFiles(m.ctx, task.GetFiles()); err != nil {
+		log.Warn("failed to remove the files of failed export task", zap.Int64("task ID", task.GetId()),
+			zap.Strings("files", task.GetFiles()), zap.Error(err))
+		return
+	}
+	task.Files = nil
+}
+
+// export flushes the collection so that the entities before the snapshot are persisted, then dispatches the
+// flushed segments to DataNodes and collects the written files. The segments are locked to prevent them from
+// being compacted or garbage collected during the export.
+func (m *exportManager) export(task *rootcoordpb.ExportTaskInfo) error {
+	ctx := m.ctx
+	sealed, err := m.callFlushCollection(ctx, task.GetCollectionId())
+	if err != nil {
+		return fmt.Errorf("failed to flush collection: %w", err)
+	}
+	if err := m.waitFlushed(ctx, sealed); err != nil {
+		return err
+	}
+
+	segments, err := m.lockSegments(ctx, task)
+	if err != nil {
+		return err
+	}
+	segIDs := make([]UniqueID, 0, len(segments))
+	for _, segment := range segments {
+		segIDs = append(segIDs, segment.GetSegmentID())
+	}
+	if len(segIDs) > 0 {
+		defer func() {
+			if err := m.callReleaseSegRefLock(ctx, task.GetId(), segIDs); err != nil {
+				log.Warn("failed to release segment lock of export task", zap.Int64("task ID", task.GetId()), zap.Error(err))
+			}
+		}()
+	}
+	// the segments may be compacted before they are locked, the rows deleted after the snapshot are only kept
+	// by the compactions if the snapshot is still within the retention duration
+	if err := checkExportSnapshot(task.GetTimestamp()); err != nil {
+		return err
+	}
+	task.Segments = segIDs
+	if err := m.saveTask(task); err != nil {
+		return err
+	}
+
+	schema, err := m.getCollectionSchema(ctx, task.GetCollectionId())
+	if err != nil {
+		return err
+	}
+	return m.exportSegments(ctx, task, schema, segments)
+}
+
+// listSegments returns the flushed segments of the partitions of the task, sorted by segment id.
+func (m *exportManager) listSegments(ctx context.Context, task *rootcoordpb.ExportTaskInfo) ([]*datapb.SegmentBinlogs, error) {
+	segments := make([]*datapb.SegmentBinlogs, 0)
+	for _, partitionID := range task.GetPartitionIds() {
+		binlogs, err := m.callGetSegmentBinlogs(ctx, task.GetCollectionId(), partitionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get segments of partition %d: %w", partitionID, err)
+		}
+		segments = append(segments, binlogs...)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetSegmentID() < segments[j].GetSegmentID()
+	})
+	return segments, nil
+}
+
+// lockSegments lists and locks the flushed segments of the task. A segment may be compacted and dropped between
+// listing and locking it, so the segments are listed again after locking, the locked segments are returned only
+// if they are still the flushed segments, otherwise the locks are released and retried.
+func (m *exportManager) lockSegments(ctx context.Context, task *rootcoordpb.ExportTaskInfo) ([]*datapb.SegmentBinlogs, error) {
+	for i := 0; i < lockSegmentsRetry; i++ {
+		segments, err := m.listSegments(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+		if len(segments) == 0 {
+			return segments, nil
+		}
+		segIDs := make([]UniqueID, 0, len(segments))
+		for _, segment := range segments {
+			segIDs = append(segIDs, segment.GetSegmentID())
+		}
+		if err := m.callAddSegRefLock(ctx, task.GetId(), segIDs); err != nil {
+			return nil, fmt.Errorf("failed to lock segments: %w", err)
+		}
+
+		// use the binlogs listed after locking, the delta logs may be changed in between
+		locked, err := m.listSegments(ctx, task)
+		if err == nil && sameSegments(segments, locked) {
+			return locked, nil
+		}
+		if releaseErr := m.callReleaseSegRefLock(ctx, task.GetId(), segIDs); releaseErr != nil {
+			log.Warn("failed to release segment lock of export task", zap.Int64("task ID", task.GetId()), zap.Error(releaseErr))
+		}
+		if err != nil {
+			return nil, err
+		}
+		log.Info("segments of export task changed during locking, retry", zap.Int64("task ID", task.GetId()),
+			zap.Int64s("locked segments", segIDs))
+	}
+	return nil, fmt.Errorf("failed to lock segments, the segments keep changing after %d attempts", lockSegmentsRetry)
+}
+
+// checkExportSnapshot checks the snapshot timestamp of an export task is within the retention duration, the
+// compactions keep the deletes within the retention duration in delta logs instead of removing the deleted rows.
+func checkExportSnapshot(ts Timestamp) error {
+	retention := Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second)
+	if tsoutil.PhysicalTime(ts).Before(time.Now().Add(-retention)) {
+		return fmt.Errorf("the snapshot %v of export is older than the retention duration %v, the entities may be compacted",
+			tsoutil.PhysicalTime(ts), retention)
+	}
+	return nil
+}
+
+func sameSegments(a, b []*datapb.SegmentBinlogs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].GetSegmentID() != b[i].GetSegmentID() {
+			return false
+		}
+	}
+	return true
+}
+
+// exportSegments dispatches the segments to idle DataNodes, at most one segment on a DataNode at a time, and
+// collects the results reported by DataNodes. The task fails if any segment fails or times out, the segments
+// running on the DataNodes which go offline are dispatched again.
+func (m *exportManager) exportSegments(ctx context.Context, task *rootcoordpb.ExportTaskInfo,
+	schema *schemapb.CollectionSchema, segments []*datapb.SegmentBinlogs) error {
+	results := m.registerResults(task.GetId(), len(segments))
+	defer m.unregisterResults(task.GetId())
+
+	ticker := time.NewTicker(time.Duration(dispatchSegmentInterval) * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.Duration(exportSegmentTimeout) * time.Millisecond
+
+	pending := segments
+	pendingSince := time.Now()
+	lastCheck := time.Now()
+	running := make(map[UniqueID]int64)       // segment id -> DataNode id
+	deadlines := make(map[UniqueID]time.Time) // segment id -> deadline
+	for len(pending) > 0 || len(running) > 0 {
+		for len(pending) > 0 {
+			segment := pending[0]
+			nodeID, err := m.dispatchSegment(ctx, task, schema, segment, running)
+			if err != nil {
+				return err
+			}
+			if nodeID == 0 {
+				// all DataNodes are busy, wait for a running segment or the next tick
+				if time.Since(pendingSince) > timeout {
+					return fmt.Errorf("failed to dispatch segment %d, no idle DataNode in %v", segment.GetSegmentID(), timeout)
+				}
+				break
+			}
+			running[segment.GetSegmentID()] = nodeID
+			deadlines[segment.GetSegmentID()] = time.Now().Add(timeout)
+			pending = pending[1:]
+			pendingSince = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case result := <-results:
+			nodeID, ok := running[result.GetSegmentId()]
+			if !ok || nodeID != result.GetDatanodeId() {
+				log.Warn("unexpected export segment result", zap.Int64("task ID", task.GetId()),
+					zap.Int64("segment ID", result.GetSegmentId()), zap.Int64("DataNode ID", result.GetDatanodeId()))
+				continue
+			}
+			delete(running, result.GetSegmentId())
+			delete(deadlines, result.GetSegmentId())
+			if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("failed to export segment %d on DataNode %d: %s", result.GetSegmentId(),
+					result.GetDatanodeId(), result.GetStatus().GetReason())
+			}
+			task.Files = append(task.Files, result.GetFiles()...)
+			task.RowCount += result.GetRowCount()
+			task.ExportedSegments = append(task.ExportedSegments, result.GetSegmentId())
+			if err := m.saveTask(task); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			for segID, deadline := range deadlines {
+				if time.Now().After(deadline) {
+					return fmt.Errorf("export segment %d on DataNode %d timeout", segID, running[segID])
+				}
+			}
+			if len(running) == 0 || time.Since(lastCheck) < time.Duration(checkDataNodesInterval)*time.Millisecond {
+				continue
+			}
+			lastCheck = time.Now()
+			lost := m.segmentsOnLostNodes(ctx, running)
+			if len(lost) > 0 && len(pending) == 0 {
+				pendingSince = time.Now()
+			}
+			for _, segment := range segments {
+				if _, ok := lost[segment.GetSegmentID()]; !ok {
+					continue
+				}
+				// the result may be reported right before the DataNode goes offline, it's waiting in the channel
+				if !m.rejectResult(task.GetId(), segment.GetSegmentID(), running[segment.GetSegmentID()]) {
+					continue
+				}
+				log.Warn("DataNode of export segment is offline, dispatch the segment again",
+					zap.Int64("task ID", task.GetId()), zap.Int64("segment ID", segment.GetSegmentID()),
+					zap.Int64("DataNode ID", running[segment.GetSegmentID()]))
+				delete(running, segment.GetSegmentID())
+				delete(deadlines, segment.GetSegmentID())
+				pending = append(pending, segment)
+			}
+		}
+	}
+	return nil
+}
+
+// segmentsOnLostNodes returns the running segments whose DataNodes are offline.
+func (m *exportManager) segmentsOnLostNodes(ctx context.Context, running map[UniqueID]int64) map[UniqueID]struct{} {
+	nodeIDs, err := m.callListDataNodes(ctx)
+	if err != nil {
+		log.Warn("failed to list DataNodes of export segments", zap.Error(err))
+		return nil
+	}
+	alive := make(map[int64]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		alive[nodeID] = struct{}{}
+	}
+	lost := make(map[UniqueID]struct{})
+	for segID, nodeID := range running {
+		if _, ok := alive[nodeID]; !ok {
+			lost[segID] = struct{}{}
+		}
+	}
+	return lost
+}
+
+// dispatchSegment sends the segment to an idle DataNode, the DataNodes running the other segments of the task
+// and the DataNodes the segment is taken from are excluded. The id of the DataNode is returned, 0 if all DataNodes
+// are busy.
+func (m *exportManager) dispatchSegment(ctx context.Context, task *rootcoordpb.ExportTaskInfo,
+	schema *schemapb.CollectionSchema, segment *datapb.SegmentBinlogs, running map[UniqueID]int64) (int64, error) {
+	workingNodes := m.rejectedNodes(task.GetId(), segment.GetSegmentID())
+	for _, nodeID := range running {
+		workingNodes = append(workingNodes, nodeID)
+	}
+	resp, err := m.callExportSegment(ctx, &datapb.ExportSegmentRequest{
+		ExportTask: &datapb.ExportSegmentTask{
+			TaskId:       task.GetId(),
+			CollectionId: task.GetCollectionId(),
+			Schema:       schema,
+			Segment:      segment,
+			Path:         task.GetPath(),
+			Format:       task.GetFormat(),
+			Timestamp:    task.GetTimestamp(),
+			Expr:         task.GetExpr(),
+		},
+		WorkingNodes: workingNodes,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to dispatch segment %d: %w", segment.GetSegmentID(), err)
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Debug("failed to dispatch export segment, retry later", zap.Int64("task ID", task.GetId()),
+			zap.Int64("segment ID", segment.GetSegmentID()), zap.String("reason", resp.GetStatus().GetReason()))
+		return 0, nil
+	}
+	log.Info("export segment dispatched", zap.Int64("task ID", task.GetId()),
+		zap.Int64("segment ID", segment.GetSegmentID()), zap.Int64("DataNode ID", resp.GetDatanodeId()))
+	return resp.GetDatanodeId(), nil
+}
+
+func (m *exportManager) registerResults(taskID int64, size int) <-chan *rootcoordpb.ExportSegmentResult {
+	m.resultsLock.Lock()
+	defer m.resultsLock.Unlock()
+	results := &exportResults{
+		ch:       make(chan *rootcoordpb.ExportSegmentResult, size),
+		reported: make(map[UniqueID]struct{}),
+		rejected: make(map[UniqueID][]int64),
+	}
+	m.results[taskID] = results
+	return results.ch
+}
+
+// unregisterResults stops receiving the results of the task. The results left in the channel are never added to
+// the task, their files are removed.
+func (m *exportManager) unregisterResults(taskID int64) {
+	m.resultsLock.Lock()
+	results, ok := m.results[taskID]
+	delete(m.results, taskID)
+	m.resultsLock.Unlock()
+	if !ok {
+		return
+	}
+	for {
+		select {
+		case result := <-results.ch:
+			if len(result.GetFiles()) == 0 {
+				continue
+			}
+			if err := m.callRemoveFiles(m.ctx, result.GetFiles()); err != nil {
+				log.Warn("failed to remove the files of unused export segment result", zap.Int64("task ID", taskID),
+					zap.Strings("files", result.GetFiles()), zap.Error(err))
+			}
+		default:
+			return
+		}
+	}
+}
+
+// rejectResult rejects the result of the segment from the DataNode, it returns false if the result is already
+// reported.
+func (m *exportManager) rejectResult(taskID int64, segID UniqueID, nodeID int64) bool {
+	m.resultsLock.Lock()
+	defer m.resultsLock.Unlock()
+	results, ok := m.results[taskID]
+	if !ok {
+		return false
+	}
+	if _, ok := results.reported[segID]; ok {
+		return false
+	}
+	results.rejected[segID] = append(results.rejected[segID], nodeID)
+	return true
+}
+
+// rejectedNodes returns the DataNodes whose results of the segment are rejected.
+func (m *exportManager) rejectedNodes(taskID int64, segID UniqueID) []int64 {
+	m.resultsLock.Lock()
+	defer m.resultsLock.Unlock()
+	results, ok := m.results[taskID]
+	if !ok {
+		return nil
+	}
+	return append([]int64{}, results.rejected[segID]...)
+}
+
+// reportSegmentResult passes the result reported by a DataNode to the running task. The result is rejected if
+// the segment is already reported or taken from the DataNode, the DataNode removes the written files then.
+func (m *exportManager) reportSegmentResult(result *rootcoordpb.ExportSegmentResult) error {
+	m.resultsLock.Lock()
+	defer m.resultsLock.Unlock()
+	results, ok := m.results[result.GetTaskId()]
+	if !ok {
+		return fmt.Errorf("export task %d is not running", result.GetTaskId())
+	}
+	if _, ok := results.reported[result.GetSegmentId()]; ok {
+		return fmt.Errorf("segment %d of export task %d is already reported", result.GetSegmentId(), result.GetTaskId())
+	}
+	for _, nodeID := range results.rejected[result.GetSegmentId()] {
+		if nodeID == result.GetDatanodeId() {
+			return fmt.Errorf("segment %d of export task %d is dispatched to another DataNode",
+				result.GetSegmentId(), result.GetTaskId())
+		}
+	}
+	select {
+	case results.ch <- result:
+		results.reported[result.GetSegmentId()] = struct{}{}
+		return nil
+	default:
+		return fmt.Errorf("too many results reported for export task %d", result.GetTaskId())
+	}
+}
+
+// waitFlushed waits until the sealed segments are flushed.
+func (m *exportManager) waitFlushed(ctx context.Context, segIDs []UniqueID) error {
+	if len(segIDs) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(waitFlushTimeout)*time.Millisecond)
+	defer cancel()
+	ticker := time.NewTicker(time.Duration(checkFlushStateInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		flushed, err := m.callGetFlushState(ctx, segIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get flush state: %w", err)
+		}
+		if flushed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for segments to be flushed: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// getTask returns a copy of the task, nil if not found.
+func (m *exportManager) getTask(taskID int64) *rootcoordpb.ExportTaskInfo {
+	m.tasksLock.RLock()
+	defer m.tasksLock.RUnlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(task).(*rootcoordpb.ExportTaskInfo)
+}
+
+// saveTask persists the task in Etcd and replaces the task in memory with a copy.
+func (m *exportManager) saveTask(task *rootcoordpb.ExportTaskInfo) error {
+	m.tasksLock.Lock()
+	defer m.tasksLock.Unlock()
+	if err := m.persistTaskInfo(task); err != nil {
+		return err
+	}
+	m.tasks[task.GetId()] = proto.Clone(task).(*rootcoordpb.ExportTaskInfo)
+	return nil
+}
+
+// persistTaskInfo stores or updates the export task info in Etcd.
+func (m *exportManager) persistTaskInfo(task *rootcoordpb.ExportTaskInfo) error {
+	value, err := proto.Marshal(task)
+	if err != nil {
+		log.Error("failed to marshall export task info proto", zap.Int64("task ID", task.GetId()), zap.Error(err))
+		return err
+	}
+	if err := m.taskStore.Save(BuildExportTaskKey(task.GetId()), string(value)); err != nil {
+		log.Error("failed to update export task info in Etcd", zap.Int64("task ID", task.GetId()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (m *exportManager) getTaskState(taskID int64) *proxypb.GetExportStateResponse {
+	task := m.getTask(taskID)
+	if task == nil {
+		return &proxypb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "export task id doesn't exist"),
+		}
+	}
+	return &proxypb.GetExportStateResponse{
+		Status:           succStatus(),
+		State:            task.GetState(),
+		TaskId:           task.GetId(),
+		CollectionName:   task.GetCollectionName(),
+		Timestamp:        task.GetTimestamp(),
+		RowCount:         task.GetRowCount(),
+		Files:            task.GetFiles(),
+		ExportedSegments: int64(len(task.GetExportedSegments())),
+		TotalSegments:    int64(len(task.GetSegments())),
+		CreateTs:         task.GetCreateTs(),
+		Reason:           task.GetReason(),
+	}
+}
+
+// BuildExportTaskKey constructs and returns an Etcd key with given task ID.
+func BuildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.ExportTaskSubPath.GetValue(), delimiter, taskID)
+}
+
+func exportTaskFinished(task *rootcoordpb.ExportTaskInfo) bool {
+	return task.GetState() == proxypb.ExportState_ExportCompleted || task.GetState() == proxypb.ExportState_ExportFailed
+}
+
+// exportTaskPastRetention returns true if the task is considered expired in Etcd.
+func exportTaskPastRetention(task *rootcoordpb.ExportTaskInfo) bool {
+	return Params.RootCoordCfg.ExportTaskRetention.GetAsFloat() <= float64(time.Now().Unix()-task.GetCreateTs())
+}
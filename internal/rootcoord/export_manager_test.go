@@ -0,0 +1,563 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockExportDataNodes simulates the DataNodes of export, a dispatched segment is reported by a goroutine.
+type mockExportDataNodes struct {
+	mgr     *exportManager
+	nodeIDs []int64
+	// result returns the result of a segment, a nil result is never reported
+	result func(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult
+
+	lock     sync.Mutex
+	requests []*datapb.ExportSegmentRequest
+	removed  []string
+}
+
+func (n *mockExportDataNodes) exportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.requests = append(n.requests, req)
+	for _, nodeID := range n.nodeIDs {
+		if funcutil.SliceContain(req.GetWorkingNodes(), nodeID) {
+			continue
+		}
+		result := n.result(req)
+		if result != nil {
+			result.TaskId = req.GetExportTask().GetTaskId()
+			result.SegmentId = req.GetExportTask().GetSegment().GetSegmentID()
+			result.DatanodeId = nodeID
+			go func() {
+				_ = n.mgr.reportSegmentResult(result)
+			}()
+		}
+		return &datapb.ExportSegmentResponse{Status: succStatus(), DatanodeId: nodeID}, nil
+	}
+	return &datapb.ExportSegmentResponse{
+		Status: failStatus(commonpb.ErrorCode_UnexpectedError, "all DataNodes are busy working on data import, the task has been rejected and wait for idle datanode"),
+	}, nil
+}
+
+func (n *mockExportDataNodes) listDataNodes(ctx context.Context) ([]UniqueID, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.nodeIDs, nil
+}
+
+func (n *mockExportDataNodes) removeFiles(ctx context.Context, files []string) error {
+	n.removed = append(n.removed, files...)
+	return nil
+}
+
+func succeededExportResult(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult {
+	segID := req.GetExportTask().GetSegment().GetSegmentID()
+	return &rootcoordpb.ExportSegmentResult{
+		Status:   succStatus(),
+		Files:    []string{path.Join(req.GetExportTask().GetPath(), strconv.FormatInt(segID, 10)+".json")},
+		RowCount: segID,
+	}
+}
+
+func newTestExportManager(ctx context.Context, segments []*datapb.SegmentBinlogs, nodeIDs ...int64) (*exportManager, *mockExportDataNodes) {
+	var countLock sync.Mutex
+	var globalCount = typeutil.UniqueID(0)
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	nodes := &mockExportDataNodes{nodeIDs: nodeIDs, result: succeededExportResult}
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	mgr := newExportManager(ctx, memkv.NewMemoryKV(), idAlloc,
+		func(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+			return []UniqueID{1}, nil
+		},
+		func(ctx context.Context, segIDs []UniqueID) (bool, error) {
+			return true, nil
+		},
+		func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error) {
+			return segments, nil
+		},
+		func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			return nil
+		},
+		func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			return nil
+		},
+		func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error) {
+			return schema, nil
+		},
+		nodes.exportSegment,
+		nodes.listDataNodes,
+		nodes.removeFiles)
+	nodes.mgr = mgr
+	return mgr, nodes
+}
+
+var exportTestTask = &rootcoordpb.ExportTaskInfo{
+	CollectionId:   1,
+	CollectionName: "export",
+	PartitionIds:   []int64{2},
+	Path:           "export",
+	Format:         storage.ExportFormatJSON,
+	Timestamp:      tsoutil.ComposeTSByTime(time.Now(), 0),
+	Expr:           "pk > 0",
+}
+
+func TestExportManager_Export(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	segments := []*datapb.SegmentBinlogs{{SegmentID: 12}, {SegmentID: 11}, {SegmentID: 13}}
+	mgr, nodes := newTestExportManager(ctx, segments, 1, 2)
+	mgr.init()
+	taskID, err := mgr.exportJob(exportTestTask)
+	assert.NoError(t, err)
+	assert.Equal(t, proxypb.ExportState_ExportPending, mgr.getTaskState(taskID).GetState())
+
+	mgr.runTask(<-mgr.queue)
+	resp := mgr.getTaskState(taskID)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, proxypb.ExportState_ExportCompleted, resp.GetState())
+	assert.Equal(t, int64(11+12+13), resp.GetRowCount())
+	assert.Equal(t, int64(3), resp.GetTotalSegments())
+	assert.Equal(t, int64(3), resp.GetExportedSegments())
+	assert.ElementsMatch(t, []string{"export/11.json", "export/12.json", "export/13.json"}, resp.GetFiles())
+
+	// the segments are dispatched in order with the task parameters
+	require.GreaterOrEqual(t, len(nodes.requests), 3)
+	first := nodes.requests[0].GetExportTask()
+	assert.Equal(t, int64(11), first.GetSegment().GetSegmentID())
+	assert.Equal(t, taskID, first.GetTaskId())
+	assert.Equal(t, "export", first.GetPath())
+	assert.Equal(t, storage.ExportFormatJSON, first.GetFormat())
+	assert.Equal(t, exportTestTask.GetTimestamp(), first.GetTimestamp())
+	assert.Equal(t, "pk > 0", first.GetExpr())
+	assert.Equal(t, "export", first.GetSchema().GetName())
+	assert.Empty(t, nodes.requests[0].GetWorkingNodes())
+
+	// the task info is persisted
+	value, err := mgr.taskStore.Load(BuildExportTaskKey(taskID))
+	assert.NoError(t, err)
+	task := &rootcoordpb.ExportTaskInfo{}
+	assert.NoError(t, proto.Unmarshal([]byte(value), task))
+	assert.Equal(t, proxypb.ExportState_ExportCompleted, task.GetState())
+	assert.ElementsMatch(t, []int64{11, 12, 13}, task.GetExportedSegments())
+
+	resp = mgr.getTaskState(taskID + 100)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	// the results are rejected after the task finished
+	assert.Error(t, mgr.reportSegmentResult(&rootcoordpb.ExportSegmentResult{TaskId: taskID, SegmentId: 11}))
+}
+
+func TestExportManager_ExportBusyDataNodes(t *testing.T) {
+	defer func(interval int) {
+		dispatchSegmentInterval = interval
+	}(dispatchSegmentInterval)
+	dispatchSegmentInterval = 10
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	segments := []*datapb.SegmentBinlogs{{SegmentID: 11}, {SegmentID: 12}}
+	mgr, nodes := newTestExportManager(ctx, segments, 1)
+	// the only DataNode is busy with other tasks for a while
+	busy := 3
+	exportSegment := mgr.callExportSegment
+	mgr.callExportSegment = func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+		if busy > 0 {
+			busy--
+			return &datapb.ExportSegmentResponse{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "busy")}, nil
+		}
+		return exportSegment(ctx, req)
+	}
+
+	taskID, err := mgr.exportJob(exportTestTask)
+	require.NoError(t, err)
+	mgr.runTask(<-mgr.queue)
+	resp := mgr.getTaskState(taskID)
+	assert.Equal(t, proxypb.ExportState_ExportCompleted, resp.GetState())
+	assert.Equal(t, int64(2), resp.GetExportedSegments())
+	// the second segment is not sent to the DataNode running the first one
+	for _, req := range nodes.requests {
+		if req.GetExportTask().GetSegment().GetSegmentID() == 12 && len(req.GetWorkingNodes()) > 0 {
+			assert.Equal(t, []int64{1}, req.GetWorkingNodes())
+		}
+	}
+}
+
+func TestExportManager_ExportLostDataNode(t *testing.T) {
+	defer func(dispatch, check int) {
+		dispatchSegmentInterval, checkDataNodesInterval = dispatch, check
+	}(dispatchSegmentInterval, checkDataNodesInterval)
+	dispatchSegmentInterval, checkDataNodesInterval = 10, 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr, nodes := newTestExportManager(ctx, []*datapb.SegmentBinlogs{{SegmentID: 11}}, 1)
+	// the DataNode 1 goes offline after the segment is dispatched to it, the DataNode 2 comes online
+	var lateErr error
+	nodes.result = func(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult {
+		if len(nodes.requests) == 1 {
+			nodes.nodeIDs = []int64{2}
+			return nil
+		}
+		// the DataNode 1 was not offline actually, it reports the result after the segment is taken from it
+		lateErr = mgr.reportSegmentResult(&rootcoordpb.ExportSegmentResult{
+			Status:     succStatus(),
+			TaskId:     req.GetExportTask().GetTaskId(),
+			SegmentId:  11,
+			DatanodeId: 1,
+			Files:      []string{"export/11_1.json"},
+		})
+		return succeededExportResult(req)
+	}
+
+	taskID, err := mgr.exportJob(exportTestTask)
+	require.NoError(t, err)
+	mgr.runTask(<-mgr.queue)
+	resp := mgr.getTaskState(taskID)
+	assert.Equal(t, proxypb.ExportState_ExportCompleted, resp.GetState())
+	assert.Equal(t, []string{"export/11.json"}, resp.GetFiles())
+	require.Equal(t, 2, len(nodes.requests))
+	// the segment is not dispatched to the DataNode it's taken from
+	assert.Equal(t, []int64{1}, nodes.requests[1].GetWorkingNodes())
+	// the late result is rejected so that the DataNode 1 removes its files
+	assert.Error(t, lateErr)
+}
+
+func TestExportManager_ReportSegmentResult(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr, nodes := newTestExportManager(ctx, nil, 1)
+	result := func(segID, nodeID int64) *rootcoordpb.ExportSegmentResult {
+		return &rootcoordpb.ExportSegmentResult{
+			Status:     succStatus(),
+			TaskId:     1,
+			SegmentId:  segID,
+			DatanodeId: nodeID,
+			Files:      []string{fmt.Sprintf("export/%d_%d.json", segID, nodeID)},
+		}
+	}
+
+	assert.Error(t, mgr.reportSegmentResult(result(11, 1)))
+
+	results := mgr.registerResults(1, 2)
+	assert.NoError(t, mgr.reportSegmentResult(result(11, 1)))
+	// a segment is reported once
+	assert.Error(t, mgr.reportSegmentResult(result(11, 2)))
+	assert.False(t, mgr.rejectResult(1, 11, 1))
+
+	// the results of the DataNode the segment is taken from are rejected
+	assert.True(t, mgr.rejectResult(1, 12, 1))
+	assert.Equal(t, []int64{1}, mgr.rejectedNodes(1, 12))
+	assert.Error(t, mgr.reportSegmentResult(result(12, 1)))
+	assert.NoError(t, mgr.reportSegmentResult(result(12, 2)))
+
+	// the results never received by the task are removed
+	assert.Equal(t, int64(11), (<-results).GetSegmentId())
+	mgr.unregisterResults(1)
+	assert.Equal(t, []string{"export/12_2.json"}, nodes.removed)
+	assert.Error(t, mgr.reportSegmentResult(result(13, 1)))
+}
+
+func TestExportManager_LockSegments(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("segments compacted before locking", func(t *testing.T) {
+		mgr, nodes := newTestExportManager(ctx, nil, 1)
+		// segment 11 and 12 are compacted into 13 after the first listing
+		listed := 0
+		mgr.callGetSegmentBinlogs = func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error) {
+			listed++
+			if listed == 1 {
+				return []*datapb.SegmentBinlogs{{SegmentID: 11}, {SegmentID: 12}}, nil
+			}
+			return []*datapb.SegmentBinlogs{{SegmentID: 13}}, nil
+		}
+		var locked, released [][]UniqueID
+		mgr.callAddSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			locked = append(locked, segIDs)
+			return nil
+		}
+		mgr.callReleaseSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			released = append(released, segIDs)
+			return nil
+		}
+
+		taskID, err := mgr.exportJob(exportTestTask)
+		require.NoError(t, err)
+		mgr.runTask(<-mgr.queue)
+		resp := mgr.getTaskState(taskID)
+		assert.Equal(t, proxypb.ExportState_ExportCompleted, resp.GetState())
+		assert.Equal(t, [][]UniqueID{{11, 12}, {13}}, locked)
+		assert.Equal(t, [][]UniqueID{{11, 12}, {13}}, released)
+		assert.Equal(t, []int64{13}, mgr.getTask(taskID).GetSegments())
+		require.Equal(t, 1, len(nodes.requests))
+		assert.Equal(t, int64(13), nodes.requests[0].GetExportTask().GetSegment().GetSegmentID())
+	})
+
+	t.Run("segments keep changing", func(t *testing.T) {
+		mgr, _ := newTestExportManager(ctx, nil, 1)
+		listed := int64(0)
+		mgr.callGetSegmentBinlogs = func(ctx context.Context, collID, partitionID UniqueID) ([]*datapb.SegmentBinlogs, error) {
+			listed++
+			return []*datapb.SegmentBinlogs{{SegmentID: listed}}, nil
+		}
+		released := 0
+		mgr.callReleaseSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			released++
+			return nil
+		}
+		taskID, err := mgr.exportJob(exportTestTask)
+		require.NoError(t, err)
+		mgr.runTask(<-mgr.queue)
+		resp := mgr.getTaskState(taskID)
+		assert.Equal(t, proxypb.ExportState_ExportFailed, resp.GetState())
+		assert.Contains(t, resp.GetReason(), "segments keep changing")
+		assert.Equal(t, lockSegmentsRetry, released)
+	})
+
+	t.Run("snapshot out of retention", func(t *testing.T) {
+		mgr, nodes := newTestExportManager(ctx, []*datapb.SegmentBinlogs{{SegmentID: 11}}, 1)
+		var released [][]UniqueID
+		mgr.callReleaseSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			released = append(released, segIDs)
+			return nil
+		}
+		task := proto.Clone(exportTestTask).(*rootcoordpb.ExportTaskInfo)
+		retention := Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second)
+		task.Timestamp = tsoutil.ComposeTSByTime(time.Now().Add(-retention-time.Minute), 0)
+		taskID, err := mgr.exportJob(task)
+		require.NoError(t, err)
+		mgr.runTask(<-mgr.queue)
+		resp := mgr.getTaskState(taskID)
+		assert.Equal(t, proxypb.ExportState_ExportFailed, resp.GetState())
+		assert.Contains(t, resp.GetReason(), "older than the retention duration")
+		assert.Equal(t, [][]UniqueID{{11}}, released)
+		assert.Empty(t, nodes.requests)
+	})
+}
+
+func TestExportManager_ExportFailed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	segments := []*datapb.SegmentBinlogs{{SegmentID: 11}}
+
+	runFailed := func(t *testing.T, mgr *exportManager) string {
+		taskID, err := mgr.exportJob(exportTestTask)
+		require.NoError(t, err)
+		mgr.runTask(<-mgr.queue)
+		resp := mgr.getTaskState(taskID)
+		assert.Equal(t, proxypb.ExportState_ExportFailed, resp.GetState())
+		return resp.GetReason()
+	}
+
+	t.Run("flush failed", func(t *testing.T) {
+		mgr, _ := newTestExportManager(ctx, segments, 1)
+		mgr.callFlushCollection = func(ctx context.Context, collID UniqueID) ([]UniqueID, error) {
+			return nil, errors.New("mock error")
+		}
+		assert.Contains(t, runFailed(t, mgr), "failed to flush collection")
+	})
+
+	t.Run("wait flush timeout", func(t *testing.T) {
+		defer func(interval, timeout int) {
+			checkFlushStateInterval, waitFlushTimeout = interval, timeout
+		}(checkFlushStateInterval, waitFlushTimeout)
+		checkFlushStateInterval, waitFlushTimeout = 10, 50
+		mgr, _ := newTestExportManager(ctx, segments, 1)
+		mgr.callGetFlushState = func(ctx context.Context, segIDs []UniqueID) (bool, error) {
+			return false, nil
+		}
+		assert.Contains(t, runFailed(t, mgr), "failed to wait for segments to be flushed")
+	})
+
+	t.Run("lock segments failed", func(t *testing.T) {
+		mgr, _ := newTestExportManager(ctx, segments, 1)
+		mgr.callAddSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			return errors.New("mock error")
+		}
+		assert.Contains(t, runFailed(t, mgr), "failed to lock segments")
+	})
+
+	t.Run("dispatch failed", func(t *testing.T) {
+		mgr, _ := newTestExportManager(ctx, segments, 1)
+		mgr.callExportSegment = func(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error) {
+			return nil, errors.New("mock error")
+		}
+		assert.Contains(t, runFailed(t, mgr), "failed to dispatch segment 11")
+	})
+
+	t.Run("export segment failed", func(t *testing.T) {
+		released := false
+		mgr, nodes := newTestExportManager(ctx, segments, 1)
+		nodes.result = func(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult {
+			return &rootcoordpb.ExportSegmentResult{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock error")}
+		}
+		mgr.callReleaseSegRefLock = func(ctx context.Context, taskID int64, segIDs []UniqueID) error {
+			released = true
+			return nil
+		}
+		reason := runFailed(t, mgr)
+		assert.Contains(t, reason, "failed to export segment 11 on DataNode 1")
+		assert.Contains(t, reason, "mock error")
+		assert.True(t, released)
+	})
+
+	t.Run("files removed", func(t *testing.T) {
+		mgr, nodes := newTestExportManager(ctx, []*datapb.SegmentBinlogs{{SegmentID: 11}, {SegmentID: 12}}, 1)
+		nodes.result = func(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult {
+			if req.GetExportTask().GetSegment().GetSegmentID() == 12 {
+				return &rootcoordpb.ExportSegmentResult{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock error")}
+			}
+			return succeededExportResult(req)
+		}
+		assert.Contains(t, runFailed(t, mgr), "failed to export segment 12 on DataNode 1")
+		assert.Equal(t, []string{"export/11.json"}, nodes.removed)
+		assert.Empty(t, mgr.getTask(1).GetFiles())
+	})
+
+	t.Run("export segment timeout", func(t *testing.T) {
+		defer func(interval, timeout int) {
+			dispatchSegmentInterval, exportSegmentTimeout = interval, timeout
+		}(dispatchSegmentInterval, exportSegmentTimeout)
+		dispatchSegmentInterval, exportSegmentTimeout = 10, 50
+		mgr, nodes := newTestExportManager(ctx, segments, 1)
+		nodes.result = func(req *datapb.ExportSegmentRequest) *rootcoordpb.ExportSegmentResult {
+			return nil
+		}
+		assert.Contains(t, runFailed(t, mgr), "export segment 11 on DataNode 1 timeout")
+	})
+
+	t.Run("no idle DataNode", func(t *testing.T) {
+		defer func(interval, timeout int) {
+			dispatchSegmentInterval, exportSegmentTimeout = interval, timeout
+		}(dispatchSegmentInterval, exportSegmentTimeout)
+		dispatchSegmentInterval, exportSegmentTimeout = 10, 50
+		mgr, _ := newTestExportManager(ctx, segments)
+		assert.Contains(t, runFailed(t, mgr), "failed to dispatch segment 11, no idle DataNode")
+	})
+
+	t.Run("id allocation failed", func(t *testing.T) {
+		mgr, _ := newTestExportManager(ctx, segments, 1)
+		mgr.idAllocator = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+			return 0, 0, errors.New("mock error")
+		}
+		_, err := mgr.exportJob(exportTestTask)
+		assert.Error(t, err)
+	})
+}
+
+func TestExportManager_ReportSegmentResult(t *testing.T) {
+	mgr, _ := newTestExportManager(context.Background(), nil)
+	result := &rootcoordpb.ExportSegmentResult{TaskId: 1, SegmentId: 11, DatanodeId: 1}
+	assert.Error(t, mgr.reportSegmentResult(result))
+
+	results := mgr.registerResults(1, 1)
+	assert.NoError(t, mgr.reportSegmentResult(result))
+	assert.Equal(t, result, <-results)
+	assert.NoError(t, mgr.reportSegmentResult(result))
+	// the result channel is full
+	assert.Error(t, mgr.reportSegmentResult(result))
+	mgr.unregisterResults(1)
+	assert.Error(t, mgr.reportSegmentResult(result))
+}
+
+func TestExportManager_LoadAndExpire(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	paramtable.Get().Save(Params.RootCoordCfg.ExportTaskRetention.Key, "200") // unit: second
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ExportTaskRetention.Key)
+
+	mgr, _ := newTestExportManager(ctx, nil)
+	tasks := []*rootcoordpb.ExportTaskInfo{
+		{Id: 1, State: proxypb.ExportState_ExportStarted, CreateTs: time.Now().Unix()},
+		{Id: 2, State: proxypb.ExportState_ExportCompleted, CreateTs: time.Now().Unix() - 300},
+		{Id: 3, State: proxypb.ExportState_ExportCompleted, CreateTs: time.Now().Unix()},
+	}
+	for _, task := range tasks {
+		value, err := proto.Marshal(task)
+		require.NoError(t, err)
+		require.NoError(t, mgr.taskStore.Save(BuildExportTaskKey(task.GetId()), string(value)))
+	}
+	require.NoError(t, mgr.taskStore.Save(BuildExportTaskKey(4), "invalid"))
+
+	mgr.init()
+	assert.Equal(t, 3, len(mgr.tasks))
+	// the started task is marked failed since the progress is lost
+	resp := mgr.getTaskState(1)
+	assert.Equal(t, proxypb.ExportState_ExportFailed, resp.GetState())
+	assert.Equal(t, "task marked failed as service restarted", resp.GetReason())
+
+	mgr.expireOldTasks()
+	assert.Nil(t, mgr.getTask(2))
+	assert.NotNil(t, mgr.getTask(1))
+	assert.NotNil(t, mgr.getTask(3))
+	_, err := mgr.taskStore.Load(BuildExportTaskKey(2))
+	assert.Error(t, err)
+}
+
+func TestExportManager_Loops(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mgr, _ := newTestExportManager(ctx, []*datapb.SegmentBinlogs{{SegmentID: 11}}, 1)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go mgr.workLoop(&wg)
+	go mgr.cleanupLoop(&wg)
+	taskID, err := mgr.exportJob(exportTestTask)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return mgr.getTaskState(taskID).GetState() == proxypb.ExportState_ExportCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(11), mgr.getTaskState(taskID).GetRowCount())
+	cancel()
+	wg.Wait()
+}
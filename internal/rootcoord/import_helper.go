@@ -18,6 +18,7 @@ type MarkSegmentsDroppedFunc func(ctx context.Context, segIDs []int64) (*commonp
 type DescribeIndexFunc func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 type GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 type UnsetIsImportingStateFunc func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+type ListDataNodesFunc func(ctx context.Context) ([]UniqueID, error)
 
 type ImportFactory interface {
 	NewGetCollectionNameFunc() GetCollectionNameFunc
@@ -27,6 +28,7 @@ type ImportFactory interface {
 	NewDescribeIndexFunc() DescribeIndexFunc
 	NewGetSegmentIndexStateFunc() GetSegmentIndexStateFunc
 	NewUnsetIsImportingStateFunc() UnsetIsImportingStateFunc
+	NewListDataNodesFunc() ListDataNodesFunc
 }
 
 type ImportFactoryImpl struct {
@@ -61,6 +63,10 @@ func (f ImportFactoryImpl) NewUnsetIsImportingStateFunc() UnsetIsImportingStateF
 	return UnsetIsImportingStateWithCore(f.c)
 }
 
+func (f ImportFactoryImpl) NewListDataNodesFunc() ListDataNodesFunc {
+	return ListDataNodesWithCore(f.c)
+}
+
 func NewImportFactory(c *Core) ImportFactory {
 	return &ImportFactoryImpl{c: c}
 }
@@ -119,3 +125,18 @@ func UnsetIsImportingStateWithCore(c *Core) UnsetIsImportingStateFunc {
 		return c.broker.UnsetIsImportingState(ctx, req)
 	}
 }
+
+func ListDataNodesWithCore(c *Core) ListDataNodesFunc {
+	return func(ctx context.Context) ([]UniqueID, error) {
+		sessions, _, err := c.session.GetSessions(typeutil.DataNodeRole)
+		if err != nil {
+			log.Error("Core failed to get DataNode sessions", zap.Error(err))
+			return nil, err
+		}
+		nodeIDs := make([]UniqueID, 0, len(sessions))
+		for _, session := range sessions {
+			nodeIDs = append(nodeIDs, session.ServerID)
+		}
+		return nodeIDs, nil
+	}
+}
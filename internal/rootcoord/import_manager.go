@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"go.uber.org/zap"
@@ -59,6 +60,15 @@ var cleanUpLoopInterval = 5 * 60 * 1000
 // default 15 * 1000 milliseconds (15 seconds)
 var flipTaskStateInterval = 15 * 1000
 
+// checkDataNodesInterval is the default interval to check if the DataNodes of working tasks are still alive, the
+// tasks of lost DataNodes are resumed from their checkpoints on other DataNodes.
+// default 60*1000 milliseconds (1 minute)
+var checkDataNodesInterval = 60 * 1000
+
+// markSegmentsDroppedAttempts is the maximum # of attempts to mark the segments after the checkpoint of a resumed
+// task as `dropped`.
+var markSegmentsDroppedAttempts uint = 3
+
 // importManager manager for import tasks
 type importManager struct {
 	ctx       context.Context // reserved
@@ -82,6 +92,7 @@ type importManager struct {
 	callDescribeIndex         func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 	callGetSegmentIndexState  func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 	callUnsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	callListDataNodes         func(ctx context.Context) ([]UniqueID, error)
 }
 
 // newImportManager helper function to create a importManager
@@ -92,7 +103,8 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 	getCollectionName func(collID, partitionID typeutil.UniqueID) (string, string, error),
 	describeIndex func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error),
 	getSegmentIndexState func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error),
-	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)) *importManager {
+	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error),
+	listDataNodes func(ctx context.Context) ([]UniqueID, error)) *importManager {
 	mgr := &importManager{
 		ctx:                       ctx,
 		taskStore:                 client,
@@ -110,6 +122,7 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 		callDescribeIndex:         describeIndex,
 		callGetSegmentIndexState:  getSegmentIndexState,
 		callUnsetIsImportingState: unsetIsImportingState,
+		callListDataNodes:         listDataNodes,
 	}
 	return mgr
 }
@@ -165,6 +178,22 @@ func (m *importManager) flipTaskStateLoop(wg *sync.WaitGroup) {
 	}
 }
 
+// resumeTasksLoop periodically calls `resumeTasksOfLostDataNodes` to reassign the tasks of lost DataNodes.
+func (m *importManager) resumeTasksLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(checkDataNodesInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("import manager context done, exit check resumeTasksLoop")
+			return
+		case <-ticker.C:
+			m.resumeTasksOfLostDataNodes(m.ctx)
+		}
+	}
+}
+
 // cleanupLoop starts a loop that checks and expires old tasks every `cleanUpLoopInterval` seconds.
 // There are two types of tasks to clean up:
 // (1) pending tasks or working tasks that existed for over `ImportTaskExpiration` seconds, these tasks will be
@@ -590,6 +619,14 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 				log.Warn("trying to update an already failed task which will end up being a no-op")
 				return nil, errors.New("trying to update an already failed task " + strconv.FormatInt(ir.GetTaskId(), 10))
 			}
+			// The task has been reassigned since the previous DataNode was considered lost, ignore the stale result.
+			if v.GetDatanodeId() != 0 && ir.GetDatanodeId() != 0 && v.GetDatanodeId() != ir.GetDatanodeId() {
+				log.Warn("import result is reported by a DataNode which is not working on the task",
+					zap.Int64("task ID", ir.GetTaskId()),
+					zap.Int64("task DataNode ID", v.GetDatanodeId()),
+					zap.Int64("reported DataNode ID", ir.GetDatanodeId()))
+				return nil, fmt.Errorf("import task %d is not assigned to DataNode %d", ir.GetTaskId(), ir.GetDatanodeId())
+			}
 			found = true
 
 			// Meta persist should be done before memory objs change.
@@ -602,8 +639,12 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 				if kv.GetKey() == importutil.FailedReason {
 					toPersistImportTaskInfo.State.ErrorMessage = kv.GetValue()
 					break
-				} else if kv.GetKey() == importutil.PersistTimeCost {
+				} else if kv.GetKey() == importutil.PersistTimeCost || kv.GetKey() == importutil.FailedRows ||
+					kv.GetKey() == importutil.DryRunReport {
 					toPersistImportTaskInfo.Infos = append(toPersistImportTaskInfo.Infos, kv)
+				} else if kv.GetKey() == importutil.Checkpoint {
+					// only the latest checkpoint is kept
+					toPersistImportTaskInfo.Infos = replaceTaskInfo(toPersistImportTaskInfo.Infos, kv)
 				}
 			}
 			// Update task in task store.
@@ -1027,6 +1068,149 @@ func (m *importManager) listAllTasks(colID int64, limit int64) ([]*milvuspb.GetI
 	return tasks[len(tasks)-int(limit):], nil
 }
 
+// resumeTasksOfLostDataNodes checks the working tasks whose DataNode is no longer alive, these tasks are put back to
+// the pending list so that their remaining files are reassigned to other DataNodes.
+func (m *importManager) resumeTasksOfLostDataNodes(ctx context.Context) {
+	if m.callListDataNodes == nil {
+		log.Error("callListDataNodes function of importManager is nil")
+		return
+	}
+	nodeIDs, err := m.callListDataNodes(ctx)
+	if err != nil {
+		log.Error("failed to list DataNodes", zap.Error(err))
+		return
+	}
+	aliveNodes := make(map[int64]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		aliveNodes[nodeID] = struct{}{}
+	}
+
+	lostTasks := make([]*datapb.ImportTaskInfo, 0)
+	m.workingLock.RLock()
+	for _, t := range m.workingTasks {
+		if t.GetState().GetStateCode() != commonpb.ImportState_ImportStarted {
+			continue
+		}
+		if _, ok := aliveNodes[t.GetDatanodeId()]; !ok {
+			lostTasks = append(lostTasks, t)
+		}
+	}
+	m.workingLock.RUnlock()
+
+	resumed := false
+	for _, t := range lostTasks {
+		if err := m.resumeTask(ctx, t); err != nil {
+			log.Error("failed to resume import task of a lost DataNode",
+				zap.Int64("task ID", t.GetId()),
+				zap.Int64("dataNode ID", t.GetDatanodeId()),
+				zap.Error(err))
+			continue
+		}
+		resumed = true
+	}
+	if resumed {
+		if err := m.sendOutTasks(ctx); err != nil {
+			log.Error("fail to send out resumed tasks", zap.Error(err))
+		}
+	}
+}
+
+// resumeTask moves a working task of a lost DataNode back to the pending list. The segments persisted before the
+// last checkpoint are kept, the other segments of the task are marked as `dropped` since their rows will be imported
+// again. A task without checkpoint is imported from the beginning.
+// The task is taken from the lost DataNode and persisted as pending before any segment is dropped, so that a result
+// reported by the old DataNode in the meantime is rejected and never refers to a dropped segment.
+func (m *importManager) resumeTask(ctx context.Context, t *datapb.ImportTaskInfo) error {
+	// Meta persist should be done before memory objs change.
+	m.workingLock.Lock()
+	if v, ok := m.workingTasks[t.GetId()]; !ok || v != t {
+		// The task has been updated in the meantime, check it again in the next round.
+		m.workingLock.Unlock()
+		return nil
+	}
+	checkpoint, err := importutil.ParseCheckpoint(t.GetInfos())
+	if err != nil {
+		m.workingLock.Unlock()
+		return err
+	}
+	toPersistImportTaskInfo := cloneImportTaskInfo(t)
+	toPersistImportTaskInfo.DatanodeId = 0
+	toPersistImportTaskInfo.State = &datapb.ImportTaskState{
+		StateCode:    commonpb.ImportState_ImportPending,
+		Segments:     make([]int64, 0),
+		ErrorMessage: t.GetState().GetErrorMessage(),
+	}
+	if checkpoint != nil {
+		toPersistImportTaskInfo.State.Segments = checkpoint.Segments
+		toPersistImportTaskInfo.State.RowCount = checkpoint.RowCount
+		toPersistImportTaskInfo.State.RowIds = checkpoint.AutoIds
+	}
+	if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+		m.workingLock.Unlock()
+		return err
+	}
+	delete(m.workingTasks, t.GetId())
+	m.workingLock.Unlock()
+
+	m.busyNodesLock.Lock()
+	delete(m.busyNodes, t.GetDatanodeId())
+	m.busyNodesLock.Unlock()
+
+	keptSegments := make(map[int64]struct{})
+	for _, segmentID := range toPersistImportTaskInfo.GetState().GetSegments() {
+		keptSegments[segmentID] = struct{}{}
+	}
+	droppedSegments := make([]int64, 0)
+	for _, segmentID := range t.GetState().GetSegments() {
+		if _, ok := keptSegments[segmentID]; !ok {
+			droppedSegments = append(droppedSegments, segmentID)
+		}
+	}
+	if len(droppedSegments) > 0 {
+		log.Info("trying to mark segments after the checkpoint as dropped",
+			zap.Int64("task ID", t.GetId()),
+			zap.Int64s("segment IDs", droppedSegments))
+		if err := m.markSegmentsDropped(ctx, droppedSegments); err != nil {
+			// The rows of the segments would be imported twice if the task goes on, fail the task instead, all of
+			// its segments are dropped by removeBadImportSegments.
+			log.Error("failed to mark segments after the checkpoint as dropped, the import task is failed",
+				zap.Int64("task ID", t.GetId()),
+				zap.Error(err))
+			failedTaskInfo := cloneImportTaskInfo(toPersistImportTaskInfo)
+			failedTaskInfo.State.StateCode = commonpb.ImportState_ImportFailed
+			failedTaskInfo.State.Segments = append(failedTaskInfo.State.Segments, droppedSegments...)
+			failedTaskInfo.State.ErrorMessage = "failed to resume the import task of a lost DataNode: " + err.Error()
+			if persistErr := m.persistTaskInfo(failedTaskInfo); persistErr != nil {
+				return persistErr
+			}
+			return err
+		}
+	}
+
+	m.pendingLock.Lock()
+	m.pendingTasks = append(m.pendingTasks, toPersistImportTaskInfo)
+	m.pendingLock.Unlock()
+	log.Info("import task of a lost DataNode has been resumed as a pending task",
+		zap.Int64("task ID", t.GetId()),
+		zap.Int64("dataNode ID", t.GetDatanodeId()),
+		zap.Int64s("kept segment IDs", toPersistImportTaskInfo.GetState().GetSegments()))
+	return nil
+}
+
+// markSegmentsDropped marks the segments as `dropped`, retrying on failures.
+func (m *importManager) markSegmentsDropped(ctx context.Context, segmentIDs []int64) error {
+	return retry.Do(ctx, func() error {
+		status, err := m.callMarkSegmentsDropped(ctx, segmentIDs)
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}, retry.Attempts(markSegmentsDroppedAttempts))
+}
+
 // removeBadImportSegments marks segments of a failed import task as `dropped`.
 func (m *importManager) removeBadImportSegments(ctx context.Context) {
 	var taskList []*datapb.ImportTaskInfo
@@ -1093,6 +1277,17 @@ func tryUpdateErrMsg(errReason string, toPersistImportTaskInfo *datapb.ImportTas
 	}
 }
 
+// replaceTaskInfo returns a copy of the infos where the value of the same key is replaced by the given one.
+func replaceTaskInfo(infos []*commonpb.KeyValuePair, kv *commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	replaced := make([]*commonpb.KeyValuePair, 0, len(infos)+1)
+	for _, info := range infos {
+		if info.GetKey() != kv.GetKey() {
+			replaced = append(replaced, info)
+		}
+	}
+	return append(replaced, kv)
+}
+
 func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo {
 	cloned := &datapb.ImportTaskInfo{
 		Id:             taskInfo.GetId(),
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -103,7 +104,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 
 		// there are 2 tasks read from store, one is pending, the other is persisted.
@@ -135,7 +136,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		var wgLoop sync.WaitGroup
@@ -154,7 +155,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		assert.Panics(t, func() {
 			mgr.init(context.TODO())
@@ -171,7 +172,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 	})
@@ -185,7 +186,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		func() {
@@ -205,7 +206,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(ctx)
 		var wgLoop sync.WaitGroup
@@ -263,7 +264,7 @@ func TestImportManager_TestSetImportTaskState(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		_, err := mgr.loadFromTaskStore(true)
 		assert.NoError(t, err)
@@ -358,7 +359,7 @@ func TestImportManager_TestEtcdCleanUp(t *testing.T) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	assert.NotNil(t, mgr)
 	_, err = mgr.loadFromTaskStore(true)
 	assert.NoError(t, err)
@@ -476,7 +477,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -498,7 +499,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 			}, nil
 		}
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -520,7 +521,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 			}, nil
 		}
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -551,7 +552,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// nil request
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), nil, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
@@ -580,7 +581,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	// row-based case, task count equal to file count
 	// since the importServiceFunc return error, tasks will be kept in pending list
 	rowReq.Files = []string{"f1.json"}
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -593,7 +594,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// column-based case, one quest one task
 	// since the importServiceFunc return error, tasks will be kept in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 1, len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -607,13 +608,13 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// row-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, len(rowReq.Files), len(mgr.workingTasks))
 
 	// column-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -637,7 +638,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// row-based case, since the importServiceFunc return success for 1 task
 	// the first task is sent to working list, and 1 task left in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -713,7 +714,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// each data node owns one task
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	for i := 0; i < len(dnList); i++ {
 		resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
@@ -722,7 +723,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// all data nodes are busy, new task waiting in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
@@ -730,7 +731,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 
 	// now all data nodes are free again, new task is executed instantly
 	count = 0
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -787,7 +788,7 @@ func TestImportManager_TaskState(t *testing.T) {
 	}
 
 	// add 3 tasks, their ID is 10000, 10001, 10002, make sure updateTaskInfo() works correctly
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
 	rowReq.Files = []string{"f2.json"}
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
@@ -810,6 +811,10 @@ func TestImportManager_TaskState(t *testing.T) {
 				Key:   "key1",
 				Value: "value1",
 			},
+			{
+				Key:   importutil.DryRunReport,
+				Value: "{}",
+			},
 			{
 				Key:   importutil.FailedReason,
 				Value: "some_reason",
@@ -884,6 +889,10 @@ func TestImportManager_TaskState(t *testing.T) {
 	resp = mgr.getTaskState(2)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.State)
+	// the dry-run report is persisted in the task infos
+	dryRunReport, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.DryRunReport, resp.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", dryRunReport)
 
 	resp = mgr.getTaskState(1)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
@@ -939,7 +948,7 @@ func TestImportManager_AllocFail(t *testing.T) {
 			ErrorCode: commonpb.ErrorCode_Success,
 		}, nil
 	}
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -1000,7 +1009,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 
 	mockKv := memkv.NewMemoryKV()
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callMarkSegmentsDropped, getCollectionName, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callMarkSegmentsDropped, getCollectionName, nil, nil, nil, nil)
 
 	// add 10 tasks for collection1, id from 1 to 10
 	file1 := "f1.json"
@@ -1282,3 +1291,211 @@ func TestImportManager_checkIndexingDone(t *testing.T) {
 	assert.True(t, done)
 	assert.Nil(t, err)
 }
+
+func TestImportManager_ResumeTasksOfLostDataNodes(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskSubPath.Key, "test_import_task")
+	mockKv := memkv.NewMemoryKV()
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 0, 0, nil
+	}
+	var sentTasks []*datapb.ImportTask
+	callImportServiceFn := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		sentTasks = append(sentTasks, req.GetImportTask())
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: 2,
+		}, nil
+	}
+	var droppedSegments []int64
+	mockMarkSegmentsDroppedErr := false
+	callMarkSegmentsDropped := func(ctx context.Context, segIDs []typeutil.UniqueID) (*commonpb.Status, error) {
+		if mockMarkSegmentsDroppedErr {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			}, nil
+		}
+		droppedSegments = append(droppedSegments, segIDs...)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		}, nil
+	}
+	mockListDataNodesErr := false
+	callListDataNodes := func(ctx context.Context) ([]UniqueID, error) {
+		if mockListDataNodesErr {
+			return nil, errors.New("mock err")
+		}
+		return []UniqueID{2}, nil
+	}
+
+	checkpoint := &importutil.ImportCheckpoint{
+		FinishedFiles: []string{"a.csv"},
+		Segments:      []int64{10, 11},
+		RowCount:      100,
+		AutoIds:       []int64{1, 101},
+	}
+	newManager := func() *importManager {
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, callListDataNodes)
+		// the task of a lost DataNode with checkpoint
+		mgr.workingTasks[1] = &datapb.ImportTaskInfo{
+			Id:         1,
+			DatanodeId: 1,
+			Files:      []string{"a.csv", "b.csv"},
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportStarted,
+				Segments:  []int64{10, 11, 12},
+				RowCount:  100,
+			},
+			Infos: []*commonpb.KeyValuePair{{Key: importutil.Checkpoint, Value: checkpoint.JSON()}},
+		}
+		// the task of an alive DataNode
+		mgr.workingTasks[2] = &datapb.ImportTaskInfo{
+			Id:         2,
+			DatanodeId: 2,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportStarted,
+			},
+		}
+		// the task is persisted before the DataNode is lost
+		mgr.workingTasks[3] = &datapb.ImportTaskInfo{
+			Id:         3,
+			DatanodeId: 3,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPersisted,
+				Segments:  []int64{30},
+			},
+		}
+		// the task of a lost DataNode without checkpoint
+		mgr.workingTasks[4] = &datapb.ImportTaskInfo{
+			Id:         4,
+			DatanodeId: 3,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportStarted,
+				Segments:  []int64{40},
+			},
+		}
+		mgr.busyNodes[1] = 0
+		mgr.busyNodes[3] = 0
+		return mgr
+	}
+
+	t.Run("list DataNodes failed", func(t *testing.T) {
+		mgr := newManager()
+		mockListDataNodesErr = true
+		defer func() {
+			mockListDataNodesErr = false
+		}()
+		mgr.resumeTasksOfLostDataNodes(ctx)
+		assert.Equal(t, 4, len(mgr.workingTasks))
+
+		mgr.callListDataNodes = nil
+		mgr.resumeTasksOfLostDataNodes(ctx)
+		assert.Equal(t, 4, len(mgr.workingTasks))
+	})
+
+	t.Run("task updated in the meantime", func(t *testing.T) {
+		mgr := newManager()
+		stale := cloneImportTaskInfo(mgr.workingTasks[1])
+		assert.NoError(t, mgr.resumeTask(ctx, stale))
+		assert.Equal(t, 0, len(droppedSegments))
+		assert.Equal(t, 4, len(mgr.workingTasks))
+		assert.Equal(t, 0, len(mgr.pendingTasks))
+	})
+
+	t.Run("mark segments dropped failed", func(t *testing.T) {
+		mgr := newManager()
+		mockMarkSegmentsDroppedErr = true
+		markSegmentsDroppedAttempts = 1
+		defer func() {
+			mockMarkSegmentsDroppedErr = false
+			markSegmentsDroppedAttempts = 3
+		}()
+		mgr.resumeTasksOfLostDataNodes(ctx)
+		assert.Equal(t, 2, len(mgr.workingTasks))
+		assert.Equal(t, 0, len(mgr.pendingTasks))
+		assert.Equal(t, 0, len(sentTasks))
+
+		// the task is failed with all its segments, which are dropped by the cleanup loop
+		resp := mgr.getTaskState(1)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+		assert.ElementsMatch(t, []int64{10, 11, 12}, resp.GetSegmentIds())
+		mockMarkSegmentsDroppedErr = false
+		mgr.removeBadImportSegments(ctx)
+		assert.ElementsMatch(t, []int64{10, 11, 12, 40}, droppedSegments)
+		droppedSegments = nil
+	})
+
+	t.Run("resume tasks", func(t *testing.T) {
+		mgr := newManager()
+		mgr.resumeTasksOfLostDataNodes(ctx)
+		assert.ElementsMatch(t, []int64{12, 40}, droppedSegments)
+		assert.Equal(t, 0, len(mgr.pendingTasks))
+		assert.Equal(t, 2, len(sentTasks))
+		assert.Equal(t, 4, len(mgr.workingTasks))
+		_, ok := mgr.busyNodes[1]
+		assert.False(t, ok)
+		_, ok = mgr.busyNodes[3]
+		assert.False(t, ok)
+		_, ok = mgr.busyNodes[2]
+		assert.True(t, ok)
+
+		// the checkpoint is sent to the new DataNode
+		for _, task := range sentTasks {
+			if task.GetTaskId() == 1 {
+				value, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.Checkpoint, task.GetInfos())
+				assert.NoError(t, err)
+				assert.Equal(t, checkpoint.JSON(), value)
+			}
+		}
+
+		// the segments before the checkpoint are kept
+		resp := mgr.getTaskState(1)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+		assert.Equal(t, []int64{10, 11}, resp.GetSegmentIds())
+		assert.Equal(t, int64(100), resp.GetRowCount())
+		assert.Equal(t, []int64{1, 101}, resp.GetIdList())
+		assert.Equal(t, int64(2), mgr.workingTasks[1].GetDatanodeId())
+
+		resp = mgr.getTaskState(4)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+		assert.Equal(t, 0, len(resp.GetSegmentIds()))
+		assert.Equal(t, int64(0), resp.GetRowCount())
+
+		// the tasks of alive DataNode or persisted are not touched
+		assert.Equal(t, int64(2), mgr.workingTasks[2].GetDatanodeId())
+		assert.Equal(t, commonpb.ImportState_ImportPersisted, mgr.workingTasks[3].GetState().GetStateCode())
+
+		// the result of the lost DataNode is ignored
+		_, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+			TaskId:     1,
+			DatanodeId: 1,
+			State:      commonpb.ImportState_ImportFailed,
+		})
+		assert.Error(t, err)
+
+		// only the latest checkpoint is kept
+		newCheckpoint := &importutil.ImportCheckpoint{
+			FinishedFiles: []string{"a.csv"},
+			File:          "b.csv",
+			RowOffset:     50,
+			Segments:      []int64{10, 11, 13},
+			RowCount:      150,
+		}
+		ti, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+			TaskId:     1,
+			DatanodeId: 2,
+			State:      commonpb.ImportState_ImportStarted,
+			Segments:   []int64{10, 11, 13},
+			RowCount:   150,
+			Infos:      []*commonpb.KeyValuePair{{Key: importutil.Checkpoint, Value: newCheckpoint.JSON()}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(ti.GetInfos()))
+		parsed, err := importutil.ParseCheckpoint(ti.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, newCheckpoint, parsed)
+	})
+}
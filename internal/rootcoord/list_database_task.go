@@ -0,0 +1,33 @@
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type listDatabaseTask struct {
+	baseTask
+	Req *proxypb.ListDatabasesRequest
+	Rsp *proxypb.ListDatabasesResponse
+}
+
+func (t *listDatabaseTask) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (t *listDatabaseTask) Execute(ctx context.Context) error {
+	t.Rsp.Status = succStatus()
+	dbs, err := t.core.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		t.Rsp.Status = failStatus(commonpb.ErrorCode_UnexpectedError, err.Error())
+		return err
+	}
+	for _, db := range dbs {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.CreatedTimestamp = append(t.Rsp.CreatedTimestamp, db.CreatedTime)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func Test_listDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &listDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.ListDatabasesRequest{},
+			Rsp:      &proxypb.ListDatabasesResponse{},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.ListDatabasesFunc = func(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+			return []*model.Database{model.NewDefaultDatabase(), {ID: 1, Name: "db1", CreatedTime: 100}}, nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &listDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.ListDatabasesRequest{},
+			Rsp:      &proxypb.ListDatabasesResponse{},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+		assert.ElementsMatch(t, []string{"default", "db1"}, task.Rsp.GetDbNames())
+		assert.ElementsMatch(t, []uint64{0, 100}, task.Rsp.GetCreatedTimestamp())
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -38,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/contextutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -68,25 +70,31 @@ const (
 
 //go:generate mockery --name=IMetaTable --outpkg=mockrootcoord
 type IMetaTable interface {
+	CreateDatabase(ctx context.Context, db *model.Database, ts Timestamp) error
+	DropDatabase(ctx context.Context, dbName string, ts Timestamp) error
+	ListDatabases(ctx context.Context, ts Timestamp) ([]*model.Database, error)
+	GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error)
+
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
-	GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
+	GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
-	ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
+	ListCollections(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error)
 	ListAbnormalCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
 	ListCollectionPhysicalChannels() map[typeutil.UniqueID][]string
 	GetCollectionVirtualChannels(colID int64) []string
 	AddPartition(ctx context.Context, partition *model.Partition) error
 	ChangePartitionState(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
-	CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	DropAlias(ctx context.Context, alias string, ts Timestamp) error
-	AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
+	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
+	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
+	RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
-	IsAlias(name string) bool
+	IsAlias(dbName string, name string) bool
 	ListAliasesByID(collID UniqueID) []string
 
 	// TODO: better to accept ctx.
@@ -117,9 +125,10 @@ type MetaTable struct {
 	ctx     context.Context
 	catalog metastore.RootCoordCatalog
 
-	collID2Meta  map[typeutil.UniqueID]*model.Collection // collection id -> collection meta
-	collName2ID  map[string]typeutil.UniqueID            // collection name to collection id
-	collAlias2ID map[string]typeutil.UniqueID            // collection alias to collection id
+	dbName2Meta map[string]*model.Database              // database name -> database meta
+	collID2Meta map[typeutil.UniqueID]*model.Collection // collection id -> collection meta
+	names       *nameDb                                 // database name -> collection name -> collection id
+	aliases     *nameDb                                 // database name -> collection alias -> collection id
 
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
@@ -141,25 +150,45 @@ func (mt *MetaTable) reload() error {
 	defer mt.ddLock.Unlock()
 
 	record := timerecord.NewTimeRecorder("rootcoord")
+	mt.dbName2Meta = make(map[string]*model.Database)
 	mt.collID2Meta = make(map[UniqueID]*model.Collection)
-	mt.collName2ID = make(map[string]UniqueID)
-	mt.collAlias2ID = make(map[string]UniqueID)
+	mt.names = newNameDb()
+	mt.aliases = newNameDb()
 
 	collectionNum := int64(0)
 	partitionNum := int64(0)
 
+	// the default database is not persisted, collections created before databases were introduced belong to it.
+	mt.dbName2Meta[util.DefaultDBName] = model.NewDefaultDatabase()
+
 	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
-	collections, err := mt.catalog.ListCollections(mt.ctx, typeutil.MaxTimestamp)
+	dbs, err := mt.catalog.ListDatabases(mt.ctx, typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
-	for name, collection := range collections {
-		mt.collID2Meta[collection.CollectionID] = collection
-		mt.collName2ID[name] = collection.CollectionID
+	for _, db := range dbs {
+		if !db.Available() {
+			continue
+		}
+		mt.dbName2Meta[db.Name] = db
+		mt.names.createDbIfNotExist(db.Name)
+		mt.aliases.createDbIfNotExist(db.Name)
+	}
+
+	for dbName, db := range mt.dbName2Meta {
+		// max ts means listing latest resources, meta table should always cache the latest version of catalog.
+		collections, err := mt.catalog.ListCollections(mt.ctx, db.ID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		for _, collection := range collections {
+			mt.collID2Meta[collection.CollectionID] = collection
+			mt.names.insert(dbName, collection.Name, collection.CollectionID)
 
-		if collection.Available() {
-			collectionNum++
-			partitionNum += int64(collection.GetPartitionNum(true))
+			if collection.Available() {
+				collectionNum++
+				partitionNum += int64(collection.GetPartitionNum(true))
+			}
 		}
 	}
 
@@ -169,7 +198,12 @@ func (mt *MetaTable) reload() error {
 		return err
 	}
 	for _, alias := range aliases {
-		mt.collAlias2ID[alias.Name] = alias.CollectionID
+		dbName, ok := mt.getDatabaseNameByIDInternal(alias.DBID)
+		if !ok {
+			log.Warn("skip alias of unknown database", zap.String("alias", alias.Name), zap.Int64("dbID", alias.DBID))
+			continue
+		}
+		mt.aliases.insert(dbName, alias.Name, alias.CollectionID)
 	}
 
 	metrics.RootCoordNumOfCollections.Set(float64(collectionNum))
@@ -179,6 +213,129 @@ func (mt *MetaTable) reload() error {
 	return nil
 }
 
+// getDatabaseNameByIDInternal get database name by database id without lock.
+func (mt *MetaTable) getDatabaseNameByIDInternal(dbID int64) (string, bool) {
+	for name, db := range mt.dbName2Meta {
+		if db.ID == dbID {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func (mt *MetaTable) CreateDatabase(ctx context.Context, db *model.Database, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2Meta[db.Name]; ok {
+		return fmt.Errorf("database already exists: %s", db.Name)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.CreateDatabase(ctx1, db, ts); err != nil {
+		return err
+	}
+	mt.dbName2Meta[db.Name] = db.Clone()
+	mt.names.createDbIfNotExist(db.Name)
+	mt.aliases.createDbIfNotExist(db.Name)
+	log.Info("create database", zap.String("database", db.Name), zap.Int64("id", db.ID), zap.Uint64("ts", ts))
+	return nil
+}
+
+func (mt *MetaTable) DropDatabase(ctx context.Context, dbName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbName = funcutil.DBNameOrDefault(dbName)
+	if dbName == util.DefaultDBName {
+		return fmt.Errorf("the default database cannot be dropped")
+	}
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		// idempotency.
+		log.Warn("drop a database which doesn't exist", zap.String("database", dbName), zap.Uint64("ts", ts))
+		return nil
+	}
+	// collections being dropped still hold their database until they are removed by garbage collector.
+	for _, coll := range mt.collID2Meta {
+		if coll.DBID == db.ID {
+			return fmt.Errorf("database is not empty, collection %s should be dropped first, database: %s", coll.Name, dbName)
+		}
+	}
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.DropDatabase(ctx1, db.ID, ts); err != nil {
+		return err
+	}
+	delete(mt.dbName2Meta, dbName)
+	mt.names.dropDb(dbName)
+	mt.aliases.dropDb(dbName)
+	log.Info("drop database", zap.String("database", dbName), zap.Int64("id", db.ID), zap.Uint64("ts", ts))
+	return nil
+}
+
+// ListDatabases lists all the available databases, including the default database.
+func (mt *MetaTable) ListDatabases(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	if isMaxTs(ts) {
+		dbs := make([]*model.Database, 0, len(mt.dbName2Meta))
+		for _, db := range mt.dbName2Meta {
+			dbs = append(dbs, db.Clone())
+		}
+		// the default database is always the first one since it's never persisted.
+		sort.Slice(dbs, func(i, j int) bool {
+			return dbs[i].CreatedTime < dbs[j].CreatedTime
+		})
+		return dbs, nil
+	}
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	dbs, err := mt.catalog.ListDatabases(ctx1, ts)
+	if err != nil {
+		return nil, err
+	}
+	ret := []*model.Database{model.NewDefaultDatabase()}
+	for _, db := range dbs {
+		if db.Available() {
+			ret = append(ret, db)
+		}
+	}
+	return ret, nil
+}
+
+func (mt *MetaTable) GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	return mt.getDatabaseByNameInternal(ctx, dbName, ts)
+}
+
+func (mt *MetaTable) getDatabaseByNameInternal(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+	dbName = funcutil.DBNameOrDefault(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if ok && (isMaxTs(ts) || db.CreatedTime <= ts) {
+		return db.Clone(), nil
+	}
+	if dbName == util.DefaultDBName {
+		return model.NewDefaultDatabase(), nil
+	}
+	if !isMaxTs(ts) {
+		// travel meta information from catalog.
+		ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+		dbs, err := mt.catalog.ListDatabases(ctx1, ts)
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range dbs {
+			if db.Name == dbName && db.Available() {
+				return db, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("database not exist: %s", dbName)
+}
+
 func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -190,13 +347,17 @@ func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection)
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("collection state should be creating, collection name: %s, collection id: %d, state: %s", coll.Name, coll.CollectionID, coll.State)
 	}
+	dbName, ok := mt.getDatabaseNameByIDInternal(coll.DBID)
+	if !ok {
+		return fmt.Errorf("database not exist, collection name: %s, database id: %d", coll.Name, coll.DBID)
+	}
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
 	if err := mt.catalog.CreateCollection(ctx1, coll, coll.CreateTime); err != nil {
 		return err
 	}
-	mt.collName2ID[coll.Name] = coll.CollectionID
+	mt.names.insert(dbName, coll.Name, coll.CollectionID)
 	mt.collID2Meta[coll.CollectionID] = coll.Clone()
-	log.Info("add collection to meta table", zap.String("collection", coll.Name),
+	log.Info("add collection to meta table", zap.String("database", dbName), zap.String("collection", coll.Name),
 		zap.Int64("id", coll.CollectionID), zap.Uint64("ts", coll.CreateTime))
 	return nil
 }
@@ -232,31 +393,6 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 	return nil
 }
 
-func (mt *MetaTable) removeIfNameMatchedInternal(collectionID UniqueID, name string) {
-	id, ok := mt.collName2ID[name]
-	if ok && id == collectionID {
-		delete(mt.collName2ID, name)
-	}
-}
-
-func (mt *MetaTable) removeIfAliasMatchedInternal(collectionID UniqueID, alias string) {
-	id, ok := mt.collAlias2ID[alias]
-	if ok && id == collectionID {
-		delete(mt.collAlias2ID, alias)
-	}
-}
-
-func (mt *MetaTable) removeIfMatchedInternal(collectionID UniqueID, name string) {
-	mt.removeIfNameMatchedInternal(collectionID, name)
-	mt.removeIfAliasMatchedInternal(collectionID, name)
-}
-
-func (mt *MetaTable) removeAllNamesIfMatchedInternal(collectionID UniqueID, names []string) {
-	for _, name := range names {
-		mt.removeIfMatchedInternal(collectionID, name)
-	}
-}
-
 func (mt *MetaTable) removeCollectionByIDInternal(collectionID UniqueID) {
 	delete(mt.collID2Meta, collectionID)
 }
@@ -268,26 +404,29 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	// Note: we cannot handle case that dropping collection with `ts1` but a collection exists in catalog with newer ts
 	// which is bigger than `ts1`. So we assume that ts should always be the latest.
 
-	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	aliases := mt.listAliasesByID(collectionID)
-	if err := mt.catalog.DropCollection(ctx1, &model.Collection{CollectionID: collectionID, Aliases: aliases}, ts); err != nil {
-		return err
-	}
-
-	allNames := common.CloneStringList(aliases)
-
 	var name string
+	dbID := util.DefaultDBID
 	coll, ok := mt.collID2Meta[collectionID]
 	if ok && coll != nil {
 		name = coll.Name
-		allNames = append(allNames, name)
+		dbID = coll.DBID
+	}
+	dbName, _ := mt.getDatabaseNameByIDInternal(dbID)
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	aliases := mt.aliases.listNamesByID(dbName, collectionID)
+	if err := mt.catalog.DropCollection(ctx1, &model.Collection{CollectionID: collectionID, DBID: dbID, Aliases: aliases}, ts); err != nil {
+		return err
 	}
 
 	// We cannot delete the name directly, since newly collection with same name may be created.
-	mt.removeAllNamesIfMatchedInternal(collectionID, allNames)
+	mt.names.removeIfMatched(dbName, name, collectionID)
+	for _, alias := range aliases {
+		mt.aliases.removeIfMatched(dbName, alias, collectionID)
+	}
 	mt.removeCollectionByIDInternal(collectionID)
 
-	log.Info("remove collection", zap.String("name", name), zap.Int64("id", collectionID), zap.Strings("aliases", aliases))
+	log.Info("remove collection", zap.String("database", dbName), zap.String("name", name), zap.Int64("id", collectionID), zap.Strings("aliases", aliases))
 	return nil
 }
 
@@ -339,18 +478,20 @@ func (mt *MetaTable) getCollectionByIDInternal(ctx context.Context, collectionID
 	return filterUnavailable(coll), nil
 }
 
-func (mt *MetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+func (mt *MetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbName = funcutil.DBNameOrDefault(dbName)
+
 	var collectionID UniqueID
 
-	collectionID, ok := mt.collAlias2ID[collectionName]
+	collectionID, ok := mt.aliases.get(dbName, collectionName)
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
 
-	collectionID, ok = mt.collName2ID[collectionName]
+	collectionID, ok = mt.names.get(dbName, collectionName)
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
@@ -359,9 +500,14 @@ func (mt *MetaTable) GetCollectionByName(ctx context.Context, collectionName str
 		return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s", collectionName))
 	}
 
+	db, err := mt.getDatabaseByNameInternal(ctx, dbName, ts)
+	if err != nil {
+		return nil, err
+	}
+
 	// travel meta information from catalog. No need to check time travel logic again, since catalog already did.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	coll, err := mt.catalog.GetCollectionByName(ctx1, collectionName, ts)
+	coll, err := mt.catalog.GetCollectionByName(ctx1, db.ID, collectionName, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -378,17 +524,23 @@ func (mt *MetaTable) GetCollectionByID(ctx context.Context, collectionID UniqueI
 	return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 }
 
-func (mt *MetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+// ListCollections lists the available collections of the database.
+func (mt *MetaTable) ListCollections(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	db, err := mt.getDatabaseByNameInternal(ctx, dbName, ts)
+	if err != nil {
+		return nil, err
+	}
+
 	if isMaxTs(ts) {
-		return mt.listCollectionFromCache()
+		return mt.listCollectionFromCache(db.ID)
 	}
 
 	// list collections should always be loaded from catalog.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	colls, err := mt.catalog.ListCollections(ctx1, ts)
+	colls, err := mt.catalog.ListCollections(ctx1, db.ID, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -401,10 +553,10 @@ func (mt *MetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*mode
 	return onlineCollections, nil
 }
 
-func (mt *MetaTable) listCollectionFromCache() ([]*model.Collection, error) {
+func (mt *MetaTable) listCollectionFromCache(dbID int64) ([]*model.Collection, error) {
 	collectionFromCache := make([]*model.Collection, 0)
 	for _, meta := range mt.collID2Meta {
-		if meta.Available() {
+		if meta.DBID == dbID && meta.Available() {
 			collectionFromCache = append(collectionFromCache, meta)
 		}
 	}
@@ -417,14 +569,16 @@ func (mt *MetaTable) ListAbnormalCollections(ctx context.Context, ts Timestamp)
 
 	// list collections should always be loaded from catalog.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	colls, err := mt.catalog.ListCollections(ctx1, ts)
-	if err != nil {
-		return nil, err
-	}
-	abnormalCollections := make([]*model.Collection, 0, len(colls))
-	for _, coll := range colls {
-		if !coll.Available() {
-			abnormalCollections = append(abnormalCollections, coll)
+	abnormalCollections := make([]*model.Collection, 0)
+	for _, db := range mt.dbName2Meta {
+		colls, err := mt.catalog.ListCollections(ctx1, db.ID, ts)
+		if err != nil {
+			return nil, err
+		}
+		for _, coll := range colls {
+			if !coll.Available() {
+				abnormalCollections = append(abnormalCollections, coll)
+			}
 		}
 	}
 	return abnormalCollections, nil
@@ -457,6 +611,52 @@ func (mt *MetaTable) AlterCollection(ctx context.Context, oldColl *model.Collect
 	return nil
 }
 
+// RenameCollection changes the name of a collection inside the database, the aliases of the collection are kept.
+func (mt *MetaTable) RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbName = funcutil.DBNameOrDefault(dbName)
+	if _, ok := mt.dbName2Meta[dbName]; !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.names.get(dbName, newName); ok {
+		return fmt.Errorf("cannot rename collection, collection already exists with same name: %s", newName)
+	}
+
+	if _, ok := mt.aliases.get(dbName, newName); ok {
+		return fmt.Errorf("cannot rename collection, alias already exists with same name: %s", newName)
+	}
+
+	collectionID, ok := mt.names.get(dbName, oldName)
+	if !ok {
+		// an alias can't be renamed, use AlterAlias instead.
+		return fmt.Errorf("collection not exists: %s", oldName)
+	}
+
+	oldColl, ok := mt.collID2Meta[collectionID]
+	if !ok || !oldColl.Available() {
+		return fmt.Errorf("collection not exists: %s", oldName)
+	}
+
+	newColl := oldColl.Clone()
+	newColl.Name = newName
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, oldColl, newColl, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+
+	mt.collID2Meta[collectionID] = newColl
+	mt.names.remove(dbName, oldName)
+	mt.names.insert(dbName, newName, collectionID)
+
+	log.Info("rename collection finished", zap.String("database", dbName), zap.String("oldName", oldName),
+		zap.String("newName", newName), zap.Int64("collectionID", collectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 // GetCollectionVirtualChannels returns virtual channels of a given collection.
 func (mt *MetaTable) GetCollectionVirtualChannels(colID int64) []string {
 	mt.ddLock.RLock()
@@ -557,25 +757,31 @@ func (mt *MetaTable) RemovePartition(ctx context.Context, collectionID UniqueID,
 	return nil
 }
 
-func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	dbName = funcutil.DBNameOrDefault(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.names.get(dbName, alias); ok {
 		return fmt.Errorf("cannot create alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.names.get(dbName, collectionName)
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
 	}
 
 	// check if alias exists.
-	aliasedCollectionID, ok := mt.collAlias2ID[alias]
+	aliasedCollectionID, ok := mt.aliases.get(dbName, alias)
 	if ok && aliasedCollectionID == collectionID {
 		log.Warn("add duplicate alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 		return nil
@@ -596,41 +802,54 @@ func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionNa
 	if err := mt.catalog.CreateAlias(ctx1, &model.Alias{
 		Name:         alias,
 		CollectionID: collectionID,
+		DBID:         db.ID,
 		CreatedTime:  ts,
 		State:        pb.AliasState_AliasCreated,
 	}, ts); err != nil {
 		return err
 	}
-	mt.collAlias2ID[alias] = collectionID
-	log.Info("create alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
+	mt.aliases.insert(dbName, alias, collectionID)
+	log.Info("create alias", zap.String("database", dbName), zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) DropAlias(ctx context.Context, alias string, ts Timestamp) error {
+func (mt *MetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	dbName = funcutil.DBNameOrDefault(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	if err := mt.catalog.DropAlias(ctx1, alias, ts); err != nil {
+	if err := mt.catalog.DropAlias(ctx1, db.ID, alias, ts); err != nil {
 		return err
 	}
-	delete(mt.collAlias2ID, alias)
-	log.Info("drop alias", zap.String("alias", alias), zap.Uint64("ts", ts))
+	mt.aliases.remove(dbName, alias)
+	log.Info("drop alias", zap.String("database", dbName), zap.String("alias", alias), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	dbName = funcutil.DBNameOrDefault(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.names.get(dbName, alias); ok {
 		return fmt.Errorf("cannot alter alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.names.get(dbName, collectionName)
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
@@ -643,7 +862,7 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	}
 
 	// check if alias exists.
-	_, ok = mt.collAlias2ID[alias]
+	_, ok = mt.aliases.get(dbName, alias)
 	if !ok {
 		//
 		return fmt.Errorf("failed to alter alias, alias does not exist: %s", alias)
@@ -653,6 +872,7 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	if err := mt.catalog.AlterAlias(ctx1, &model.Alias{
 		Name:         alias,
 		CollectionID: collectionID,
+		DBID:         db.ID,
 		CreatedTime:  ts,
 		State:        pb.AliasState_AliasCreated,
 	}, ts); err != nil {
@@ -660,27 +880,30 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	}
 
 	// alias switch to another collection anyway.
-	mt.collAlias2ID[alias] = collectionID
-	log.Info("alter alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
+	mt.aliases.insert(dbName, alias, collectionID)
+	log.Info("alter alias", zap.String("database", dbName), zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) IsAlias(name string) bool {
+func (mt *MetaTable) IsAlias(dbName string, name string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	_, ok := mt.collAlias2ID[name]
+	_, ok := mt.aliases.get(funcutil.DBNameOrDefault(dbName), name)
 	return ok
 }
 
 func (mt *MetaTable) listAliasesByID(collID UniqueID) []string {
 	ret := make([]string, 0)
-	for alias, id := range mt.collAlias2ID {
-		if id == collID {
-			ret = append(ret, alias)
-		}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return ret
+	}
+	dbName, ok := mt.getDatabaseNameByIDInternal(coll.DBID)
+	if !ok {
+		return ret
 	}
-	return ret
+	return mt.aliases.listNamesByID(dbName, collID)
 }
 
 func (mt *MetaTable) ListAliasesByID(collID UniqueID) []string {
@@ -742,7 +965,7 @@ func (mt *MetaTable) GetCollectionIDByName(name string) (UniqueID, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	id, ok := mt.collName2ID[name]
+	id, ok := mt.names.get(util.DefaultDBName, name)
 	if !ok {
 		return InvalidCollectionID, fmt.Errorf("collection not exists: %s", name)
 	}
@@ -491,10 +491,11 @@ func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 
 func TestMetaTable_GetCollectionByName(t *testing.T) {
 	t.Run("get by alias", func(t *testing.T) {
+		aliases := newNameDb()
+		aliases.insert(util.DefaultDBName, "alias", 100)
 		meta := &MetaTable{
-			collAlias2ID: map[string]typeutil.UniqueID{
-				"alias": 100,
-			},
+			names:   newNameDb(),
+			aliases: aliases,
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
 				100: {
 					State:      pb.CollectionState_CollectionCreated,
@@ -507,7 +508,7 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 			},
 		}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "alias", 101)
+		coll, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "alias", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
@@ -515,10 +516,11 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 	})
 
 	t.Run("get by name", func(t *testing.T) {
+		names := newNameDb()
+		names.insert(util.DefaultDBName, "name", 100)
 		meta := &MetaTable{
-			collName2ID: map[string]typeutil.UniqueID{
-				"name": 100,
-			},
+			names:   names,
+			aliases: newNameDb(),
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
 				100: {
 					State:      pb.CollectionState_CollectionCreated,
@@ -531,23 +533,46 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 			},
 		}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "name", 101)
+		coll, err := meta.GetCollectionByName(ctx, "", "name", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
 		assert.Equal(t, Params.CommonCfg.DefaultPartitionName.GetValue(), coll.Partitions[0].PartitionName)
 	})
 
+	t.Run("get by name in another database", func(t *testing.T) {
+		names := newNameDb()
+		names.insert("db1", "name", 100)
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              {ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated},
+			},
+			names:   names,
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {DBID: 1, State: pb.CollectionState_CollectionCreated},
+			},
+		}
+		ctx := context.Background()
+		coll, err := meta.GetCollectionByName(ctx, "db1", "name", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), coll.DBID)
+		_, err = meta.GetCollectionByName(ctx, util.DefaultDBName, "name", typeutil.MaxTimestamp)
+		assert.True(t, common.IsCollectionNotExistError(err))
+	})
+
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(nil, errors.New("error mock GetCollectionByName"))
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, names: newNameDb(), aliases: newNameDb()}
 		ctx := context.Background()
-		_, err := meta.GetCollectionByName(ctx, "name", 101)
+		_, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "name", 101)
 		assert.Error(t, err)
 	})
 
@@ -555,12 +580,13 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(&model.Collection{State: pb.CollectionState_CollectionDropped}, nil)
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, names: newNameDb(), aliases: newNameDb()}
 		ctx := context.Background()
-		_, err := meta.GetCollectionByName(ctx, "name", 101)
+		_, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "name", 101)
 		assert.Error(t, err)
 		assert.True(t, common.IsCollectionNotExistError(err))
 	})
@@ -569,6 +595,7 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(&model.Collection{
@@ -579,9 +606,9 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 				{PartitionID: 22, PartitionName: "dropped", State: pb.PartitionState_PartitionDropped},
 			},
 		}, nil)
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, names: newNameDb(), aliases: newNameDb()}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "name", 101)
+		coll, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "name", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
@@ -590,8 +617,8 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 
 	t.Run("get latest version", func(t *testing.T) {
 		ctx := context.Background()
-		meta := &MetaTable{collName2ID: nil}
-		_, err := meta.GetCollectionByName(ctx, "not_exist", typeutil.MaxTimestamp)
+		meta := &MetaTable{names: newNameDb(), aliases: newNameDb()}
+		_, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "not_exist", typeutil.MaxTimestamp)
 		assert.Error(t, err)
 		assert.True(t, common.IsCollectionNotExistError(err))
 	})
@@ -639,6 +666,92 @@ func TestMetaTable_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestMetaTable_RenameCollection(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		names := newNameDb()
+		names.insert(util.DefaultDBName, "old", 100)
+		names.insert(util.DefaultDBName, "other", 200)
+		aliases := newNameDb()
+		aliases.insert(util.DefaultDBName, "alias", 100)
+		return &MetaTable{
+			catalog: catalog,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			names:   names,
+			aliases: aliases,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Name: "old", State: pb.CollectionState_CollectionCreated},
+				200: {CollectionID: 200, Name: "other", State: pb.CollectionState_CollectionCreated},
+			},
+		}
+	}
+
+	t.Run("database not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenameCollection(context.Background(), "not_exist", "old", "new", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("new name conflicts", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenameCollection(context.Background(), util.DefaultDBName, "old", "other", 0)
+		assert.Error(t, err)
+		err = meta.RenameCollection(context.Background(), util.DefaultDBName, "old", "alias", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.RenameCollection(context.Background(), util.DefaultDBName, "not_exist", "new", 0)
+		assert.Error(t, err)
+		// alias can't be renamed.
+		err = meta.RenameCollection(context.Background(), util.DefaultDBName, "alias", "new", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("alter metastore fail", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(errors.New("error"))
+		meta := newMeta(catalog)
+		err := meta.RenameCollection(context.Background(), util.DefaultDBName, "old", "new", 0)
+		assert.Error(t, err)
+		id, ok := meta.names.get(util.DefaultDBName, "old")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(100), id)
+		assert.Equal(t, "old", meta.collID2Meta[100].Name)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.MatchedBy(func(coll *model.Collection) bool { return coll.Name == "old" }),
+			mock.MatchedBy(func(coll *model.Collection) bool { return coll.Name == "new" }),
+			mock.Anything,
+			mock.Anything,
+		).Return(nil)
+		meta := newMeta(catalog)
+		err := meta.RenameCollection(context.Background(), "", "old", "new", 0)
+		assert.NoError(t, err)
+		_, ok := meta.names.get(util.DefaultDBName, "old")
+		assert.False(t, ok)
+		id, ok := meta.names.get(util.DefaultDBName, "new")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(100), id)
+		assert.Equal(t, "new", meta.collID2Meta[100].Name)
+		id, ok = meta.aliases.get(util.DefaultDBName, "alias")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(100), id)
+	})
+}
+
 func Test_filterUnavailable(t *testing.T) {
 	coll := &model.Collection{}
 	nPartition := 10
@@ -714,7 +827,7 @@ func TestMetaTable_RemoveCollection(t *testing.T) {
 			mock.Anything, // model.Collection
 			mock.AnythingOfType("uint64"),
 		).Return(errors.New("error mock DropCollection"))
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, names: newNameDb(), aliases: newNameDb()}
 		ctx := context.Background()
 		err := meta.RemoveCollection(ctx, 100, 9999)
 		assert.Error(t, err)
@@ -727,47 +840,160 @@ func TestMetaTable_RemoveCollection(t *testing.T) {
 			mock.Anything, // model.Collection
 			mock.AnythingOfType("uint64"),
 		).Return(nil)
+		names := newNameDb()
+		names.insert("db1", "collection", 100)
+		aliases := newNameDb()
+		aliases.insert("db1", "alias1", 100)
+		aliases.insert("db1", "alias2", 100)
 		meta := &MetaTable{
 			catalog: catalog,
-			collAlias2ID: map[string]typeutil.UniqueID{
-				"alias1": 100,
-				"alias2": 100,
+			dbName2Meta: map[string]*model.Database{
+				"db1": {ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated},
 			},
+			names:   names,
+			aliases: aliases,
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
-				100: {Name: "collection"},
-			},
-			collName2ID: map[string]typeutil.UniqueID{
-				"collection": 100,
+				100: {Name: "collection", DBID: 1},
 			},
 		}
 		ctx := context.Background()
 		err := meta.RemoveCollection(ctx, 100, 9999)
 		assert.NoError(t, err)
+		_, ok := names.get("db1", "collection")
+		assert.False(t, ok)
+		assert.Empty(t, aliases.listNamesByID("db1", 100))
+	})
+}
+
+func TestMetaTable_Database(t *testing.T) {
+	t.Run("create database", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("CreateDatabase",
+			mock.Anything, // context.Context
+			mock.Anything, // model.Database
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := &MetaTable{
+			catalog: catalog,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			names:   newNameDb(),
+			aliases: newNameDb(),
+		}
+		ctx := context.Background()
+		err := meta.CreateDatabase(ctx, &model.Database{ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated, CreatedTime: 100}, 100)
+		assert.NoError(t, err)
+		err = meta.CreateDatabase(ctx, &model.Database{ID: 2, Name: "db1"}, 101)
+		assert.Error(t, err)
+
+		db, err := meta.GetDatabaseByName(ctx, "db1", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), db.ID)
+		db, err = meta.GetDatabaseByName(ctx, "", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.True(t, db.IsDefault())
+
+		dbs, err := meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(dbs))
+		assert.Equal(t, util.DefaultDBName, dbs[0].Name)
+		assert.Equal(t, "db1", dbs[1].Name)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("DropDatabase",
+			mock.Anything, // context.Context
+			int64(2),
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := &MetaTable{
+			catalog: catalog,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              {ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated},
+				"db2":              {ID: 2, Name: "db2", State: pb.DatabaseState_DatabaseCreated},
+			},
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {Name: "collection", DBID: 1, State: pb.CollectionState_CollectionDropping},
+			},
+			names:   newNameDb(),
+			aliases: newNameDb(),
+		}
+		ctx := context.Background()
+		assert.Error(t, meta.DropDatabase(ctx, util.DefaultDBName, 100))
+		assert.Error(t, meta.DropDatabase(ctx, "db1", 100))
+		assert.NoError(t, meta.DropDatabase(ctx, "db2", 100))
+		assert.NoError(t, meta.DropDatabase(ctx, "not_exist", 100))
+		_, err := meta.GetDatabaseByName(ctx, "db2", typeutil.MaxTimestamp)
+		assert.Error(t, err)
+	})
+
+	t.Run("list collections of database", func(t *testing.T) {
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              {ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated},
+			},
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {Name: "collection", State: pb.CollectionState_CollectionCreated},
+				101: {Name: "collection", DBID: 1, State: pb.CollectionState_CollectionCreated},
+				102: {Name: "dropping", DBID: 1, State: pb.CollectionState_CollectionDropping},
+			},
+		}
+		ctx := context.Background()
+		colls, err := meta.ListCollections(ctx, "db1", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(colls))
+		assert.Equal(t, UniqueID(101), colls[0].CollectionID)
+		_, err = meta.ListCollections(ctx, "not_exist", typeutil.MaxTimestamp)
+		assert.Error(t, err)
 	})
 }
 
 func TestMetaTable_reload(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return(nil, errors.New("error mock ListDatabases"))
+		meta := &MetaTable{catalog: catalog}
+		err := meta.reload()
+		assert.Error(t, err)
+		assert.Empty(t, meta.collID2Meta)
+	})
+
 	t.Run("failed to list collections", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return(nil, nil)
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("uint64"),
 		).Return(nil, errors.New("error mock ListCollections"))
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.Error(t, err)
 		assert.Empty(t, meta.collID2Meta)
-		assert.Empty(t, meta.collName2ID)
-		assert.Empty(t, meta.collAlias2ID)
 	})
 
 	t.Run("failed to list aliases", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return(nil, nil)
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("uint64"),
 		).Return(
-			map[string]*model.Collection{"test": {CollectionID: 100, Name: "test"}},
+			[]*model.Collection{{CollectionID: 100, Name: "test"}},
 			nil)
 		catalog.On("ListAliases",
 			mock.Anything, // context.Context
@@ -776,32 +1002,59 @@ func TestMetaTable_reload(t *testing.T) {
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.Error(t, err)
-		assert.Empty(t, meta.collAlias2ID)
+		assert.Empty(t, meta.aliases.listNamesByID(util.DefaultDBName, 100))
 	})
 
 	t.Run("normal case", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return(
+			[]*model.Database{
+				{ID: 1, Name: "db1", State: pb.DatabaseState_DatabaseCreated},
+				{ID: 2, Name: "db2", State: pb.DatabaseState_DatabaseDropped},
+			},
+			nil)
+
+		catalog.On("ListCollections",
+			mock.Anything, // context.Context
+			util.DefaultDBID,
+			mock.AnythingOfType("uint64"),
+		).Return(
+			[]*model.Collection{{CollectionID: 100, Name: "test"}},
+			nil)
+
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			int64(1),
 			mock.AnythingOfType("uint64"),
 		).Return(
-			map[string]*model.Collection{"test": {CollectionID: 100, Name: "test"}},
+			[]*model.Collection{{CollectionID: 101, DBID: 1, Name: "test"}},
 			nil)
 
 		catalog.On("ListAliases",
 			mock.Anything, // context.Context
 			mock.AnythingOfType("uint64"),
 		).Return(
-			[]*model.Alias{{Name: "alias", CollectionID: 100}},
+			[]*model.Alias{{Name: "alias", CollectionID: 100}, {Name: "alias", CollectionID: 101, DBID: 1}},
 			nil)
 
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(meta.collID2Meta))
-		assert.Equal(t, 1, len(meta.collName2ID))
-		assert.Equal(t, 1, len(meta.collAlias2ID))
+		assert.Equal(t, 2, len(meta.dbName2Meta))
+		assert.Equal(t, 2, len(meta.collID2Meta))
+		id, ok := meta.names.get("db1", "test")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(101), id)
+		id, ok = meta.aliases.get(util.DefaultDBName, "alias")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(100), id)
+		id, ok = meta.aliases.get("db1", "alias")
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(101), id)
 	})
 }
 
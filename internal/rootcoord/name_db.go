@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// nameDb maps names to collection ids, the names are scoped by database.
+// It's not thread safe, the caller should hold the lock of meta table.
+type nameDb struct {
+	db2Name2ID map[string]map[string]UniqueID // database name -> collection name/alias -> collection id
+}
+
+func newNameDb() *nameDb {
+	return &nameDb{
+		db2Name2ID: map[string]map[string]UniqueID{
+			util.DefaultDBName: make(map[string]UniqueID),
+		},
+	}
+}
+
+func (n *nameDb) exist(dbName string) bool {
+	_, ok := n.db2Name2ID[dbName]
+	return ok
+}
+
+func (n *nameDb) createDbIfNotExist(dbName string) {
+	if !n.exist(dbName) {
+		n.db2Name2ID[dbName] = make(map[string]UniqueID)
+	}
+}
+
+func (n *nameDb) dropDb(dbName string) {
+	delete(n.db2Name2ID, dbName)
+}
+
+func (n *nameDb) insert(dbName string, name string, id UniqueID) {
+	n.createDbIfNotExist(dbName)
+	n.db2Name2ID[dbName][name] = id
+}
+
+func (n *nameDb) get(dbName string, name string) (UniqueID, bool) {
+	name2ID, ok := n.db2Name2ID[dbName]
+	if !ok {
+		return 0, false
+	}
+	id, ok := name2ID[name]
+	return id, ok
+}
+
+// removeIfMatched removes the name only if it still points to the given collection,
+// since a newly collection with the same name may be created.
+func (n *nameDb) removeIfMatched(dbName string, name string, id UniqueID) {
+	existed, ok := n.get(dbName, name)
+	if ok && existed == id {
+		delete(n.db2Name2ID[dbName], name)
+	}
+}
+
+func (n *nameDb) remove(dbName string, name string) {
+	name2ID, ok := n.db2Name2ID[dbName]
+	if ok {
+		delete(name2ID, name)
+	}
+}
+
+// listNamesByID lists all the names in the database which point to the given collection.
+func (n *nameDb) listNamesByID(dbName string, id UniqueID) []string {
+	ret := make([]string, 0)
+	for name, existed := range n.db2Name2ID[dbName] {
+		if existed == id {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -27,6 +27,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -74,11 +76,13 @@ type Limit = ratelimitutil.Limit
 // Limitations:
 //  1. DML throughput limitation;
 //  2. DDL, DQL qps/rps limitation;
+//  3. DML throughput and DQL qps/vps limitation of each collection;
 //
 // Protections:
 //  1. TT protection -> 				dqlRate = maxDQLRate * (maxDelay - ttDelay) / maxDelay
 //  2. Memory protection -> 			dmlRate = maxDMLRate * (highMem - curMem) / (highMem - lowMem)
 //  3. Disk quota protection ->			force deny writing if exceeded
+//     (both the cluster disk quota and the disk quota of each collection)
 //  4. DQL Queue length protection ->   dqlRate = curDQLRate * CoolOffSpeed
 //  5. DQL queue latency protection ->  dqlRate = curDQLRate * CoolOffSpeed
 //  6. Search result protection ->	 	searchRate = curSearchRate * CoolOffSpeed
@@ -89,6 +93,7 @@ type QuotaCenter struct {
 	proxies    *proxyClientManager
 	queryCoord types.QueryCoord
 	dataCoord  types.DataCoord
+	meta       IMetaTable
 
 	// metrics
 	queryNodeMetrics map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics
@@ -96,8 +101,10 @@ type QuotaCenter struct {
 	proxyMetrics     map[UniqueID]*metricsinfo.ProxyQuotaMetrics
 	dataCoordMetrics *metricsinfo.DataCoordQuotaMetrics
 
-	currentRates map[internalpb.RateType]Limit
-	tsoAllocator tso.Allocator
+	currentRates    map[internalpb.RateType]Limit
+	collectionRates map[UniqueID]map[internalpb.RateType]Limit
+	partitionRates  map[UniqueID]map[UniqueID]map[internalpb.RateType]Limit // collection id -> partition id -> rates
+	tsoAllocator    tso.Allocator
 
 	rateAllocateStrategy RateAllocateStrategy
 
@@ -106,13 +113,16 @@ type QuotaCenter struct {
 }
 
 // NewQuotaCenter returns a new QuotaCenter.
-func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoord, dataCoord types.DataCoord, tsoAllocator tso.Allocator) *QuotaCenter {
+func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoord, dataCoord types.DataCoord, tsoAllocator tso.Allocator, meta IMetaTable) *QuotaCenter {
 	return &QuotaCenter{
-		proxies:      proxies,
-		queryCoord:   queryCoord,
-		dataCoord:    dataCoord,
-		currentRates: make(map[internalpb.RateType]Limit),
-		tsoAllocator: tsoAllocator,
+		proxies:         proxies,
+		queryCoord:      queryCoord,
+		dataCoord:       dataCoord,
+		meta:            meta,
+		currentRates:    make(map[internalpb.RateType]Limit),
+		collectionRates: make(map[UniqueID]map[internalpb.RateType]Limit),
+		partitionRates:  make(map[UniqueID]map[UniqueID]map[internalpb.RateType]Limit),
+		tsoAllocator:    tsoAllocator,
 
 		rateAllocateStrategy: DefaultRateAllocateStrategy,
 		stopChan:             make(chan struct{}),
@@ -278,6 +288,19 @@ func (q *QuotaCenter) getRealTimeRate(rateType internalpb.RateType) float64 {
 	return rate
 }
 
+// getCollectionRealTimeRate return real time rate of the collection in Proxy.
+func (q *QuotaCenter) getCollectionRealTimeRate(collectionID UniqueID, rateType internalpb.RateType) float64 {
+	var rate float64
+	for _, metric := range q.proxyMetrics {
+		for _, r := range metric.CollectionRms[collectionID] {
+			if r.Label == rateType.String() {
+				rate += r.Rate
+			}
+		}
+	}
+	return rate
+}
+
 // guaranteeMinRate make sure the rate will not be less than the min rate.
 func (q *QuotaCenter) guaranteeMinRate(minRate float64, rateType internalpb.RateType) {
 	if minRate > 0 && q.currentRates[rateType] < Limit(minRate) {
@@ -302,6 +325,7 @@ func (q *QuotaCenter) calculateReadRates() {
 		}
 		q.guaranteeMinRate(Params.QuotaConfig.DQLMinSearchRate.GetAsFloat(), internalpb.RateType_DQLSearch)
 		q.guaranteeMinRate(Params.QuotaConfig.DQLMinQueryRate.GetAsFloat(), internalpb.RateType_DQLQuery)
+		q.coolOffCollectionReading(coolOffSpeed)
 		log.Warn("QuotaCenter cool read rates off done",
 			zap.Any("searchRate", q.currentRates[internalpb.RateType_DQLSearch]),
 			zap.Any("queryRate", q.currentRates[internalpb.RateType_DQLQuery]))
@@ -371,12 +395,77 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	}
 	q.guaranteeMinRate(Params.QuotaConfig.DMLMinInsertRate.GetAsFloat(), internalpb.RateType_DMLInsert)
 	q.guaranteeMinRate(Params.QuotaConfig.DMLMinDeleteRate.GetAsFloat(), internalpb.RateType_DMLDelete)
+	q.calculateCollectionWriteRates(ttFactor)
+	q.calculatePartitionWriteRates(ttFactor)
 	return nil
 }
 
+// coolOffCollectionReading cools the dql rates of the limited collections off according to their real time rates.
+func (q *QuotaCenter) coolOffCollectionReading(coolOffSpeed float64) {
+	for collectionID, rates := range q.collectionRates {
+		realTimeSearchRate := q.getCollectionRealTimeRate(collectionID, internalpb.RateType_DQLSearch)
+		realTimeQueryRate := q.getCollectionRealTimeRate(collectionID, internalpb.RateType_DQLQuery)
+		if rates[internalpb.RateType_DQLSearch] != Inf && realTimeSearchRate > 0 {
+			rates[internalpb.RateType_DQLSearch] = Limit(realTimeSearchRate * coolOffSpeed)
+		}
+		if rates[internalpb.RateType_DQLQuery] != Inf && realTimeQueryRate > 0 {
+			rates[internalpb.RateType_DQLQuery] = Limit(realTimeQueryRate * coolOffSpeed)
+		}
+	}
+}
+
+// calculateCollectionWriteRates calculates the dml rates of collections, writing of a collection
+// is denied if its disk quota exceeded, otherwise its rates are limited by the factor.
+func (q *QuotaCenter) calculateCollectionWriteRates(factor float64) {
+	for collectionID, rates := range q.collectionRates {
+		if q.ifCollectionDiskQuotaExceeded(collectionID) {
+			rates[internalpb.RateType_DMLInsert] = 0
+			rates[internalpb.RateType_DMLDelete] = 0
+			rates[internalpb.RateType_DMLBulkLoad] = 0
+			log.Warn("QuotaCenter force to deny writing of collection",
+				zap.Int64("collectionID", collectionID),
+				zap.String("reason", string(DiskQuotaExceeded)))
+			continue
+		}
+		if rates[internalpb.RateType_DMLInsert] != Inf {
+			rates[internalpb.RateType_DMLInsert] *= Limit(factor)
+		}
+		if rates[internalpb.RateType_DMLDelete] != Inf {
+			rates[internalpb.RateType_DMLDelete] *= Limit(factor)
+		}
+	}
+}
+
+// calculatePartitionWriteRates calculates the dml rates of partitions, writing of a partition
+// is denied if its disk quota exceeded, otherwise its rates are limited by the factor.
+func (q *QuotaCenter) calculatePartitionWriteRates(factor float64) {
+	for collectionID, partitionRates := range q.partitionRates {
+		for partitionID, rates := range partitionRates {
+			if q.ifPartitionDiskQuotaExceeded(collectionID, partitionID) {
+				rates[internalpb.RateType_DMLInsert] = 0
+				rates[internalpb.RateType_DMLDelete] = 0
+				rates[internalpb.RateType_DMLBulkLoad] = 0
+				log.Warn("QuotaCenter force to deny writing of partition",
+					zap.Int64("collectionID", collectionID),
+					zap.Int64("partitionID", partitionID),
+					zap.String("reason", string(DiskQuotaExceeded)))
+				continue
+			}
+			if rates[internalpb.RateType_DMLInsert] != Inf {
+				rates[internalpb.RateType_DMLInsert] *= Limit(factor)
+			}
+			if rates[internalpb.RateType_DMLDelete] != Inf {
+				rates[internalpb.RateType_DMLDelete] *= Limit(factor)
+			}
+		}
+	}
+}
+
 // calculateRates calculates target rates by different strategies.
 func (q *QuotaCenter) calculateRates() error {
 	q.resetCurrentRates()
+	q.resetCollectionRates()
+	q.resetPartitionRates()
 
 	err := q.calculateWriteRates()
 	if err != nil {
@@ -410,6 +499,133 @@ func (q *QuotaCenter) resetCurrentRates() {
 	}
 }
 
+// resetCollectionRates resets the rates of the collections which have metrics reported to
+// the rates configured in collection properties, or the configured rates per collection.
+func (q *QuotaCenter) resetCollectionRates() {
+	q.collectionRates = make(map[UniqueID]map[internalpb.RateType]Limit)
+	for _, collectionID := range q.getMetricCollections() {
+		properties := q.getCollectionProperties(collectionID)
+		dmlEnabled := Params.QuotaConfig.DMLLimitEnabled.GetAsBool()
+		dqlEnabled := Params.QuotaConfig.DQLLimitEnabled.GetAsBool()
+		q.collectionRates[collectionID] = map[internalpb.RateType]Limit{
+			internalpb.RateType_DMLInsert: getCollectionRate(properties, common.CollectionInsertRateMaxKey,
+				Params.QuotaConfig.DMLMaxInsertRatePerCollection.GetAsFloat(), dmlEnabled, true),
+			internalpb.RateType_DMLDelete: getCollectionRate(properties, common.CollectionDeleteRateMaxKey,
+				Params.QuotaConfig.DMLMaxDeleteRatePerCollection.GetAsFloat(), dmlEnabled, true),
+			internalpb.RateType_DMLBulkLoad: getCollectionRate(properties, common.CollectionBulkLoadRateMaxKey,
+				Params.QuotaConfig.DMLMaxBulkLoadRatePerCollection.GetAsFloat(), dmlEnabled, true),
+			internalpb.RateType_DQLSearch: getCollectionRate(properties, common.CollectionSearchRateMaxKey,
+				Params.QuotaConfig.DQLMaxSearchRatePerCollection.GetAsFloat(), dqlEnabled, false),
+			internalpb.RateType_DQLQuery: getCollectionRate(properties, common.CollectionQueryRateMaxKey,
+				Params.QuotaConfig.DQLMaxQueryRatePerCollection.GetAsFloat(), dqlEnabled, false),
+		}
+	}
+}
+
+// getMetricCollections returns the collections which have binlog size or real time rates reported.
+func (q *QuotaCenter) getMetricCollections() []UniqueID {
+	collectionSet := typeutil.NewUniqueSet()
+	if q.dataCoordMetrics != nil {
+		for collectionID := range q.dataCoordMetrics.CollectionBinlogSize {
+			collectionSet.Insert(collectionID)
+		}
+	}
+	for _, metric := range q.proxyMetrics {
+		for collectionID := range metric.CollectionRms {
+			collectionSet.Insert(collectionID)
+		}
+	}
+	return collectionSet.Collect()
+}
+
+// resetPartitionRates resets the dml rates of the partitions which have metrics reported to the rates
+// configured in the partition properties of their collections, or the configured rates per partition.
+func (q *QuotaCenter) resetPartitionRates() {
+	q.partitionRates = make(map[UniqueID]map[UniqueID]map[internalpb.RateType]Limit)
+	dmlEnabled := Params.QuotaConfig.DMLLimitEnabled.GetAsBool()
+	for collectionID, partitionIDs := range q.getMetricPartitions() {
+		properties := q.getCollectionProperties(collectionID)
+		q.partitionRates[collectionID] = make(map[UniqueID]map[internalpb.RateType]Limit, len(partitionIDs))
+		for _, partitionID := range partitionIDs {
+			q.partitionRates[collectionID][partitionID] = map[internalpb.RateType]Limit{
+				internalpb.RateType_DMLInsert: getCollectionRate(properties, common.PartitionInsertRateMaxKey,
+					Params.QuotaConfig.DMLMaxInsertRatePerPartition.GetAsFloat(), dmlEnabled, true),
+				internalpb.RateType_DMLDelete: getCollectionRate(properties, common.PartitionDeleteRateMaxKey,
+					Params.QuotaConfig.DMLMaxDeleteRatePerPartition.GetAsFloat(), dmlEnabled, true),
+				internalpb.RateType_DMLBulkLoad: getCollectionRate(properties, common.PartitionBulkLoadRateMaxKey,
+					Params.QuotaConfig.DMLMaxBulkLoadRatePerPartition.GetAsFloat(), dmlEnabled, true),
+			}
+		}
+	}
+}
+
+// getMetricPartitions returns the partitions which have binlog size or real time rates reported,
+// the partitions are grouped by collection.
+func (q *QuotaCenter) getMetricPartitions() map[UniqueID][]UniqueID {
+	partitionSets := make(map[UniqueID]typeutil.UniqueSet)
+	add := func(collectionID, partitionID UniqueID) {
+		if _, ok := partitionSets[collectionID]; !ok {
+			partitionSets[collectionID] = typeutil.NewUniqueSet()
+		}
+		partitionSets[collectionID].Insert(partitionID)
+	}
+	if q.dataCoordMetrics != nil {
+		for collectionID, partitions := range q.dataCoordMetrics.PartitionBinlogSize {
+			for partitionID := range partitions {
+				add(collectionID, partitionID)
+			}
+		}
+	}
+	for _, metric := range q.proxyMetrics {
+		for collectionID, partitions := range metric.PartitionRms {
+			for partitionID := range partitions {
+				add(collectionID, partitionID)
+			}
+		}
+	}
+	partitions := make(map[UniqueID][]UniqueID, len(partitionSets))
+	for collectionID, partitionSet := range partitionSets {
+		partitions[collectionID] = partitionSet.Collect()
+	}
+	return partitions
+}
+
+// getCollectionProperties returns the properties of the collection, nil is returned if the collection is not found.
+func (q *QuotaCenter) getCollectionProperties(collectionID UniqueID) map[string]string {
+	if q.meta == nil {
+		return nil
+	}
+	coll, err := q.meta.GetCollectionByID(context.Background(), collectionID, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Debug("QuotaCenter failed to get collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	return funcutil.KeyValuePair2Map(coll.Properties)
+}
+
+// getCollectionRate returns the rate configured by the property of key, defaultRate is returned if
+// the property is absent or illegal. The property value is in megabytes if isMegaBytes is true.
+func getCollectionRate(properties map[string]string, key string, defaultRate float64, enabled bool, isMegaBytes bool) Limit {
+	if !enabled {
+		return Inf
+	}
+	rate := defaultRate
+	if v, ok := properties[key]; ok {
+		r, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			log.Warn("QuotaCenter: illegal collection rate", zap.String("key", key), zap.String("value", v), zap.Error(err))
+		} else if isMegaBytes && r >= 0 {
+			rate = r * 1024 * 1024
+		} else {
+			rate = r
+		}
+	}
+	if rate < 0 {
+		return Inf // no limit
+	}
+	return Limit(rate)
+}
+
 // getTimeTickDelayFactor gets time tick delay of DataNodes and QueryNodes,
 // and return the factor according to max tolerable time tick delay.
 func (q *QuotaCenter) getTimeTickDelayFactor(ts Timestamp) float64 {
@@ -640,20 +856,79 @@ func (q *QuotaCenter) ifDiskQuotaExceeded() bool {
 	return false
 }
 
+// ifCollectionDiskQuotaExceeded checks if disk quota of the collection exceeded.
+func (q *QuotaCenter) ifCollectionDiskQuotaExceeded(collectionID UniqueID) bool {
+	if !Params.QuotaConfig.DiskProtectionEnabled.GetAsBool() {
+		return false
+	}
+	if q.dataCoordMetrics == nil {
+		return false
+	}
+	binlogSize, ok := q.dataCoordMetrics.CollectionBinlogSize[collectionID]
+	if !ok {
+		return false
+	}
+	diskQuota := Params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat()
+	if v, ok := q.getCollectionProperties(collectionID)[common.CollectionDiskQuotaKey]; ok {
+		quota, err := strconv.ParseFloat(v, 64)
+		if err == nil && quota > 0 {
+			diskQuota = quota * 1024 * 1024
+		}
+	}
+	if float64(binlogSize) >= diskQuota {
+		log.Warn("QuotaCenter: disk quota of collection exceeded",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("curDiskUsage", binlogSize),
+			zap.Float64("diskQuota", diskQuota))
+		return true
+	}
+	return false
+}
+
+// ifPartitionDiskQuotaExceeded checks if disk quota of the partition exceeded.
+func (q *QuotaCenter) ifPartitionDiskQuotaExceeded(collectionID UniqueID, partitionID UniqueID) bool {
+	if !Params.QuotaConfig.DiskProtectionEnabled.GetAsBool() {
+		return false
+	}
+	if q.dataCoordMetrics == nil {
+		return false
+	}
+	binlogSize, ok := q.dataCoordMetrics.PartitionBinlogSize[collectionID][partitionID]
+	if !ok {
+		return false
+	}
+	diskQuota := Params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat()
+	if v, ok := q.getCollectionProperties(collectionID)[common.PartitionDiskQuotaKey]; ok {
+		quota, err := strconv.ParseFloat(v, 64)
+		if err == nil && quota > 0 {
+			diskQuota = quota * 1024 * 1024
+		}
+	}
+	if float64(binlogSize) >= diskQuota {
+		log.Warn("QuotaCenter: disk quota of partition exceeded",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("partitionID", partitionID),
+			zap.Int64("curDiskUsage", binlogSize),
+			zap.Float64("diskQuota", diskQuota))
+		return true
+	}
+	return false
+}
+
 // setRates notifies Proxies to set rates for different rate types.
 func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
 	defer cancel()
-	var map2List func() []*internalpb.Rate
+	var map2List func(currentRates map[internalpb.RateType]Limit) []*internalpb.Rate
 	switch q.rateAllocateStrategy {
 	case Average:
-		map2List = func() []*internalpb.Rate {
+		map2List = func(currentRates map[internalpb.RateType]Limit) []*internalpb.Rate {
 			proxyNum := q.proxies.GetProxyCount()
 			if proxyNum == 0 {
 				return nil
 			}
-			rates := make([]*internalpb.Rate, 0, len(q.currentRates))
-			for rt, r := range q.currentRates {
+			rates := make([]*internalpb.Rate, 0, len(currentRates))
+			for rt, r := range currentRates {
 				if r == Inf {
 					rates = append(rates, &internalpb.Rate{Rt: rt, R: float64(r)})
 				} else {
@@ -665,13 +940,45 @@ func (q *QuotaCenter) setRates() error {
 	case ByRateWeight:
 		// TODO: support ByRateWeight
 	}
+	limited := func(rates map[internalpb.RateType]Limit) bool {
+		for _, r := range rates {
+			if r != Inf {
+				return true
+			}
+		}
+		return false
+	}
+	// collections and partitions without limitation are absent, their rateLimiters in Proxy would be removed
+	collectionRates := make([]*proxypb.CollectionRate, 0, len(q.collectionRates))
+	for collectionID, rates := range q.collectionRates {
+		if limited(rates) {
+			collectionRates = append(collectionRates, &proxypb.CollectionRate{
+				Collection: collectionID,
+				Rates:      map2List(rates),
+			})
+		}
+	}
+	partitionRates := make([]*proxypb.PartitionRate, 0)
+	for collectionID, partitions := range q.partitionRates {
+		for partitionID, rates := range partitions {
+			if limited(rates) {
+				partitionRates = append(partitionRates, &proxypb.PartitionRate{
+					Collection: collectionID,
+					Partition:  partitionID,
+					Rates:      map2List(rates),
+				})
+			}
+		}
+	}
 	timestamp := tsoutil.ComposeTSByTime(time.Now(), 0)
 	req := &proxypb.SetRatesRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgID(int64(timestamp)),
 			commonpbutil.WithTimeStamp(timestamp),
 		),
-		Rates: map2List(),
+		Rates:              map2List(q.currentRates),
+		RatesPerCollection: collectionRates,
+		RatesPerPartition:  partitionRates,
 	}
 	return q.proxies.SetRates(ctx, req)
 }
@@ -27,6 +27,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -85,36 +87,36 @@ func TestQuotaCenter(t *testing.T) {
 	pcm := newProxyClientManager(core.proxyCreator)
 
 	t.Run("test QuotaCenter", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		go quotaCenter.run()
 		time.Sleep(10 * time.Millisecond)
 		quotaCenter.stop()
 	})
 
 	t.Run("test syncMetrics", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		err = quotaCenter.syncMetrics()
 		assert.Error(t, err) // for empty response
 
-		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{retErr: true}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{retErr: true}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		err = quotaCenter.syncMetrics()
 		assert.Error(t, err)
 
-		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{retErr: true}, core.tsoAllocator)
+		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{retErr: true}, core.tsoAllocator, core.meta)
 		err = quotaCenter.syncMetrics()
 		assert.Error(t, err)
 
-		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{retFailStatus: true}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{retFailStatus: true}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		err = quotaCenter.syncMetrics()
 		assert.Error(t, err)
 
-		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{retFailStatus: true}, core.tsoAllocator)
+		quotaCenter = NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{retFailStatus: true}, core.tsoAllocator, core.meta)
 		err = quotaCenter.syncMetrics()
 		assert.Error(t, err)
 	})
 
 	t.Run("test forceDeny", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		quotaCenter.forceDenyReading(ManualForceDeny)
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DQLQuery])
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DQLQuery])
@@ -124,7 +126,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test calculateRates", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		err = quotaCenter.calculateRates()
 		assert.NoError(t, err)
 		alloc := newMockTsoAllocator()
@@ -138,7 +140,7 @@ func TestQuotaCenter(t *testing.T) {
 
 	t.Run("test getTimeTickDelayFactor", func(t *testing.T) {
 		// test MaxTimestamp
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		factor := quotaCenter.getTimeTickDelayFactor(0)
 		assert.Equal(t, float64(1), factor)
 
@@ -185,7 +187,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test getTimeTickDelayFactor factors", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		type ttCase struct {
 			maxTtDelay     time.Duration
 			curTt          time.Time
@@ -223,7 +225,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test getNQInQueryFactor", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		factor := quotaCenter.getNQInQueryFactor()
 		assert.Equal(t, float64(1), factor)
 
@@ -249,7 +251,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test getQueryLatencyFactor", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		factor := quotaCenter.getQueryLatencyFactor()
 		assert.Equal(t, float64(1), factor)
 
@@ -274,7 +276,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test checkReadResult", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		factor := quotaCenter.getReadResultFactor()
 		assert.Equal(t, float64(1), factor)
 
@@ -299,7 +301,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test calculateReadRates", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
 			1: {Rms: []metricsinfo.RateMetric{
 				{Label: internalpb.RateType_DQLSearch.String(), Rate: 100},
@@ -341,7 +343,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test calculateWriteRates", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		err = quotaCenter.calculateWriteRates()
 		assert.NoError(t, err)
 
@@ -365,8 +367,116 @@ func TestQuotaCenter(t *testing.T) {
 		Params.QuotaConfig.ForceDenyWriting = forceBak
 	})
 
+	t.Run("test calculate collection rates", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+			switch collectionID {
+			case 1:
+				return &model.Collection{CollectionID: 1, Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionInsertRateMaxKey, Value: "1"},
+					{Key: common.CollectionSearchRateMaxKey, Value: "100"},
+					{Key: common.CollectionQueryRateMaxKey, Value: "illegal"},
+				}}, nil
+			case 2:
+				return &model.Collection{CollectionID: 2, Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionDiskQuotaKey, Value: fmt.Sprintf("%f", 99.0/1024/1024)},
+				}}, nil
+			}
+			return nil, fmt.Errorf("mock err")
+		}
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{
+			CollectionBinlogSize: map[int64]int64{1: 100, 2: 100},
+		}
+		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
+			1: {CollectionRms: map[int64][]metricsinfo.RateMetric{
+				1: {{Label: internalpb.RateType_DQLSearch.String(), Rate: 50}},
+				3: {{Label: internalpb.RateType_DQLQuery.String(), Rate: 50}},
+			}}}
+		paramtable.Get().Save(Params.QuotaConfig.DMLLimitEnabled.Key, "true")
+		paramtable.Get().Save(Params.QuotaConfig.DQLLimitEnabled.Key, "true")
+		paramtable.Get().Save(Params.QuotaConfig.DiskProtectionEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.QuotaConfig.DMLLimitEnabled.Key)
+		defer paramtable.Get().Reset(Params.QuotaConfig.DQLLimitEnabled.Key)
+
+		quotaCenter.resetCollectionRates()
+		assert.Equal(t, 3, len(quotaCenter.collectionRates))
+		assert.Equal(t, Limit(1024*1024), quotaCenter.collectionRates[1][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(100), quotaCenter.collectionRates[1][internalpb.RateType_DQLSearch])
+		assert.Equal(t, Inf, quotaCenter.collectionRates[1][internalpb.RateType_DQLQuery])
+		assert.Equal(t, Inf, quotaCenter.collectionRates[3][internalpb.RateType_DMLInsert])
+
+		// collection 2 exceeded its disk quota
+		quotaCenter.calculateCollectionWriteRates(0.5)
+		assert.Equal(t, Limit(1024*1024*0.5), quotaCenter.collectionRates[1][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.collectionRates[2][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.collectionRates[2][internalpb.RateType_DMLDelete])
+		assert.Equal(t, Inf, quotaCenter.collectionRates[3][internalpb.RateType_DMLInsert])
+
+		quotaCenter.coolOffCollectionReading(0.9)
+		assert.Equal(t, Limit(50*0.9), quotaCenter.collectionRates[1][internalpb.RateType_DQLSearch])
+		assert.Equal(t, Inf, quotaCenter.collectionRates[3][internalpb.RateType_DQLQuery])
+
+		err = quotaCenter.setRates()
+		assert.NoError(t, err)
+	})
+
+	t.Run("test calculate partition rates", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+			switch collectionID {
+			case 1:
+				return &model.Collection{CollectionID: 1, Properties: []*commonpb.KeyValuePair{
+					{Key: common.PartitionInsertRateMaxKey, Value: "1"},
+					{Key: common.PartitionDeleteRateMaxKey, Value: "illegal"},
+					{Key: common.PartitionDiskQuotaKey, Value: fmt.Sprintf("%f", 99.0/1024/1024)},
+				}}, nil
+			}
+			return nil, fmt.Errorf("mock err")
+		}
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{
+			CollectionBinlogSize: map[int64]int64{1: 150, 2: 100},
+			PartitionBinlogSize: map[int64]map[int64]int64{
+				1: {10: 100, 11: 50},
+				2: {20: 100},
+			},
+		}
+		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
+			1: {PartitionRms: map[int64]map[int64][]metricsinfo.RateMetric{
+				1: {12: {{Label: internalpb.RateType_DMLInsert.String(), Rate: 50}}},
+			}}}
+		paramtable.Get().Save(Params.QuotaConfig.DMLLimitEnabled.Key, "true")
+		paramtable.Get().Save(Params.QuotaConfig.DiskProtectionEnabled.Key, "true")
+		paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerPartition.Key, fmt.Sprintf("%f", 99.0/1024/1024))
+		defer paramtable.Get().Reset(Params.QuotaConfig.DMLLimitEnabled.Key)
+		defer paramtable.Get().Reset(Params.QuotaConfig.DiskQuotaPerPartition.Key)
+
+		quotaCenter.resetPartitionRates()
+		assert.Equal(t, 2, len(quotaCenter.partitionRates))
+		assert.Equal(t, 3, len(quotaCenter.partitionRates[1]))
+		assert.Equal(t, 1, len(quotaCenter.partitionRates[2]))
+		assert.Equal(t, Limit(1024*1024), quotaCenter.partitionRates[1][12][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Inf, quotaCenter.partitionRates[1][12][internalpb.RateType_DMLDelete])
+		assert.Equal(t, Inf, quotaCenter.partitionRates[2][20][internalpb.RateType_DMLInsert])
+
+		// partition 10 exceeded the disk quota in the properties of collection 1,
+		// partition 20 exceeded the configured disk quota per partition
+		quotaCenter.calculatePartitionWriteRates(0.5)
+		assert.Equal(t, Limit(0), quotaCenter.partitionRates[1][10][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.partitionRates[1][10][internalpb.RateType_DMLDelete])
+		assert.Equal(t, Limit(0), quotaCenter.partitionRates[1][10][internalpb.RateType_DMLBulkLoad])
+		assert.Equal(t, Limit(1024*1024*0.5), quotaCenter.partitionRates[1][11][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(1024*1024*0.5), quotaCenter.partitionRates[1][12][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Inf, quotaCenter.partitionRates[1][12][internalpb.RateType_DMLDelete])
+		assert.Equal(t, Limit(0), quotaCenter.partitionRates[2][20][internalpb.RateType_DMLInsert])
+
+		err = quotaCenter.setRates()
+		assert.NoError(t, err)
+	})
+
 	t.Run("test getMemoryFactor basic", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		factor := quotaCenter.getMemoryFactor()
 		assert.Equal(t, float64(1), factor)
 		quotaCenter.dataNodeMetrics = map[UniqueID]*metricsinfo.DataNodeQuotaMetrics{1: {Hms: metricsinfo.HardwareMetrics{MemoryUsage: 100, Memory: 100}}}
@@ -378,7 +488,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test getMemoryFactor factors", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		type memCase struct {
 			lowWater       float64
 			highWater      float64
@@ -418,7 +528,7 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test ifDiskQuotaExceeded", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 
 		paramtable.Get().Save(Params.QuotaConfig.DiskProtectionEnabled.Key, "false")
 		ok := quotaCenter.ifDiskQuotaExceeded()
@@ -439,14 +549,14 @@ func TestQuotaCenter(t *testing.T) {
 	})
 
 	t.Run("test setRates", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		quotaCenter.currentRates[internalpb.RateType_DMLInsert] = 100
 		err = quotaCenter.setRates()
 		assert.NoError(t, err)
 	})
 
 	t.Run("test guaranteeMinRate", func(t *testing.T) {
-		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
+		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator, core.meta)
 		minRate := Limit(100)
 		quotaCenter.currentRates[internalpb.RateType_DQLSearch] = Limit(50)
 		quotaCenter.guaranteeMinRate(float64(minRate), internalpb.RateType_DQLSearch)
@@ -0,0 +1,57 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+type renameCollectionTask struct {
+	baseTask
+	Req *proxypb.RenameCollectionRequest
+}
+
+func (t *renameCollectionTask) Prepare(ctx context.Context) error {
+	if t.Req.GetOldName() == "" || t.Req.GetNewName() == "" {
+		return errors.New("collection name should not be empty")
+	}
+	if t.Req.GetOldName() == t.Req.GetNewName() {
+		return fmt.Errorf("new collection name is the same as the old one: %s", t.Req.GetNewName())
+	}
+	return nil
+}
+
+func (t *renameCollectionTask) Execute(ctx context.Context) error {
+	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetOldName(), t.GetTs())
+	if err != nil {
+		return err
+	}
+
+	ts := t.GetTs()
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&renameCollectionStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetOldName(),
+		newName:  t.Req.GetNewName(),
+		ts:       ts,
+	}, &renameCollectionStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetNewName(),
+		newName:  t.Req.GetOldName(),
+		ts:       ts,
+	})
+	// expire the cache by collection id, so that the aliases pointing to the collection are also refreshed.
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{t.Req.GetOldName(), t.Req.GetNewName()},
+		collectionID:    coll.CollectionID,
+		ts:              ts,
+	}, &nullStep{})
+
+	return undoTask.Execute(ctx)
+}
@@ -0,0 +1,112 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func Test_renameCollectionTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &renameCollectionTask{Req: &proxypb.RenameCollectionRequest{OldName: "old"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		task := &renameCollectionTask{Req: &proxypb.RenameCollectionRequest{OldName: "old", NewName: "old"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &renameCollectionTask{Req: &proxypb.RenameCollectionRequest{OldName: "old", NewName: "new"}}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_renameCollectionTask_Execute(t *testing.T) {
+	t.Run("collection not exist", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to rename", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 100, Name: collectionName}, nil
+		}
+		meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+			return errors.New("error mock RenameCollection")
+		}
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to expire cache, check if undo worked", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 100, Name: collectionName}, nil
+		}
+		renamed := make([]string, 0)
+		meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+			renamed = append(renamed, newName)
+			return nil
+		}
+		undoSteps := make(chan *stepStack, 1)
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			undoSteps <- s
+		}
+		core := newTestCore(withMeta(meta), withInvalidProxyManager(), withStepExecutor(executor))
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+
+		// rename back to the old name.
+		s := <-undoSteps
+		assert.Equal(t, 1, len(s.steps))
+		_, err = s.steps[0].Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"new", "old"}, renamed)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 100, Name: collectionName}, nil
+		}
+		meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+			assert.Equal(t, "db1", dbName)
+			assert.Equal(t, "old", oldName)
+			assert.Equal(t, "new", newName)
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.RenameCollectionRequest{DbName: "db1", OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -47,10 +49,12 @@ import (
 	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util"
@@ -59,6 +63,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -132,6 +137,7 @@ type Core struct {
 	factory dependency.Factory
 
 	importManager *importManager
+	exportManager *exportManager
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -366,9 +372,9 @@ func (c *Core) initMetaTable() error {
 			}
 
 			catalog = &kvmetestore.Catalog{Txn: metaKV, Snapshot: ss}
-		case util.MetaStoreTypeMysql:
+		case util.MetaStoreTypeMysql, util.MetaStoreTypeSQLite:
 			// connect to database
-			err := dbcore.Connect(&Params.DBCfg)
+			err := dbcore.Connect(Params.MetaStoreCfg.MetaStoreType.GetValue(), &Params.DBCfg)
 			if err != nil {
 				return err
 			}
@@ -426,12 +432,39 @@ func (c *Core) initImportManager() error {
 		f.NewDescribeIndexFunc(),
 		f.NewGetSegmentIndexStateFunc(),
 		f.NewUnsetIsImportingStateFunc(),
+		f.NewListDataNodesFunc(),
 	)
 	c.importManager.init(c.ctx)
 
 	return nil
 }
 
+func (c *Core) initExportManager() error {
+	expTaskKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+
+	f := NewExportFactory(c)
+	c.exportManager = newExportManager(
+		c.ctx,
+		expTaskKv,
+		f.NewIDAllocator(),
+		f.NewFlushCollectionFunc(),
+		f.NewGetFlushStateFunc(),
+		f.NewGetSegmentBinlogsFunc(),
+		f.NewAddSegRefLockFunc(),
+		f.NewReleaseSegRefLockFunc(),
+		f.NewGetCollectionSchemaFunc(),
+		f.NewExportSegmentFunc(),
+		f.NewListDataNodesFunc(),
+		f.NewRemoveFilesFunc(),
+	)
+	c.exportManager.init()
+
+	return nil
+}
+
 func (c *Core) initInternal() error {
 	if err := c.initSession(); err != nil {
 		return err
@@ -475,13 +508,17 @@ func (c *Core) initInternal() error {
 
 	c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
-	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.queryCoord, c.dataCoord, c.tsoAllocator)
+	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.queryCoord, c.dataCoord, c.tsoAllocator, c.meta)
 	log.Debug("RootCoord init QuotaCenter done")
 
 	if err := c.initImportManager(); err != nil {
 		return err
 	}
 
+	if err := c.initExportManager(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -592,21 +629,27 @@ func (c *Core) restore(ctx context.Context) error {
 		}
 	}
 
-	colls, err = c.meta.ListCollections(ctx, typeutil.MaxTimestamp)
+	dbs, err := c.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
-	for _, coll := range colls {
-		for _, part := range coll.Partitions {
-			ts, err := c.tsoAllocator.GenerateTSO(1)
-			if err != nil {
-				return err
-			}
+	for _, db := range dbs {
+		colls, err = c.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		for _, coll := range colls {
+			for _, part := range coll.Partitions {
+				ts, err := c.tsoAllocator.GenerateTSO(1)
+				if err != nil {
+					return err
+				}
 
-			switch part.State {
-			case pb.PartitionState_PartitionDropping:
-				go c.garbageCollector.ReDropPartition(coll.PhysicalChannelNames, part.Clone(), ts)
-			default:
+				switch part.State {
+				case pb.PartitionState_PartitionDropping:
+					go c.garbageCollector.ReDropPartition(coll.PhysicalChannelNames, part.Clone(), ts)
+				default:
+				}
 			}
 		}
 	}
@@ -649,13 +692,21 @@ func (c *Core) startInternal() error {
 }
 
 func (c *Core) startServerLoop() {
-	c.wg.Add(6)
+	c.wg.Add(7)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.importManager.resumeTasksLoop(&c.wg)
+
+	c.wg.Add(1)
+	go c.exportManager.cleanupLoop(&c.wg)
+	for i := 0; i < Params.RootCoordCfg.ExportTaskParallelism.GetAsInt(); i++ {
+		c.wg.Add(1)
+		go c.exportManager.workLoop(&c.wg)
+	}
 }
 
 // Start starts RootCoord.
@@ -918,7 +969,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 func (c *Core) describeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*model.Collection, error) {
 	ts := getTravelTs(in)
 	if in.GetCollectionName() != "" {
-		return c.meta.GetCollectionByName(ctx, in.GetCollectionName(), ts)
+		return c.meta.GetCollectionByName(ctx, in.GetDbName(), in.GetCollectionName(), ts)
 	}
 	return c.meta.GetCollectionByID(ctx, in.GetCollectionID(), ts)
 }
@@ -1661,6 +1712,43 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return succStatus(), nil
 }
 
+// RenameCollection changes the name of a collection, the collection id and aliases are kept.
+func (c *Core) RenameCollection(ctx context.Context, in *proxypb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RenameCollection")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()),
+		zap.String("oldName", in.GetOldName()), zap.String("newName", in.GetNewName()))
+	log.Info("received request to rename collection")
+
+	t := &renameCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to rename collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to rename collection", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RenameCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to rename collection", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
 // Import imports large files (json, numpy, etc.) on MinIO/S3 storage into Milvus storage.
 func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -1672,16 +1760,24 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 	// Get collection/partition ID from collection/partition name.
 	var colInfo *model.Collection
 	var err error
-	if colInfo, err = c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp); err != nil {
+	dbName := importutil.GetDBName(req.GetOptions())
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	if colInfo, err = c.meta.GetCollectionByName(ctx, dbName, req.GetCollectionName(), typeutil.MaxTimestamp); err != nil {
 		log.Error("failed to find collection ID from its name",
+			zap.String("database name", dbName),
 			zap.String("collection name", req.GetCollectionName()),
 			zap.Error(err))
 		return nil, err
 	}
 	cID := colInfo.CollectionID
 	req.ChannelNames = c.meta.GetCollectionVirtualChannels(cID)
-	if req.GetPartitionName() == "" {
-		req.PartitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+	if req.PartitionName, err = getImportPartitionName(colInfo, req); err != nil {
+		log.Error("failed to get the partition to import into",
+			zap.String("collection name", req.GetCollectionName()),
+			zap.Error(err))
+		return nil, err
 	}
 	var pID UniqueID
 	if pID, err = c.meta.GetPartitionByName(cID, req.GetPartitionName(), typeutil.MaxTimestamp); err != nil {
@@ -1702,6 +1798,39 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 	return importJobResp, nil
 }
 
+// getImportPartitionName returns the partition that the import request imports into. The hidden partitions of a
+// partition key collection can't be specified by name, a backup import picks one of them by its index.
+func getImportPartitionName(colInfo *model.Collection, req *milvuspb.ImportRequest) (string, error) {
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	index, ok, err := importutil.ParsePartitionKeyIndex(req.GetOptions())
+	if err != nil {
+		return "", err
+	}
+	isPartitionKey := false
+	for _, field := range colInfo.Fields {
+		if typeutil.IsPartitionKeyField(model.MarshalFieldModel(field)) {
+			isPartitionKey = true
+			break
+		}
+	}
+	if !isPartitionKey {
+		if ok {
+			return "", fmt.Errorf("%s is only supported by partition key collection", importutil.PartitionKeyIndex)
+		}
+		if req.GetPartitionName() == "" {
+			return defaultPartitionName, nil
+		}
+		return req.GetPartitionName(), nil
+	}
+	if req.GetPartitionName() != "" && req.GetPartitionName() != defaultPartitionName {
+		return "", errors.New("not support manually specifying the partition name if partition key mode is used")
+	}
+	if !ok {
+		return "", errors.New("import into partition key collection is only supported by backup import")
+	}
+	return fmt.Sprintf("%s_%d", defaultPartitionName, index), nil
+}
+
 // GetImportState returns the current state of an import task.
 func (c *Core) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -1725,7 +1854,7 @@ func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTask
 	if len(collectionName) != 0 {
 		// if the collection name is specified but not found, user may input a wrong name, the collection doesn't exist or has been dropped.
 		// we will return error to notify user the name is incorrect.
-		colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp)
+		colInfo, err := c.meta.GetCollectionByName(ctx, util.DefaultDBName, req.GetCollectionName(), typeutil.MaxTimestamp)
 		if err != nil {
 			err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
 			log.Error("ListImportTasks failed", zap.Error(err))
@@ -1809,6 +1938,11 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 		log.Info("an import task has failed, marking DataNode available and resending import task",
 			zap.Int64("task ID", ir.GetTaskId()))
 		resendTaskFunc()
+	} else if ir.GetState() == commonpb.ImportState_ImportStarted {
+		// A checkpoint is reported, the DataNode is still busy with the task.
+		log.Debug("import task checkpoint reported",
+			zap.Int64("task ID", ir.GetTaskId()),
+			zap.Int64s("segment IDs", ir.GetSegments()))
 	} else if ir.GetState() != commonpb.ImportState_ImportPersisted {
 		log.Debug("unexpected import task state reported, return immediately (this should not happen)",
 			zap.Any("task ID", ir.GetTaskId()),
@@ -1818,14 +1952,16 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 		// Here ir.GetState() == commonpb.ImportState_ImportPersisted
 		// When a DataNode finishes importing, remove this DataNode from the busy node list and send out import tasks again.
 		resendTaskFunc()
-		// Flush all import data segments.
-		if err := c.broker.Flush(ctx, ti.GetCollectionId(), ir.GetSegments()); err != nil {
-			log.Error("failed to call Flush on bulk insert segments",
-				zap.Int64("task ID", ir.GetTaskId()))
-			return &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			}, nil
+		// Flush all import data segments, a dry-run import has no segment.
+		if len(ir.GetSegments()) > 0 {
+			if err := c.broker.Flush(ctx, ti.GetCollectionId(), ir.GetSegments()); err != nil {
+				log.Error("failed to call Flush on bulk insert segments",
+					zap.Int64("task ID", ir.GetTaskId()))
+				return &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				}, nil
+			}
 		}
 	}
 
@@ -1834,6 +1970,161 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	}, nil
 }
 
+// Export writes the entities of a collection at a snapshot timestamp into files on the object storage, the task
+// is executed in background and its state can be checked by GetExportState.
+func (c *Core) Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", req.GetDbName()),
+		zap.String("collection name", req.GetCollectionName()), zap.Strings("partition names", req.GetPartitionNames()),
+		zap.String("path", req.GetPath()), zap.String("format", req.GetFormat()))
+	log.Info("RootCoord receive export request")
+
+	colInfo, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to find collection ID from its name", zap.Error(err))
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_CollectionNotExists, err.Error()),
+		}, nil
+	}
+
+	task := &rootcoordpb.ExportTaskInfo{
+		CollectionId:   colInfo.CollectionID,
+		CollectionName: colInfo.Name,
+		Path:           req.GetPath(),
+		Format:         strings.ToLower(req.GetFormat()),
+		Timestamp:      req.GetTimestamp(),
+		Expr:           req.GetExpr(),
+	}
+	if task.Format == "" {
+		task.Format = storage.ExportFormatJSON
+	}
+	if task.Format != storage.ExportFormatJSON && task.Format != storage.ExportFormatNumpy && task.Format != storage.ExportFormatParquet {
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, fmt.Sprintf("unknown export format '%s', should be json, numpy or parquet", req.GetFormat())),
+		}, nil
+	}
+	if err := validateExportPath(task.Path); err != nil {
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()),
+		}, nil
+	}
+	if task.Expr != "" {
+		schema := &schemapb.CollectionSchema{
+			Name:   colInfo.Name,
+			AutoID: colInfo.AutoID,
+			Fields: model.MarshalFieldModels(colInfo.Fields),
+		}
+		if _, err := planparserv2.CreateRetrievePlan(schema, task.Expr); err != nil {
+			return &proxypb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, fmt.Sprintf("invalid export expr: %s", err.Error())),
+			}, nil
+		}
+	}
+
+	partitionIDs := make(map[string]UniqueID, len(colInfo.Partitions))
+	for _, partition := range colInfo.Partitions {
+		partitionIDs[partition.PartitionName] = partition.PartitionID
+	}
+	if len(req.GetPartitionNames()) == 0 {
+		for _, partition := range colInfo.Partitions {
+			task.PartitionIds = append(task.PartitionIds, partition.PartitionID)
+		}
+	}
+	for _, name := range req.GetPartitionNames() {
+		partitionID, ok := partitionIDs[name]
+		if !ok {
+			return &proxypb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, fmt.Sprintf("partition '%s' doesn't exist", name)),
+			}, nil
+		}
+		task.PartitionIds = append(task.PartitionIds, partitionID)
+	}
+
+	ts, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	if task.Timestamp == 0 {
+		task.Timestamp = ts
+	} else {
+		// a future snapshot would miss the entities inserted after the segments are flushed
+		if task.Timestamp > ts {
+			return &proxypb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument,
+					fmt.Sprintf("the snapshot %v of export is in the future", tsoutil.PhysicalTime(task.Timestamp))),
+			}, nil
+		}
+		if err := checkExportSnapshot(task.Timestamp); err != nil {
+			return &proxypb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()),
+			}, nil
+		}
+	}
+
+	taskID, err := c.exportManager.exportJob(task)
+	if err != nil {
+		log.Warn("failed to create export task", zap.Error(err))
+		return &proxypb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &proxypb.ExportResponse{
+		Status: succStatus(),
+		TaskId: taskID,
+	}, nil
+}
+
+// validateExportPath rejects the paths which may overwrite the files managed by milvus, an export path can't
+// contain the directories of binlogs and index files, nor go up by "..".
+func validateExportPath(exportPath string) error {
+	if exportPath == "" {
+		return errors.New("export path is empty")
+	}
+	for _, elem := range strings.Split(path.Clean(exportPath), "/") {
+		switch elem {
+		case "..":
+			return fmt.Errorf("export path '%s' can't contain '..'", exportPath)
+		case common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.SegmentIndexPath:
+			return fmt.Errorf("export path '%s' can't be under the reserved directory '%s'", exportPath, elem)
+		}
+	}
+	return nil
+}
+
+// ReportExport receives the result of an export segment task from a DataNode.
+func (c *Core) ReportExport(ctx context.Context, result *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error) {
+	log.Info("RootCoord receive export segment result",
+		zap.Int64("task ID", result.GetTaskId()),
+		zap.Int64("segment ID", result.GetSegmentId()),
+		zap.Int64("DataNode ID", result.GetDatanodeId()),
+		zap.Any("status", result.GetStatus()))
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+	if err := c.exportManager.reportSegmentResult(result); err != nil {
+		log.Warn("failed to report export segment result", zap.Int64("task ID", result.GetTaskId()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// GetExportState returns the current state of an export task.
+func (c *Core) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return c.exportManager.getTaskState(req.GetTaskId()), nil
+}
+
 // ExpireCredCache will call invalidate credential cache
 func (c *Core) ExpireCredCache(ctx context.Context, username string) error {
 	req := proxypb.InvalidateCredCacheRequest{
@@ -2545,3 +2836,118 @@ func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest)
 
 	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: errReasons}, nil
 }
+
+// CreateDatabase creates a database to hold collections.
+func (c *Core) CreateDatabase(ctx context.Context, in *proxypb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CreateDatabase")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()))
+	log.Info("received request to create database")
+
+	t := &createDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to create database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to create database", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to create database", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// DropDatabase drops a database, only the empty database can be dropped.
+func (c *Core) DropDatabase(ctx context.Context, in *proxypb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropDatabase")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()))
+	log.Info("received request to drop database")
+
+	t := &dropDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop database", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to drop database", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// ListDatabases lists all the databases, including the default database.
+func (c *Core) ListDatabases(ctx context.Context, in *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("ListDatabases")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole))
+	log.Info("received request to list databases")
+
+	t := &listDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+		Rsp:      &proxypb.ListDatabasesResponse{},
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to list databases", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &proxypb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to list databases", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &proxypb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("ListDatabases").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to list databases", zap.Int("num of databases", len(t.Rsp.GetDbNames())))
+	return t.Rsp, nil
+}
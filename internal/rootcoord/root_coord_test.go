@@ -13,7 +13,9 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -23,12 +25,16 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
@@ -201,6 +207,120 @@ func TestRootCoord_DropPartition(t *testing.T) {
 	})
 }
 
+func TestRootCoord_CreateDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &proxypb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &proxypb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &proxypb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &proxypb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DropDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &proxypb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &proxypb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &proxypb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &proxypb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_ListDatabases(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &proxypb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &proxypb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &proxypb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &proxypb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestRootCoord_CreateAlias(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -277,6 +397,44 @@ func TestRootCoord_DropAlias(t *testing.T) {
 	})
 }
 
+func TestRootCoord_RenameCollection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &proxypb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &proxypb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &proxypb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &proxypb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_AlterAlias(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -822,7 +980,7 @@ func TestCore_Import(t *testing.T) {
 		meta.GetCollectionIDByNameFunc = func(name string) (UniqueID, error) {
 			return 0, errors.New("error mock GetCollectionIDByName")
 		}
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("collection name not found")
 		}
 		_, err := c.Import(ctx, &milvuspb.ImportRequest{
@@ -864,13 +1022,79 @@ func TestCore_Import(t *testing.T) {
 			return 101, nil
 		}
 		coll := &model.Collection{Name: "a-good-name"}
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return coll.Clone(), nil
+		}
+		_, err := c.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: "a-good-name",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("database and partition key", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(),
+			withMeta(meta))
+		meta.GetCollectionVirtualChannelsFunc = func(colID int64) []string {
+			return []string{"ch-1", "ch-2"}
+		}
+		var partitionName string
+		meta.GetPartitionByNameFunc = func(collID UniqueID, name string, ts Timestamp) (UniqueID, error) {
+			partitionName = name
+			return 101, nil
+		}
+		coll := &model.Collection{
+			Name: "a-good-name",
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64, TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.PartitionKeyKey, Value: "true"},
+				}},
+			},
+		}
+		var dbName string
+		meta.GetCollectionByNameFunc = func(ctx context.Context, db string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			dbName = db
 			return coll.Clone(), nil
 		}
+
+		// hidden partitions can't be specified by name
 		_, err := c.Import(ctx, &milvuspb.ImportRequest{
 			CollectionName: "a-good-name",
+			PartitionName:  "_default_1",
+			Options:        []*commonpb.KeyValuePair{{Key: importutil.BackupFlag, Value: "true"}},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, util.DefaultDBName, dbName)
+
+		// only backup import picks a hidden partition by index
+		_, err = c.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: "a-good-name",
+		})
+		assert.Error(t, err)
+
+		_, err = c.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: "a-good-name",
+			Options: []*commonpb.KeyValuePair{
+				{Key: importutil.BackupFlag, Value: "true"},
+				{Key: importutil.PartitionKeyIndex, Value: "1"},
+				{Key: importutil.DBName, Value: "db1"},
+			},
 		})
 		assert.NoError(t, err)
+		assert.Equal(t, "db1", dbName)
+		assert.Equal(t, "_default_1", partitionName)
+
+		// the index is rejected by collection without partition key
+		coll.Fields = coll.Fields[:1]
+		_, err = c.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: "a-good-name",
+			Options: []*commonpb.KeyValuePair{
+				{Key: importutil.BackupFlag, Value: "true"},
+				{Key: importutil.PartitionKeyIndex, Value: "1"},
+			},
+		})
+		assert.Error(t, err)
 	})
 }
 
@@ -911,7 +1135,7 @@ func TestCore_GetImportState(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil, nil)
 		resp, err := c.GetImportState(ctx, &milvuspb.GetImportStateRequest{
 			Task: 100,
 		})
@@ -923,6 +1147,191 @@ func TestCore_GetImportState(t *testing.T) {
 	})
 }
 
+func TestCore_Export(t *testing.T) {
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName != "coll" {
+			return nil, errors.New("collection name not found")
+		}
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "coll",
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+			Partitions: []*model.Partition{
+				{PartitionID: 10, PartitionName: "p1"},
+				{PartitionID: 11, PartitionName: "p2"},
+			},
+		}, nil
+	}
+	newCore := func(opts ...Opt) *Core {
+		c := newTestCore(append([]Opt{withHealthyCode(), withMeta(meta)}, opts...)...)
+		c.exportManager = newExportManager(context.Background(), memkv.NewMemoryKV(),
+			func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+				return 100, 0, nil
+			}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		return c
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad collection name", func(t *testing.T) {
+		c := newCore()
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "a-bad-name", Path: "a"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad request", func(t *testing.T) {
+		c := newCore()
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: "a", Format: "xml"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: "a", PartitionNames: []string{"p3"}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: "a", Expr: "pk >"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: "a", Expr: "unknown > 1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("reserved path", func(t *testing.T) {
+		c := newCore()
+		for _, exportPath := range []string{"../a", "a/../../b", "files/insert_log/a", "files/delta_log", "a/stats_log/b", "index_files"} {
+			resp, err := c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: exportPath})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode(), exportPath)
+		}
+	})
+
+	t.Run("snapshot out of retention", func(t *testing.T) {
+		alloc := newMockTsoAllocator()
+		alloc.GenerateTSOF = func(count uint32) (uint64, error) {
+			return tsoutil.ComposeTSByTime(time.Now(), 0), nil
+		}
+		c := newCore(withTsoAllocator(alloc))
+		retention := Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second)
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			Path:           "a",
+			Timestamp:      tsoutil.ComposeTSByTime(time.Now().Add(-retention-time.Minute), 0),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("snapshot in the future", func(t *testing.T) {
+		now := time.Now()
+		alloc := newMockTsoAllocator()
+		alloc.GenerateTSOF = func(count uint32) (uint64, error) {
+			return tsoutil.ComposeTSByTime(now, 0), nil
+		}
+		c := newCore(withTsoAllocator(alloc))
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			Path:           "a",
+			Timestamp:      tsoutil.ComposeTSByTime(now.Add(time.Minute), 0),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.Export(context.Background(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			Path:           "a",
+			Timestamp:      tsoutil.ComposeTSByTime(now.Add(-time.Second), 0),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to allocate timestamp", func(t *testing.T) {
+		c := newCore(withInvalidTsoAllocator())
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{CollectionName: "coll", Path: "a"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		alloc := newMockTsoAllocator()
+		alloc.GenerateTSOF = func(count uint32) (uint64, error) {
+			return 1000, nil
+		}
+		c := newCore(withTsoAllocator(alloc))
+		resp, err := c.Export(context.Background(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"p2"},
+			Path:           "a",
+			Format:         "Parquet",
+			Expr:           "pk > 10",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(100), resp.GetTaskId())
+
+		task := c.exportManager.getTask(100)
+		assert.Equal(t, []int64{11}, task.GetPartitionIds())
+		assert.Equal(t, storage.ExportFormatParquet, task.GetFormat())
+		assert.Equal(t, uint64(1000), task.GetTimestamp())
+		assert.Equal(t, "pk > 10", task.GetExpr())
+
+		state, err := c.GetExportState(context.Background(), &proxypb.GetExportStateRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+		assert.Equal(t, proxypb.ExportState_ExportPending, state.GetState())
+		assert.Equal(t, "coll", state.GetCollectionName())
+	})
+
+	t.Run("get state not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetExportState(context.Background(), &proxypb.GetExportStateRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestCore_ReportExport(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ReportExport(context.Background(), &rootcoordpb.ExportSegmentResult{TaskId: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("task not running", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.exportManager = newExportManager(context.Background(), memkv.NewMemoryKV(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resp, err := c.ReportExport(context.Background(), &rootcoordpb.ExportSegmentResult{TaskId: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.exportManager = newExportManager(context.Background(), memkv.NewMemoryKV(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		results := c.exportManager.registerResults(100, 1)
+		resp, err := c.ReportExport(context.Background(), &rootcoordpb.ExportSegmentResult{TaskId: 100, SegmentId: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, int64(1), (<-results).GetSegmentId())
+	})
+}
+
 func TestCore_ListImportTasks(t *testing.T) {
 	mockKv := memkv.NewMemoryKV()
 	ti1 := &datapb.ImportTaskInfo{
@@ -980,7 +1389,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 
 	t.Run("normal case", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			if collectionName == ti1.CollectionName {
 				return &model.Collection{
 					CollectionID: ti1.CollectionId,
@@ -995,7 +1404,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(), withMeta(meta))
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// list all tasks
 		resp, err := c.ListImportTasks(ctx, &milvuspb.ListImportTasksRequest{})
@@ -1086,7 +1495,7 @@ func TestCore_ReportImport(t *testing.T) {
 
 	ticker := newRocksMqTtSynchronizer()
 	meta := newMockMetaTable()
-	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 		return nil, errors.New("error mock GetCollectionByName")
 	}
 	meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
@@ -1160,7 +1569,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report complete import", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
 			TaskId: 100,
 			State:  commonpb.ImportState_ImportCompleted,
@@ -1175,7 +1584,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report complete import with task not found", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
 			TaskId: 101,
 			State:  commonpb.ImportState_ImportCompleted,
@@ -1187,7 +1596,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report import started state", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil)
 		c.importManager.loadFromTaskStore(true)
 		c.importManager.sendOutTasks(ctx)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
@@ -1196,6 +1605,8 @@ func TestCore_ReportImport(t *testing.T) {
 		})
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		// the DataNode is still busy with the task
+		assert.Equal(t, 1, len(c.importManager.busyNodes))
 		// Change the state back.
 		err = c.importManager.setImportTaskState(100, commonpb.ImportState_ImportPending)
 		assert.NoError(t, err)
@@ -1211,7 +1622,7 @@ func TestCore_ReportImport(t *testing.T) {
 			withDataCoord(dc))
 		c.broker = newServerBroker(c)
 		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil,
-			callDescribeIndex, nil, callUnsetIsImportingState)
+			callDescribeIndex, nil, callUnsetIsImportingState, nil)
 		c.importManager.loadFromTaskStore(true)
 		c.importManager.sendOutTasks(ctx)
 
@@ -57,7 +57,7 @@ func Test_showCollectionTask_Execute(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.ListCollectionsFunc = func(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+		meta.ListCollectionsFunc = func(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error) {
 			return []*model.Collection{
 				{
 					Name: "test coll",
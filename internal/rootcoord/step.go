@@ -148,6 +148,7 @@ func (s *changeCollectionStateStep) Desc() string {
 
 type expireCacheStep struct {
 	baseStep
+	dbName          string
 	collectionNames []string
 	collectionID    UniqueID
 	ts              Timestamp
@@ -155,13 +156,13 @@ type expireCacheStep struct {
 }
 
 func (s *expireCacheStep) Execute(ctx context.Context) ([]nestedStep, error) {
-	err := s.core.ExpireMetaCache(ctx, s.collectionNames, s.collectionID, s.ts, s.opts...)
+	err := s.core.ExpireMetaCache(ctx, s.dbName, s.collectionNames, s.collectionID, s.ts, s.opts...)
 	return nil, err
 }
 
 func (s *expireCacheStep) Desc() string {
-	return fmt.Sprintf("expire cache, collection id: %d, collection names: %s, ts: %d",
-		s.collectionID, s.collectionNames, s.ts)
+	return fmt.Sprintf("expire cache, database: %s, collection id: %d, collection names: %s, ts: %d",
+		s.dbName, s.collectionID, s.collectionNames, s.ts)
 }
 
 type deleteCollectionDataStep struct {
@@ -358,6 +359,24 @@ func (a *AlterCollectionStep) Desc() string {
 	return fmt.Sprintf("alter collection, collectionID: %d, ts: %d", a.oldColl.CollectionID, a.ts)
 }
 
+type renameCollectionStep struct {
+	baseStep
+	dbName  string
+	oldName string
+	newName string
+	ts      Timestamp
+}
+
+func (s *renameCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RenameCollection(ctx, s.dbName, s.oldName, s.newName, s.ts)
+	return nil, err
+}
+
+func (s *renameCollectionStep) Desc() string {
+	return fmt.Sprintf("rename collection, database: %s, old name: %s, new name: %s, ts: %d",
+		s.dbName, s.oldName, s.newName, s.ts)
+}
+
 type BroadcastAlteredCollectionStep struct {
 	baseStep
 	req  *milvuspb.AlterCollectionRequest
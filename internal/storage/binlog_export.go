@@ -0,0 +1,663 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/sbinet/npyio"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// SegmentBinlogPaths are the binlog files of one segment.
+// Insert logs of all fields are listed together, they are joined by row when reading.
+type SegmentBinlogPaths struct {
+	InsertLogs []string
+	DeltaLogs  []string
+	StatsLogs  []string
+}
+
+// SegmentData is a segment reconstructed from its binlogs.
+type SegmentData struct {
+	Schema *schemapb.CollectionSchema
+	Data   *InsertData
+	// Offsets are the offsets of the rows in Data which are not deleted by the delta logs
+	Offsets []int
+	Stats   []*PrimaryKeyStats
+}
+
+// RowNum returns the number of rows which are not deleted
+func (sd *SegmentData) RowNum() int {
+	return len(sd.Offsets)
+}
+
+// ReadSegmentData reads the binlogs of a segment by ChunkManager, joins the per-field insert binlogs
+// by row and filters out the rows deleted by the delta logs.
+func ReadSegmentData(ctx context.Context, cm ChunkManager, schema *schemapb.CollectionSchema, paths *SegmentBinlogPaths) (*SegmentData, error) {
+	return ReadSegmentDataAt(ctx, cm, schema, paths, typeutil.MaxTimestamp)
+}
+
+// ReadSegmentDataAt is ReadSegmentData at the snapshot of timestamp ts, the rows inserted after ts are
+// filtered out and the deletes after ts are ignored.
+func ReadSegmentDataAt(ctx context.Context, cm ChunkManager, schema *schemapb.CollectionSchema, paths *SegmentBinlogPaths, ts Timestamp) (*SegmentData, error) {
+	insertBlobs, err := readBlobs(ctx, cm, paths.InsertLogs)
+	if err != nil {
+		return nil, err
+	}
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{Schema: schema})
+	_, _, _, insertData, err := insertCodec.DeserializeAll(insertBlobs)
+	if err != nil {
+		return nil, err
+	}
+
+	rowNum := -1
+	for fieldID, fieldData := range insertData.Data {
+		if rowNum == -1 {
+			rowNum = fieldData.RowNum()
+		} else if rowNum != fieldData.RowNum() {
+			return nil, fmt.Errorf("row number of field %d is %d, not matched with other fields %d", fieldID, fieldData.RowNum(), rowNum)
+		}
+	}
+
+	offsets := make([]int, 0, rowNum)
+	if len(paths.DeltaLogs) == 0 && ts == typeutil.MaxTimestamp {
+		for i := 0; i < rowNum; i++ {
+			offsets = append(offsets, i)
+		}
+	} else {
+		offsets, err = filterDeletedRows(ctx, cm, schema, insertData, paths.DeltaLogs, ts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var stats []*PrimaryKeyStats
+	pkStatsLogs, err := filterPKStatsLogs(schema, paths.StatsLogs)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkStatsLogs) > 0 {
+		statsBlobs, err := readBlobs(ctx, cm, pkStatsLogs)
+		if err != nil {
+			return nil, err
+		}
+		stats, err = DeserializeStats(statsBlobs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SegmentData{
+		Schema:  schema,
+		Data:    insertData,
+		Offsets: offsets,
+		Stats:   stats,
+	}, nil
+}
+
+// filterPKStatsLogs returns the stats logs of the primary key, the other scalar fields have their own min/max stats.
+// The field ID is the parent directory of a stats log.
+func filterPKStatsLogs(schema *schemapb.CollectionSchema, statsLogs []string) ([]string, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(statsLogs))
+	for _, statsLog := range statsLogs {
+		fieldID, err := strconv.ParseInt(path.Base(path.Dir(statsLog)), 10, 64)
+		if err != nil || fieldID == pkField.GetFieldID() {
+			// keep the logs with unknown layout, they are regarded as pk stats as before
+			result = append(result, statsLog)
+		}
+	}
+	return result, nil
+}
+
+func readBlobs(ctx context.Context, cm ChunkManager, paths []string) ([]*Blob, error) {
+	values, err := cm.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*Blob, 0, len(paths))
+	for i, path := range paths {
+		blobs = append(blobs, &Blob{Key: path, Value: values[i]})
+	}
+	return blobs, nil
+}
+
+// filterDeletedRows returns the offsets of rows which are not deleted at snapshot ts, a row is deleted if
+// there is a delete of its primary key with larger timestamp, the rows and deletes after ts are skipped.
+func filterDeletedRows(ctx context.Context, cm ChunkManager, schema *schemapb.CollectionSchema, insertData *InsertData, deltaLogs []string, ts Timestamp) ([]int, error) {
+	deleted := make(map[interface{}]Timestamp)
+	if len(deltaLogs) > 0 {
+		deltaBlobs, err := readBlobs(ctx, cm, deltaLogs)
+		if err != nil {
+			return nil, err
+		}
+		_, _, deleteData, err := NewDeleteCodec().Deserialize(deltaBlobs)
+		if err != nil {
+			return nil, err
+		}
+		for i, pk := range deleteData.Pks {
+			if deleteData.Tss[i] > ts {
+				continue
+			}
+			if deleteTs, ok := deleted[pk.GetValue()]; !ok || deleteTs < deleteData.Tss[i] {
+				deleted[pk.GetValue()] = deleteData.Tss[i]
+			}
+		}
+	}
+
+	pkData, err := GetPkFromInsertData(schema, insertData)
+	if err != nil {
+		return nil, err
+	}
+	tsData, err := GetTimestampFromInsertData(insertData)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make([]int, 0, pkData.RowNum())
+	for i := 0; i < pkData.RowNum(); i++ {
+		if uint64(tsData.Data[i]) > ts {
+			continue
+		}
+		if deleteTs, ok := deleted[pkData.GetRow(i)]; ok && uint64(tsData.Data[i]) < deleteTs {
+			continue
+		}
+		offsets = append(offsets, i)
+	}
+	return offsets, nil
+}
+
+// exportFields returns the fields of schema which have data in the segment, in the order of schema
+func (sd *SegmentData) exportFields() []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(sd.Schema.GetFields())+2)
+	if _, ok := sd.Data.Data[common.RowIDField]; ok && !hasField(sd.Schema, common.RowIDField) {
+		fields = append(fields, &schemapb.FieldSchema{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64})
+	}
+	if _, ok := sd.Data.Data[common.TimeStampField]; ok && !hasField(sd.Schema, common.TimeStampField) {
+		fields = append(fields, &schemapb.FieldSchema{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64})
+	}
+	for _, field := range sd.Schema.GetFields() {
+		if _, ok := sd.Data.Data[field.GetFieldID()]; ok {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func hasField(schema *schemapb.CollectionSchema, fieldID int64) bool {
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == fieldID {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteSegmentDataCSV writes the segment data as csv with a header of field names,
+// vector, array and json values are written as json text.
+func WriteSegmentDataCSV(w io.Writer, sd *SegmentData) error {
+	fields := sd.exportFields()
+	writer := csv.NewWriter(w)
+
+	header := make([]string, 0, len(fields))
+	for _, field := range fields {
+		header = append(header, field.GetName())
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(fields))
+	for _, offset := range sd.Offsets {
+		for i, field := range fields {
+			value, err := formatFieldValue(field.GetDataType(), sd.Data.Data[field.GetFieldID()].GetRow(offset))
+			if err != nil {
+				return fmt.Errorf("failed to format value of field %s: %w", field.GetName(), err)
+			}
+			record[i] = value
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFieldValue(dataType schemapb.DataType, value interface{}) (string, error) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return strconv.FormatBool(value.(bool)), nil
+	case schemapb.DataType_Int8:
+		return strconv.FormatInt(int64(value.(int8)), 10), nil
+	case schemapb.DataType_Int16:
+		return strconv.FormatInt(int64(value.(int16)), 10), nil
+	case schemapb.DataType_Int32:
+		return strconv.FormatInt(int64(value.(int32)), 10), nil
+	case schemapb.DataType_Int64:
+		return strconv.FormatInt(value.(int64), 10), nil
+	case schemapb.DataType_Float:
+		return strconv.FormatFloat(float64(value.(float32)), 'g', -1, 32), nil
+	case schemapb.DataType_Double:
+		return strconv.FormatFloat(value.(float64), 'g', -1, 64), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value.(string), nil
+	case typeutil.DataTypeJSON:
+		return string(value.([]byte)), nil
+	case typeutil.DataTypeArray:
+		return marshalArrayValue(value.(*schemapb.ScalarField))
+	case schemapb.DataType_FloatVector:
+		bs, err := json.Marshal(value.([]float32))
+		return string(bs), err
+	case schemapb.DataType_BinaryVector:
+		// json encodes []byte as base64, write the bytes as numbers instead
+		vector := value.([]byte)
+		bytes := make([]int, 0, len(vector))
+		for _, b := range vector {
+			bytes = append(bytes, int(b))
+		}
+		bs, err := json.Marshal(bytes)
+		return string(bs), err
+	default:
+		return "", fmt.Errorf("unsupported data type %s", dataType.String())
+	}
+}
+
+func marshalArrayValue(value *schemapb.ScalarField) (string, error) {
+	bs, err := json.Marshal(arrayValueData(value))
+	return string(bs), err
+}
+
+func arrayValueData(value *schemapb.ScalarField) interface{} {
+	switch value.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return value.GetBoolData().GetData()
+	case *schemapb.ScalarField_IntData:
+		return value.GetIntData().GetData()
+	case *schemapb.ScalarField_LongData:
+		return value.GetLongData().GetData()
+	case *schemapb.ScalarField_FloatData:
+		return value.GetFloatData().GetData()
+	case *schemapb.ScalarField_DoubleData:
+		return value.GetDoubleData().GetData()
+	case *schemapb.ScalarField_StringData:
+		return value.GetStringData().GetData()
+	default:
+		return []interface{}{}
+	}
+}
+
+// WriteSegmentDataParquet writes the segment data as a parquet file, columns are named by field names.
+// Float vectors are written as list of float, binary vectors as fixed size binary, array and json values as json text.
+func WriteSegmentDataParquet(w io.Writer, sd *SegmentData) error {
+	fields := sd.exportFields()
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	for _, field := range fields {
+		arrowType, err := toArrowType(field)
+		if err != nil {
+			return err
+		}
+		arrowFields = append(arrowFields, arrow.Field{Name: field.GetName(), Type: arrowType})
+	}
+	arrowSchema := arrow.NewSchema(arrowFields, nil)
+
+	writer, err := pqarrow.NewFileWriter(arrowSchema, w, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), arrowSchema)
+	defer builder.Release()
+	// write the rows batch by batch, each batch is a row group, so that the arrow data of the whole segment is not held
+	for start := 0; start < len(sd.Offsets); start += parquetBatchRows {
+		end := start + parquetBatchRows
+		if end > len(sd.Offsets) {
+			end = len(sd.Offsets)
+		}
+		if err := writeParquetBatch(writer, builder, fields, sd, sd.Offsets[start:end]); err != nil {
+			writer.Close()
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+// parquetBatchRows is the number of rows of a row group written by WriteSegmentDataParquet
+const parquetBatchRows = 65536
+
+func writeParquetBatch(writer *pqarrow.FileWriter, builder *array.RecordBuilder, fields []*schemapb.FieldSchema, sd *SegmentData, offsets []int) error {
+	for i, field := range fields {
+		fieldData := sd.Data.Data[field.GetFieldID()]
+		for _, offset := range offsets {
+			if err := appendArrowValue(builder.Field(i), field.GetDataType(), fieldData.GetRow(offset)); err != nil {
+				return fmt.Errorf("failed to append value of field %s: %w", field.GetName(), err)
+			}
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	return writer.Write(record)
+}
+
+func toArrowType(field *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON, typeutil.DataTypeArray:
+		return arrow.BinaryTypes.String, nil
+	case schemapb.DataType_FloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &arrow.FixedSizeBinaryType{ByteWidth: int(dim / 8)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.GetDataType().String(), field.GetName())
+	}
+}
+
+func appendArrowValue(builder array.Builder, dataType schemapb.DataType, value interface{}) error {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		builder.(*array.BooleanBuilder).Append(value.(bool))
+	case schemapb.DataType_Int8:
+		builder.(*array.Int8Builder).Append(value.(int8))
+	case schemapb.DataType_Int16:
+		builder.(*array.Int16Builder).Append(value.(int16))
+	case schemapb.DataType_Int32:
+		builder.(*array.Int32Builder).Append(value.(int32))
+	case schemapb.DataType_Int64:
+		builder.(*array.Int64Builder).Append(value.(int64))
+	case schemapb.DataType_Float:
+		builder.(*array.Float32Builder).Append(value.(float32))
+	case schemapb.DataType_Double:
+		builder.(*array.Float64Builder).Append(value.(float64))
+	case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON, typeutil.DataTypeArray:
+		str, err := formatFieldValue(dataType, value)
+		if err != nil {
+			return err
+		}
+		builder.(*array.StringBuilder).Append(str)
+	case schemapb.DataType_FloatVector:
+		listBuilder := builder.(*array.ListBuilder)
+		listBuilder.Append(true)
+		listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues(value.([]float32), nil)
+	case schemapb.DataType_BinaryVector:
+		builder.(*array.FixedSizeBinaryBuilder).Append(value.([]byte))
+	default:
+		return fmt.Errorf("unsupported data type %s", dataType.String())
+	}
+	return nil
+}
+
+// The file formats of WriteSegmentFiles, they are the formats accepted by bulk insert
+const (
+	ExportFormatJSON    = "json"
+	ExportFormatNumpy   = "numpy"
+	ExportFormatParquet = "parquet"
+)
+
+// DropSystemFields removes the RowID and Timestamp fields from the segment data, so that the exported files
+// only contain user fields and can be bulk inserted into another collection.
+func (sd *SegmentData) DropSystemFields() {
+	delete(sd.Data.Data, common.RowIDField)
+	delete(sd.Data.Data, common.TimeStampField)
+}
+
+// WriteSegmentDataJSON writes the segment data as a row-based json document: {"rows": [{"field": value, ...}, ...]}.
+// Vectors and arrays are written as json lists, json values are embedded as they are.
+func WriteSegmentDataJSON(w io.Writer, sd *SegmentData) error {
+	fields := sd.exportFields()
+	writer := bufio.NewWriter(w)
+	if _, err := writer.WriteString(`{"rows":[`); err != nil {
+		return err
+	}
+
+	row := make(map[string]interface{}, len(fields))
+	for i, offset := range sd.Offsets {
+		for _, field := range fields {
+			value, err := jsonFieldValue(field.GetDataType(), sd.Data.Data[field.GetFieldID()].GetRow(offset))
+			if err != nil {
+				return fmt.Errorf("failed to format value of field %s: %w", field.GetName(), err)
+			}
+			row[field.GetName()] = value
+		}
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if err := writer.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := writer.Write(bs); err != nil {
+			return err
+		}
+	}
+
+	if _, err := writer.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+func jsonFieldValue(dataType schemapb.DataType, value interface{}) (interface{}, error) {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_VarChar, schemapb.DataType_FloatVector:
+		return value, nil
+	case typeutil.DataTypeJSON:
+		return json.RawMessage(value.([]byte)), nil
+	case typeutil.DataTypeArray:
+		return arrayValueData(value.(*schemapb.ScalarField)), nil
+	case schemapb.DataType_BinaryVector:
+		// json encodes []byte as base64, write the bytes as numbers instead
+		vector := value.([]byte)
+		numbers := make([]int, 0, len(vector))
+		for _, b := range vector {
+			numbers = append(numbers, int(b))
+		}
+		return numbers, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", dataType.String())
+	}
+}
+
+// WriteSegmentDataNumpy writes each field of the segment data as a numpy array, the result is keyed by field name.
+// Vectors are written as 2-D arrays, json and array fields are not supported by numpy format.
+func WriteSegmentDataNumpy(sd *SegmentData) (map[string][]byte, error) {
+	fields := sd.exportFields()
+	if err := checkNumpyFields(fields); err != nil {
+		return nil, err
+	}
+	result := make(map[string][]byte, len(fields))
+	for _, field := range fields {
+		buf := &bytes.Buffer{}
+		if err := writeFieldNumpy(buf, sd, field); err != nil {
+			return nil, err
+		}
+		result[field.GetName()] = buf.Bytes()
+	}
+	return result, nil
+}
+
+func checkNumpyFields(fields []*schemapb.FieldSchema) error {
+	for _, field := range fields {
+		if _, err := toNumpyElemType(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFieldNumpy writes one field of the segment data as a numpy array
+func writeFieldNumpy(w io.Writer, sd *SegmentData, field *schemapb.FieldSchema) error {
+	elemType, err := toNumpyElemType(field)
+	if err != nil {
+		return err
+	}
+
+	fieldData := sd.Data.Data[field.GetFieldID()]
+	values := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(sd.Offsets))
+	for _, offset := range sd.Offsets {
+		value := reflect.ValueOf(fieldData.GetRow(offset))
+		if elemType.Kind() == reflect.Array {
+			// vectors are written as fixed size arrays to get a 2-D numpy array
+			vector := reflect.New(elemType).Elem()
+			if reflect.Copy(vector, value) != elemType.Len() {
+				return fmt.Errorf("dimension of field %s is not matched with the schema", field.GetName())
+			}
+			value = vector
+		}
+		values = reflect.Append(values, value)
+	}
+
+	if err := npyio.Write(w, values.Interface()); err != nil {
+		return fmt.Errorf("failed to write numpy data of field %s: %w", field.GetName(), err)
+	}
+	return nil
+}
+
+func toNumpyElemType(field *schemapb.FieldSchema) (reflect.Type, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return reflect.TypeOf(false), nil
+	case schemapb.DataType_Int8:
+		return reflect.TypeOf(int8(0)), nil
+	case schemapb.DataType_Int16:
+		return reflect.TypeOf(int16(0)), nil
+	case schemapb.DataType_Int32:
+		return reflect.TypeOf(int32(0)), nil
+	case schemapb.DataType_Int64:
+		return reflect.TypeOf(int64(0)), nil
+	case schemapb.DataType_Float:
+		return reflect.TypeOf(float32(0)), nil
+	case schemapb.DataType_Double:
+		return reflect.TypeOf(float64(0)), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return reflect.TypeOf(""), nil
+	case schemapb.DataType_FloatVector:
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ArrayOf(int(dim), reflect.TypeOf(float32(0))), nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ArrayOf(int(dim/8), reflect.TypeOf(uint8(0))), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s by numpy format", field.GetDataType().String(), field.GetName())
+	}
+}
+
+// WriteSegmentFiles writes the segment data into files under dir by ChunkManager and returns the written files.
+// For json and parquet format the file is dir/name.json or dir/name.parquet,
+// for numpy format each field is written as dir/name/field.npy.
+// The files are streamed to the ChunkManager, the written files are removed if any of them fails.
+func WriteSegmentFiles(ctx context.Context, cm ChunkManager, sd *SegmentData, format string, dir string, name string) ([]string, error) {
+	writers := make(map[string]func(w io.Writer) error)
+	switch format {
+	case ExportFormatJSON:
+		writers[path.Join(dir, name+".json")] = func(w io.Writer) error {
+			return WriteSegmentDataJSON(w, sd)
+		}
+	case ExportFormatParquet:
+		writers[path.Join(dir, name+".parquet")] = func(w io.Writer) error {
+			return WriteSegmentDataParquet(w, sd)
+		}
+	case ExportFormatNumpy:
+		fields := sd.exportFields()
+		if err := checkNumpyFields(fields); err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			field := field
+			writers[path.Join(dir, name, field.GetName()+".npy")] = func(w io.Writer) error {
+				return writeFieldNumpy(w, sd, field)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown export format %s", format)
+	}
+
+	files := make([]string, 0, len(writers))
+	for file := range writers {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for i, file := range files {
+		if err := writeFileFrom(ctx, cm, file, writers[file]); err != nil {
+			if removeErr := cm.MultiRemove(ctx, files[:i+1]); removeErr != nil {
+				return nil, fmt.Errorf("%w, failed to remove the written files: %s", err, removeErr.Error())
+			}
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// writeFileFrom pipes the output of write to ChunkManager.WriteFrom, so that the file content is not buffered as a whole
+func writeFileFrom(ctx context.Context, cm ChunkManager, filePath string, write func(w io.Writer) error) error {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(write(writer))
+	}()
+	err := cm.WriteFrom(ctx, filePath, reader)
+	// unblock the writer if the ChunkManager stops reading before EOF
+	reader.CloseWithError(err)
+	return err
+}
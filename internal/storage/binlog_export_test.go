@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/sbinet/npyio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genExportTestSegment(t *testing.T, rootPath string) (*schemapb.CollectionSchema, *SegmentBinlogPaths) {
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.RowIDField:     &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			common.TimeStampField: &Int64FieldData{NumRows: []int64{3}, Data: []int64{10, 10, 30}},
+			100:                   &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			101:                   &StringFieldData{NumRows: []int64{3}, Data: []string{"a", "b", "c"}},
+			102:                   &FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{0, 1, 2, 3, 4, 5}, Dim: 2},
+		},
+	}
+	codec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: schema})
+	insertBlobs, statsBlobs, err := codec.Serialize(PartitionID, SegmentID, insertData)
+	require.NoError(t, err)
+
+	// pk 2 is deleted, the delete of pk 3 is older than the row
+	deleteData := &DeleteData{}
+	deleteData.Append(NewInt64PrimaryKey(2), 20)
+	deleteData.Append(NewInt64PrimaryKey(3), 20)
+	deltaBlob, err := NewDeleteCodec().Serialize(CollectionID, PartitionID, SegmentID, deleteData)
+	require.NoError(t, err)
+
+	contents := make(map[string][]byte)
+	paths := &SegmentBinlogPaths{}
+	for _, blob := range insertBlobs {
+		p := path.Join(rootPath, "insert_log", blob.Key, "1")
+		contents[p] = blob.Value
+		paths.InsertLogs = append(paths.InsertLogs, p)
+	}
+	for _, blob := range statsBlobs {
+		p := path.Join(rootPath, "stats_log", blob.Key, "1")
+		contents[p] = blob.Value
+		paths.StatsLogs = append(paths.StatsLogs, p)
+	}
+	deltaPath := path.Join(rootPath, "delta_log", "1")
+	contents[deltaPath] = deltaBlob.Value
+	paths.DeltaLogs = append(paths.DeltaLogs, deltaPath)
+
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	require.NoError(t, cm.MultiWrite(context.Background(), contents))
+	return schema, paths
+}
+
+func TestReadSegmentData(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+
+	sd, err := ReadSegmentData(context.Background(), cm, schema, paths)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sd.RowNum())
+	assert.Equal(t, []int{0, 2}, sd.Offsets)
+	assert.Equal(t, 1, len(sd.Stats))
+
+	paths.InsertLogs = append(paths.InsertLogs, path.Join(rootPath, "not_exist"))
+	_, err = ReadSegmentData(context.Background(), cm, schema, paths)
+	assert.Error(t, err)
+}
+
+func TestReadSegmentDataAt(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+
+	// the delete of pk 2 and the row of pk 3 are after the snapshot
+	sd, err := ReadSegmentDataAt(context.Background(), cm, schema, paths, 15)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, sd.Offsets)
+
+	sd, err = ReadSegmentDataAt(context.Background(), cm, schema, paths, 25)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, sd.Offsets)
+
+	paths.DeltaLogs = nil
+	sd, err = ReadSegmentDataAt(context.Background(), cm, schema, paths, 25)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, sd.Offsets)
+}
+
+func TestWriteSegmentDataCSV(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	sd, err := ReadSegmentData(context.Background(), cm, schema, paths)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteSegmentDataCSV(buf, sd))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, []string{
+		"RowID,Timestamp,pk,text,vector",
+		"1,10,1,a,\"[0,1]\"",
+		"3,30,3,c,\"[4,5]\"",
+	}, lines)
+}
+
+func TestWriteSegmentDataParquet(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	sd, err := ReadSegmentData(context.Background(), cm, schema, paths)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteSegmentDataParquet(buf, sd))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("PAR1")))
+
+	sd.Schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", DataType: schemapb.DataType_None}},
+	}
+	assert.Error(t, WriteSegmentDataParquet(&bytes.Buffer{}, sd))
+}
+
+func TestWriteSegmentDataJSON(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	sd, err := ReadSegmentData(context.Background(), cm, schema, paths)
+	require.NoError(t, err)
+	sd.DropSystemFields()
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteSegmentDataJSON(buf, sd))
+	assert.Equal(t, `{"rows":[{"pk":1,"text":"a","vector":[0,1]},{"pk":3,"text":"c","vector":[4,5]}]}`+"\n", buf.String())
+
+	sd.Offsets = nil
+	buf.Reset()
+	assert.NoError(t, WriteSegmentDataJSON(buf, sd))
+	assert.Equal(t, `{"rows":[]}`+"\n", buf.String())
+}
+
+func TestWriteSegmentDataNumpy(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	sd, err := ReadSegmentData(context.Background(), cm, schema, paths)
+	require.NoError(t, err)
+	sd.DropSystemFields()
+
+	arrays, err := WriteSegmentDataNumpy(sd)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(arrays))
+
+	var pks []int64
+	assert.NoError(t, npyio.Read(bytes.NewReader(arrays["pk"]), &pks))
+	assert.Equal(t, []int64{1, 3}, pks)
+	reader, err := npyio.NewReader(bytes.NewReader(arrays["vector"]))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2}, reader.Header.Descr.Shape)
+	var vectors []float32
+	assert.NoError(t, reader.Read(&vectors))
+	assert.Equal(t, []float32{0, 1, 4, 5}, vectors)
+
+	sd.Schema.Fields[3].DataType = typeutil.DataTypeJSON
+	_, err = WriteSegmentDataNumpy(sd)
+	assert.Error(t, err)
+}
+
+func TestWriteSegmentFiles(t *testing.T) {
+	rootPath := t.TempDir()
+	schema, paths := genExportTestSegment(t, rootPath)
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	ctx := context.Background()
+	sd, err := ReadSegmentData(ctx, cm, schema, paths)
+	require.NoError(t, err)
+	sd.DropSystemFields()
+
+	dir := path.Join(rootPath, "export")
+	files, err := WriteSegmentFiles(ctx, cm, sd, ExportFormatJSON, dir, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path.Join(dir, "1.json")}, files)
+	content, err := cm.Read(ctx, files[0])
+	assert.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteSegmentDataJSON(buf, sd))
+	assert.Equal(t, buf.Bytes(), content)
+
+	files, err = WriteSegmentFiles(ctx, cm, sd, ExportFormatParquet, dir, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path.Join(dir, "1.parquet")}, files)
+
+	files, err = WriteSegmentFiles(ctx, cm, sd, ExportFormatNumpy, dir, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path.Join(dir, "1", "pk.npy"), path.Join(dir, "1", "text.npy"), path.Join(dir, "1", "vector.npy")}, files)
+	for _, file := range files {
+		exist, err := cm.Exist(ctx, file)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+	}
+
+	_, err = WriteSegmentFiles(ctx, cm, sd, "csv", dir, "1")
+	assert.Error(t, err)
+
+	// the written files are removed if a later file fails
+	failedDir := path.Join(rootPath, "failed")
+	_, err = WriteSegmentFiles(ctx, &failedWriteChunkManager{LocalChunkManager: cm, failedFile: path.Join(failedDir, "1", "vector.npy")},
+		sd, ExportFormatNumpy, failedDir, "1")
+	assert.Error(t, err)
+	for _, file := range []string{"pk.npy", "text.npy", "vector.npy"} {
+		exist, err := cm.Exist(ctx, path.Join(failedDir, "1", file))
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	}
+}
+
+type failedWriteChunkManager struct {
+	*LocalChunkManager
+	failedFile string
+}
+
+func (cm *failedWriteChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader) error {
+	if filePath == cm.failedFile {
+		// read a part of the content to make sure the writer is unblocked
+		if _, err := reader.Read(make([]byte, 1)); err != nil {
+			return err
+		}
+		return errors.New("mock write error")
+	}
+	return cm.LocalChunkManager.WriteFrom(ctx, filePath, reader)
+}
+
+func TestFormatFieldValue(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		value    interface{}
+		expect   string
+	}{
+		{schemapb.DataType_Bool, true, "true"},
+		{schemapb.DataType_Int8, int8(1), "1"},
+		{schemapb.DataType_Int16, int16(2), "2"},
+		{schemapb.DataType_Int32, int32(3), "3"},
+		{schemapb.DataType_Float, float32(1.5), "1.5"},
+		{schemapb.DataType_Double, 2.5, "2.5"},
+		{schemapb.DataType_BinaryVector, []byte{1, 255}, "[1,255]"},
+		{schemapb.DataType_FloatVector, []float32{1, 2}, "[1,2]"},
+		{typeutil.DataTypeJSON, []byte(`{"a":1}`), `{"a":1}`},
+		{typeutil.DataTypeArray, newLongArray(1, 2), "[1,2]"},
+	}
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			value, err := formatFieldValue(c.dataType, c.value)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expect, value)
+		})
+	}
+
+	_, err := formatFieldValue(schemapb.DataType_None, nil)
+	assert.Error(t, err)
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -141,6 +142,14 @@ type StringFieldData struct {
 	NumRows []int64
 	Data    []string
 }
+type JSONFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+}
+type ArrayFieldData struct {
+	NumRows []int64
+	Data    []*schemapb.ScalarField
+}
 type BinaryVectorFieldData struct {
 	NumRows []int64
 	Data    []byte
@@ -161,6 +170,8 @@ func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
 func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
 func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int         { return len(data.Data) }
+func (data *ArrayFieldData) RowNum() int        { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
 
@@ -173,6 +184,8 @@ func (data *Int64FieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *FloatFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *DoubleFieldData) GetRow(i int) interface{} { return data.Data[i] }
 func (data *StringFieldData) GetRow(i int) interface{} { return data.Data[i] }
+func (data *JSONFieldData) GetRow(i int) interface{}   { return data.Data[i] }
+func (data *ArrayFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -222,6 +235,22 @@ func (data *StringFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data)
 }
 
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows)
+	for _, doc := range data.Data {
+		size += len(doc)
+	}
+	return size
+}
+
+func (data *ArrayFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows)
+	for _, row := range data.Data {
+		size += proto.Size(row)
+	}
+	return size
+}
+
 func (data *BinaryVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
@@ -383,6 +412,26 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case typeutil.DataTypeJSON:
+			for _, singleJSON := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+		case typeutil.DataTypeArray:
+			for _, singleArray := range singleData.(*ArrayFieldData).Data {
+				err = eventWriter.AddOneArrayToPayload(singleArray)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			if err != nil {
@@ -442,6 +491,18 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				Value:  statsBuffer,
 				RowNum: rowNum,
 			})
+		} else if !common.IsSystemField(field.FieldID) && SupportFieldStats(field.DataType) && typeutil.IsFieldStatsEnabled(field) {
+			// min/max of the scalar fields enabling stats, used to skip the segments which can't match the filter
+			statsWriter := &StatsWriter{}
+			err = statsWriter.GenerateFieldStats(field.FieldID, field.DataType, singleData, typeutil.IsBloomFilterField(field))
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:    blobKey,
+				Value:  statsWriter.GetBuffer(),
+				RowNum: rowNum,
+			})
 		}
 	}
 
@@ -669,6 +730,48 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				stringFieldData.NumRows = append(stringFieldData.NumRows, int64(len(stringPayload)))
 				insertData.Data[fieldID] = stringFieldData
 
+			case typeutil.DataTypeJSON:
+				jsonPayload, err := eventReader.GetJSONFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &JSONFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([][]byte, 0, rowNum),
+					}
+				}
+				jsonFieldData := insertData.Data[fieldID].(*JSONFieldData)
+
+				jsonFieldData.Data = append(jsonFieldData.Data, jsonPayload...)
+				totalLength += len(jsonPayload)
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(len(jsonPayload)))
+				insertData.Data[fieldID] = jsonFieldData
+
+			case typeutil.DataTypeArray:
+				arrayPayload, err := eventReader.GetArrayFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &ArrayFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([]*schemapb.ScalarField, 0, rowNum),
+					}
+				}
+				arrayFieldData := insertData.Data[fieldID].(*ArrayFieldData)
+
+				arrayFieldData.Data = append(arrayFieldData.Data, arrayPayload...)
+				totalLength += len(arrayPayload)
+				arrayFieldData.NumRows = append(arrayFieldData.NumRows, int64(len(arrayPayload)))
+				insertData.Data[fieldID] = arrayFieldData
+
 			case schemapb.DataType_BinaryVector:
 				var singleData []byte
 				singleData, dim, err = eventReader.GetBinaryVectorFromPayload()
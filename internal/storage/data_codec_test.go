@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -44,8 +47,16 @@ const (
 	StringField       = 107
 	BinaryVectorField = 108
 	FloatVectorField  = 109
+	JSONField         = 110
+	ArrayField        = 111
 )
 
+func newLongArray(values ...int64) *schemapb.ScalarField {
+	return &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+	}
+}
+
 func TestInsertCodec(t *testing.T) {
 	schema := &etcdpb.CollectionMeta{
 		ID:            CollectionID,
@@ -141,6 +152,23 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      JSONField,
+					Name:         "field_json",
+					IsPrimaryKey: false,
+					Description:  "json",
+					DataType:     typeutil.DataTypeJSON,
+				},
+				{
+					FieldID:      ArrayField,
+					Name:         "field_array",
+					IsPrimaryKey: false,
+					Description:  "array",
+					DataType:     typeutil.DataTypeArray,
+					TypeParams: []*commonpb.KeyValuePair{
+						{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+					},
+				},
 			},
 		},
 	}
@@ -197,6 +225,14 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"a":3}`), []byte(`{"a":4}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows: []int64{2},
+				Data:    []*schemapb.ScalarField{newLongArray(5), newLongArray(6, 7)},
+			},
 		},
 	}
 
@@ -252,6 +288,14 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"a":1}`), []byte(`{"a":2}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows: []int64{2},
+				Data:    []*schemapb.ScalarField{newLongArray(1, 2), newLongArray()},
+			},
 		},
 	}
 
@@ -269,6 +313,8 @@ func TestInsertCodec(t *testing.T) {
 			StringField:       &StringFieldData{[]int64{}, []string{}},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
+			JSONField:         &JSONFieldData{[]int64{}, [][]byte{}},
+			ArrayField:        &ArrayFieldData{[]int64{}, []*schemapb.ScalarField{}},
 		},
 	}
 	b, s, err := insertCodec.Serialize(PartitionID, SegmentID, insertDataEmpty)
@@ -306,6 +352,8 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[JSONField].(*JSONFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[ArrayField].(*ArrayFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -318,6 +366,13 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{[]byte(`{"a":1}`), []byte(`{"a":2}`), []byte(`{"a":3}`), []byte(`{"a":4}`)}, resultData.Data[JSONField].(*JSONFieldData).Data)
+	arrays := resultData.Data[ArrayField].(*ArrayFieldData).Data
+	assert.Equal(t, 4, len(arrays))
+	assert.Equal(t, []int64{1, 2}, arrays[0].GetLongData().GetData())
+	assert.Empty(t, arrays[1].GetLongData().GetData())
+	assert.Equal(t, []int64{5}, arrays[2].GetLongData().GetData())
+	assert.Equal(t, []int64{6, 7}, arrays[3].GetLongData().GetData())
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
 
@@ -334,6 +389,49 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInsertCodecFieldStats(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{
+					FieldID:    Int32Field,
+					Name:       "field_int32",
+					DataType:   schemapb.DataType_Int32,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldStatsKey, Value: "true"}},
+				},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double},
+			},
+		},
+	}
+	insertCodec := NewInsertCodec(schema)
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			Int32Field:     &Int32FieldData{NumRows: []int64{2}, Data: []int32{3, 4}},
+			DoubleField:    &DoubleFieldData{NumRows: []int64{2}, Data: []float64{5, 6}},
+		},
+	}
+
+	_, statsBlobs, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.NoError(t, err)
+	// pk stats and the min/max of the field enabling stats only
+	assert.Equal(t, 2, len(statsBlobs))
+	assert.Equal(t, fmt.Sprintf("%d", Int64Field), statsBlobs[0].GetKey())
+	assert.Equal(t, fmt.Sprintf("%d", Int32Field), statsBlobs[1].GetKey())
+
+	fieldStats, err := DeserializeFieldStats(statsBlobs[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(fieldStats))
+	assert.Equal(t, int64(Int32Field), fieldStats[0].FieldID)
+}
+
 func TestDeleteCodec(t *testing.T) {
 	t.Run("int64 pk", func(t *testing.T) {
 		deleteCodec := NewDeleteCodec()
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// DoubleFieldValue is the comparable value of the float and double fields, it's only used by the field stats
+// since the floating point fields can't be primary key.
+type DoubleFieldValue struct {
+	Value float64
+}
+
+var _ PrimaryKey = (*DoubleFieldValue)(nil)
+
+func NewDoubleFieldValue(v float64) *DoubleFieldValue {
+	return &DoubleFieldValue{
+		Value: v,
+	}
+}
+
+func (dv *DoubleFieldValue) GT(key PrimaryKey) bool {
+	v, ok := key.(*DoubleFieldValue)
+	if !ok {
+		log.Warn("type of compared value is not double")
+		return false
+	}
+	return dv.Value > v.Value
+}
+
+func (dv *DoubleFieldValue) GE(key PrimaryKey) bool {
+	v, ok := key.(*DoubleFieldValue)
+	if !ok {
+		log.Warn("type of compared value is not double")
+		return false
+	}
+	return dv.Value >= v.Value
+}
+
+func (dv *DoubleFieldValue) LT(key PrimaryKey) bool {
+	v, ok := key.(*DoubleFieldValue)
+	if !ok {
+		log.Warn("type of compared value is not double")
+		return false
+	}
+	return dv.Value < v.Value
+}
+
+func (dv *DoubleFieldValue) LE(key PrimaryKey) bool {
+	v, ok := key.(*DoubleFieldValue)
+	if !ok {
+		log.Warn("type of compared value is not double")
+		return false
+	}
+	return dv.Value <= v.Value
+}
+
+func (dv *DoubleFieldValue) EQ(key PrimaryKey) bool {
+	v, ok := key.(*DoubleFieldValue)
+	if !ok {
+		log.Warn("type of compared value is not double")
+		return false
+	}
+	return dv.Value == v.Value
+}
+
+func (dv *DoubleFieldValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dv.Value)
+}
+
+func (dv *DoubleFieldValue) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &dv.Value)
+}
+
+func (dv *DoubleFieldValue) SetValue(data interface{}) error {
+	value, ok := data.(float64)
+	if !ok {
+		return fmt.Errorf("wrong type value when setValue for DoubleFieldValue")
+	}
+
+	dv.Value = value
+	return nil
+}
+
+func (dv *DoubleFieldValue) Type() schemapb.DataType {
+	return schemapb.DataType_Double
+}
+
+func (dv *DoubleFieldValue) GetValue() interface{} {
+	return dv.Value
+}
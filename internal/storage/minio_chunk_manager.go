@@ -45,6 +45,9 @@ const (
 	CloudProviderAWS = "aws"
 )
 
+// writeFromPartSize is the part size to upload an object of unknown size, which is 640GB at most by 10000 parts.
+const writeFromPartSize = 64 * 1024 * 1024
+
 func WrapErrNoSuchKey(key string) error {
 	return fmt.Errorf("%w(key=%s)", ErrNoSuchKey, key)
 }
@@ -196,6 +199,17 @@ func (mcm *MinioChunkManager) Write(ctx context.Context, filePath string, conten
 	return nil
 }
 
+// WriteFrom saves an object read from the reader, the object is uploaded by parts of writeFromPartSize
+// as the size is unknown.
+func (mcm *MinioChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader) error {
+	_, err := mcm.Client.PutObject(ctx, mcm.bucketName, filePath, reader, -1, minio.PutObjectOptions{PartSize: writeFromPartSize})
+	if err != nil {
+		log.Warn("failed to put object", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
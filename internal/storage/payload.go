@@ -29,6 +29,7 @@ import (
 	"reflect"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -45,6 +46,8 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
+	AddOneArrayToPayload(msg *schemapb.ScalarField) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -66,6 +69,8 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetStringFromPayload() ([]string, error)
+	GetJSONFromPayload() ([][]byte, error)
+	GetArrayFromPayload() ([]*schemapb.ScalarField, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
 	GetPayloadLengthFromReader() (int, error)
@@ -87,6 +92,9 @@ func NewPayloadWriter(colType schemapb.DataType, dim ...int) (*PayloadWriter, er
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
 		w = C.NewVectorPayloadWriter(C.int(colType), C.int(dim[0]))
+	} else if typeutil.IsJSONType(colType) || typeutil.IsArrayType(colType) {
+		// JSON documents and marshaled arrays are stored as a string column
+		w = C.NewPayloadWriter(C.int(schemapb.DataType_VarChar))
 	} else {
 		w = C.NewPayloadWriter(C.int(colType))
 	}
@@ -149,6 +157,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case typeutil.DataTypeJSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
+		case typeutil.DataTypeArray:
+			val, ok := msgs.(*schemapb.ScalarField)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneArrayToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -289,6 +309,32 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return HandleCStatus(&status, "AddOneStringToPayload failed")
 }
 
+// AddOneJSONToPayload adds one JSON document into payload
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	cmsg := (*C.char)(C.CBytes(msg))
+	clength := C.int(length)
+	defer C.free(unsafe.Pointer(cmsg))
+
+	status := C.AddOneStringToPayload(w.payloadWriterPtr, cmsg, clength)
+	return HandleCStatus(&status, "AddOneJSONToPayload failed")
+}
+
+// AddOneArrayToPayload adds one array into payload, the array is stored in its protobuf encoding
+func (w *PayloadWriter) AddOneArrayToPayload(msg *schemapb.ScalarField) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	length := len(data)
+	cmsg := (*C.char)(C.CBytes(data))
+	clength := C.int(length)
+	defer C.free(unsafe.Pointer(cmsg))
+
+	status := C.AddOneStringToPayload(w.payloadWriterPtr, cmsg, clength)
+	return HandleCStatus(&status, "AddOneArrayToPayload failed")
+}
+
 // AddBinaryVectorToPayload dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
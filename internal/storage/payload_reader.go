@@ -8,8 +8,10 @@ import (
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/parquet"
 	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -66,6 +68,12 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.DataTypeJSON:
+		val, err := r.GetJSONFromPayload()
+		return val, 0, err
+	case typeutil.DataTypeArray:
+		val, err := r.GetArrayFromPayload()
+		return val, 0, err
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -254,6 +262,52 @@ func (r *PayloadReader) GetStringFromPayload() ([]string, error) {
 	return ret, nil
 }
 
+// GetJSONFromPayload returns JSON documents from payload.
+func (r *PayloadReader) GetJSONFromPayload() ([][]byte, error) {
+	if !typeutil.IsJSONType(r.colType) {
+		return nil, fmt.Errorf("failed to get json from datatype %v", typeutil.DataTypeName(r.colType))
+	}
+	return r.getByteArraysFromPayload()
+}
+
+// GetArrayFromPayload returns arrays from payload.
+func (r *PayloadReader) GetArrayFromPayload() ([]*schemapb.ScalarField, error) {
+	if !typeutil.IsArrayType(r.colType) {
+		return nil, fmt.Errorf("failed to get array from datatype %v", typeutil.DataTypeName(r.colType))
+	}
+
+	values, err := r.getByteArraysFromPayload()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*schemapb.ScalarField, len(values))
+	for i, value := range values {
+		ret[i] = &schemapb.ScalarField{}
+		if err := proto.Unmarshal(value, ret[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *PayloadReader) getByteArraysFromPayload() ([][]byte, error) {
+	values := make([]parquet.ByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([][]byte, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = append([]byte{}, values[i]...)
+	}
+	return ret, nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
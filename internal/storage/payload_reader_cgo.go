@@ -12,9 +12,11 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReaderCgo reads data from payload
@@ -27,7 +29,12 @@ func NewPayloadReaderCgo(colType schemapb.DataType, buf []byte) (*PayloadReaderC
 	if len(buf) == 0 {
 		return nil, errors.New("create Payload reader failed, buffer is empty")
 	}
-	r := C.NewPayloadReader(C.int(colType), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.int64_t(len(buf)))
+	cColType := colType
+	if typeutil.IsJSONType(colType) || typeutil.IsArrayType(colType) {
+		// JSON documents and marshaled arrays are stored as a string column
+		cColType = schemapb.DataType_VarChar
+	}
+	r := C.NewPayloadReader(C.int(cColType), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.int64_t(len(buf)))
 	if r == nil {
 		return nil, errors.New("failed to read parquet from buffer")
 	}
@@ -71,6 +78,12 @@ func (r *PayloadReaderCgo) GetDataFromPayload() (interface{}, int, error) {
 	case schemapb.DataType_String:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.DataTypeJSON:
+		val, err := r.GetJSONFromPayload()
+		return val, 0, err
+	case typeutil.DataTypeArray:
+		val, err := r.GetArrayFromPayload()
+		return val, 0, err
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -253,6 +266,51 @@ func (r *PayloadReaderCgo) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+func (r *PayloadReaderCgo) GetJSONFromPayload() ([][]byte, error) {
+	if !typeutil.IsJSONType(r.colType) {
+		return nil, errors.New("incorrect data type")
+	}
+	return r.getByteArraysFromPayload()
+}
+
+func (r *PayloadReaderCgo) GetArrayFromPayload() ([]*schemapb.ScalarField, error) {
+	if !typeutil.IsArrayType(r.colType) {
+		return nil, errors.New("incorrect data type")
+	}
+
+	values, err := r.getByteArraysFromPayload()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*schemapb.ScalarField, len(values))
+	for i, value := range values {
+		ret[i] = &schemapb.ScalarField{}
+		if err := proto.Unmarshal(value, ret[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *PayloadReaderCgo) getByteArraysFromPayload() ([][]byte, error) {
+	length, err := r.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([][]byte, length)
+	for i := 0; i < length; i++ {
+		var cStr *C.char
+		var cSize C.int
+
+		status := C.GetOneStringFromPayload(r.payloadReaderPtr, C.int(i), &cStr, &cSize)
+		if err := HandleCStatus(&status, "GetOneStringFromPayload failed"); err != nil {
+			return nil, err
+		}
+		ret[i] = C.GoBytes(unsafe.Pointer(cStr), cSize)
+	}
+	return ret, nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReaderCgo) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestPayload_ReaderAndWriter(t *testing.T) {
@@ -332,6 +333,96 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		w.ReleasePayloadWriter()
 	})
 
+	t.Run("TestAddJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeJSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"a":1}`))
+		assert.Nil(t, err)
+		err = w.AddOneJSONToPayload([]byte(`{"b":{"c":"x"}}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`[1,2]`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload("{}")
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeJSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+
+		docs, err := r.GetJSONFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{"a":1}`), []byte(`{"b":{"c":"x"}}`), []byte(`[1,2]`)}, docs)
+
+		idocs, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, docs, idocs.([][]byte))
+
+		_, err = r.GetStringFromPayload()
+		assert.NotNil(t, err)
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
+	t.Run("TestAddArray", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeArray)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		arrays := []*schemapb.ScalarField{
+			{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{}}}},
+			{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}}},
+		}
+		err = w.AddOneArrayToPayload(arrays[0])
+		assert.Nil(t, err)
+		err = w.AddOneArrayToPayload(arrays[1])
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(arrays[2])
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]int64{1})
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeArray, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+
+		values, err := r.GetArrayFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(values))
+		assert.Equal(t, []int64{1, 2, 3}, values[0].GetLongData().GetData())
+		assert.Empty(t, values[1].GetLongData().GetData())
+		assert.Equal(t, []string{"a", "b"}, values[2].GetStringData().GetData())
+
+		ivalues, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, len(values), len(ivalues.([]*schemapb.ScalarField)))
+
+		_, err = r.GetJSONFromPayload()
+		assert.NotNil(t, err)
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector, 8)
 		require.Nil(t, err)
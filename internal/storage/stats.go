@@ -18,6 +18,7 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -135,6 +136,145 @@ func (stats *PrimaryKeyStats) updatePk(pk PrimaryKey) {
 	}
 }
 
+// FieldStats contains the min/max statistics data of a scalar column, and the optional bloom filter of the values
+type FieldStats struct {
+	FieldID int64              `json:"fieldID"`
+	Type    int64              `json:"type"`
+	Max     PrimaryKey         `json:"max"`
+	Min     PrimaryKey         `json:"min"`
+	BF      *bloom.BloomFilter `json:"bf,omitempty"`
+}
+
+// UnmarshalJSON unmarshal bytes to FieldStats
+func (stats *FieldStats) UnmarshalJSON(data []byte) error {
+	var messageMap map[string]*json.RawMessage
+	err := json.Unmarshal(data, &messageMap)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(*messageMap["fieldID"], &stats.FieldID)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(*messageMap["type"], &stats.Type)
+	if err != nil {
+		return err
+	}
+
+	switch schemapb.DataType(stats.Type) {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		stats.Max = &Int64PrimaryKey{}
+		stats.Min = &Int64PrimaryKey{}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		stats.Max = &DoubleFieldValue{}
+		stats.Min = &DoubleFieldValue{}
+	case schemapb.DataType_VarChar:
+		stats.Max = &VarCharPrimaryKey{}
+		stats.Min = &VarCharPrimaryKey{}
+	default:
+		return fmt.Errorf("unsupported field stats type: %s", schemapb.DataType(stats.Type).String())
+	}
+
+	if bfMessage, ok := messageMap["bf"]; ok && bfMessage != nil && string(*bfMessage) != "null" {
+		stats.BF = &bloom.BloomFilter{}
+		err = stats.BF.UnmarshalJSON(*bfMessage)
+		if err != nil {
+			return err
+		}
+	}
+
+	// min and max are null if the stats is generated from empty field data
+	if maxMessage, ok := messageMap["max"]; ok && maxMessage != nil && string(*maxMessage) != "null" {
+		err = json.Unmarshal(*maxMessage, stats.Max)
+		if err != nil {
+			return err
+		}
+	} else {
+		stats.Max = nil
+	}
+
+	if minMessage, ok := messageMap["min"]; ok && minMessage != nil && string(*minMessage) != "null" {
+		err = json.Unmarshal(*minMessage, stats.Min)
+		if err != nil {
+			return err
+		}
+	} else {
+		stats.Min = nil
+	}
+
+	return nil
+}
+
+// update updates min and max value
+func (stats *FieldStats) update(value PrimaryKey) {
+	if stats.Min == nil || stats.Min.GT(value) {
+		stats.Min = value
+	}
+	if stats.Max == nil || stats.Max.LT(value) {
+		stats.Max = value
+	}
+}
+
+// Merge widens the min/max range to cover the other stats of the same field,
+// the bloom filter is dropped if the filters can't be merged
+func (stats *FieldStats) Merge(other *FieldStats) {
+	if stats.BF != nil && (other.BF == nil || stats.BF.Merge(other.BF) != nil) {
+		stats.BF = nil
+	}
+	if other.Min != nil {
+		stats.update(other.Min)
+	}
+	if other.Max != nil {
+		stats.update(other.Max)
+	}
+}
+
+// MayContain returns false only if the stats prove that @value doesn't exist in the column,
+// the value must be of the same type of min/max.
+func (stats *FieldStats) MayContain(value PrimaryKey) bool {
+	if stats.Min == nil || stats.Max == nil {
+		return false
+	}
+	if stats.Min.GT(value) || stats.Max.LT(value) {
+		return false
+	}
+	if stats.BF == nil {
+		return true
+	}
+	switch v := value.(type) {
+	case *Int64PrimaryKey:
+		buf := make([]byte, 8)
+		common.Endian.PutUint64(buf, uint64(v.Value))
+		return stats.BF.Test(buf)
+	case *VarCharPrimaryKey:
+		return stats.BF.TestString(v.Value)
+	}
+	return true
+}
+
+// SupportFieldStats returns true if the min/max statistics of the data type can be recorded
+func SupportFieldStats(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportFieldBloomFilter returns true if the bloom filter of the data type can be recorded
+func SupportFieldBloomFilter(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
 // StatsWriter writes stats to buffer
 type StatsWriter struct {
 	buffer []byte
@@ -193,6 +333,74 @@ func (sw *StatsWriter) GeneratePrimaryKeyStats(fieldID int64, pkType schemapb.Da
 	return nil
 }
 
+// GenerateFieldStats writes the min/max statistics of @msgs with @fieldID to @buffer,
+// the bloom filter of the values is also written if @withBloomFilter is true.
+func (sw *StatsWriter) GenerateFieldStats(fieldID int64, dataType schemapb.DataType, msgs FieldData, withBloomFilter bool) error {
+	stats := &FieldStats{
+		FieldID: fieldID,
+		Type:    int64(dataType),
+	}
+	if withBloomFilter {
+		if !SupportFieldBloomFilter(dataType) {
+			return fmt.Errorf("unsupported field bloom filter type: %s", dataType.String())
+		}
+		stats.BF = bloom.NewWithEstimates(uint(msgs.RowNum()), MaxBloomFalsePositive)
+	}
+
+	buf := make([]byte, 8)
+	addInt64 := func(value int64) {
+		stats.update(NewInt64PrimaryKey(value))
+		if stats.BF != nil {
+			common.Endian.PutUint64(buf, uint64(value))
+			stats.BF.Add(buf)
+		}
+	}
+
+	switch dataType {
+	case schemapb.DataType_Int8:
+		for _, value := range msgs.(*Int8FieldData).Data {
+			addInt64(int64(value))
+		}
+	case schemapb.DataType_Int16:
+		for _, value := range msgs.(*Int16FieldData).Data {
+			addInt64(int64(value))
+		}
+	case schemapb.DataType_Int32:
+		for _, value := range msgs.(*Int32FieldData).Data {
+			addInt64(int64(value))
+		}
+	case schemapb.DataType_Int64:
+		for _, value := range msgs.(*Int64FieldData).Data {
+			addInt64(value)
+		}
+	case schemapb.DataType_Float:
+		for _, value := range msgs.(*FloatFieldData).Data {
+			stats.update(NewDoubleFieldValue(float64(value)))
+		}
+	case schemapb.DataType_Double:
+		for _, value := range msgs.(*DoubleFieldData).Data {
+			stats.update(NewDoubleFieldValue(value))
+		}
+	case schemapb.DataType_VarChar:
+		for _, value := range msgs.(*StringFieldData).Data {
+			stats.update(NewVarCharPrimaryKey(value))
+			if stats.BF != nil {
+				stats.BF.AddString(value)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported field stats type: %s", dataType.String())
+	}
+
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 // StatsReader reads stats
 type StatsReader struct {
 	buffer []byte
@@ -214,6 +422,17 @@ func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
 	return stats, nil
 }
 
+// GetFieldStats returns buffer as FieldStats
+func (sr *StatsReader) GetFieldStats() (*FieldStats, error) {
+	stats := &FieldStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // DeserializeStats deserialize @blobs as []*PrimaryKeyStats
 func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
 	results := make([]*PrimaryKeyStats, 0, len(blobs))
@@ -231,3 +450,21 @@ func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
 	}
 	return results, nil
 }
+
+// DeserializeFieldStats deserialize @blobs as []*FieldStats
+func DeserializeFieldStats(blobs []*Blob) ([]*FieldStats, error) {
+	results := make([]*FieldStats, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		sr := &StatsReader{}
+		sr.SetBuffer(blob.Value)
+		stats, err := sr.GetFieldStats()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, stats)
+	}
+	return results, nil
+}
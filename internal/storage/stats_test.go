@@ -156,3 +156,109 @@ func TestStatsWriter_UpgradePrimaryKey(t *testing.T) {
 		assert.True(t, unmarshaledStats.BF.Test(buffer))
 	}
 }
+
+func TestStatsWriter_FieldStats(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(101, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{5, -3, 9, 2}}, false)
+		assert.NoError(t, err)
+
+		sr := &StatsReader{}
+		sr.SetBuffer(sw.GetBuffer())
+		stats, err := sr.GetFieldStats()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), stats.FieldID)
+		assert.True(t, stats.Min.EQ(NewInt64PrimaryKey(-3)))
+		assert.True(t, stats.Max.EQ(NewInt64PrimaryKey(9)))
+	})
+
+	t.Run("int32", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(101, schemapb.DataType_Int32, &Int32FieldData{Data: []int32{7, 1}}, false)
+		assert.NoError(t, err)
+
+		stats, err := DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(stats))
+		assert.True(t, stats[0].Min.EQ(NewInt64PrimaryKey(1)))
+		assert.True(t, stats[0].Max.EQ(NewInt64PrimaryKey(7)))
+	})
+
+	t.Run("varchar", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(102, schemapb.DataType_VarChar, &StringFieldData{Data: []string{"b", "a", "c"}}, false)
+		assert.NoError(t, err)
+
+		stats, err := DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.True(t, stats[0].Min.EQ(NewVarCharPrimaryKey("a")))
+		assert.True(t, stats[0].Max.EQ(NewVarCharPrimaryKey("c")))
+	})
+
+	t.Run("float", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(103, schemapb.DataType_Float, &FloatFieldData{Data: []float32{1.5, -2.5, 0}}, false)
+		assert.NoError(t, err)
+
+		stats, err := DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.True(t, stats[0].Min.EQ(NewDoubleFieldValue(-2.5)))
+		assert.True(t, stats[0].Max.EQ(NewDoubleFieldValue(1.5)))
+		assert.Nil(t, stats[0].BF)
+		assert.True(t, stats[0].MayContain(NewDoubleFieldValue(1)))
+		assert.False(t, stats[0].MayContain(NewDoubleFieldValue(2)))
+
+		err = sw.GenerateFieldStats(103, schemapb.DataType_Float, &FloatFieldData{Data: []float32{1.5}}, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("bloom filter", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(101, schemapb.DataType_Int16, &Int16FieldData{Data: []int16{1, 100, 50}}, true)
+		assert.NoError(t, err)
+		stats, err := DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.NotNil(t, stats[0].BF)
+		for _, v := range []int64{1, 100, 50} {
+			assert.True(t, stats[0].MayContain(NewInt64PrimaryKey(v)))
+		}
+		assert.False(t, stats[0].MayContain(NewInt64PrimaryKey(101)))
+
+		err = sw.GenerateFieldStats(102, schemapb.DataType_VarChar, &StringFieldData{Data: []string{"a", "c"}}, true)
+		assert.NoError(t, err)
+		stats, err = DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.True(t, stats[0].MayContain(NewVarCharPrimaryKey("a")))
+		assert.True(t, stats[0].MayContain(NewVarCharPrimaryKey("c")))
+	})
+
+	t.Run("empty data", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(101, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{}}, false)
+		assert.NoError(t, err)
+
+		stats, err := DeserializeFieldStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.Nil(t, stats[0].Min)
+		assert.Nil(t, stats[0].Max)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		sw := &StatsWriter{}
+		err := sw.GenerateFieldStats(101, schemapb.DataType_FloatVector, &FloatVectorFieldData{}, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		stats := &FieldStats{FieldID: 101, Type: int64(schemapb.DataType_Int64)}
+		stats.Merge(&FieldStats{Min: NewInt64PrimaryKey(3), Max: NewInt64PrimaryKey(8)})
+		stats.Merge(&FieldStats{Min: NewInt64PrimaryKey(-1), Max: NewInt64PrimaryKey(5)})
+		stats.Merge(&FieldStats{})
+		assert.True(t, stats.Min.EQ(NewInt64PrimaryKey(-1)))
+		assert.True(t, stats.Max.EQ(NewInt64PrimaryKey(8)))
+
+		stats.BF = bloom.NewWithEstimates(10, MaxBloomFalsePositive)
+		stats.Merge(&FieldStats{BF: bloom.NewWithEstimates(100, MaxBloomFalsePositive)})
+		assert.Nil(t, stats.BF)
+	})
+}
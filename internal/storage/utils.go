@@ -550,6 +550,33 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeJSON:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+
+			fieldData := &JSONFieldData{
+				NumRows: []int64{int64(msg.NumRows)},
+				Data:    make([][]byte, 0, len(srcData)),
+			}
+
+			fieldData.Data = append(fieldData.Data, srcData...)
+			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeArray:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+
+			fieldData := &ArrayFieldData{
+				NumRows: []int64{int64(msg.NumRows)},
+				Data:    make([]*schemapb.ScalarField, 0, len(srcData)),
+			}
+
+			for _, row := range srcData {
+				array := &schemapb.ScalarField{}
+				if err := proto.Unmarshal(row, array); err != nil {
+					log.Error("failed to unmarshal array", zap.Int64("fieldID", field.FieldID), zap.Error(err))
+					return nil, err
+				}
+				fieldData.Data = append(fieldData.Data, array)
+			}
+			idata.Data[field.FieldID] = fieldData
 		}
 	}
 
@@ -667,6 +694,32 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+func mergeJSONField(data *InsertData, fid FieldID, field *JSONFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &JSONFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*JSONFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeArrayField(data *InsertData, fid FieldID, field *ArrayFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &ArrayFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*ArrayFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
 func mergeBinaryVectorField(data *InsertData, fid FieldID, field *BinaryVectorFieldData) {
 	if _, ok := data.Data[fid]; !ok {
 		fieldData := &BinaryVectorFieldData{
@@ -717,6 +770,10 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeDoubleField(data, fid, field)
 	case *StringFieldData:
 		mergeStringField(data, fid, field)
+	case *JSONFieldData:
+		mergeJSONField(data, fid, field)
+	case *ArrayFieldData:
+		mergeArrayField(data, fid, field)
 	case *BinaryVectorFieldData:
 		mergeBinaryVectorField(data, fid, field)
 	case *FloatVectorFieldData:
@@ -808,6 +865,32 @@ func stringFieldDataToPbBytes(field *StringFieldData) ([]byte, error) {
 	return proto.Marshal(arr)
 }
 
+func jsonFieldDataToPbBytes(field *JSONFieldData) ([]byte, error) {
+	arr := &schemapb.BytesArray{Data: field.Data}
+	return proto.Marshal(arr)
+}
+
+func arrayFieldDataToPbBytes(field *ArrayFieldData) ([]byte, error) {
+	rows, err := marshalArrayRows(field.Data)
+	if err != nil {
+		return nil, err
+	}
+	arr := &schemapb.BytesArray{Data: rows}
+	return proto.Marshal(arr)
+}
+
+func marshalArrayRows(arrays []*schemapb.ScalarField) ([][]byte, error) {
+	rows := make([][]byte, 0, len(arrays))
+	for _, array := range arrays {
+		row, err := proto.Marshal(array)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := binary.Write(buf, endian, data)
@@ -822,6 +905,8 @@ func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 // For binary vector, return it directly.
 // For bool data, first transfer to schemapb.BoolArray and then marshal it. (TODO: handle bool like other scalar data.)
 // For variable-length data, such as string, first transfer to schemapb.StringArray and then marshal it.
+// For JSON data, first transfer to schemapb.BytesArray and then marshal it.
+// For array data, marshal each row and then marshal them as a schemapb.BytesArray.
 // TODO: find a proper way to store variable-length data. Or we should unify to use protobuf?
 func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, error) {
 	switch field := fieldData.(type) {
@@ -830,6 +915,10 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 		return boolFieldDataToPbBytes(field)
 	case *StringFieldData:
 		return stringFieldDataToPbBytes(field)
+	case *JSONFieldData:
+		return jsonFieldDataToPbBytes(field)
+	case *ArrayFieldData:
+		return arrayFieldDataToPbBytes(field)
 	case *BinaryVectorFieldData:
 		return field.Data, nil
 	case *FloatVectorFieldData:
@@ -976,6 +1065,38 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *JSONFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeJSON,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: rawData.Data,
+							},
+						},
+					},
+				},
+			}
+		case *ArrayFieldData:
+			rows, err := marshalArrayRows(rawData.Data)
+			if err != nil {
+				return insertRecord, err
+			}
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeArray,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: rows,
+							},
+						},
+					},
+				},
+			}
 		case *FloatVectorFieldData:
 			fieldData = &schemapb.FieldData{
 				Type:    schemapb.DataType_FloatVector,
@@ -154,6 +154,11 @@ func (vcm *VectorChunkManager) Write(ctx context.Context, filePath string, conte
 	return vcm.vectorStorage.Write(ctx, filePath, content)
 }
 
+// WriteFrom writes the vector data read from reader to local cache if cache enabled.
+func (vcm *VectorChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader) error {
+	return vcm.vectorStorage.WriteFrom(ctx, filePath, reader)
+}
+
 // MultiWrite writes the vector data to local cache if cache enabled.
 func (vcm *VectorChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	return vcm.vectorStorage.MultiWrite(ctx, contents)
@@ -40,7 +40,7 @@ type TimeTickProvider interface {
 // If Limit function return true, the request will be rejected.
 // Otherwise, the request will pass. Limit also returns limit of limiter.
 type Limiter interface {
-	Limit(rt internalpb.RateType, n int) (bool, float64)
+	Limit(collectionID int64, partitionID int64, rt internalpb.RateType, n int) (bool, float64)
 }
 
 // Component is the interface all services implement
@@ -91,6 +91,16 @@ type DataNode interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error)
 
+	// ExportSegment writes the entities of a flushed segment visible at a timestamp into files on MinIO/S3 storage,
+	// the result is reported to RootCoord
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the segment binlogs, the target path and format
+	//
+	// Return status indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*commonpb.Status, error)
+
 	// ResendSegmentStats resend un-flushed segment stats back upstream to DataCoord by resending DataNode time tick message.
 	// It returns a list of segments to be sent.
 	ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error)
@@ -311,6 +321,16 @@ type DataCoord interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 
+	// ExportSegment sends a segment of an export task to a free DataNode
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the segment to export and the DataNodes working on the task
+	//
+	// The `Status` in response struct `ExportSegmentResponse` indicates if this operation is processed successfully or fail cause;
+	// the `datanode_id` in `ExportSegmentResponse` return the id of the DataNode which takes the segment.
+	// error is always nil
+	ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest) (*datapb.ExportSegmentResponse, error)
+
 	// UpdateSegmentStatistics updates a segment's stats.
 	UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	// UpdateChannelCheckpoint updates channel checkpoint in dataCoord.
@@ -458,6 +478,36 @@ type RootCoord interface {
 	Component
 	TimeTickProvider
 
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *proxypb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database, the database should be empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *proxypb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases list all the databases, including the default database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` and `CreatedTimestamp` in response hold the names and the creation time of the databases.
+	// error is always nil
+	ListDatabases(ctx context.Context, req *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error)
+
 	// CreateCollection notifies RootCoord to create a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -635,6 +685,16 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// RenameCollection notifies RootCoord to rename a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old collection name and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, req *proxypb.RenameCollectionRequest) (*commonpb.Status, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -756,6 +816,35 @@ type RootCoord interface {
 	// error is always nil
 	ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error)
 
+	// Export writes the entities of a collection visible at a timestamp to data files on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, partition names, output path, file format and timestamp
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error)
+
+	// GetExportState checks export task state
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `state` in `GetExportStateResponse` return the state of the export task.
+	// error is always nil
+	GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error)
+
+	// ReportExport reports the result of a segment of an export task to rootCoord
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the export result of the segment, including the written files and row count
+	//
+	// response status contains the status/error code and failing reason if any error is returned
+	// error is always nil
+	ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult) (*commonpb.Status, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -890,6 +979,36 @@ type ProxyComponent interface {
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
 
+	// CreateDatabase notifies Proxy to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, request *proxypb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database, the database should be empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, request *proxypb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies Proxy to list all the databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` and `CreatedTimestamp` in response hold the names and the creation time of the databases.
+	// error is always nil
+	ListDatabases(ctx context.Context, request *proxypb.ListDatabasesRequest) (*proxypb.ListDatabasesResponse, error)
+
 	// CreateCollection notifies Proxy to create a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1136,6 +1255,20 @@ type ProxyComponent interface {
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace rows by primary key
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The rows with the same primary keys are deleted and the new rows are inserted with a single timestamp,
+	// so there is no point in time at which the old rows are deleted but the new rows are not visible.
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `SuccIndex` in `MutationResult` return the succeed number of upserted rows.
+	// the `ErrIndex` in `MutationResult` return the failed number of upsert rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *proxypb.UpsertRequest) (*milvuspb.MutationResult, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1251,6 +1384,17 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// RenameCollection notifies Proxy to rename a collection, the aliases of the collection are kept
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old collection name and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, request *proxypb.RenameCollectionRequest) (*commonpb.Status, error)
+
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
@@ -1287,6 +1431,26 @@ type ProxyComponent interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// Export writes the entities of a collection visible at a timestamp to data files(json, numpy or parquet) on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, partition names, output path, file format and timestamp
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *proxypb.ExportRequest) (*proxypb.ExportResponse, error)
+
+	// Check export task state
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `state` in `GetExportStateResponse` return the state of the export task, the exported files and row count.
+	// error is always nil
+	GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*proxypb.GetExportStateResponse, error)
+
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
 	// CreateCredential create new user and password
@@ -24,8 +24,9 @@ import (
 
 // Meta Prefix consts
 const (
-	MetaStoreTypeEtcd  = "etcd"
-	MetaStoreTypeMysql = "mysql"
+	MetaStoreTypeEtcd   = "etcd"
+	MetaStoreTypeMysql  = "mysql"
+	MetaStoreTypeSQLite = "sqlite"
 
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
@@ -50,6 +51,14 @@ const (
 
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
+
+	// DefaultDBName is the database which collections belong to when no database is specified.
+	DefaultDBName = "default"
+	// DefaultDBID is the id of the default database, collections created before databases
+	// were introduced carry no database id and thus belong to the default database.
+	DefaultDBID = int64(0)
+	// DBObjectSeparator separates the database name and the object name of a grant object, like `db1.col1`.
+	DBObjectSeparator = "."
 )
 
 const (
@@ -31,7 +31,7 @@ func NewFactory(standAlone bool) *DefaultFactory {
 // Init create a msg factory(TODO only support one mq at the same time.)
 // In order to guarantee backward compatibility of config file, we still support multiple mq configs.
 // 1. Rocksmq only run on local mode, and it has the highest priority
-// 2. Pulsar has higher priority than Kafka within remote msg
+// 2. Pulsar has higher priority than Kafka within remote msg, and Kafka has higher priority than Nats
 func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	// skip if using default factory
 	if f.msgStreamFactory != nil {
@@ -50,7 +50,7 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 
 	f.msgStreamFactory = f.initMQRemoteService(params)
 	if f.msgStreamFactory == nil {
-		panic("no available remote mq configuration, must config Pulsar or Kafka at least one of these!")
+		panic("no available remote mq configuration, must config Pulsar, Kafka or Nats at least one of these!")
 	}
 }
 
@@ -65,7 +65,7 @@ func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) m
 	return nil
 }
 
-// initRemoteService Pulsar has higher priority than Kafka.
+// initRemoteService Pulsar has higher priority than Kafka, and Kafka has higher priority than Nats.
 func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam) msgstream.Factory {
 	if params.PulsarEnable() {
 		return msgstream.NewPmsFactory(&params.PulsarCfg)
@@ -75,6 +75,10 @@ func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam)
 		return msgstream.NewKmsFactory(&params.KafkaCfg)
 	}
 
+	if params.NatsEnable() {
+		return msgstream.NewNmsFactory(&params.NatsCfg)
+	}
+
 	return nil
 }
 
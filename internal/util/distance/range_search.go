@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// RadiusKey is the key of the outer bound of a range search in search params
+	RadiusKey = "radius"
+	// RangeFilterKey is the key of the inner bound of a range search in search params
+	RangeFilterKey = "range_filter"
+)
+
+// RangeSearchParams holds the bounds of a range search.
+// For IP, a result is kept when radius < distance <= range_filter,
+// for other metrics, a result is kept when range_filter <= distance < radius.
+type RangeSearchParams struct {
+	Radius         float64
+	RangeFilter    float64
+	HasRangeFilter bool
+
+	positivelyRelated bool
+}
+
+func parseRangeValue(key string, value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64: // for numeric values, json unmarshal will interpret it as float64
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s [%s] is invalid", key, v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("%s [%v] is invalid, should be a number", key, value)
+	}
+}
+
+// ParseRangeSearchParams parses radius and range_filter from the json encoded search params.
+// It returns nil if radius is not specified, which means the search is a plain top-k search.
+func ParseRangeSearchParams(searchParams string, metricType string) (*RangeSearchParams, error) {
+	if searchParams == "" {
+		return nil, nil
+	}
+	paramsMap := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParams), &paramsMap); err != nil {
+		return nil, fmt.Errorf("search params in wrong format:%w", err)
+	}
+
+	radiusValue, hasRadius := paramsMap[RadiusKey]
+	rangeFilterValue, hasRangeFilter := paramsMap[RangeFilterKey]
+	if !hasRadius {
+		if hasRangeFilter {
+			return nil, fmt.Errorf("%s must be specified together with %s", RangeFilterKey, RadiusKey)
+		}
+		return nil, nil
+	}
+
+	ret := &RangeSearchParams{
+		HasRangeFilter:    hasRangeFilter,
+		positivelyRelated: PositivelyRelated(metricType),
+	}
+	var err error
+	if ret.Radius, err = parseRangeValue(RadiusKey, radiusValue); err != nil {
+		return nil, err
+	}
+	if !hasRangeFilter {
+		return ret, nil
+	}
+	if ret.RangeFilter, err = parseRangeValue(RangeFilterKey, rangeFilterValue); err != nil {
+		return nil, err
+	}
+	if ret.positivelyRelated && ret.RangeFilter <= ret.Radius {
+		return nil, fmt.Errorf("%s [%v] must be greater than %s [%v] for metric type %s",
+			RangeFilterKey, ret.RangeFilter, RadiusKey, ret.Radius, metricType)
+	}
+	if !ret.positivelyRelated && ret.RangeFilter >= ret.Radius {
+		return nil, fmt.Errorf("%s [%v] must be less than %s [%v] for metric type %s",
+			RangeFilterKey, ret.RangeFilter, RadiusKey, ret.Radius, metricType)
+	}
+	return ret, nil
+}
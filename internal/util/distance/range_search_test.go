@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeSearchParams(t *testing.T) {
+	t.Run("not range search", func(t *testing.T) {
+		params, err := ParseRangeSearchParams("", L2)
+		assert.NoError(t, err)
+		assert.Nil(t, params)
+
+		params, err = ParseRangeSearchParams(`{"nprobe": 10}`, L2)
+		assert.NoError(t, err)
+		assert.Nil(t, params)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		invalidParams := []string{
+			`{"nprobe": 10`,
+			`{"range_filter": 1.0}`,
+			`{"radius": "abc"}`,
+			`{"radius": [1]}`,
+			`{"radius": 1.0, "range_filter": "abc"}`,
+		}
+		for _, p := range invalidParams {
+			_, err := ParseRangeSearchParams(p, L2)
+			assert.Error(t, err, p)
+		}
+
+		_, err := ParseRangeSearchParams(`{"radius": 1.0, "range_filter": 2.0}`, L2)
+		assert.Error(t, err)
+		_, err = ParseRangeSearchParams(`{"radius": 2.0, "range_filter": 1.0}`, IP)
+		assert.Error(t, err)
+		_, err = ParseRangeSearchParams(`{"radius": 1.0, "range_filter": 1.0}`, IP)
+		assert.Error(t, err)
+	})
+
+	t.Run("L2", func(t *testing.T) {
+		params, err := ParseRangeSearchParams(`{"nprobe": 10, "radius": 2.0, "range_filter": "1.0"}`, L2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, params.Radius)
+		assert.Equal(t, 1.0, params.RangeFilter)
+		assert.True(t, params.HasRangeFilter)
+
+		params, err = ParseRangeSearchParams(`{"radius": 2.0}`, L2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, params.Radius)
+		assert.False(t, params.HasRangeFilter)
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		params, err := ParseRangeSearchParams(`{"radius": 0.2, "range_filter": 0.8}`, "ip")
+		assert.NoError(t, err)
+		assert.Equal(t, 0.2, params.Radius)
+		assert.Equal(t, 0.8, params.RangeFilter)
+		assert.True(t, params.HasRangeFilter)
+
+		params, err = ParseRangeSearchParams(`{"radius": 0.2}`, IP)
+		assert.NoError(t, err)
+		assert.False(t, params.HasRangeFilter)
+	})
+}
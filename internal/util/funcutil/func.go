@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
@@ -380,6 +381,15 @@ func IsEmptyString(str string) bool {
 	return strings.TrimSpace(str) == ""
 }
 
+// DBNameOrDefault returns the default database name if the database name is not specified,
+// the requests from clients which are not aware of database work on the default database.
+func DBNameOrDefault(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
+
 func HandleTenantForEtcdKey(prefix string, tenant string, key string) string {
 	res := prefix
 	if tenant != "" {
@@ -30,6 +30,7 @@ import (
 	"github.com/jarcoal/httpmock"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -509,6 +510,11 @@ func TestIsEmptyString(t *testing.T) {
 	assert.Equal(t, IsEmptyString("hello"), false)
 }
 
+func TestDBNameOrDefault(t *testing.T) {
+	assert.Equal(t, util.DefaultDBName, DBNameOrDefault(""))
+	assert.Equal(t, "db1", DBNameOrDefault("db1"))
+}
+
 func TestHandleTenantForEtcdKey(t *testing.T) {
 	assert.Equal(t, "a/b/c", HandleTenantForEtcdKey("a", "b", "c"))
 
@@ -97,3 +97,9 @@ func PolicyForPrivilege(roleName string, objectType string, objectName string, p
 func PolicyForResource(objectType string, objectName string) string {
 	return fmt.Sprintf("%s-%s", objectType, objectName)
 }
+
+// CombineObjectName returns the name of the object scoped by the database, so that a grant can target
+// all the objects of a database with a pattern like "db1.*".
+func CombineObjectName(dbName string, objectName string) string {
+	return DBNameOrDefault(dbName) + util.DBObjectSeparator + objectName
+}
@@ -23,13 +23,13 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
-	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
@@ -179,8 +179,8 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(c.ClientMaxSendSize),
 			),
-			grpc.WithUnaryInterceptor(grpcopentracing.UnaryClientInterceptor(opts...)),
-			grpc.WithStreamInterceptor(grpcopentracing.StreamClientInterceptor(opts...)),
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor(opts...)),
+			grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor(opts...)),
 			grpc.WithDefaultServiceConfig(retryPolicy),
 			grpc.WithKeepaliveParams(keepalive.ClientParameters{
 				Time:                c.KeepAliveTime,
@@ -209,8 +209,8 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(c.ClientMaxSendSize),
 			),
-			grpc.WithUnaryInterceptor(grpcopentracing.UnaryClientInterceptor(opts...)),
-			grpc.WithStreamInterceptor(grpcopentracing.StreamClientInterceptor(opts...)),
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor(opts...)),
+			grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor(opts...)),
 			grpc.WithDefaultServiceConfig(retryPolicy),
 			grpc.WithKeepaliveParams(keepalive.ClientParameters{
 				Time:                c.KeepAliveTime,
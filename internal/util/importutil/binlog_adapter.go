@@ -727,6 +727,26 @@ func (p *BinlogAdapter) readInsertlog(fieldID storage.FieldID, logPath string,
 		if err != nil {
 			return err
 		}
+	case typeutil.DataTypeJSON:
+		data, err := binlogFile.ReadJSON()
+		if err != nil {
+			return err
+		}
+
+		err = p.dispatchJSONToShards(data, memoryData, shardList, fieldID)
+		if err != nil {
+			return err
+		}
+	case typeutil.DataTypeArray:
+		data, err := binlogFile.ReadArray()
+		if err != nil {
+			return err
+		}
+
+		err = p.dispatchArrayToShards(data, memoryData, shardList, fieldID)
+		if err != nil {
+			return err
+		}
 	case schemapb.DataType_BinaryVector:
 		data, dim, err := binlogFile.ReadBinaryVector()
 		if err != nil {
@@ -947,6 +967,54 @@ func (p *BinlogAdapter) dispatchVarcharToShards(data []string, memoryData []map[
 	return nil
 }
 
+func (p *BinlogAdapter) dispatchJSONToShards(data [][]byte, memoryData []map[storage.FieldID]storage.FieldData,
+	shardList []int32, fieldID storage.FieldID) error {
+	// verify row count
+	if len(data) != len(shardList) {
+		log.Error("Binlog adapter: JSON field row count is not equal to shard list row count", zap.Int("dataLen", len(data)), zap.Int("shardLen", len(shardList)))
+		return fmt.Errorf("JSON field row count %d is not equal to shard list row count %d", len(data), len(shardList))
+	}
+
+	// dispatch entities acoording to shard list
+	for i, val := range data {
+		shardID := shardList[i]
+		if shardID < 0 {
+			continue // this entity has been deleted or excluded by timestamp
+		}
+
+		fields := memoryData[shardID] // initSegmentData() can ensure the existence, no need to check bound here
+		field := fields[fieldID]      // initSegmentData() can ensure the existence, no need to check existence here
+		field.(*storage.JSONFieldData).Data = append(field.(*storage.JSONFieldData).Data, val)
+		field.(*storage.JSONFieldData).NumRows[0]++
+	}
+
+	return nil
+}
+
+func (p *BinlogAdapter) dispatchArrayToShards(data []*schemapb.ScalarField, memoryData []map[storage.FieldID]storage.FieldData,
+	shardList []int32, fieldID storage.FieldID) error {
+	// verify row count
+	if len(data) != len(shardList) {
+		log.Error("Binlog adapter: array field row count is not equal to shard list row count", zap.Int("dataLen", len(data)), zap.Int("shardLen", len(shardList)))
+		return fmt.Errorf("array field row count %d is not equal to shard list row count %d", len(data), len(shardList))
+	}
+
+	// dispatch entities acoording to shard list
+	for i, val := range data {
+		shardID := shardList[i]
+		if shardID < 0 {
+			continue // this entity has been deleted or excluded by timestamp
+		}
+
+		fields := memoryData[shardID] // initSegmentData() can ensure the existence, no need to check bound here
+		field := fields[fieldID]      // initSegmentData() can ensure the existence, no need to check existence here
+		field.(*storage.ArrayFieldData).Data = append(field.(*storage.ArrayFieldData).Data, val)
+		field.(*storage.ArrayFieldData).NumRows[0]++
+	}
+
+	return nil
+}
+
 func (p *BinlogAdapter) dispatchBinaryVecToShards(data []byte, dim int, memoryData []map[storage.FieldID]storage.FieldData,
 	shardList []int32, fieldID storage.FieldID) error {
 	// verify row count
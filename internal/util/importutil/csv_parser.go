@@ -0,0 +1,552 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// stop parsing a csv file once this count of invalid rows are found
+	MaxCSVRowErrors = 100
+
+	// byte order mark might be written at the beginning of a csv file by some editors
+	utf8BOM = "\ufeff"
+)
+
+var (
+	// errEmptyValue is returned if a cell of non-string field is empty
+	errEmptyValue = errors.New("value is empty")
+	// errDimensionMismatch is returned if the size of a vector doesn't equal to the dimension
+	errDimensionMismatch = errors.New("dimension mismatch")
+)
+
+// CSVRowError describes an invalid row of a csv file, the Field is empty if the whole row is invalid
+type CSVRowError struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+
+	err error // the original error of the cell
+}
+
+func (e *CSVRowError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("file '%s' line %d: %s", e.File, e.Line, e.Reason)
+	}
+	return fmt.Sprintf("file '%s' line %d field '%s': %s", e.File, e.Line, e.Field, e.Reason)
+}
+
+func (e *CSVRowError) Unwrap() error {
+	return e.err
+}
+
+// CSVRowErrors is returned by CSVParser if any row of the file is invalid, at most MaxCSVRowErrors rows are reported
+type CSVRowErrors []*CSVRowError
+
+func (e CSVRowErrors) Error() string {
+	if len(e) == 0 {
+		return "no invalid row"
+	}
+	return fmt.Sprintf("found %d invalid rows, the first one is at %s", len(e), e[0].Error())
+}
+
+// JSON returns the invalid rows in JSON format, which is reported in the import task infos
+func (e CSVRowErrors) JSON() string {
+	bs, err := json.Marshal(e)
+	if err != nil {
+		return e.Error()
+	}
+	return string(bs)
+}
+
+// csvConvertFunc converts a cell to the value accepted by the Validator of JSONRowConsumer
+type csvConvertFunc func(cell string) (interface{}, error)
+
+type CSVParser struct {
+	ctx        context.Context                    // for canceling parse process
+	options    CSVOptions                         // delimiter, quoting and vector encoding
+	bufSize    int64                              // max rows in a buffer
+	fields     map[string]*schemapb.FieldSchema   // fields need to be parsed, the column names are field names
+	converters map[storage.FieldID]csvConvertFunc // methods to convert cells of each field
+
+	// if it is set, the invalid rows are passed to it and skipped instead of failing the parse, for dry-run import
+	invalidRowFunc func(rowErrors []*CSVRowError)
+}
+
+// NewCSVParser is helper function to create a CSVParser
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, options CSVOptions) (*CSVParser, error) {
+	if collectionSchema == nil {
+		log.Error("CSV parser: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+	if options.Delimiter == 0 {
+		options.Delimiter = ','
+	}
+	if options.Quoting == "" {
+		options.Quoting = CSVQuotingStandard
+	}
+	if options.VectorEncoding == "" {
+		options.VectorEncoding = CSVVectorJSON
+	}
+
+	fields := make(map[string]*schemapb.FieldSchema)
+	converters := make(map[storage.FieldID]csvConvertFunc)
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		// if primary key field is auto-gernerated, no need to parse
+		if schema.GetAutoID() {
+			continue
+		}
+
+		convertFunc, err := initCSVConvertFunc(schema, options.VectorEncoding)
+		if err != nil {
+			log.Error("CSV parser: failed to initialize converter", zap.String("fieldName", schema.GetName()), zap.Error(err))
+			return nil, err
+		}
+		if schema.GetDataType() != schemapb.DataType_String && schema.GetDataType() != schemapb.DataType_VarChar {
+			convertFunc = notEmpty(convertFunc)
+		}
+		fields[schema.GetName()] = schema
+		converters[schema.GetFieldID()] = convertFunc
+	}
+
+	parser := &CSVParser{
+		ctx:        ctx,
+		options:    options,
+		bufSize:    MinBufferSize,
+		fields:     fields,
+		converters: converters,
+	}
+	if bufSize := estimateBufSize(collectionSchema); bufSize > 0 {
+		parser.bufSize = bufSize
+	}
+
+	return parser, nil
+}
+
+// notEmpty rejects the empty cells of non-string fields
+func notEmpty(convertFunc csvConvertFunc) csvConvertFunc {
+	return func(cell string) (interface{}, error) {
+		if strings.TrimSpace(cell) == "" {
+			return nil, errEmptyValue
+		}
+		return convertFunc(cell)
+	}
+}
+
+// initCSVConvertFunc constructs the method to convert cells of a field, the cells are fully validated here
+// so that the row errors can be reported with line number and field name
+func initCSVConvertFunc(schema *schemapb.FieldSchema, vectorEncoding string) (csvConvertFunc, error) {
+	parseInt := func(bitSize int) csvConvertFunc {
+		return func(cell string) (interface{}, error) {
+			cell = strings.TrimSpace(cell)
+			if _, err := strconv.ParseInt(cell, 0, bitSize); err != nil {
+				return nil, fmt.Errorf("illegal value '%s' for int%d type, error: %w", cell, bitSize, err)
+			}
+			return json.Number(cell), nil
+		}
+	}
+	parseFloatNumber := func(bitSize int) csvConvertFunc {
+		return func(cell string) (interface{}, error) {
+			cell = strings.TrimSpace(cell)
+			if _, err := parseFloat(cell, bitSize, schema.GetName()); err != nil {
+				return nil, err
+			}
+			return json.Number(cell), nil
+		}
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		return func(cell string) (interface{}, error) {
+			value, err := strconv.ParseBool(strings.TrimSpace(cell))
+			if err != nil {
+				return nil, fmt.Errorf("illegal value '%s' for bool type", cell)
+			}
+			return value, nil
+		}, nil
+	case schemapb.DataType_Int8:
+		return parseInt(8), nil
+	case schemapb.DataType_Int16:
+		return parseInt(16), nil
+	case schemapb.DataType_Int32:
+		return parseInt(32), nil
+	case schemapb.DataType_Int64:
+		return parseInt(64), nil
+	case schemapb.DataType_Float:
+		return parseFloatNumber(32), nil
+	case schemapb.DataType_Double:
+		return parseFloatNumber(64), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return func(cell string) (interface{}, error) {
+			return cell, nil
+		}, nil
+	case typeutil.DataTypeJSON:
+		return func(cell string) (interface{}, error) {
+			if !json.Valid([]byte(cell)) {
+				return nil, fmt.Errorf("illegal value '%s' for JSON type, not a valid JSON document", cell)
+			}
+			return cell, nil
+		}, nil
+	case typeutil.DataTypeArray:
+		if err := typeutil.ValidateArrayField(schema); err != nil {
+			return nil, err
+		}
+		elementType, _ := typeutil.GetElementType(schema)
+		return func(cell string) (interface{}, error) {
+			arr, err := decodeCSVJSONArray(cell)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := parseArray(elementType, arr, schema.GetName()); err != nil {
+				return nil, err
+			}
+			return arr, nil
+		}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		if vectorEncoding == CSVVectorBase64 {
+			return func(cell string) (interface{}, error) {
+				bs, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cell))
+				if err != nil {
+					return nil, fmt.Errorf("illegal base64 value for float vector, error: %w", err)
+				}
+				if len(bs) != dim*4 {
+					return nil, fmt.Errorf("%w, decoded size %d doesn't equal to %d bytes of vector dimension %d", errDimensionMismatch, len(bs), dim*4, dim)
+				}
+				arr := make([]interface{}, 0, dim)
+				for i := 0; i < dim; i++ {
+					value := math.Float32frombits(binary.LittleEndian.Uint32(bs[i*4:]))
+					if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+						return nil, fmt.Errorf("value '%v' at position %d is not a number or infinity", value, i)
+					}
+					arr = append(arr, json.Number(strconv.FormatFloat(float64(value), 'g', -1, 32)))
+				}
+				return arr, nil
+			}, nil
+		}
+		return func(cell string) (interface{}, error) {
+			arr, err := decodeCSVJSONArray(cell)
+			if err != nil {
+				return nil, err
+			}
+			if len(arr) != dim {
+				return nil, fmt.Errorf("%w, array size %d doesn't equal to vector dimension %d", errDimensionMismatch, len(arr), dim)
+			}
+			for _, element := range arr {
+				num, ok := element.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for float vector", element)
+				}
+				if _, err := parseFloat(string(num), 32, schema.GetName()); err != nil {
+					return nil, err
+				}
+			}
+			return arr, nil
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		if vectorEncoding == CSVVectorBase64 {
+			return func(cell string) (interface{}, error) {
+				bs, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cell))
+				if err != nil {
+					return nil, fmt.Errorf("illegal base64 value for binary vector, error: %w", err)
+				}
+				if len(bs)*8 != dim {
+					return nil, fmt.Errorf("%w, bit size %d doesn't equal to vector dimension %d", errDimensionMismatch, len(bs)*8, dim)
+				}
+				arr := make([]interface{}, 0, len(bs))
+				for _, b := range bs {
+					arr = append(arr, json.Number(strconv.Itoa(int(b))))
+				}
+				return arr, nil
+			}, nil
+		}
+		return func(cell string) (interface{}, error) {
+			arr, err := decodeCSVJSONArray(cell)
+			if err != nil {
+				return nil, err
+			}
+			// we use uint8 to represent binary vector, each uint8 value represents 8 dimensions.
+			if len(arr)*8 != dim {
+				return nil, fmt.Errorf("%w, bit size %d doesn't equal to vector dimension %d", errDimensionMismatch, len(arr)*8, dim)
+			}
+			for _, element := range arr {
+				num, ok := element.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for binary vector", element)
+				}
+				if _, err := strconv.ParseUint(string(num), 0, 8); err != nil {
+					return nil, fmt.Errorf("illegal value '%v' for binary vector, error: %w", num, err)
+				}
+			}
+			return arr, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupport data type: %s", getTypeName(schema.GetDataType()))
+	}
+}
+
+// decodeCSVJSONArray decodes a cell which is a JSON array, numbers are kept as json.Number like the JSON parser does
+func decodeCSVJSONArray(cell string) ([]interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(cell))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("'%s' is not a JSON array, error: %w", cell, err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("'%s' is not a JSON array, redundant content after the array", cell)
+	}
+	arr, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a JSON array", cell)
+	}
+	return arr, nil
+}
+
+// csvRecordReader reads a record and returns the line number where the record begins
+type csvRecordReader interface {
+	Read() ([]string, int, error)
+}
+
+// quotedRecordReader reads records by encoding/csv, for standard and lazy quoting
+type quotedRecordReader struct {
+	reader *csv.Reader
+}
+
+func (r *quotedRecordReader) Read() ([]string, int, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, 0, err
+	}
+	line, _ := r.reader.FieldPos(0)
+	return record, line, nil
+}
+
+// plainRecordReader splits each line by the delimiter without quoting, empty lines are skipped
+type plainRecordReader struct {
+	reader    *bufio.Reader
+	delimiter string
+	line      int
+}
+
+func (r *plainRecordReader) Read() ([]string, int, error) {
+	for {
+		text, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || text == "") {
+			return nil, 0, err
+		}
+		r.line++
+		text = strings.TrimRight(text, "\r\n")
+		if text == "" {
+			continue
+		}
+		return strings.Split(text, r.delimiter), r.line, nil
+	}
+}
+
+func (p *CSVParser) newRecordReader(r io.Reader) csvRecordReader {
+	if p.options.Quoting == CSVQuotingNone {
+		return &plainRecordReader{reader: bufio.NewReader(r), delimiter: string(p.options.Delimiter)}
+	}
+	reader := csv.NewReader(r)
+	reader.Comma = p.options.Delimiter
+	reader.LazyQuotes = p.options.Quoting == CSVQuotingLazy
+	// column count is checked by the parser to report the line number
+	reader.FieldsPerRecord = -1
+	return &quotedRecordReader{reader: reader}
+}
+
+// parseHeader maps the columns to fields, redundant, duplicated or missed column is not allowed
+func (p *CSVParser) parseHeader(header []string, filePath string) ([]*schemapb.FieldSchema, error) {
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], utf8BOM)
+	}
+
+	columns := make([]*schemapb.FieldSchema, 0, len(header))
+	found := make(map[string]struct{})
+	for _, name := range header {
+		name = strings.TrimSpace(name)
+		schema, ok := p.fields[name]
+		if !ok {
+			log.Error("CSV parser: the column is not defined in collection schema", zap.String("filePath", filePath), zap.String("fieldName", name))
+			return nil, fmt.Errorf("the column '%s' of file '%s' is not defined in collection schema", name, filePath)
+		}
+		if _, ok := found[name]; ok {
+			log.Error("CSV parser: duplicated column", zap.String("filePath", filePath), zap.String("fieldName", name))
+			return nil, fmt.Errorf("the column '%s' of file '%s' is duplicated", name, filePath)
+		}
+		found[name] = struct{}{}
+		columns = append(columns, schema)
+	}
+
+	for name := range p.fields {
+		if _, ok := found[name]; !ok {
+			log.Error("CSV parser: a column is missed", zap.String("filePath", filePath), zap.String("fieldName", name))
+			return nil, fmt.Errorf("the column of field '%s' is missed in file '%s'", name, filePath)
+		}
+	}
+
+	return columns, nil
+}
+
+// ParseRows reads the csv file, the first line is the header which contains field names,
+// the rows are converted and passed to the handler in batches. The handler is no longer called once
+// an invalid row is found, the parser continues to collect at most MaxCSVRowErrors invalid rows and returns them.
+// If invalidRowFunc is set, the invalid rows are passed to it and the valid rows are still consumed.
+func (p *CSVParser) ParseRows(r io.Reader, filePath string, handler JSONRowHandler) error {
+	if handler == nil {
+		log.Error("CSV parse handler is nil")
+		return errors.New("CSV parse handler is nil")
+	}
+
+	reader := p.newRecordReader(r)
+	header, _, err := reader.Read()
+	if err == io.EOF {
+		log.Error("CSV parser: the file has no header", zap.String("filePath", filePath))
+		return fmt.Errorf("the file '%s' has no header", filePath)
+	}
+	if err != nil {
+		log.Error("CSV parser: failed to read the header", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to read the header of file '%s', error: %w", filePath, err)
+	}
+	columns, err := p.parseHeader(header, filePath)
+	if err != nil {
+		return err
+	}
+
+	rowErrors := make(CSVRowErrors, 0)
+	rowCount := 0
+	buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+	for len(rowErrors) < MaxCSVRowErrors {
+		record, line, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// the reader cannot recover from a syntax error, stop parsing
+			parseErr := &csv.ParseError{}
+			if errors.As(err, &parseErr) && p.invalidRowFunc == nil {
+				rowErrors = append(rowErrors, &CSVRowError{File: filePath, Line: parseErr.Line, Reason: parseErr.Err.Error()})
+				break
+			}
+			log.Error("CSV parser: failed to read the file", zap.String("filePath", filePath), zap.Error(err))
+			return fmt.Errorf("failed to read the file '%s', error: %w", filePath, err)
+		}
+
+		rowCount++
+		cellErrors := make([]*CSVRowError, 0)
+		row := make(map[storage.FieldID]interface{}, len(columns))
+		if len(record) != len(columns) {
+			cellErrors = append(cellErrors, &CSVRowError{File: filePath, Line: line,
+				Reason: fmt.Sprintf("the row has %d columns but the header has %d columns", len(record), len(columns))})
+		} else {
+			for i, schema := range columns {
+				value, err := p.converters[schema.GetFieldID()](record[i])
+				if err != nil {
+					cellErrors = append(cellErrors, &CSVRowError{File: filePath, Line: line, Field: schema.GetName(),
+						Reason: err.Error(), err: err})
+					continue
+				}
+				row[schema.GetFieldID()] = value
+			}
+		}
+
+		if len(cellErrors) > 0 {
+			if p.invalidRowFunc != nil {
+				p.invalidRowFunc(cellErrors)
+			} else {
+				rowErrors = append(rowErrors, cellErrors[0])
+			}
+			continue
+		}
+		// no need to consume the rows once an invalid row is found
+		if len(rowErrors) > 0 {
+			continue
+		}
+		buf = append(buf, row)
+		if len(buf) >= int(p.bufSize) {
+			if err = handler.Handle(buf); err != nil {
+				log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+				return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+			}
+
+			// clear the buffer
+			buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+
+			// outside context might be canceled(service stop, or future enhancement for canceling import task)
+			if isCanceled(p.ctx) {
+				log.Error("CSV parser: import task was canceled")
+				return errors.New("import task was canceled")
+			}
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		log.Error("CSV parser: found invalid rows", zap.String("filePath", filePath), zap.Int("count", len(rowErrors)),
+			zap.Error(rowErrors[0]))
+		return rowErrors
+	}
+
+	if rowCount == 0 {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		if err = handler.Handle(buf); err != nil {
+			log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
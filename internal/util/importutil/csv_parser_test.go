@@ -0,0 +1,338 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+var csvSampleHeader = []string{"FieldBool", "FieldInt8", "FieldInt16", "FieldInt32", "FieldInt64",
+	"FieldFloat", "FieldDouble", "FieldString", "FieldBinaryVector", "FieldFloatVector"}
+
+// createSampleCSV generates the content of sampleSchema(), vectors are JSON arrays
+func createSampleCSV(t *testing.T, rowCount int, delimiter rune) []byte {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Comma = delimiter
+	assert.NoError(t, writer.Write(csvSampleHeader))
+	for i := 0; i < rowCount; i++ {
+		assert.NoError(t, writer.Write([]string{
+			strconv.FormatBool(i%2 == 0),
+			strconv.Itoa(i),
+			strconv.Itoa(100 + i),
+			strconv.Itoa(1000 + i),
+			strconv.Itoa(i),
+			"3.5",
+			"1.25",
+			"No." + strconv.Itoa(i),
+			"[200, " + strconv.Itoa(i) + "]",
+			"[0.1, 0.2, 0.3, " + strconv.Itoa(i) + "]",
+		}))
+	}
+	writer.Flush()
+	assert.NoError(t, writer.Error())
+	return buf.Bytes()
+}
+
+func Test_NewCSVParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser, err := NewCSVParser(ctx, nil, CSVOptions{})
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	parser, err = NewCSVParser(ctx, sampleSchema(), CSVOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, parser)
+	assert.Equal(t, ',', parser.options.Delimiter)
+	assert.Equal(t, CSVQuotingStandard, parser.options.Quoting)
+	assert.Equal(t, CSVVectorJSON, parser.options.VectorEncoding)
+	assert.Equal(t, len(csvSampleHeader), len(parser.fields))
+
+	// auto-generated primary key is not parsed
+	schema := sampleSchema()
+	schema.Fields[4].AutoID = true
+	parser, err = NewCSVParser(ctx, schema, CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, len(csvSampleHeader)-1, len(parser.fields))
+
+	// illegal dimension
+	schema = sampleSchema()
+	schema.Fields[9].TypeParams[0].Value = "x"
+	parser, err = NewCSVParser(ctx, schema, CSVOptions{})
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+}
+
+func Test_CSVParserParseRows(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("standard quoting", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{})
+		assert.NoError(t, err)
+		// set bufSize = 4, means call handle() after reading 4 rows
+		parser.bufSize = 4
+
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(bytes.NewReader(createSampleCSV(t, 10, ',')), "a.csv", consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, len(consumer.rows))
+		// 3 batches and the final nil
+		assert.Equal(t, 4, consumer.handleCount)
+
+		row := consumer.rows[3]
+		assert.Equal(t, false, row[102])
+		assert.Equal(t, json.Number("3"), row[103])
+		assert.Equal(t, json.Number("103"), row[104])
+		assert.Equal(t, json.Number("1003"), row[105])
+		assert.Equal(t, json.Number("3"), row[106])
+		assert.Equal(t, json.Number("3.5"), row[107])
+		assert.Equal(t, json.Number("1.25"), row[108])
+		assert.Equal(t, "No.3", row[109])
+		assert.Equal(t, []interface{}{json.Number("200"), json.Number("3")}, row[110])
+		assert.Equal(t, []interface{}{json.Number("0.1"), json.Number("0.2"), json.Number("0.3"), json.Number("3")}, row[111])
+	})
+
+	t.Run("tab delimiter without quoting", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{Delimiter: '\t', Quoting: CSVQuotingNone})
+		assert.NoError(t, err)
+
+		content := strings.Join(csvSampleHeader, "\t") + "\r\n" +
+			"true\t1\t2\t3\t4\t5.5\t6.5\t\"quoted\"\t[1, 2]\t[1, 2, 3, 4]\r\n" +
+			"\n" +
+			"false\t1\t2\t3\t5\t5.5\t6.5\ta,b\t[1, 2]\t[1, 2, 3, 4]"
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(strings.NewReader(content), "a.tsv", consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(consumer.rows))
+		assert.Equal(t, "\"quoted\"", consumer.rows[0][109])
+		assert.Equal(t, "a,b", consumer.rows[1][109])
+	})
+
+	t.Run("base64 vectors", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{VectorEncoding: CSVVectorBase64})
+		assert.NoError(t, err)
+
+		floats := make([]byte, 16)
+		for i, f := range []float32{0.5, -1, 2.25, 3} {
+			binary.LittleEndian.PutUint32(floats[i*4:], math.Float32bits(f))
+		}
+		content := strings.Join(csvSampleHeader, ",") + "\n" +
+			"true,1,2,3,4,5.5,6.5,a," + base64.StdEncoding.EncodeToString([]byte{255, 1}) + "," +
+			base64.StdEncoding.EncodeToString(floats) + "\n"
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(consumer.rows))
+		assert.Equal(t, []interface{}{json.Number("255"), json.Number("1")}, consumer.rows[0][110])
+		assert.Equal(t, []interface{}{json.Number("0.5"), json.Number("-1"), json.Number("2.25"), json.Number("3")}, consumer.rows[0][111])
+
+		// size of decoded vector doesn't match the dimension
+		content = strings.Join(csvSampleHeader, ",") + "\n" +
+			"true,1,2,3,4,5.5,6.5,a," + base64.StdEncoding.EncodeToString([]byte{255}) + "," +
+			base64.StdEncoding.EncodeToString(floats[:12]) + "\n"
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		var rowErrors CSVRowErrors
+		assert.True(t, errors.As(err, &rowErrors))
+		assert.Equal(t, 1, len(rowErrors))
+		assert.Equal(t, "FieldBinaryVector", rowErrors[0].Field)
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{})
+		assert.NoError(t, err)
+		consumer := &mockJSONRowConsumer{}
+
+		err = parser.ParseRows(strings.NewReader(""), "a.csv", nil)
+		assert.Error(t, err)
+		err = parser.ParseRows(strings.NewReader(""), "a.csv", consumer)
+		assert.Error(t, err)
+		// redundant column
+		err = parser.ParseRows(strings.NewReader(strings.Join(append(csvSampleHeader, "dummy"), ",")), "a.csv", consumer)
+		assert.Error(t, err)
+		// duplicated column
+		err = parser.ParseRows(strings.NewReader(strings.Join(append(csvSampleHeader, "FieldBool"), ",")), "a.csv", consumer)
+		assert.Error(t, err)
+		// missed column
+		err = parser.ParseRows(strings.NewReader(strings.Join(csvSampleHeader[1:], ",")), "a.csv", consumer)
+		assert.Error(t, err)
+		// no rows
+		err = parser.ParseRows(strings.NewReader(strings.Join(csvSampleHeader, ",")), "a.csv", consumer)
+		assert.Error(t, err)
+		assert.Equal(t, 0, consumer.handleCount)
+
+		// byte order mark is ignored
+		content := utf8BOM + string(createSampleCSV(t, 1, ','))
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(consumer.rows))
+	})
+
+	t.Run("invalid rows", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{})
+		assert.NoError(t, err)
+
+		content := strings.Join(csvSampleHeader, ",") + "\n" +
+			"true,1,2,3,4,5.5,6.5,a,\"[1, 2]\",\"[1, 2, 3, 4]\"\n" +
+			"true,300,2,3,4,5.5,6.5,a,\"[1, 2]\",\"[1, 2, 3, 4]\"\n" +
+			"true,1,2,3,4,5.5,6.5,\"multi\nline\",\"[1, 2]\",\"[1, 2, 3]\"\n" +
+			"true,1,2,3,4\n" +
+			"yes,1,2,3,4,5.5,6.5,a,\"[1, 2]\",\"[1, 2, 3, 4]\"\n"
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		var rowErrors CSVRowErrors
+		assert.True(t, errors.As(err, &rowErrors))
+		assert.Equal(t, 0, consumer.handleCount)
+
+		expected := []struct {
+			line  int
+			field string
+		}{
+			{3, "FieldInt8"},
+			{4, "FieldFloatVector"},
+			{6, ""},
+			{7, "FieldBool"},
+		}
+		assert.Equal(t, len(expected), len(rowErrors))
+		for i, e := range expected {
+			assert.Equal(t, "a.csv", rowErrors[i].File)
+			assert.Equal(t, e.line, rowErrors[i].Line)
+			assert.Equal(t, e.field, rowErrors[i].Field)
+		}
+
+		var reported []*CSVRowError
+		err = json.Unmarshal([]byte(rowErrors.JSON()), &reported)
+		assert.NoError(t, err)
+		assert.Equal(t, len(rowErrors), len(reported))
+		for i := range reported {
+			assert.Equal(t, rowErrors[i].Error(), reported[i].Error())
+		}
+		assert.True(t, errors.Is(rowErrors[1], errDimensionMismatch))
+
+		// at most MaxCSVRowErrors rows are reported
+		content = strings.Join(csvSampleHeader, ",") + "\n" + strings.Repeat("x\n", MaxCSVRowErrors*2)
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		assert.True(t, errors.As(err, &rowErrors))
+		assert.Equal(t, MaxCSVRowErrors, len(rowErrors))
+
+		// syntax error stops the parsing
+		content = strings.Join(csvSampleHeader, ",") + "\n" + "a\"b\n" + "x\n"
+		err = parser.ParseRows(strings.NewReader(content), "a.csv", consumer)
+		assert.True(t, errors.As(err, &rowErrors))
+		assert.Equal(t, 1, len(rowErrors))
+		assert.Equal(t, 2, rowErrors[0].Line)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		parser, err := NewCSVParser(ctx, sampleSchema(), CSVOptions{})
+		assert.NoError(t, err)
+		consumer := &mockJSONRowConsumer{handleErr: errors.New("error")}
+		err = parser.ParseRows(bytes.NewReader(createSampleCSV(t, 1, ',')), "a.csv", consumer)
+		assert.Error(t, err)
+
+		parser.bufSize = 1
+		err = parser.ParseRows(bytes.NewReader(createSampleCSV(t, 1, ',')), "a.csv", consumer)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ImportWrapperCSV(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	csvFilePath := TempFilesPath + "rows_1.csv"
+	err = cm.Write(ctx, csvFilePath, createSampleCSV(t, 10, ','))
+	assert.NoError(t, err)
+	// the default delimiter of tsv file is tab
+	tsvFilePath := TempFilesPath + "rows_2.tsv"
+	err = cm.Write(ctx, tsvFilePath, createSampleCSV(t, 5, '\t'))
+	assert.NoError(t, err)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	err = wrapper.Import([]string{csvFilePath, tsvFilePath}, ImportOptions{OnlyValidate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import([]string{csvFilePath, tsvFilePath}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 15, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// csv files can't be mixed with numpy files
+	_, err = wrapper.fileValidation([]string{csvFilePath, "FieldBool.npy"})
+	assert.Error(t, err)
+
+	// the invalid rows are reported in the infos
+	badFilePath := TempFilesPath + "rows_3.csv"
+	err = cm.Write(ctx, badFilePath, []byte(strings.Join(csvSampleHeader, ",")+"\nx\n"))
+	assert.NoError(t, err)
+	err = wrapper.Import([]string{badFilePath}, DefaultImportOptions())
+	assert.Error(t, err)
+	found := false
+	for _, kv := range importResult.GetInfos() {
+		if kv.GetKey() == FailedRows {
+			found = true
+			assert.Contains(t, kv.GetValue(), "rows_3.csv")
+		}
+	}
+	assert.True(t, found)
+}
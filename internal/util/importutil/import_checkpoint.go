@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// ImportCheckpoint is the progress of an import task, it is reported to rootcoord after all the rows before
+// the checkpoint are persisted into segments. If the datanode is lost, the rootcoord assigns the task to another
+// datanode along with the checkpoint, the finished files and the first RowOffset rows of File are skipped.
+// Only row-based files have checkpoints, the numpy files of a task are combined so they are imported as a whole.
+type ImportCheckpoint struct {
+	FinishedFiles []string `json:"finished_files"`
+	File          string   `json:"file,omitempty"`       // the file being imported
+	RowOffset     int64    `json:"row_offset,omitempty"` // count of rows of File which are persisted
+	Segments      []int64  `json:"segments"`             // segments which contain the rows before the checkpoint
+	RowCount      int64    `json:"row_count"`
+	AutoIds       []int64  `json:"auto_ids"` // auto-generated id ranges of the rows before the checkpoint
+}
+
+// JSON returns the checkpoint in JSON format, which is persisted in the import task infos
+func (c *ImportCheckpoint) JSON() string {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal import checkpoint, error: %s", err.Error())
+	}
+	return string(bs)
+}
+
+// isFinished returns true if the file is fully imported before the checkpoint
+func (c *ImportCheckpoint) isFinished(filePath string) bool {
+	for _, file := range c.FinishedFiles {
+		if file == filePath {
+			return true
+		}
+	}
+	return false
+}
+
+// skipRows returns the count of rows of the file which are persisted before the checkpoint
+func (c *ImportCheckpoint) skipRows(filePath string) int64 {
+	if c.File != filePath {
+		return 0
+	}
+	return c.RowOffset
+}
+
+// ParseCheckpoint gets the checkpoint from the import task infos, returns nil if the task has no checkpoint
+func ParseCheckpoint(infos []*commonpb.KeyValuePair) (*ImportCheckpoint, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(Checkpoint, infos)
+	if err != nil || value == "" {
+		return nil, nil
+	}
+
+	checkpoint := &ImportCheckpoint{}
+	if err := json.Unmarshal([]byte(value), checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse import checkpoint '%s', error: %w", value, err)
+	}
+	return checkpoint, nil
+}
+
+// checkpointRowHandler skips the rows persisted before the checkpoint of a resumed task, and makes a new checkpoint
+// for every ImportWrapper.checkpointRows rows of a large file
+type checkpointRowHandler struct {
+	wrapper    *ImportWrapper
+	consumer   *JSONRowConsumer
+	filePath   string
+	skipRows   int64 // count of rows to be skipped
+	rowOffset  int64 // count of rows handled, including the skipped rows
+	lastOffset int64 // row offset of the last checkpoint
+}
+
+func (h *checkpointRowHandler) Handle(rows []map[storage.FieldID]interface{}) error {
+	if rows == nil {
+		return h.consumer.Handle(nil)
+	}
+
+	if h.skipRows > 0 {
+		skip := h.skipRows
+		if skip > int64(len(rows)) {
+			skip = int64(len(rows))
+		}
+		h.skipRows -= skip
+		h.rowOffset += skip
+		h.lastOffset = h.rowOffset
+		rows = rows[skip:]
+		if len(rows) == 0 {
+			return nil
+		}
+	}
+
+	if err := h.consumer.Handle(rows); err != nil {
+		return err
+	}
+	h.rowOffset += int64(len(rows))
+
+	if h.wrapper.checkpointRows > 0 && h.rowOffset-h.lastOffset >= h.wrapper.checkpointRows {
+		// force flush the buffered rows so that all the rows before the checkpoint are persisted
+		if err := h.consumer.Handle(nil); err != nil {
+			return err
+		}
+		if err := h.wrapper.saveRowOffsetCheckpoint(h.filePath, h.rowOffset, h.consumer.IDRange()); err != nil {
+			return err
+		}
+		h.lastOffset = h.rowOffset
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// checkpointTest records the checkpoints reported by ImportWrapper
+type checkpointTest struct {
+	importResult *rootcoordpb.ImportResult
+	checkpoints  []*ImportCheckpoint
+	states       []commonpb.ImportState
+	nextSegment  int64
+	rowCount     int
+	reportErr    error
+}
+
+func newCheckpointTest() *checkpointTest {
+	return &checkpointTest{
+		importResult: &rootcoordpb.ImportResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			TaskId:     1,
+			DatanodeId: 1,
+			State:      commonpb.ImportState_ImportStarted,
+			Segments:   make([]int64, 0),
+			AutoIds:    make([]int64, 0),
+		},
+		checkpoints: make([]*ImportCheckpoint, 0),
+		states:      make([]commonpb.ImportState, 0),
+		nextSegment: 100,
+	}
+}
+
+func (c *checkpointTest) newWrapper(ctx context.Context, t *testing.T, cm storage.ChunkManager) *ImportWrapper {
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		if c.reportErr != nil {
+			return c.reportErr
+		}
+		checkpoint, err := ParseCheckpoint(res.GetInfos())
+		assert.NoError(t, err)
+		c.checkpoints = append(c.checkpoints, checkpoint)
+		c.states = append(c.states, res.GetState())
+		return nil
+	}
+	assignSegmentFunc := func(shardID int) (int64, string, error) {
+		c.nextSegment++
+		return c.nextSegment, "ch", nil
+	}
+	createBinlogFunc := func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+		for _, data := range fields {
+			c.rowCount += data.RowNum()
+			break
+		}
+		return nil, nil, nil
+	}
+	saveSegmentFunc := func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
+		c.importResult.Segments = append(c.importResult.Segments, segmentID)
+		c.importResult.RowCount += rowCount
+		return nil
+	}
+
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, c.importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, createBinlogFunc, saveSegmentFunc)
+	wrapper.reportImportAttempts = 1
+	return wrapper
+}
+
+func Test_ParseCheckpoint(t *testing.T) {
+	checkpoint, err := ParseCheckpoint(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	checkpoint, err = ParseCheckpoint([]*commonpb.KeyValuePair{{Key: Checkpoint, Value: "{"}})
+	assert.Error(t, err)
+	assert.Nil(t, checkpoint)
+
+	expected := &ImportCheckpoint{
+		FinishedFiles: []string{"a.json"},
+		File:          "b.json",
+		RowOffset:     10,
+		Segments:      []int64{1, 2},
+		RowCount:      20,
+		AutoIds:       []int64{100, 120},
+	}
+	checkpoint, err = ParseCheckpoint([]*commonpb.KeyValuePair{{Key: Checkpoint, Value: expected.JSON()}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, checkpoint)
+
+	assert.True(t, checkpoint.isFinished("a.json"))
+	assert.False(t, checkpoint.isFinished("b.json"))
+	assert.Equal(t, int64(0), checkpoint.skipRows("a.json"))
+	assert.Equal(t, int64(10), checkpoint.skipRows("b.json"))
+}
+
+func Test_CheckpointRowHandler(t *testing.T) {
+	ctx := context.Background()
+	c := newCheckpointTest()
+	wrapper := c.newWrapper(ctx, t, nil)
+	wrapper.checkpointRows = 4
+	wrapper.initCheckpoint(&ImportCheckpoint{
+		File:      "a.json",
+		RowOffset: 3,
+		Segments:  []int64{1},
+		RowCount:  3,
+	})
+	assert.Equal(t, []int64{1}, c.importResult.Segments)
+	assert.Equal(t, int64(3), c.importResult.RowCount)
+
+	consumer, err := NewJSONRowConsumer(wrapper.collectionSchema, wrapper.rowIDAllocator, wrapper.shardNum, SingleBlockSize, wrapper.flushFunc)
+	assert.NoError(t, err)
+	handler := wrapper.newCheckpointRowHandler("a.json", consumer)
+
+	newRows := func(pks ...string) []map[storage.FieldID]interface{} {
+		rows := make([]map[storage.FieldID]interface{}, 0)
+		for _, pk := range pks {
+			rows = append(rows, sampleReportRow(pk))
+		}
+		return rows
+	}
+
+	// the first 3 rows are skipped
+	assert.NoError(t, handler.Handle(newRows("1", "2")))
+	assert.NoError(t, handler.Handle(newRows("3", "4", "5")))
+	assert.Equal(t, 0, len(c.checkpoints))
+	// the 4th row after the last checkpoint, a new checkpoint is made
+	assert.NoError(t, handler.Handle(newRows("6", "7")))
+	assert.Equal(t, 1, len(c.checkpoints))
+	assert.Equal(t, "a.json", c.checkpoints[0].File)
+	assert.Equal(t, int64(7), c.checkpoints[0].RowOffset)
+	assert.Equal(t, int64(7), c.checkpoints[0].RowCount)
+	assert.Equal(t, 4, c.rowCount)
+	assert.Equal(t, c.importResult.Segments, c.checkpoints[0].Segments)
+	assert.Equal(t, 0, len(wrapper.workingSegments))
+
+	assert.NoError(t, handler.Handle(newRows("8")))
+	assert.NoError(t, handler.Handle(nil))
+	assert.Equal(t, 1, len(c.checkpoints))
+	assert.Equal(t, 5, c.rowCount)
+
+	// failed to report checkpoint
+	c.reportErr = errors.New("error")
+	assert.Error(t, handler.Handle(newRows("9", "10", "11", "12")))
+}
+
+func Test_ImportWrapperCheckpoint(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	filePaths := []string{TempFilesPath + "rows_1.csv", TempFilesPath + "rows_2.csv"}
+	for _, filePath := range filePaths {
+		err = cm.Write(ctx, filePath, createSampleCSV(t, 5, ','))
+		assert.NoError(t, err)
+	}
+
+	t.Run("checkpoint after each file", func(t *testing.T) {
+		c := newCheckpointTest()
+		wrapper := c.newWrapper(ctx, t, cm)
+		err = wrapper.Import(filePaths, DefaultImportOptions())
+		assert.NoError(t, err)
+		assert.Nil(t, wrapper.checkpoint)
+		assert.Equal(t, 10, c.rowCount)
+		assert.Equal(t, int64(10), c.importResult.RowCount)
+
+		assert.Equal(t, []commonpb.ImportState{commonpb.ImportState_ImportStarted, commonpb.ImportState_ImportStarted,
+			commonpb.ImportState_ImportPersisted}, c.states)
+		assert.Equal(t, filePaths[:1], c.checkpoints[0].FinishedFiles)
+		assert.Equal(t, int64(5), c.checkpoints[0].RowCount)
+		assert.Equal(t, filePaths, c.checkpoints[1].FinishedFiles)
+		assert.Equal(t, int64(10), c.checkpoints[1].RowCount)
+		assert.Equal(t, c.importResult.Segments, c.checkpoints[1].Segments)
+	})
+
+	t.Run("resume from checkpoint", func(t *testing.T) {
+		c := newCheckpointTest()
+		wrapper := c.newWrapper(ctx, t, cm)
+		options := DefaultImportOptions()
+		options.Checkpoint = &ImportCheckpoint{
+			FinishedFiles: []string{filePaths[0]},
+			File:          filePaths[1],
+			RowOffset:     2,
+			Segments:      []int64{1, 2},
+			RowCount:      7,
+		}
+		err = wrapper.Import(filePaths, options)
+		assert.NoError(t, err)
+		// the first file and the first 2 rows of the second file are skipped
+		assert.Equal(t, 3, c.rowCount)
+		assert.Equal(t, int64(10), c.importResult.RowCount)
+		assert.Equal(t, []int64{1, 2}, c.importResult.Segments[:2])
+		assert.Equal(t, commonpb.ImportState_ImportPersisted, c.importResult.State)
+		assert.Equal(t, filePaths, c.checkpoints[0].FinishedFiles)
+	})
+
+	t.Run("no checkpoint for dry run", func(t *testing.T) {
+		c := newCheckpointTest()
+		wrapper := c.newWrapper(ctx, t, cm)
+		options := DefaultImportOptions()
+		options.DryRun = true
+		err = wrapper.Import(filePaths, options)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(c.checkpoints))
+		assert.Nil(t, c.checkpoints[0])
+	})
+
+	t.Run("failed to report checkpoint", func(t *testing.T) {
+		c := newCheckpointTest()
+		c.reportErr = errors.New("error")
+		wrapper := c.newWrapper(ctx, t, cm)
+		err = wrapper.Import(filePaths, DefaultImportOptions())
+		assert.Error(t, err)
+		assert.Equal(t, 5, c.rowCount)
+	})
+}
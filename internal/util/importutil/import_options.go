@@ -18,9 +18,11 @@ package importutil
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -33,15 +35,52 @@ const (
 	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
 	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"csv_delimiter: a single character, default ',' for .csv file and '\\t' for .tsv file \n" +
+		"csv_quoting: standard, lazy or none, default standard \n" +
+		"csv_vector_encoding: json or base64, default json \n"
 	BackupFlag = "backup"
+	DryRunFlag = "dry_run" // scan the files and report the statistics without persisting any data
+	DBName     = "db_name" // the database of the collection, default to the default database
+
+	// PartitionKeyIndex is the index of the hidden partition of a partition key collection that a backup import restores
+	// into. The rows of a hidden partition are hashed to the partition with the same index as long as the number of
+	// partitions is kept, so the backup tool imports each hidden partition by its index instead of a partition name.
+	PartitionKeyIndex = "partition_key_index"
+
+	CSVDelimiter      = "csv_delimiter"       // single character delimiter of csv file, default ',' for .csv and '\t' for .tsv
+	CSVQuoting        = "csv_quoting"         // quoting mode of csv file: standard, lazy or none, default standard
+	CSVVectorEncoding = "csv_vector_encoding" // encoding of vector cells in csv file: json or base64, default json
+)
+
+// quoting modes of csv file
+const (
+	CSVQuotingStandard = "standard" // RFC 4180, a field can be enclosed in double quotes
+	CSVQuotingLazy     = "lazy"     // a quote may appear in an unquoted field and a non-doubled quote may appear in a quoted field
+	CSVQuotingNone     = "none"     // no quoting, each line is split by the delimiter
+)
+
+// encodings of vector cells in csv file
+const (
+	CSVVectorJSON   = "json"   // a JSON array, e.g. [0.1, 0.2]
+	CSVVectorBase64 = "base64" // base64 of little-endian float32 values for float vector, or of raw bytes for binary vector
 )
 
 type ImportOptions struct {
 	OnlyValidate bool
 	TsStartPoint uint64
 	TsEndPoint   uint64
-	IsBackup     bool // whether is triggered by backup tool
+	IsBackup     bool              // whether is triggered by backup tool
+	DryRun       bool              // only generate an ImportReport, no data is persisted
+	Checkpoint   *ImportCheckpoint // the progress of a resumed task, nil for a new task
+	CSV          CSVOptions
+}
+
+// CSVOptions are the options to parse csv/tsv files
+type CSVOptions struct {
+	Delimiter      rune // 0 means choosing by the file extension
+	Quoting        string
+	VectorEncoding string
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -49,6 +88,7 @@ func DefaultImportOptions() ImportOptions {
 		OnlyValidate: false,
 		TsStartPoint: 0,
 		TsEndPoint:   math.MaxUint64,
+		CSV:          CSVOptions{Quoting: CSVQuotingStandard, VectorEncoding: CSVVectorJSON},
 	}
 	return options
 }
@@ -81,7 +121,11 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
-	return nil
+	if _, _, err = ParsePartitionKeyIndex(options); err != nil {
+		return err
+	}
+	_, err = ParseCSVOptions(options)
+	return err
 }
 
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
@@ -119,3 +163,72 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// IsDryRun returns if the request is a dry-run import
+func IsDryRun(options []*commonpb.KeyValuePair) bool {
+	dryRun, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRunFlag, options)
+	if err != nil || strings.ToLower(dryRun) != "true" {
+		return false
+	}
+	return true
+}
+
+// GetDBName returns the database of the collection to import into, an empty string means the default database
+func GetDBName(options []*commonpb.KeyValuePair) string {
+	dbName, err := funcutil.GetAttrByKeyFromRepeatedKV(DBName, options)
+	if err != nil {
+		return ""
+	}
+	return dbName
+}
+
+// ParsePartitionKeyIndex returns the index of the hidden partition to restore into, the second return value is false
+// if the index is not specified. The index is only accepted by a backup import.
+func ParsePartitionKeyIndex(options []*commonpb.KeyValuePair) (int, bool, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(PartitionKeyIndex, options)
+	if err != nil {
+		return 0, false, nil
+	}
+	if !IsBackup(options) {
+		return 0, false, fmt.Errorf("%s is only supported by backup import", PartitionKeyIndex)
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 {
+		return 0, false, fmt.Errorf("%s '%s' is illegal, it should be a non-negative integer", PartitionKeyIndex, value)
+	}
+	return index, true, nil
+}
+
+// ParseCSVOptions get the csv options from input options, the delimiter should be a single character,
+// the escape sequence \t is accepted as a tab
+func ParseCSVOptions(options []*commonpb.KeyValuePair) (CSVOptions, error) {
+	csvOptions := CSVOptions{Quoting: CSVQuotingStandard, VectorEncoding: CSVVectorJSON}
+	optionMap := funcutil.KeyValuePair2Map(options)
+	if value, ok := optionMap[CSVDelimiter]; ok && value != "" {
+		if value == "\\t" {
+			value = "\t"
+		}
+		runes := []rune(value)
+		if len(runes) != 1 || runes[0] == '"' || runes[0] == '\r' || runes[0] == '\n' || runes[0] == utf8.RuneError {
+			return csvOptions, fmt.Errorf("%s '%s' is illegal, it should be a single character except quote and line break", CSVDelimiter, value)
+		}
+		csvOptions.Delimiter = runes[0]
+	}
+	if value, ok := optionMap[CSVQuoting]; ok && value != "" {
+		value = strings.ToLower(value)
+		if value != CSVQuotingStandard && value != CSVQuotingLazy && value != CSVQuotingNone {
+			return csvOptions, fmt.Errorf("%s '%s' is illegal, it should be one of %s, %s and %s", CSVQuoting, value,
+				CSVQuotingStandard, CSVQuotingLazy, CSVQuotingNone)
+		}
+		csvOptions.Quoting = value
+	}
+	if value, ok := optionMap[CSVVectorEncoding]; ok && value != "" {
+		value = strings.ToLower(value)
+		if value != CSVVectorJSON && value != CSVVectorBase64 {
+			return csvOptions, fmt.Errorf("%s '%s' is illegal, it should be %s or %s", CSVVectorEncoding, value,
+				CSVVectorJSON, CSVVectorBase64)
+		}
+		csvOptions.VectorEncoding = value
+	}
+	return csvOptions, nil
+}
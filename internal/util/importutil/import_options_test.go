@@ -106,3 +106,88 @@ func TestIsBackup(t *testing.T) {
 	})
 	assert.Equal(t, false, noBackup)
 }
+
+func TestGetDBName(t *testing.T) {
+	assert.Equal(t, "", GetDBName([]*commonpb.KeyValuePair{}))
+	assert.Equal(t, "db1", GetDBName([]*commonpb.KeyValuePair{
+		{Key: "db_name", Value: "db1"},
+	}))
+}
+
+func TestParsePartitionKeyIndex(t *testing.T) {
+	index, ok, err := ParsePartitionKeyIndex([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 0, index)
+
+	index, ok, err = ParsePartitionKeyIndex([]*commonpb.KeyValuePair{
+		{Key: "backup", Value: "true"},
+		{Key: "partition_key_index", Value: "3"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, index)
+
+	// only accepted by backup import
+	_, _, err = ParsePartitionKeyIndex([]*commonpb.KeyValuePair{
+		{Key: "partition_key_index", Value: "3"},
+	})
+	assert.Error(t, err)
+
+	_, _, err = ParsePartitionKeyIndex([]*commonpb.KeyValuePair{
+		{Key: "backup", Value: "true"},
+		{Key: "partition_key_index", Value: "-1"},
+	})
+	assert.Error(t, err)
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "backup", Value: "true"},
+		{Key: "partition_key_index", Value: "a"},
+	}))
+}
+
+func TestIsDryRun(t *testing.T) {
+	isDryRun := IsDryRun([]*commonpb.KeyValuePair{
+		{Key: "dry_run", Value: "true"},
+	})
+	assert.Equal(t, true, isDryRun)
+	isDryRun2 := IsDryRun([]*commonpb.KeyValuePair{
+		{Key: "dry_run", Value: "True"},
+	})
+	assert.Equal(t, true, isDryRun2)
+	falseDryRun := IsDryRun([]*commonpb.KeyValuePair{
+		{Key: "dry_run", Value: "false"},
+	})
+	assert.Equal(t, false, falseDryRun)
+	noDryRun := IsDryRun([]*commonpb.KeyValuePair{})
+	assert.Equal(t, false, noDryRun)
+}
+
+func TestParseCSVOptions(t *testing.T) {
+	options, err := ParseCSVOptions([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, CSVOptions{Quoting: CSVQuotingStandard, VectorEncoding: CSVVectorJSON}, options)
+
+	options, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: CSVDelimiter, Value: "\\t"},
+		{Key: CSVQuoting, Value: "None"},
+		{Key: CSVVectorEncoding, Value: "base64"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, CSVOptions{Delimiter: '\t', Quoting: CSVQuotingNone, VectorEncoding: CSVVectorBase64}, options)
+
+	options, err = ParseCSVOptions([]*commonpb.KeyValuePair{{Key: CSVDelimiter, Value: "|"}})
+	assert.NoError(t, err)
+	assert.Equal(t, '|', options.Delimiter)
+
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{{Key: CSVDelimiter, Value: "||"}})
+	assert.Error(t, err)
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{{Key: CSVDelimiter, Value: "\""}})
+	assert.Error(t, err)
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{{Key: CSVQuoting, Value: "double"}})
+	assert.Error(t, err)
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{{Key: CSVVectorEncoding, Value: "hex"}})
+	assert.Error(t, err)
+
+	// the csv options are validated along with other options
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: CSVQuoting, Value: "double"}}))
+}
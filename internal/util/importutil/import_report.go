@@ -0,0 +1,338 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// ImportReport is the result of a dry-run import, the files are fully scanned but nothing is persisted.
+// The value statistics of fields are collected from JSON, CSV and parquet files, the invalid rows are skipped.
+// A numpy file holds a whole column without null value, its shape and type are validated as a whole, so the
+// first error of a numpy file is reported instead of the value statistics.
+// At most maxDryRunPKs distinct primary keys are kept to count the duplicated ones, PKsTruncated is true if
+// there are more, then DuplicatedPKs only counts the duplicates of the kept primary keys.
+type ImportReport struct {
+	Files         []*FileReport           `json:"files"`
+	Fields        map[string]*FieldReport `json:"fields"`
+	DuplicatedPKs int64                   `json:"duplicated_pks"` // count of rows whose primary key already appears in the task
+	PKsTruncated  bool                    `json:"pks_truncated"`
+	Shards        []*ShardReport          `json:"shards"`
+}
+
+// FileReport is the statistics of a file, Error is the reason why the file cannot be imported
+type FileReport struct {
+	File        string `json:"file"`
+	RowCount    int64  `json:"row_count"` // rows scanned, including the invalid rows
+	InvalidRows int64  `json:"invalid_rows"`
+	Error       string `json:"error,omitempty"`
+}
+
+// FieldReport is the statistics of invalid values of a field
+type FieldReport struct {
+	NullCount        int64 `json:"null_count"`
+	InvalidCount     int64 `json:"invalid_count"`
+	DimMismatchCount int64 `json:"dim_mismatch_count"`
+}
+
+// ShardReport is the estimation of the segments would be generated for a shard
+type ShardReport struct {
+	ShardID      int   `json:"shard_id"`
+	RowCount     int64 `json:"row_count"`
+	Size         int64 `json:"size"` // in-memory size of the fields data in bytes
+	SegmentCount int64 `json:"segment_count"`
+
+	workingSize int64 // size of the last segment, it is closed when the size exceeds segment size
+}
+
+// JSON returns the report in JSON format, which is reported in the import task infos
+func (r *ImportReport) JSON() string {
+	bs, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal dry-run report, error: %s", err.Error())
+	}
+	return string(bs)
+}
+
+// maxDryRunPKs is the max count of distinct primary keys kept by a dry-run import to count the duplicated ones,
+// so that the memory of the report is bounded.
+var maxDryRunPKs = 1000000
+
+// importReporter collects the statistics of a dry-run import
+type importReporter struct {
+	report           *ImportReport
+	collectionSchema *schemapb.CollectionSchema
+	segmentSize      int64
+	fields           map[storage.FieldID]*schemapb.FieldSchema // fields need to be checked
+	validators       map[storage.FieldID]*Validator
+	scratch          map[storage.FieldID]storage.FieldData // discarded output of the validators
+	primaryKey       *schemapb.FieldSchema
+	intPKs           map[int64]struct{}
+	strPKs           map[string]struct{}
+	current          *FileReport // the file being scanned
+}
+
+func newImportReporter(collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64) (*importReporter, error) {
+	if collectionSchema == nil {
+		return nil, errors.New("collection schema is nil")
+	}
+
+	validators := make(map[storage.FieldID]*Validator)
+	if err := initValidators(collectionSchema, validators); err != nil {
+		return nil, err
+	}
+
+	r := &importReporter{
+		report: &ImportReport{
+			Files:  make([]*FileReport, 0),
+			Fields: make(map[string]*FieldReport),
+			Shards: make([]*ShardReport, 0, shardNum),
+		},
+		collectionSchema: collectionSchema,
+		segmentSize:      segmentSize,
+		fields:           make(map[storage.FieldID]*schemapb.FieldSchema),
+		validators:       validators,
+		scratch:          initSegmentData(collectionSchema),
+		intPKs:           make(map[int64]struct{}),
+		strPKs:           make(map[string]struct{}),
+	}
+	for i := 0; i < int(shardNum); i++ {
+		r.report.Shards = append(r.report.Shards, &ShardReport{ShardID: i})
+	}
+	for _, schema := range collectionSchema.Fields {
+		if schema.GetIsPrimaryKey() {
+			r.primaryKey = schema
+		}
+		// RowIDField and TimeStampField is internal field, auto-generated primary key is not provided
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField || schema.GetAutoID() {
+			continue
+		}
+		r.fields[schema.GetFieldID()] = schema
+		r.report.Fields[schema.GetName()] = &FieldReport{}
+	}
+	if r.primaryKey == nil {
+		return nil, errors.New("primary key field is not found")
+	}
+
+	return r, nil
+}
+
+// startFile begins the statistics of a file
+func (r *importReporter) startFile(filePath string) {
+	r.current = &FileReport{File: filePath}
+	r.report.Files = append(r.report.Files, r.current)
+}
+
+// finishFile records the error which stops scanning the file
+func (r *importReporter) finishFile(err error) {
+	if r.current != nil && err != nil {
+		r.current.Error = err.Error()
+	}
+	r.current = nil
+}
+
+// failedFiles returns the count of files which cannot be imported
+func (r *importReporter) failedFiles() int {
+	count := 0
+	for _, file := range r.report.Files {
+		if file.Error != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// addFileRows counts the rows of a file which are validated as a whole
+func (r *importReporter) addFileRows(rowCount int) {
+	if r.current != nil {
+		r.current.RowCount += int64(rowCount)
+	}
+}
+
+// checkRow validates each field of a row parsed from JSON or CSV file, returns false if the row is invalid.
+// The missing fields of a JSON row are counted as null values.
+func (r *importReporter) checkRow(row map[storage.FieldID]interface{}) bool {
+	valid := true
+	for id, schema := range r.fields {
+		value, ok := row[id]
+		fieldReport := r.report.Fields[schema.GetName()]
+		if !ok || value == nil {
+			fieldReport.NullCount++
+			valid = false
+			continue
+		}
+		if arr, ok := value.([]interface{}); ok && !r.dimensionMatched(schema, len(arr)) {
+			fieldReport.DimMismatchCount++
+			valid = false
+			continue
+		}
+		if err := r.validators[id].convertFunc(value, r.scratch[id]); err != nil {
+			fieldReport.InvalidCount++
+			valid = false
+		}
+	}
+
+	r.countRow(valid)
+	return valid
+}
+
+// invalidCSVRow counts an invalid row of CSV file
+func (r *importReporter) invalidCSVRow(rowErrors []*CSVRowError) {
+	for _, rowError := range rowErrors {
+		// the whole row is invalid if the field is empty
+		r.countFieldError(rowError.Field, rowError)
+	}
+
+	r.countRow(false)
+}
+
+// invalidParquetRow counts an invalid row of parquet file
+func (r *importReporter) invalidParquetRow(fieldErrors map[string]error) {
+	for fieldName, err := range fieldErrors {
+		r.countFieldError(fieldName, err)
+	}
+
+	r.countRow(false)
+}
+
+func (r *importReporter) countFieldError(fieldName string, err error) {
+	fieldReport, ok := r.report.Fields[fieldName]
+	if !ok {
+		return
+	}
+	if errors.Is(err, errEmptyValue) {
+		fieldReport.NullCount++
+	} else if errors.Is(err, errDimensionMismatch) {
+		fieldReport.DimMismatchCount++
+	} else {
+		fieldReport.InvalidCount++
+	}
+}
+
+func (r *importReporter) countRow(valid bool) {
+	if r.current == nil {
+		return
+	}
+	r.current.RowCount++
+	if !valid {
+		r.current.InvalidRows++
+	}
+}
+
+func (r *importReporter) dimensionMatched(schema *schemapb.FieldSchema, size int) bool {
+	switch schema.GetDataType() {
+	case schemapb.DataType_FloatVector:
+		return size == r.validators[schema.GetFieldID()].dimension
+	case schemapb.DataType_BinaryVector:
+		return size*8 == r.validators[schema.GetFieldID()].dimension
+	default:
+		return true
+	}
+}
+
+// resetScratch releases the output of the validators
+func (r *importReporter) resetScratch() {
+	r.scratch = initSegmentData(r.collectionSchema)
+}
+
+// addShardData is called instead of persisting the fields data of a shard, it counts the duplicated primary keys
+// and estimates the segments in the same way as ImportWrapper.flushFunc() does
+func (r *importReporter) addShardData(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+	if shardID < 0 || shardID >= len(r.report.Shards) {
+		return fmt.Errorf("illegal shard id %d", shardID)
+	}
+
+	rowCount := 0
+	size := 0
+	for _, field := range fields {
+		rowCount = field.RowNum()
+		size += field.GetMemorySize()
+	}
+
+	if !r.primaryKey.GetAutoID() {
+		switch pkData := fields[r.primaryKey.GetFieldID()].(type) {
+		case *storage.Int64FieldData:
+			for _, pk := range pkData.Data {
+				if _, ok := r.intPKs[pk]; ok {
+					r.report.DuplicatedPKs++
+				} else if r.keepPK() {
+					r.intPKs[pk] = struct{}{}
+				}
+			}
+		case *storage.StringFieldData:
+			for _, pk := range pkData.Data {
+				if _, ok := r.strPKs[pk]; ok {
+					r.report.DuplicatedPKs++
+				} else if r.keepPK() {
+					r.strPKs[pk] = struct{}{}
+				}
+			}
+		}
+	}
+
+	shard := r.report.Shards[shardID]
+	if shard.workingSize > 0 && shard.workingSize+int64(size) >= r.segmentSize {
+		shard.workingSize = 0
+	}
+	if shard.workingSize == 0 {
+		shard.SegmentCount++
+	}
+	shard.workingSize += int64(size)
+	shard.RowCount += int64(rowCount)
+	shard.Size += int64(size)
+	return nil
+}
+
+// keepPK returns false and marks the report truncated if there are too many primary keys to keep a new one
+func (r *importReporter) keepPK() bool {
+	if len(r.intPKs)+len(r.strPKs) < maxDryRunPKs {
+		return true
+	}
+	r.report.PKsTruncated = true
+	return false
+}
+
+// dryRunRowHandler checks the rows for the dry-run report, only the valid rows are passed to the consumer
+type dryRunRowHandler struct {
+	reporter *importReporter
+	consumer JSONRowHandler
+}
+
+func (h *dryRunRowHandler) Handle(rows []map[storage.FieldID]interface{}) error {
+	if rows == nil {
+		return h.consumer.Handle(nil)
+	}
+
+	validRows := make([]map[storage.FieldID]interface{}, 0, len(rows))
+	for _, row := range rows {
+		if h.reporter.checkRow(row) {
+			validRows = append(validRows, row)
+		}
+	}
+	h.reporter.resetScratch()
+
+	if len(validRows) == 0 {
+		return nil
+	}
+	return h.consumer.Handle(validRows)
+}
@@ -0,0 +1,282 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func sampleReportRow(pk string) map[storage.FieldID]interface{} {
+	return map[storage.FieldID]interface{}{
+		102: true,
+		103: json.Number("1"),
+		104: json.Number("2"),
+		105: json.Number("3"),
+		106: json.Number(pk),
+		107: json.Number("1.5"),
+		108: json.Number("2.5"),
+		109: "a",
+		110: []interface{}{json.Number("1"), json.Number("2")},
+		111: []interface{}{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4")},
+	}
+}
+
+func Test_NewImportReporter(t *testing.T) {
+	reporter, err := newImportReporter(nil, 2, 1024)
+	assert.Error(t, err)
+	assert.Nil(t, reporter)
+
+	reporter, err = newImportReporter(sampleSchema(), 2, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(reporter.report.Fields))
+	assert.Equal(t, 2, len(reporter.report.Shards))
+
+	// auto-generated primary key is not checked
+	schema := sampleSchema()
+	schema.Fields[4].AutoID = true
+	reporter, err = newImportReporter(schema, 2, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, len(reporter.report.Fields))
+
+	schema.Fields[4].IsPrimaryKey = false
+	reporter, err = newImportReporter(schema, 2, 1024)
+	assert.Error(t, err)
+	assert.Nil(t, reporter)
+}
+
+func Test_ImportReporterCheckRow(t *testing.T) {
+	reporter, err := newImportReporter(sampleSchema(), 2, 1024)
+	assert.NoError(t, err)
+	reporter.startFile("a.json")
+
+	assert.True(t, reporter.checkRow(sampleReportRow("1")))
+
+	row := sampleReportRow("2")
+	row[102] = nil
+	delete(row, 109)
+	row[103] = json.Number("1000")
+	row[110] = []interface{}{json.Number("1")}
+	row[111] = []interface{}{json.Number("1")}
+	assert.False(t, reporter.checkRow(row))
+
+	row = sampleReportRow("3")
+	row[111] = []interface{}{json.Number("1"), json.Number("2"), json.Number("3"), "x"}
+	assert.False(t, reporter.checkRow(row))
+
+	fields := reporter.report.Fields
+	assert.Equal(t, FieldReport{NullCount: 1}, *fields["FieldBool"])
+	assert.Equal(t, FieldReport{NullCount: 1}, *fields["FieldString"])
+	assert.Equal(t, FieldReport{InvalidCount: 1}, *fields["FieldInt8"])
+	assert.Equal(t, FieldReport{DimMismatchCount: 1}, *fields["FieldBinaryVector"])
+	assert.Equal(t, FieldReport{InvalidCount: 1, DimMismatchCount: 1}, *fields["FieldFloatVector"])
+	assert.Equal(t, FieldReport{}, *fields["FieldInt64"])
+
+	// invalid rows of CSV file
+	reporter.invalidCSVRow([]*CSVRowError{
+		{Field: "FieldBool", err: errEmptyValue},
+		{Field: "FieldFloatVector", err: errDimensionMismatch},
+		{Field: "FieldInt8", err: errors.New("error")},
+	})
+	reporter.invalidCSVRow([]*CSVRowError{{Reason: "the row has 1 columns"}})
+	assert.Equal(t, FieldReport{NullCount: 2}, *fields["FieldBool"])
+	assert.Equal(t, FieldReport{InvalidCount: 1, DimMismatchCount: 2}, *fields["FieldFloatVector"])
+	assert.Equal(t, FieldReport{InvalidCount: 2}, *fields["FieldInt8"])
+
+	// invalid row of parquet file
+	reporter.invalidParquetRow(map[string]error{
+		"FieldBool":         errEmptyValue,
+		"FieldBinaryVector": errDimensionMismatch,
+		"FieldDouble":       errors.New("error"),
+	})
+	assert.Equal(t, FieldReport{NullCount: 3}, *fields["FieldBool"])
+	assert.Equal(t, FieldReport{DimMismatchCount: 2}, *fields["FieldBinaryVector"])
+	assert.Equal(t, FieldReport{InvalidCount: 1}, *fields["FieldDouble"])
+
+	reporter.finishFile(errors.New("error"))
+	assert.Equal(t, FileReport{File: "a.json", RowCount: 6, InvalidRows: 5, Error: "error"}, *reporter.report.Files[0])
+	assert.Equal(t, 1, reporter.failedFiles())
+}
+
+func Test_ImportReporterAddShardData(t *testing.T) {
+	reporter, err := newImportReporter(sampleSchema(), 2, 128)
+	assert.NoError(t, err)
+
+	newFields := func(pks ...int64) map[storage.FieldID]storage.FieldData {
+		return map[storage.FieldID]storage.FieldData{
+			106: &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+		}
+	}
+	// 56 bytes including the NumRows, the first segment
+	assert.NoError(t, reporter.addShardData(newFields(1, 2, 3, 4, 5, 6), 0))
+	// 56 bytes, still the first segment
+	assert.NoError(t, reporter.addShardData(newFields(7, 8, 9, 10, 11, 1), 0))
+	// 24 bytes, exceeds the segment size, the second segment
+	assert.NoError(t, reporter.addShardData(newFields(12, 2), 0))
+	assert.NoError(t, reporter.addShardData(newFields(13), 1))
+	assert.Error(t, reporter.addShardData(newFields(14), 2))
+
+	assert.Equal(t, int64(2), reporter.report.DuplicatedPKs)
+	assert.False(t, reporter.report.PKsTruncated)
+	assert.Equal(t, ShardReport{ShardID: 0, RowCount: 14, Size: 136, SegmentCount: 2, workingSize: 24}, *reporter.report.Shards[0])
+	assert.Equal(t, ShardReport{ShardID: 1, RowCount: 1, Size: 16, SegmentCount: 1, workingSize: 16}, *reporter.report.Shards[1])
+}
+
+func Test_ImportReporterTruncatePKs(t *testing.T) {
+	defer func(max int) {
+		maxDryRunPKs = max
+	}(maxDryRunPKs)
+	maxDryRunPKs = 3
+
+	reporter, err := newImportReporter(sampleSchema(), 1, 1024)
+	assert.NoError(t, err)
+	pks := []int64{1, 2, 3, 4, 5, 1, 4}
+	err = reporter.addShardData(map[storage.FieldID]storage.FieldData{
+		106: &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+	}, 0)
+	assert.NoError(t, err)
+
+	// the primary keys after the first 3 are not kept, the duplicated 4 is not counted
+	assert.Equal(t, 3, len(reporter.intPKs))
+	assert.Equal(t, int64(1), reporter.report.DuplicatedPKs)
+	assert.True(t, reporter.report.PKsTruncated)
+	assert.Equal(t, int64(7), reporter.report.Shards[0].RowCount)
+}
+
+func Test_DryRunRowHandler(t *testing.T) {
+	reporter, err := newImportReporter(sampleSchema(), 2, 1024)
+	assert.NoError(t, err)
+	reporter.startFile("a.json")
+
+	consumer := &mockJSONRowConsumer{}
+	handler := &dryRunRowHandler{reporter: reporter, consumer: consumer}
+	invalidRow := sampleReportRow("2")
+	invalidRow[102] = nil
+	assert.NoError(t, handler.Handle([]map[storage.FieldID]interface{}{sampleReportRow("1"), invalidRow}))
+	assert.NoError(t, handler.Handle([]map[storage.FieldID]interface{}{invalidRow}))
+	assert.NoError(t, handler.Handle(nil))
+	// only the valid rows are consumed
+	assert.Equal(t, 1, len(consumer.rows))
+	assert.Equal(t, 2, consumer.handleCount)
+	assert.Equal(t, int64(3), reporter.current.RowCount)
+	assert.Equal(t, int64(2), reporter.current.InvalidRows)
+}
+
+func Test_ImportWrapperDryRun(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	jsonContent := []byte(`{
+		"rows":[
+			{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 1, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+			{"FieldBool": null, "FieldInt8": 11, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 2, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3]},
+			{"FieldBool": true, "FieldInt8": 1000, "FieldInt16": 103, "FieldInt32": 1003, "FieldInt64": 3, "FieldFloat": 3.16, "FieldDouble": 3.56, "FieldString": "hello world", "FieldBinaryVector": [252, 0], "FieldFloatVector": [3.1, 3.2, 3.3, 3.4]},
+			{"FieldBool": true, "FieldInt8": 12, "FieldInt16": 104, "FieldInt32": 1004, "FieldInt64": 4, "FieldFloat": 3.17, "FieldDouble": 4.56, "FieldBinaryVector": [251, 0], "FieldFloatVector": [4.1, 4.2, 4.3, 4.4]}
+		]
+	}`)
+	jsonFilePath := TempFilesPath + "rows_1.json"
+	err = cm.Write(ctx, jsonFilePath, jsonContent)
+	assert.NoError(t, err)
+
+	// the primary keys 0 and 1 are duplicated with the json file, the last row has empty int8 value
+	csvFilePath := TempFilesPath + "rows_2.csv"
+	csvContent := string(createSampleCSV(t, 4, ',')) + "true,,2,3,4,5.5,6.5,a,\"[1, 2]\",\"[1, 2, 3, 4]\"\n"
+	err = cm.Write(ctx, csvFilePath, []byte(csvContent))
+	assert.NoError(t, err)
+
+	// the file has no valid column
+	badFilePath := TempFilesPath + "rows_3.csv"
+	err = cm.Write(ctx, badFilePath, []byte("dummy\n1\n"))
+	assert.NoError(t, err)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	options := DefaultImportOptions()
+	options.DryRun = true
+	err = wrapper.Import([]string{jsonFilePath, csvFilePath, badFilePath}, options)
+	assert.NoError(t, err)
+	// nothing is persisted
+	assert.Equal(t, 0, rowCounter.rowCount)
+	assert.Nil(t, wrapper.reporter)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRunReport, importResult.GetInfos())
+	assert.NoError(t, err)
+	report := &ImportReport{}
+	err = json.Unmarshal([]byte(value), report)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(report.Files))
+	// the missing field of the last json row is counted as null value
+	assert.Equal(t, FileReport{File: jsonFilePath, RowCount: 4, InvalidRows: 3}, *report.Files[0])
+	assert.Equal(t, FileReport{File: csvFilePath, RowCount: 5, InvalidRows: 1}, *report.Files[1])
+	assert.Equal(t, badFilePath, report.Files[2].File)
+	assert.True(t, strings.Contains(report.Files[2].Error, "dummy"))
+
+	assert.Equal(t, FieldReport{NullCount: 1}, *report.Fields["FieldBool"])
+	assert.Equal(t, FieldReport{NullCount: 1, InvalidCount: 1}, *report.Fields["FieldInt8"])
+	assert.Equal(t, FieldReport{DimMismatchCount: 1}, *report.Fields["FieldFloatVector"])
+	assert.Equal(t, FieldReport{NullCount: 1}, *report.Fields["FieldString"])
+	assert.Equal(t, int64(1), report.DuplicatedPKs)
+
+	rowCount := int64(0)
+	segmentCount := int64(0)
+	for _, shard := range report.Shards {
+		rowCount += shard.RowCount
+		segmentCount += shard.SegmentCount
+	}
+	assert.Equal(t, int64(5), rowCount)
+	assert.Less(t, int64(0), segmentCount)
+}
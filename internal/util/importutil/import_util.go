@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isCanceled(ctx context.Context) bool {
@@ -113,6 +114,16 @@ func initSegmentData(collectionSchema *schemapb.CollectionSchema) map[storage.Fi
 				Data:    make([]string, 0),
 				NumRows: []int64{0},
 			}
+		case typeutil.DataTypeJSON:
+			segmentData[schema.GetFieldID()] = &storage.JSONFieldData{
+				Data:    make([][]byte, 0),
+				NumRows: []int64{0},
+			}
+		case typeutil.DataTypeArray:
+			segmentData[schema.GetFieldID()] = &storage.ArrayFieldData{
+				Data:    make([]*schemapb.ScalarField, 0),
+				NumRows: []int64{0},
+			}
 		default:
 			log.Error("Import util: unsupported data type", zap.String("DataType", getTypeName(schema.DataType)))
 			return nil
@@ -136,6 +147,100 @@ func parseFloat(s string, bitsize int, fieldName string) (float64, error) {
 	return value, nil
 }
 
+// parseArray converts the elements of a JSON array to an array of the element type
+func parseArray(elementType schemapb.DataType, arr []interface{}, fieldName string) (*schemapb.ScalarField, error) {
+	illegalElement := func(element interface{}) error {
+		return fmt.Errorf("illegal element '%v' for array field '%s', element type is %s", element, fieldName, getTypeName(elementType))
+	}
+
+	switch elementType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(arr))
+		for _, element := range arr {
+			value, ok := element.(bool)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			data = append(data, value)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		bitSize := 32
+		if elementType == schemapb.DataType_Int8 {
+			bitSize = 8
+		} else if elementType == schemapb.DataType_Int16 {
+			bitSize = 16
+		}
+		data := make([]int32, 0, len(arr))
+		for _, element := range arr {
+			num, ok := element.(json.Number)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			value, err := strconv.ParseInt(string(num), 0, bitSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse element '%v' for array field '%s', error: %w", num, fieldName, err)
+			}
+			data = append(data, int32(value))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(arr))
+		for _, element := range arr {
+			num, ok := element.(json.Number)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			value, err := strconv.ParseInt(string(num), 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse element '%v' for array field '%s', error: %w", num, fieldName, err)
+			}
+			data = append(data, value)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, len(arr))
+		for _, element := range arr {
+			num, ok := element.(json.Number)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			value, err := parseFloat(string(num), 32, fieldName)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, float32(value))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, len(arr))
+		for _, element := range arr {
+			num, ok := element.(json.Number)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			value, err := parseFloat(string(num), 64, fieldName)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, value)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, 0, len(arr))
+		for _, element := range arr {
+			value, ok := element.(string)
+			if !ok {
+				return nil, illegalElement(element)
+			}
+			data = append(data, value)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupport element type %s of array field '%s'", getTypeName(elementType), fieldName)
+	}
+}
+
 // initValidators constructs valiator methods and data conversion methods
 func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[storage.FieldID]*Validator) error {
 	if collectionSchema == nil {
@@ -323,6 +428,45 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 				}
 				return nil
 			}
+		case typeutil.DataTypeJSON:
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				// a JSON field accepts either a JSON document encoded in a string, or any JSON value
+				var doc []byte
+				if value, ok := obj.(string); ok {
+					doc = []byte(value)
+					if !json.Valid(doc) {
+						return fmt.Errorf("illegal value '%v' for JSON type field '%s', not a valid JSON document", obj, schema.GetName())
+					}
+				} else {
+					var err error
+					doc, err = json.Marshal(obj)
+					if err != nil {
+						return fmt.Errorf("illegal value '%v' for JSON type field '%s', error: %w", obj, schema.GetName(), err)
+					}
+				}
+				field.(*storage.JSONFieldData).Data = append(field.(*storage.JSONFieldData).Data, doc)
+				field.(*storage.JSONFieldData).NumRows[0]++
+				return nil
+			}
+		case typeutil.DataTypeArray:
+			if err := typeutil.ValidateArrayField(schema); err != nil {
+				return err
+			}
+			elementType, _ := typeutil.GetElementType(schema)
+
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				arr, ok := obj.([]interface{})
+				if !ok {
+					return fmt.Errorf("'%v' is not an array for array field '%s'", obj, schema.GetName())
+				}
+				value, err := parseArray(elementType, arr, schema.GetName())
+				if err != nil {
+					return err
+				}
+				field.(*storage.ArrayFieldData).Data = append(field.(*storage.ArrayFieldData).Data, value)
+				field.(*storage.ArrayFieldData).NumRows[0]++
+				return nil
+			}
 		default:
 			return fmt.Errorf("unsupport data type: %s", getTypeName(collectionSchema.Fields[i].DataType))
 		}
@@ -515,6 +659,10 @@ func getTypeName(dt schemapb.DataType) string {
 		return "BinaryVector"
 	case schemapb.DataType_FloatVector:
 		return "FloatVector"
+	case typeutil.DataTypeJSON:
+		return "JSON"
+	case typeutil.DataTypeArray:
+		return "Array"
 	default:
 		return "InvalidType"
 	}
@@ -24,7 +24,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -398,6 +400,89 @@ func Test_InitValidators(t *testing.T) {
 		checkConvertFunc("FieldFloatVector", validVal, invalidVal)
 	})
 
+	t.Run("check JSON convert function", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Name:   "schema",
+			AutoID: true,
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  102,
+					Name:     "FieldJSON",
+					DataType: typeutil.DataTypeJSON,
+				},
+			},
+		}
+		validators := make(map[storage.FieldID]*Validator)
+		err := initValidators(schema, validators)
+		assert.Nil(t, err)
+		assert.False(t, validators[102].isString)
+
+		fields := initSegmentData(schema)
+		assert.NotNil(t, fields)
+		fieldData := fields[102]
+
+		// a JSON document encoded in a string
+		err = validators[102].convertFunc(`{"a": 1}`, fieldData)
+		assert.Nil(t, err)
+		// a decoded JSON object
+		err = validators[102].convertFunc(map[string]interface{}{"b": []interface{}{jsonNumber("2"), "c"}}, fieldData)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, fieldData.RowNum())
+		assert.Equal(t, []byte(`{"a": 1}`), fieldData.GetRow(0))
+		assert.Equal(t, []byte(`{"b":[2,"c"]}`), fieldData.GetRow(1))
+
+		// a string which is not a valid JSON document
+		err = validators[102].convertFunc("{a", fieldData)
+		assert.NotNil(t, err)
+		assert.Equal(t, 2, fieldData.RowNum())
+	})
+
+	t.Run("check array convert function", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Name:   "schema",
+			AutoID: true,
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  102,
+					Name:     "FieldArray",
+					DataType: typeutil.DataTypeArray,
+					TypeParams: []*commonpb.KeyValuePair{
+						{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+					},
+				},
+			},
+		}
+		validators := make(map[storage.FieldID]*Validator)
+		err := initValidators(schema, validators)
+		assert.Nil(t, err)
+
+		fields := initSegmentData(schema)
+		assert.NotNil(t, fields)
+		fieldData := fields[102]
+
+		err = validators[102].convertFunc([]interface{}{jsonNumber("1"), jsonNumber("2")}, fieldData)
+		assert.Nil(t, err)
+		err = validators[102].convertFunc([]interface{}{}, fieldData)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, fieldData.RowNum())
+		assert.Equal(t, []int64{1, 2}, fieldData.GetRow(0).(*schemapb.ScalarField).GetLongData().GetData())
+		assert.Empty(t, fieldData.GetRow(1).(*schemapb.ScalarField).GetLongData().GetData())
+
+		// not an array, or an element of the wrong type
+		err = validators[102].convertFunc(jsonNumber("1"), fieldData)
+		assert.NotNil(t, err)
+		err = validators[102].convertFunc([]interface{}{"a"}, fieldData)
+		assert.NotNil(t, err)
+		err = validators[102].convertFunc([]interface{}{jsonNumber("1.5")}, fieldData)
+		assert.NotNil(t, err)
+		assert.Equal(t, 2, fieldData.RowNum())
+
+		// the element type must be specified
+		schema.Fields[0].TypeParams = nil
+		err = initValidators(schema, validators)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("init error cases", func(t *testing.T) {
 		schema = &schemapb.CollectionSchema{
 			Name:        "schema",
@@ -608,6 +693,10 @@ func Test_GetTypeName(t *testing.T) {
 	assert.NotEmpty(t, str)
 	str = getTypeName(schemapb.DataType_FloatVector)
 	assert.NotEmpty(t, str)
+	str = getTypeName(typeutil.DataTypeJSON)
+	assert.Equal(t, "JSON", str)
+	str = getTypeName(typeutil.DataTypeArray)
+	assert.Equal(t, "Array", str)
 	str = getTypeName(schemapb.DataType_None)
 	assert.Equal(t, "InvalidType", str)
 }
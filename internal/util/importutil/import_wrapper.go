@@ -19,6 +19,7 @@ package importutil
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -38,8 +39,11 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
+	TSVFileExt     = ".tsv"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -59,12 +63,19 @@ const (
 	// the total memory size might cause OOM.
 	MaxTotalSizeInMemory = 2 * 1024 * 1024 * 1024 // 2GB
 
+	// a large row-based file makes a checkpoint every DefaultCheckpointRows rows, so that a resumed task
+	// doesn't import the file from the beginning. The working segments are sealed at each checkpoint.
+	DefaultCheckpointRows = 1000000
+
 	// keywords of import task informations
 	FailedReason    = "failed_reason"
 	Files           = "files"
 	CollectionName  = "collection"
 	PartitionName   = "partition"
 	PersistTimeCost = "persist_cost"
+	FailedRows      = "failed_rows"
+	DryRunReport    = "dry_run_report"
+	Checkpoint      = "checkpoint"
 )
 
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
@@ -103,6 +114,9 @@ type ImportWrapper struct {
 	reportImportAttempts uint                                      // attempts count if report function get error
 
 	workingSegments map[int]*WorkingSegment // a map shard id to working segments
+	reporter        *importReporter         // collect statistics instead of persisting data for dry-run import
+	checkpoint      *ImportCheckpoint       // progress of row-based files, nil if the progress is not reported
+	checkpointRows  int64                   // count of rows between two checkpoints of a file
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -142,6 +156,7 @@ func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.Collection
 		reportFunc:           reportFunc,
 		reportImportAttempts: ReportImportAttempts,
 		workingSegments:      make(map[int]*WorkingSegment),
+		checkpointRows:       DefaultCheckpointRows,
 	}
 
 	return wrapper
@@ -212,7 +227,7 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 }
 
 // fileValidation verify the input paths
-// if all the files are json type, return true
+// if all the files are json or parquet type, return true, each file contains all the fields
 // if all the files are numpy type, return false, and not allow duplicate file name
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
@@ -224,21 +239,22 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, parquet file, csv/tsv file or numpy file
+		if fileType != JSONFileExt && fileType != NumpyFileExt && fileType != ParquetFileExt &&
+			fileType != CSVFileExt && fileType != TSVFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && fileType != NumpyFileExt {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based support json, parquet and csv/tsv type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if fileType == NumpyFileExt {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -315,6 +331,26 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		return err
 	}
 
+	// for dry-run import, the flushFunc collects statistics instead of persisting data,
+	// and the error of each file is reported instead of stopping the import
+	if options.DryRun {
+		p.reporter, err = newImportReporter(p.collectionSchema, p.shardNum, p.segmentSize)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			p.reporter = nil
+		}()
+	}
+
+	// the progress of row-based files is reported as checkpoints, a resumed task continues from its checkpoint
+	if rowBased && !options.DryRun && !options.OnlyValidate {
+		p.initCheckpoint(options.Checkpoint)
+		defer func() {
+			p.checkpoint = nil
+		}()
+	}
+
 	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
 		// parse and consume row-based files
@@ -325,14 +361,44 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			_, fileType := GetFileNameAndExt(filePath)
 			log.Info("import wrapper:  row-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
 
+			if p.checkpoint != nil && p.checkpoint.isFinished(filePath) {
+				log.Info("import wrapper: skip the file finished before the checkpoint", zap.String("filePath", filePath))
+				continue
+			}
+
+			if p.reporter != nil {
+				p.reporter.startFile(filePath)
+			}
 			if fileType == JSONFileExt {
 				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
 				if err != nil {
 					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
-					return err
+				}
+			} else if fileType == ParquetFileExt {
+				err = p.parseParquet(filePath, options.OnlyValidate)
+				if err != nil {
+					log.Error("import wrapper: failed to parse parquet file", zap.Error(err), zap.String("filePath", filePath))
+				}
+			} else if fileType == CSVFileExt || fileType == TSVFileExt {
+				err = p.parseCSV(filePath, options.OnlyValidate, options.CSV)
+				if err != nil {
+					log.Error("import wrapper: failed to parse csv file", zap.Error(err), zap.String("filePath", filePath))
 				}
 			} // no need to check else, since the fileValidation() already do this
 
+			if p.reporter != nil {
+				p.reporter.finishFile(err)
+			} else if err != nil {
+				return err
+			}
+
+			if p.checkpoint != nil {
+				err = p.saveFileCheckpoint(filePath)
+				if err != nil {
+					return err
+				}
+			}
+
 			// trigger gc after each file finished
 			triggerGC()
 		}
@@ -395,10 +461,17 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			log.Info("import wrapper:  column-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
 
 			if fileType == NumpyFileExt {
+				if p.reporter != nil {
+					p.reporter.startFile(filePath)
+				}
 				err = p.parseColumnBasedNumpy(filePath, options.OnlyValidate, combineFunc)
 
 				if err != nil {
 					log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", filePath))
+				}
+				if p.reporter != nil {
+					p.reporter.finishFile(err)
+				} else if err != nil {
 					return err
 				}
 			}
@@ -408,19 +481,48 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		// trigger after read finished
 		triggerGC()
 
-		// split fields data into segments
-		err := p.splitFieldsData(fieldsData, SingleBlockSize)
-		if err != nil {
-			return err
+		// the columns can't be combined into segments if any file is invalid
+		if p.reporter == nil || p.reporter.failedFiles() == 0 {
+			// split fields data into segments
+			err := p.splitFieldsData(fieldsData, SingleBlockSize)
+			if err != nil {
+				return err
+			}
 		}
 
 		// trigger after write finished
 		triggerGC()
 	}
 
+	if p.reporter != nil {
+		return p.reportDryRun(p.reportImportAttempts, tr)
+	}
 	return p.reportPersisted(p.reportImportAttempts, tr)
 }
 
+// reportDryRun reports the statistics of a dry-run import to rootcoord, the task is marked ImportPersisted
+// without any segment, so that the rootcoord completes it directly.
+func (p *ImportWrapper) reportDryRun(reportAttempts uint, tr *timerecord.TimeRecorder) error {
+	if tr != nil {
+		ts := tr.Elapse("dry run finished").Seconds()
+		p.importResult.Infos = append(p.importResult.Infos,
+			&commonpb.KeyValuePair{Key: PersistTimeCost, Value: strconv.FormatFloat(ts, 'f', 2, 64)})
+	}
+	p.importResult.Infos = append(p.importResult.Infos, &commonpb.KeyValuePair{Key: DryRunReport, Value: p.reporter.report.JSON()})
+
+	// the auto-generated ids are not used
+	p.importResult.AutoIds = make([]int64, 0)
+	p.importResult.State = commonpb.ImportState_ImportPersisted
+	reportErr := retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.importResult)
+	}, retry.Attempts(reportAttempts))
+	if reportErr != nil {
+		log.Warn("import wrapper: fail to report dry-run import state to RootCoord", zap.Error(reportErr))
+		return reportErr
+	}
+	return nil
+}
+
 // reportPersisted notify the rootcoord to mark the task state to be ImportPersisted
 func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.TimeRecorder) error {
 	// force close all segments
@@ -448,6 +550,89 @@ func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.Time
 	return nil
 }
 
+// initCheckpoint starts tracking the progress of row-based files, for a resumed task, the segments, row count and
+// auto-generated ids before the checkpoint are added into the import result
+func (p *ImportWrapper) initCheckpoint(checkpoint *ImportCheckpoint) {
+	if checkpoint == nil {
+		p.checkpoint = &ImportCheckpoint{
+			FinishedFiles: make([]string, 0),
+			Segments:      make([]int64, 0),
+			AutoIds:       make([]int64, 0),
+		}
+		return
+	}
+
+	log.Info("import wrapper: resume import from checkpoint", zap.Strings("finishedFiles", checkpoint.FinishedFiles),
+		zap.String("file", checkpoint.File), zap.Int64("rowOffset", checkpoint.RowOffset),
+		zap.Int64s("segments", checkpoint.Segments))
+	p.checkpoint = checkpoint
+	p.importResult.Segments = append(p.importResult.Segments, checkpoint.Segments...)
+	p.importResult.RowCount += checkpoint.RowCount
+	p.importResult.AutoIds = append(p.importResult.AutoIds, checkpoint.AutoIds...)
+}
+
+// newCheckpointRowHandler creates a JSONRowHandler to skip the rows before the checkpoint and make new checkpoints
+func (p *ImportWrapper) newCheckpointRowHandler(filePath string, consumer *JSONRowConsumer) *checkpointRowHandler {
+	return &checkpointRowHandler{
+		wrapper:  p,
+		consumer: consumer,
+		filePath: filePath,
+		skipRows: p.checkpoint.skipRows(filePath),
+	}
+}
+
+// saveFileCheckpoint makes a checkpoint after a file is fully imported
+func (p *ImportWrapper) saveFileCheckpoint(filePath string) error {
+	p.checkpoint.FinishedFiles = append(p.checkpoint.FinishedFiles, filePath)
+	p.checkpoint.File = ""
+	p.checkpoint.RowOffset = 0
+	return p.saveCheckpoint(nil)
+}
+
+// saveRowOffsetCheckpoint makes a checkpoint in the middle of a file, autoIDs are the id ranges generated for the file
+// which are not recorded in the import result yet
+func (p *ImportWrapper) saveRowOffsetCheckpoint(filePath string, rowOffset int64, autoIDs []int64) error {
+	p.checkpoint.File = filePath
+	p.checkpoint.RowOffset = rowOffset
+	return p.saveCheckpoint(autoIDs)
+}
+
+func (p *ImportWrapper) saveCheckpoint(autoIDs []int64) error {
+	// seal all the working segments so that the rows before the checkpoint are persisted
+	err := p.closeAllWorkingSegments()
+	if err != nil {
+		return err
+	}
+
+	p.checkpoint.Segments = append(make([]int64, 0, len(p.importResult.Segments)), p.importResult.Segments...)
+	p.checkpoint.RowCount = p.importResult.RowCount
+	p.checkpoint.AutoIds = make([]int64, 0, len(p.importResult.AutoIds)+len(autoIDs))
+	p.checkpoint.AutoIds = append(p.checkpoint.AutoIds, p.importResult.AutoIds...)
+	p.checkpoint.AutoIds = append(p.checkpoint.AutoIds, autoIDs...)
+	return p.reportCheckpoint()
+}
+
+// reportCheckpoint notifies the rootcoord the progress of the task and the persisted segments, the task state
+// is still ImportStarted
+func (p *ImportWrapper) reportCheckpoint() error {
+	infos := make([]*commonpb.KeyValuePair, 0, len(p.importResult.Infos)+1)
+	for _, kv := range p.importResult.Infos {
+		if kv.GetKey() != Checkpoint {
+			infos = append(infos, kv)
+		}
+	}
+	p.importResult.Infos = append(infos, &commonpb.KeyValuePair{Key: Checkpoint, Value: p.checkpoint.JSON()})
+
+	reportErr := retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.importResult)
+	}, retry.Attempts(p.reportImportAttempts))
+	if reportErr != nil {
+		log.Warn("import wrapper: fail to report import checkpoint to RootCoord", zap.Error(reportErr))
+		return reportErr
+	}
+	return nil
+}
+
 // isBinlogImport is to judge whether it is binlog import operation
 // For internal usage by the restore tool: https://github.com/zilliztech/milvus-backup
 // This tool exports data from a milvus service, and call bulkload interface to import native data into another milvus service.
@@ -548,7 +733,15 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 		return err
 	}
 
-	err = parser.ParseRows(reader, consumer)
+	var handler JSONRowHandler = consumer
+	if p.reporter != nil {
+		handler = &dryRunRowHandler{reporter: p.reporter, consumer: consumer}
+		// the missing values are counted as null values by the reporter
+		parser.allowMissingFields = true
+	} else if p.checkpoint != nil {
+		handler = p.newCheckpointRowHandler(filePath, consumer)
+	}
+	err = parser.ParseRows(reader, handler)
 	if err != nil {
 		return err
 	}
@@ -594,6 +787,9 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 
 	// the numpy parser return a storage.FieldData, here construct a map[string]storage.FieldData to combine
 	flushFunc := func(field storage.FieldData) error {
+		if p.reporter != nil {
+			p.reporter.addFileRows(field.RowNum())
+		}
 		fields := make(map[storage.FieldID]storage.FieldData)
 		fields[id] = field
 		return combineFunc(fields)
@@ -610,6 +806,141 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 	return nil
 }
 
+// parseParquet is the entry of parquet import operation, the row groups of the file are parsed one by one
+// and the fields data of each row group is split into shards by splitFieldsData()
+func (p *ImportWrapper) parseParquet(filePath string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+	// the parquet reader reads the footer and the row groups on demand instead of the whole file
+	reader, err := newChunkFileReader(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		log.Error("import wrapper: failed to open parquet file", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to open parquet file '%s', error: %w", filePath, err)
+	}
+
+	// the checkpoints of parquet file are made between row groups, so the skipped rows are whole row groups
+	var skipRows int64
+	if p.checkpoint != nil {
+		skipRows = p.checkpoint.skipRows(filePath)
+	}
+	rowOffset, lastOffset := skipRows, skipRows
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		rowCount := int64(0)
+		for _, data := range fields {
+			rowCount = int64(data.RowNum())
+			break
+		}
+
+		fieldsData := initSegmentData(p.collectionSchema)
+		if fieldsData == nil {
+			log.Error("import wrapper: failed to initialize FieldData list")
+			return fmt.Errorf("failed to initialize FieldData list")
+		}
+		for id, data := range fields {
+			fieldsData[id] = data
+		}
+		if p.reporter != nil {
+			for _, data := range fields {
+				p.reporter.addFileRows(data.RowNum())
+				break
+			}
+		}
+
+		printFieldsDataInfo(fieldsData, "import wrapper: prepare to split parquet row group", []string{filePath})
+		err := p.splitFieldsData(fieldsData, SingleBlockSize)
+		if err != nil {
+			return err
+		}
+
+		// the auto-generated ids of parquet file are recorded by splitFieldsData()
+		rowOffset += rowCount
+		if p.checkpoint != nil && p.checkpointRows > 0 && rowOffset-lastOffset >= p.checkpointRows {
+			err = p.saveRowOffsetCheckpoint(filePath, rowOffset, nil)
+			if err != nil {
+				return err
+			}
+			lastOffset = rowOffset
+		}
+		return nil
+	}
+
+	parser := NewParquetParser(p.ctx, p.collectionSchema, flushFunc)
+	if p.reporter != nil {
+		parser.invalidRowFunc = p.reporter.invalidParquetRow
+	}
+	err = parser.Parse(reader, skipRows, onlyValidate)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseCSV is the entry of csv/tsv import operation, the rows are consumed by JSONRowConsumer,
+// the invalid rows are reported in the import task infos
+func (p *ImportWrapper) parseCSV(filePath string, onlyValidate bool, options CSVOptions) error {
+	tr := timerecord.NewTimeRecorder("csv parser: " + filePath)
+
+	// tab is the default delimiter of tsv file
+	_, fileType := GetFileNameAndExt(filePath)
+	if options.Delimiter == 0 && fileType == TSVFileExt {
+		options.Delimiter = '\t'
+	}
+	parser, err := NewCSVParser(p.ctx, p.collectionSchema, options)
+	if err != nil {
+		return err
+	}
+
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if onlyValidate {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", []string{filePath})
+			return p.flushFunc(fields, shardID)
+		}
+	}
+
+	consumer, err := NewJSONRowConsumer(p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
+	if err != nil {
+		return err
+	}
+
+	var handler JSONRowHandler = consumer
+	if p.reporter != nil {
+		handler = &dryRunRowHandler{reporter: p.reporter, consumer: consumer}
+		parser.invalidRowFunc = p.reporter.invalidCSVRow
+	} else if p.checkpoint != nil {
+		handler = p.newCheckpointRowHandler(filePath, consumer)
+	}
+	err = parser.ParseRows(bufio.NewReader(file), filePath, handler)
+	if err != nil {
+		var rowErrors CSVRowErrors
+		if errors.As(err, &rowErrors) {
+			p.importResult.Infos = append(p.importResult.Infos, &commonpb.KeyValuePair{Key: FailedRows, Value: rowErrors.JSON()})
+		}
+		return err
+	}
+
+	// for row-based files, auto-id is generated within JSONRowConsumer
+	p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
+
+	tr.Elapse("parsed")
+	return nil
+}
+
 // appendFunc defines the methods to append data to storage.FieldData
 func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storage.FieldData, n int, target storage.FieldData) error {
 	switch schema.DataType {
@@ -682,6 +1013,20 @@ func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storag
 			arr.Data = append(arr.Data, src.GetRow(n).(string))
 			return nil
 		}
+	case typeutil.DataTypeJSON:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.JSONFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte))
+			arr.NumRows[0]++
+			return nil
+		}
+	case typeutil.DataTypeArray:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.ArrayFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).(*schemapb.ScalarField))
+			arr.NumRows[0]++
+			return nil
+		}
 	default:
 		return nil
 	}
@@ -860,6 +1205,11 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 		return nil
 	}
 
+	// dry-run import, no segment is generated
+	if p.reporter != nil {
+		return p.reporter.addShardData(fields, shardID)
+	}
+
 	// if there is no segment for this shard, create a new one
 	// if the segment exists and its size almost exceed segmentSize, close it and create a new one
 	var segment *WorkingSegment
@@ -873,6 +1223,14 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 			}
 			segment = nil
 			p.workingSegments[shardID] = nil
+
+			// let the rootcoord know the new segment, it is dropped if the task is resumed from the last checkpoint
+			if p.checkpoint != nil {
+				err = p.reportCheckpoint()
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 	}
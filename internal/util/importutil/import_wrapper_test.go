@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"math"
 	"os"
 	"path"
@@ -70,6 +71,10 @@ func (mc *MockChunkManager) Write(ctx context.Context, filePath string, content
 	return nil
 }
 
+func (mc *MockChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader) error {
+	return nil
+}
+
 func (mc *MockChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	return nil
 }
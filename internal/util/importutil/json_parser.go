@@ -46,6 +46,8 @@ type JSONParser struct {
 	bufSize      int64            // max rows in a buffer
 	fields       map[string]int64 // fields need to be parsed
 	name2FieldID map[string]storage.FieldID
+
+	allowMissingFields bool // if true, the rows missing some fields are passed to the handler instead of failing the file
 }
 
 // NewJSONParser helper function to create a JSONParser
@@ -79,9 +81,21 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 }
 
 func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
+	bufSize := estimateBufSize(collectionSchema)
+	if bufSize <= 0 {
+		return
+	}
+
+	log.Info("JSON parser: reset bufSize", zap.Int64("bufSize", bufSize))
+	parser.bufSize = bufSize
+}
+
+// estimateBufSize returns the rows count of a buffer for row-based parsers, returns 0 if the record size
+// cannot be estimated
+func estimateBufSize(collectionSchema *schemapb.CollectionSchema) int64 {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
-		return
+		return 0
 	}
 
 	// split the file into no more than MaxBatchCount batches to parse
@@ -95,8 +109,7 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 		bufSize = MinBufferSize
 	}
 
-	log.Info("JSON parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
-	parser.bufSize = int64(bufSize)
+	return int64(bufSize)
 }
 
 func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}, error) {
@@ -118,7 +131,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 	}
 
 	// some fields not provided?
-	if len(row) != len(p.name2FieldID) {
+	if len(row) != len(p.name2FieldID) && !p.allowMissingFields {
 		for k, v := range p.name2FieldID {
 			_, ok := row[v]
 			if !ok {
@@ -18,6 +18,7 @@ package importutil
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -148,6 +150,22 @@ func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 			return fmt.Errorf("illegal dimension %d of numpy file for binary vector field '%s', dimension should be %d",
 				shape[1]*8, schema.GetName(), p.columnDesc.dimension)
 		}
+	} else if typeutil.DataTypeJSON == schema.DataType {
+		// JSON documents are stored in a numpy string array, one document per element
+		if elementType != schemapb.DataType_VarChar {
+			log.Error("Numpy parser: illegal data type of numpy file for JSON field", zap.Any("dataType", elementType),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal data type %s of numpy file for JSON field '%s'", getTypeName(elementType), schema.GetName())
+		}
+
+		// scalar field, the shape should be 1
+		if len(shape) != 1 {
+			log.Error("Numpy parser: illegal shape of numpy file for JSON field, shape should be 1", zap.Int("shape", len(shape)),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal shape %d of numpy file for JSON field '%s', shape should be 1", shape, schema.GetName())
+		}
+
+		p.columnDesc.elementCount = shape[0]
 	} else {
 		if elementType != schema.DataType {
 			log.Error("Numpy parser: illegal data type of numpy file for scalar field", zap.Any("numpyDataType", elementType),
@@ -262,6 +280,27 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 			NumRows: []int64{int64(p.columnDesc.elementCount)},
 			Data:    data,
 		}
+	case typeutil.DataTypeJSON:
+		data, err := adapter.ReadString(p.columnDesc.elementCount)
+		if err != nil {
+			log.Error("Numpy parser: failed to read JSON array", zap.Error(err))
+			return err
+		}
+
+		docs := make([][]byte, 0, len(data))
+		for i, str := range data {
+			doc := []byte(str)
+			if !json.Valid(doc) {
+				log.Error("Numpy parser: illegal JSON document", zap.Int("index", i), zap.String("fieldName", p.columnDesc.name))
+				return fmt.Errorf("illegal JSON document at index %d of field '%s'", i, p.columnDesc.name)
+			}
+			docs = append(docs, doc)
+		}
+
+		p.columnData = &storage.JSONFieldData{
+			NumRows: []int64{int64(p.columnDesc.elementCount)},
+			Data:    docs,
+		}
 	case schemapb.DataType_BinaryVector:
 		data, err := adapter.ReadUint8(p.columnDesc.elementCount)
 		if err != nil {
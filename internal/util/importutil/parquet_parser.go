@@ -0,0 +1,691 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// chunkFileReader implements parquet.ReaderAtSeeker on a file of ChunkManager, the parquet reader reads the footer
+// and the column chunks of the requested row groups by ReadAt, so the file is never loaded into memory entirely
+type chunkFileReader struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+	filePath     string
+	size         int64
+	offset       int64
+}
+
+func newChunkFileReader(ctx context.Context, chunkManager storage.ChunkManager, filePath string) (*chunkFileReader, error) {
+	size, err := chunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkFileReader{
+		ctx:          ctx,
+		chunkManager: chunkManager,
+		filePath:     filePath,
+		size:         size,
+	}, nil
+}
+
+func (r *chunkFileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d of file '%s'", off, r.filePath)
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	length := int64(len(p))
+	if off+length > r.size {
+		length = r.size - off
+	}
+	if length == 0 {
+		return 0, nil
+	}
+	data, err := r.chunkManager.ReadAt(r.ctx, r.filePath, off, length)
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *chunkFileReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if abs < 0 {
+		return 0, fmt.Errorf("negative position %d of file '%s'", abs, r.filePath)
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+type ParquetParser struct {
+	ctx              context.Context                                          // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema                               // collection schema
+	fields           map[string]*schemapb.FieldSchema                         // fields need to be parsed, the column names are field names
+	callFlushFunc    func(fields map[storage.FieldID]storage.FieldData) error // call back function to output fields data of a row group
+	invalidRowFunc   func(fieldErrors map[string]error)                       // if set, the invalid rows are passed to it instead of failing the file
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema,
+	flushFunc func(fields map[storage.FieldID]storage.FieldData) error) *ParquetParser {
+	if collectionSchema == nil || flushFunc == nil {
+		return nil
+	}
+
+	fields := make(map[string]*schemapb.FieldSchema)
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		// if primary key field is auto-gernerated, no need to parse
+		if schema.GetAutoID() {
+			continue
+		}
+		fields[schema.GetName()] = schema
+	}
+
+	return &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		fields:           fields,
+		callFlushFunc:    flushFunc,
+	}
+}
+
+// validate checks the columns of the parquet file against the collection schema,
+// every field except the dynamic field must be provided by a column with compatible type and no redundant column
+// is allowed.
+func (p *ParquetParser) validate(arrowSchema *arrow.Schema) error {
+	columns := make(map[string]struct{})
+	for _, arrowField := range arrowSchema.Fields() {
+		schema, ok := p.fields[arrowField.Name]
+		if !ok {
+			log.Error("Parquet parser: the column is not defined in collection schema", zap.String("columnName", arrowField.Name))
+			return fmt.Errorf("the column '%s' is not defined in collection schema", arrowField.Name)
+		}
+		if err := validateParquetColumnType(schema, arrowField.Type); err != nil {
+			log.Error("Parquet parser: illegal column type", zap.String("columnName", arrowField.Name), zap.Error(err))
+			return err
+		}
+		columns[arrowField.Name] = struct{}{}
+	}
+
+	for name, schema := range p.fields {
+		// the dynamic field is optional, it's filled with empty JSON objects if the column is not provided
+		if typeutil.IsDynamicField(schema) {
+			continue
+		}
+		if _, ok := columns[name]; !ok {
+			log.Error("Parquet parser: there is no column corresponding to field", zap.String("fieldName", name))
+			return fmt.Errorf("there is no column corresponding to field '%s'", name)
+		}
+	}
+	return nil
+}
+
+func validateParquetColumnType(schema *schemapb.FieldSchema, dataType arrow.DataType) error {
+	illegalTypeErr := fmt.Errorf("illegal type %s of parquet column for %s field '%s'",
+		dataType.Name(), getTypeName(schema.GetDataType()), schema.GetName())
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar:
+		if dataType.ID() != parquetScalarType(schema.GetDataType()) {
+			return illegalTypeErr
+		}
+	case typeutil.DataTypeJSON:
+		// JSON documents are stored as strings, one document per row
+		if dataType.ID() != arrow.STRING && dataType.ID() != arrow.BINARY {
+			return illegalTypeErr
+		}
+	case typeutil.DataTypeArray:
+		// arrays are stored as lists of the element type
+		if err := typeutil.ValidateArrayField(schema); err != nil {
+			return err
+		}
+		elementType, _ := typeutil.GetElementType(schema)
+		listType, ok := dataType.(*arrow.ListType)
+		if !ok || listType.Elem().ID() != parquetScalarType(elementType) {
+			return illegalTypeErr
+		}
+	case schemapb.DataType_FloatVector:
+		// float vectors are stored as lists of float32 or float64, the dimension of a fixed size list is checked here
+		// and the length of every variable list is checked when it's read
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+		var elemType arrow.DataType
+		switch listType := dataType.(type) {
+		case *arrow.ListType:
+			elemType = listType.Elem()
+		case *arrow.FixedSizeListType:
+			if int(listType.Len()) != dim {
+				return fmt.Errorf("illegal dimension %d of parquet column for float vector field '%s', dimension should be %d",
+					listType.Len(), schema.GetName(), dim)
+			}
+			elemType = listType.Elem()
+		default:
+			return illegalTypeErr
+		}
+		if elemType.ID() != arrow.FLOAT32 && elemType.ID() != arrow.FLOAT64 {
+			return illegalTypeErr
+		}
+	case schemapb.DataType_BinaryVector:
+		// binary vectors are stored as binaries of dim/8 bytes
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+		switch binaryType := dataType.(type) {
+		case *arrow.FixedSizeBinaryType:
+			if binaryType.ByteWidth*8 != dim {
+				return fmt.Errorf("illegal dimension %d of parquet column for binary vector field '%s', dimension should be %d",
+					binaryType.ByteWidth*8, schema.GetName(), dim)
+			}
+		case *arrow.BinaryType:
+		default:
+			return illegalTypeErr
+		}
+	default:
+		return fmt.Errorf("unsupported data type %s of field '%s' for parquet file", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+	return nil
+}
+
+// parquetScalarType returns the arrow type of the parquet column for a scalar data type, arrow.NULL if the data type
+// is not a scalar
+func parquetScalarType(dataType schemapb.DataType) arrow.Type {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return arrow.BOOL
+	case schemapb.DataType_Int8:
+		return arrow.INT8
+	case schemapb.DataType_Int16:
+		return arrow.INT16
+	case schemapb.DataType_Int32:
+		return arrow.INT32
+	case schemapb.DataType_Int64:
+		return arrow.INT64
+	case schemapb.DataType_Float:
+		return arrow.FLOAT32
+	case schemapb.DataType_Double:
+		return arrow.FLOAT64
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return arrow.STRING
+	default:
+		return arrow.NULL
+	}
+}
+
+// consume converts the columns of a row group into storage.FieldData. The values are checked before conversion,
+// the first invalid value fails the row group, unless invalidRowFunc is set, then the invalid rows are passed to
+// it and skipped.
+func (p *ParquetParser) consume(table arrow.Table) (map[storage.FieldID]storage.FieldData, error) {
+	invalidRows := make(map[int]map[string]error)
+	for i := 0; i < int(table.NumCols()); i++ {
+		column := table.Column(i)
+		schema, ok := p.fields[column.Name()]
+		if !ok {
+			return nil, fmt.Errorf("the column '%s' is not defined in collection schema", column.Name())
+		}
+		valueErrors := checkParquetColumn(schema, column.Data(), p.invalidRowFunc != nil)
+		if len(valueErrors) > 0 && p.invalidRowFunc == nil {
+			log.Error("Parquet parser: illegal value", zap.String("fieldName", schema.GetName()), zap.Error(valueErrors[0].err))
+			return nil, valueErrors[0].err
+		}
+		for _, valueError := range valueErrors {
+			if _, ok := invalidRows[valueError.row]; !ok {
+				invalidRows[valueError.row] = make(map[string]error)
+			}
+			invalidRows[valueError.row][schema.GetName()] = valueError.err
+		}
+	}
+
+	skip := make(map[int]struct{}, len(invalidRows))
+	for row, fieldErrors := range invalidRows {
+		p.invalidRowFunc(fieldErrors)
+		skip[row] = struct{}{}
+	}
+
+	fields := make(map[storage.FieldID]storage.FieldData)
+	for i := 0; i < int(table.NumCols()); i++ {
+		column := table.Column(i)
+		schema := p.fields[column.Name()]
+		fieldData, err := readParquetColumn(schema, column.Data(), skip)
+		if err != nil {
+			log.Error("Parquet parser: failed to read column", zap.String("fieldName", schema.GetName()), zap.Error(err))
+			return nil, err
+		}
+		fields[schema.GetFieldID()] = fieldData
+	}
+
+	// the dynamic field column is not provided, no dynamic value for every row
+	for _, schema := range p.fields {
+		if _, ok := fields[schema.GetFieldID()]; ok || !typeutil.IsDynamicField(schema) {
+			continue
+		}
+		rowCount := int(table.NumRows()) - len(skip)
+		docs := make([][]byte, 0, rowCount)
+		for j := 0; j < rowCount; j++ {
+			docs = append(docs, []byte("{}"))
+		}
+		fields[schema.GetFieldID()] = &storage.JSONFieldData{NumRows: []int64{int64(rowCount)}, Data: docs}
+	}
+	return fields, nil
+}
+
+// parquetValueError is an invalid value of a column, the row is the offset in the row group
+type parquetValueError struct {
+	row int
+	err error
+}
+
+// checkParquetColumn checks the null values, the dimensions of vectors and the values which can't be converted,
+// only the first invalid value is returned if all is false
+func checkParquetColumn(schema *schemapb.FieldSchema, data *arrow.Chunked, all bool) []*parquetValueError {
+	dim := 0
+	if schema.GetDataType() == schemapb.DataType_FloatVector || schema.GetDataType() == schemapb.DataType_BinaryVector {
+		// the dimension is checked when the column type is validated
+		dim, _ = getFieldDimension(schema)
+	}
+
+	valueErrors := make([]*parquetValueError, 0)
+	row := 0
+	for _, chunk := range data.Chunks() {
+		for i := 0; i < chunk.Len(); i, row = i+1, row+1 {
+			err := checkParquetValue(schema, dim, chunk, i, row)
+			if err == nil {
+				continue
+			}
+			valueErrors = append(valueErrors, &parquetValueError{row: row, err: err})
+			if !all {
+				return valueErrors
+			}
+		}
+	}
+	return valueErrors
+}
+
+// checkParquetValue checks the i-th value of a column chunk, the row is used in the error message
+func checkParquetValue(schema *schemapb.FieldSchema, dim int, chunk arrow.Array, i int, row int) error {
+	if chunk.IsNull(i) {
+		return fmt.Errorf("%w, null value is not allowed at row %d of field '%s'", errEmptyValue, row, schema.GetName())
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Float:
+		return checkParquetFloat(float64(chunk.(*array.Float32).Value(i)), row, schema.GetName())
+	case schemapb.DataType_Double:
+		return checkParquetFloat(chunk.(*array.Float64).Value(i), row, schema.GetName())
+	case typeutil.DataTypeJSON:
+		if !json.Valid(getParquetBytes(chunk, i)) {
+			return fmt.Errorf("illegal JSON document at row %d of field '%s'", row, schema.GetName())
+		}
+	case schemapb.DataType_FloatVector:
+		elements, beg, end := getParquetListRange(chunk, i)
+		if end-beg != dim {
+			return fmt.Errorf("%w, illegal dimension %d of float vector at row %d of field '%s', dimension should be %d",
+				errDimensionMismatch, end-beg, row, schema.GetName(), dim)
+		}
+		for j := beg; j < end; j++ {
+			var value float64
+			switch arr := elements.(type) {
+			case *array.Float32:
+				value = float64(arr.Value(j))
+			case *array.Float64:
+				// the value is stored as float32
+				value = float64(float32(arr.Value(j)))
+			}
+			if err := checkParquetFloat(value, row, schema.GetName()); err != nil {
+				return err
+			}
+		}
+	case typeutil.DataTypeArray:
+		elements, beg, end := getParquetListRange(chunk, i)
+		for j := beg; j < end; j++ {
+			if elements.IsNull(j) {
+				return fmt.Errorf("%w, null element is not allowed at row %d of field '%s'", errEmptyValue, row, schema.GetName())
+			}
+			var err error
+			switch arr := elements.(type) {
+			case *array.Float32:
+				err = checkParquetFloat(float64(arr.Value(j)), row, schema.GetName())
+			case *array.Float64:
+				err = checkParquetFloat(arr.Value(j), row, schema.GetName())
+			}
+			if err != nil {
+				return err
+			}
+		}
+	case schemapb.DataType_BinaryVector:
+		if size := len(getParquetBytes(chunk, i)); size*8 != dim {
+			return fmt.Errorf("%w, illegal dimension %d of binary vector at row %d of field '%s', dimension should be %d",
+				errDimensionMismatch, size*8, row, schema.GetName(), dim)
+		}
+	}
+	return nil
+}
+
+func checkParquetFloat(value float64, row int, fieldName string) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("value '%v' is not a number or infinity at row %d of field '%s'", value, row, fieldName)
+	}
+	return nil
+}
+
+// getParquetBytes returns the i-th value of a string, binary or fixed size binary array
+func getParquetBytes(arr arrow.Array, i int) []byte {
+	switch values := arr.(type) {
+	case *array.String:
+		return []byte(values.Value(i))
+	case *array.Binary:
+		return values.Value(i)
+	case *array.FixedSizeBinary:
+		return values.Value(i)
+	}
+	return nil
+}
+
+// forEachParquetRow calls fn on the rows of a column except the skipped ones, returns the count of visited rows
+func forEachParquetRow(data *arrow.Chunked, skip map[int]struct{}, fn func(chunk arrow.Array, i int)) int64 {
+	row, count := 0, int64(0)
+	for _, chunk := range data.Chunks() {
+		for i := 0; i < chunk.Len(); i, row = i+1, row+1 {
+			if _, ok := skip[row]; ok {
+				continue
+			}
+			fn(chunk, i)
+			count++
+		}
+	}
+	return count
+}
+
+// readParquetColumn reads all the chunks of a column into a storage.FieldData except the skipped rows,
+// the values must have been checked by checkParquetColumn
+func readParquetColumn(schema *schemapb.FieldSchema, data *arrow.Chunked, skip map[int]struct{}) (storage.FieldData, error) {
+	rowCount := int64(data.Len() - len(skip))
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		values := make([]bool, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Boolean).Value(i))
+		})
+		return &storage.BoolFieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Int8:
+		values := make([]int8, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Int8).Value(i))
+		})
+		return &storage.Int8FieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Int16:
+		values := make([]int16, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Int16).Value(i))
+		})
+		return &storage.Int16FieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Int32:
+		values := make([]int32, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Int32).Value(i))
+		})
+		return &storage.Int32FieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Int64:
+		values := make([]int64, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Int64).Value(i))
+		})
+		return &storage.Int64FieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Float:
+		values := make([]float32, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Float32).Value(i))
+		})
+		return &storage.FloatFieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_Double:
+		values := make([]float64, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.Float64).Value(i))
+		})
+		return &storage.DoubleFieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		values := make([]string, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, chunk.(*array.String).Value(i))
+		})
+		return &storage.StringFieldData{NumRows: []int64{n}, Data: values}, nil
+	case typeutil.DataTypeJSON:
+		docs := make([][]byte, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			docs = append(docs, append([]byte{}, getParquetBytes(chunk, i)...))
+		})
+		return &storage.JSONFieldData{NumRows: []int64{n}, Data: docs}, nil
+	case typeutil.DataTypeArray:
+		values := make([]*schemapb.ScalarField, 0, rowCount)
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			elements, beg, end := getParquetListRange(chunk, i)
+			values = append(values, readParquetArray(elements, beg, end))
+		})
+		return &storage.ArrayFieldData{NumRows: []int64{n}, Data: values}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]float32, 0, rowCount*int64(dim))
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			elements, beg, end := getParquetListRange(chunk, i)
+			switch arr := elements.(type) {
+			case *array.Float32:
+				values = append(values, arr.Float32Values()[beg:end]...)
+			case *array.Float64:
+				for _, value := range arr.Float64Values()[beg:end] {
+					values = append(values, float32(value))
+				}
+			}
+		})
+		return &storage.FloatVectorFieldData{NumRows: []int64{n}, Data: values, Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]byte, 0, rowCount*int64(dim/8))
+		n := forEachParquetRow(data, skip, func(chunk arrow.Array, i int) {
+			values = append(values, getParquetBytes(chunk, i)...)
+		})
+		return &storage.BinaryVectorFieldData{NumRows: []int64{n}, Data: values, Dim: dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field '%s' for parquet file", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+}
+
+// readParquetArray converts the elements in range [beg, end) of a list into an array value, the int8 and int16
+// elements are kept as int32 as the arrays parsed from JSON files
+func readParquetArray(elements arrow.Array, beg, end int) *schemapb.ScalarField {
+	switch arr := elements.(type) {
+	case *array.Boolean:
+		data := make([]bool, 0, end-beg)
+		for j := beg; j < end; j++ {
+			data = append(data, arr.Value(j))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}
+	case *array.Int8:
+		data := make([]int32, 0, end-beg)
+		for _, value := range arr.Int8Values()[beg:end] {
+			data = append(data, int32(value))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}
+	case *array.Int16:
+		data := make([]int32, 0, end-beg)
+		for _, value := range arr.Int16Values()[beg:end] {
+			data = append(data, int32(value))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}
+	case *array.Int32:
+		data := append([]int32{}, arr.Int32Values()[beg:end]...)
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}
+	case *array.Int64:
+		data := append([]int64{}, arr.Int64Values()[beg:end]...)
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}
+	case *array.Float32:
+		data := append([]float32{}, arr.Float32Values()[beg:end]...)
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}
+	case *array.Float64:
+		data := append([]float64{}, arr.Float64Values()[beg:end]...)
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}
+	case *array.String:
+		data := make([]string, 0, end-beg)
+		for j := beg; j < end; j++ {
+			data = append(data, arr.Value(j))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}
+	}
+	return &schemapb.ScalarField{}
+}
+
+// getParquetListRange returns the element array and the range of the i-th list of a list or fixed size list array
+func getParquetListRange(arr arrow.Array, i int) (arrow.Array, int, int) {
+	switch list := arr.(type) {
+	case *array.List:
+		j := i + list.Data().Offset()
+		return list.ListValues(), int(list.Offsets()[j]), int(list.Offsets()[j+1])
+	case *array.FixedSizeList:
+		n := int(list.DataType().(*arrow.FixedSizeListType).Len())
+		j := i + list.Data().Offset()
+		return list.ListValues(), j * n, (j + 1) * n
+	}
+	return nil, 0, 0
+}
+
+// Parse reads the parquet file row group by row group, the fields data of every row group is passed to the flush
+// function, so that the memory is bounded by the size of a row group rather than the whole file.
+// The first skipRows rows are persisted by a previous run of the import task, the row groups holding them are
+// skipped without being read, skipRows must be at the end of a row group.
+// If onlyValidate is true, all the row groups are still read and validated but nothing is flushed.
+func (p *ParquetParser) Parse(reader parquet.ReaderAtSeeker, skipRows int64, onlyValidate bool) error {
+	pqReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Error("Parquet parser: failed to open parquet file", zap.Error(err))
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer pqReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		log.Error("Parquet parser: failed to create arrow file reader", zap.Error(err))
+		return fmt.Errorf("failed to create arrow file reader, error: %w", err)
+	}
+
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Error("Parquet parser: failed to read schema of parquet file", zap.Error(err))
+		return fmt.Errorf("failed to read schema of parquet file, error: %w", err)
+	}
+	if err := p.validate(arrowSchema); err != nil {
+		return err
+	}
+
+	// all the columns are read since redundant column is not allowed
+	columns := make([]int, 0, pqReader.MetaData().Schema.NumColumns())
+	for i := 0; i < pqReader.MetaData().Schema.NumColumns(); i++ {
+		columns = append(columns, i)
+	}
+
+	for rowGroup := 0; rowGroup < pqReader.NumRowGroups(); rowGroup++ {
+		// outside context might be canceled(service stop, or future enhancement for canceling import task)
+		if isCanceled(p.ctx) {
+			log.Error("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		if skipRows > 0 {
+			rowCount := pqReader.MetaData().RowGroup(rowGroup).NumRows()
+			if rowCount > skipRows {
+				log.Error("Parquet parser: the skipped rows are not at the end of a row group",
+					zap.Int("rowGroup", rowGroup), zap.Int64("skipRows", skipRows))
+				return fmt.Errorf("the skipped rows are not at the end of row group %d of parquet file", rowGroup)
+			}
+			skipRows -= rowCount
+			continue
+		}
+
+		table, err := fileReader.ReadRowGroups(p.ctx, columns, []int{rowGroup})
+		if err != nil {
+			log.Error("Parquet parser: failed to read row group", zap.Int("rowGroup", rowGroup), zap.Error(err))
+			return fmt.Errorf("failed to read row group %d of parquet file, error: %w", rowGroup, err)
+		}
+		fields, err := p.consume(table)
+		table.Release()
+		if err != nil {
+			return fmt.Errorf("failed to parse row group %d of parquet file, error: %w", rowGroup, err)
+		}
+
+		// all the rows of the row group are skipped if they are invalid
+		rowCount := 0
+		for _, data := range fields {
+			rowCount = data.RowNum()
+			break
+		}
+		if onlyValidate || rowCount == 0 {
+			continue
+		}
+		if err := p.callFlushFunc(fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
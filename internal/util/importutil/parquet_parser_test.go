@@ -0,0 +1,472 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// createSampleParquet generates a parquet file for sampleSchema(), the float vectors are stored as lists,
+// the dimension of the float vector at badDimRow is wrong if badDimRow is not negative
+func createSampleParquet(t *testing.T, rowCount int, rowGroupLength int64, badDimRow int) []byte {
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "FieldBool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "FieldInt16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "FieldInt64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldFloat", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "FieldDouble", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String},
+		{Name: "FieldBinaryVector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}},
+		{Name: "FieldFloatVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32)},
+	}, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+	for i := 0; i < rowCount; i++ {
+		builder.Field(0).(*array.BooleanBuilder).Append(i%2 == 0)
+		builder.Field(1).(*array.Int8Builder).Append(int8(i))
+		builder.Field(2).(*array.Int16Builder).Append(int16(i))
+		builder.Field(3).(*array.Int32Builder).Append(int32(i))
+		builder.Field(4).(*array.Int64Builder).Append(int64(i))
+		builder.Field(5).(*array.Float32Builder).Append(float32(i) + 0.5)
+		builder.Field(6).(*array.Float64Builder).Append(float64(i) + 0.25)
+		builder.Field(7).(*array.StringBuilder).Append(fmt.Sprintf("row_%d", i))
+		builder.Field(8).(*array.FixedSizeBinaryBuilder).Append([]byte{byte(i), 0})
+		listBuilder := builder.Field(9).(*array.ListBuilder)
+		listBuilder.Append(true)
+		vector := []float32{float32(i), float32(i) + 0.1, float32(i) + 0.2, float32(i) + 0.3}
+		if i == badDimRow {
+			vector = vector[:3]
+		}
+		listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues(vector, nil)
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+
+	buf := &bytes.Buffer{}
+	writer, err := pqarrow.NewFileWriter(arrowSchema, buf, parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(rowGroupLength)),
+		pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Write(record))
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser := NewParquetParser(ctx, nil, nil)
+	assert.Nil(t, parser)
+
+	parser = NewParquetParser(ctx, sampleSchema(), nil)
+	assert.Nil(t, parser)
+
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+	parser = NewParquetParser(ctx, sampleSchema(), flushFunc)
+	assert.NotNil(t, parser)
+	assert.Equal(t, len(sampleSchema().GetFields()), len(parser.fields))
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquet(t, 10, 4, -1)
+
+	rowGroups := 0
+	fieldsData := initSegmentData(sampleSchema())
+	wrapper := &ImportWrapper{}
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		rowGroups++
+		for _, field := range sampleSchema().GetFields() {
+			data := fields[field.GetFieldID()]
+			appendFunc := wrapper.appendFunc(field)
+			for i := 0; i < data.RowNum(); i++ {
+				err := appendFunc(data, i, fieldsData[field.GetFieldID()])
+				assert.NoError(t, err)
+			}
+		}
+		return nil
+	}
+
+	parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+	err := parser.Parse(bytes.NewReader(content), 0, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowGroups)
+
+	err = parser.Parse(bytes.NewReader(content), 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rowGroups)
+
+	for _, field := range sampleSchema().GetFields() {
+		assert.Equal(t, 10, fieldsData[field.GetFieldID()].RowNum())
+	}
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, i%2 == 0, fieldsData[102].GetRow(i))
+		assert.Equal(t, int8(i), fieldsData[103].GetRow(i))
+		assert.Equal(t, int16(i), fieldsData[104].GetRow(i))
+		assert.Equal(t, int32(i), fieldsData[105].GetRow(i))
+		assert.Equal(t, int64(i), fieldsData[106].GetRow(i))
+		assert.Equal(t, float32(i)+0.5, fieldsData[107].GetRow(i))
+		assert.Equal(t, float64(i)+0.25, fieldsData[108].GetRow(i))
+		assert.Equal(t, fmt.Sprintf("row_%d", i), fieldsData[109].GetRow(i))
+		assert.Equal(t, []byte{byte(i), 0}, fieldsData[110].GetRow(i))
+		assert.Equal(t, []float32{float32(i), float32(i) + 0.1, float32(i) + 0.2, float32(i) + 0.3}, fieldsData[111].GetRow(i))
+	}
+
+	// flush error
+	parser = NewParquetParser(ctx, sampleSchema(), func(fields map[storage.FieldID]storage.FieldData) error {
+		return fmt.Errorf("flush error")
+	})
+	err = parser.Parse(bytes.NewReader(content), 0, false)
+	assert.Error(t, err)
+
+	// dimension of a float vector is wrong, it's detected in validation mode too
+	content = createSampleParquet(t, 10, 4, 6)
+	err = parser.Parse(bytes.NewReader(content), 0, true)
+	assert.Error(t, err)
+
+	// not a parquet file
+	err = parser.Parse(bytes.NewReader([]byte("dummy")), 0, true)
+	assert.Error(t, err)
+
+	// the row groups before the checkpoint are skipped
+	rowGroups = 0
+	fieldsData = initSegmentData(sampleSchema())
+	parser = NewParquetParser(ctx, sampleSchema(), flushFunc)
+	err = parser.Parse(bytes.NewReader(createSampleParquet(t, 10, 4, -1)), 4, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rowGroups)
+	assert.Equal(t, 6, fieldsData[106].RowNum())
+	assert.Equal(t, int64(4), fieldsData[106].GetRow(0))
+
+	// the checkpoint is not at the end of a row group
+	err = parser.Parse(bytes.NewReader(createSampleParquet(t, 10, 4, -1)), 5, false)
+	assert.Error(t, err)
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	parser = NewParquetParser(cancelCtx, sampleSchema(), flushFunc)
+	err = parser.Parse(bytes.NewReader(createSampleParquet(t, 10, 4, -1)), 0, false)
+	assert.Error(t, err)
+}
+
+func Test_ParquetParserInvalidRows(t *testing.T) {
+	ctx := context.Background()
+	// the dimension of the float vector at row 6 is wrong
+	content := createSampleParquet(t, 10, 4, 6)
+
+	var pks []int64
+	parser := NewParquetParser(ctx, sampleSchema(), func(fields map[storage.FieldID]storage.FieldData) error {
+		pks = append(pks, fields[106].(*storage.Int64FieldData).Data...)
+		return nil
+	})
+	var invalidRows []map[string]error
+	parser.invalidRowFunc = func(fieldErrors map[string]error) {
+		invalidRows = append(invalidRows, fieldErrors)
+	}
+	err := parser.Parse(bytes.NewReader(content), 0, false)
+	assert.NoError(t, err)
+
+	// the invalid row is skipped
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 7, 8, 9}, pks)
+	assert.Equal(t, 1, len(invalidRows))
+	assert.Equal(t, 1, len(invalidRows[0]))
+	assert.ErrorIs(t, invalidRows[0]["FieldFloatVector"], errDimensionMismatch)
+}
+
+func Test_ParquetParserArray(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tags", DataType: typeutil.DataTypeArray,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: "VarChar"}}},
+			{FieldID: 102, Name: "scores", DataType: typeutil.DataTypeArray,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: "Int16"}}},
+			{FieldID: 103, Name: common.MetaFieldName, DataType: typeutil.DataTypeJSON},
+		},
+	}
+
+	writeParquet := func(scoresType arrow.DataType, appendScores func(builder array.Builder)) []byte {
+		arrowSchema := arrow.NewSchema([]arrow.Field{
+			{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+			{Name: "scores", Type: scoresType},
+		}, nil)
+		builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+		defer builder.Release()
+		builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+		tags := builder.Field(1).(*array.ListBuilder)
+		tags.Append(true)
+		tags.ValueBuilder().(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+		tags.Append(true)
+		appendScores(builder.Field(2))
+		record := builder.NewRecord()
+		defer record.Release()
+
+		buf := &bytes.Buffer{}
+		writer, err := pqarrow.NewFileWriter(arrowSchema, buf, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Write(record))
+		assert.NoError(t, writer.Close())
+		return buf.Bytes()
+	}
+
+	// the dynamic field is not provided
+	var fields map[storage.FieldID]storage.FieldData
+	parser := NewParquetParser(ctx, schema, func(data map[storage.FieldID]storage.FieldData) error {
+		fields = data
+		return nil
+	})
+	content := writeParquet(arrow.ListOf(arrow.PrimitiveTypes.Int16), func(builder array.Builder) {
+		scores := builder.(*array.ListBuilder)
+		scores.Append(true)
+		scores.ValueBuilder().(*array.Int16Builder).AppendValues([]int16{1, 2, 3}, nil)
+		scores.Append(true)
+		scores.ValueBuilder().(*array.Int16Builder).AppendValues([]int16{4}, nil)
+	})
+	err := parser.Parse(bytes.NewReader(content), 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fields[101].RowNum())
+	assert.Equal(t, []string{"a", "b"}, fields[101].GetRow(0).(*schemapb.ScalarField).GetStringData().GetData())
+	assert.Empty(t, fields[101].GetRow(1).(*schemapb.ScalarField).GetStringData().GetData())
+	assert.Equal(t, []int32{1, 2, 3}, fields[102].GetRow(0).(*schemapb.ScalarField).GetIntData().GetData())
+	assert.Equal(t, []int32{4}, fields[102].GetRow(1).(*schemapb.ScalarField).GetIntData().GetData())
+	assert.Equal(t, [][]byte{[]byte("{}"), []byte("{}")}, fields[103].(*storage.JSONFieldData).Data)
+
+	// the element type doesn't match
+	content = writeParquet(arrow.ListOf(arrow.PrimitiveTypes.Int64), func(builder array.Builder) {
+		scores := builder.(*array.ListBuilder)
+		scores.Append(true)
+		scores.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1}, nil)
+		scores.Append(true)
+	})
+	err = parser.Parse(bytes.NewReader(content), 0, true)
+	assert.Error(t, err)
+
+	// the column is not a list
+	content = writeParquet(arrow.PrimitiveTypes.Int16, func(builder array.Builder) {
+		builder.(*array.Int16Builder).AppendValues([]int16{1, 2}, nil)
+	})
+	err = parser.Parse(bytes.NewReader(content), 0, true)
+	assert.Error(t, err)
+
+	// null element is not allowed
+	content = writeParquet(arrow.ListOf(arrow.PrimitiveTypes.Int16), func(builder array.Builder) {
+		scores := builder.(*array.ListBuilder)
+		scores.Append(true)
+		scores.ValueBuilder().(*array.Int16Builder).AppendValues([]int16{1, 2}, []bool{true, false})
+		scores.Append(true)
+	})
+	err = parser.Parse(bytes.NewReader(content), 0, true)
+	assert.ErrorIs(t, err, errEmptyValue)
+}
+
+func Test_ChunkFileReader(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	filePath := TempFilesPath + "reader.parquet"
+	content := createSampleParquet(t, 10, 4, -1)
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+
+	_, err = newChunkFileReader(ctx, cm, TempFilesPath+"dummy.parquet")
+	assert.Error(t, err)
+
+	reader, err := newChunkFileReader(ctx, cm, filePath)
+	assert.NoError(t, err)
+
+	buf := make([]byte, 8)
+	n, err := reader.ReadAt(buf, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, n)
+	assert.Equal(t, content[4:12], buf)
+
+	// read beyond the end of file
+	n, err = reader.ReadAt(buf, int64(len(content)-4))
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, content[len(content)-4:], buf[:4])
+	_, err = reader.ReadAt(buf, int64(len(content)))
+	assert.ErrorIs(t, err, io.EOF)
+	_, err = reader.ReadAt(buf, -1)
+	assert.Error(t, err)
+
+	pos, err := reader.Seek(-8, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)-8), pos)
+	pos, err = reader.Seek(4, io.SeekCurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)-4), pos)
+	_, err = reader.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+	_, err = reader.Seek(0, 100)
+	assert.Error(t, err)
+
+	rowCount := 0
+	parser := NewParquetParser(ctx, sampleSchema(), func(fields map[storage.FieldID]storage.FieldData) error {
+		rowCount += fields[106].RowNum()
+		return nil
+	})
+	err = parser.Parse(reader, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, rowCount)
+}
+
+func Test_ParquetParserValidate(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+	parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+
+	fields := []arrow.Field{
+		{Name: "FieldBool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "FieldInt16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "FieldInt64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldFloat", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "FieldDouble", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String},
+		{Name: "FieldBinaryVector", Type: arrow.BinaryTypes.Binary},
+		{Name: "FieldFloatVector", Type: arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float64)},
+	}
+	err := parser.validate(arrow.NewSchema(fields, nil))
+	assert.NoError(t, err)
+
+	// missed column
+	err = parser.validate(arrow.NewSchema(fields[1:], nil))
+	assert.Error(t, err)
+
+	// redundant column
+	err = parser.validate(arrow.NewSchema(append(fields, arrow.Field{Name: "dummy", Type: arrow.PrimitiveTypes.Int8}), nil))
+	assert.Error(t, err)
+
+	schema := sampleSchema()
+	tests := []struct {
+		field    *schemapb.FieldSchema
+		dataType arrow.DataType
+		isValid  bool
+	}{
+		{schema.Fields[0], arrow.PrimitiveTypes.Int8, false},
+		{schema.Fields[1], arrow.PrimitiveTypes.Int16, false},
+		{schema.Fields[4], arrow.PrimitiveTypes.Int32, false},
+		{schema.Fields[5], arrow.PrimitiveTypes.Float64, false},
+		{schema.Fields[7], arrow.BinaryTypes.Binary, false},
+		{schema.Fields[8], &arrow.FixedSizeBinaryType{ByteWidth: 2}, true},
+		{schema.Fields[8], &arrow.FixedSizeBinaryType{ByteWidth: 4}, false},
+		{schema.Fields[8], arrow.ListOf(arrow.PrimitiveTypes.Uint8), false},
+		{schema.Fields[9], arrow.ListOf(arrow.PrimitiveTypes.Float32), true},
+		{schema.Fields[9], arrow.ListOf(arrow.PrimitiveTypes.Int32), false},
+		{schema.Fields[9], arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float32), true},
+		{schema.Fields[9], arrow.FixedSizeListOf(8, arrow.PrimitiveTypes.Float32), false},
+		{schema.Fields[9], arrow.PrimitiveTypes.Float32, false},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s_%s", test.field.GetName(), test.dataType.Name()), func(t *testing.T) {
+			err := validateParquetColumnType(test.field, test.dataType)
+			if test.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_ImportWrapperParquet(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	filePath := TempFilesPath + "rows_1.parquet"
+	err = cm.Write(ctx, filePath, createSampleParquet(t, 10, 4, -1))
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	err = wrapper.Import([]string{filePath}, ImportOptions{OnlyValidate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import([]string{filePath}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 10, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// parquet files can't be mixed with numpy files
+	_, err = wrapper.fileValidation([]string{filePath, "FieldBool.npy"})
+	assert.Error(t, err)
+}
@@ -65,7 +65,9 @@ type QueryNodeQuotaMetrics struct {
 }
 
 type DataCoordQuotaMetrics struct {
-	TotalBinlogSize int64
+	TotalBinlogSize      int64
+	CollectionBinlogSize map[int64]int64
+	PartitionBinlogSize  map[int64]map[int64]int64 // collection id -> partition id -> binlog size
 }
 
 // DataNodeQuotaMetrics are metrics of DataNode.
@@ -77,6 +79,8 @@ type DataNodeQuotaMetrics struct {
 
 // ProxyQuotaMetrics are metrics of Proxy.
 type ProxyQuotaMetrics struct {
-	Hms HardwareMetrics
-	Rms []RateMetric
+	Hms           HardwareMetrics
+	Rms           []RateMetric
+	CollectionRms map[int64][]RateMetric
+	PartitionRms  map[int64]map[int64][]RateMetric // collection id -> partition id -> rate metrics
 }
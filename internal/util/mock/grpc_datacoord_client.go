@@ -141,6 +141,10 @@ func (m *GrpcDataCoordClient) Import(ctx context.Context, req *datapb.ImportTask
 	return &datapb.ImportTaskResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest, opts ...grpc.CallOption) (*datapb.ExportSegmentResponse, error) {
+	return &datapb.ExportSegmentResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -69,6 +69,10 @@ func (m *GrpcDataNodeClient) Import(ctx context.Context, req *datapb.ImportTaskR
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) ExportSegment(ctx context.Context, req *datapb.ExportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest, opts ...grpc.CallOption) (*datapb.ResendSegmentStatsResponse, error) {
 	return &datapb.ResendSegmentStatsResponse{}, m.Err
 }
@@ -39,6 +39,18 @@ func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Chec
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CreateDatabase(ctx context.Context, in *proxypb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DropDatabase(ctx context.Context, in *proxypb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDatabases(ctx context.Context, in *proxypb.ListDatabasesRequest, opts ...grpc.CallOption) (*proxypb.ListDatabasesResponse, error) {
+	return &proxypb.ListDatabasesResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -109,6 +121,10 @@ func (m *GrpcRootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.Alter
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *proxypb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.Err
 }
@@ -201,6 +217,18 @@ func (m *GrpcRootCoordClient) ReportImport(ctx context.Context, req *rootcoordpb
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) Export(ctx context.Context, req *proxypb.ExportRequest, opts ...grpc.CallOption) (*proxypb.ExportResponse, error) {
+	return &proxypb.ExportResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest, opts ...grpc.CallOption) (*proxypb.GetExportStateResponse, error) {
+	return &proxypb.GetExportStateResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ReportExport(ctx context.Context, req *rootcoordpb.ExportSegmentResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
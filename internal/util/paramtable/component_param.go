@@ -65,6 +65,7 @@ type ComponentParam struct {
 	IndexNodeCfg  indexNodeConfig
 	HTTPCfg       httpConfig
 	HookCfg       hookConfig
+	TraceCfg      traceConfig
 
 	RootCoordGrpcServerCfg  GrpcServerConfig
 	ProxyGrpcServerCfg      GrpcServerConfig
@@ -110,6 +111,7 @@ func (p *ComponentParam) Init() {
 	p.IndexNodeCfg.init(&p.BaseTable)
 	p.HTTPCfg.init(&p.BaseTable)
 	p.HookCfg.init()
+	p.TraceCfg.init(&p.BaseTable)
 
 	p.RootCoordGrpcServerCfg.Init(typeutil.RootCoordRole, &p.BaseTable)
 	p.ProxyGrpcServerCfg.Init(typeutil.ProxyRole, &p.BaseTable)
@@ -142,6 +144,10 @@ func (p *ComponentParam) KafkaEnable() bool {
 	return p.KafkaCfg.Address.GetValue() != ""
 }
 
+func (p *ComponentParam) NatsEnable() bool {
+	return p.NatsCfg.Address.GetValue() != ""
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -548,10 +554,14 @@ func (p *commonConfig) init(base *BaseTable) {
 type rootCoordConfig struct {
 	DmlChannelNum               ParamItem `refreshable:"false"`
 	MaxPartitionNum             ParamItem `refreshable:"true"`
+	DefaultPartitionsWithKey    ParamItem `refreshable:"true"`
 	MinSegmentSizeToEnableIndex ParamItem `refreshable:"true"`
 	ImportTaskExpiration        ParamItem `refreshable:"true"`
 	ImportTaskRetention         ParamItem `refreshable:"true"`
 	ImportTaskSubPath           ParamItem `refreshable:"true"`
+	ExportTaskRetention         ParamItem `refreshable:"true"`
+	ExportTaskSubPath           ParamItem `refreshable:"true"`
+	ExportTaskParallelism       ParamItem `refreshable:"false"`
 	EnableActiveStandby         ParamItem `refreshable:"false"`
 }
 
@@ -570,6 +580,13 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.MaxPartitionNum.Init(base.mgr)
 
+	p.DefaultPartitionsWithKey = ParamItem{
+		Key:          "rootCoord.defaultPartitionsWithPartitionKey",
+		Version:      "2.2.0",
+		DefaultValue: "64",
+	}
+	p.DefaultPartitionsWithKey.Init(base.mgr)
+
 	p.MinSegmentSizeToEnableIndex = ParamItem{
 		Key:          "rootCoord.minSegmentSizeToEnableIndex",
 		Version:      "2.0.0",
@@ -598,6 +615,27 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.ImportTaskSubPath.Init(base.mgr)
 
+	p.ExportTaskRetention = ParamItem{
+		Key:          "rootCoord.exportTaskRetention",
+		Version:      "2.2.0",
+		DefaultValue: strconv.Itoa(24 * 60 * 60),
+	}
+	p.ExportTaskRetention.Init(base.mgr)
+
+	p.ExportTaskSubPath = ParamItem{
+		Key:          "rootCoord.exportTaskSubPath",
+		Version:      "2.2.0",
+		DefaultValue: "exporttask",
+	}
+	p.ExportTaskSubPath.Init(base.mgr)
+
+	p.ExportTaskParallelism = ParamItem{
+		Key:          "rootCoord.exportTaskParallelism",
+		Version:      "2.2.0",
+		DefaultValue: "1",
+	}
+	p.ExportTaskParallelism.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "rootCoord.enableActiveStandby",
 		Version:      "2.2.0",
@@ -1315,7 +1353,10 @@ type dataCoordConfig struct {
 	SingleCompactionDeltaLogMaxSize   ParamItem `refreshable:"true"`
 	SingleCompactionExpiredLogMaxSize ParamItem `refreshable:"true"`
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
+	SingleCompactionExpiredRatio      ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
+	ExpiredCompactionCheckInterval    ParamItem `refreshable:"false"`
+	ClusteringCompactionMaxPlanSize   ParamItem `refreshable:"true"`
 
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
@@ -1488,6 +1529,27 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
+	p.SingleCompactionExpiredRatio = ParamItem{
+		Key:          "dataCoord.compaction.single.expiredRatio.threshold",
+		Version:      "2.2.0",
+		DefaultValue: "0.2",
+	}
+	p.SingleCompactionExpiredRatio.Init(base.mgr)
+
+	p.ExpiredCompactionCheckInterval = ParamItem{
+		Key:          "dataCoord.compaction.expiredCheckInterval",
+		Version:      "2.2.0",
+		DefaultValue: "600",
+	}
+	p.ExpiredCompactionCheckInterval.Init(base.mgr)
+
+	p.ClusteringCompactionMaxPlanSize = ParamItem{
+		Key:          "dataCoord.compaction.clustering.maxPlanSize",
+		Version:      "2.2.0",
+		DefaultValue: "1024",
+	}
+	p.ClusteringCompactionMaxPlanSize.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -142,6 +142,8 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex.GetAsInt64())
 		assert.NotEqual(t, Params.ImportTaskExpiration.GetAsFloat(), 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
+		assert.Equal(t, "exporttask", Params.ExportTaskSubPath.GetValue())
+		assert.Equal(t, 1, Params.ExportTaskParallelism.GetAsInt())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
 
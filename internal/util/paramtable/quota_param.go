@@ -69,6 +69,14 @@ type quotaConfig struct {
 	DMLMaxBulkLoadRate ParamItem `refreshable:"false"`
 	DMLMinBulkLoadRate ParamItem `refreshable:"false"`
 
+	DMLMaxInsertRatePerCollection   ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerCollection   ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerCollection ParamItem `refreshable:"true"`
+
+	DMLMaxInsertRatePerPartition   ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerPartition   ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerPartition ParamItem `refreshable:"true"`
+
 	// dql
 	DQLLimitEnabled  ParamItem `refreshable:"true"`
 	DQLMaxSearchRate ParamItem `refreshable:"false"`
@@ -76,6 +84,9 @@ type quotaConfig struct {
 	DQLMaxQueryRate  ParamItem `refreshable:"false"`
 	DQLMinQueryRate  ParamItem `refreshable:"false"`
 
+	DQLMaxSearchRatePerCollection ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerCollection  ParamItem `refreshable:"true"`
+
 	// limits
 	MaxCollectionNum ParamItem `refreshable:"true"`
 
@@ -90,6 +101,8 @@ type quotaConfig struct {
 	QueryNodeMemoryHighWaterLevel ParamItem `refreshable:"true"`
 	DiskProtectionEnabled         ParamItem `refreshable:"true"`
 	DiskQuota                     ParamItem `refreshable:"true"`
+	DiskQuotaPerCollection        ParamItem `refreshable:"true"`
+	DiskQuotaPerPartition         ParamItem `refreshable:"true"`
 
 	// limit reading
 	ForceDenyReading        ParamItem `refreshable:"true"`
@@ -373,6 +386,132 @@ func (p *quotaConfig) init(base *BaseTable) {
 	}
 	p.DMLMinBulkLoadRate.Init(base.mgr)
 
+	p.DMLMaxInsertRatePerCollection = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.collection.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxInsertRatePerCollection.Init(base.mgr)
+
+	p.DMLMaxInsertRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.partition.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxInsertRatePerPartition.Init(base.mgr)
+
+	p.DMLMaxDeleteRatePerCollection = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.collection.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxDeleteRatePerCollection.Init(base.mgr)
+
+	p.DMLMaxDeleteRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.partition.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxDeleteRatePerPartition.Init(base.mgr)
+
+	p.DMLMaxBulkLoadRatePerCollection = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.collection.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxBulkLoadRatePerCollection.Init(base.mgr)
+
+	p.DMLMaxBulkLoadRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.partition.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				return fmt.Sprintf("%f", megaBytes2Bytes(rate))
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DMLMaxBulkLoadRatePerPartition.Init(base.mgr)
+
 	// dql
 	p.DQLLimitEnabled = ParamItem{
 		Key:          "quotaAndLimits.dql.enabled",
@@ -457,6 +596,40 @@ func (p *quotaConfig) init(base *BaseTable) {
 	}
 	p.DQLMinQueryRate.Init(base.mgr)
 
+	p.DQLMaxSearchRatePerCollection = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.collection.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DQLMaxSearchRatePerCollection.Init(base.mgr)
+
+	p.DQLMaxQueryRatePerCollection = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.collection.max",
+		Version:      "2.2.2",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+	}
+	p.DQLMaxQueryRatePerCollection.Init(base.mgr)
+
 	// limits
 	p.MaxCollectionNum = ParamItem{
 		Key:          "quotaAndLimits.limits.collection.maxNum",
@@ -617,6 +790,44 @@ func (p *quotaConfig) init(base *BaseTable) {
 	}
 	p.DiskQuota.Init(base.mgr)
 
+	p.DiskQuotaPerCollection = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuotaPerCollection",
+		Version:      "2.2.2",
+		DefaultValue: quota,
+		Formatter: func(v string) string {
+			if !p.DiskProtectionEnabled.GetAsBool() {
+				return max
+			}
+			level := getAsFloat(v)
+			// (0, +inf)
+			if level <= 0 {
+				level = getAsFloat(quota)
+			}
+			// megabytes to bytes
+			return fmt.Sprintf("%f", megaBytes2Bytes(level))
+		},
+	}
+	p.DiskQuotaPerCollection.Init(base.mgr)
+
+	p.DiskQuotaPerPartition = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuotaPerPartition",
+		Version:      "2.2.2",
+		DefaultValue: quota,
+		Formatter: func(v string) string {
+			if !p.DiskProtectionEnabled.GetAsBool() {
+				return max
+			}
+			level := getAsFloat(v)
+			// (0, +inf)
+			if level <= 0 {
+				level = getAsFloat(quota)
+			}
+			// megabytes to bytes
+			return fmt.Sprintf("%f", megaBytes2Bytes(level))
+		},
+	}
+	p.DiskQuotaPerPartition.Init(base.mgr)
+
 	// limit reading
 	p.ForceDenyReading = ParamItem{
 		Key:          "quotaAndLimits.limitReading.forceDeny",
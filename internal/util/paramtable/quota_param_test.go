@@ -55,6 +55,12 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, defaultMin, qc.DMLMinDeleteRate.GetAsFloat())
 		assert.Equal(t, defaultMax, qc.DMLMaxBulkLoadRate.GetAsFloat())
 		assert.Equal(t, defaultMin, qc.DMLMinBulkLoadRate.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxInsertRatePerCollection.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxDeleteRatePerCollection.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxBulkLoadRatePerCollection.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxInsertRatePerPartition.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxDeleteRatePerPartition.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DMLMaxBulkLoadRatePerPartition.GetAsFloat())
 	})
 
 	t.Run("test dql", func(t *testing.T) {
@@ -63,6 +69,8 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, defaultMin, qc.DQLMinSearchRate.GetAsFloat())
 		assert.Equal(t, defaultMax, qc.DQLMaxQueryRate.GetAsFloat())
 		assert.Equal(t, defaultMin, qc.DQLMinQueryRate.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DQLMaxSearchRatePerCollection.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DQLMaxQueryRatePerCollection.GetAsFloat())
 	})
 
 	t.Run("test limits", func(t *testing.T) {
@@ -79,6 +87,8 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, defaultHighWaterLevel, qc.QueryNodeMemoryHighWaterLevel.GetAsFloat())
 		assert.Equal(t, true, qc.DiskProtectionEnabled.GetAsBool())
 		assert.Equal(t, defaultMax, qc.DiskQuota.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DiskQuotaPerCollection.GetAsFloat())
+		assert.Equal(t, defaultMax, qc.DiskQuotaPerPartition.GetAsFloat())
 	})
 
 	t.Run("test limit reading", func(t *testing.T) {
@@ -50,6 +50,7 @@ type ServiceParam struct {
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
+	NatsCfg         NatsConfig
 	MinioCfg        MinioConfig
 }
 
@@ -63,6 +64,7 @@ func (p *ServiceParam) Init() {
 	p.PulsarCfg.Init(&p.BaseTable)
 	p.KafkaCfg.Init(&p.BaseTable)
 	p.RocksmqCfg.Init(&p.BaseTable)
+	p.NatsCfg.Init(&p.BaseTable)
 	p.MinioCfg.Init(&p.BaseTable)
 }
 
@@ -248,6 +250,7 @@ type MetaDBConfig struct {
 	MaxOpenConns ParamItem `refreshable:"false"`
 	MaxIdleConns ParamItem `refreshable:"false"`
 	LogLevel     ParamItem `refreshable:"false"`
+	SQLitePath   ParamItem `refreshable:"false"`
 }
 
 func (p *MetaDBConfig) Init(base *BaseTable) {
@@ -306,6 +309,13 @@ func (p *MetaDBConfig) Init(base *BaseTable) {
 		DefaultValue: "debug",
 	}
 	p.LogLevel.Init(base.mgr)
+
+	p.SQLitePath = ParamItem{
+		Key:          "sqlite.path",
+		Version:      "2.2.0",
+		DefaultValue: "/var/lib/milvus/meta.db",
+	}
+	p.SQLitePath.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -486,6 +496,31 @@ func (k *KafkaConfig) Init(base *BaseTable) {
 	k.ProducerExtraConfig.Init(base.mgr)
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- nats ---
+type NatsConfig struct {
+	Address ParamItem `refreshable:"false"`
+	// RetentionTimeInMinutes is the max age of the messages kept in a JetStream stream, 0 means unlimited
+	RetentionTimeInMinutes ParamItem `refreshable:"false"`
+}
+
+func (n *NatsConfig) Init(base *BaseTable) {
+	// due to implicit rule of MQ priority，the default address should be empty
+	n.Address = ParamItem{
+		Key:          "nats.address",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	n.Address.Init(base.mgr)
+
+	n.RetentionTimeInMinutes = ParamItem{
+		Key:          "nats.retentionTimeInMinutes",
+		DefaultValue: "7200",
+		Version:      "2.2.0",
+	}
+	n.RetentionTimeInMinutes.Init(base.mgr)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- rocksmq ---
 type RocksmqConfig struct {
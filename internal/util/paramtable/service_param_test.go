@@ -139,6 +139,17 @@ func TestServiceParam(t *testing.T) {
 		}
 	})
 
+	t.Run("test natsConfig", func(t *testing.T) {
+		// test default value
+		{
+			nc := &NatsConfig{}
+			base := &BaseTable{mgr: &config.Manager{}}
+			nc.Init(base)
+			assert.Empty(t, nc.Address.GetValue())
+			assert.Equal(t, 7200, nc.RetentionTimeInMinutes.GetAsInt())
+		}
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := &SParams.MinioCfg
 
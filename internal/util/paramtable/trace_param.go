@@ -0,0 +1,47 @@
+package paramtable
+
+type traceConfig struct {
+	Exporter       ParamItem `refreshable:"false"`
+	SampleFraction ParamItem `refreshable:"false"`
+	OtlpEndpoint   ParamItem `refreshable:"false"`
+	OtlpMethod     ParamItem `refreshable:"false"`
+	OtlpInsecure   ParamItem `refreshable:"false"`
+}
+
+func (t *traceConfig) init(base *BaseTable) {
+	// empty exporter means spans are created and propagated but never exported
+	t.Exporter = ParamItem{
+		Key:          "trace.exporter",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	t.Exporter.Init(base.mgr)
+
+	t.SampleFraction = ParamItem{
+		Key:          "trace.sampleFraction",
+		DefaultValue: "0",
+		Version:      "2.2.0",
+	}
+	t.SampleFraction.Init(base.mgr)
+
+	t.OtlpEndpoint = ParamItem{
+		Key:          "trace.otlp.endpoint",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	t.OtlpEndpoint.Init(base.mgr)
+
+	t.OtlpMethod = ParamItem{
+		Key:          "trace.otlp.method",
+		DefaultValue: "grpc",
+		Version:      "2.2.0",
+	}
+	t.OtlpMethod.Init(base.mgr)
+
+	t.OtlpInsecure = ParamItem{
+		Key:          "trace.otlp.insecure",
+		DefaultValue: "true",
+		Version:      "2.2.0",
+	}
+	t.OtlpInsecure.Init(base.mgr)
+}
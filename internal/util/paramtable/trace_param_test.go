@@ -0,0 +1,17 @@
+package paramtable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceConfig_Init(t *testing.T) {
+	params := ComponentParam{}
+	params.InitOnce()
+	cf := params.TraceCfg
+	assert.Equal(t, "", cf.Exporter.GetValue())
+	assert.Equal(t, 0.0, cf.SampleFraction.GetAsFloat())
+	assert.Equal(t, "grpc", cf.OtlpMethod.GetValue())
+	assert.Equal(t, true, cf.OtlpInsecure.GetAsBool())
+}
@@ -103,6 +103,24 @@ func (lim *Limiter) AllowN(now time.Time, n int) bool {
 	return ok
 }
 
+// Cancel gives back n tokens taken by a successful AllowN,
+// it's used when the event is rejected by another limiter afterwards.
+func (lim *Limiter) Cancel(n int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	if lim.limit == Inf {
+		return
+	} else if lim.limit == 0 {
+		lim.burst += float64(n)
+		return
+	}
+
+	now, _, tokens := lim.advance(time.Now())
+	lim.last = now
+	lim.tokens = math.Min(tokens+float64(n), lim.burst)
+}
+
 // SetLimit sets a new Limit for the limiter.
 func (lim *Limiter) SetLimit(newLimit Limit) {
 	lim.mu.Lock()
@@ -259,6 +259,35 @@ func makeTestTime(t *testing.T) *testTime {
 	}
 }
 
+func TestCancel(t *testing.T) {
+	check := func(lim *Limiter, n int, want bool) {
+		if ok := lim.AllowN(time.Now(), n); ok != want {
+			t.Errorf("lim.AllowN(%v) = %v want %v", n, ok, want)
+		}
+	}
+
+	lim := NewLimiter(1, 1)
+	check(lim, 100, true)
+	check(lim, 1, false)
+	lim.Cancel(100)
+	check(lim, 1, true)
+
+	// tokens given back never exceed the burst
+	lim.Cancel(100)
+	check(lim, 2, true)
+	check(lim, 1, false)
+
+	lim = NewLimiter(0, 1)
+	check(lim, 1, true)
+	check(lim, 1, false)
+	lim.Cancel(1)
+	check(lim, 1, true)
+
+	lim = NewLimiter(Inf, 0)
+	lim.Cancel(1)
+	check(lim, math.MaxInt, true)
+}
+
 func TestSimultaneousRequests(t *testing.T) {
 	const (
 		limit       = 1
@@ -19,6 +19,7 @@ package ratelimitutil
 import (
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 )
@@ -27,8 +28,20 @@ const (
 	DefaultWindow      = 10 * time.Second
 	DefaultGranularity = 1 * time.Second
 	DefaultAvgDuration = 3 * time.Second
+
+	subLabelSep = "."
 )
 
+// FormatSubLabel returns the label of a sub dimension (such as a collection) of the given label.
+func FormatSubLabel(label string, subLabel string) string {
+	return label + subLabelSep + subLabel
+}
+
+// SplitSubLabel splits a label formatted by FormatSubLabel into its label and sub label.
+func SplitSubLabel(label string) (string, string, bool) {
+	return strings.Cut(label, subLabelSep)
+}
+
 // RateCollector helps to collect and calculate values (like throughput, QPS, TPS, etc...),
 // It implements a sliding window with custom size and granularity to store values.
 type RateCollector struct {
@@ -81,6 +94,17 @@ func (r *RateCollector) Deregister(label string) {
 	delete(r.values, label)
 }
 
+// Labels returns all the registered labels.
+func (r *RateCollector) Labels() []string {
+	r.Lock()
+	defer r.Unlock()
+	labels := make([]string, 0, len(r.values))
+	for label := range r.values {
+		labels = append(labels, label)
+	}
+	return labels
+}
+
 // Add is shorthand for add(label, value, time.Now()).
 func (r *RateCollector) Add(label string, value float64) {
 	r.add(label, value, time.Now())
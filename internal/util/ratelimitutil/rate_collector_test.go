@@ -138,3 +138,23 @@ func TestRateCollector(t *testing.T) {
 		}
 	})
 }
+
+func TestRateCollector_SubLabel(t *testing.T) {
+	rc, err := NewRateCollector(DefaultWindow, DefaultGranularity)
+	assert.NoError(t, err)
+
+	label := FormatSubLabel("DMLInsert", "100")
+	assert.Equal(t, "DMLInsert.100", label)
+	l, sub, ok := SplitSubLabel(label)
+	assert.True(t, ok)
+	assert.Equal(t, "DMLInsert", l)
+	assert.Equal(t, "100", sub)
+	_, _, ok = SplitSubLabel("DMLInsert")
+	assert.False(t, ok)
+
+	rc.Register("DMLInsert")
+	rc.Register(label)
+	assert.ElementsMatch(t, []string{"DMLInsert", label}, rc.Labels())
+	rc.Deregister(label)
+	assert.ElementsMatch(t, []string{"DMLInsert"}, rc.Labels())
+}
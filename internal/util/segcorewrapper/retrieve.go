@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segcorewrapper
+
+/*
+#cgo pkg-config: milvus_segcore milvus_common
+
+#include <stdlib.h>	// free
+#include "common/type_c.h"
+#include "segcore/collection_c.h"
+#include "segcore/plan_c.h"
+#include "segcore/segment_c.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"unsafe"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Retrieve loads the insert data into a sealed segment of segcore and runs the retrieve plan on it at timestamp ts,
+// the offsets of the matched rows in the insert data are returned in ascending order. The rows are filtered by
+// segcore exactly as a query on QueryNodes does, the insert data must contain the RowID and Timestamp fields.
+func Retrieve(schema *schemapb.CollectionSchema, insertData *storage.InsertData, plan *planpb.PlanNode, ts typeutil.Timestamp) ([]int64, error) {
+	insertRecord, err := storage.TransferInsertDataToInsertRecord(insertData)
+	if err != nil {
+		return nil, err
+	}
+	if insertRecord.GetNumRows() == 0 {
+		return []int64{}, nil
+	}
+	planBlob, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	collection := C.NewCollection(cSchemaBlob)
+	defer C.DeleteCollection(collection)
+
+	segment := C.NewSegment(collection, C.Sealed, C.int64_t(0))
+	defer C.DeleteSegment(segment)
+
+	for _, fieldData := range insertRecord.GetFieldsData() {
+		dataBlob, err := proto.Marshal(fieldData)
+		if err != nil {
+			return nil, err
+		}
+		loadInfo := C.CLoadFieldDataInfo{
+			field_id:  C.int64_t(fieldData.GetFieldId()),
+			blob:      (*C.uint8_t)(unsafe.Pointer(&dataBlob[0])),
+			blob_size: C.uint64_t(len(dataBlob)),
+			row_count: C.int64_t(insertRecord.GetNumRows()),
+		}
+		status := C.LoadFieldData(segment, loadInfo)
+		if err := HandleCStatus(&status, "LoadFieldData failed"); err != nil {
+			return nil, err
+		}
+	}
+
+	var cPlan C.CRetrievePlan
+	status := C.CreateRetrievePlanByExpr(collection, unsafe.Pointer(&planBlob[0]), C.int64_t(len(planBlob)), &cPlan)
+	if err := HandleCStatus(&status, "Create retrieve plan by expr failed"); err != nil {
+		return nil, err
+	}
+	defer C.DeleteRetrievePlan(cPlan)
+
+	var cResult C.CRetrieveResult
+	status = C.Retrieve(segment, cPlan, C.uint64_t(ts), &cResult)
+	if err := HandleCStatus(&status, "Retrieve failed"); err != nil {
+		return nil, err
+	}
+	defer C.DeleteRetrieveResult(&cResult)
+
+	result := &segcorepb.RetrieveResults{}
+	resultBlob := C.GoBytes(unsafe.Pointer(cResult.proto_blob), C.int(cResult.proto_size))
+	if err := proto.Unmarshal(resultBlob, result); err != nil {
+		return nil, err
+	}
+	offsets := result.GetOffset()
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets, nil
+}
+
+// HandleCStatus deals with the error returned from CGO
+func HandleCStatus(status *C.CStatus, extraInfo string) error {
+	if status.error_code == 0 {
+		return nil
+	}
+	errorCode := status.error_code
+	errorName, ok := commonpb.ErrorCode_name[int32(errorCode)]
+	if !ok {
+		errorName = "UnknownError"
+	}
+	errorMsg := C.GoString(status.error_msg)
+	defer C.free(unsafe.Pointer(status.error_msg))
+
+	finalMsg := fmt.Sprintf("[%s] %s", errorName, errorMsg)
+	logMsg := fmt.Sprintf("%s, C Runtime Exception: %s\n", extraInfo, finalMsg)
+	log.Warn(logMsg)
+	return errors.New(finalMsg)
+}
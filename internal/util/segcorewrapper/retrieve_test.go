@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segcorewrapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestRetrieve(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "retrieve",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "16"}}},
+			{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	insertData := &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{10, 10, 10, 30}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{4, 3, 2, 1}},
+			101:                   &storage.StringFieldData{NumRows: []int64{4}, Data: []string{"a", "b", "ab", "ab"}},
+			102:                   &storage.FloatVectorFieldData{NumRows: []int64{4}, Data: []float32{0, 1, 2, 3, 4, 5, 6, 7}, Dim: 2},
+		},
+	}
+
+	t.Run("filter", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "pk > 1")
+		require.NoError(t, err)
+		offsets, err := Retrieve(schema, insertData, plan, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 1, 2}, offsets)
+
+		plan, err = planparserv2.CreateRetrievePlan(schema, `name like "a%" && pk < 4`)
+		require.NoError(t, err)
+		offsets, err = Retrieve(schema, insertData, plan, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2}, offsets)
+	})
+
+	t.Run("rows after the timestamp", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "pk > 0")
+		require.NoError(t, err)
+		offsets, err := Retrieve(schema, insertData, plan, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 1, 2}, offsets)
+
+		offsets, err = Retrieve(schema, insertData, plan, 30)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 1, 2, 3}, offsets)
+	})
+
+	t.Run("empty data", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "pk > 0")
+		require.NoError(t, err)
+		empty := &storage.InsertData{
+			Data: map[storage.FieldID]storage.FieldData{
+				common.RowIDField:     &storage.Int64FieldData{},
+				common.TimeStampField: &storage.Int64FieldData{},
+				100:                   &storage.Int64FieldData{},
+				101:                   &storage.StringFieldData{},
+				102:                   &storage.FloatVectorFieldData{Dim: 2},
+			},
+		}
+		offsets, err := Retrieve(schema, empty, plan, 20)
+		assert.NoError(t, err)
+		assert.Empty(t, offsets)
+	})
+}
@@ -17,10 +17,8 @@
 package trace
 
 import (
-	"context"
-
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
 )
 
@@ -31,7 +29,16 @@ type InterceptorSuite struct {
 }
 
 var (
-	filterFunc = func(ctx context.Context, fullMethodName string) bool {
+	filterFunc = func(info *otelgrpc.InterceptorInfo) bool {
+		var fullMethodName string
+		switch {
+		case info.UnaryServerInfo != nil:
+			fullMethodName = info.UnaryServerInfo.FullMethod
+		case info.StreamServerInfo != nil:
+			fullMethodName = info.StreamServerInfo.FullMethod
+		default:
+			fullMethodName = info.Method
+		}
 		if fullMethodName == `/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick` ||
 			fullMethodName == `/milvus.proto.rootcoord.RootCoord/AllocTimestamp` {
 			return false
@@ -40,12 +47,12 @@ var (
 	}
 )
 
-// GetInterceptorOpts returns the Option of gRPC open-tracing
-func GetInterceptorOpts() []grpc_opentracing.Option {
-	tracer := opentracing.GlobalTracer()
-	opts := []grpc_opentracing.Option{
-		grpc_opentracing.WithTracer(tracer),
-		grpc_opentracing.WithFilterFunc(filterFunc),
+// GetInterceptorOpts returns the Option of gRPC open-telemetry, the trace context
+// is propagated as W3C traceparent in gRPC metadata.
+func GetInterceptorOpts() []otelgrpc.Option {
+	opts := []otelgrpc.Option{
+		otelgrpc.WithPropagators(propagation.TraceContext{}),
+		otelgrpc.WithInterceptorFilter(filterFunc),
 	}
 	return opts
 }
@@ -14,24 +14,80 @@ package trace
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"os"
 	"runtime"
 	"strings"
 	"sync"
 
-	slog "github.com/milvus-io/milvus/internal/log"
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/log"
-	"github.com/uber/jaeger-client-go"
-	"github.com/uber/jaeger-client-go/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// ExporterStdout prints finished spans to stdout.
+	ExporterStdout = "stdout"
+	// ExporterOtlp sends finished spans to an OTLP collector.
+	ExporterOtlp = "otlp"
+
+	otlpMethodGrpc = "grpc"
+	otlpMethodHTTP = "http"
+
+	tracerName = "github.com/milvus-io/milvus"
 )
 
 var tracingCloserMtx sync.Mutex
 var tracingCloser io.Closer
 
-// InitTracing init global trace from env. If not specified, use default config.
+// Config is the configuration of the global tracer provider.
+type Config struct {
+	// Exporter is one of ExporterStdout and ExporterOtlp, spans are not exported if empty.
+	Exporter string
+	// SampleFraction is the fraction of root spans to be sampled.
+	SampleFraction float64
+	OtlpEndpoint   string
+	// OtlpMethod is the OTLP transport, grpc or http.
+	OtlpMethod   string
+	OtlpInsecure bool
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+// InitTracing init global tracer provider from the `trace` section of the param table.
 func InitTracing(serviceName string) io.Closer {
+	params := paramtable.Get()
+	return InitTracingWithConfig(serviceName, &Config{
+		Exporter:       params.TraceCfg.Exporter.GetValue(),
+		SampleFraction: params.TraceCfg.SampleFraction.GetAsFloat(),
+		OtlpEndpoint:   params.TraceCfg.OtlpEndpoint.GetValue(),
+		OtlpMethod:     params.TraceCfg.OtlpMethod.GetValue(),
+		OtlpInsecure:   params.TraceCfg.OtlpInsecure.GetAsBool(),
+	})
+}
+
+// InitTracingWithConfig init global tracer provider with the given config.
+// The W3C trace context propagator is always installed, so that trace context
+// is passed along even when spans are not exported.
+func InitTracingWithConfig(serviceName string, cfg *Config) io.Closer {
 	tracingCloserMtx.Lock()
 	defer tracingCloserMtx.Unlock()
 
@@ -39,46 +95,75 @@ func InitTracing(serviceName string) io.Closer {
 		return tracingCloser
 	}
 
-	cfg := &config.Configuration{
-		ServiceName: serviceName,
-		Sampler: &config.SamplerConfig{
-			Type:  "const",
-			Param: 0,
-		},
-	}
-	if true {
-		cfg = initFromEnv(serviceName)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	opts := []sdk.TracerProviderOption{
+		sdk.WithSampler(sdk.ParentBased(sdk.TraceIDRatioBased(cfg.SampleFraction))),
+		sdk.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
 	}
-	tracer, closer, err := cfg.NewTracer()
-	tracingCloser = closer
+	exp, err := newExporter(cfg)
 	if err != nil {
-		log.Error(err)
-		tracingCloser = nil
+		log.Warn("failed to create trace exporter, spans will not be exported", zap.Error(err))
 	}
-	opentracing.SetGlobalTracer(tracer)
+	if exp != nil {
+		opts = append(opts, sdk.WithBatcher(exp))
+	}
+	tp := sdk.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
 
+	tracingCloser = closerFunc(func() error {
+		return tp.Shutdown(context.Background())
+	})
 	return tracingCloser
 }
 
-func initFromEnv(serviceName string) *config.Configuration {
-	cfg, err := config.FromEnv()
-	if err != nil {
-		log.Error(err)
-		return nil
+func newExporter(cfg *Config) (sdk.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOtlp:
+		var client otlptrace.Client
+		switch cfg.OtlpMethod {
+		case "", otlpMethodGrpc:
+			opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OtlpEndpoint)}
+			if cfg.OtlpInsecure {
+				opts = append(opts, otlptracegrpc.WithInsecure())
+			}
+			client = otlptracegrpc.NewClient(opts...)
+		case otlpMethodHTTP:
+			opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OtlpEndpoint)}
+			if cfg.OtlpInsecure {
+				opts = append(opts, otlptracehttp.WithInsecure())
+			}
+			client = otlptracehttp.NewClient(opts...)
+		default:
+			return nil, fmt.Errorf("unsupported otlp method: %s", cfg.OtlpMethod)
+		}
+		return otlptrace.New(context.Background(), client)
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter: %s", cfg.Exporter)
 	}
-	cfg.ServiceName = serviceName
-	return cfg
 }
 
-// StartSpanFromContext starts an opentracing span. The default operation name is
+// Tracer returns the milvus tracer of the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// StartSpanFromContext starts an opentelemetry span. The default operation name is
 // upper two call stacks of the function
-func StartSpanFromContext(ctx context.Context, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContext(ctx context.Context, opts ...trace.SpanStartOption) (trace.Span, context.Context) {
 	return StartSpanFromContextWithSkip(ctx, 3, opts...)
 }
 
-// StartSpanFromContextWithSkip starts an opentracing span with call skip. The operation
+// StartSpanFromContextWithSkip starts an opentelemetry span with call skip. The operation
 // name is upper @skip call stacks of the function
-func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...trace.SpanStartOption) (trace.Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), nil
 	}
@@ -86,8 +171,8 @@ func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentra
 	var pcs [1]uintptr
 	n := runtime.Callers(skip, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "unknown", opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := Tracer().Start(ctx, "unknown", opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	frames := runtime.CallersFrames(pcs[:])
@@ -97,26 +182,21 @@ func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentra
 		name = name[lastSlash+1:]
 	}
 
-	if parent := opentracing.SpanFromContext(ctx); parent != nil {
-		opts = append(opts, opentracing.ChildOf(parent.Context()))
-	}
-	span := opentracing.StartSpan(name, opts...)
-
-	file, line := frame.File, frame.Line
-	span.LogFields(log.String("filename", file), log.Int("line", line))
+	ctx, span := Tracer().Start(ctx, name, opts...)
+	span.SetAttributes(attribute.String("filename", frame.File), attribute.Int("line", frame.Line))
 
-	return span, opentracing.ContextWithSpan(ctx, span)
+	return span, ctx
 }
 
-// StartSpanFromContextWithOperationName starts an opentracing span with specific operation name.
+// StartSpanFromContextWithOperationName starts an opentelemetry span with specific operation name.
 // And will log print the current call line number and file name.
-func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...trace.SpanStartOption) (trace.Span, context.Context) {
 	return StartSpanFromContextWithOperationNameWithSkip(ctx, operationName, 3, opts...)
 }
 
-// StartSpanFromContextWithOperationNameWithSkip starts an opentracing span with specific operation name.
+// StartSpanFromContextWithOperationNameWithSkip starts an opentelemetry span with specific operation name.
 // And will log print the current call line number and file name.
-func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operationName string, skip int, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operationName string, skip int, opts ...trace.SpanStartOption) (trace.Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), nil
 	}
@@ -124,27 +204,21 @@ func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operatio
 	var pcs [1]uintptr
 	n := runtime.Callers(skip, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := Tracer().Start(ctx, operationName, opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	frames := runtime.CallersFrames(pcs[:])
 	frame, _ := frames.Next()
-	file, line := frame.File, frame.Line
-
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
-	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
 
-	span.LogFields(log.String("filename", file), log.Int("line", line))
+	ctx, span := Tracer().Start(ctx, operationName, opts...)
+	span.SetAttributes(attribute.String("filename", frame.File), attribute.Int("line", frame.Line))
 
 	return span, ctx
 }
 
 // LogError is a method to log error with span.
-func LogError(span opentracing.Span, err error) {
+func LogError(span trace.Span, err error) {
 	if err == nil {
 		return
 	}
@@ -153,24 +227,24 @@ func LogError(span opentracing.Span, err error) {
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span.LogFields(log.Error(err))
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
-		slog.Warn("trace log error failed", zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		log.Warn("trace log error failed", zap.Error(err))
+		return
 	}
 
 	frames := runtime.CallersFrames(pcs[:])
 	frame, _ := frames.Next()
-	file, line := frame.File, frame.Line
-	span.LogFields(log.String("filename", file), log.Int("line", line), log.Error(err))
+	span.RecordError(err, trace.WithAttributes(attribute.String("filename", frame.File), attribute.Int("line", frame.Line)))
+	span.SetStatus(codes.Error, err.Error())
 }
 
 // InfoFromSpan is a method return span details.
-func InfoFromSpan(span opentracing.Span) (traceID string, sampled, found bool) {
+func InfoFromSpan(span trace.Span) (traceID string, sampled, found bool) {
 	if span != nil {
-		if spanContext, ok := span.Context().(jaeger.SpanContext); ok {
-			traceID = spanContext.TraceID().String()
-			sampled = spanContext.IsSampled()
-			return traceID, sampled, true
+		spanContext := span.SpanContext()
+		if spanContext.HasTraceID() {
+			return spanContext.TraceID().String(), spanContext.IsSampled(), true
 		}
 	}
 	return "", false, false
@@ -179,42 +253,24 @@ func InfoFromSpan(span opentracing.Span) (traceID string, sampled, found bool) {
 // InfoFromContext is a method return details of span associated with context.
 func InfoFromContext(ctx context.Context) (traceID string, sampled, found bool) {
 	if ctx != nil {
-		if span := opentracing.SpanFromContext(ctx); span != nil {
-			return InfoFromSpan(span)
-		}
+		return InfoFromSpan(trace.SpanFromContext(ctx))
 	}
 	return "", false, false
 }
 
-// InjectContextToMsgProperties is a method inject span to pulsr message.
-func InjectContextToMsgProperties(sc opentracing.SpanContext, properties map[string]string) {
-	tracer := opentracing.GlobalTracer()
-	tracer.Inject(sc, opentracing.TextMap, PropertiesReaderWriter{properties})
-}
-
-// PropertiesReaderWriter is for saving trace in pulsar msg properties.
-// Implement Set and ForeachKey methods.
-type PropertiesReaderWriter struct {
-	PpMap map[string]string
+// InjectContextToMsgProperties is a method inject the span context of ctx to msg properties
+// as W3C traceparent.
+func InjectContextToMsgProperties(ctx context.Context, properties map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(properties))
 }
 
-// Set sets key, value to PpMap.
-func (ppRW PropertiesReaderWriter) Set(key, val string) {
-	key = strings.ToLower(key)
-	ppRW.PpMap[key] = val
-}
-
-// ForeachKey iterates each key value of PpMap.
-func (ppRW PropertiesReaderWriter) ForeachKey(handler func(key, val string) error) error {
-	for k, val := range ppRW.PpMap {
-		if err := handler(k, val); err != nil {
-			return err
-		}
-	}
-	return nil
+// ExtractContextFromMsgProperties returns a copy of ctx carrying the remote span context
+// saved in msg properties.
+func ExtractContextFromMsgProperties(ctx context.Context, properties map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(properties))
 }
 
 // NoopSpan is a minimal span to reduce overhead.
-func NoopSpan() opentracing.Span {
-	return opentracing.NoopTracer{}.StartSpan("Default-span")
+func NoopSpan() trace.Span {
+	return trace.SpanFromContext(context.Background())
 }
@@ -18,25 +18,39 @@ import (
 	"os"
 	"testing"
 
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
-type simpleStruct struct {
-	name  string
-	value string
-}
-
 func TestMain(m *testing.M) {
-	closer := InitTracing("test")
+	closer := InitTracingWithConfig("test", &Config{Exporter: ExporterStdout, SampleFraction: 1})
 	defer closer.Close()
 	os.Exit(m.Run())
 }
 
-func TestInit(t *testing.T) {
-	cfg := initFromEnv("test")
-	assert.NotNil(t, cfg)
+func TestNewExporter(t *testing.T) {
+	exp, err := newExporter(&Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, exp)
+
+	exp, err = newExporter(&Config{Exporter: ExporterStdout})
+	assert.NoError(t, err)
+	assert.NotNil(t, exp)
+
+	exp, err = newExporter(&Config{Exporter: ExporterOtlp, OtlpEndpoint: "127.0.0.1:4317", OtlpInsecure: true})
+	assert.NoError(t, err)
+	assert.NotNil(t, exp)
+
+	exp, err = newExporter(&Config{Exporter: ExporterOtlp, OtlpEndpoint: "127.0.0.1:4318", OtlpMethod: "http"})
+	assert.NoError(t, err)
+	assert.NotNil(t, exp)
+
+	_, err = newExporter(&Config{Exporter: ExporterOtlp, OtlpMethod: "unknown"})
+	assert.Error(t, err)
+
+	_, err = newExporter(&Config{Exporter: "jaeger"})
+	assert.Error(t, err)
 }
 
 func TestTracing(t *testing.T) {
@@ -48,16 +62,14 @@ func TestTracing(t *testing.T) {
 	sp, ctx := StartSpanFromContext(ctx)
 	id, sampled, found := InfoFromContext(ctx)
 	fmt.Printf("traceID = %s, sampled = %t, found = %t", id, sampled, found)
-	sp.SetTag("tag1", "tag1")
+	assert.True(t, found)
+	assert.True(t, sampled)
+	sp.SetAttributes(attribute.String("tag1", "tag1"))
 	// use self-defined operation name for span
 	// sp, ctx := StartSpanFromContextWithOperationName(ctx, "self-defined name")
-	defer sp.Finish()
+	defer sp.End()
 
-	ss := &simpleStruct{
-		name:  "name",
-		value: "value",
-	}
-	sp.LogFields(oplog.String("key", "value"), oplog.Object("key", ss))
+	sp.AddEvent("event", oteltrace.WithAttributes(attribute.String("key", "value")))
 
 	err := caller(ctx)
 
@@ -70,9 +82,9 @@ func TestTracing(t *testing.T) {
 func caller(ctx context.Context) error {
 	for i := 0; i < 2; i++ {
 		// if span starts in a loop, defer is not allowed.
-		// manually call span.Finish() if error occurs or one loop ends
+		// manually call span.End() if error occurs or one loop ends
 		sp, _ := StartSpanFromContextWithOperationName(ctx, fmt.Sprintf("test:%d", i))
-		sp.SetTag(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i))
+		sp.SetAttributes(attribute.String(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i)))
 
 		var err error
 		if i == 1 {
@@ -81,11 +93,11 @@ func caller(ctx context.Context) error {
 
 		if err != nil {
 			LogError(sp, err)
-			sp.Finish()
+			sp.End()
 			return nil
 		}
 
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
@@ -97,14 +109,16 @@ func TestInject(t *testing.T) {
 	//start span
 	//default use function name for operation name
 	sp, ctx := StartSpanFromContext(ctx)
-	id, sampled, found := InfoFromContext(ctx)
-	fmt.Printf("traceID = %s, sampled = %t, found = %t", id, sampled, found)
-	pp := PropertiesReaderWriter{PpMap: map[string]string{}}
-	InjectContextToMsgProperties(sp.Context(), pp.PpMap)
-	tracer := opentracing.GlobalTracer()
-	sc, _ := tracer.Extract(opentracing.TextMap, pp)
-	assert.NotNil(t, sc)
-
+	defer sp.End()
+	properties := map[string]string{}
+	InjectContextToMsgProperties(ctx, properties)
+	assert.Contains(t, properties, "traceparent")
+
+	remoteCtx := ExtractContextFromMsgProperties(context.Background(), properties)
+	child, _ := StartSpanFromContext(remoteCtx)
+	defer child.End()
+	assert.Equal(t, sp.SpanContext().TraceID(), child.SpanContext().TraceID())
+	assert.NotEqual(t, sp.SpanContext().SpanID(), child.SpanContext().SpanID())
 }
 
 func TestTraceError(t *testing.T) {
@@ -132,4 +146,9 @@ func TestTraceError(t *testing.T) {
 	assert.Equal(t, id, "")
 	assert.Equal(t, sampled, false)
 	assert.Equal(t, found, false)
+
+	id, sampled, found = InfoFromSpan(NoopSpan())
+	assert.Equal(t, id, "")
+	assert.Equal(t, sampled, false)
+	assert.Equal(t, found, false)
 }
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+// SearchGroupBy tracks the groups while reducing search results grouped by a scalar field.
+// Hits must be fed in descending order of score, so the groups are ranked by their best hit.
+type SearchGroupBy struct {
+	groupTopK int64
+	groupSize int64
+	// group value -> rank of the group
+	ranks map[interface{}]int64
+	// group value -> number of hits kept in the group
+	counts map[interface{}]int64
+}
+
+// NewSearchGroupBy creates a SearchGroupBy keeping at most groupTopK groups, each with at most groupSize hits.
+func NewSearchGroupBy(groupTopK int64, groupSize int64) *SearchGroupBy {
+	return &SearchGroupBy{
+		groupTopK: groupTopK,
+		groupSize: groupSize,
+		ranks:     make(map[interface{}]int64),
+		counts:    make(map[interface{}]int64),
+	}
+}
+
+// Add tries to put a hit of the group value, it returns the rank of the group and whether the hit is kept.
+func (g *SearchGroupBy) Add(value interface{}) (int64, bool) {
+	rank, ok := g.ranks[value]
+	if !ok {
+		if int64(len(g.ranks)) >= g.groupTopK {
+			return -1, false
+		}
+		rank = int64(len(g.ranks))
+		g.ranks[value] = rank
+	}
+	if g.counts[value] >= g.groupSize {
+		return rank, false
+	}
+	g.counts[value]++
+	return rank, true
+}
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchGroupBy(t *testing.T) {
+	g := NewSearchGroupBy(2, 2)
+
+	rank, ok := g.Add("a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), rank)
+
+	rank, ok = g.Add(int64(1))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), rank)
+
+	// group a is full after two hits
+	rank, ok = g.Add("a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), rank)
+	rank, ok = g.Add("a")
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), rank)
+
+	// no more groups allowed
+	rank, ok = g.Add("b")
+	assert.False(t, ok)
+	assert.Equal(t, int64(-1), rank)
+
+	// nil is also a group value
+	g = NewSearchGroupBy(1, 1)
+	_, ok = g.Add(nil)
+	assert.True(t, ok)
+	_, ok = g.Add(nil)
+	assert.False(t, ok)
+}
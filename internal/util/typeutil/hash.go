@@ -17,6 +17,8 @@
 package typeutil
 
 import (
+	"errors"
+	"fmt"
 	"hash/crc32"
 	"unsafe"
 
@@ -94,3 +96,27 @@ func HashPK2Channels(primaryKeys *schemapb.IDs, shardNames []string) []uint32 {
 
 	return hashValues
 }
+
+// HashKey2Partitions hash partition keys to partitions
+func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]uint32, error) {
+	numPartitions := uint32(len(partitionNames))
+	if numPartitions == 0 {
+		return nil, errors.New("no partitions to hash partition keys to")
+	}
+	var hashValues []uint32
+	switch keys.GetType() {
+	case schemapb.DataType_Int64:
+		for _, key := range keys.GetScalars().GetLongData().GetData() {
+			value, _ := Hash32Int64(key)
+			hashValues = append(hashValues, value%numPartitions)
+		}
+	case schemapb.DataType_VarChar:
+		for _, key := range keys.GetScalars().GetStringData().GetData() {
+			hash := HashString2Uint32(key)
+			hashValues = append(hashValues, hash%numPartitions)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported partition key type: %s", keys.GetType().String())
+	}
+	return hashValues, nil
+}
@@ -94,3 +94,46 @@ func TestHashPK2Channels(t *testing.T) {
 	assert.Equal(t, 5, len(ret))
 	assert.Equal(t, ret[1], ret[2])
 }
+
+func TestHashKey2Partitions(t *testing.T) {
+	partitionNames := []string{"p0", "p1", "p2"}
+	int64Keys := &schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{Data: []int64{100, 102, 102, 103, 104}},
+				},
+			},
+		},
+	}
+	ret, err := HashKey2Partitions(int64Keys, partitionNames)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(ret))
+	// same key hash to same partition
+	assert.Equal(t, ret[1], ret[2])
+	for _, idx := range ret {
+		assert.Less(t, idx, uint32(len(partitionNames)))
+	}
+
+	stringKeys := &schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: []string{"ab", "bc", "bc", "abd", "milvus"}},
+				},
+			},
+		},
+	}
+	ret, err = HashKey2Partitions(stringKeys, partitionNames)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(ret))
+	assert.Equal(t, ret[1], ret[2])
+
+	_, err = HashKey2Partitions(stringKeys, nil)
+	assert.Error(t, err)
+
+	_, err = HashKey2Partitions(&schemapb.FieldData{Type: schemapb.DataType_Float}, partitionNames)
+	assert.Error(t, err)
+}
@@ -26,6 +26,7 @@ func TestGenEmptyFieldData(t *testing.T) {
 		schemapb.DataType_Float,
 		schemapb.DataType_Double,
 		schemapb.DataType_VarChar,
+		DataTypeJSON,
 	}
 	allUnsupportedTypes := []schemapb.DataType{
 		schemapb.DataType_String,
@@ -29,6 +29,26 @@ import (
 	"go.uber.org/zap"
 )
 
+// DataTypeJSON is the data type of fields holding JSON documents. The schemapb enum has no value for it
+// yet, so the value is reserved here and must stay in sync with the segcore DataType enum.
+const DataTypeJSON schemapb.DataType = 23
+
+// DataTypeArray is the data type of fields holding a list of scalars, the element type is declared by the
+// element_type type param. Like DataTypeJSON, it is reserved here until schemapb has a value for it.
+const DataTypeArray schemapb.DataType = 22
+
+// DataTypeName returns the name of dataType, including the types not known to schemapb.
+func DataTypeName(dataType schemapb.DataType) string {
+	switch dataType {
+	case DataTypeJSON:
+		return "JSON"
+	case DataTypeArray:
+		return "Array"
+	default:
+		return dataType.String()
+	}
+}
+
 func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error) {
 	maxLength := 0
 	var err error
@@ -50,6 +70,9 @@ func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error
 		if err != nil {
 			return 0, err
 		}
+	case DataTypeJSON, DataTypeArray:
+		// JSON documents and arrays have no declared length limit
+		maxLength = math.MaxInt32
 	default:
 		return 0, fmt.Errorf("field %s is not a variable-length type", fieldSchema.DataType.String())
 	}
@@ -75,7 +98,7 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 4
 		case schemapb.DataType_Int64, schemapb.DataType_Double:
 			res += 8
-		case schemapb.DataType_VarChar:
+		case schemapb.DataType_VarChar, DataTypeJSON, DataTypeArray:
 			maxLengthPerRow, err := GetAvgLengthOfVarLengthField(fs)
 			if err != nil {
 				return 0, err
@@ -126,6 +149,11 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			}
 			//TODO:: check len(varChar) <= maxLengthPerRow
 			res += len(fs.GetScalars().GetStringData().Data[rowOffset])
+		case DataTypeJSON, DataTypeArray:
+			if rowOffset >= len(fs.GetScalars().GetBytesData().GetData()) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(fs.GetScalars().GetBytesData().Data[rowOffset])
 		case schemapb.DataType_BinaryVector:
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
@@ -137,10 +165,11 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 
 // SchemaHelper provides methods to get the schema of fields
 type SchemaHelper struct {
-	schema           *schemapb.CollectionSchema
-	nameOffset       map[string]int
-	idOffset         map[int64]int
-	primaryKeyOffset int
+	schema             *schemapb.CollectionSchema
+	nameOffset         map[string]int
+	idOffset           map[int64]int
+	primaryKeyOffset   int
+	dynamicFieldOffset int
 }
 
 // CreateSchemaHelper returns a new SchemaHelper object
@@ -148,7 +177,7 @@ func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error
 	if schema == nil {
 		return nil, errors.New("schema is nil")
 	}
-	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1}
+	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1, dynamicFieldOffset: -1}
 	for offset, field := range schema.Fields {
 		if _, ok := schemaHelper.nameOffset[field.Name]; ok {
 			return nil, fmt.Errorf("duplicated fieldName: %s", field.Name)
@@ -164,6 +193,9 @@ func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error
 			}
 			schemaHelper.primaryKeyOffset = offset
 		}
+		if IsDynamicField(field) {
+			schemaHelper.dynamicFieldOffset = offset
+		}
 	}
 	return &schemaHelper, nil
 }
@@ -176,6 +208,14 @@ func (helper *SchemaHelper) GetPrimaryKeyField() (*schemapb.FieldSchema, error)
 	return helper.schema.Fields[helper.primaryKeyOffset], nil
 }
 
+// GetDynamicField returns the schema of the hidden field which keeps the undeclared fields
+func (helper *SchemaHelper) GetDynamicField() (*schemapb.FieldSchema, error) {
+	if helper.dynamicFieldOffset == -1 {
+		return nil, fmt.Errorf("failed to get dynamic field: dynamic field is not enabled")
+	}
+	return helper.schema.Fields[helper.dynamicFieldOffset], nil
+}
+
 // GetFieldFromName is used to find the schema by field name
 func (helper *SchemaHelper) GetFieldFromName(fieldName string) (*schemapb.FieldSchema, error) {
 	offset, ok := helper.nameOffset[fieldName]
@@ -271,6 +311,199 @@ func IsStringType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsJSONType returns true if input is a JSON type, otherwise false
+func IsJSONType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeJSON
+}
+
+// IsArrayType returns true if input is an array type, otherwise false
+func IsArrayType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeArray
+}
+
+// GetElementType returns the element type declared by the type params of an array field
+func GetElementType(field *schemapb.FieldSchema) (schemapb.DataType, error) {
+	if !IsArrayType(field.GetDataType()) {
+		return schemapb.DataType_None, fmt.Errorf("%s is not of array type", field.GetName())
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.ElementTypeKey {
+			elementType, ok := schemapb.DataType_value[kv.GetValue()]
+			if !ok {
+				return schemapb.DataType_None, fmt.Errorf("invalid element type %s of array field %s", kv.GetValue(), field.GetName())
+			}
+			return schemapb.DataType(elementType), nil
+		}
+	}
+	return schemapb.DataType_None, fmt.Errorf("element type is not specified for array field %s", field.GetName())
+}
+
+// ValidateArrayField checks that the element type of an array field is a supported scalar type
+func ValidateArrayField(field *schemapb.FieldSchema) error {
+	elementType, err := GetElementType(field)
+	if err != nil {
+		return err
+	}
+	switch elementType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+		return nil
+	default:
+		return fmt.Errorf("element type %s is not supported for array field %s", elementType.String(), field.GetName())
+	}
+}
+
+// IsDynamicField returns true if the field keeps the undeclared fields of a dynamic schema
+func IsDynamicField(field *schemapb.FieldSchema) bool {
+	return field.GetName() == common.MetaFieldName && IsJSONType(field.GetDataType())
+}
+
+// IsPartitionKeyField returns true if the field is marked as the partition key of the collection
+func IsPartitionKeyField(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.PartitionKeyKey {
+			isPartitionKey, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && isPartitionKey
+		}
+	}
+	return false
+}
+
+// GetPartitionKeyField returns the partition key field of the schema, or nil if partition key is not enabled
+func GetPartitionKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if IsPartitionKeyField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
+// ValidatePartitionKeyField checks that at most one non-primary int64 or varchar field is marked as partition key
+func ValidatePartitionKeyField(schema *schemapb.CollectionSchema) error {
+	var partitionKeyField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if !IsPartitionKeyField(field) {
+			continue
+		}
+		if partitionKeyField != nil {
+			return fmt.Errorf("there are more than one partition key field: %s, %s", partitionKeyField.GetName(), field.GetName())
+		}
+		if field.GetIsPrimaryKey() {
+			return fmt.Errorf("the primary field %s can not be the partition key", field.GetName())
+		}
+		if field.GetDataType() != schemapb.DataType_Int64 && field.GetDataType() != schemapb.DataType_VarChar {
+			return fmt.Errorf("the data type of partition key field %s should be Int64 or VarChar", field.GetName())
+		}
+		partitionKeyField = field
+	}
+	return nil
+}
+
+// IsClusteringKeyField returns true if the field is marked as the clustering key of the collection
+func IsClusteringKeyField(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.ClusteringKeyKey {
+			isClusteringKey, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && isClusteringKey
+		}
+	}
+	return false
+}
+
+// GetClusteringKeyField returns the clustering key field of the schema, or nil if clustering key is not enabled
+func GetClusteringKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if IsClusteringKeyField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
+// ValidateClusteringKeyField checks that at most one non-primary integer or varchar field is marked as clustering key
+func ValidateClusteringKeyField(schema *schemapb.CollectionSchema) error {
+	var clusteringKeyField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if !IsClusteringKeyField(field) {
+			continue
+		}
+		if clusteringKeyField != nil {
+			return fmt.Errorf("there are more than one clustering key field: %s, %s", clusteringKeyField.GetName(), field.GetName())
+		}
+		if field.GetIsPrimaryKey() {
+			return fmt.Errorf("the primary field %s can not be the clustering key", field.GetName())
+		}
+		switch field.GetDataType() {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_VarChar:
+		default:
+			return fmt.Errorf("the data type of clustering key field %s should be integer or VarChar", field.GetName())
+		}
+		clusteringKeyField = field
+	}
+	return nil
+}
+
+// IsBloomFilterField returns true if the bloom filter stats of the field is enabled
+func IsBloomFilterField(field *schemapb.FieldSchema) bool {
+	return getBoolTypeParam(field, common.BloomFilterKey)
+}
+
+// IsFieldStatsEnabled returns true if the min/max stats of the field are written at flush and compaction,
+// which is the case for the clustering key and the fields enabling the stats or the bloom filter stats
+func IsFieldStatsEnabled(field *schemapb.FieldSchema) bool {
+	return getBoolTypeParam(field, common.FieldStatsKey) || IsBloomFilterField(field) || IsClusteringKeyField(field)
+}
+
+func getBoolTypeParam(field *schemapb.FieldSchema, key string) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == key {
+			enabled, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && enabled
+		}
+	}
+	return false
+}
+
+// ValidateFieldStats checks that the min/max stats are only enabled on the non-primary numeric or varchar fields,
+// and the bloom filter stats are only enabled on the non-primary integer or varchar fields
+func ValidateFieldStats(schema *schemapb.CollectionSchema) error {
+	for _, field := range schema.GetFields() {
+		if getBoolTypeParam(field, common.FieldStatsKey) {
+			if field.GetIsPrimaryKey() {
+				return fmt.Errorf("the primary field %s always has stats, it can not be enabled again", field.GetName())
+			}
+			switch field.GetDataType() {
+			case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+				schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+			default:
+				return fmt.Errorf("the data type of stats field %s should be numeric or VarChar", field.GetName())
+			}
+		}
+		if IsBloomFilterField(field) {
+			if field.GetIsPrimaryKey() {
+				return fmt.Errorf("the primary field %s always has bloom filter, it can not be enabled again", field.GetName())
+			}
+			switch field.GetDataType() {
+			case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_VarChar:
+			default:
+				return fmt.Errorf("the data type of bloom filter field %s should be integer or VarChar", field.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// GetDynamicField returns the dynamic field of the schema, or nil if dynamic schema is not enabled
+func GetDynamicField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if IsDynamicField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -348,6 +581,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -420,6 +663,8 @@ func DeleteFieldData(dst []*schemapb.FieldData) {
 				dstScalar.GetDoubleData().Data = dstScalar.GetDoubleData().Data[:len(dstScalar.GetDoubleData().Data)-1]
 			case *schemapb.ScalarField_StringData:
 				dstScalar.GetStringData().Data = dstScalar.GetStringData().Data[:len(dstScalar.GetStringData().Data)-1]
+			case *schemapb.ScalarField_BytesData:
+				dstScalar.GetBytesData().Data = dstScalar.GetBytesData().Data[:len(dstScalar.GetBytesData().Data)-1]
 			default:
 				log.Error("wrong field type added", zap.String("field type", fieldData.Type.String()))
 			}
@@ -526,6 +771,16 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data...)
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: srcScalar.BytesData.Data,
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data...)
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", srcFieldData.Type.String()))
 			}
@@ -685,6 +940,46 @@ func GetPK(data *schemapb.IDs, idx int64) interface{} {
 	return nil
 }
 
+// IsGroupByFieldType returns whether search results can be grouped by a field of the data type
+func IsGroupByFieldType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+		schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetGroupByValue returns the value of the scalar field at idx, nil is returned if the field or the row is missing
+func GetGroupByValue(fieldsData []*schemapb.FieldData, fieldID int64, idx int64) interface{} {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != fieldID {
+			continue
+		}
+		switch scalarData := fieldData.GetScalars().GetData().(type) {
+		case *schemapb.ScalarField_BoolData:
+			if idx < int64(len(scalarData.BoolData.GetData())) {
+				return scalarData.BoolData.GetData()[idx]
+			}
+		case *schemapb.ScalarField_IntData:
+			if idx < int64(len(scalarData.IntData.GetData())) {
+				return scalarData.IntData.GetData()[idx]
+			}
+		case *schemapb.ScalarField_LongData:
+			if idx < int64(len(scalarData.LongData.GetData())) {
+				return scalarData.LongData.GetData()[idx]
+			}
+		case *schemapb.ScalarField_StringData:
+			if idx < int64(len(scalarData.StringData.GetData())) {
+				return scalarData.StringData.GetData()[idx]
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 func GetTS(i *internalpb.RetrieveResults, idx int64) uint64 {
 	if i.FieldsData == nil {
 		return 0
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -421,6 +422,21 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 			},
 			FieldId: fieldID,
 		}
+	case DataTypeJSON:
+		fieldData = &schemapb.FieldData{
+			Type:      DataTypeJSON,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: fieldValue.([][]byte),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
 	case schemapb.DataType_BinaryVector:
 		fieldData = &schemapb.FieldData{
 			Type:      schemapb.DataType_BinaryVector,
@@ -468,6 +484,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		JSONFieldName         = "JSONField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -475,6 +492,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		JSONFieldID           = common.StartOfUserFieldID + 8
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -483,8 +501,9 @@ func TestAppendFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	JSONArray := [][]byte{[]byte(`{"a":1}`), []byte(`{"b":[2,3]}`)}
 
-	result := make([]*schemapb.FieldData, 7)
+	result := make([]*schemapb.FieldData, 8)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -493,6 +512,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -502,6 +522,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[1:2], 1))
 
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
@@ -513,6 +534,7 @@ func TestAppendFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray, result[7].GetScalars().GetBytesData().Data)
 }
 
 func TestDeleteFieldData(t *testing.T) {
@@ -525,6 +547,7 @@ func TestDeleteFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		JSONFieldName         = "JSONField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -532,6 +555,7 @@ func TestDeleteFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		JSONFieldID           = common.StartOfUserFieldID + 8
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -540,9 +564,10 @@ func TestDeleteFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	JSONArray := [][]byte{[]byte(`{"a":1}`), []byte(`{"b":[2,3]}`)}
 
-	result1 := make([]*schemapb.FieldData, 7)
-	result2 := make([]*schemapb.FieldData, 7)
+	result1 := make([]*schemapb.FieldData, 8)
+	result2 := make([]*schemapb.FieldData, 8)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -551,6 +576,7 @@ func TestDeleteFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -560,6 +586,7 @@ func TestDeleteFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[1:2], 1))
 
 	AppendFieldData(result1, fieldDataArray1, 0)
 	AppendFieldData(result1, fieldDataArray2, 0)
@@ -571,6 +598,7 @@ func TestDeleteFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray[0:1], result1[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector[0:Dim/8], result1[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector[0:Dim], result1[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray[0:1], result1[7].GetScalars().GetBytesData().Data)
 
 	AppendFieldData(result2, fieldDataArray2, 0)
 	AppendFieldData(result2, fieldDataArray1, 0)
@@ -582,6 +610,7 @@ func TestDeleteFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray[1:2], result2[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector[Dim/8:2*Dim/8], result2[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector[Dim:2*Dim], result2[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray[1:2], result2[7].GetScalars().GetBytesData().Data)
 }
 
 func TestGetPrimaryFieldSchema(t *testing.T) {
@@ -611,6 +640,67 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestGetDynamicField(t *testing.T) {
+	int64Field := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "int64Field",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{int64Field},
+	}
+
+	assert.Nil(t, GetDynamicField(schema))
+	helper, err := CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+	_, err = helper.GetDynamicField()
+	assert.Error(t, err)
+
+	dynamicField := &schemapb.FieldSchema{
+		FieldID:  101,
+		Name:     common.MetaFieldName,
+		DataType: DataTypeJSON,
+	}
+	schema.Fields = append(schema.Fields, dynamicField)
+	assert.Equal(t, dynamicField, GetDynamicField(schema))
+	helper, err = CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+	field, err := helper.GetDynamicField()
+	assert.NoError(t, err)
+	assert.Equal(t, dynamicField, field)
+}
+
+func TestArrayField(t *testing.T) {
+	arrayField := &schemapb.FieldSchema{
+		FieldID:  100,
+		Name:     "arrayField",
+		DataType: DataTypeArray,
+	}
+	assert.True(t, IsArrayType(arrayField.GetDataType()))
+	assert.Equal(t, "Array", DataTypeName(arrayField.GetDataType()))
+
+	// element type is not specified
+	_, err := GetElementType(arrayField)
+	assert.Error(t, err)
+	assert.Error(t, ValidateArrayField(arrayField))
+
+	arrayField.TypeParams = []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: "Int64"}}
+	elementType, err := GetElementType(arrayField)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, elementType)
+	assert.NoError(t, ValidateArrayField(arrayField))
+
+	arrayField.TypeParams[0].Value = "FloatVector"
+	assert.Error(t, ValidateArrayField(arrayField))
+
+	arrayField.TypeParams[0].Value = "NotAType"
+	assert.Error(t, ValidateArrayField(arrayField))
+
+	_, err = GetElementType(&schemapb.FieldSchema{Name: "int64Field", DataType: schemapb.DataType_Int64})
+	assert.Error(t, err)
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -670,6 +760,40 @@ func TestGetPK(t *testing.T) {
 	}
 }
 
+func TestGetGroupByValue(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{
+		genFieldData("bool", 100, schemapb.DataType_Bool, []bool{true, false}, 1),
+		genFieldData("int32", 101, schemapb.DataType_Int32, []int32{1, 2}, 1),
+		genFieldData("int64", 102, schemapb.DataType_Int64, []int64{3, 4}, 1),
+		{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: 103,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: []string{"a", "b"}},
+					},
+				},
+			},
+		},
+		genFieldData("float", 104, schemapb.DataType_Float, []float32{1.0, 2.0}, 1),
+	}
+
+	assert.Equal(t, false, GetGroupByValue(fieldsData, 100, 1))
+	assert.Equal(t, int32(2), GetGroupByValue(fieldsData, 101, 1))
+	assert.Equal(t, int64(3), GetGroupByValue(fieldsData, 102, 0))
+	assert.Equal(t, "b", GetGroupByValue(fieldsData, 103, 1))
+	assert.Nil(t, GetGroupByValue(fieldsData, 104, 0))
+	assert.Nil(t, GetGroupByValue(fieldsData, 105, 0))
+	assert.Nil(t, GetGroupByValue(fieldsData, 102, 2))
+
+	assert.True(t, IsGroupByFieldType(schemapb.DataType_Int64))
+	assert.True(t, IsGroupByFieldType(schemapb.DataType_VarChar))
+	assert.False(t, IsGroupByFieldType(schemapb.DataType_Float))
+	assert.False(t, IsGroupByFieldType(DataTypeJSON))
+	assert.False(t, IsGroupByFieldType(schemapb.DataType_FloatVector))
+}
+
 func TestGetTS(t *testing.T) {
 	var timeStampFieldData = [5]Timestamp{0, 1, 2, 3, 4}
 	result := &internalpb.RetrieveResults{
@@ -787,3 +911,174 @@ func TestComparePk(t *testing.T) {
 	less = ComparePKInSlice(strPks, 2, 1)
 	assert.False(t, less)
 }
+
+func TestPartitionKeyField(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+	keyField := &schemapb.FieldSchema{
+		FieldID:    101,
+		Name:       "tenant",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}},
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField},
+	}
+	assert.Nil(t, GetPartitionKeyField(schema))
+	assert.NoError(t, ValidatePartitionKeyField(schema))
+
+	schema.Fields = append(schema.Fields, keyField)
+	assert.True(t, IsPartitionKeyField(keyField))
+	assert.Equal(t, keyField, GetPartitionKeyField(schema))
+	assert.NoError(t, ValidatePartitionKeyField(schema))
+
+	// more than one partition key
+	anotherKeyField := proto.Clone(keyField).(*schemapb.FieldSchema)
+	anotherKeyField.Name = "another"
+	assert.Error(t, ValidatePartitionKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, keyField, anotherKeyField},
+	}))
+
+	// primary key can't be partition key
+	pkKeyField := proto.Clone(pkField).(*schemapb.FieldSchema)
+	pkKeyField.TypeParams = keyField.GetTypeParams()
+	assert.Error(t, ValidatePartitionKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkKeyField},
+	}))
+
+	// unsupported data type
+	floatKeyField := &schemapb.FieldSchema{
+		FieldID:    102,
+		Name:       "float",
+		DataType:   schemapb.DataType_Float,
+		TypeParams: keyField.GetTypeParams(),
+	}
+	assert.Error(t, ValidatePartitionKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, floatKeyField},
+	}))
+
+	// invalid flag value
+	assert.False(t, IsPartitionKeyField(&schemapb.FieldSchema{
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "invalid"}},
+	}))
+}
+
+func TestClusteringKeyField(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+	keyField := &schemapb.FieldSchema{
+		FieldID:    101,
+		Name:       "ts",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKeyKey, Value: "true"}},
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField},
+	}
+	assert.Nil(t, GetClusteringKeyField(schema))
+	assert.NoError(t, ValidateClusteringKeyField(schema))
+
+	schema.Fields = append(schema.Fields, keyField)
+	assert.True(t, IsClusteringKeyField(keyField))
+	assert.Equal(t, keyField, GetClusteringKeyField(schema))
+	assert.NoError(t, ValidateClusteringKeyField(schema))
+
+	// more than one clustering key
+	anotherKeyField := proto.Clone(keyField).(*schemapb.FieldSchema)
+	anotherKeyField.Name = "another"
+	assert.Error(t, ValidateClusteringKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, keyField, anotherKeyField},
+	}))
+
+	// primary key can't be clustering key
+	pkKeyField := proto.Clone(pkField).(*schemapb.FieldSchema)
+	pkKeyField.TypeParams = keyField.GetTypeParams()
+	assert.Error(t, ValidateClusteringKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkKeyField},
+	}))
+
+	// unsupported data type
+	floatKeyField := &schemapb.FieldSchema{
+		FieldID:    102,
+		Name:       "float",
+		DataType:   schemapb.DataType_Float,
+		TypeParams: keyField.GetTypeParams(),
+	}
+	assert.Error(t, ValidateClusteringKeyField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, floatKeyField},
+	}))
+
+	// invalid flag value
+	assert.False(t, IsClusteringKeyField(&schemapb.FieldSchema{
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKeyKey, Value: "invalid"}},
+	}))
+}
+
+func TestBloomFilterField(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	bfParams := []*commonpb.KeyValuePair{{Key: common.BloomFilterKey, Value: "true"}}
+	int32Field := &schemapb.FieldSchema{FieldID: 101, Name: "int32", DataType: schemapb.DataType_Int32, TypeParams: bfParams}
+	varcharField := &schemapb.FieldSchema{FieldID: 102, Name: "varchar", DataType: schemapb.DataType_VarChar, TypeParams: bfParams}
+
+	assert.False(t, IsBloomFilterField(pkField))
+	assert.True(t, IsBloomFilterField(int32Field))
+	assert.False(t, IsBloomFilterField(&schemapb.FieldSchema{
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.BloomFilterKey, Value: "invalid"}},
+	}))
+
+	assert.NoError(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, int32Field, varcharField},
+	}))
+
+	// primary key has bloom filter already
+	pkBloomField := proto.Clone(pkField).(*schemapb.FieldSchema)
+	pkBloomField.TypeParams = bfParams
+	assert.Error(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkBloomField},
+	}))
+
+	// unsupported data type
+	assert.Error(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, {FieldID: 103, Name: "double", DataType: schemapb.DataType_Double, TypeParams: bfParams}},
+	}))
+}
+
+func TestFieldStatsEnabled(t *testing.T) {
+	statsParams := []*commonpb.KeyValuePair{{Key: common.FieldStatsKey, Value: "true"}}
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	doubleField := &schemapb.FieldSchema{FieldID: 101, Name: "double", DataType: schemapb.DataType_Double, TypeParams: statsParams}
+
+	assert.False(t, IsFieldStatsEnabled(pkField))
+	assert.False(t, IsFieldStatsEnabled(&schemapb.FieldSchema{FieldID: 102, Name: "int64", DataType: schemapb.DataType_Int64}))
+	assert.True(t, IsFieldStatsEnabled(doubleField))
+	assert.True(t, IsFieldStatsEnabled(&schemapb.FieldSchema{
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.BloomFilterKey, Value: "true"}},
+	}))
+	assert.True(t, IsFieldStatsEnabled(&schemapb.FieldSchema{
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKeyKey, Value: "true"}},
+	}))
+
+	assert.NoError(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, doubleField},
+	}))
+
+	// primary key has stats already
+	pkStatsField := proto.Clone(pkField).(*schemapb.FieldSchema)
+	pkStatsField.TypeParams = statsParams
+	assert.Error(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkStatsField},
+	}))
+
+	// unsupported data type
+	assert.Error(t, ValidateFieldStats(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, {FieldID: 103, Name: "bool", DataType: schemapb.DataType_Bool, TypeParams: statsParams}},
+	}))
+}
@@ -9,10 +9,25 @@ CREATE DATABASE if not exists milvus_meta CHARACTER SET utf8mb4;
     2. Query index in community version CANNOT includes tenant_id, since tenant_id is not existed and will miss query index.
  */
 
+-- databases
+CREATE TABLE if not exists milvus_meta.databases (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT NOT NULL,
+    db_name VARCHAR(256),
+    ts BIGINT UNSIGNED DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_db_id_ts (tenant_id, db_id, ts)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 -- collections
 CREATE TABLE if not exists milvus_meta.collections (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT DEFAULT 0,
     collection_id BIGINT NOT NULL,
     collection_name VARCHAR(256),
     description VARCHAR(2048) DEFAULT NULL,
@@ -34,6 +49,7 @@ CREATE TABLE if not exists milvus_meta.collections (
 CREATE TABLE if not exists milvus_meta.collection_aliases (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT DEFAULT 0,
     collection_id BIGINT NOT NULL,
     collection_alias VARCHAR(128),
     ts BIGINT UNSIGNED DEFAULT 0,
@@ -41,7 +57,7 @@ CREATE TABLE if not exists milvus_meta.collection_aliases (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    UNIQUE KEY uk_tenant_id_collection_alias_ts (tenant_id, collection_alias, ts),
+    UNIQUE KEY uk_tenant_id_db_id_collection_alias_ts (tenant_id, db_id, collection_alias, ts),
     INDEX idx_tenant_id_collection_id_ts (tenant_id, collection_id, ts)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -142,7 +158,7 @@ CREATE TABLE if not exists milvus_meta.segments (
     collection_id BIGINT NOT NULL,
     partition_id BIGINT NOT NULL,
     num_rows BIGINT NOT NULL,
-    max_row_num INT COMMENT 'estimate max rows',
+    max_row_num BIGINT COMMENT 'estimate max rows',
     dm_channel VARCHAR(128) NOT NULL,
     dml_position TEXT COMMENT 'checkpoint',
     start_position TEXT,
@@ -151,11 +167,13 @@ CREATE TABLE if not exists milvus_meta.segments (
     segment_state TINYINT UNSIGNED NOT NULL,
     last_expire_time bigint unsigned COMMENT 'segment assignment expiration time',
     dropped_at bigint unsigned,
-    is_deleted BOOL DEFAULT FALSE,
+    is_importing BOOL DEFAULT FALSE,
+    is_fake BOOL DEFAULT FALSE,
+    is_clustered BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    INDEX idx_tenant_id_collection_id_segment_id (tenant_id, collection_id, segment_id)
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- segment indexes
@@ -186,16 +204,17 @@ CREATE TABLE if not exists milvus_meta.segment_indexes (
 CREATE TABLE if not exists milvus_meta.binlogs (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
-    field_id BIGINT NOT NULL,
-    segment_id BIGINT NOT NULL,
     collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
     log_type SMALLINT UNSIGNED NOT NULL COMMENT 'binlog、stats binlog、delta binlog',
+    log_id BIGINT NOT NULL,
     num_entries BIGINT,
     timestamp_from BIGINT UNSIGNED,
     timestamp_to BIGINT UNSIGNED,
     log_path VARCHAR(256) NOT NULL,
     log_size BIGINT NOT NULL,
-    is_deleted BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
@@ -267,4 +286,83 @@ CREATE TABLE if not exists milvus_meta.grant_id (
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     INDEX idx_grant_id_tenant_grantor (tenant_id, grant_id, grantor_id, is_deleted),
     PRIMARY KEY (id)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- flushed segment events waiting to be published
+CREATE TABLE if not exists milvus_meta.segment_events (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    segment_id BIGINT NOT NULL,
+    event_key VARCHAR(256) NOT NULL,
+    event MEDIUMBLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    virtual_channel VARCHAR(128) NOT NULL,
+    position TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_virtual_channel (tenant_id, virtual_channel)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel remove flags
+CREATE TABLE if not exists milvus_meta.removed_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    virtual_channel VARCHAR(128) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_virtual_channel (tenant_id, virtual_channel)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load info
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    released_partitions VARCHAR(4096),
+    replica_number INT NOT NULL,
+    status INT NOT NULL,
+    field_index_id VARCHAR(4096),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load info
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    replica_number INT NOT NULL,
+    status INT NOT NULL,
+    field_index_id VARCHAR(4096),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    nodes VARCHAR(4096),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;